@@ -22,6 +22,8 @@ const (
 	Query_Account_FullMethodName      = "/cosmos.circuit.v1.Query/Account"
 	Query_Accounts_FullMethodName     = "/cosmos.circuit.v1.Query/Accounts"
 	Query_DisabledList_FullMethodName = "/cosmos.circuit.v1.Query/DisabledList"
+	Query_TripRules_FullMethodName    = "/cosmos.circuit.v1.Query/TripRules"
+	Query_TripExpiries_FullMethodName = "/cosmos.circuit.v1.Query/TripExpiries"
 )
 
 // QueryClient is the client API for Query service.
@@ -34,6 +36,10 @@ type QueryClient interface {
 	Accounts(ctx context.Context, in *QueryAccountsRequest, opts ...grpc.CallOption) (*AccountsResponse, error)
 	// DisabledList returns a list of disabled message urls
 	DisabledList(ctx context.Context, in *QueryDisabledListRequest, opts ...grpc.CallOption) (*DisabledListResponse, error)
+	// TripRules returns the rules automatically tripping the circuit breaker.
+	TripRules(ctx context.Context, in *QueryTripRulesRequest, opts ...grpc.CallOption) (*TripRulesResponse, error)
+	// TripExpiries returns the expiries of the trips of the circuit breaker.
+	TripExpiries(ctx context.Context, in *QueryTripExpiriesRequest, opts ...grpc.CallOption) (*TripExpiriesResponse, error)
 }
 
 type queryClient struct {
@@ -71,6 +77,24 @@ func (c *queryClient) DisabledList(ctx context.Context, in *QueryDisabledListReq
 	return out, nil
 }
 
+func (c *queryClient) TripRules(ctx context.Context, in *QueryTripRulesRequest, opts ...grpc.CallOption) (*TripRulesResponse, error) {
+	out := new(TripRulesResponse)
+	err := c.cc.Invoke(ctx, Query_TripRules_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TripExpiries(ctx context.Context, in *QueryTripExpiriesRequest, opts ...grpc.CallOption) (*TripExpiriesResponse, error) {
+	out := new(TripExpiriesResponse)
+	err := c.cc.Invoke(ctx, Query_TripExpiries_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -81,6 +105,10 @@ type QueryServer interface {
 	Accounts(context.Context, *QueryAccountsRequest) (*AccountsResponse, error)
 	// DisabledList returns a list of disabled message urls
 	DisabledList(context.Context, *QueryDisabledListRequest) (*DisabledListResponse, error)
+	// TripRules returns the rules automatically tripping the circuit breaker.
+	TripRules(context.Context, *QueryTripRulesRequest) (*TripRulesResponse, error)
+	// TripExpiries returns the expiries of the trips of the circuit breaker.
+	TripExpiries(context.Context, *QueryTripExpiriesRequest) (*TripExpiriesResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -97,6 +125,12 @@ func (UnimplementedQueryServer) Accounts(context.Context, *QueryAccountsRequest)
 func (UnimplementedQueryServer) DisabledList(context.Context, *QueryDisabledListRequest) (*DisabledListResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DisabledList not implemented")
 }
+func (UnimplementedQueryServer) TripRules(context.Context, *QueryTripRulesRequest) (*TripRulesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TripRules not implemented")
+}
+func (UnimplementedQueryServer) TripExpiries(context.Context, *QueryTripExpiriesRequest) (*TripExpiriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TripExpiries not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -164,6 +198,42 @@ func _Query_DisabledList_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_TripRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTripRulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TripRules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_TripRules_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TripRules(ctx, req.(*QueryTripRulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TripExpiries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTripExpiriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TripExpiries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_TripExpiries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TripExpiries(ctx, req.(*QueryTripExpiriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -183,6 +253,14 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DisabledList",
 			Handler:    _Query_DisabledList_Handler,
 		},
+		{
+			MethodName: "TripRules",
+			Handler:    _Query_TripRules_Handler,
+		},
+		{
+			MethodName: "TripExpiries",
+			Handler:    _Query_TripExpiries_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/circuit/v1/query.proto",
@@ -19,9 +19,12 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Msg_AuthorizeCircuitBreaker_FullMethodName = "/cosmos.circuit.v1.Msg/AuthorizeCircuitBreaker"
-	Msg_TripCircuitBreaker_FullMethodName      = "/cosmos.circuit.v1.Msg/TripCircuitBreaker"
-	Msg_ResetCircuitBreaker_FullMethodName     = "/cosmos.circuit.v1.Msg/ResetCircuitBreaker"
+	Msg_AuthorizeCircuitBreaker_FullMethodName  = "/cosmos.circuit.v1.Msg/AuthorizeCircuitBreaker"
+	Msg_TripCircuitBreaker_FullMethodName       = "/cosmos.circuit.v1.Msg/TripCircuitBreaker"
+	Msg_ResetCircuitBreaker_FullMethodName      = "/cosmos.circuit.v1.Msg/ResetCircuitBreaker"
+	Msg_SetTripRule_FullMethodName              = "/cosmos.circuit.v1.Msg/SetTripRule"
+	Msg_RemoveTripRule_FullMethodName           = "/cosmos.circuit.v1.Msg/RemoveTripRule"
+	Msg_ExtendCircuitBreakerTrip_FullMethodName = "/cosmos.circuit.v1.Msg/ExtendCircuitBreakerTrip"
 )
 
 // MsgClient is the client API for Msg service.
@@ -36,6 +39,15 @@ type MsgClient interface {
 	// ResetCircuitBreaker resumes processing of Msg's in the state machine that
 	// have been been paused using TripCircuitBreaker.
 	ResetCircuitBreaker(ctx context.Context, in *MsgResetCircuitBreaker, opts ...grpc.CallOption) (*MsgResetCircuitBreakerResponse, error)
+	// SetTripRule allows a super-admin to set the rule automatically tripping
+	// the circuit breaker for a Msg type URL.
+	SetTripRule(ctx context.Context, in *MsgSetTripRule, opts ...grpc.CallOption) (*MsgSetTripRuleResponse, error)
+	// RemoveTripRule allows a super-admin to remove the rule automatically
+	// tripping the circuit breaker for a Msg type URL.
+	RemoveTripRule(ctx context.Context, in *MsgRemoveTripRule, opts ...grpc.CallOption) (*MsgRemoveTripRuleResponse, error)
+	// ExtendCircuitBreakerTrip allows a super-admin to postpone the expiry of
+	// the trips of Msg type URLs.
+	ExtendCircuitBreakerTrip(ctx context.Context, in *MsgExtendCircuitBreakerTrip, opts ...grpc.CallOption) (*MsgExtendCircuitBreakerTripResponse, error)
 }
 
 type msgClient struct {
@@ -73,6 +85,33 @@ func (c *msgClient) ResetCircuitBreaker(ctx context.Context, in *MsgResetCircuit
 	return out, nil
 }
 
+func (c *msgClient) SetTripRule(ctx context.Context, in *MsgSetTripRule, opts ...grpc.CallOption) (*MsgSetTripRuleResponse, error) {
+	out := new(MsgSetTripRuleResponse)
+	err := c.cc.Invoke(ctx, Msg_SetTripRule_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RemoveTripRule(ctx context.Context, in *MsgRemoveTripRule, opts ...grpc.CallOption) (*MsgRemoveTripRuleResponse, error) {
+	out := new(MsgRemoveTripRuleResponse)
+	err := c.cc.Invoke(ctx, Msg_RemoveTripRule_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ExtendCircuitBreakerTrip(ctx context.Context, in *MsgExtendCircuitBreakerTrip, opts ...grpc.CallOption) (*MsgExtendCircuitBreakerTripResponse, error) {
+	out := new(MsgExtendCircuitBreakerTripResponse)
+	err := c.cc.Invoke(ctx, Msg_ExtendCircuitBreakerTrip_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
@@ -85,6 +124,15 @@ type MsgServer interface {
 	// ResetCircuitBreaker resumes processing of Msg's in the state machine that
 	// have been been paused using TripCircuitBreaker.
 	ResetCircuitBreaker(context.Context, *MsgResetCircuitBreaker) (*MsgResetCircuitBreakerResponse, error)
+	// SetTripRule allows a super-admin to set the rule automatically tripping
+	// the circuit breaker for a Msg type URL.
+	SetTripRule(context.Context, *MsgSetTripRule) (*MsgSetTripRuleResponse, error)
+	// RemoveTripRule allows a super-admin to remove the rule automatically
+	// tripping the circuit breaker for a Msg type URL.
+	RemoveTripRule(context.Context, *MsgRemoveTripRule) (*MsgRemoveTripRuleResponse, error)
+	// ExtendCircuitBreakerTrip allows a super-admin to postpone the expiry of
+	// the trips of Msg type URLs.
+	ExtendCircuitBreakerTrip(context.Context, *MsgExtendCircuitBreakerTrip) (*MsgExtendCircuitBreakerTripResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -101,6 +149,15 @@ func (UnimplementedMsgServer) TripCircuitBreaker(context.Context, *MsgTripCircui
 func (UnimplementedMsgServer) ResetCircuitBreaker(context.Context, *MsgResetCircuitBreaker) (*MsgResetCircuitBreakerResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ResetCircuitBreaker not implemented")
 }
+func (UnimplementedMsgServer) SetTripRule(context.Context, *MsgSetTripRule) (*MsgSetTripRuleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetTripRule not implemented")
+}
+func (UnimplementedMsgServer) RemoveTripRule(context.Context, *MsgRemoveTripRule) (*MsgRemoveTripRuleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveTripRule not implemented")
+}
+func (UnimplementedMsgServer) ExtendCircuitBreakerTrip(context.Context, *MsgExtendCircuitBreakerTrip) (*MsgExtendCircuitBreakerTripResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExtendCircuitBreakerTrip not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -168,6 +225,60 @@ func _Msg_ResetCircuitBreaker_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SetTripRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetTripRule)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetTripRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetTripRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetTripRule(ctx, req.(*MsgSetTripRule))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveTripRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveTripRule)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveTripRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RemoveTripRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveTripRule(ctx, req.(*MsgRemoveTripRule))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ExtendCircuitBreakerTrip_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgExtendCircuitBreakerTrip)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ExtendCircuitBreakerTrip(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_ExtendCircuitBreakerTrip_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ExtendCircuitBreakerTrip(ctx, req.(*MsgExtendCircuitBreakerTrip))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -187,6 +298,18 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ResetCircuitBreaker",
 			Handler:    _Msg_ResetCircuitBreaker_Handler,
 		},
+		{
+			MethodName: "SetTripRule",
+			Handler:    _Msg_SetTripRule_Handler,
+		},
+		{
+			MethodName: "RemoveTripRule",
+			Handler:    _Msg_RemoveTripRule_Handler,
+		},
+		{
+			MethodName: "ExtendCircuitBreakerTrip",
+			Handler:    _Msg_ExtendCircuitBreakerTrip_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/circuit/v1/tx.proto",
@@ -21,9 +21,11 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Query_Allowance_FullMethodName           = "/cosmos.feegrant.v1beta1.Query/Allowance"
-	Query_Allowances_FullMethodName          = "/cosmos.feegrant.v1beta1.Query/Allowances"
-	Query_AllowancesByGranter_FullMethodName = "/cosmos.feegrant.v1beta1.Query/AllowancesByGranter"
+	Query_Allowance_FullMethodName            = "/cosmos.feegrant.v1beta1.Query/Allowance"
+	Query_Allowances_FullMethodName           = "/cosmos.feegrant.v1beta1.Query/Allowances"
+	Query_AllowancesByGranter_FullMethodName  = "/cosmos.feegrant.v1beta1.Query/AllowancesByGranter"
+	Query_SponsorshipPool_FullMethodName      = "/cosmos.feegrant.v1beta1.Query/SponsorshipPool"
+	Query_SponsorshipPoolUsage_FullMethodName = "/cosmos.feegrant.v1beta1.Query/SponsorshipPoolUsage"
 )
 
 // QueryClient is the client API for Query service.
@@ -38,6 +40,10 @@ type QueryClient interface {
 	//
 	// Since: cosmos-sdk 0.46
 	AllowancesByGranter(ctx context.Context, in *QueryAllowancesByGranterRequest, opts ...grpc.CallOption) (*QueryAllowancesByGranterResponse, error)
+	// SponsorshipPool returns a sponsorship pool by its address.
+	SponsorshipPool(ctx context.Context, in *QuerySponsorshipPoolRequest, opts ...grpc.CallOption) (*QuerySponsorshipPoolResponse, error)
+	// SponsorshipPoolUsage returns the fees paid by a sponsorship pool for a user.
+	SponsorshipPoolUsage(ctx context.Context, in *QuerySponsorshipPoolUsageRequest, opts ...grpc.CallOption) (*QuerySponsorshipPoolUsageResponse, error)
 }
 
 type queryClient struct {
@@ -75,6 +81,24 @@ func (c *queryClient) AllowancesByGranter(ctx context.Context, in *QueryAllowanc
 	return out, nil
 }
 
+func (c *queryClient) SponsorshipPool(ctx context.Context, in *QuerySponsorshipPoolRequest, opts ...grpc.CallOption) (*QuerySponsorshipPoolResponse, error) {
+	out := new(QuerySponsorshipPoolResponse)
+	err := c.cc.Invoke(ctx, Query_SponsorshipPool_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SponsorshipPoolUsage(ctx context.Context, in *QuerySponsorshipPoolUsageRequest, opts ...grpc.CallOption) (*QuerySponsorshipPoolUsageResponse, error) {
+	out := new(QuerySponsorshipPoolUsageResponse)
+	err := c.cc.Invoke(ctx, Query_SponsorshipPoolUsage_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -87,6 +111,10 @@ type QueryServer interface {
 	//
 	// Since: cosmos-sdk 0.46
 	AllowancesByGranter(context.Context, *QueryAllowancesByGranterRequest) (*QueryAllowancesByGranterResponse, error)
+	// SponsorshipPool returns a sponsorship pool by its address.
+	SponsorshipPool(context.Context, *QuerySponsorshipPoolRequest) (*QuerySponsorshipPoolResponse, error)
+	// SponsorshipPoolUsage returns the fees paid by a sponsorship pool for a user.
+	SponsorshipPoolUsage(context.Context, *QuerySponsorshipPoolUsageRequest) (*QuerySponsorshipPoolUsageResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -103,6 +131,12 @@ func (UnimplementedQueryServer) Allowances(context.Context, *QueryAllowancesRequ
 func (UnimplementedQueryServer) AllowancesByGranter(context.Context, *QueryAllowancesByGranterRequest) (*QueryAllowancesByGranterResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AllowancesByGranter not implemented")
 }
+func (UnimplementedQueryServer) SponsorshipPool(context.Context, *QuerySponsorshipPoolRequest) (*QuerySponsorshipPoolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SponsorshipPool not implemented")
+}
+func (UnimplementedQueryServer) SponsorshipPoolUsage(context.Context, *QuerySponsorshipPoolUsageRequest) (*QuerySponsorshipPoolUsageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SponsorshipPoolUsage not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -170,6 +204,42 @@ func _Query_AllowancesByGranter_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_SponsorshipPool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySponsorshipPoolRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SponsorshipPool(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_SponsorshipPool_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SponsorshipPool(ctx, req.(*QuerySponsorshipPoolRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SponsorshipPoolUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySponsorshipPoolUsageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SponsorshipPoolUsage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_SponsorshipPoolUsage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SponsorshipPoolUsage(ctx, req.(*QuerySponsorshipPoolUsageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -189,6 +259,14 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "AllowancesByGranter",
 			Handler:    _Query_AllowancesByGranter_Handler,
 		},
+		{
+			MethodName: "SponsorshipPool",
+			Handler:    _Query_SponsorshipPool_Handler,
+		},
+		{
+			MethodName: "SponsorshipPoolUsage",
+			Handler:    _Query_SponsorshipPoolUsage_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/feegrant/v1beta1/query.proto",
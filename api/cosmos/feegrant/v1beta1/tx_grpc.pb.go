@@ -21,9 +21,11 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Msg_GrantAllowance_FullMethodName  = "/cosmos.feegrant.v1beta1.Msg/GrantAllowance"
-	Msg_RevokeAllowance_FullMethodName = "/cosmos.feegrant.v1beta1.Msg/RevokeAllowance"
-	Msg_PruneAllowances_FullMethodName = "/cosmos.feegrant.v1beta1.Msg/PruneAllowances"
+	Msg_GrantAllowance_FullMethodName        = "/cosmos.feegrant.v1beta1.Msg/GrantAllowance"
+	Msg_RevokeAllowance_FullMethodName       = "/cosmos.feegrant.v1beta1.Msg/RevokeAllowance"
+	Msg_PruneAllowances_FullMethodName       = "/cosmos.feegrant.v1beta1.Msg/PruneAllowances"
+	Msg_CreateSponsorshipPool_FullMethodName = "/cosmos.feegrant.v1beta1.Msg/CreateSponsorshipPool"
+	Msg_CloseSponsorshipPool_FullMethodName  = "/cosmos.feegrant.v1beta1.Msg/CloseSponsorshipPool"
 )
 
 // MsgClient is the client API for Msg service.
@@ -40,6 +42,12 @@ type MsgClient interface {
 	//
 	// Since cosmos-sdk 0.50
 	PruneAllowances(ctx context.Context, in *MsgPruneAllowances, opts ...grpc.CallOption) (*MsgPruneAllowancesResponse, error)
+	// CreateSponsorshipPool creates a sponsorship pool funded by the admin, which
+	// pays the fees of the transactions of any signer matching its message filter.
+	CreateSponsorshipPool(ctx context.Context, in *MsgCreateSponsorshipPool, opts ...grpc.CallOption) (*MsgCreateSponsorshipPoolResponse, error)
+	// CloseSponsorshipPool closes a sponsorship pool, returning its remaining
+	// funds to the admin.
+	CloseSponsorshipPool(ctx context.Context, in *MsgCloseSponsorshipPool, opts ...grpc.CallOption) (*MsgCloseSponsorshipPoolResponse, error)
 }
 
 type msgClient struct {
@@ -77,6 +85,24 @@ func (c *msgClient) PruneAllowances(ctx context.Context, in *MsgPruneAllowances,
 	return out, nil
 }
 
+func (c *msgClient) CreateSponsorshipPool(ctx context.Context, in *MsgCreateSponsorshipPool, opts ...grpc.CallOption) (*MsgCreateSponsorshipPoolResponse, error) {
+	out := new(MsgCreateSponsorshipPoolResponse)
+	err := c.cc.Invoke(ctx, Msg_CreateSponsorshipPool_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CloseSponsorshipPool(ctx context.Context, in *MsgCloseSponsorshipPool, opts ...grpc.CallOption) (*MsgCloseSponsorshipPoolResponse, error) {
+	out := new(MsgCloseSponsorshipPoolResponse)
+	err := c.cc.Invoke(ctx, Msg_CloseSponsorshipPool_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
@@ -91,6 +117,12 @@ type MsgServer interface {
 	//
 	// Since cosmos-sdk 0.50
 	PruneAllowances(context.Context, *MsgPruneAllowances) (*MsgPruneAllowancesResponse, error)
+	// CreateSponsorshipPool creates a sponsorship pool funded by the admin, which
+	// pays the fees of the transactions of any signer matching its message filter.
+	CreateSponsorshipPool(context.Context, *MsgCreateSponsorshipPool) (*MsgCreateSponsorshipPoolResponse, error)
+	// CloseSponsorshipPool closes a sponsorship pool, returning its remaining
+	// funds to the admin.
+	CloseSponsorshipPool(context.Context, *MsgCloseSponsorshipPool) (*MsgCloseSponsorshipPoolResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -107,6 +139,12 @@ func (UnimplementedMsgServer) RevokeAllowance(context.Context, *MsgRevokeAllowan
 func (UnimplementedMsgServer) PruneAllowances(context.Context, *MsgPruneAllowances) (*MsgPruneAllowancesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PruneAllowances not implemented")
 }
+func (UnimplementedMsgServer) CreateSponsorshipPool(context.Context, *MsgCreateSponsorshipPool) (*MsgCreateSponsorshipPoolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSponsorshipPool not implemented")
+}
+func (UnimplementedMsgServer) CloseSponsorshipPool(context.Context, *MsgCloseSponsorshipPool) (*MsgCloseSponsorshipPoolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CloseSponsorshipPool not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -174,6 +212,42 @@ func _Msg_PruneAllowances_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_CreateSponsorshipPool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateSponsorshipPool)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateSponsorshipPool(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_CreateSponsorshipPool_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateSponsorshipPool(ctx, req.(*MsgCreateSponsorshipPool))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CloseSponsorshipPool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCloseSponsorshipPool)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CloseSponsorshipPool(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_CloseSponsorshipPool_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CloseSponsorshipPool(ctx, req.(*MsgCloseSponsorshipPool))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -193,6 +267,14 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "PruneAllowances",
 			Handler:    _Msg_PruneAllowances_Handler,
 		},
+		{
+			MethodName: "CreateSponsorshipPool",
+			Handler:    _Msg_CreateSponsorshipPool_Handler,
+		},
+		{
+			MethodName: "CloseSponsorshipPool",
+			Handler:    _Msg_CloseSponsorshipPool_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/feegrant/v1beta1/tx.proto",
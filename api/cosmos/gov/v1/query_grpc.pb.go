@@ -21,15 +21,16 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Query_Constitution_FullMethodName = "/cosmos.gov.v1.Query/Constitution"
-	Query_Proposal_FullMethodName     = "/cosmos.gov.v1.Query/Proposal"
-	Query_Proposals_FullMethodName    = "/cosmos.gov.v1.Query/Proposals"
-	Query_Vote_FullMethodName         = "/cosmos.gov.v1.Query/Vote"
-	Query_Votes_FullMethodName        = "/cosmos.gov.v1.Query/Votes"
-	Query_Params_FullMethodName       = "/cosmos.gov.v1.Query/Params"
-	Query_Deposit_FullMethodName      = "/cosmos.gov.v1.Query/Deposit"
-	Query_Deposits_FullMethodName     = "/cosmos.gov.v1.Query/Deposits"
-	Query_TallyResult_FullMethodName  = "/cosmos.gov.v1.Query/TallyResult"
+	Query_Constitution_FullMethodName       = "/cosmos.gov.v1.Query/Constitution"
+	Query_Proposal_FullMethodName           = "/cosmos.gov.v1.Query/Proposal"
+	Query_Proposals_FullMethodName          = "/cosmos.gov.v1.Query/Proposals"
+	Query_ScheduledProposals_FullMethodName = "/cosmos.gov.v1.Query/ScheduledProposals"
+	Query_Vote_FullMethodName               = "/cosmos.gov.v1.Query/Vote"
+	Query_Votes_FullMethodName              = "/cosmos.gov.v1.Query/Votes"
+	Query_Params_FullMethodName             = "/cosmos.gov.v1.Query/Params"
+	Query_Deposit_FullMethodName            = "/cosmos.gov.v1.Query/Deposit"
+	Query_Deposits_FullMethodName           = "/cosmos.gov.v1.Query/Deposits"
+	Query_TallyResult_FullMethodName        = "/cosmos.gov.v1.Query/TallyResult"
 )
 
 // QueryClient is the client API for Query service.
@@ -42,6 +43,9 @@ type QueryClient interface {
 	Proposal(ctx context.Context, in *QueryProposalRequest, opts ...grpc.CallOption) (*QueryProposalResponse, error)
 	// Proposals queries all proposals based on given status.
 	Proposals(ctx context.Context, in *QueryProposalsRequest, opts ...grpc.CallOption) (*QueryProposalsResponse, error)
+	// ScheduledProposals queries the passed proposals whose messages are queued
+	// for execution, by ascending proposal id.
+	ScheduledProposals(ctx context.Context, in *QueryScheduledProposalsRequest, opts ...grpc.CallOption) (*QueryScheduledProposalsResponse, error)
 	// Vote queries voted information based on proposalID, voterAddr.
 	Vote(ctx context.Context, in *QueryVoteRequest, opts ...grpc.CallOption) (*QueryVoteResponse, error)
 	// Votes queries votes of a given proposal.
@@ -91,6 +95,15 @@ func (c *queryClient) Proposals(ctx context.Context, in *QueryProposalsRequest,
 	return out, nil
 }
 
+func (c *queryClient) ScheduledProposals(ctx context.Context, in *QueryScheduledProposalsRequest, opts ...grpc.CallOption) (*QueryScheduledProposalsResponse, error) {
+	out := new(QueryScheduledProposalsResponse)
+	err := c.cc.Invoke(ctx, Query_ScheduledProposals_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) Vote(ctx context.Context, in *QueryVoteRequest, opts ...grpc.CallOption) (*QueryVoteResponse, error) {
 	out := new(QueryVoteResponse)
 	err := c.cc.Invoke(ctx, Query_Vote_FullMethodName, in, out, opts...)
@@ -155,6 +168,9 @@ type QueryServer interface {
 	Proposal(context.Context, *QueryProposalRequest) (*QueryProposalResponse, error)
 	// Proposals queries all proposals based on given status.
 	Proposals(context.Context, *QueryProposalsRequest) (*QueryProposalsResponse, error)
+	// ScheduledProposals queries the passed proposals whose messages are queued
+	// for execution, by ascending proposal id.
+	ScheduledProposals(context.Context, *QueryScheduledProposalsRequest) (*QueryScheduledProposalsResponse, error)
 	// Vote queries voted information based on proposalID, voterAddr.
 	Vote(context.Context, *QueryVoteRequest) (*QueryVoteResponse, error)
 	// Votes queries votes of a given proposal.
@@ -183,6 +199,9 @@ func (UnimplementedQueryServer) Proposal(context.Context, *QueryProposalRequest)
 func (UnimplementedQueryServer) Proposals(context.Context, *QueryProposalsRequest) (*QueryProposalsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Proposals not implemented")
 }
+func (UnimplementedQueryServer) ScheduledProposals(context.Context, *QueryScheduledProposalsRequest) (*QueryScheduledProposalsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduledProposals not implemented")
+}
 func (UnimplementedQueryServer) Vote(context.Context, *QueryVoteRequest) (*QueryVoteResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Vote not implemented")
 }
@@ -268,6 +287,24 @@ func _Query_Proposals_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ScheduledProposals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryScheduledProposalsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ScheduledProposals(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ScheduledProposals_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ScheduledProposals(ctx, req.(*QueryScheduledProposalsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_Vote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryVoteRequest)
 	if err := dec(in); err != nil {
@@ -395,6 +432,10 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Proposals",
 			Handler:    _Query_Proposals_Handler,
 		},
+		{
+			MethodName: "ScheduledProposals",
+			Handler:    _Query_ScheduledProposals_Handler,
+		},
 		{
 			MethodName: "Vote",
 			Handler:    _Query_Vote_Handler,
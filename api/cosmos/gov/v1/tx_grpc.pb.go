@@ -21,13 +21,14 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Msg_SubmitProposal_FullMethodName    = "/cosmos.gov.v1.Msg/SubmitProposal"
-	Msg_ExecLegacyContent_FullMethodName = "/cosmos.gov.v1.Msg/ExecLegacyContent"
-	Msg_Vote_FullMethodName              = "/cosmos.gov.v1.Msg/Vote"
-	Msg_VoteWeighted_FullMethodName      = "/cosmos.gov.v1.Msg/VoteWeighted"
-	Msg_Deposit_FullMethodName           = "/cosmos.gov.v1.Msg/Deposit"
-	Msg_UpdateParams_FullMethodName      = "/cosmos.gov.v1.Msg/UpdateParams"
-	Msg_CancelProposal_FullMethodName    = "/cosmos.gov.v1.Msg/CancelProposal"
+	Msg_SubmitProposal_FullMethodName           = "/cosmos.gov.v1.Msg/SubmitProposal"
+	Msg_ExecLegacyContent_FullMethodName        = "/cosmos.gov.v1.Msg/ExecLegacyContent"
+	Msg_Vote_FullMethodName                     = "/cosmos.gov.v1.Msg/Vote"
+	Msg_VoteWeighted_FullMethodName             = "/cosmos.gov.v1.Msg/VoteWeighted"
+	Msg_Deposit_FullMethodName                  = "/cosmos.gov.v1.Msg/Deposit"
+	Msg_UpdateParams_FullMethodName             = "/cosmos.gov.v1.Msg/UpdateParams"
+	Msg_CancelProposal_FullMethodName           = "/cosmos.gov.v1.Msg/CancelProposal"
+	Msg_CancelScheduledExecution_FullMethodName = "/cosmos.gov.v1.Msg/CancelScheduledExecution"
 )
 
 // MsgClient is the client API for Msg service.
@@ -54,6 +55,9 @@ type MsgClient interface {
 	//
 	// Since: cosmos-sdk 0.50
 	CancelProposal(ctx context.Context, in *MsgCancelProposal, opts ...grpc.CallOption) (*MsgCancelProposalResponse, error)
+	// CancelScheduledExecution defines a governance operation for canceling the
+	// execution of a scheduled proposal. The authority is defined in the keeper.
+	CancelScheduledExecution(ctx context.Context, in *MsgCancelScheduledExecution, opts ...grpc.CallOption) (*MsgCancelScheduledExecutionResponse, error)
 }
 
 type msgClient struct {
@@ -127,6 +131,15 @@ func (c *msgClient) CancelProposal(ctx context.Context, in *MsgCancelProposal, o
 	return out, nil
 }
 
+func (c *msgClient) CancelScheduledExecution(ctx context.Context, in *MsgCancelScheduledExecution, opts ...grpc.CallOption) (*MsgCancelScheduledExecutionResponse, error) {
+	out := new(MsgCancelScheduledExecutionResponse)
+	err := c.cc.Invoke(ctx, Msg_CancelScheduledExecution_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
@@ -151,6 +164,9 @@ type MsgServer interface {
 	//
 	// Since: cosmos-sdk 0.50
 	CancelProposal(context.Context, *MsgCancelProposal) (*MsgCancelProposalResponse, error)
+	// CancelScheduledExecution defines a governance operation for canceling the
+	// execution of a scheduled proposal. The authority is defined in the keeper.
+	CancelScheduledExecution(context.Context, *MsgCancelScheduledExecution) (*MsgCancelScheduledExecutionResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -179,6 +195,9 @@ func (UnimplementedMsgServer) UpdateParams(context.Context, *MsgUpdateParams) (*
 func (UnimplementedMsgServer) CancelProposal(context.Context, *MsgCancelProposal) (*MsgCancelProposalResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CancelProposal not implemented")
 }
+func (UnimplementedMsgServer) CancelScheduledExecution(context.Context, *MsgCancelScheduledExecution) (*MsgCancelScheduledExecutionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelScheduledExecution not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -318,6 +337,24 @@ func _Msg_CancelProposal_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_CancelScheduledExecution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelScheduledExecution)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelScheduledExecution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_CancelScheduledExecution_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelScheduledExecution(ctx, req.(*MsgCancelScheduledExecution))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -353,6 +390,10 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CancelProposal",
 			Handler:    _Msg_CancelProposal_Handler,
 		},
+		{
+			MethodName: "CancelScheduledExecution",
+			Handler:    _Msg_CancelScheduledExecution_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/gov/v1/tx.proto",
@@ -1,8 +1,20 @@
 package baseapp
 
-import "context"
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
 
 // CircuitBreaker is an interface that defines the methods for a circuit breaker.
 type CircuitBreaker interface {
 	IsAllowed(ctx context.Context, typeURL string) (bool, error)
 }
+
+// MsgCircuitBreaker is an optional interface of a circuit breaker checking the
+// messages themselves rather than their type URL. When implemented, the message
+// router checks every message it dispatches with IsMsgAllowed, including the
+// messages dispatched by other messages.
+type MsgCircuitBreaker interface {
+	IsMsgAllowed(ctx context.Context, msg sdk.Msg) (bool, error)
+}
@@ -104,7 +104,7 @@ func (msr *MsgServiceRouter) registerHybridHandler(sd *grpc.ServiceDesc, method
 	// decorate the hybrid handler with the circuit breaker
 	circuitBreakerHybridHandler := func(ctx context.Context, req, resp protoiface.MessageV1) error {
 		messageName := codectypes.MsgTypeURL(req)
-		allowed, err := msr.circuitBreaker.IsAllowed(ctx, messageName)
+		allowed, err := msr.isAllowed(ctx, req)
 		if err != nil {
 			return err
 		}
@@ -183,7 +183,7 @@ func (msr *MsgServiceRouter) registerMsgServiceHandler(sd *grpc.ServiceDesc, met
 
 		if msr.circuitBreaker != nil {
 			msgURL := sdk.MsgTypeURL(msg)
-			isAllowed, err := msr.circuitBreaker.IsAllowed(ctx, msgURL)
+			isAllowed, err := msr.isAllowed(ctx, msg)
 			if err != nil {
 				return nil, err
 			}
@@ -210,6 +210,16 @@ func (msr *MsgServiceRouter) registerMsgServiceHandler(sd *grpc.ServiceDesc, met
 	return nil
 }
 
+// isAllowed checks a message with the circuit breaker, by the message itself
+// if the circuit breaker supports it, or by its type URL otherwise.
+func (msr *MsgServiceRouter) isAllowed(ctx context.Context, msg sdk.Msg) (bool, error) {
+	if cb, ok := msr.circuitBreaker.(MsgCircuitBreaker); ok {
+		return cb.IsMsgAllowed(ctx, msg)
+	}
+
+	return msr.circuitBreaker.IsAllowed(ctx, sdk.MsgTypeURL(msg))
+}
+
 // SetInterfaceRegistry sets the interface registry for the router.
 func (msr *MsgServiceRouter) SetInterfaceRegistry(interfaceRegistry codectypes.InterfaceRegistry) {
 	msr.interfaceRegistry = interfaceRegistry
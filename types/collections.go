@@ -2,6 +2,7 @@ package types
 
 import (
 	"fmt"
+	"math/big"
 	"time"
 
 	"cosmossdk.io/collections"
@@ -32,6 +33,14 @@ var (
 	// IntValue represents a collections.ValueCodec to work with Int.
 	IntValue collcodec.ValueCodec[math.Int] = intValueCodec{}
 
+	// LegacyDecValue represents a collections.ValueCodec to work with LegacyDec.
+	LegacyDecValue collcodec.ValueCodec[math.LegacyDec] = legacyDecValueCodec{}
+
+	// IntKey represents a collections.KeyCodec to work with Int. Keys are
+	// encoded with a fixed size, preserving the numeric ordering of the values,
+	// so they can be used to iterate over Int values in order.
+	IntKey collcodec.KeyCodec[math.Int] = intKeyCodec{}
+
 	// TimeKey represents a collections.KeyCodec to work with time.Time
 	// Deprecated: exists only for state compatibility reasons, should not
 	// be used for new storage keys using time. Please use the time KeyCodec
@@ -166,6 +175,119 @@ func (i intValueCodec) ValueType() string {
 	return "math.Int"
 }
 
+type legacyDecValueCodec struct{}
+
+func (i legacyDecValueCodec) Encode(value math.LegacyDec) ([]byte, error) {
+	return value.Marshal()
+}
+
+func (i legacyDecValueCodec) Decode(b []byte) (math.LegacyDec, error) {
+	v := new(math.LegacyDec)
+	err := v.Unmarshal(b)
+	if err != nil {
+		return math.LegacyDec{}, err
+	}
+	return *v, nil
+}
+
+func (i legacyDecValueCodec) EncodeJSON(value math.LegacyDec) ([]byte, error) {
+	return value.MarshalJSON()
+}
+
+func (i legacyDecValueCodec) DecodeJSON(b []byte) (math.LegacyDec, error) {
+	v := new(math.LegacyDec)
+	err := v.UnmarshalJSON(b)
+	if err != nil {
+		return math.LegacyDec{}, err
+	}
+	return *v, nil
+}
+
+func (i legacyDecValueCodec) Stringify(value math.LegacyDec) string {
+	return value.String()
+}
+
+func (i legacyDecValueCodec) ValueType() string {
+	return "math.LegacyDec"
+}
+
+// intKeySize is the size of an encoded Int key: a sign byte followed by the
+// big endian absolute value, padded to math.MaxBitLen bits.
+const intKeySize = 1 + math.MaxBitLen/8
+
+type intKeyCodec struct{}
+
+func (i intKeyCodec) Encode(buffer []byte, key math.Int) (int, error) {
+	if len(buffer) < intKeySize {
+		return 0, fmt.Errorf("invalid Int buffer size, wanted: %d at least, got: %d", intKeySize, len(buffer))
+	}
+	if key.IsNil() {
+		return 0, fmt.Errorf("cannot encode nil Int key")
+	}
+	abs := key.BigInt()
+	if abs.BitLen() > math.MaxBitLen {
+		return 0, fmt.Errorf("integer key out of range; got: %d bits, max: %d", abs.BitLen(), math.MaxBitLen)
+	}
+	abs.Abs(abs).FillBytes(buffer[1:intKeySize])
+	if key.IsNegative() {
+		// negative values sort before positive ones, the greater the absolute
+		// value the smaller the key.
+		buffer[0] = 0
+		for j := 1; j < intKeySize; j++ {
+			buffer[j] = ^buffer[j]
+		}
+	} else {
+		buffer[0] = 1
+	}
+	return intKeySize, nil
+}
+
+func (i intKeyCodec) Decode(buffer []byte) (int, math.Int, error) {
+	if len(buffer) != intKeySize {
+		return 0, math.Int{}, fmt.Errorf("invalid Int buffer size, wanted: %d, got: %d", intKeySize, len(buffer))
+	}
+	abs := make([]byte, intKeySize-1)
+	copy(abs, buffer[1:])
+	switch buffer[0] {
+	case 1:
+		return intKeySize, math.NewIntFromBigInt(new(big.Int).SetBytes(abs)), nil
+	case 0:
+		for j := range abs {
+			abs[j] = ^abs[j]
+		}
+		return intKeySize, math.NewIntFromBigInt(new(big.Int).Neg(new(big.Int).SetBytes(abs))), nil
+	default:
+		return 0, math.Int{}, fmt.Errorf("invalid Int key sign byte: %d", buffer[0])
+	}
+}
+
+func (i intKeyCodec) Size(key math.Int) int { return intKeySize }
+
+func (i intKeyCodec) EncodeJSON(value math.Int) ([]byte, error) { return value.MarshalJSON() }
+
+func (i intKeyCodec) DecodeJSON(b []byte) (math.Int, error) {
+	v := new(math.Int)
+	err := v.UnmarshalJSON(b)
+	if err != nil {
+		return math.Int{}, err
+	}
+	return *v, nil
+}
+
+func (i intKeyCodec) Stringify(key math.Int) string { return key.String() }
+func (i intKeyCodec) KeyType() string               { return "math.Int" }
+func (i intKeyCodec) EncodeNonTerminal(buffer []byte, key math.Int) (int, error) {
+	return i.Encode(buffer, key)
+}
+
+func (i intKeyCodec) DecodeNonTerminal(buffer []byte) (int, math.Int, error) {
+	if len(buffer) < intKeySize {
+		return 0, math.Int{}, fmt.Errorf("invalid Int buffer size, wanted: %d at least, got: %d", intKeySize, len(buffer))
+	}
+	return i.Decode(buffer[:intKeySize])
+}
+func (i intKeyCodec) SizeNonTerminal(key math.Int) int { return i.Size(key) }
+
 type timeKeyCodec struct{}
 
 func (timeKeyCodec) Encode(buffer []byte, key time.Time) (int, error) {
@@ -1,10 +1,15 @@
 package types
 
 import (
+	"math/big"
+	"sort"
 	"testing"
 	"time"
 
+	"github.com/stretchr/testify/require"
+
 	"cosmossdk.io/collections/colltest"
+	"cosmossdk.io/math"
 )
 
 func TestCollectionsCorrectness(t *testing.T) {
@@ -27,4 +32,44 @@ func TestCollectionsCorrectness(t *testing.T) {
 	t.Run("Time", func(t *testing.T) {
 		colltest.TestKeyCodec(t, TimeKey, time.Time{})
 	})
+
+	t.Run("Int", func(t *testing.T) {
+		colltest.TestKeyCodec(t, IntKey, math.NewInt(-1_000_000))
+		colltest.TestKeyCodec(t, IntKey, math.NewInt(0))
+		colltest.TestKeyCodec(t, IntKey, math.NewIntFromUint64(1<<63))
+	})
+
+	t.Run("LegacyDec", func(t *testing.T) {
+		colltest.TestValueCodec(t, LegacyDecValue, math.LegacyNewDecWithPrec(-15, 3))
+		colltest.TestValueCodec(t, LegacyDecValue, math.LegacyZeroDec())
+	})
+}
+
+func TestIntKeyOrdering(t *testing.T) {
+	maxInt := math.NewIntFromBigInt(new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), math.MaxBitLen), big.NewInt(1)))
+	values := []math.Int{
+		maxInt.Neg(),
+		math.NewInt(-256),
+		math.NewInt(-255),
+		math.NewInt(-1),
+		math.ZeroInt(),
+		math.NewInt(1),
+		math.NewInt(255),
+		math.NewInt(256),
+		maxInt,
+	}
+
+	keys := make([][]byte, len(values))
+	for i, v := range values {
+		keys[i] = make([]byte, IntKey.Size(v))
+		_, err := IntKey.Encode(keys[i], v)
+		require.NoError(t, err)
+
+		_, decoded, err := IntKey.Decode(keys[i])
+		require.NoError(t, err)
+		require.True(t, v.Equal(decoded), "expected %s, got %s", v, decoded)
+	}
+	require.True(t, sort.SliceIsSorted(keys, func(i, j int) bool {
+		return string(keys[i]) < string(keys[j])
+	}))
 }
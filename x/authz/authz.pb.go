@@ -30,6 +30,43 @@ var _ = time.Kitchen
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// FieldFilterType defines the type of constraint of a FieldFilter.
+type FieldFilterType int32
+
+const (
+	// FIELD_FILTER_TYPE_UNSPECIFIED defines an invalid constraint type.
+	FIELD_FILTER_TYPE_UNSPECIFIED FieldFilterType = 0
+	// FIELD_FILTER_TYPE_EXACT requires the field to be equal to the single value.
+	FIELD_FILTER_TYPE_EXACT FieldFilterType = 1
+	// FIELD_FILTER_TYPE_ALLOWLIST requires the field to be equal to one of the values.
+	FIELD_FILTER_TYPE_ALLOWLIST FieldFilterType = 2
+	// FIELD_FILTER_TYPE_MAX requires the field to be a number at most equal to
+	// the single value.
+	FIELD_FILTER_TYPE_MAX FieldFilterType = 3
+)
+
+var FieldFilterType_name = map[int32]string{
+	0: "FIELD_FILTER_TYPE_UNSPECIFIED",
+	1: "FIELD_FILTER_TYPE_EXACT",
+	2: "FIELD_FILTER_TYPE_ALLOWLIST",
+	3: "FIELD_FILTER_TYPE_MAX",
+}
+
+var FieldFilterType_value = map[string]int32{
+	"FIELD_FILTER_TYPE_UNSPECIFIED": 0,
+	"FIELD_FILTER_TYPE_EXACT":       1,
+	"FIELD_FILTER_TYPE_ALLOWLIST":   2,
+	"FIELD_FILTER_TYPE_MAX":         3,
+}
+
+func (x FieldFilterType) String() string {
+	return proto.EnumName(FieldFilterType_name, int32(x))
+}
+
+func (FieldFilterType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_544dc2e84b61c637, []int{0}
+}
+
 // GenericAuthorization gives the grantee unrestricted permissions to execute
 // the provided method on behalf of the granter's account.
 type GenericAuthorization struct {
@@ -70,6 +107,140 @@ func (m *GenericAuthorization) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_GenericAuthorization proto.InternalMessageInfo
 
+// MaxUseAuthorization gives the grantee permissions to execute the provided
+// method on behalf of the granter's account a limited number of times.
+type MaxUseAuthorization struct {
+	// Msg, identified by it's type URL, to grant permissions to execute
+	Msg string `protobuf:"bytes,1,opt,name=msg,proto3" json:"msg,omitempty"`
+	// remaining_uses is the number of times the grantee can still execute the
+	// msg, the grant is deleted once it is used up.
+	RemainingUses uint64 `protobuf:"varint,2,opt,name=remaining_uses,json=remainingUses,proto3" json:"remaining_uses,omitempty"`
+}
+
+func (m *MaxUseAuthorization) Reset()         { *m = MaxUseAuthorization{} }
+func (m *MaxUseAuthorization) String() string { return proto.CompactTextString(m) }
+func (*MaxUseAuthorization) ProtoMessage()    {}
+func (*MaxUseAuthorization) Descriptor() ([]byte, []int) {
+	return fileDescriptor_544dc2e84b61c637, []int{1}
+}
+func (m *MaxUseAuthorization) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MaxUseAuthorization) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MaxUseAuthorization.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MaxUseAuthorization) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MaxUseAuthorization.Merge(m, src)
+}
+func (m *MaxUseAuthorization) XXX_Size() int {
+	return m.Size()
+}
+func (m *MaxUseAuthorization) XXX_DiscardUnknown() {
+	xxx_messageInfo_MaxUseAuthorization.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MaxUseAuthorization proto.InternalMessageInfo
+
+// FilteredAuthorization gives the grantee permissions to execute the provided
+// method on behalf of the granter's account, as long as the msg satisfies all
+// of the field filters.
+type FilteredAuthorization struct {
+	// Msg, identified by it's type URL, to grant permissions to execute
+	Msg string `protobuf:"bytes,1,opt,name=msg,proto3" json:"msg,omitempty"`
+	// filters are the constraints on the fields of the msg, all of which must be
+	// satisfied for the msg to be accepted.
+	Filters []FieldFilter `protobuf:"bytes,2,rep,name=filters,proto3" json:"filters"`
+}
+
+func (m *FilteredAuthorization) Reset()         { *m = FilteredAuthorization{} }
+func (m *FilteredAuthorization) String() string { return proto.CompactTextString(m) }
+func (*FilteredAuthorization) ProtoMessage()    {}
+func (*FilteredAuthorization) Descriptor() ([]byte, []int) {
+	return fileDescriptor_544dc2e84b61c637, []int{2}
+}
+func (m *FilteredAuthorization) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FilteredAuthorization) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FilteredAuthorization.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FilteredAuthorization) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FilteredAuthorization.Merge(m, src)
+}
+func (m *FilteredAuthorization) XXX_Size() int {
+	return m.Size()
+}
+func (m *FilteredAuthorization) XXX_DiscardUnknown() {
+	xxx_messageInfo_FilteredAuthorization.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FilteredAuthorization proto.InternalMessageInfo
+
+// FieldFilter constrains the value of a field of a msg.
+type FieldFilter struct {
+	// path is the dot separated path of the field in the msg, each element being
+	// the JSON or proto name of a field, e.g. "amount.denom". When the path goes
+	// through a repeated field, the constraint applies to every element.
+	Path string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	// type is the type of the constraint.
+	Type FieldFilterType `protobuf:"varint,2,opt,name=type,proto3,enum=cosmos.authz.v1beta1.FieldFilterType" json:"type,omitempty"`
+	// values are the values of the constraint: the expected value for an exact
+	// match, the allowed values for an allowlist, and the maximum value for a
+	// numeric cap.
+	Values []string `protobuf:"bytes,3,rep,name=values,proto3" json:"values,omitempty"`
+}
+
+func (m *FieldFilter) Reset()         { *m = FieldFilter{} }
+func (m *FieldFilter) String() string { return proto.CompactTextString(m) }
+func (*FieldFilter) ProtoMessage()    {}
+func (*FieldFilter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_544dc2e84b61c637, []int{3}
+}
+func (m *FieldFilter) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FieldFilter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FieldFilter.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FieldFilter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FieldFilter.Merge(m, src)
+}
+func (m *FieldFilter) XXX_Size() int {
+	return m.Size()
+}
+func (m *FieldFilter) XXX_DiscardUnknown() {
+	xxx_messageInfo_FieldFilter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FieldFilter proto.InternalMessageInfo
+
 // Grant gives permissions to execute
 // the provide method with expiration time.
 type Grant struct {
@@ -78,13 +249,16 @@ type Grant struct {
 	// doesn't have a time expiration (other conditions  in `authorization`
 	// may apply to invalidate the grant)
 	Expiration *time.Time `protobuf:"bytes,2,opt,name=expiration,proto3,stdtime" json:"expiration,omitempty"`
+	// time when the grant was last used to execute a msg. If null, then the
+	// grant was never used.
+	LastUsed *time.Time `protobuf:"bytes,3,opt,name=last_used,json=lastUsed,proto3,stdtime" json:"last_used,omitempty"`
 }
 
 func (m *Grant) Reset()         { *m = Grant{} }
 func (m *Grant) String() string { return proto.CompactTextString(m) }
 func (*Grant) ProtoMessage()    {}
 func (*Grant) Descriptor() ([]byte, []int) {
-	return fileDescriptor_544dc2e84b61c637, []int{1}
+	return fileDescriptor_544dc2e84b61c637, []int{4}
 }
 func (m *Grant) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -120,13 +294,14 @@ type GrantAuthorization struct {
 	Grantee       string     `protobuf:"bytes,2,opt,name=grantee,proto3" json:"grantee,omitempty"`
 	Authorization *types.Any `protobuf:"bytes,3,opt,name=authorization,proto3" json:"authorization,omitempty"`
 	Expiration    *time.Time `protobuf:"bytes,4,opt,name=expiration,proto3,stdtime" json:"expiration,omitempty"`
+	LastUsed      *time.Time `protobuf:"bytes,5,opt,name=last_used,json=lastUsed,proto3,stdtime" json:"last_used,omitempty"`
 }
 
 func (m *GrantAuthorization) Reset()         { *m = GrantAuthorization{} }
 func (m *GrantAuthorization) String() string { return proto.CompactTextString(m) }
 func (*GrantAuthorization) ProtoMessage()    {}
 func (*GrantAuthorization) Descriptor() ([]byte, []int) {
-	return fileDescriptor_544dc2e84b61c637, []int{2}
+	return fileDescriptor_544dc2e84b61c637, []int{5}
 }
 func (m *GrantAuthorization) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -165,7 +340,7 @@ func (m *GrantQueueItem) Reset()         { *m = GrantQueueItem{} }
 func (m *GrantQueueItem) String() string { return proto.CompactTextString(m) }
 func (*GrantQueueItem) ProtoMessage()    {}
 func (*GrantQueueItem) Descriptor() ([]byte, []int) {
-	return fileDescriptor_544dc2e84b61c637, []int{3}
+	return fileDescriptor_544dc2e84b61c637, []int{6}
 }
 func (m *GrantQueueItem) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -195,7 +370,11 @@ func (m *GrantQueueItem) XXX_DiscardUnknown() {
 var xxx_messageInfo_GrantQueueItem proto.InternalMessageInfo
 
 func init() {
+	proto.RegisterEnum("cosmos.authz.v1beta1.FieldFilterType", FieldFilterType_name, FieldFilterType_value)
 	proto.RegisterType((*GenericAuthorization)(nil), "cosmos.authz.v1beta1.GenericAuthorization")
+	proto.RegisterType((*MaxUseAuthorization)(nil), "cosmos.authz.v1beta1.MaxUseAuthorization")
+	proto.RegisterType((*FilteredAuthorization)(nil), "cosmos.authz.v1beta1.FilteredAuthorization")
+	proto.RegisterType((*FieldFilter)(nil), "cosmos.authz.v1beta1.FieldFilter")
 	proto.RegisterType((*Grant)(nil), "cosmos.authz.v1beta1.Grant")
 	proto.RegisterType((*GrantAuthorization)(nil), "cosmos.authz.v1beta1.GrantAuthorization")
 	proto.RegisterType((*GrantQueueItem)(nil), "cosmos.authz.v1beta1.GrantQueueItem")
@@ -204,35 +383,52 @@ func init() {
 func init() { proto.RegisterFile("cosmos/authz/v1beta1/authz.proto", fileDescriptor_544dc2e84b61c637) }
 
 var fileDescriptor_544dc2e84b61c637 = []byte{
-	// 446 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x93, 0x3f, 0x8f, 0xd3, 0x30,
-	0x18, 0xc6, 0xe3, 0xeb, 0xf1, 0xe7, 0x7c, 0x3a, 0x04, 0x51, 0x86, 0xd2, 0x21, 0xa9, 0x22, 0x84,
-	0x4e, 0x48, 0x8d, 0x75, 0x07, 0x13, 0x13, 0x8d, 0x90, 0x4e, 0xb0, 0x11, 0x8e, 0x85, 0xa5, 0x72,
-	0x5a, 0xe3, 0x5a, 0xd4, 0x71, 0x64, 0x3b, 0xe8, 0x72, 0x1f, 0x81, 0xe9, 0x3e, 0x03, 0x9f, 0x00,
-	0xa4, 0x7e, 0x88, 0x8a, 0xa9, 0x62, 0x62, 0xe2, 0x4f, 0x3b, 0xf0, 0x35, 0x50, 0xed, 0x44, 0x34,
-	0xb4, 0x12, 0x1d, 0x58, 0x22, 0xdb, 0xef, 0xf3, 0xbc, 0xef, 0x93, 0x5f, 0x62, 0xd8, 0x1d, 0x0a,
-	0xc5, 0x85, 0x42, 0xb8, 0xd0, 0xe3, 0x4b, 0xf4, 0xee, 0x24, 0x25, 0x1a, 0x9f, 0xd8, 0x5d, 0x94,
-	0x4b, 0xa1, 0x85, 0xeb, 0x59, 0x45, 0x64, 0xcf, 0x2a, 0x45, 0xe7, 0x0e, 0xe6, 0x2c, 0x13, 0xc8,
-	0x3c, 0xad, 0xb0, 0x73, 0xd7, 0x0a, 0x07, 0x66, 0x87, 0x2a, 0x97, 0x2d, 0x05, 0x54, 0x08, 0x3a,
-	0x21, 0xc8, 0xec, 0xd2, 0xe2, 0x0d, 0xd2, 0x8c, 0x13, 0xa5, 0x31, 0xcf, 0x2b, 0x81, 0x47, 0x05,
-	0x15, 0xd6, 0xb8, 0x5a, 0xd5, 0x1d, 0xff, 0xb6, 0xe1, 0xac, 0xb4, 0xa5, 0x50, 0x43, 0xef, 0x8c,
-	0x64, 0x44, 0xb2, 0x61, 0xbf, 0xd0, 0x63, 0x21, 0xd9, 0x25, 0xd6, 0x4c, 0x64, 0xee, 0x6d, 0xd8,
-	0xe2, 0x8a, 0xb6, 0x41, 0x17, 0x1c, 0x1f, 0x24, 0xab, 0xe5, 0xe3, 0xe7, 0x9f, 0xa7, 0xbd, 0x70,
-	0xdb, 0x3b, 0x44, 0x0d, 0xe7, 0xfb, 0x5f, 0x1f, 0x1f, 0x04, 0x56, 0xd6, 0x53, 0xa3, 0xb7, 0x68,
-	0x5b, 0xf7, 0xf0, 0x13, 0x80, 0xd7, 0xce, 0x24, 0xce, 0xb4, 0x9b, 0xc2, 0x23, 0xbc, 0x5e, 0x32,
-	0x13, 0x0f, 0x4f, 0xbd, 0xc8, 0x46, 0x8e, 0xea, 0xc8, 0x51, 0x3f, 0x2b, 0xe3, 0xfb, 0xbb, 0x45,
-	0x48, 0x9a, 0x2d, 0xdd, 0xa7, 0x10, 0x92, 0x8b, 0x9c, 0x49, 0x3b, 0x60, 0xcf, 0x0c, 0xe8, 0x6c,
-	0x0c, 0x38, 0xaf, 0x51, 0xc6, 0x37, 0x67, 0xdf, 0x02, 0x70, 0xf5, 0x3d, 0x00, 0xc9, 0x9a, 0x2f,
-	0xfc, 0xb0, 0x07, 0x5d, 0x93, 0xb9, 0x09, 0xea, 0x14, 0xde, 0xa0, 0xab, 0x53, 0x22, 0x2d, 0xac,
-	0xb8, 0xfd, 0x65, 0xda, 0xab, 0xbf, 0x75, 0x7f, 0x34, 0x92, 0x44, 0xa9, 0x97, 0x5a, 0xb2, 0x8c,
-	0x26, 0xb5, 0xf0, 0x8f, 0x87, 0x98, 0x34, 0x3b, 0x78, 0xc8, 0x26, 0xa8, 0xd6, 0xff, 0x07, 0xf5,
-	0xa4, 0x01, 0x6a, 0xff, 0x9f, 0xa0, 0xf6, 0x37, 0x20, 0x3d, 0x82, 0xb7, 0x0c, 0xa3, 0x17, 0x05,
-	0x29, 0xc8, 0x33, 0x4d, 0xb8, 0x1b, 0xc2, 0x23, 0xae, 0xe8, 0x40, 0x97, 0x39, 0x19, 0x14, 0x72,
-	0xa2, 0xda, 0xa0, 0xdb, 0x3a, 0x3e, 0x48, 0x0e, 0xb9, 0xa2, 0xe7, 0x65, 0x4e, 0x5e, 0xc9, 0x89,
-	0x8a, 0xe3, 0xd9, 0x4f, 0xdf, 0x99, 0x2d, 0x7c, 0x30, 0x5f, 0xf8, 0xe0, 0xc7, 0xc2, 0x07, 0x57,
-	0x4b, 0xdf, 0x99, 0x2f, 0x7d, 0xe7, 0xeb, 0xd2, 0x77, 0x5e, 0xdf, 0xa3, 0x4c, 0x8f, 0x8b, 0x34,
-	0x1a, 0x0a, 0x5e, 0xdd, 0x06, 0xb4, 0xf6, 0x7f, 0x5d, 0xd8, 0x4b, 0x96, 0x5e, 0x37, 0xf9, 0x1e,
-	0xfe, 0x0e, 0x00, 0x00, 0xff, 0xff, 0x50, 0x89, 0xaf, 0x02, 0x89, 0x03, 0x00, 0x00,
+	// 720 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0xcd, 0x6e, 0xda, 0x4a,
+	0x18, 0x65, 0x80, 0xfc, 0x30, 0x28, 0xb9, 0xdc, 0xb9, 0xe4, 0x5e, 0x42, 0x74, 0x0d, 0xb1, 0x9a,
+	0x0a, 0x45, 0x8a, 0xad, 0xd0, 0x6e, 0x1a, 0xa9, 0x52, 0x21, 0x81, 0x88, 0x96, 0xb4, 0xa9, 0x03,
+	0x6a, 0xda, 0x8d, 0x65, 0xe2, 0x89, 0xb1, 0xea, 0x1f, 0xe4, 0x19, 0x47, 0x21, 0x4f, 0x50, 0x75,
+	0x15, 0xa9, 0xab, 0xae, 0xbb, 0xe9, 0x32, 0x8b, 0xac, 0xbb, 0x46, 0x5d, 0x45, 0x5d, 0x75, 0x95,
+	0x36, 0xc9, 0x22, 0x2f, 0xd0, 0x07, 0xa8, 0xec, 0x81, 0xc6, 0x04, 0x24, 0xa8, 0xd4, 0x0d, 0x9a,
+	0x6f, 0xe6, 0x9c, 0xef, 0x3b, 0x73, 0x38, 0xb6, 0x61, 0x76, 0xcf, 0x26, 0xa6, 0x4d, 0x44, 0xc5,
+	0xa5, 0xcd, 0x23, 0xf1, 0x60, 0xb5, 0x81, 0xa9, 0xb2, 0xca, 0x2a, 0xa1, 0xe5, 0xd8, 0xd4, 0x46,
+	0x49, 0x86, 0x10, 0xd8, 0x5e, 0x17, 0x91, 0xfe, 0x5b, 0x31, 0x75, 0xcb, 0x16, 0xfd, 0x5f, 0x06,
+	0x4c, 0xcf, 0x33, 0xa0, 0xec, 0x57, 0x62, 0x97, 0xc5, 0x8e, 0x32, 0x9a, 0x6d, 0x6b, 0x06, 0x16,
+	0xfd, 0xaa, 0xe1, 0xee, 0x8b, 0x54, 0x37, 0x31, 0xa1, 0x8a, 0xd9, 0xea, 0x02, 0x92, 0x9a, 0xad,
+	0xd9, 0x8c, 0xe8, 0xad, 0x7a, 0x1d, 0x6f, 0xd3, 0x14, 0xab, 0xcd, 0x8e, 0x78, 0x0a, 0x93, 0x9b,
+	0xd8, 0xc2, 0x8e, 0xbe, 0x57, 0x70, 0x69, 0xd3, 0x76, 0xf4, 0x23, 0x85, 0xea, 0xb6, 0x85, 0x12,
+	0x30, 0x62, 0x12, 0x2d, 0x05, 0xb2, 0x20, 0x17, 0x93, 0xbc, 0xe5, 0xda, 0xe3, 0xcf, 0xa7, 0x2b,
+	0xfc, 0xb0, 0x3b, 0x08, 0x7d, 0xcc, 0xb7, 0xd7, 0x27, 0xcb, 0x19, 0x06, 0x5b, 0x21, 0xea, 0x6b,
+	0x71, 0x58, 0x77, 0xfe, 0x3d, 0x80, 0xff, 0x6c, 0x29, 0x87, 0x75, 0x82, 0x47, 0x4c, 0x45, 0x4b,
+	0x70, 0xd6, 0xc1, 0xa6, 0xa2, 0x5b, 0xba, 0xa5, 0xc9, 0x2e, 0xc1, 0x24, 0x15, 0xce, 0x82, 0x5c,
+	0x54, 0x9a, 0xf9, 0xb5, 0x5b, 0x27, 0x98, 0xac, 0x55, 0xc6, 0x17, 0xc7, 0x05, 0xc4, 0x0d, 0xd1,
+	0xc0, 0x7f, 0x02, 0x70, 0xae, 0xac, 0x1b, 0x14, 0x3b, 0x58, 0x1d, 0xa5, 0xae, 0x0c, 0xa7, 0xf6,
+	0x7d, 0xa8, 0x27, 0x2b, 0x92, 0x8b, 0xe7, 0x17, 0x85, 0xa1, 0x22, 0xca, 0x3a, 0x36, 0x54, 0xd6,
+	0xb4, 0x18, 0xeb, 0x9c, 0x67, 0x42, 0x1f, 0xaf, 0x4f, 0x96, 0x81, 0xd4, 0x23, 0xaf, 0x3d, 0x19,
+	0x5f, 0x7e, 0x36, 0x20, 0x7f, 0xa8, 0x4c, 0x9e, 0xc2, 0x78, 0x60, 0x1e, 0x42, 0x30, 0xda, 0x52,
+	0x68, 0xb3, 0x2b, 0xdb, 0x5f, 0xa3, 0x07, 0x30, 0x4a, 0xdb, 0x2d, 0xec, 0x7b, 0x39, 0x9b, 0x5f,
+	0x1a, 0x29, 0xba, 0xd6, 0x6e, 0x61, 0xc9, 0xa7, 0xa0, 0x7f, 0xe1, 0xe4, 0x81, 0x62, 0xb8, 0x98,
+	0xa4, 0x22, 0xd9, 0x48, 0x2e, 0x26, 0x75, 0x2b, 0xfe, 0x07, 0x80, 0x13, 0x9b, 0x8e, 0x62, 0x51,
+	0xd4, 0x80, 0x33, 0x4a, 0x50, 0x90, 0x3f, 0x39, 0x9e, 0x4f, 0x0a, 0x2c, 0x85, 0x42, 0x2f, 0x85,
+	0x42, 0xc1, 0x6a, 0x17, 0xef, 0x8e, 0x77, 0x73, 0xa9, 0xbf, 0x25, 0xda, 0x80, 0x10, 0x1f, 0xb6,
+	0x74, 0x87, 0x0d, 0x08, 0xfb, 0x03, 0xd2, 0x03, 0x03, 0x6a, 0xbd, 0xa7, 0xa3, 0x38, 0xdd, 0x39,
+	0xcf, 0x80, 0xe3, 0x6f, 0x19, 0x20, 0x05, 0x78, 0xa8, 0x00, 0x63, 0x86, 0x42, 0xa8, 0x97, 0x2b,
+	0x35, 0x15, 0xf9, 0x8d, 0x26, 0xd3, 0x1e, 0xad, 0x4e, 0xb0, 0xca, 0x5f, 0x84, 0x21, 0xf2, 0xaf,
+	0xdd, 0x1f, 0x95, 0x3c, 0x9c, 0xd2, 0xbc, 0x5d, 0xec, 0x30, 0xdf, 0x8b, 0xa9, 0x2f, 0xa7, 0x2b,
+	0xbd, 0x37, 0x40, 0x41, 0x55, 0x1d, 0x4c, 0xc8, 0x0e, 0x75, 0x74, 0x4b, 0x93, 0x7a, 0xc0, 0x1b,
+	0x0e, 0xfb, 0x5f, 0xc6, 0xe0, 0xe0, 0x41, 0xaf, 0x23, 0x7f, 0xde, 0xeb, 0x47, 0x7d, 0x5e, 0x47,
+	0x47, 0xda, 0x14, 0x1d, 0xf0, 0xf9, 0x61, 0xd0, 0xe7, 0x89, 0x31, 0x1b, 0xdc, 0x78, 0x7c, 0x1f,
+	0xce, 0xfa, 0x16, 0x3f, 0x77, 0xb1, 0x8b, 0x2b, 0x14, 0x9b, 0x88, 0x87, 0x33, 0x26, 0xd1, 0x64,
+	0x2f, 0x90, 0xb2, 0xeb, 0x18, 0x24, 0x05, 0xfc, 0x2c, 0xc6, 0x4d, 0xa2, 0x79, 0x51, 0xad, 0x3b,
+	0x06, 0x59, 0x7e, 0x07, 0xe0, 0x5f, 0xb7, 0x22, 0x8c, 0x16, 0xe1, 0xff, 0xe5, 0x4a, 0xa9, 0xba,
+	0x21, 0x97, 0x2b, 0xd5, 0x5a, 0x49, 0x92, 0x6b, 0x2f, 0xb7, 0x4b, 0x72, 0xfd, 0xe9, 0xce, 0x76,
+	0x69, 0xbd, 0x52, 0xae, 0x94, 0x36, 0x12, 0x21, 0xb4, 0x00, 0xff, 0x1b, 0x84, 0x94, 0x76, 0x0b,
+	0xeb, 0xb5, 0x04, 0x40, 0x19, 0xb8, 0x30, 0x78, 0x58, 0xa8, 0x56, 0x9f, 0xbd, 0xa8, 0x56, 0x76,
+	0x6a, 0x89, 0x30, 0x9a, 0x87, 0x73, 0x83, 0x80, 0xad, 0xc2, 0x6e, 0x22, 0x92, 0x8e, 0xbe, 0xf9,
+	0xc0, 0x85, 0x8a, 0xc5, 0xce, 0x05, 0x17, 0xea, 0x5c, 0x72, 0xe0, 0xec, 0x92, 0x03, 0xdf, 0x2f,
+	0x39, 0x70, 0x7c, 0xc5, 0x85, 0xce, 0xae, 0xb8, 0xd0, 0xd7, 0x2b, 0x2e, 0xf4, 0xea, 0x8e, 0xa6,
+	0xd3, 0xa6, 0xdb, 0x10, 0xf6, 0x6c, 0xb3, 0xfb, 0xe2, 0x17, 0x03, 0x8f, 0xfb, 0x21, 0xfb, 0x9e,
+	0x34, 0x26, 0x7d, 0xcf, 0xee, 0xfd, 0x1c, 0x00, 0xbc, 0x27, 0xd1, 0xe6, 0x74, 0x06, 0x00, 0x00,
 }
 
 func (m *GenericAuthorization) Marshal() (dAtA []byte, err error) {
@@ -265,6 +461,129 @@ func (m *GenericAuthorization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MaxUseAuthorization) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MaxUseAuthorization) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MaxUseAuthorization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RemainingUses != 0 {
+		i = encodeVarintAuthz(dAtA, i, uint64(m.RemainingUses))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Msg) > 0 {
+		i -= len(m.Msg)
+		copy(dAtA[i:], m.Msg)
+		i = encodeVarintAuthz(dAtA, i, uint64(len(m.Msg)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FilteredAuthorization) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FilteredAuthorization) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FilteredAuthorization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Filters) > 0 {
+		for iNdEx := len(m.Filters) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Filters[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuthz(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Msg) > 0 {
+		i -= len(m.Msg)
+		copy(dAtA[i:], m.Msg)
+		i = encodeVarintAuthz(dAtA, i, uint64(len(m.Msg)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FieldFilter) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FieldFilter) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FieldFilter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Values) > 0 {
+		for iNdEx := len(m.Values) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Values[iNdEx])
+			copy(dAtA[i:], m.Values[iNdEx])
+			i = encodeVarintAuthz(dAtA, i, uint64(len(m.Values[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Type != 0 {
+		i = encodeVarintAuthz(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Path) > 0 {
+		i -= len(m.Path)
+		copy(dAtA[i:], m.Path)
+		i = encodeVarintAuthz(dAtA, i, uint64(len(m.Path)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Grant) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -285,14 +604,24 @@ func (m *Grant) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	if m.Expiration != nil {
-		n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.Expiration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Expiration):])
+	if m.LastUsed != nil {
+		n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.LastUsed, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.LastUsed):])
 		if err1 != nil {
 			return 0, err1
 		}
 		i -= n1
 		i = encodeVarintAuthz(dAtA, i, uint64(n1))
 		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Expiration != nil {
+		n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.Expiration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Expiration):])
+		if err2 != nil {
+			return 0, err2
+		}
+		i -= n2
+		i = encodeVarintAuthz(dAtA, i, uint64(n2))
+		i--
 		dAtA[i] = 0x12
 	}
 	if m.Authorization != nil {
@@ -330,13 +659,23 @@ func (m *GrantAuthorization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.LastUsed != nil {
+		n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.LastUsed, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.LastUsed):])
+		if err4 != nil {
+			return 0, err4
+		}
+		i -= n4
+		i = encodeVarintAuthz(dAtA, i, uint64(n4))
+		i--
+		dAtA[i] = 0x2a
+	}
 	if m.Expiration != nil {
-		n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.Expiration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Expiration):])
-		if err3 != nil {
-			return 0, err3
+		n5, err5 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.Expiration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Expiration):])
+		if err5 != nil {
+			return 0, err5
 		}
-		i -= n3
-		i = encodeVarintAuthz(dAtA, i, uint64(n3))
+		i -= n5
+		i = encodeVarintAuthz(dAtA, i, uint64(n5))
 		i--
 		dAtA[i] = 0x22
 	}
@@ -425,6 +764,63 @@ func (m *GenericAuthorization) Size() (n int) {
 	return n
 }
 
+func (m *MaxUseAuthorization) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Msg)
+	if l > 0 {
+		n += 1 + l + sovAuthz(uint64(l))
+	}
+	if m.RemainingUses != 0 {
+		n += 1 + sovAuthz(uint64(m.RemainingUses))
+	}
+	return n
+}
+
+func (m *FilteredAuthorization) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Msg)
+	if l > 0 {
+		n += 1 + l + sovAuthz(uint64(l))
+	}
+	if len(m.Filters) > 0 {
+		for _, e := range m.Filters {
+			l = e.Size()
+			n += 1 + l + sovAuthz(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *FieldFilter) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Path)
+	if l > 0 {
+		n += 1 + l + sovAuthz(uint64(l))
+	}
+	if m.Type != 0 {
+		n += 1 + sovAuthz(uint64(m.Type))
+	}
+	if len(m.Values) > 0 {
+		for _, s := range m.Values {
+			l = len(s)
+			n += 1 + l + sovAuthz(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *Grant) Size() (n int) {
 	if m == nil {
 		return 0
@@ -439,6 +835,10 @@ func (m *Grant) Size() (n int) {
 		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Expiration)
 		n += 1 + l + sovAuthz(uint64(l))
 	}
+	if m.LastUsed != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.LastUsed)
+		n += 1 + l + sovAuthz(uint64(l))
+	}
 	return n
 }
 
@@ -464,6 +864,10 @@ func (m *GrantAuthorization) Size() (n int) {
 		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Expiration)
 		n += 1 + l + sovAuthz(uint64(l))
 	}
+	if m.LastUsed != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.LastUsed)
+		n += 1 + l + sovAuthz(uint64(l))
+	}
 	return n
 }
 
@@ -570,7 +974,7 @@ func (m *GenericAuthorization) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Grant) Unmarshal(dAtA []byte) error {
+func (m *MaxUseAuthorization) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -593,17 +997,17 @@ func (m *Grant) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Grant: wiretype end group for non-group")
+			return fmt.Errorf("proto: MaxUseAuthorization: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Grant: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MaxUseAuthorization: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authorization", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAuthz
@@ -613,33 +1017,29 @@ func (m *Grant) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthAuthz
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthAuthz
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Authorization == nil {
-				m.Authorization = &types.Any{}
-			}
-			if err := m.Authorization.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Msg = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemainingUses", wireType)
 			}
-			var msglen int
+			m.RemainingUses = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAuthz
@@ -649,13 +1049,367 @@ func (m *Grant) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.RemainingUses |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthAuthz
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuthz(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FilteredAuthorization) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuthz
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FilteredAuthorization: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FilteredAuthorization: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Msg = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Filters", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Filters = append(m.Filters, FieldFilter{})
+			if err := m.Filters[len(m.Filters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuthz(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FieldFilter) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuthz
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FieldFilter: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FieldFilter: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Path = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= FieldFilterType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Values", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Values = append(m.Values, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuthz(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Grant) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuthz
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Grant: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Grant: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authorization", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Authorization == nil {
+				m.Authorization = &types.Any{}
+			}
+			if err := m.Authorization.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
 			}
 			postIndex := iNdEx + msglen
 			if postIndex < 0 {
@@ -671,6 +1425,42 @@ func (m *Grant) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastUsed", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastUsed == nil {
+				m.LastUsed = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.LastUsed, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAuthz(dAtA[iNdEx:])
@@ -857,6 +1647,42 @@ func (m *GrantAuthorization) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastUsed", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastUsed == nil {
+				m.LastUsed = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.LastUsed, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAuthz(dAtA[iNdEx:])
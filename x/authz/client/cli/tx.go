@@ -29,6 +29,9 @@ const (
 	FlagAllowedValidators = "allowed-validators"
 	FlagDenyValidators    = "deny-validators"
 	FlagAllowList         = "allow-list"
+	FlagMaxUses           = "max-uses"
+	FlagPeriod            = "period"
+	FlagPeriodLimit       = "period-limit"
 	delegate              = "delegate"
 	redelegate            = "redelegate"
 	unbond                = "unbond"
@@ -48,6 +51,7 @@ func GetTxCmd(ac address.Codec) *cobra.Command {
 	AuthorizationTxCmd.AddCommand(
 		NewCmdGrantAuthorization(ac),
 		NewCmdRevokeAuthorization(ac),
+		NewCmdRevokeAllAuthorizations(),
 		NewCmdExecAuthorization(),
 	)
 
@@ -57,15 +61,17 @@ func GetTxCmd(ac address.Codec) *cobra.Command {
 // NewCmdGrantAuthorization returns a CLI command handler for creating a MsgGrant transaction.
 func NewCmdGrantAuthorization(ac address.Codec) *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "grant <grantee> <authorization_type=\"send\"|\"generic\"|\"delegate\"|\"unbond\"|\"redelegate\"> --from <granter>",
+		Use:   "grant <grantee> <authorization_type=\"send\"|\"periodic-send\"|\"generic\"|\"max-use\"|\"delegate\"|\"unbond\"|\"redelegate\"> --from <granter>",
 		Short: "Grant authorization to an address",
 		Long: strings.TrimSpace(
 			fmt.Sprintf(`create a new grant authorization to an address to execute a transaction on your behalf:
 
 Examples:
  $ %s tx %s grant cosmos1skjw.. send --spend-limit=1000stake --from=cosmos1skl..
+ $ %s tx %s grant cosmos1skjw.. periodic-send --period=86400 --period-limit=100stake --from=cosmos1skl..
  $ %s tx %s grant cosmos1skjw.. generic --msg-type=/cosmos.gov.v1.MsgVote --from=cosmos1sk..
-	`, version.AppName, authz.ModuleName, version.AppName, authz.ModuleName),
+ $ %s tx %s grant cosmos1skjw.. max-use --msg-type=/cosmos.gov.v1.MsgVote --max-uses=3 --from=cosmos1sk..
+	`, version.AppName, authz.ModuleName, version.AppName, authz.ModuleName, version.AppName, authz.ModuleName, version.AppName, authz.ModuleName),
 		),
 		Args: cobra.ExactArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -121,6 +127,52 @@ Examples:
 
 				authorization = bank.NewSendAuthorization(spendLimit, allowed)
 
+			case "periodic-send":
+				limit, err := cmd.Flags().GetString(FlagSpendLimit)
+				if err != nil {
+					return err
+				}
+
+				spendLimit, err := sdk.ParseCoinsNormalized(limit)
+				if err != nil {
+					return err
+				}
+
+				periodLimitStr, err := cmd.Flags().GetString(FlagPeriodLimit)
+				if err != nil {
+					return err
+				}
+
+				periodLimit, err := sdk.ParseCoinsNormalized(periodLimitStr)
+				if err != nil {
+					return err
+				}
+
+				if !periodLimit.IsAllPositive() {
+					return fmt.Errorf("period-limit should be greater than zero")
+				}
+
+				period, err := cmd.Flags().GetInt64(FlagPeriod)
+				if err != nil {
+					return err
+				}
+
+				if period <= 0 {
+					return fmt.Errorf("period should be greater than zero")
+				}
+
+				allowList, err := cmd.Flags().GetStringSlice(FlagAllowList)
+				if err != nil {
+					return err
+				}
+
+				allowed, err := bech32toAccAddresses(allowList, ac)
+				if err != nil {
+					return err
+				}
+
+				authorization = bank.NewPeriodicSendAuthorization(spendLimit, time.Duration(period)*time.Second, periodLimit, allowed)
+
 			case "generic":
 				msgType, err := cmd.Flags().GetString(FlagMsgType)
 				if err != nil {
@@ -128,6 +180,23 @@ Examples:
 				}
 
 				authorization = authz.NewGenericAuthorization(msgType)
+
+			case "max-use":
+				msgType, err := cmd.Flags().GetString(FlagMsgType)
+				if err != nil {
+					return err
+				}
+
+				maxUses, err := cmd.Flags().GetUint64(FlagMaxUses)
+				if err != nil {
+					return err
+				}
+
+				if maxUses == 0 {
+					return fmt.Errorf("max-uses should be greater than zero")
+				}
+
+				authorization = authz.NewMaxUseAuthorization(msgType, maxUses)
 			case delegate, unbond, redelegate:
 				limit, err := cmd.Flags().GetString(FlagSpendLimit)
 				if err != nil {
@@ -212,6 +281,9 @@ Examples:
 	cmd.Flags().StringSlice(FlagAllowedValidators, []string{}, "Allowed validators addresses separated by ,")
 	cmd.Flags().StringSlice(FlagDenyValidators, []string{}, "Deny validators addresses separated by ,")
 	cmd.Flags().StringSlice(FlagAllowList, []string{}, "Allowed addresses grantee is allowed to send funds separated by ,")
+	cmd.Flags().Uint64(FlagMaxUses, 0, "Number of times the grantee can execute the Msg for a MaxUseAuthorization")
+	cmd.Flags().Int64(FlagPeriod, 0, "Period in seconds for a PeriodicSendAuthorization")
+	cmd.Flags().String(FlagPeriodLimit, "", "Spend limit per period for a PeriodicSendAuthorization, an array of Coins allowed spend")
 	cmd.Flags().Int64(FlagExpiration, 0, "Expire time as Unix timestamp. Set zero (0) for no expiry. Default is 0.")
 	return cmd
 }
@@ -262,6 +334,41 @@ Example:
 	return cmd
 }
 
+// NewCmdRevokeAllAuthorizations returns a CLI command handler for creating a MsgRevokeAll transaction.
+func NewCmdRevokeAllAuthorizations() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "revoke-all --from=[granter]",
+		Short: "revoke all authorizations of a granter",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`revoke all the authorizations granted by a granter, optionally only those for a msg type.
+At most a batch of grants is revoked by a transaction, the command must be repeated until none is left:
+Example:
+ $ %s tx %s revoke-all --from=cosmos1skj..
+ $ %s tx %s revoke-all --msg-type=%s --from=cosmos1skj..
+			`, version.AppName, authz.ModuleName, version.AppName, authz.ModuleName, bank.SendAuthorization{}.MsgTypeURL()),
+		),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msgType, err := cmd.Flags().GetString(FlagMsgType)
+			if err != nil {
+				return err
+			}
+
+			msg := authz.NewMsgRevokeAll(clientCtx.GetFromAddress(), msgType)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	cmd.Flags().String(FlagMsgType, "", "Only revoke the authorizations for this Msg type URL")
+	return cmd
+}
+
 // NewCmdExecAuthorization returns a CLI command handler for creating a MsgExec transaction.
 func NewCmdExecAuthorization() *cobra.Command {
 	cmd := &cobra.Command{
@@ -0,0 +1,251 @@
+package authz
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	gogoproto "github.com/cosmos/gogoproto/proto"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/dynamicpb"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// gasCostPerFieldValue is the gas consumed for each field value checked
+// against a filter.
+const gasCostPerFieldValue = uint64(10)
+
+var _ Authorization = &FilteredAuthorization{}
+
+// NewFilteredAuthorization creates a new FilteredAuthorization object.
+func NewFilteredAuthorization(msgTypeURL string, filters ...FieldFilter) *FilteredAuthorization {
+	return &FilteredAuthorization{
+		Msg:     msgTypeURL,
+		Filters: filters,
+	}
+}
+
+// MsgTypeURL implements Authorization.MsgTypeURL.
+func (a FilteredAuthorization) MsgTypeURL() string {
+	return a.Msg
+}
+
+// Accept implements Authorization.Accept. The msg is decoded through
+// protoreflect and accepted if all of its fields selected by the filters
+// satisfy them.
+func (a FilteredAuthorization) Accept(ctx context.Context, msg sdk.Msg) (AcceptResponse, error) {
+	if sdk.MsgTypeURL(msg) != a.Msg {
+		return AcceptResponse{}, sdkerrors.ErrInvalidType.Wrap("type mismatch")
+	}
+
+	protoMsg, err := toProtoReflect(msg)
+	if err != nil {
+		return AcceptResponse{}, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	for _, filter := range a.Filters {
+		values, err := fieldValues(protoMsg, strings.Split(filter.Path, "."))
+		if err != nil {
+			return AcceptResponse{}, sdkerrors.ErrInvalidRequest.Wrapf("filter %s: %s", filter.Path, err)
+		}
+
+		for _, value := range values {
+			sdkCtx.GasMeter().ConsumeGas(gasCostPerFieldValue, "filtered authorization")
+			if err := filter.check(value); err != nil {
+				return AcceptResponse{}, sdkerrors.ErrUnauthorized.Wrapf("filter %s: %s", filter.Path, err)
+			}
+		}
+	}
+
+	return AcceptResponse{Accept: true}, nil
+}
+
+// ValidateBasic implements Authorization.ValidateBasic.
+func (a FilteredAuthorization) ValidateBasic() error {
+	if a.Msg == "" {
+		return errors.New("msg type cannot be empty")
+	}
+	if len(a.Filters) == 0 {
+		return errors.New("filters cannot be empty")
+	}
+
+	for _, filter := range a.Filters {
+		if err := filter.ValidateBasic(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ValidateBasic performs stateless validation of a field filter.
+func (f FieldFilter) ValidateBasic() error {
+	if f.Path == "" {
+		return errors.New("filter path cannot be empty")
+	}
+
+	for _, name := range strings.Split(f.Path, ".") {
+		if name == "" {
+			return fmt.Errorf("filter path %s has an empty field name", f.Path)
+		}
+	}
+
+	switch f.Type {
+	case FIELD_FILTER_TYPE_EXACT:
+		if len(f.Values) != 1 {
+			return fmt.Errorf("exact filter %s must have a single value", f.Path)
+		}
+	case FIELD_FILTER_TYPE_ALLOWLIST:
+		if len(f.Values) == 0 {
+			return fmt.Errorf("allowlist filter %s must have at least one value", f.Path)
+		}
+	case FIELD_FILTER_TYPE_MAX:
+		if len(f.Values) != 1 {
+			return fmt.Errorf("max filter %s must have a single value", f.Path)
+		}
+		if _, err := sdkmath.LegacyNewDecFromStr(f.Values[0]); err != nil {
+			return fmt.Errorf("max filter %s must have a numeric value: %w", f.Path, err)
+		}
+	default:
+		return fmt.Errorf("filter %s has an invalid type %s", f.Path, f.Type)
+	}
+
+	return nil
+}
+
+// check returns an error if the value does not satisfy the filter.
+func (f FieldFilter) check(value string) error {
+	switch f.Type {
+	case FIELD_FILTER_TYPE_EXACT, FIELD_FILTER_TYPE_ALLOWLIST:
+		for _, allowed := range f.Values {
+			if value == allowed {
+				return nil
+			}
+		}
+		return fmt.Errorf("value %s is not allowed", value)
+
+	case FIELD_FILTER_TYPE_MAX:
+		max, err := sdkmath.LegacyNewDecFromStr(f.Values[0])
+		if err != nil {
+			return err
+		}
+
+		number, err := sdkmath.LegacyNewDecFromStr(value)
+		if err != nil {
+			return fmt.Errorf("value %s is not a number", value)
+		}
+
+		if number.GT(max) {
+			return fmt.Errorf("value %s is greater than %s", value, f.Values[0])
+		}
+		return nil
+
+	default:
+		return fmt.Errorf("invalid filter type %s", f.Type)
+	}
+}
+
+// toProtoReflect converts a gogoproto msg to a dynamic protoreflect message.
+func toProtoReflect(msg sdk.Msg) (protoreflect.Message, error) {
+	desc, err := gogoproto.HybridResolver.FindDescriptorByName(protoreflect.FullName(gogoproto.MessageName(msg)))
+	if err != nil {
+		return nil, err
+	}
+
+	msgDesc, ok := desc.(protoreflect.MessageDescriptor)
+	if !ok {
+		return nil, fmt.Errorf("%s is not a message", desc.FullName())
+	}
+
+	bz, err := gogoproto.Marshal(msg)
+	if err != nil {
+		return nil, err
+	}
+
+	dynamicMsg := dynamicpb.NewMessage(msgDesc)
+	if err := proto.Unmarshal(bz, dynamicMsg); err != nil {
+		return nil, err
+	}
+
+	return dynamicMsg, nil
+}
+
+// fieldValues returns the string values of the scalar field at the given
+// path, one for each element of the repeated fields along the path.
+func fieldValues(msg protoreflect.Message, path []string) ([]string, error) {
+	fd := findField(msg.Descriptor(), path[0])
+	if fd == nil {
+		return nil, fmt.Errorf("unknown field %s in %s", path[0], msg.Descriptor().FullName())
+	}
+	if fd.IsMap() {
+		return nil, fmt.Errorf("map field %s is not supported", path[0])
+	}
+
+	value := msg.Get(fd)
+	var elems []protoreflect.Value
+	if fd.IsList() {
+		list := value.List()
+		for i := 0; i < list.Len(); i++ {
+			elems = append(elems, list.Get(i))
+		}
+	} else {
+		elems = []protoreflect.Value{value}
+	}
+
+	var values []string
+	for _, elem := range elems {
+		if fd.Kind() == protoreflect.MessageKind || fd.Kind() == protoreflect.GroupKind {
+			if len(path) == 1 {
+				return nil, fmt.Errorf("field %s is not a scalar", path[0])
+			}
+
+			nested, err := fieldValues(elem.Message(), path[1:])
+			if err != nil {
+				return nil, err
+			}
+			values = append(values, nested...)
+			continue
+		}
+
+		if len(path) > 1 {
+			return nil, fmt.Errorf("field %s is a scalar", path[0])
+		}
+		values = append(values, scalarString(fd, elem))
+	}
+
+	return values, nil
+}
+
+// findField returns the field of the message with the given JSON or proto
+// name, or nil if there is none.
+func findField(desc protoreflect.MessageDescriptor, name string) protoreflect.FieldDescriptor {
+	if fd := desc.Fields().ByJSONName(name); fd != nil {
+		return fd
+	}
+	return desc.Fields().ByName(protoreflect.Name(name))
+}
+
+// scalarString returns the string representation of a scalar value, as in the
+// JSON encoding of the message.
+func scalarString(fd protoreflect.FieldDescriptor, value protoreflect.Value) string {
+	switch fd.Kind() {
+	case protoreflect.EnumKind:
+		if enumValue := fd.Enum().Values().ByNumber(value.Enum()); enumValue != nil {
+			return string(enumValue.Name())
+		}
+		return strconv.Itoa(int(value.Enum()))
+	case protoreflect.BytesKind:
+		return base64.StdEncoding.EncodeToString(value.Bytes())
+	default:
+		return value.String()
+	}
+}
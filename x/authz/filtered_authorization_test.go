@@ -0,0 +1,103 @@
+package authz_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+)
+
+func TestFilteredAuthorizationValidateBasic(t *testing.T) {
+	msgTypeURL := sdk.MsgTypeURL(&banktypes.MsgSend{})
+	testCases := []struct {
+		name    string
+		filters []authz.FieldFilter
+		expErr  bool
+	}{
+		{"no filters", nil, true},
+		{"empty path", []authz.FieldFilter{{Type: authz.FIELD_FILTER_TYPE_EXACT, Values: []string{"a"}}}, true},
+		{"empty field name", []authz.FieldFilter{{Path: "amount..denom", Type: authz.FIELD_FILTER_TYPE_EXACT, Values: []string{"a"}}}, true},
+		{"unspecified type", []authz.FieldFilter{{Path: "to_address", Values: []string{"a"}}}, true},
+		{"exact with two values", []authz.FieldFilter{{Path: "to_address", Type: authz.FIELD_FILTER_TYPE_EXACT, Values: []string{"a", "b"}}}, true},
+		{"empty allowlist", []authz.FieldFilter{{Path: "to_address", Type: authz.FIELD_FILTER_TYPE_ALLOWLIST}}, true},
+		{"non numeric max", []authz.FieldFilter{{Path: "amount.amount", Type: authz.FIELD_FILTER_TYPE_MAX, Values: []string{"a"}}}, true},
+		{"valid", []authz.FieldFilter{
+			{Path: "to_address", Type: authz.FIELD_FILTER_TYPE_ALLOWLIST, Values: []string{"a", "b"}},
+			{Path: "amount.amount", Type: authz.FIELD_FILTER_TYPE_MAX, Values: []string{"100"}},
+		}, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := authz.NewFilteredAuthorization(msgTypeURL, tc.filters...).ValidateBasic()
+			if tc.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestFilteredAuthorizationAccept(t *testing.T) {
+	ctx := testutil.DefaultContext(storetypes.NewKVStoreKey(authz.ModuleName), storetypes.NewTransientStoreKey("transient_test"))
+	fromAddr, toAddr, otherAddr := sdk.AccAddress("from"), sdk.AccAddress("to"), sdk.AccAddress("other")
+
+	a := authz.NewFilteredAuthorization(sdk.MsgTypeURL(&banktypes.MsgSend{}),
+		authz.FieldFilter{Path: "toAddress", Type: authz.FIELD_FILTER_TYPE_EXACT, Values: []string{toAddr.String()}},
+		authz.FieldFilter{Path: "amount.denom", Type: authz.FIELD_FILTER_TYPE_ALLOWLIST, Values: []string{"stake", "atom"}},
+		authz.FieldFilter{Path: "amount.amount", Type: authz.FIELD_FILTER_TYPE_MAX, Values: []string{"100"}},
+	)
+	require.NoError(t, a.ValidateBasic())
+
+	testCases := []struct {
+		name   string
+		msg    sdk.Msg
+		expErr error
+	}{
+		{"accepted", banktypes.NewMsgSend(fromAddr, toAddr, sdk.NewCoins(sdk.NewInt64Coin("atom", 50), sdk.NewInt64Coin("stake", 100))), nil},
+		{"recipient not allowed", banktypes.NewMsgSend(fromAddr, otherAddr, sdk.NewCoins(sdk.NewInt64Coin("stake", 100))), sdkerrors.ErrUnauthorized},
+		{"denom not allowed", banktypes.NewMsgSend(fromAddr, toAddr, sdk.NewCoins(sdk.NewInt64Coin("stake", 50), sdk.NewInt64Coin("xyz", 50))), sdkerrors.ErrUnauthorized},
+		{"amount above the cap", banktypes.NewMsgSend(fromAddr, toAddr, sdk.NewCoins(sdk.NewInt64Coin("stake", 101))), sdkerrors.ErrUnauthorized},
+		{"msg type mismatch", &banktypes.MsgMultiSend{}, sdkerrors.ErrInvalidType},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			resp, err := a.Accept(ctx, tc.msg)
+			if tc.expErr != nil {
+				require.ErrorIs(t, err, tc.expErr)
+				return
+			}
+
+			require.NoError(t, err)
+			require.True(t, resp.Accept)
+			require.False(t, resp.Delete)
+			require.Nil(t, resp.Updated)
+		})
+	}
+
+	t.Log("verify enum fields are matched by name")
+	a = authz.NewFilteredAuthorization(sdk.MsgTypeURL(&govv1.MsgVote{}),
+		authz.FieldFilter{Path: "option", Type: authz.FIELD_FILTER_TYPE_EXACT, Values: []string{"VOTE_OPTION_YES"}},
+	)
+	_, err := a.Accept(ctx, govv1.NewMsgVote(fromAddr, 1, govv1.OptionYes, ""))
+	require.NoError(t, err)
+	_, err = a.Accept(ctx, govv1.NewMsgVote(fromAddr, 1, govv1.OptionNo, ""))
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	t.Log("verify unknown fields are rejected")
+	a = authz.NewFilteredAuthorization(sdk.MsgTypeURL(&govv1.MsgVote{}),
+		authz.FieldFilter{Path: "unknown", Type: authz.FIELD_FILTER_TYPE_EXACT, Values: []string{"a"}},
+	)
+	_, err = a.Accept(ctx, govv1.NewMsgVote(fromAddr, 1, govv1.OptionYes, ""))
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+}
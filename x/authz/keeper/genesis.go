@@ -32,6 +32,10 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data *authz.GenesisState) {
 		if err != nil {
 			panic(err)
 		}
+
+		if entry.LastUsed != nil {
+			k.setGrantLastUsed(ctx, grantStoreKey(grantee, granter, a.MsgTypeURL()), *entry.LastUsed)
+		}
 	}
 }
 
@@ -43,6 +47,7 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *authz.GenesisState {
 			Granter:       granter.String(),
 			Grantee:       grantee.String(),
 			Expiration:    grant.Expiration,
+			LastUsed:      grant.LastUsed,
 			Authorization: grant.Authorization,
 		})
 		return false
@@ -54,6 +54,7 @@ func (k Keeper) Grants(ctx context.Context, req *authz.QueryGrantsRequest) (*aut
 			Grants: []*authz.Grant{{
 				Authorization: authorizationAny,
 				Expiration:    grant.Expiration,
+				LastUsed:      grant.LastUsed,
 			}},
 		}, nil
 	}
@@ -75,6 +76,7 @@ func (k Keeper) Grants(ctx context.Context, req *authz.QueryGrantsRequest) (*aut
 		return &authz.Grant{
 			Authorization: authorizationAny,
 			Expiration:    auth.Expiration,
+			LastUsed:      auth.LastUsed,
 		}, nil
 	}, func() *authz.Grant {
 		return &authz.Grant{}
@@ -120,6 +122,7 @@ func (k Keeper) GranterGrants(ctx context.Context, req *authz.QueryGranterGrants
 			Grantee:       grantee.String(),
 			Authorization: any,
 			Expiration:    auth.Expiration,
+			LastUsed:      auth.LastUsed,
 		}, nil
 	}, func() *authz.Grant {
 		return &authz.Grant{}
@@ -166,6 +169,7 @@ func (k Keeper) GranteeGrants(ctx context.Context, req *authz.QueryGranteeGrants
 		return &authz.GrantAuthorization{
 			Authorization: authorizationAny,
 			Expiration:    auth.Expiration,
+			LastUsed:      auth.LastUsed,
 			Granter:       granter.String(),
 			Grantee:       req.Grantee,
 		}, nil
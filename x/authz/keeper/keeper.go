@@ -29,6 +29,10 @@ import (
 // https://github.com/cosmos/cosmos-sdk/discussions/9072
 const gasCostPerIteration = uint64(20)
 
+// revokeAllBatchSize is the maximum number of grants revoked by a single
+// MsgRevokeAll, keeping its gas consumption bounded.
+const revokeAllBatchSize = 100
+
 type Keeper struct {
 	storeService corestoretypes.KVStoreService
 	cdc          codec.Codec
@@ -68,30 +72,51 @@ func (k Keeper) getGrant(ctx context.Context, skey []byte) (grant authz.Grant, f
 	return grant, true
 }
 
-func (k Keeper) update(ctx context.Context, grantee, granter sdk.AccAddress, updated authz.Authorization) error {
-	skey := grantStoreKey(grantee, granter, updated.MsgTypeURL())
+// update records the time a grant was last used and, if updated is not nil,
+// replaces its authorization.
+func (k Keeper) update(ctx context.Context, grantee, granter sdk.AccAddress, msgType string, updated authz.Authorization) error {
+	skey := grantStoreKey(grantee, granter, msgType)
 	grant, found := k.getGrant(ctx, skey)
 	if !found {
 		return authz.ErrNoAuthorizationFound
 	}
 
-	msg, ok := updated.(proto.Message)
-	if !ok {
-		return sdkerrors.ErrPackAny.Wrapf("cannot proto marshal %T", updated)
-	}
+	if updated != nil {
+		msg, ok := updated.(proto.Message)
+		if !ok {
+			return sdkerrors.ErrPackAny.Wrapf("cannot proto marshal %T", updated)
+		}
 
-	any, err := codectypes.NewAnyWithValue(msg)
-	if err != nil {
-		return err
+		any, err := codectypes.NewAnyWithValue(msg)
+		if err != nil {
+			return err
+		}
+
+		grant.Authorization = any
 	}
 
-	grant.Authorization = any
+	now := sdk.UnwrapSDKContext(ctx).BlockTime()
+	grant.LastUsed = &now
 	store := k.storeService.OpenKVStore(ctx)
 	store.Set(skey, k.cdc.MustMarshal(&grant))
 
 	return nil
 }
 
+// setGrantLastUsed sets the time the grant stored at skey was last used.
+func (k Keeper) setGrantLastUsed(ctx context.Context, skey []byte, lastUsed time.Time) {
+	grant, found := k.getGrant(ctx, skey)
+	if !found {
+		return
+	}
+
+	grant.LastUsed = &lastUsed
+	store := k.storeService.OpenKVStore(ctx)
+	if err := store.Set(skey, k.cdc.MustMarshal(&grant)); err != nil {
+		panic(err)
+	}
+}
+
 // DispatchActions attempts to execute the provided messages via authorization
 // grants from the message signer to the grantee.
 func (k Keeper) DispatchActions(ctx context.Context, grantee sdk.AccAddress, msgs []sdk.Msg) ([][]byte, error) {
@@ -138,8 +163,8 @@ func (k Keeper) DispatchActions(ctx context.Context, grantee sdk.AccAddress, msg
 
 			if resp.Delete {
 				err = k.DeleteGrant(ctx, grantee, granter, sdk.MsgTypeURL(msg))
-			} else if resp.Updated != nil {
-				err = k.update(ctx, grantee, granter, resp.Updated)
+			} else {
+				err = k.update(ctx, grantee, granter, sdk.MsgTypeURL(msg), resp.Updated)
 			}
 			if err != nil {
 				return nil, err
@@ -257,6 +282,47 @@ func (k Keeper) DeleteGrant(ctx context.Context, grantee, granter sdk.AccAddress
 	})
 }
 
+// RevokeAllGrants revokes the grants given by the granter to any grantee,
+// only those for msgType if it is not empty. At most revokeAllBatchSize grants
+// are revoked, hasMore telling whether grants are left to revoke.
+func (k Keeper) RevokeAllGrants(ctx context.Context, granter sdk.AccAddress, msgType string) (revoked uint64, hasMore bool, err error) {
+	store := k.storeService.OpenKVStore(ctx)
+	prefix := grantStoreKey(nil, granter, "")
+	iter, err := store.Iterator(prefix, storetypes.PrefixEndBytes(prefix))
+	if err != nil {
+		return 0, false, err
+	}
+
+	var keys [][]byte
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	for ; iter.Valid(); iter.Next() {
+		sdkCtx.GasMeter().ConsumeGas(gasCostPerIteration, "revoke all grants")
+
+		_, _, grantMsgType := parseGrantStoreKey(iter.Key())
+		if msgType != "" && grantMsgType != msgType {
+			continue
+		}
+
+		if len(keys) == revokeAllBatchSize {
+			hasMore = true
+			break
+		}
+		keys = append(keys, iter.Key())
+	}
+	if err := iter.Close(); err != nil {
+		return 0, false, err
+	}
+
+	for _, key := range keys {
+		_, grantee, grantMsgType := parseGrantStoreKey(key)
+		if err := k.DeleteGrant(ctx, grantee, granter, grantMsgType); err != nil {
+			return 0, false, err
+		}
+	}
+
+	return uint64(len(keys)), hasMore, nil
+}
+
 // GetAuthorizations Returns list of `Authorizations` granted to the grantee by the granter.
 func (k Keeper) GetAuthorizations(ctx context.Context, grantee, granter sdk.AccAddress) ([]authz.Authorization, error) {
 	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
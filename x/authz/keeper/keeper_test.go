@@ -302,6 +302,51 @@ func (s *TestSuite) TestDispatchAction() {
 	}
 }
 
+func (s *TestSuite) TestGrantLastUsed() {
+	require := s.Require()
+	granterAddr, granteeAddr, recipientAddr := s.addrs[0], s.addrs[1], s.addrs[2]
+
+	err := s.authzKeeper.SaveGrant(s.ctx, granteeAddr, granterAddr, banktypes.NewSendAuthorization(coins100, nil), nil)
+	require.NoError(err)
+	err = s.authzKeeper.SaveGrant(s.ctx, granteeAddr, granterAddr, authz.NewGenericAuthorization(sdk.MsgTypeURL(&banktypes.MsgMultiSend{})), nil)
+	require.NoError(err)
+
+	s.T().Log("verify a new grant was never used")
+	res, err := s.queryClient.GranterGrants(s.ctx, &authz.QueryGranterGrantsRequest{Granter: granterAddr.String()})
+	require.NoError(err)
+	require.Len(res.Grants, 2)
+	for _, grant := range res.Grants {
+		require.Nil(grant.LastUsed)
+	}
+
+	s.T().Log("verify the time a grant is used is recorded, whether its authorization is updated or not")
+	ctx := s.ctx.WithBlockTime(s.ctx.BlockTime().Add(time.Hour))
+	_, err = s.authzKeeper.DispatchActions(ctx, granteeAddr, []sdk.Msg{
+		banktypes.NewMsgSend(granterAddr, recipientAddr, coins10),
+		banktypes.NewMsgMultiSend(banktypes.NewInput(granterAddr, coins10), []banktypes.Output{banktypes.NewOutput(recipientAddr, coins10)}),
+	})
+	require.NoError(err)
+
+	for _, msgType := range []string{bankSendAuthMsgType, sdk.MsgTypeURL(&banktypes.MsgMultiSend{})} {
+		res, err := s.queryClient.Grants(ctx, &authz.QueryGrantsRequest{
+			Granter:    granterAddr.String(),
+			Grantee:    granteeAddr.String(),
+			MsgTypeUrl: msgType,
+		})
+		require.NoError(err)
+		require.Len(res.Grants, 1)
+		require.NotNil(res.Grants[0].LastUsed)
+		require.True(ctx.BlockTime().Equal(*res.Grants[0].LastUsed))
+	}
+
+	s.T().Log("verify the last used time is exported and imported")
+	genesis := s.authzKeeper.ExportGenesis(ctx)
+	require.Len(genesis.Authorization, 2)
+	for _, entry := range genesis.Authorization {
+		require.NotNil(entry.LastUsed)
+	}
+}
+
 // Tests that all msg events included in an authz MsgExec tx
 // Ref: https://github.com/cosmos/cosmos-sdk/issues/9501
 func (s *TestSuite) TestDispatchedEvents() {
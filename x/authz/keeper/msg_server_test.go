@@ -304,6 +304,44 @@ func (suite *TestSuite) TestRevoke() {
 	}
 }
 
+func (suite *TestSuite) TestRevokeAll() {
+	require := suite.Require()
+	granter := suite.addrs[0]
+	grantees := simtestutil.CreateIncrementalAccounts(103)[2:]
+	multiSendMsgType := sdk.MsgTypeURL(&banktypes.MsgMultiSend{})
+
+	for _, grantee := range grantees[:3] {
+		require.NoError(suite.authzKeeper.SaveGrant(suite.ctx, grantee, granter, banktypes.NewSendAuthorization(coins100, nil), nil))
+	}
+	for _, grantee := range grantees {
+		require.NoError(suite.authzKeeper.SaveGrant(suite.ctx, grantee, granter, authz.NewGenericAuthorization(multiSendMsgType), nil))
+	}
+
+	_, err := suite.msgSrvr.RevokeAll(suite.ctx, &authz.MsgRevokeAll{Granter: "invalid"})
+	require.ErrorContains(err, "invalid bech32 string")
+
+	suite.T().Log("verify only the grants of the msg type are revoked")
+	res, err := suite.msgSrvr.RevokeAll(suite.ctx, &authz.MsgRevokeAll{Granter: granter.String(), MsgTypeUrl: bankSendAuthMsgType})
+	require.NoError(err)
+	require.Equal(uint64(3), res.Revoked)
+	require.False(res.HasMore)
+
+	suite.T().Log("verify the grants are revoked in batches")
+	res, err = suite.msgSrvr.RevokeAll(suite.ctx, &authz.MsgRevokeAll{Granter: granter.String()})
+	require.NoError(err)
+	require.Equal(uint64(100), res.Revoked)
+	require.True(res.HasMore)
+
+	res, err = suite.msgSrvr.RevokeAll(suite.ctx, &authz.MsgRevokeAll{Granter: granter.String()})
+	require.NoError(err)
+	require.Equal(uint64(1), res.Revoked)
+	require.False(res.HasMore)
+
+	grants, err := suite.queryClient.GranterGrants(suite.ctx, &authz.QueryGranterGrantsRequest{Granter: granter.String()})
+	require.NoError(err)
+	require.Empty(grants.Grants)
+}
+
 func (suite *TestSuite) TestExec() {
 	addrs := suite.createAccounts(2)
 
@@ -0,0 +1,43 @@
+package authz
+
+import (
+	"context"
+	"errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ Authorization = &MaxUseAuthorization{}
+
+// NewMaxUseAuthorization creates a new MaxUseAuthorization object.
+func NewMaxUseAuthorization(msgTypeURL string, maxUses uint64) *MaxUseAuthorization {
+	return &MaxUseAuthorization{
+		Msg:           msgTypeURL,
+		RemainingUses: maxUses,
+	}
+}
+
+// MsgTypeURL implements Authorization.MsgTypeURL.
+func (a MaxUseAuthorization) MsgTypeURL() string {
+	return a.Msg
+}
+
+// Accept implements Authorization.Accept.
+func (a MaxUseAuthorization) Accept(ctx context.Context, msg sdk.Msg) (AcceptResponse, error) {
+	if a.RemainingUses <= 1 {
+		return AcceptResponse{Accept: true, Delete: true}, nil
+	}
+
+	return AcceptResponse{Accept: true, Updated: NewMaxUseAuthorization(a.Msg, a.RemainingUses-1)}, nil
+}
+
+// ValidateBasic implements Authorization.ValidateBasic.
+func (a MaxUseAuthorization) ValidateBasic() error {
+	if a.Msg == "" {
+		return errors.New("msg type cannot be empty")
+	}
+	if a.RemainingUses == 0 {
+		return errors.New("remaining uses must be positive")
+	}
+	return nil
+}
@@ -0,0 +1,35 @@
+package authz_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func TestMaxUseAuthorization(t *testing.T) {
+	msgTypeURL := banktypes.SendAuthorization{}.MsgTypeURL()
+	require.Error(t, authz.NewMaxUseAuthorization("", 2).ValidateBasic())
+	require.Error(t, authz.NewMaxUseAuthorization(msgTypeURL, 0).ValidateBasic())
+
+	a := authz.NewMaxUseAuthorization(msgTypeURL, 2)
+	require.NoError(t, a.ValidateBasic())
+	require.Equal(t, msgTypeURL, a.MsgTypeURL())
+
+	t.Log("verify the remaining uses are decremented")
+	resp, err := a.Accept(context.Background(), &banktypes.MsgSend{})
+	require.NoError(t, err)
+	require.True(t, resp.Accept)
+	require.False(t, resp.Delete)
+	require.Equal(t, authz.NewMaxUseAuthorization(msgTypeURL, 1), resp.Updated)
+
+	t.Log("verify the authorization is deleted once used up")
+	resp, err = resp.Updated.Accept(context.Background(), &banktypes.MsgSend{})
+	require.NoError(t, err)
+	require.True(t, resp.Accept)
+	require.True(t, resp.Delete)
+	require.Nil(t, resp.Updated)
+}
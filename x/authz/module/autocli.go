@@ -70,6 +70,13 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						{ProtoField: "msg_type_url"},
 					},
 				},
+				{
+					RpcMethod: "RevokeAll",
+					Use:       "revoke-all --from [granter]",
+					Short:     `Revoke all the authorizations of a granter, optionally only those for a msg type`,
+					Example: fmt.Sprintf(`%s tx authz revoke-all --msg-type-url=%s --from=cosmos1skj..`,
+						version.AppName, bank.SendAuthorization{}.MsgTypeURL()),
+				},
 			},
 		},
 	}
@@ -14,6 +14,7 @@ var (
 	_ sdk.Msg = &MsgGrant{}
 	_ sdk.Msg = &MsgRevoke{}
 	_ sdk.Msg = &MsgExec{}
+	_ sdk.Msg = &MsgRevokeAll{}
 
 	_ cdctypes.UnpackInterfacesMessage = &MsgGrant{}
 	_ cdctypes.UnpackInterfacesMessage = &MsgExec{}
@@ -79,6 +80,14 @@ func NewMsgRevoke(granter, grantee sdk.AccAddress, msgTypeURL string) MsgRevoke
 	}
 }
 
+// NewMsgRevokeAll creates a new MsgRevokeAll
+func NewMsgRevokeAll(granter sdk.AccAddress, msgTypeURL string) MsgRevokeAll {
+	return MsgRevokeAll{
+		Granter:    granter.String(),
+		MsgTypeUrl: msgTypeURL,
+	}
+}
+
 // NewMsgExec creates a new MsgExecAuthorized
 func NewMsgExec(grantee sdk.AccAddress, msgs []sdk.Msg) MsgExec {
 	msgsAny := make([]*cdctypes.Any, len(msgs))
@@ -23,3 +23,39 @@ func (k MockBankKeeper) UpdateParams(goCtx context.Context, req *bank.MsgUpdateP
 func (k MockBankKeeper) SetSendEnabled(goCtx context.Context, req *bank.MsgSetSendEnabled) (*bank.MsgSetSendEnabledResponse, error) {
 	return nil, nil
 }
+
+func (k MockBankKeeper) CreateDenom(goCtx context.Context, req *bank.MsgCreateDenom) (*bank.MsgCreateDenomResponse, error) {
+	return nil, nil
+}
+
+func (k MockBankKeeper) Mint(goCtx context.Context, req *bank.MsgMint) (*bank.MsgMintResponse, error) {
+	return nil, nil
+}
+
+func (k MockBankKeeper) Burn(goCtx context.Context, req *bank.MsgBurn) (*bank.MsgBurnResponse, error) {
+	return nil, nil
+}
+
+func (k MockBankKeeper) ChangeAdmin(goCtx context.Context, req *bank.MsgChangeAdmin) (*bank.MsgChangeAdminResponse, error) {
+	return nil, nil
+}
+
+func (k MockBankKeeper) SetDenomMetadata(goCtx context.Context, req *bank.MsgSetDenomMetadata) (*bank.MsgSetDenomMetadataResponse, error) {
+	return nil, nil
+}
+
+func (k MockBankKeeper) CreateStream(goCtx context.Context, req *bank.MsgCreateStream) (*bank.MsgCreateStreamResponse, error) {
+	return nil, nil
+}
+
+func (k MockBankKeeper) TopUpStream(goCtx context.Context, req *bank.MsgTopUpStream) (*bank.MsgTopUpStreamResponse, error) {
+	return nil, nil
+}
+
+func (k MockBankKeeper) CancelStream(goCtx context.Context, req *bank.MsgCancelStream) (*bank.MsgCancelStreamResponse, error) {
+	return nil, nil
+}
+
+func (k MockBankKeeper) WithdrawStream(goCtx context.Context, req *bank.MsgWithdrawStream) (*bank.MsgWithdrawStreamResponse, error) {
+	return nil, nil
+}
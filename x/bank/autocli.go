@@ -84,6 +84,56 @@ To look up all denoms, do not provide any arguments.`,
 					),
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "denoms"}},
 				},
+				{
+					RpcMethod:      "DenomAuthorityMetadata",
+					Use:            "denom-authority-metadata [denom]",
+					Short:          "Query the authority metadata of a factory denom",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "denom"}},
+				},
+				{
+					RpcMethod:      "DenomsFromCreator",
+					Use:            "denoms-from-creator [creator]",
+					Short:          "Query all the factory denoms created by an address",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "creator"}},
+				},
+				{
+					RpcMethod: "SendHooks",
+					Use:       "send-hooks",
+					Short:     "Query the send hooks registered for the denoms",
+					Long:      "Query the send hooks registered for the denoms. To query the hooks applying to a specific denom, in execution order, use the --denom flag.",
+				},
+				{
+					RpcMethod:      "BalanceHistory",
+					Use:            "balance-history [address] [denom]",
+					Short:          "Query the balance changes of an account for a denom over a height range",
+					Long:           "Query the balance changes of an account for a denom over a height range, set with the --start-height and --end-height flags. The changes are only logged while the balance_history_enabled param is set.",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "address"}, {ProtoField: "denom"}},
+				},
+				{
+					RpcMethod:      "TopHolders",
+					Use:            "top-holders [denom]",
+					Short:          "Query the largest holders of a denom, by descending balance",
+					Long:           "Query the largest holders of a denom, by descending balance. The number of holders is set with the --limit flag. Requires the top_holders_index_enabled param to be set.",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "denom"}},
+				},
+				{
+					RpcMethod:      "HolderCount",
+					Use:            "holder-count [denom]",
+					Short:          "Query the number of accounts holding a denom",
+					Long:           "Query the number of accounts holding a denom. Requires the top_holders_index_enabled param to be set.",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "denom"}},
+				},
+				{
+					RpcMethod:      "Stream",
+					Use:            "stream [stream-id]",
+					Short:          "Query a payment stream and the amount its recipient can withdraw",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "stream_id"}},
+				},
+				{
+					RpcMethod: "Streams",
+					Use:       "streams",
+					Short:     "Query all the open payment streams",
+				},
 			},
 		},
 		Tx: &autocliv1.ServiceCommandDescriptor{
@@ -0,0 +1,16 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// BeginBlocker prunes the balance-change log entries which are older than the
+// retention period, and continues to drop or build the top holders index.
+func (k BaseKeeper) BeginBlocker(ctx context.Context) error {
+	if err := k.pruneBalanceHistory(ctx); err != nil {
+		return err
+	}
+	return k.processHoldersIndex(ctx, types.HoldersIndexBatchGasLimit)
+}
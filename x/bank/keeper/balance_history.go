@@ -0,0 +1,141 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/collections"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// GetBalanceHistory returns a page of the balance changes of addr and denom
+// logged between startHeight and endHeight, both inclusive. The key of the
+// page is the height of the next change to return.
+func (k BaseViewKeeper) GetBalanceHistory(ctx context.Context, addr sdk.AccAddress, denom string, startHeight, endHeight uint64, pageReq *query.PageRequest) ([]types.BalanceChange, *query.PageResponse, error) {
+	if pageReq == nil {
+		pageReq = &query.PageRequest{}
+	}
+	if len(pageReq.Key) != 0 && pageReq.Offset > 0 {
+		return nil, nil, errors.New("invalid request, either offset or key is expected, got both")
+	}
+
+	limit := pageReq.Limit
+	if limit == 0 {
+		limit = query.DefaultLimit
+	}
+	countTotal := pageReq.CountTotal && len(pageReq.Key) == 0
+
+	if len(pageReq.Key) != 0 {
+		if len(pageReq.Key) != 8 {
+			return nil, nil, fmt.Errorf("invalid page key length %d", len(pageReq.Key))
+		}
+		height := sdk.BigEndianToUint64(pageReq.Key)
+		if pageReq.Reverse {
+			endHeight = min(endHeight, height)
+		} else {
+			startHeight = max(startHeight, height)
+		}
+	}
+
+	changes := []types.BalanceChange{}
+	pageRes := &query.PageResponse{}
+	if startHeight > endHeight {
+		return changes, pageRes, nil
+	}
+
+	rng := new(collections.Range[collections.Triple[sdk.AccAddress, string, uint64]]).
+		StartInclusive(collections.Join3(addr, denom, startHeight)).
+		EndInclusive(collections.Join3(addr, denom, endHeight))
+	if pageReq.Reverse {
+		rng = rng.Descending()
+	}
+
+	iter, err := k.BalanceChanges.Iterate(ctx, rng)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer iter.Close()
+
+	var count uint64
+	for ; iter.Valid(); iter.Next() {
+		count++
+		if count <= pageReq.Offset {
+			continue
+		}
+
+		if uint64(len(changes)) == limit {
+			if pageRes.NextKey == nil {
+				key, err := iter.Key()
+				if err != nil {
+					return nil, nil, err
+				}
+				pageRes.NextKey = sdk.Uint64ToBigEndian(key.K3())
+			}
+			if !countTotal {
+				break
+			}
+			continue
+		}
+
+		kv, err := iter.KeyValue()
+		if err != nil {
+			return nil, nil, err
+		}
+		changes = append(changes, types.BalanceChange{
+			Height:  int64(kv.Key.K3()),
+			Balance: sdk.NewCoin(denom, kv.Value),
+		})
+	}
+
+	if countTotal {
+		pageRes.Total = count
+	}
+
+	return changes, pageRes, nil
+}
+
+// logBalanceChange records the new balance of addr in the balance-change log,
+// keyed by the current block height. Several changes at the same height only
+// keep the last balance.
+func (k BaseSendKeeper) logBalanceChange(ctx context.Context, addr sdk.AccAddress, balance sdk.Coin) error {
+	height := uint64(sdk.UnwrapSDKContext(ctx).BlockHeight())
+	return k.BalanceChanges.Set(ctx, collections.Join3(addr, balance.Denom, height), balance.Amount)
+}
+
+// pruneBalanceHistory removes the changes of the balance-change log which are
+// older than the BalanceHistoryRetentionBlocks param. At most
+// MaxBalanceChangesPrunedPerBlock changes are removed per call, the remaining
+// ones are removed by the next blocks.
+func (k BaseSendKeeper) pruneBalanceHistory(ctx context.Context) error {
+	retention := k.GetParams(ctx).BalanceHistoryRetentionBlocks
+	height := uint64(sdk.UnwrapSDKContext(ctx).BlockHeight())
+	if retention == 0 || height <= retention {
+		return nil
+	}
+
+	iter, err := k.BalanceChanges.Indexes.Height.Iterate(ctx, collections.NewPrefixUntilPairRange[uint64, collections.Triple[sdk.AccAddress, string, uint64]](height-retention))
+	if err != nil {
+		return err
+	}
+	defer iter.Close()
+
+	keys := make([]collections.Triple[sdk.AccAddress, string, uint64], 0)
+	for ; iter.Valid() && len(keys) < types.MaxBalanceChangesPrunedPerBlock; iter.Next() {
+		key, err := iter.PrimaryKey()
+		if err != nil {
+			return err
+		}
+		keys = append(keys, key)
+	}
+
+	for _, key := range keys {
+		if err := k.BalanceChanges.Remove(ctx, key); err != nil {
+			return err
+		}
+	}
+	return nil
+}
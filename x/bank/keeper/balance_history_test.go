@@ -0,0 +1,198 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktestutil "github.com/cosmos/cosmos-sdk/x/bank/testutil"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func (suite *KeeperTestSuite) TestBalanceHistory() {
+	ctx := sdk.UnwrapSDKContext(suite.ctx)
+	require := suite.Require()
+	acc0 := authtypes.NewBaseAccountWithAddress(accAddrs[0])
+
+	setHeight := func(height int64) {
+		ctx = ctx.WithBlockHeight(height)
+		suite.ctx = ctx
+	}
+
+	// changes are not logged while the balance history is disabled
+	setHeight(5)
+	suite.mockFundAccount(accAddrs[0])
+	require.NoError(banktestutil.FundAccount(ctx, suite.bankKeeper, accAddrs[0], sdk.NewCoins(sdk.NewInt64Coin("stake", 50))))
+
+	params := banktypes.DefaultParams()
+	params.BalanceHistoryEnabled = true
+	require.NoError(suite.bankKeeper.SetParams(ctx, params))
+
+	setHeight(10)
+	suite.mockFundAccount(accAddrs[0])
+	require.NoError(banktestutil.FundAccount(ctx, suite.bankKeeper, accAddrs[0], sdk.NewCoins(sdk.NewInt64Coin("stake", 50), sdk.NewInt64Coin("foo", 10))))
+
+	// only the last balance of a height is logged
+	setHeight(12)
+	for i := 0; i < 2; i++ {
+		suite.mockSendCoins(ctx, acc0, accAddrs[1])
+		require.NoError(suite.bankKeeper.SendCoins(ctx, accAddrs[0], accAddrs[1], sdk.NewCoins(sdk.NewInt64Coin("stake", 20))))
+	}
+
+	setHeight(15)
+	suite.mockSendCoins(ctx, acc0, accAddrs[1])
+	require.NoError(suite.bankKeeper.SendCoins(ctx, accAddrs[0], accAddrs[1], sdk.NewCoins(sdk.NewInt64Coin("stake", 60))))
+
+	testCases := []struct {
+		name       string
+		req        *banktypes.QueryBalanceHistoryRequest
+		expErr     bool
+		expChanges []banktypes.BalanceChange
+		expNextKey bool
+	}{
+		{
+			name:   "invalid address",
+			req:    &banktypes.QueryBalanceHistoryRequest{Address: "invalid", Denom: "stake"},
+			expErr: true,
+		},
+		{
+			name:   "invalid denom",
+			req:    &banktypes.QueryBalanceHistoryRequest{Address: accAddrs[0].String(), Denom: "!"},
+			expErr: true,
+		},
+		{
+			name:   "invalid height range",
+			req:    &banktypes.QueryBalanceHistoryRequest{Address: accAddrs[0].String(), Denom: "stake", StartHeight: 12, EndHeight: 10},
+			expErr: true,
+		},
+		{
+			name: "full history",
+			req:  &banktypes.QueryBalanceHistoryRequest{Address: accAddrs[0].String(), Denom: "stake"},
+			expChanges: []banktypes.BalanceChange{
+				{Height: 10, Balance: sdk.NewInt64Coin("stake", 100)},
+				{Height: 12, Balance: sdk.NewInt64Coin("stake", 60)},
+				{Height: 15, Balance: sdk.NewInt64Coin("stake", 0)},
+			},
+		},
+		{
+			name: "height range",
+			req:  &banktypes.QueryBalanceHistoryRequest{Address: accAddrs[0].String(), Denom: "stake", StartHeight: 11, EndHeight: 15},
+			expChanges: []banktypes.BalanceChange{
+				{Height: 12, Balance: sdk.NewInt64Coin("stake", 60)},
+				{Height: 15, Balance: sdk.NewInt64Coin("stake", 0)},
+			},
+		},
+		{
+			name: "other denom",
+			req:  &banktypes.QueryBalanceHistoryRequest{Address: accAddrs[0].String(), Denom: "foo"},
+			expChanges: []banktypes.BalanceChange{
+				{Height: 10, Balance: sdk.NewInt64Coin("foo", 10)},
+			},
+		},
+		{
+			name: "receiver",
+			req:  &banktypes.QueryBalanceHistoryRequest{Address: accAddrs[1].String(), Denom: "stake", EndHeight: 12},
+			expChanges: []banktypes.BalanceChange{
+				{Height: 12, Balance: sdk.NewInt64Coin("stake", 40)},
+			},
+		},
+		{
+			name: "paginated",
+			req: &banktypes.QueryBalanceHistoryRequest{
+				Address:    accAddrs[0].String(),
+				Denom:      "stake",
+				Pagination: &query.PageRequest{Limit: 2},
+			},
+			expChanges: []banktypes.BalanceChange{
+				{Height: 10, Balance: sdk.NewInt64Coin("stake", 100)},
+				{Height: 12, Balance: sdk.NewInt64Coin("stake", 60)},
+			},
+			expNextKey: true,
+		},
+		{
+			name: "paginated by key",
+			req: &banktypes.QueryBalanceHistoryRequest{
+				Address:    accAddrs[0].String(),
+				Denom:      "stake",
+				Pagination: &query.PageRequest{Key: sdk.Uint64ToBigEndian(15), Limit: 2},
+			},
+			expChanges: []banktypes.BalanceChange{
+				{Height: 15, Balance: sdk.NewInt64Coin("stake", 0)},
+			},
+		},
+		{
+			name: "reverse",
+			req: &banktypes.QueryBalanceHistoryRequest{
+				Address:    accAddrs[0].String(),
+				Denom:      "stake",
+				EndHeight:  14,
+				Pagination: &query.PageRequest{Limit: 1, Reverse: true},
+			},
+			expChanges: []banktypes.BalanceChange{
+				{Height: 12, Balance: sdk.NewInt64Coin("stake", 60)},
+			},
+			expNextKey: true,
+		},
+		{
+			name: "invalid page key",
+			req: &banktypes.QueryBalanceHistoryRequest{
+				Address:    accAddrs[0].String(),
+				Denom:      "stake",
+				Pagination: &query.PageRequest{Key: []byte{1}},
+			},
+			expErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			res, err := suite.queryClient.BalanceHistory(ctx, tc.req)
+			if tc.expErr {
+				suite.Require().Error(err)
+				return
+			}
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.expChanges, res.BalanceChanges)
+			suite.Require().Equal(tc.expNextKey, res.Pagination.NextKey != nil)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestPruneBalanceHistory() {
+	ctx := sdk.UnwrapSDKContext(suite.ctx)
+	require := suite.Require()
+
+	params := banktypes.DefaultParams()
+	params.BalanceHistoryEnabled = true
+	params.BalanceHistoryRetentionBlocks = 5
+	require.NoError(suite.bankKeeper.SetParams(ctx, params))
+
+	for _, height := range []int64{10, 12, 15} {
+		ctx = ctx.WithBlockHeight(height)
+		suite.ctx = ctx
+		suite.mockFundAccount(accAddrs[0])
+		require.NoError(banktestutil.FundAccount(ctx, suite.bankKeeper, accAddrs[0], sdk.NewCoins(sdk.NewInt64Coin("stake", 10))))
+	}
+
+	history := func() []banktypes.BalanceChange {
+		res, err := suite.queryClient.BalanceHistory(ctx, &banktypes.QueryBalanceHistoryRequest{Address: accAddrs[0].String(), Denom: "stake"})
+		require.NoError(err)
+		return res.BalanceChanges
+	}
+
+	// changes inside the retention period are kept
+	require.NoError(suite.bankKeeper.BeginBlocker(ctx.WithBlockHeight(14)))
+	require.Len(history(), 3)
+
+	// changes at or before height - retention are pruned
+	require.NoError(suite.bankKeeper.BeginBlocker(ctx.WithBlockHeight(17)))
+	require.Equal([]banktypes.BalanceChange{
+		{Height: 15, Balance: sdk.NewInt64Coin("stake", 30)},
+	}, history())
+
+	// a zero retention keeps the whole log
+	params.BalanceHistoryRetentionBlocks = 0
+	require.NoError(suite.bankKeeper.SetParams(ctx, params))
+	require.NoError(suite.bankKeeper.BeginBlocker(ctx.WithBlockHeight(100)))
+	require.Len(history(), 1)
+}
@@ -1,6 +1,10 @@
 package keeper
 
-import "github.com/cosmos/cosmos-sdk/x/bank/types"
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+)
 
 // This file exists in the keeper package to expose some private things
 // for the purpose of testing in the keeper_test package.
@@ -12,3 +16,7 @@ func (k BaseSendKeeper) SetSendRestriction(restriction types.SendRestrictionFn)
 func (k BaseSendKeeper) GetSendRestrictionFn() types.SendRestrictionFn {
 	return k.sendRestriction.fn
 }
+
+func (k BaseSendKeeper) ProcessHoldersIndex(ctx context.Context, gasLimit uint64) error {
+	return k.processHoldersIndex(ctx, gasLimit)
+}
@@ -0,0 +1,248 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// CreateFactoryDenom creates the factory denom factory/{creator}/{subdenom}
+// and makes the creator its admin. The denom creation fee set in the params is
+// burned from the creator's account.
+func (k BaseKeeper) CreateFactoryDenom(ctx context.Context, creator sdk.AccAddress, subdenom string) (string, error) {
+	creatorStr, err := k.ak.AddressCodec().BytesToString(creator)
+	if err != nil {
+		return "", err
+	}
+
+	denom, err := types.GetFactoryDenom(creatorStr, subdenom)
+	if err != nil {
+		return "", err
+	}
+
+	has, err := k.DenomAuthority.Has(ctx, denom)
+	if err != nil {
+		return "", err
+	}
+	if has || k.HasSupply(ctx, denom) {
+		return "", errorsmod.Wrapf(types.ErrFactoryDenomExists, "denom %s", denom)
+	}
+
+	if fee := k.GetParams(ctx).DenomCreationFee; !fee.IsZero() {
+		if err := k.burnAccountCoins(ctx, creator, fee); err != nil {
+			return "", errorsmod.Wrap(err, "unable to pay the denom creation fee")
+		}
+	}
+
+	if err := k.SetDenomAuthorityMetadata(ctx, denom, types.DenomAuthorityMetadata{Admin: creatorStr}); err != nil {
+		return "", err
+	}
+
+	if !k.HasDenomMetaData(ctx, denom) {
+		k.SetDenomMetaData(ctx, types.Metadata{
+			DenomUnits: []*types.DenomUnit{{Denom: denom, Exponent: 0}},
+			Base:       denom,
+			Display:    denom,
+		})
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCreateDenom,
+			sdk.NewAttribute(types.AttributeKeyCreator, creatorStr),
+			sdk.NewAttribute(types.AttributeKeyDenom, denom),
+		),
+	)
+
+	return denom, nil
+}
+
+// GetDenomAuthorityMetadata returns the authority metadata of a factory denom.
+func (k BaseKeeper) GetDenomAuthorityMetadata(ctx context.Context, denom string) (types.DenomAuthorityMetadata, error) {
+	metadata, err := k.DenomAuthority.Get(ctx, denom)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return types.DenomAuthorityMetadata{}, errorsmod.Wrapf(types.ErrInvalidFactoryDenom, "denom %s was not created through the denom factory", denom)
+		}
+		return types.DenomAuthorityMetadata{}, err
+	}
+
+	return metadata, nil
+}
+
+// SetDenomAuthorityMetadata sets the authority metadata of a factory denom and
+// indexes the denom by its creator.
+func (k BaseKeeper) SetDenomAuthorityMetadata(ctx context.Context, denom string, metadata types.DenomAuthorityMetadata) error {
+	creator, _, err := types.DeconstructFactoryDenom(denom)
+	if err != nil {
+		return err
+	}
+
+	if err := k.DenomAuthority.Set(ctx, denom, metadata); err != nil {
+		return err
+	}
+
+	return k.DenomsByCreator.Set(ctx, collections.Join(creator, denom))
+}
+
+// GetAllFactoryDenoms returns all the factory denoms along with their
+// authority metadata.
+func (k BaseKeeper) GetAllFactoryDenoms(ctx context.Context) []types.FactoryDenom {
+	factoryDenoms := make([]types.FactoryDenom, 0)
+	err := k.DenomAuthority.Walk(ctx, nil, func(denom string, metadata types.DenomAuthorityMetadata) (stop bool, err error) {
+		factoryDenoms = append(factoryDenoms, types.FactoryDenom{Denom: denom, AuthorityMetadata: metadata})
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	return factoryDenoms
+}
+
+// ChangeFactoryDenomAdmin transfers the administration of a factory denom
+// from admin to newAdmin. An empty newAdmin leaves the denom without admin.
+func (k BaseKeeper) ChangeFactoryDenomAdmin(ctx context.Context, admin sdk.AccAddress, denom, newAdmin string) error {
+	if err := k.assertFactoryDenomAdmin(ctx, admin, denom); err != nil {
+		return err
+	}
+
+	if err := k.DenomAuthority.Set(ctx, denom, types.DenomAuthorityMetadata{Admin: newAdmin}); err != nil {
+		return err
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeChangeAdmin,
+			sdk.NewAttribute(types.AttributeKeyDenom, denom),
+			sdk.NewAttribute(types.AttributeKeyNewAdmin, newAdmin),
+		),
+	)
+
+	return nil
+}
+
+// SetFactoryDenomMetadata sets the client metadata of the factory denom
+// metadata.Base on behalf of its admin.
+func (k BaseKeeper) SetFactoryDenomMetadata(ctx context.Context, admin sdk.AccAddress, metadata types.Metadata) error {
+	if err := k.assertFactoryDenomAdmin(ctx, admin, metadata.Base); err != nil {
+		return err
+	}
+
+	if err := metadata.Validate(); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	k.SetDenomMetaData(ctx, metadata)
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSetDenomMetadata,
+			sdk.NewAttribute(types.AttributeKeyDenom, metadata.Base),
+		),
+	)
+
+	return nil
+}
+
+// MintFactoryCoins mints amount of a factory denom on behalf of its admin and
+// sends it to recipient. The minting restriction of the keeper is applied to
+// the minted coins, and the send restrictions are applied to the transfer from
+// the admin to the recipient.
+func (k BaseKeeper) MintFactoryCoins(ctx context.Context, admin sdk.AccAddress, amount sdk.Coin, recipient sdk.AccAddress) error {
+	if err := k.assertFactoryDenomAdmin(ctx, admin, amount.Denom); err != nil {
+		return err
+	}
+
+	amounts := sdk.NewCoins(amount)
+	if !amounts.IsValid() || amounts.Empty() {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, amount.String())
+	}
+
+	if err := k.mintCoinsRestrictionFn(ctx, amounts); err != nil {
+		return err
+	}
+
+	if err := k.addCoins(ctx, admin, amounts); err != nil {
+		return err
+	}
+
+	supply := k.GetSupply(ctx, amount.Denom)
+	k.setSupply(ctx, supply.Add(amount))
+
+	k.logger.Debug("minted factory coins", "amount", amounts.String(), "admin", admin.String())
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		types.NewCoinMintEvent(admin, amounts),
+	)
+
+	if admin.Equals(recipient) {
+		return nil
+	}
+
+	return k.SendCoins(ctx, admin, recipient, amounts)
+}
+
+// BurnFactoryCoins burns amounts of factory denoms from the balance of their
+// admin.
+func (k BaseKeeper) BurnFactoryCoins(ctx context.Context, admin sdk.AccAddress, amounts sdk.Coins) error {
+	if !amounts.IsValid() || amounts.Empty() {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, amounts.String())
+	}
+
+	for _, amount := range amounts {
+		if err := k.assertFactoryDenomAdmin(ctx, admin, amount.Denom); err != nil {
+			return err
+		}
+	}
+
+	return k.burnAccountCoins(ctx, admin, amounts)
+}
+
+// assertFactoryDenomAdmin returns an error if admin is not the admin of the
+// factory denom.
+func (k BaseKeeper) assertFactoryDenomAdmin(ctx context.Context, admin sdk.AccAddress, denom string) error {
+	metadata, err := k.GetDenomAuthorityMetadata(ctx, denom)
+	if err != nil {
+		return err
+	}
+
+	adminStr, err := k.ak.AddressCodec().BytesToString(admin)
+	if err != nil {
+		return err
+	}
+
+	if metadata.Admin == "" || metadata.Admin != adminStr {
+		return errorsmod.Wrapf(types.ErrUnauthorizedAdmin, "%s is not the admin of %s", adminStr, denom)
+	}
+
+	return nil
+}
+
+// burnAccountCoins removes amounts from the balance of addr and from the
+// supply.
+func (k BaseKeeper) burnAccountCoins(ctx context.Context, addr sdk.AccAddress, amounts sdk.Coins) error {
+	if err := k.subUnlockedCoins(ctx, addr, amounts); err != nil {
+		return err
+	}
+
+	for _, amount := range amounts {
+		supply := k.GetSupply(ctx, amount.GetDenom())
+		supply = supply.Sub(amount)
+		k.setSupply(ctx, supply)
+	}
+
+	k.logger.Debug("burned tokens from account", "amount", amounts.String(), "from", addr.String())
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		types.NewCoinBurnEvent(addr, amounts),
+	)
+
+	return nil
+}
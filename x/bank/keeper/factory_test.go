@@ -0,0 +1,166 @@
+package keeper_test
+
+import (
+	"context"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	banktestutil "github.com/cosmos/cosmos-sdk/x/bank/testutil"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func (suite *KeeperTestSuite) TestCreateFactoryDenom() {
+	ctx := suite.ctx
+	require := suite.Require()
+
+	fee := sdk.NewCoins(sdk.NewInt64Coin("stake", 10))
+	params := banktypes.DefaultParams()
+	params.DenomCreationFee = fee
+	require.NoError(suite.bankKeeper.SetParams(ctx, params))
+
+	creator := authtypes.NewBaseAccountWithAddress(accAddrs[0])
+	suite.mockFundAccount(accAddrs[0])
+	require.NoError(banktestutil.FundAccount(ctx, suite.bankKeeper, accAddrs[0], sdk.NewCoins(sdk.NewInt64Coin("stake", 15))))
+	supplyBefore := suite.bankKeeper.GetSupply(ctx, "stake")
+
+	suite.authKeeper.EXPECT().GetAccount(ctx, accAddrs[0]).Return(creator)
+	res, err := suite.msgServer.CreateDenom(ctx, banktypes.NewMsgCreateDenom(accAddrs[0].String(), "bitcoin"))
+	require.NoError(err)
+
+	expDenom := fmt.Sprintf("factory/%s/bitcoin", accAddrs[0])
+	require.Equal(expDenom, res.NewTokenDenom)
+
+	// the creation fee is burned
+	require.Equal(sdk.NewInt64Coin("stake", 5), suite.bankKeeper.GetBalance(ctx, accAddrs[0], "stake"))
+	require.Equal(supplyBefore.SubAmount(fee.AmountOf("stake")), suite.bankKeeper.GetSupply(ctx, "stake"))
+
+	authority, err := suite.bankKeeper.GetDenomAuthorityMetadata(ctx, expDenom)
+	require.NoError(err)
+	require.Equal(accAddrs[0].String(), authority.Admin)
+
+	metadata, found := suite.bankKeeper.GetDenomMetaData(ctx, expDenom)
+	require.True(found)
+	require.Equal(expDenom, metadata.Base)
+
+	// the denom already exists
+	_, err = suite.msgServer.CreateDenom(ctx, banktypes.NewMsgCreateDenom(accAddrs[0].String(), "bitcoin"))
+	require.ErrorIs(err, banktypes.ErrFactoryDenomExists)
+
+	// the creator cannot pay the creation fee
+	suite.authKeeper.EXPECT().GetAccount(ctx, accAddrs[0]).Return(creator)
+	_, err = suite.msgServer.CreateDenom(ctx, banktypes.NewMsgCreateDenom(accAddrs[0].String(), "litecoin"))
+	require.ErrorContains(err, "unable to pay the denom creation fee")
+
+	// invalid subdenom
+	_, err = suite.msgServer.CreateDenom(ctx, banktypes.NewMsgCreateDenom(accAddrs[0].String(), "bit%coin"))
+	require.ErrorIs(err, banktypes.ErrInvalidFactoryDenom)
+}
+
+func (suite *KeeperTestSuite) TestMintBurnFactoryDenom() {
+	ctx := suite.ctx
+	require := suite.Require()
+
+	admin := authtypes.NewBaseAccountWithAddress(accAddrs[0])
+	res, err := suite.msgServer.CreateDenom(ctx, banktypes.NewMsgCreateDenom(accAddrs[0].String(), "bitcoin"))
+	require.NoError(err)
+	denom := res.NewTokenDenom
+	suite.bankKeeper.SetSendEnabled(ctx, denom, true)
+
+	// only the admin can mint
+	_, err = suite.msgServer.Mint(ctx, &banktypes.MsgMint{Sender: accAddrs[1].String(), Amount: sdk.NewInt64Coin(denom, 100)})
+	require.ErrorIs(err, banktypes.ErrUnauthorizedAdmin)
+
+	// denoms not created through the factory cannot be minted
+	_, err = suite.msgServer.Mint(ctx, &banktypes.MsgMint{Sender: accAddrs[0].String(), Amount: sdk.NewInt64Coin("stake", 100)})
+	require.ErrorIs(err, banktypes.ErrInvalidFactoryDenom)
+
+	// mint to the admin
+	_, err = suite.msgServer.Mint(ctx, banktypes.NewMsgMint(accAddrs[0].String(), sdk.NewInt64Coin(denom, 100), ""))
+	require.NoError(err)
+	require.Equal(sdk.NewInt64Coin(denom, 100), suite.bankKeeper.GetBalance(ctx, accAddrs[0], denom))
+
+	// mint to another account
+	suite.mockSendCoins(ctx, admin, accAddrs[1])
+	_, err = suite.msgServer.Mint(ctx, banktypes.NewMsgMint(accAddrs[0].String(), sdk.NewInt64Coin(denom, 50), accAddrs[1].String()))
+	require.NoError(err)
+	require.Equal(sdk.NewInt64Coin(denom, 100), suite.bankKeeper.GetBalance(ctx, accAddrs[0], denom))
+	require.Equal(sdk.NewInt64Coin(denom, 50), suite.bankKeeper.GetBalance(ctx, accAddrs[1], denom))
+	require.Equal(sdk.NewInt64Coin(denom, 150), suite.bankKeeper.GetSupply(ctx, denom))
+
+	// blocked addresses cannot receive minted coins
+	_, err = suite.msgServer.Mint(ctx, banktypes.NewMsgMint(accAddrs[0].String(), sdk.NewInt64Coin(denom, 50), accAddrs[4].String()))
+	require.ErrorContains(err, "is not allowed to receive funds")
+
+	// the minting restriction applies to factory denoms
+	restricted := keeper.NewMsgServerImpl(suite.bankKeeper.WithMintCoinsRestriction(func(_ context.Context, coins sdk.Coins) error {
+		return fmt.Errorf("cannot mint %s", coins)
+	}))
+	_, err = restricted.Mint(ctx, banktypes.NewMsgMint(accAddrs[0].String(), sdk.NewInt64Coin(denom, 50), ""))
+	require.ErrorContains(err, "cannot mint")
+
+	// only the admin can burn
+	_, err = suite.msgServer.Burn(ctx, banktypes.NewMsgBurn(accAddrs[1].String(), sdk.NewCoins(sdk.NewInt64Coin(denom, 50))))
+	require.ErrorIs(err, banktypes.ErrUnauthorizedAdmin)
+
+	suite.authKeeper.EXPECT().GetAccount(ctx, accAddrs[0]).Return(admin)
+	_, err = suite.msgServer.Burn(ctx, banktypes.NewMsgBurn(accAddrs[0].String(), sdk.NewCoins(sdk.NewInt64Coin(denom, 60))))
+	require.NoError(err)
+	require.Equal(sdk.NewInt64Coin(denom, 40), suite.bankKeeper.GetBalance(ctx, accAddrs[0], denom))
+	require.Equal(sdk.NewInt64Coin(denom, 90), suite.bankKeeper.GetSupply(ctx, denom))
+}
+
+func (suite *KeeperTestSuite) TestChangeFactoryDenomAdmin() {
+	ctx := suite.ctx
+	require := suite.Require()
+
+	res, err := suite.msgServer.CreateDenom(ctx, banktypes.NewMsgCreateDenom(accAddrs[0].String(), "bitcoin"))
+	require.NoError(err)
+	denom := res.NewTokenDenom
+
+	_, err = suite.msgServer.ChangeAdmin(ctx, banktypes.NewMsgChangeAdmin(accAddrs[1].String(), denom, accAddrs[1].String()))
+	require.ErrorIs(err, banktypes.ErrUnauthorizedAdmin)
+
+	_, err = suite.msgServer.ChangeAdmin(ctx, banktypes.NewMsgChangeAdmin(accAddrs[0].String(), denom, "invalid"))
+	require.Error(err)
+
+	_, err = suite.msgServer.ChangeAdmin(ctx, banktypes.NewMsgChangeAdmin(accAddrs[0].String(), denom, accAddrs[1].String()))
+	require.NoError(err)
+
+	authority, err := suite.bankKeeper.GetDenomAuthorityMetadata(ctx, denom)
+	require.NoError(err)
+	require.Equal(accAddrs[1].String(), authority.Admin)
+
+	// the previous admin lost its rights
+	_, err = suite.msgServer.Mint(ctx, banktypes.NewMsgMint(accAddrs[0].String(), sdk.NewInt64Coin(denom, 100), ""))
+	require.ErrorIs(err, banktypes.ErrUnauthorizedAdmin)
+
+	metadata := banktypes.Metadata{
+		Description: "The native token of the bitcoin factory",
+		DenomUnits: []*banktypes.DenomUnit{
+			{Denom: denom, Exponent: 0},
+			{Denom: "btc", Exponent: 8},
+		},
+		Base:    denom,
+		Display: "btc",
+		Name:    "Bitcoin",
+		Symbol:  "BTC",
+	}
+	_, err = suite.msgServer.SetDenomMetadata(ctx, banktypes.NewMsgSetDenomMetadata(accAddrs[0].String(), metadata))
+	require.ErrorIs(err, banktypes.ErrUnauthorizedAdmin)
+
+	_, err = suite.msgServer.SetDenomMetadata(ctx, banktypes.NewMsgSetDenomMetadata(accAddrs[1].String(), metadata))
+	require.NoError(err)
+
+	actual, found := suite.bankKeeper.GetDenomMetaData(ctx, denom)
+	require.True(found)
+	require.Equal(metadata, actual)
+
+	// renouncing the administration of the denom
+	_, err = suite.msgServer.ChangeAdmin(ctx, banktypes.NewMsgChangeAdmin(accAddrs[1].String(), denom, ""))
+	require.NoError(err)
+
+	_, err = suite.msgServer.Mint(ctx, banktypes.NewMsgMint(accAddrs[1].String(), sdk.NewInt64Coin(denom, 100), ""))
+	require.ErrorIs(err, banktypes.ErrUnauthorizedAdmin)
+}
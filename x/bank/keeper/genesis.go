@@ -3,6 +3,7 @@ package keeper
 import (
 	"context"
 	"fmt"
+	"math"
 
 	"cosmossdk.io/collections"
 
@@ -40,6 +41,12 @@ func (k BaseKeeper) InitGenesis(ctx context.Context, genState *types.GenesisStat
 
 		totalSupplyMap.Add(balance.Coins...)
 	}
+
+	// balances are imported without going through setBalance, so the top
+	// holders index has to be built from them, at once.
+	if err := k.processHoldersIndex(ctx, math.MaxUint64); err != nil {
+		panic(err)
+	}
 	totalSupply := totalSupplyMap.ToCoins()
 
 	if !genState.Supply.Empty() && !genState.Supply.Equal(totalSupply) {
@@ -53,6 +60,27 @@ func (k BaseKeeper) InitGenesis(ctx context.Context, genState *types.GenesisStat
 	for _, meta := range genState.DenomMetadata {
 		k.SetDenomMetaData(ctx, meta)
 	}
+
+	for _, factoryDenom := range genState.FactoryDenoms {
+		if err := k.SetDenomAuthorityMetadata(ctx, factoryDenom.Denom, factoryDenom.AuthorityMetadata); err != nil {
+			panic(err)
+		}
+	}
+
+	for _, stream := range genState.Streams {
+		if err := k.PaymentStreams.Set(ctx, stream.Id, stream); err != nil {
+			panic(err)
+		}
+	}
+	if err := k.StreamSequence.Set(ctx, genState.NextStreamId); err != nil {
+		panic(err)
+	}
+
+	for _, attachment := range genState.SendHooks {
+		if err := k.AttachSendHooks(ctx, attachment); err != nil {
+			panic(err)
+		}
+	}
 }
 
 // ExportGenesis returns the bank module's genesis state.
@@ -69,5 +97,14 @@ func (k BaseKeeper) ExportGenesis(ctx context.Context) *types.GenesisState {
 		k.GetAllDenomMetaData(ctx),
 		k.GetAllSendEnabledEntries(ctx),
 	)
+	rv.FactoryDenoms = k.GetAllFactoryDenoms(ctx)
+	rv.Streams = k.GetAllStreams(ctx)
+
+	nextStreamID, err := k.StreamSequence.Peek(ctx)
+	if err != nil {
+		panic(err)
+	}
+	rv.NextStreamId = nextStreamID
+	rv.SendHooks = k.GetAllSendHooksAttachments(ctx)
 	return rv
 }
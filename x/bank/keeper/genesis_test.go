@@ -1,6 +1,8 @@
 package keeper_test
 
 import (
+	"time"
+
 	sdkmath "cosmossdk.io/math"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -48,6 +50,84 @@ func (suite *KeeperTestSuite) TestExportGenesis() {
 	suite.Require().Equal(expectedMetadata, exportGenesis.DenomMetadata)
 }
 
+func (suite *KeeperTestSuite) TestFactoryDenomsGenesis() {
+	ctx := suite.ctx
+
+	genesis := types.DefaultGenesisState()
+	genesis.FactoryDenoms = []types.FactoryDenom{
+		{
+			Denom:             "factory/" + accAddrs[0].String() + "/bitcoin",
+			AuthorityMetadata: types.DenomAuthorityMetadata{Admin: accAddrs[1].String()},
+		},
+		{
+			Denom: "factory/" + accAddrs[1].String() + "/litecoin",
+		},
+	}
+	suite.bankKeeper.InitGenesis(ctx, genesis)
+
+	authority, err := suite.bankKeeper.GetDenomAuthorityMetadata(ctx, genesis.FactoryDenoms[0].Denom)
+	suite.Require().NoError(err)
+	suite.Require().Equal(accAddrs[1].String(), authority.Admin)
+
+	res, err := suite.queryClient.DenomsFromCreator(ctx, &types.QueryDenomsFromCreatorRequest{Creator: accAddrs[1].String()})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]string{genesis.FactoryDenoms[1].Denom}, res.Denoms)
+
+	exportGenesis := suite.bankKeeper.ExportGenesis(ctx)
+	suite.Require().ElementsMatch(genesis.FactoryDenoms, exportGenesis.FactoryDenoms)
+}
+
+func (suite *KeeperTestSuite) TestTopHoldersGenesis() {
+	ctx := suite.ctx
+
+	balances, supply := suite.getTestBalancesAndSupply()
+	genesis := types.DefaultGenesisState()
+	genesis.Params.TopHoldersIndexEnabled = true
+	genesis.Balances = balances
+	genesis.Supply = supply
+	suite.bankKeeper.InitGenesis(ctx, genesis)
+
+	holders, err := suite.bankKeeper.GetTopHolders(ctx, "testcoin1", types.DefaultTopHoldersLimit)
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.DenomOwner{{Address: balances[0].Address, Balance: sdk.NewInt64Coin("testcoin1", 32)}}, holders)
+
+	count, err := suite.bankKeeper.GetHolderCount(ctx, "testcoin3")
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(1), count)
+}
+
+func (suite *KeeperTestSuite) TestStreamsGenesis() {
+	ctx := sdk.UnwrapSDKContext(suite.ctx)
+
+	genesis := types.DefaultGenesisState()
+	genesis.Streams = []types.Stream{
+		{
+			Id:          2,
+			Sender:      accAddrs[0].String(),
+			Recipient:   accAddrs[1].String(),
+			Deposit:     sdk.NewInt64Coin("stake", 100),
+			Withdrawn:   sdk.NewInt64Coin("stake", 10),
+			StartTime:   ctx.BlockTime().UTC(),
+			EndTime:     ctx.BlockTime().Add(time.Hour).UTC(),
+			SettledTime: ctx.BlockTime().UTC(),
+		},
+	}
+	genesis.NextStreamId = 5
+	suite.bankKeeper.InitGenesis(ctx, genesis)
+
+	stream, err := suite.bankKeeper.GetStream(ctx, 2)
+	suite.Require().NoError(err)
+	suite.Require().Equal(genesis.Streams[0], stream)
+
+	nextStreamID, err := suite.bankKeeper.StreamSequence.Peek(ctx)
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(5), nextStreamID)
+
+	exportGenesis := suite.bankKeeper.ExportGenesis(ctx)
+	suite.Require().Equal(genesis.Streams, exportGenesis.Streams)
+	suite.Require().Equal(genesis.NextStreamId, exportGenesis.NextStreamId)
+}
+
 func (suite *KeeperTestSuite) getTestBalancesAndSupply() ([]types.Balance, sdk.Coins) {
 	addr2, _ := sdk.AccAddressFromBech32("cosmos1f9xjhxm0plzrh9cskf4qee4pc2xwp0n0556gh0")
 	addr1, _ := sdk.AccAddressFromBech32("cosmos1t5u0jfg3ljsjrh2m9e47d4ny2hea7eehxrzdgd")
@@ -2,6 +2,8 @@ package keeper
 
 import (
 	"context"
+	"errors"
+	gomath "math"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -316,3 +318,209 @@ func (k BaseKeeper) DenomOwnersByQuery(ctx context.Context, req *types.QueryDeno
 
 	return &types.QueryDenomOwnersByQueryResponse{DenomOwners: resp.DenomOwners, Pagination: resp.Pagination}, nil
 }
+
+// DenomAuthorityMetadata implements the Query/DenomAuthorityMetadata gRPC method.
+func (k BaseKeeper) DenomAuthorityMetadata(ctx context.Context, req *types.QueryDenomAuthorityMetadataRequest) (*types.QueryDenomAuthorityMetadataResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	if _, _, err := types.DeconstructFactoryDenom(req.Denom); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	metadata, err := k.DenomAuthority.Get(ctx, req.Denom)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, status.Errorf(codes.NotFound, "authority metadata for denom %s not found", req.Denom)
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryDenomAuthorityMetadataResponse{AuthorityMetadata: metadata}, nil
+}
+
+// DenomsFromCreator implements the Query/DenomsFromCreator gRPC method.
+func (k BaseKeeper) DenomsFromCreator(ctx context.Context, req *types.QueryDenomsFromCreatorRequest) (*types.QueryDenomsFromCreatorResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	if _, err := k.ak.AddressCodec().StringToBytes(req.Creator); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	denoms, pageRes, err := query.CollectionPaginate(
+		ctx,
+		k.DenomsByCreator,
+		req.Pagination,
+		func(key collections.Pair[string, string], _ collections.NoValue) (string, error) {
+			return key.K2(), nil
+		},
+		query.WithCollectionPaginationPairPrefix[string, string](req.Creator),
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryDenomsFromCreatorResponse{Denoms: denoms, Pagination: pageRes}, nil
+}
+
+// SendHooks implements the Query/SendHooks gRPC method.
+func (k BaseKeeper) SendHooks(ctx context.Context, req *types.QuerySendHooksRequest) (*types.QuerySendHooksResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	if req.Denom != "" {
+		if err := sdk.ValidateDenom(req.Denom); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+
+	hooks, err := k.GetSendHooks(ctx, req.Denom)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QuerySendHooksResponse{SendHooks: hooks}, nil
+}
+
+// BalanceHistory implements the Query/BalanceHistory gRPC method.
+func (k BaseKeeper) BalanceHistory(ctx context.Context, req *types.QueryBalanceHistoryRequest) (*types.QueryBalanceHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := sdk.ValidateDenom(req.Denom); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	addr, err := k.ak.AddressCodec().StringToBytes(req.Address)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid address: %s", err.Error())
+	}
+
+	if req.StartHeight < 0 || req.EndHeight < 0 || (req.EndHeight != 0 && req.EndHeight < req.StartHeight) {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid height range [%d, %d]", req.StartHeight, req.EndHeight)
+	}
+
+	endHeight := uint64(req.EndHeight)
+	if endHeight == 0 {
+		endHeight = gomath.MaxUint64
+	}
+
+	changes, pageRes, err := k.GetBalanceHistory(ctx, addr, req.Denom, uint64(req.StartHeight), endHeight, req.Pagination)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "paginate: %v", err)
+	}
+
+	return &types.QueryBalanceHistoryResponse{BalanceChanges: changes, Pagination: pageRes}, nil
+}
+
+// TopHolders implements the Query/TopHolders gRPC method
+func (k BaseKeeper) TopHolders(ctx context.Context, req *types.QueryTopHoldersRequest) (*types.QueryTopHoldersResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := sdk.ValidateDenom(req.Denom); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	limit := int(req.Limit)
+	if limit == 0 {
+		limit = types.DefaultTopHoldersLimit
+	}
+	if limit > types.MaxTopHoldersLimit {
+		return nil, status.Errorf(codes.InvalidArgument, "limit %d exceeds the maximum of %d", limit, types.MaxTopHoldersLimit)
+	}
+
+	if !k.GetParams(ctx).TopHoldersIndexEnabled {
+		return nil, status.Error(codes.FailedPrecondition, "top holders index is disabled")
+	}
+	ready, err := k.IsHoldersIndexReady(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if !ready {
+		return nil, status.Error(codes.FailedPrecondition, "top holders index is not ready")
+	}
+
+	holders, err := k.GetTopHolders(ctx, req.Denom, limit)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryTopHoldersResponse{Holders: holders}, nil
+}
+
+// HolderCount implements the Query/HolderCount gRPC method
+func (k BaseKeeper) HolderCount(ctx context.Context, req *types.QueryHolderCountRequest) (*types.QueryHolderCountResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := sdk.ValidateDenom(req.Denom); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if !k.GetParams(ctx).TopHoldersIndexEnabled {
+		return nil, status.Error(codes.FailedPrecondition, "top holders index is disabled")
+	}
+	ready, err := k.IsHoldersIndexReady(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if !ready {
+		return nil, status.Error(codes.FailedPrecondition, "top holders index is not ready")
+	}
+
+	count, err := k.GetHolderCount(ctx, req.Denom)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryHolderCountResponse{Count: count}, nil
+}
+
+// Stream implements the Query/Stream gRPC method
+func (k BaseKeeper) Stream(ctx context.Context, req *types.QueryStreamRequest) (*types.QueryStreamResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	stream, err := k.GetStream(ctx, req.StreamId)
+	if err != nil {
+		if errors.Is(err, types.ErrStreamNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	now := sdk.UnwrapSDKContext(ctx).BlockTime()
+	withdrawable := sdk.NewCoin(stream.Deposit.Denom, stream.Streamed(now))
+
+	return &types.QueryStreamResponse{Stream: stream, Withdrawable: withdrawable}, nil
+}
+
+// Streams implements the Query/Streams gRPC method
+func (k BaseKeeper) Streams(ctx context.Context, req *types.QueryStreamsRequest) (*types.QueryStreamsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	streams, pageRes, err := query.CollectionPaginate(
+		ctx,
+		k.PaymentStreams,
+		req.Pagination,
+		func(_ uint64, stream types.Stream) (types.Stream, error) {
+			return stream, nil
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryStreamsResponse{Streams: streams, Pagination: pageRes}, nil
+}
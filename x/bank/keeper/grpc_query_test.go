@@ -913,3 +913,46 @@ func (suite *KeeperTestSuite) TestGRPCDenomOwnersByQuery() {
 
 	suite.Require().True(true)
 }
+
+func (suite *KeeperTestSuite) TestQueryFactoryDenoms() {
+	ctx, queryClient := suite.ctx, suite.queryClient
+
+	var denoms []string
+	for _, subdenom := range []string{"bitcoin", "litecoin", "dogecoin"} {
+		denom, err := suite.bankKeeper.CreateFactoryDenom(ctx, accAddrs[0], subdenom)
+		suite.Require().NoError(err)
+		denoms = append(denoms, denom)
+	}
+	_, err := suite.bankKeeper.CreateFactoryDenom(ctx, accAddrs[1], "bitcoin")
+	suite.Require().NoError(err)
+
+	_, err = queryClient.DenomAuthorityMetadata(ctx, &types.QueryDenomAuthorityMetadataRequest{Denom: "stake"})
+	suite.Require().Error(err)
+
+	_, err = queryClient.DenomAuthorityMetadata(ctx, &types.QueryDenomAuthorityMetadataRequest{Denom: "factory/" + accAddrs[2].String() + "/bitcoin"})
+	suite.Require().Error(err)
+
+	authorityRes, err := queryClient.DenomAuthorityMetadata(ctx, &types.QueryDenomAuthorityMetadataRequest{Denom: denoms[0]})
+	suite.Require().NoError(err)
+	suite.Require().Equal(accAddrs[0].String(), authorityRes.AuthorityMetadata.Admin)
+
+	_, err = queryClient.DenomsFromCreator(ctx, &types.QueryDenomsFromCreatorRequest{Creator: "invalid"})
+	suite.Require().Error(err)
+
+	denomsRes, err := queryClient.DenomsFromCreator(ctx, &types.QueryDenomsFromCreatorRequest{
+		Creator:    accAddrs[0].String(),
+		Pagination: &query.PageRequest{Limit: 2, CountTotal: true},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(denomsRes.Denoms, 2)
+	suite.Require().NotNil(denomsRes.Pagination.NextKey)
+	suite.Require().Equal(uint64(3), denomsRes.Pagination.Total)
+
+	denomsRes, err = queryClient.DenomsFromCreator(ctx, &types.QueryDenomsFromCreatorRequest{
+		Creator:    accAddrs[0].String(),
+		Pagination: &query.PageRequest{Key: denomsRes.Pagination.NextKey},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(denomsRes.Denoms, 1)
+	suite.Require().Nil(denomsRes.Pagination.NextKey)
+}
@@ -0,0 +1,287 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// GetTopHolders returns up to limit holders of denom, by descending balance.
+// It relies on the top holders index, which is only complete once
+// IsHoldersIndexReady returns true.
+func (k BaseViewKeeper) GetTopHolders(ctx context.Context, denom string, limit int) ([]types.DenomOwner, error) {
+	iter, err := k.Holders.Iterate(ctx, collections.NewPrefixedPairRange[string, collections.Pair[math.Int, sdk.AccAddress]](denom).Descending())
+	if err != nil {
+		return nil, err
+	}
+	defer iter.Close()
+
+	holders := make([]types.DenomOwner, 0, limit)
+	for ; iter.Valid() && len(holders) < limit; iter.Next() {
+		pk, err := iter.PrimaryKey()
+		if err != nil {
+			return nil, err
+		}
+		holders = append(holders, types.DenomOwner{
+			Address: pk.K2().String(),
+			Balance: sdk.NewCoin(denom, pk.K1()),
+		})
+	}
+
+	return holders, nil
+}
+
+// GetHolderCount returns the number of accounts with a non-zero balance of
+// denom. It relies on the top holders index, which is only complete once
+// IsHoldersIndexReady returns true.
+func (k BaseViewKeeper) GetHolderCount(ctx context.Context, denom string) (uint64, error) {
+	count, err := k.HolderCount.Get(ctx, denom)
+	if errors.Is(err, collections.ErrNotFound) {
+		return 0, nil
+	}
+	return count, err
+}
+
+// updateHoldersIndex moves addr in the holders index of balance.Denom from its
+// current balance to the provided one. It must be called before the balance is
+// persisted.
+func (k BaseSendKeeper) updateHoldersIndex(ctx context.Context, addr sdk.AccAddress, balance sdk.Coin) error {
+	current := k.GetBalance(ctx, addr, balance.Denom)
+
+	if !current.IsZero() {
+		err := k.Holders.Unreference(ctx, collections.Join(current.Amount, addr), func() (sdk.Coin, error) {
+			return current, nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	if !balance.IsZero() {
+		err := k.Holders.Reference(ctx, collections.Join(balance.Amount, addr), balance, func() (sdk.Coin, error) {
+			// the current balance, if any, has already been unreferenced
+			return sdk.Coin{}, collections.ErrNotFound
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	switch {
+	case current.IsZero() && !balance.IsZero():
+		return k.addHolderCount(ctx, balance.Denom, 1)
+	case !current.IsZero() && balance.IsZero():
+		return k.addHolderCount(ctx, balance.Denom, -1)
+	default:
+		return nil
+	}
+}
+
+// addHolderCount adds delta to the holder count of denom, removing the count
+// once it reaches zero.
+func (k BaseSendKeeper) addHolderCount(ctx context.Context, denom string, delta int64) error {
+	count, err := k.GetHolderCount(ctx, denom)
+	if err != nil {
+		return err
+	}
+
+	count = uint64(int64(count) + delta)
+	if count == 0 {
+		return k.HolderCount.Remove(ctx, denom)
+	}
+	return k.HolderCount.Set(ctx, denom, count)
+}
+
+// IsHoldersIndexReady returns whether the top holders index is enabled and
+// covers all the balances. The index is not ready while it is being built in
+// batches after having been enabled.
+func (k BaseViewKeeper) IsHoldersIndexReady(ctx context.Context) (bool, error) {
+	params, err := k.Params.Get(ctx)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return false, err
+	}
+	if !params.TopHoldersIndexEnabled {
+		return false, nil
+	}
+
+	building, err := k.HoldersIndexProgress.Has(ctx)
+	return !building, err
+}
+
+// isHolderIndexed returns whether the balance of addr for denom is already in
+// the top holders index, so that its updates must be indexed too. While the
+// index is built, only the balances up to the last one indexed are.
+func (k BaseSendKeeper) isHolderIndexed(ctx context.Context, addr sdk.AccAddress, denom string) (bool, error) {
+	progress, err := k.HoldersIndexProgress.Get(ctx)
+	if errors.Is(err, collections.ErrNotFound) {
+		return true, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	if progress.Dropping || progress.LastAddress == nil {
+		return false, nil
+	}
+
+	kc := k.Balances.KeyCodec()
+	key, err := collections.EncodeKeyWithPrefix(nil, kc, collections.Join(addr, denom))
+	if err != nil {
+		return false, err
+	}
+	last, err := collections.EncodeKeyWithPrefix(nil, kc, collections.Join(progress.LastAddress, progress.LastDenom))
+	if err != nil {
+		return false, err
+	}
+	return bytes.Compare(key, last) <= 0, nil
+}
+
+// processHoldersIndex continues to drop, and then to build when enabled, the
+// top holders index, until gasLimit is consumed. The progress is persisted so
+// that the next call resumes from the last entry processed. At least one entry
+// is processed per call, so that the index progresses whatever the gas limit.
+func (k BaseSendKeeper) processHoldersIndex(ctx context.Context, gasLimit uint64) error {
+	progress, err := k.HoldersIndexProgress.Get(ctx)
+	if errors.Is(err, collections.ErrNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	gasMeter := storetypes.NewInfiniteGasMeter()
+	ctx = sdk.UnwrapSDKContext(ctx).WithGasMeter(gasMeter)
+	outOfGas := func() bool { return gasMeter.GasConsumed() >= gasLimit }
+
+	if progress.Dropping {
+		done, err := k.dropHoldersIndex(ctx, outOfGas)
+		if err != nil || !done {
+			return err
+		}
+		if !k.GetParams(ctx).TopHoldersIndexEnabled {
+			return k.HoldersIndexProgress.Remove(ctx)
+		}
+		progress = types.HoldersIndexProgress{}
+	}
+
+	done, err := k.buildHoldersIndex(ctx, &progress, outOfGas)
+	if err != nil {
+		return err
+	}
+	if done {
+		return k.HoldersIndexProgress.Remove(ctx)
+	}
+	return k.HoldersIndexProgress.Set(ctx, progress)
+}
+
+// dropHoldersIndex deletes the entries of the top holders index and the holder
+// counts until outOfGas returns true. It returns whether the index is empty.
+func (k BaseSendKeeper) dropHoldersIndex(ctx context.Context, outOfGas func() bool) (bool, error) {
+	iter, err := k.Holders.Iterate(ctx, nil)
+	if err != nil {
+		return false, err
+	}
+	var holders []collections.Pair[string, collections.Pair[math.Int, sdk.AccAddress]]
+	for ; iter.Valid() && (len(holders) == 0 || !outOfGas()); iter.Next() {
+		key, err := iter.FullKey()
+		if err != nil {
+			iter.Close()
+			return false, err
+		}
+		holders = append(holders, key)
+	}
+	remaining := iter.Valid()
+	if err := iter.Close(); err != nil {
+		return false, err
+	}
+
+	for _, key := range holders {
+		err := k.Holders.Unreference(ctx, key.K2(), func() (sdk.Coin, error) {
+			return sdk.NewCoin(key.K1(), key.K2().K1()), nil
+		})
+		if err != nil {
+			return false, err
+		}
+	}
+	if remaining {
+		return false, nil
+	}
+
+	countIter, err := k.HolderCount.Iterate(ctx, nil)
+	if err != nil {
+		return false, err
+	}
+	var denoms []string
+	for ; countIter.Valid() && (len(denoms) == 0 || !outOfGas()); countIter.Next() {
+		denom, err := countIter.Key()
+		if err != nil {
+			countIter.Close()
+			return false, err
+		}
+		denoms = append(denoms, denom)
+	}
+	remaining = countIter.Valid()
+	if err := countIter.Close(); err != nil {
+		return false, err
+	}
+
+	for _, denom := range denoms {
+		if err := k.HolderCount.Remove(ctx, denom); err != nil {
+			return false, err
+		}
+	}
+	return !remaining, nil
+}
+
+// buildHoldersIndex indexes the balances following the last one indexed by
+// progress, until outOfGas returns true. It returns whether all the balances
+// are indexed.
+func (k BaseSendKeeper) buildHoldersIndex(ctx context.Context, progress *types.HoldersIndexProgress, outOfGas func() bool) (bool, error) {
+	var rng collections.Ranger[collections.Pair[sdk.AccAddress, string]]
+	if progress.LastAddress != nil {
+		rng = new(collections.Range[collections.Pair[sdk.AccAddress, string]]).
+			StartExclusive(collections.Join(progress.LastAddress, progress.LastDenom))
+	}
+
+	iter, err := k.Balances.Iterate(ctx, rng)
+	if err != nil {
+		return false, err
+	}
+	var balances []collections.KeyValue[collections.Pair[sdk.AccAddress, string], math.Int]
+	for ; iter.Valid() && (len(balances) == 0 || !outOfGas()); iter.Next() {
+		kv, err := iter.KeyValue()
+		if err != nil {
+			iter.Close()
+			return false, err
+		}
+		balances = append(balances, kv)
+	}
+	done := !iter.Valid()
+	if err := iter.Close(); err != nil {
+		return false, err
+	}
+
+	for _, kv := range balances {
+		progress.LastAddress, progress.LastDenom = kv.Key.K1(), kv.Key.K2()
+		if kv.Value.IsZero() {
+			continue
+		}
+
+		err := k.Holders.Reference(ctx, collections.Join(kv.Value, kv.Key.K1()), sdk.NewCoin(kv.Key.K2(), kv.Value), func() (sdk.Coin, error) {
+			return sdk.Coin{}, collections.ErrNotFound
+		})
+		if err != nil {
+			return false, err
+		}
+		if err := k.addHolderCount(ctx, kv.Key.K2(), 1); err != nil {
+			return false, err
+		}
+	}
+	return done, nil
+}
@@ -0,0 +1,118 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktestutil "github.com/cosmos/cosmos-sdk/x/bank/testutil"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func (suite *KeeperTestSuite) TestTopHolders() {
+	ctx := suite.ctx
+	require := suite.Require()
+
+	// the queries fail while the index is disabled
+	_, err := suite.queryClient.TopHolders(ctx, &banktypes.QueryTopHoldersRequest{Denom: "stake"})
+	require.ErrorContains(err, "top holders index is disabled")
+	_, err = suite.queryClient.HolderCount(ctx, &banktypes.QueryHolderCountRequest{Denom: "stake"})
+	require.ErrorContains(err, "top holders index is disabled")
+
+	// balances existing before the index is enabled are indexed
+	suite.mockFundAccount(accAddrs[0])
+	require.NoError(banktestutil.FundAccount(ctx, suite.bankKeeper, accAddrs[0], sdk.NewCoins(sdk.NewInt64Coin("stake", 100), sdk.NewInt64Coin("foo", 5))))
+	suite.mockFundAccount(accAddrs[1])
+	require.NoError(banktestutil.FundAccount(ctx, suite.bankKeeper, accAddrs[1], sdk.NewCoins(sdk.NewInt64Coin("stake", 300))))
+
+	params := banktypes.DefaultParams()
+	params.TopHoldersIndexEnabled = true
+	require.NoError(suite.bankKeeper.SetParams(ctx, params))
+
+	// the index is built in batches at the beginning of the next blocks, and
+	// is not ready until then
+	_, err = suite.queryClient.TopHolders(ctx, &banktypes.QueryTopHoldersRequest{Denom: "stake"})
+	require.ErrorContains(err, "top holders index is not ready")
+	_, err = suite.queryClient.HolderCount(ctx, &banktypes.QueryHolderCountRequest{Denom: "stake"})
+	require.ErrorContains(err, "top holders index is not ready")
+
+	// a single balance is indexed per batch without gas
+	require.NoError(suite.bankKeeper.ProcessHoldersIndex(ctx, 0))
+	progress, err := suite.bankKeeper.HoldersIndexProgress.Get(ctx)
+	require.NoError(err)
+	require.False(progress.Dropping)
+	require.NotNil(progress.LastAddress)
+	ready, err := suite.bankKeeper.IsHoldersIndexReady(ctx)
+	require.NoError(err)
+	require.False(ready)
+
+	// balances updated during the build are indexed once
+	suite.mockFundAccount(accAddrs[2])
+	require.NoError(banktestutil.FundAccount(ctx, suite.bankKeeper, accAddrs[2], sdk.NewCoins(sdk.NewInt64Coin("stake", 200))))
+	acc0 := authtypes.NewBaseAccountWithAddress(accAddrs[0])
+	suite.mockSendCoins(ctx, acc0, accAddrs[1])
+	require.NoError(suite.bankKeeper.SendCoins(ctx, accAddrs[0], accAddrs[1], sdk.NewCoins(sdk.NewInt64Coin("foo", 5))))
+	suite.mockSendCoins(ctx, authtypes.NewBaseAccountWithAddress(accAddrs[1]), accAddrs[0])
+	require.NoError(suite.bankKeeper.SendCoins(ctx, accAddrs[1], accAddrs[0], sdk.NewCoins(sdk.NewInt64Coin("foo", 5))))
+
+	require.NoError(suite.bankKeeper.BeginBlocker(ctx))
+	ready, err = suite.bankKeeper.IsHoldersIndexReady(ctx)
+	require.NoError(err)
+	require.True(ready)
+
+	res, err := suite.queryClient.TopHolders(ctx, &banktypes.QueryTopHoldersRequest{Denom: "stake"})
+	require.NoError(err)
+	require.Equal([]banktypes.DenomOwner{
+		{Address: accAddrs[1].String(), Balance: sdk.NewInt64Coin("stake", 300)},
+		{Address: accAddrs[2].String(), Balance: sdk.NewInt64Coin("stake", 200)},
+		{Address: accAddrs[0].String(), Balance: sdk.NewInt64Coin("stake", 100)},
+	}, res.Holders)
+
+	countRes, err := suite.queryClient.HolderCount(ctx, &banktypes.QueryHolderCountRequest{Denom: "stake"})
+	require.NoError(err)
+	require.Equal(uint64(3), countRes.Count)
+
+	// the index follows the transfers
+	acc1 := authtypes.NewBaseAccountWithAddress(accAddrs[1])
+	suite.mockSendCoins(ctx, acc1, accAddrs[0])
+	require.NoError(suite.bankKeeper.SendCoins(ctx, accAddrs[1], accAddrs[0], sdk.NewCoins(sdk.NewInt64Coin("stake", 300))))
+
+	res, err = suite.queryClient.TopHolders(ctx, &banktypes.QueryTopHoldersRequest{Denom: "stake", Limit: 1})
+	require.NoError(err)
+	require.Equal([]banktypes.DenomOwner{
+		{Address: accAddrs[0].String(), Balance: sdk.NewInt64Coin("stake", 400)},
+	}, res.Holders)
+
+	count, err := suite.bankKeeper.GetHolderCount(ctx, "stake")
+	require.NoError(err)
+	require.Equal(uint64(2), count)
+
+	holders, err := suite.bankKeeper.GetTopHolders(ctx, "foo", banktypes.DefaultTopHoldersLimit)
+	require.NoError(err)
+	require.Equal([]banktypes.DenomOwner{
+		{Address: accAddrs[0].String(), Balance: sdk.NewInt64Coin("foo", 5)},
+	}, holders)
+
+	_, err = suite.queryClient.TopHolders(ctx, &banktypes.QueryTopHoldersRequest{Denom: "stake", Limit: banktypes.MaxTopHoldersLimit + 1})
+	require.Error(err)
+	_, err = suite.queryClient.HolderCount(ctx, &banktypes.QueryHolderCountRequest{Denom: "!"})
+	require.Error(err)
+
+	// disabling the index drops it
+	params.TopHoldersIndexEnabled = false
+	require.NoError(suite.bankKeeper.SetParams(ctx, params))
+	require.NoError(suite.bankKeeper.BeginBlocker(ctx))
+
+	ready, err = suite.bankKeeper.IsHoldersIndexReady(ctx)
+	require.NoError(err)
+	require.False(ready)
+	has, err := suite.bankKeeper.HoldersIndexProgress.Has(ctx)
+	require.NoError(err)
+	require.False(has)
+
+	count, err = suite.bankKeeper.GetHolderCount(ctx, "stake")
+	require.NoError(err)
+	require.Zero(count)
+
+	holders, err = suite.bankKeeper.GetTopHolders(ctx, "stake", banktypes.DefaultTopHoldersLimit)
+	require.NoError(err)
+	require.Empty(holders)
+}
@@ -12,6 +12,7 @@ import (
 func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
 	ir.RegisterRoute(types.ModuleName, "nonnegative-outstanding", NonnegativeBalanceInvariant(k))
 	ir.RegisterRoute(types.ModuleName, "total-supply", TotalSupply(k))
+	ir.RegisterRoute(types.ModuleName, "stream-escrow", StreamEscrowInvariant(k))
 }
 
 // AllInvariants runs all invariants of the X/bank module.
@@ -21,7 +22,11 @@ func AllInvariants(k Keeper) sdk.Invariant {
 		if stop {
 			return res, stop
 		}
-		return TotalSupply(k)(ctx)
+		res, stop = TotalSupply(k)(ctx)
+		if stop {
+			return res, stop
+		}
+		return StreamEscrowInvariant(k)(ctx)
 	}
 }
 
@@ -75,3 +80,36 @@ func TotalSupply(k Keeper) sdk.Invariant {
 				expectedTotal, supply)), broken
 	}
 }
+
+// StreamEscrowInvariant checks that all the payment streams are valid and that
+// their escrow accounts hold at least the coins not yet paid to their recipients
+func StreamEscrowInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg   string
+			count int
+		)
+
+		for _, stream := range k.GetAllStreams(ctx) {
+			if err := stream.Validate(); err != nil {
+				count++
+				msg += fmt.Sprintf("\t%s\n", err)
+				continue
+			}
+
+			remaining := stream.Remaining()
+			escrowed := k.GetBalance(ctx, types.StreamEscrowAddress(stream.Id), remaining.Denom)
+			if escrowed.IsLT(remaining) {
+				count++
+				msg += fmt.Sprintf("\tstream %d escrows %s, less than its remaining %s\n", stream.Id, escrowed, remaining)
+			}
+		}
+
+		broken := count != 0
+
+		return sdk.FormatInvariant(
+			types.ModuleName, "stream-escrow",
+			fmt.Sprintf("amount of invalid payment streams found %d\n%s", count, msg),
+		), broken
+	}
+}
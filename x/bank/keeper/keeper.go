@@ -3,6 +3,7 @@ package keeper
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"cosmossdk.io/core/store"
 	errorsmod "cosmossdk.io/errors"
@@ -49,6 +50,24 @@ type Keeper interface {
 	DelegateCoins(ctx context.Context, delegatorAddr, moduleAccAddr sdk.AccAddress, amt sdk.Coins) error
 	UndelegateCoins(ctx context.Context, moduleAccAddr, delegatorAddr sdk.AccAddress, amt sdk.Coins) error
 
+	CreateFactoryDenom(ctx context.Context, creator sdk.AccAddress, subdenom string) (string, error)
+	GetDenomAuthorityMetadata(ctx context.Context, denom string) (types.DenomAuthorityMetadata, error)
+	SetDenomAuthorityMetadata(ctx context.Context, denom string, metadata types.DenomAuthorityMetadata) error
+	GetAllFactoryDenoms(ctx context.Context) []types.FactoryDenom
+	ChangeFactoryDenomAdmin(ctx context.Context, admin sdk.AccAddress, denom, newAdmin string) error
+	SetFactoryDenomMetadata(ctx context.Context, admin sdk.AccAddress, metadata types.Metadata) error
+	MintFactoryCoins(ctx context.Context, admin sdk.AccAddress, amount sdk.Coin, recipient sdk.AccAddress) error
+	BurnFactoryCoins(ctx context.Context, admin sdk.AccAddress, amounts sdk.Coins) error
+
+	CreateStream(ctx context.Context, sender, recipient sdk.AccAddress, amount sdk.Coin, endTime time.Time) (uint64, error)
+	TopUpStream(ctx context.Context, sender sdk.AccAddress, id uint64, amount sdk.Coin) error
+	CancelStream(ctx context.Context, sender sdk.AccAddress, id uint64) (sdk.Coin, error)
+	WithdrawStream(ctx context.Context, recipient sdk.AccAddress, id uint64) (sdk.Coin, error)
+	GetStream(ctx context.Context, id uint64) (types.Stream, error)
+	GetAllStreams(ctx context.Context) []types.Stream
+
+	BeginBlocker(ctx context.Context) error
+
 	types.QueryServer
 }
 
@@ -182,3 +182,195 @@ func (k msgServer) SetSendEnabled(goCtx context.Context, msg *types.MsgSetSendEn
 
 	return &types.MsgSetSendEnabledResponse{}, nil
 }
+
+func (k msgServer) CreateDenom(goCtx context.Context, msg *types.MsgCreateDenom) (*types.MsgCreateDenomResponse, error) {
+	sender, err := k.stringToBytes(msg.Sender)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid sender address: %s", err)
+	}
+
+	denom, err := k.CreateFactoryDenom(goCtx, sender, msg.Subdenom)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCreateDenomResponse{NewTokenDenom: denom}, nil
+}
+
+func (k msgServer) Mint(goCtx context.Context, msg *types.MsgMint) (*types.MsgMintResponse, error) {
+	sender, err := k.stringToBytes(msg.Sender)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid sender address: %s", err)
+	}
+
+	recipient := sender
+	if msg.MintToAddress != "" {
+		recipient, err = k.stringToBytes(msg.MintToAddress)
+		if err != nil {
+			return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid mint to address: %s", err)
+		}
+	}
+
+	if !msg.Amount.IsValid() || !msg.Amount.IsPositive() {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidCoins, msg.Amount.String())
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if !sdk.AccAddress(sender).Equals(sdk.AccAddress(recipient)) {
+		if err := k.IsSendEnabledCoins(ctx, msg.Amount); err != nil {
+			return nil, err
+		}
+
+		if k.BlockedAddr(recipient) {
+			return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive funds", msg.MintToAddress)
+		}
+	}
+
+	if err := k.MintFactoryCoins(ctx, sender, msg.Amount, recipient); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgMintResponse{}, nil
+}
+
+func (k msgServer) Burn(goCtx context.Context, msg *types.MsgBurn) (*types.MsgBurnResponse, error) {
+	from, err := k.stringToBytes(msg.FromAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid from address: %s", err)
+	}
+
+	if !msg.Amount.IsValid() || !msg.Amount.IsAllPositive() {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidCoins, msg.Amount.String())
+	}
+
+	if err := k.BurnFactoryCoins(goCtx, from, msg.Amount); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgBurnResponse{}, nil
+}
+
+func (k msgServer) ChangeAdmin(goCtx context.Context, msg *types.MsgChangeAdmin) (*types.MsgChangeAdminResponse, error) {
+	sender, err := k.stringToBytes(msg.Sender)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid sender address: %s", err)
+	}
+
+	if msg.NewAdmin != "" {
+		if _, err := k.stringToBytes(msg.NewAdmin); err != nil {
+			return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid new admin address: %s", err)
+		}
+	}
+
+	if err := k.ChangeFactoryDenomAdmin(goCtx, sender, msg.Denom, msg.NewAdmin); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgChangeAdminResponse{}, nil
+}
+
+func (k msgServer) SetDenomMetadata(goCtx context.Context, msg *types.MsgSetDenomMetadata) (*types.MsgSetDenomMetadataResponse, error) {
+	sender, err := k.stringToBytes(msg.Sender)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid sender address: %s", err)
+	}
+
+	if err := k.SetFactoryDenomMetadata(goCtx, sender, msg.Metadata); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetDenomMetadataResponse{}, nil
+}
+
+func (k msgServer) CreateStream(goCtx context.Context, msg *types.MsgCreateStream) (*types.MsgCreateStreamResponse, error) {
+	sender, err := k.stringToBytes(msg.Sender)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid sender address: %s", err)
+	}
+
+	recipient, err := k.stringToBytes(msg.Recipient)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid recipient address: %s", err)
+	}
+
+	if !msg.Amount.IsValid() || !msg.Amount.IsPositive() {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidCoins, msg.Amount.String())
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.IsSendEnabledCoins(ctx, msg.Amount); err != nil {
+		return nil, err
+	}
+
+	if k.BlockedAddr(recipient) {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive funds", msg.Recipient)
+	}
+
+	id, err := k.Keeper.CreateStream(ctx, sender, recipient, msg.Amount, msg.EndTime)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCreateStreamResponse{StreamId: id}, nil
+}
+
+func (k msgServer) TopUpStream(goCtx context.Context, msg *types.MsgTopUpStream) (*types.MsgTopUpStreamResponse, error) {
+	sender, err := k.stringToBytes(msg.Sender)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid sender address: %s", err)
+	}
+
+	if !msg.Amount.IsValid() || !msg.Amount.IsPositive() {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidCoins, msg.Amount.String())
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.IsSendEnabledCoins(ctx, msg.Amount); err != nil {
+		return nil, err
+	}
+
+	if err := k.Keeper.TopUpStream(ctx, sender, msg.StreamId, msg.Amount); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgTopUpStreamResponse{}, nil
+}
+
+func (k msgServer) CancelStream(goCtx context.Context, msg *types.MsgCancelStream) (*types.MsgCancelStreamResponse, error) {
+	sender, err := k.stringToBytes(msg.Sender)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid sender address: %s", err)
+	}
+
+	refunded, err := k.Keeper.CancelStream(goCtx, sender, msg.StreamId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCancelStreamResponse{Refunded: refunded}, nil
+}
+
+func (k msgServer) WithdrawStream(goCtx context.Context, msg *types.MsgWithdrawStream) (*types.MsgWithdrawStreamResponse, error) {
+	recipient, err := k.stringToBytes(msg.Recipient)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid recipient address: %s", err)
+	}
+
+	paid, err := k.Keeper.WithdrawStream(goCtx, recipient, msg.StreamId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgWithdrawStreamResponse{Amount: paid}, nil
+}
+
+// stringToBytes decodes a bech32 address with the address codec of the
+// underlying BaseKeeper.
+func (k msgServer) stringToBytes(addr string) ([]byte, error) {
+	base, ok := k.Keeper.(BaseKeeper)
+	if !ok {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("invalid keeper type: %T", k.Keeper)
+	}
+
+	return base.ak.AddressCodec().StringToBytes(addr)
+}
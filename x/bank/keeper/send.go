@@ -9,6 +9,7 @@ import (
 	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/log"
 	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/telemetry"
@@ -26,6 +27,12 @@ type SendKeeper interface {
 	PrependSendRestriction(restriction types.SendRestrictionFn)
 	ClearSendRestriction()
 
+	RegisterSendHooks(hooks types.SendHooks)
+	AttachSendHooks(ctx context.Context, attachment types.SendHooksAttachment) error
+	DetachSendHooks(ctx context.Context, attachment types.SendHooksAttachment) error
+	GetSendHooks(ctx context.Context, denom string) ([]types.SendHook, error)
+	GetAllSendHooksAttachments(ctx context.Context) []types.SendHooksAttachment
+
 	InputOutputCoins(ctx context.Context, input types.Input, outputs []types.Output) error
 	SendCoins(ctx context.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) error
 
@@ -69,6 +76,7 @@ type BaseSendKeeper struct {
 	authority string
 
 	sendRestriction *sendRestriction
+	sendHooks       *sendHooksRegistry
 }
 
 func NewBaseSendKeeper(
@@ -92,6 +100,7 @@ func NewBaseSendKeeper(
 		authority:       authority,
 		logger:          logger,
 		sendRestriction: newSendRestriction(),
+		sendHooks:       newSendHooksRegistry(),
 	}
 }
 
@@ -132,7 +141,15 @@ func (k BaseSendKeeper) SetParams(ctx context.Context, params types.Params) erro
 		k.SetAllSendEnabled(ctx, params.SendEnabled) //nolint:staticcheck // SA1019: params.SendEnabled is deprecated
 
 		// override params without SendEnabled
-		params = types.NewParams(params.DefaultSendEnabled)
+		params.SendEnabled = nil //nolint:staticcheck // SA1019: params.SendEnabled is deprecated
+	}
+
+	// the top holders index is dropped, and then built again when enabled, in
+	// batches at the beginning of the next blocks
+	if params.TopHoldersIndexEnabled != k.GetParams(ctx).TopHoldersIndexEnabled {
+		if err := k.HoldersIndexProgress.Set(ctx, types.HoldersIndexProgress{Dropping: true}); err != nil {
+			return err
+		}
 	}
 	return k.Params.Set(ctx, params)
 }
@@ -177,10 +194,18 @@ func (k BaseSendKeeper) InputOutputCoins(ctx context.Context, input types.Input,
 			return err
 		}
 
+		if err := k.runBeforeSendHooks(ctx, inAddress, outAddress, out.Coins); err != nil {
+			return err
+		}
+
 		if err := k.addCoins(ctx, outAddress, out.Coins); err != nil {
 			return err
 		}
 
+		if err := k.runAfterReceiveHooks(ctx, inAddress, outAddress, out.Coins); err != nil {
+			return err
+		}
+
 		sdkCtx.EventManager().EmitEvent(
 			sdk.NewEvent(
 				types.EventTypeTransfer,
@@ -217,11 +242,21 @@ func (k BaseSendKeeper) SendCoins(ctx context.Context, fromAddr, toAddr sdk.AccA
 		return err
 	}
 
+	err = k.runBeforeSendHooks(ctx, fromAddr, toAddr, amt)
+	if err != nil {
+		return err
+	}
+
 	err = k.addCoins(ctx, toAddr, amt)
 	if err != nil {
 		return err
 	}
 
+	err = k.runAfterReceiveHooks(ctx, fromAddr, toAddr, amt)
+	if err != nil {
+		return err
+	}
+
 	// Create account if recipient does not exist.
 	//
 	// NOTE: This should ultimately be removed in favor a more flexible approach
@@ -329,6 +364,24 @@ func (k BaseSendKeeper) setBalance(ctx context.Context, addr sdk.AccAddress, bal
 		return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, balance.String())
 	}
 
+	params := k.getBalanceIndexParams(ctx)
+	if params.BalanceHistoryEnabled {
+		if err := k.logBalanceChange(ctx, addr, balance); err != nil {
+			return err
+		}
+	}
+	if params.TopHoldersIndexEnabled {
+		indexed, err := k.isHolderIndexed(ctx, addr, balance.Denom)
+		if err != nil {
+			return err
+		}
+		if indexed {
+			if err := k.updateHoldersIndex(ctx, addr, balance); err != nil {
+				return err
+			}
+		}
+	}
+
 	// x/bank invariants prohibit persistence of zero balances
 	if balance.IsZero() {
 		err := k.Balances.Remove(ctx, collections.Join(addr, balance.Denom))
@@ -340,6 +393,14 @@ func (k BaseSendKeeper) setBalance(ctx context.Context, addr sdk.AccAddress, bal
 	return k.Balances.Set(ctx, collections.Join(addr, balance.Denom), balance.Amount)
 }
 
+// getBalanceIndexParams returns the params enabling the indexes of the
+// balances. They are read without consuming gas, so that the balance writes of
+// the chains which do not enable any index cost nothing more.
+func (k BaseSendKeeper) getBalanceIndexParams(ctx context.Context) types.Params {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	return k.GetParams(sdkCtx.WithGasMeter(storetypes.NewInfiniteGasMeter()))
+}
+
 // IsSendEnabledCoins checks the coins provided and returns an ErrSendDisabled
 // if any of the coins are not configured for sending. Returns nil if sending is
 // enabled for all provided coins.
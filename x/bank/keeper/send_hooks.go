@@ -0,0 +1,246 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// RegisterSendHooks registers the provided hooks under their name. Functions
+// cannot be persisted, so the hooks must be registered at app construction,
+// but they only run for the denoms and denom prefixes they are attached to
+// with AttachSendHooks, which is persisted in state.
+func (k BaseSendKeeper) RegisterSendHooks(hooks types.SendHooks) {
+	k.sendHooks.register(hooks)
+}
+
+// AttachSendHooks attaches the send hooks registered under attachment.Name to
+// a denom or a denom prefix. The hooks then run in SendCoins and
+// InputOutputCoins for every transfer of the denoms they are attached to.
+func (k BaseSendKeeper) AttachSendHooks(ctx context.Context, attachment types.SendHooksAttachment) error {
+	if err := attachment.Validate(); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	if _, ok := k.sendHooks.hooks[attachment.Name]; !ok {
+		return errorsmod.Wrapf(types.ErrSendHooksNotFound, "no send hooks registered under %q", attachment.Name)
+	}
+
+	return k.SendHooks.Set(ctx, sendHooksKey(attachment))
+}
+
+// DetachSendHooks detaches the send hooks registered under attachment.Name from
+// a denom or a denom prefix.
+func (k BaseSendKeeper) DetachSendHooks(ctx context.Context, attachment types.SendHooksAttachment) error {
+	key := sendHooksKey(attachment)
+	has, err := k.SendHooks.Has(ctx, key)
+	if err != nil {
+		return err
+	}
+	if !has {
+		return errorsmod.Wrapf(types.ErrSendHooksNotFound, "send hooks %q are not attached to %s", attachment.Name, attachment.Denom)
+	}
+
+	return k.SendHooks.Remove(ctx, key)
+}
+
+// GetSendHooks returns the send hooks that apply to denom, in execution order.
+// When denom is empty, all the attached send hooks are returned.
+func (k BaseSendKeeper) GetSendHooks(ctx context.Context, denom string) ([]types.SendHook, error) {
+	var hooks []types.SendHook
+	err := k.SendHooks.Walk(ctx, nil, func(key collections.Triple[bool, string, string]) (bool, error) {
+		attachment := sendHooksAttachment(key)
+		if denom != "" && !attachmentMatches(attachment, denom) {
+			return false, nil
+		}
+
+		registered := k.sendHooks.hooks[attachment.Name]
+		hooks = append(hooks, types.SendHook{
+			Denom:        attachment.Denom,
+			IsPrefix:     attachment.IsPrefix,
+			Name:         attachment.Name,
+			BeforeSend:   registered.BeforeSend != nil,
+			AfterReceive: registered.AfterReceive != nil,
+		})
+		return false, nil
+	})
+
+	return hooks, err
+}
+
+// GetAllSendHooksAttachments returns all the send hooks attachments, in
+// execution order.
+func (k BaseSendKeeper) GetAllSendHooksAttachments(ctx context.Context) []types.SendHooksAttachment {
+	var attachments []types.SendHooksAttachment
+	err := k.SendHooks.Walk(ctx, nil, func(key collections.Triple[bool, string, string]) (bool, error) {
+		attachments = append(attachments, sendHooksAttachment(key))
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	return attachments
+}
+
+// runBeforeSendHooks runs the before-send hooks attached to the denoms of amt.
+func (k BaseSendKeeper) runBeforeSendHooks(ctx context.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) error {
+	return k.runSendHooks(ctx, amt, func(ctx context.Context, hooks types.SendHooks, coins sdk.Coins) error {
+		if hooks.BeforeSend == nil {
+			return nil
+		}
+		return hooks.BeforeSend(ctx, fromAddr, toAddr, coins)
+	})
+}
+
+// runAfterReceiveHooks runs the after-receive hooks attached to the denoms of amt.
+func (k BaseSendKeeper) runAfterReceiveHooks(ctx context.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) error {
+	return k.runSendHooks(ctx, amt, func(ctx context.Context, hooks types.SendHooks, coins sdk.Coins) error {
+		if hooks.AfterReceive == nil {
+			return nil
+		}
+		return hooks.AfterReceive(ctx, fromAddr, toAddr, coins)
+	})
+}
+
+// runSendHooks calls fn for every attachment that applies to at least one denom
+// of amt, in execution order, with the coins of amt the attachment applies to.
+//
+// Prefix attachments run first, sorted by prefix, then exact denom attachments,
+// sorted by denom. Attachments of the same denom or prefix are sorted by name.
+// Sorting the prefixes means that a prefix runs before the longer prefixes
+// extending it, e.g. "factory/" before "factory/{creator}/".
+func (k BaseSendKeeper) runSendHooks(ctx context.Context, amt sdk.Coins, fn func(context.Context, types.SendHooks, sdk.Coins) error) error {
+	if len(k.sendHooks.hooks) == 0 {
+		return nil
+	}
+
+	var attachments []types.SendHooksAttachment
+	err := k.SendHooks.Walk(ctx, collections.NewPrefixedTripleRange[bool, string, string](false), func(key collections.Triple[bool, string, string]) (bool, error) {
+		attachments = append(attachments, sendHooksAttachment(key))
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	// amt is sorted by denom, which keeps the exact attachments sorted.
+	for _, coin := range amt {
+		err := k.SendHooks.Walk(ctx, collections.NewSuperPrefixedTripleRange[bool, string, string](true, coin.Denom), func(key collections.Triple[bool, string, string]) (bool, error) {
+			attachments = append(attachments, sendHooksAttachment(key))
+			return false, nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, attachment := range attachments {
+		var coins sdk.Coins
+		for _, coin := range amt {
+			if attachmentMatches(attachment, coin.Denom) {
+				coins = append(coins, coin)
+			}
+		}
+		if len(coins) == 0 {
+			continue
+		}
+
+		hooks, ok := k.sendHooks.hooks[attachment.Name]
+		if !ok {
+			return errorsmod.Wrapf(types.ErrSendHooksNotFound, "no send hooks registered under %q attached to %s", attachment.Name, attachment.Denom)
+		}
+
+		if err := callSendHook(ctx, hooks.Name, func(ctx context.Context) error {
+			return fn(ctx, hooks, coins)
+		}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// sendHooksKey returns the key of the attachment in the SendHooks key set.
+func sendHooksKey(attachment types.SendHooksAttachment) collections.Triple[bool, string, string] {
+	return collections.Join3(!attachment.IsPrefix, attachment.Denom, attachment.Name)
+}
+
+// sendHooksAttachment returns the attachment of a key of the SendHooks key set.
+func sendHooksAttachment(key collections.Triple[bool, string, string]) types.SendHooksAttachment {
+	return types.SendHooksAttachment{
+		Denom:    key.K2(),
+		IsPrefix: !key.K1(),
+		Name:     key.K3(),
+	}
+}
+
+// attachmentMatches returns true if the attachment applies to denom.
+func attachmentMatches(attachment types.SendHooksAttachment, denom string) bool {
+	if attachment.IsPrefix {
+		return strings.HasPrefix(denom, attachment.Denom)
+	}
+	return attachment.Denom == denom
+}
+
+// sendHooksRegistry houses the send hooks registered in the SendKeeper, by name.
+// It exists so that hooks can be registered without needing the SendKeeper to have a pointer receiver.
+type sendHooksRegistry struct {
+	hooks map[string]types.SendHooks
+}
+
+// newSendHooksRegistry creates a new, empty sendHooksRegistry.
+func newSendHooksRegistry() *sendHooksRegistry {
+	return &sendHooksRegistry{hooks: make(map[string]types.SendHooks)}
+}
+
+// register adds the hooks to the registry.
+func (r *sendHooksRegistry) register(hooks types.SendHooks) {
+	if hooks.Name == "" {
+		panic(errors.New("send hooks name cannot be empty"))
+	}
+	if hooks.BeforeSend == nil && hooks.AfterReceive == nil {
+		panic(fmt.Errorf("send hooks %q have no hook function", hooks.Name))
+	}
+	if _, ok := r.hooks[hooks.Name]; ok {
+		panic(fmt.Errorf("send hooks %q already registered", hooks.Name))
+	}
+
+	r.hooks[hooks.Name] = hooks
+}
+
+// callSendHook runs a single hook call with its own gas meter, which cannot
+// consume more than types.SendHookGasLimit. The gas consumed by the hook is
+// charged to the gas meter of ctx.
+func callSendHook(ctx context.Context, name string, fn func(context.Context) error) (err error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	parentGasMeter := sdkCtx.GasMeter()
+
+	limit := types.SendHookGasLimit
+	if remaining := parentGasMeter.GasRemaining(); remaining < limit {
+		limit = remaining
+	}
+	gasMeter := storetypes.NewGasMeter(limit)
+
+	defer func() {
+		if rec := recover(); rec != nil {
+			oog, ok := rec.(storetypes.ErrorOutOfGas)
+			if !ok {
+				panic(rec)
+			}
+			err = errorsmod.Wrapf(sdkerrors.ErrOutOfGas, "send hook %q out of gas in location: %v", name, oog.Descriptor)
+		}
+		parentGasMeter.ConsumeGas(gasMeter.GasConsumedToLimit(), "send hook")
+	}()
+
+	return fn(sdkCtx.WithGasMeter(gasMeter))
+}
@@ -0,0 +1,194 @@
+package keeper_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktestutil "github.com/cosmos/cosmos-sdk/x/bank/testutil"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func (suite *KeeperTestSuite) TestSendHooks() {
+	ctx := suite.ctx
+	require := suite.Require()
+
+	var calls []string
+	recordHooks := func(name string) banktypes.SendHooks {
+		return banktypes.SendHooks{
+			Name: name,
+			BeforeSend: func(_ context.Context, _, _ sdk.AccAddress, amt sdk.Coins) error {
+				calls = append(calls, fmt.Sprintf("%s before %s", name, amt))
+				return nil
+			},
+			AfterReceive: func(ctx context.Context, _, toAddr sdk.AccAddress, amt sdk.Coins) error {
+				calls = append(calls, fmt.Sprintf("%s after %s", name, amt))
+				// the coins have already been credited to the receiver
+				for _, coin := range amt {
+					require.True(suite.bankKeeper.GetBalance(ctx, toAddr, coin.Denom).IsGTE(coin))
+				}
+				return nil
+			},
+		}
+	}
+
+	suite.bankKeeper.RegisterSendHooks(recordHooks("bar"))
+	suite.bankKeeper.RegisterSendHooks(recordHooks("creator"))
+	suite.bankKeeper.RegisterSendHooks(recordHooks("factory"))
+	suite.bankKeeper.RegisterSendHooks(banktypes.SendHooks{
+		Name: "bar2",
+		BeforeSend: func(_ context.Context, _, _ sdk.AccAddress, amt sdk.Coins) error {
+			calls = append(calls, fmt.Sprintf("bar2 before %s", amt))
+			return nil
+		},
+	})
+
+	// registered hooks only run once attached
+	require.Empty(suite.bankKeeper.GetAllSendHooksAttachments(ctx))
+	for _, attachment := range []banktypes.SendHooksAttachment{
+		{Denom: "factory/creator/bar", Name: "bar2"},
+		{Denom: "factory/creator/bar", Name: "bar"},
+		{Denom: "factory/creator/", IsPrefix: true, Name: "creator"},
+		{Denom: "factory/", IsPrefix: true, Name: "factory"},
+		{Denom: "factory/creator/baz", Name: "bar"},
+	} {
+		require.NoError(suite.bankKeeper.AttachSendHooks(ctx, attachment))
+	}
+	require.NoError(suite.bankKeeper.DetachSendHooks(ctx, banktypes.SendHooksAttachment{Denom: "factory/creator/baz", Name: "bar"}))
+
+	hooks, err := suite.bankKeeper.GetSendHooks(ctx, "")
+	require.NoError(err)
+	require.Equal([]banktypes.SendHook{
+		{Denom: "factory/", IsPrefix: true, Name: "factory", BeforeSend: true, AfterReceive: true},
+		{Denom: "factory/creator/", IsPrefix: true, Name: "creator", BeforeSend: true, AfterReceive: true},
+		{Denom: "factory/creator/bar", Name: "bar", BeforeSend: true, AfterReceive: true},
+		{Denom: "factory/creator/bar", Name: "bar2", BeforeSend: true},
+	}, hooks)
+
+	// the attachments are persisted and exported
+	require.Equal([]banktypes.SendHooksAttachment{
+		{Denom: "factory/", IsPrefix: true, Name: "factory"},
+		{Denom: "factory/creator/", IsPrefix: true, Name: "creator"},
+		{Denom: "factory/creator/bar", Name: "bar"},
+		{Denom: "factory/creator/bar", Name: "bar2"},
+	}, suite.bankKeeper.ExportGenesis(ctx).SendHooks)
+
+	res, err := suite.queryClient.SendHooks(ctx, &banktypes.QuerySendHooksRequest{Denom: "factory/other/foo"})
+	require.NoError(err)
+	require.Equal([]banktypes.SendHook{
+		{Denom: "factory/", IsPrefix: true, Name: "factory", BeforeSend: true, AfterReceive: true},
+	}, res.SendHooks)
+
+	_, err = suite.queryClient.SendHooks(ctx, &banktypes.QuerySendHooksRequest{Denom: "!"})
+	require.Error(err)
+
+	balances := sdk.NewCoins(
+		sdk.NewInt64Coin("factory/creator/bar", 100),
+		sdk.NewInt64Coin("factory/creator/foo", 100),
+		sdk.NewInt64Coin("factory/other/foo", 100),
+		sdk.NewInt64Coin("stake", 100),
+	)
+	suite.mockFundAccount(accAddrs[0])
+	require.NoError(banktestutil.FundAccount(ctx, suite.bankKeeper, accAddrs[0], balances))
+	calls = nil
+
+	acc0 := authtypes.NewBaseAccountWithAddress(accAddrs[0])
+	suite.mockSendCoins(ctx, acc0, accAddrs[1])
+	require.NoError(suite.bankKeeper.SendCoins(ctx, accAddrs[0], accAddrs[1], sdk.NewCoins(
+		sdk.NewInt64Coin("factory/creator/bar", 10),
+		sdk.NewInt64Coin("factory/other/foo", 10),
+		sdk.NewInt64Coin("stake", 10),
+	)))
+	require.Equal([]string{
+		"factory before 10factory/creator/bar,10factory/other/foo",
+		"creator before 10factory/creator/bar",
+		"bar before 10factory/creator/bar",
+		"bar2 before 10factory/creator/bar",
+		"factory after 10factory/creator/bar,10factory/other/foo",
+		"creator after 10factory/creator/bar",
+		"bar after 10factory/creator/bar",
+	}, calls)
+
+	// transfers of denoms without hooks do not run any hook
+	calls = nil
+	suite.mockSendCoins(ctx, acc0, accAddrs[1])
+	require.NoError(suite.bankKeeper.SendCoins(ctx, accAddrs[0], accAddrs[1], sdk.NewCoins(sdk.NewInt64Coin("stake", 10))))
+	require.Empty(calls)
+
+	// hooks run for each output of InputOutputCoins
+	calls = nil
+	input := banktypes.NewInput(accAddrs[0], sdk.NewCoins(sdk.NewInt64Coin("factory/creator/foo", 20)))
+	outputs := []banktypes.Output{
+		banktypes.NewOutput(accAddrs[1], sdk.NewCoins(sdk.NewInt64Coin("factory/creator/foo", 15))),
+		banktypes.NewOutput(accAddrs[2], sdk.NewCoins(sdk.NewInt64Coin("factory/creator/foo", 5))),
+	}
+	suite.mockInputOutputCoins([]sdk.AccountI{acc0}, []sdk.AccAddress{accAddrs[1], accAddrs[2]})
+	require.NoError(suite.bankKeeper.InputOutputCoins(ctx, input, outputs))
+	require.Equal([]string{
+		"factory before 15factory/creator/foo",
+		"creator before 15factory/creator/foo",
+		"factory after 15factory/creator/foo",
+		"creator after 15factory/creator/foo",
+		"factory before 5factory/creator/foo",
+		"creator before 5factory/creator/foo",
+		"factory after 5factory/creator/foo",
+		"creator after 5factory/creator/foo",
+	}, calls)
+}
+
+func (suite *KeeperTestSuite) TestSendHooksErrors() {
+	ctx := suite.ctx
+	require := suite.Require()
+
+	balances := sdk.NewCoins(sdk.NewInt64Coin("foo", 100), sdk.NewInt64Coin("bar", 100))
+	suite.mockFundAccount(accAddrs[0])
+	require.NoError(banktestutil.FundAccount(ctx, suite.bankKeeper, accAddrs[0], balances))
+
+	hookErr := errors.New("transfers of foo are frozen")
+	freezer := banktypes.SendHooks{
+		Name: "freezer",
+		BeforeSend: func(_ context.Context, _, _ sdk.AccAddress, _ sdk.Coins) error {
+			return hookErr
+		},
+	}
+	suite.bankKeeper.RegisterSendHooks(freezer)
+	suite.bankKeeper.RegisterSendHooks(banktypes.SendHooks{
+		Name: "spender",
+		AfterReceive: func(ctx context.Context, _, _ sdk.AccAddress, _ sdk.Coins) error {
+			sdk.UnwrapSDKContext(ctx).GasMeter().ConsumeGas(banktypes.SendHookGasLimit+1, "spender")
+			return nil
+		},
+	})
+	require.NoError(suite.bankKeeper.AttachSendHooks(ctx, banktypes.SendHooksAttachment{Denom: "foo", Name: "freezer"}))
+	require.NoError(suite.bankKeeper.AttachSendHooks(ctx, banktypes.SendHooksAttachment{Denom: "bar", Name: "spender"}))
+
+	require.Panics(func() {
+		suite.bankKeeper.RegisterSendHooks(banktypes.SendHooks{Name: "empty"})
+	})
+	require.Panics(func() {
+		suite.bankKeeper.RegisterSendHooks(freezer)
+	})
+	err := suite.bankKeeper.AttachSendHooks(ctx, banktypes.SendHooksAttachment{Denom: "foo", Name: "unknown"})
+	require.ErrorIs(err, banktypes.ErrSendHooksNotFound)
+	err = suite.bankKeeper.AttachSendHooks(ctx, banktypes.SendHooksAttachment{IsPrefix: true, Name: "freezer"})
+	require.ErrorIs(err, sdkerrors.ErrInvalidRequest)
+	err = suite.bankKeeper.DetachSendHooks(ctx, banktypes.SendHooksAttachment{Denom: "bar", Name: "freezer"})
+	require.ErrorIs(err, banktypes.ErrSendHooksNotFound)
+
+	acc0 := authtypes.NewBaseAccountWithAddress(accAddrs[0])
+	suite.authKeeper.EXPECT().GetAccount(ctx, acc0.GetAddress()).Return(acc0)
+	err = suite.bankKeeper.SendCoins(ctx, accAddrs[0], accAddrs[1], sdk.NewCoins(sdk.NewInt64Coin("foo", 10)))
+	require.ErrorIs(err, hookErr)
+
+	// the gas consumed by the hooks is charged to the caller, up to the limit
+	gasCtx := sdk.UnwrapSDKContext(ctx).WithGasMeter(storetypes.NewInfiniteGasMeter())
+	suite.authKeeper.EXPECT().GetAccount(gasCtx, acc0.GetAddress()).Return(acc0)
+	err = suite.bankKeeper.SendCoins(gasCtx, accAddrs[0], accAddrs[1], sdk.NewCoins(sdk.NewInt64Coin("bar", 10)))
+	require.ErrorIs(err, sdkerrors.ErrOutOfGas)
+	require.Greater(gasCtx.GasMeter().GasConsumed(), banktypes.SendHookGasLimit)
+}
@@ -0,0 +1,281 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"time"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// CreateStream opens a payment stream of amount from sender to recipient,
+// flowing linearly from the current block time until endTime. The amount is
+// moved to the escrow account of the stream. It returns the id of the stream.
+func (k BaseKeeper) CreateStream(ctx context.Context, sender, recipient sdk.AccAddress, amount sdk.Coin, endTime time.Time) (uint64, error) {
+	now := sdk.UnwrapSDKContext(ctx).BlockTime()
+	if !endTime.After(now) {
+		return 0, errorsmod.Wrapf(types.ErrInvalidStream, "end time %s must be after the block time %s", endTime, now)
+	}
+
+	if sender.Equals(recipient) {
+		return 0, errorsmod.Wrap(types.ErrInvalidStream, "sender and recipient must be different")
+	}
+
+	if !k.ak.HasAccount(ctx, recipient) {
+		return 0, errorsmod.Wrapf(sdkerrors.ErrUnknownAddress, "recipient account %s does not exist", recipient)
+	}
+
+	senderStr, err := k.ak.AddressCodec().BytesToString(sender)
+	if err != nil {
+		return 0, err
+	}
+	recipientStr, err := k.ak.AddressCodec().BytesToString(recipient)
+	if err != nil {
+		return 0, err
+	}
+
+	id, err := k.StreamSequence.Next(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	if err := k.SendCoins(ctx, sender, types.StreamEscrowAddress(id), sdk.NewCoins(amount)); err != nil {
+		return 0, err
+	}
+
+	stream := types.Stream{
+		Id:          id,
+		Sender:      senderStr,
+		Recipient:   recipientStr,
+		Deposit:     amount,
+		Withdrawn:   sdk.NewCoin(amount.Denom, math.ZeroInt()),
+		StartTime:   now,
+		EndTime:     endTime,
+		SettledTime: now,
+	}
+	if err := k.PaymentStreams.Set(ctx, id, stream); err != nil {
+		return 0, err
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCreateStream,
+			sdk.NewAttribute(types.AttributeKeyStreamID, strconv.FormatUint(id, 10)),
+			sdk.NewAttribute(types.AttributeKeySender, stream.Sender),
+			sdk.NewAttribute(types.AttributeKeyRecipient, stream.Recipient),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
+		),
+	)
+
+	return id, nil
+}
+
+// TopUpStream adds amount to the payment stream with the given id. The stream
+// is settled first, then the coins remaining in escrow, including amount, flow
+// until the end time of the stream. Only the sender of the stream can top it up.
+func (k BaseKeeper) TopUpStream(ctx context.Context, sender sdk.AccAddress, id uint64, amount sdk.Coin) error {
+	stream, err := k.GetStream(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	isSender, err := k.isStreamAddress(stream.Sender, sender)
+	if err != nil {
+		return err
+	}
+	if !isSender {
+		return errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is not the sender of stream %d", sender, id)
+	}
+
+	if amount.Denom != stream.Deposit.Denom {
+		return errorsmod.Wrapf(types.ErrInvalidStream, "stream %d streams %s, got %s", id, stream.Deposit.Denom, amount.Denom)
+	}
+
+	now := sdk.UnwrapSDKContext(ctx).BlockTime()
+	if !now.Before(stream.EndTime) {
+		return errorsmod.Wrapf(types.ErrInvalidStream, "stream %d has ended", id)
+	}
+
+	if _, err := k.settleStream(ctx, &stream); err != nil {
+		return err
+	}
+
+	if err := k.SendCoins(ctx, sender, types.StreamEscrowAddress(id), sdk.NewCoins(amount)); err != nil {
+		return err
+	}
+
+	stream.Deposit = stream.Deposit.Add(amount)
+	if err := k.PaymentStreams.Set(ctx, id, stream); err != nil {
+		return err
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeTopUpStream,
+			sdk.NewAttribute(types.AttributeKeyStreamID, strconv.FormatUint(id, 10)),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
+		),
+	)
+
+	return nil
+}
+
+// CancelStream closes the payment stream with the given id. The coins streamed
+// so far are paid to the recipient and the rest is refunded to the sender. Only
+// the sender of the stream can cancel it. It returns the refunded amount.
+func (k BaseKeeper) CancelStream(ctx context.Context, sender sdk.AccAddress, id uint64) (sdk.Coin, error) {
+	stream, err := k.GetStream(ctx, id)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	isSender, err := k.isStreamAddress(stream.Sender, sender)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	if !isSender {
+		return sdk.Coin{}, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is not the sender of stream %d", sender, id)
+	}
+
+	if _, err := k.settleStream(ctx, &stream); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	refunded := stream.Remaining()
+	if refunded.IsPositive() {
+		if err := k.SendCoins(ctx, types.StreamEscrowAddress(id), sender, sdk.NewCoins(refunded)); err != nil {
+			return sdk.Coin{}, err
+		}
+	}
+
+	if err := k.PaymentStreams.Remove(ctx, id); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCancelStream,
+			sdk.NewAttribute(types.AttributeKeyStreamID, strconv.FormatUint(id, 10)),
+			sdk.NewAttribute(types.AttributeKeyRefunded, refunded.String()),
+		),
+	)
+
+	return refunded, nil
+}
+
+// WithdrawStream pays the coins streamed so far to the recipient of the payment
+// stream with the given id. The stream is closed once it has been fully paid.
+// Only the recipient of the stream can withdraw from it. It returns the paid
+// amount.
+func (k BaseKeeper) WithdrawStream(ctx context.Context, recipient sdk.AccAddress, id uint64) (sdk.Coin, error) {
+	stream, err := k.GetStream(ctx, id)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	isRecipient, err := k.isStreamAddress(stream.Recipient, recipient)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	if !isRecipient {
+		return sdk.Coin{}, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is not the recipient of stream %d", recipient, id)
+	}
+
+	paid, err := k.settleStream(ctx, &stream)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	if stream.Remaining().IsZero() {
+		err = k.PaymentStreams.Remove(ctx, id)
+	} else {
+		err = k.PaymentStreams.Set(ctx, id, stream)
+	}
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeWithdrawStream,
+			sdk.NewAttribute(types.AttributeKeyStreamID, strconv.FormatUint(id, 10)),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, paid.String()),
+		),
+	)
+
+	return paid, nil
+}
+
+// GetStream returns the payment stream with the given id.
+func (k BaseViewKeeper) GetStream(ctx context.Context, id uint64) (types.Stream, error) {
+	stream, err := k.PaymentStreams.Get(ctx, id)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return types.Stream{}, errorsmod.Wrapf(types.ErrStreamNotFound, "stream %d", id)
+		}
+		return types.Stream{}, err
+	}
+
+	return stream, nil
+}
+
+// GetAllStreams returns all the open payment streams, by ascending id.
+func (k BaseViewKeeper) GetAllStreams(ctx context.Context) []types.Stream {
+	iter, err := k.PaymentStreams.Iterate(ctx, nil)
+	if err != nil {
+		panic(err)
+	}
+
+	streams, err := iter.Values()
+	if err != nil {
+		panic(err)
+	}
+
+	return streams
+}
+
+// isStreamAddress returns whether addr is the sender or recipient address of a
+// stream, as stored in the stream.
+func (k BaseKeeper) isStreamAddress(streamAddr string, addr sdk.AccAddress) (bool, error) {
+	bz, err := k.ak.AddressCodec().StringToBytes(streamAddr)
+	if err != nil {
+		return false, err
+	}
+	return addr.Equals(sdk.AccAddress(bz)), nil
+}
+
+// settleStream pays the coins streamed since the last settlement of stream to
+// its recipient, and moves the settlement time of the stream to the current
+// block time. The stream is updated in place but not persisted. It returns the
+// paid amount.
+func (k BaseKeeper) settleStream(ctx context.Context, stream *types.Stream) (sdk.Coin, error) {
+	now := sdk.UnwrapSDKContext(ctx).BlockTime()
+	paid := sdk.NewCoin(stream.Deposit.Denom, stream.Streamed(now))
+
+	if paid.IsPositive() {
+		recipient, err := k.ak.AddressCodec().StringToBytes(stream.Recipient)
+		if err != nil {
+			return sdk.Coin{}, err
+		}
+		if err := k.SendCoins(ctx, types.StreamEscrowAddress(stream.Id), recipient, sdk.NewCoins(paid)); err != nil {
+			return sdk.Coin{}, err
+		}
+		stream.Withdrawn = stream.Withdrawn.Add(paid)
+	}
+
+	if now.After(stream.SettledTime) {
+		stream.SettledTime = now
+		if now.After(stream.EndTime) {
+			stream.SettledTime = stream.EndTime
+		}
+	}
+
+	return paid, nil
+}
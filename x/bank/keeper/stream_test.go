@@ -0,0 +1,149 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	banktestutil "github.com/cosmos/cosmos-sdk/x/bank/testutil"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// mockStreamPayment mocks the transfer of coins out of the escrow account of a stream.
+func (suite *KeeperTestSuite) mockStreamPayment(ctx sdk.Context, id uint64, receiver sdk.AccAddress) {
+	escrow := authtypes.NewBaseAccountWithAddress(banktypes.StreamEscrowAddress(id))
+	suite.mockSendCoins(ctx, escrow, receiver)
+}
+
+func (suite *KeeperTestSuite) TestPaymentStream() {
+	ctx := sdk.UnwrapSDKContext(suite.ctx)
+	require := suite.Require()
+	startTime := ctx.BlockTime()
+	acc0 := authtypes.NewBaseAccountWithAddress(accAddrs[0])
+
+	setTime := func(elapsed time.Duration) {
+		ctx = ctx.WithBlockTime(startTime.Add(elapsed))
+		suite.ctx = ctx
+	}
+
+	suite.bankKeeper.SetSendEnabled(ctx, "stake", true)
+	suite.bankKeeper.SetSendEnabled(ctx, "foo", true)
+	suite.mockFundAccount(accAddrs[0])
+	require.NoError(banktestutil.FundAccount(ctx, suite.bankKeeper, accAddrs[0], sdk.NewCoins(sdk.NewInt64Coin("stake", 2000))))
+
+	// the recipient must exist
+	suite.authKeeper.EXPECT().HasAccount(ctx, accAddrs[2]).Return(false)
+	_, err := suite.msgServer.CreateStream(ctx, banktypes.NewMsgCreateStream(accAddrs[0].String(), accAddrs[2].String(), sdk.NewInt64Coin("stake", 1000), startTime.Add(100*time.Second)))
+	require.ErrorIs(err, sdkerrors.ErrUnknownAddress)
+
+	// the stream must end in the future
+	_, err = suite.msgServer.CreateStream(ctx, banktypes.NewMsgCreateStream(accAddrs[0].String(), accAddrs[1].String(), sdk.NewInt64Coin("stake", 1000), startTime))
+	require.ErrorIs(err, banktypes.ErrInvalidStream)
+
+	// blocked addresses cannot receive streams
+	_, err = suite.msgServer.CreateStream(ctx, banktypes.NewMsgCreateStream(accAddrs[0].String(), accAddrs[4].String(), sdk.NewInt64Coin("stake", 1000), startTime.Add(100*time.Second)))
+	require.ErrorContains(err, "is not allowed to receive funds")
+
+	suite.authKeeper.EXPECT().HasAccount(ctx, accAddrs[1]).Return(true)
+	suite.mockSendCoins(ctx, acc0, banktypes.StreamEscrowAddress(0))
+	res, err := suite.msgServer.CreateStream(ctx, banktypes.NewMsgCreateStream(accAddrs[0].String(), accAddrs[1].String(), sdk.NewInt64Coin("stake", 1000), startTime.Add(100*time.Second)))
+	require.NoError(err)
+	id := res.StreamId
+	require.Equal(sdk.NewInt64Coin("stake", 1000), suite.bankKeeper.GetBalance(ctx, banktypes.StreamEscrowAddress(id), "stake"))
+
+	// a quarter of the stream has flowed
+	setTime(25 * time.Second)
+	suite.mockStreamPayment(ctx, id, accAddrs[1])
+	withdrawRes, err := suite.msgServer.WithdrawStream(ctx, banktypes.NewMsgWithdrawStream(accAddrs[1].String(), id))
+	require.NoError(err)
+	require.Equal(sdk.NewInt64Coin("stake", 250), withdrawRes.Amount)
+	require.Equal(sdk.NewInt64Coin("stake", 250), suite.bankKeeper.GetBalance(ctx, accAddrs[1], "stake"))
+
+	// only the recipient can withdraw
+	_, err = suite.msgServer.WithdrawStream(ctx, banktypes.NewMsgWithdrawStream(accAddrs[0].String(), id))
+	require.ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	setTime(50 * time.Second)
+	queryRes, err := suite.mockQueryClient(ctx).Stream(ctx, &banktypes.QueryStreamRequest{StreamId: id})
+	require.NoError(err)
+	require.Equal(sdk.NewInt64Coin("stake", 250), queryRes.Withdrawable)
+	require.Equal(sdk.NewInt64Coin("stake", 250), queryRes.Stream.Withdrawn)
+
+	// only the sender can top up, in the denom of the stream
+	_, err = suite.msgServer.TopUpStream(ctx, banktypes.NewMsgTopUpStream(accAddrs[1].String(), id, sdk.NewInt64Coin("stake", 500)))
+	require.ErrorIs(err, sdkerrors.ErrUnauthorized)
+	_, err = suite.msgServer.TopUpStream(ctx, banktypes.NewMsgTopUpStream(accAddrs[0].String(), id, sdk.NewInt64Coin("foo", 500)))
+	require.ErrorIs(err, banktypes.ErrInvalidStream)
+
+	// topping up settles the stream, the top up flows until the same end time
+	suite.mockStreamPayment(ctx, id, accAddrs[1])
+	suite.mockSendCoins(ctx, acc0, banktypes.StreamEscrowAddress(id))
+	_, err = suite.msgServer.TopUpStream(ctx, banktypes.NewMsgTopUpStream(accAddrs[0].String(), id, sdk.NewInt64Coin("stake", 500)))
+	require.NoError(err)
+	require.Equal(sdk.NewInt64Coin("stake", 500), suite.bankKeeper.GetBalance(ctx, accAddrs[1], "stake"))
+
+	stream, err := suite.bankKeeper.GetStream(ctx, id)
+	require.NoError(err)
+	require.Equal(sdk.NewInt64Coin("stake", 1500), stream.Deposit)
+	require.Equal(sdk.NewInt64Coin("stake", 1000), stream.Remaining())
+
+	_, broken := keeper.StreamEscrowInvariant(suite.bankKeeper)(ctx)
+	require.False(broken)
+
+	// only the sender can cancel
+	_, err = suite.msgServer.CancelStream(ctx, banktypes.NewMsgCancelStream(accAddrs[1].String(), id))
+	require.ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	// cancelling pays the recipient and refunds the sender
+	setTime(75 * time.Second)
+	suite.mockStreamPayment(ctx, id, accAddrs[1])
+	suite.mockStreamPayment(ctx, id, accAddrs[0])
+	cancelRes, err := suite.msgServer.CancelStream(ctx, banktypes.NewMsgCancelStream(accAddrs[0].String(), id))
+	require.NoError(err)
+	require.Equal(sdk.NewInt64Coin("stake", 500), cancelRes.Refunded)
+	require.Equal(sdk.NewInt64Coin("stake", 1000), suite.bankKeeper.GetBalance(ctx, accAddrs[0], "stake"))
+	require.Equal(sdk.NewInt64Coin("stake", 1000), suite.bankKeeper.GetBalance(ctx, accAddrs[1], "stake"))
+	require.True(suite.bankKeeper.GetBalance(ctx, banktypes.StreamEscrowAddress(id), "stake").IsZero())
+
+	_, err = suite.bankKeeper.GetStream(ctx, id)
+	require.ErrorIs(err, banktypes.ErrStreamNotFound)
+	_, err = suite.mockQueryClient(ctx).Stream(ctx, &banktypes.QueryStreamRequest{StreamId: id})
+	require.Error(err)
+}
+
+func (suite *KeeperTestSuite) TestPaymentStreamEnd() {
+	ctx := sdk.UnwrapSDKContext(suite.ctx)
+	require := suite.Require()
+	startTime := ctx.BlockTime()
+	acc0 := authtypes.NewBaseAccountWithAddress(accAddrs[0])
+
+	suite.mockFundAccount(accAddrs[0])
+	require.NoError(banktestutil.FundAccount(ctx, suite.bankKeeper, accAddrs[0], sdk.NewCoins(sdk.NewInt64Coin("stake", 100))))
+
+	suite.authKeeper.EXPECT().HasAccount(ctx, accAddrs[1]).Return(true)
+	suite.mockSendCoins(ctx, acc0, banktypes.StreamEscrowAddress(0))
+	id, err := suite.bankKeeper.CreateStream(ctx, accAddrs[0], accAddrs[1], sdk.NewInt64Coin("stake", 100), startTime.Add(time.Hour))
+	require.NoError(err)
+
+	res, err := suite.queryClient.Streams(ctx, &banktypes.QueryStreamsRequest{})
+	require.NoError(err)
+	require.Len(res.Streams, 1)
+	require.Equal(accAddrs[0].String(), res.Streams[0].Sender)
+
+	// the whole deposit is withdrawn after the end of the stream, which closes it
+	ctx = ctx.WithBlockTime(startTime.Add(2 * time.Hour))
+	suite.ctx = ctx
+	err = suite.bankKeeper.TopUpStream(ctx, accAddrs[0], id, sdk.NewInt64Coin("stake", 10))
+	require.ErrorIs(err, banktypes.ErrInvalidStream)
+
+	suite.mockStreamPayment(ctx, id, accAddrs[1])
+	paid, err := suite.bankKeeper.WithdrawStream(ctx, accAddrs[1], id)
+	require.NoError(err)
+	require.Equal(sdk.NewInt64Coin("stake", 100), paid)
+
+	_, err = suite.bankKeeper.GetStream(ctx, id)
+	require.ErrorIs(err, banktypes.ErrStreamNotFound)
+	require.Empty(suite.bankKeeper.GetAllStreams(ctx))
+}
@@ -54,6 +54,29 @@ func (b BalancesIndexes) IndexesList() []collections.Index[collections.Pair[sdk.
 	return []collections.Index[collections.Pair[sdk.AccAddress, string], math.Int]{b.Denom}
 }
 
+func newBalanceChangesIndexes(sb *collections.SchemaBuilder) BalanceChangesIndexes {
+	return BalanceChangesIndexes{
+		Height: indexes.NewMulti(
+			sb, types.BalanceChangesByHeightPrefix, "balance_changes_by_height",
+			collections.Uint64Key, collections.TripleKeyCodec(sdk.AccAddressKey, collections.StringKey, collections.Uint64Key),
+			func(key collections.Triple[sdk.AccAddress, string, uint64], _ math.Int) (uint64, error) {
+				return key.K3(), nil
+			},
+		),
+	}
+}
+
+// BalanceChangesIndexes defines the indexes of the balance-change log.
+type BalanceChangesIndexes struct {
+	// Height indexes the balance changes by height, to prune them once they
+	// are older than the retention period.
+	Height *indexes.Multi[uint64, collections.Triple[sdk.AccAddress, string, uint64], math.Int]
+}
+
+func (b BalanceChangesIndexes) IndexesList() []collections.Index[collections.Triple[sdk.AccAddress, string, uint64], math.Int] {
+	return []collections.Index[collections.Triple[sdk.AccAddress, string, uint64], math.Int]{b.Height}
+}
+
 // BaseViewKeeper implements a read only keeper implementation of ViewKeeper.
 type BaseViewKeeper struct {
 	cdc          codec.BinaryCodec
@@ -67,21 +90,59 @@ type BaseViewKeeper struct {
 	SendEnabled   collections.Map[string, bool]
 	Balances      *collections.IndexedMap[collections.Pair[sdk.AccAddress, string], math.Int, BalancesIndexes]
 	Params        collections.Item[types.Params]
+	// DenomAuthority holds the authority metadata of the factory denoms.
+	DenomAuthority collections.Map[string, types.DenomAuthorityMetadata]
+	// DenomsByCreator indexes the factory denoms by (creator, denom).
+	DenomsByCreator collections.KeySet[collections.Pair[string, string]]
+	// BalanceChanges logs the balance of (address, denom) at the end of each
+	// height it changed, when the balance history is enabled.
+	BalanceChanges *collections.IndexedMap[collections.Triple[sdk.AccAddress, string, uint64], math.Int, BalanceChangesIndexes]
+	// Holders indexes the holders of each denom by (denom, balance, address),
+	// when the top holders index is enabled.
+	Holders *indexes.Multi[string, collections.Pair[math.Int, sdk.AccAddress], sdk.Coin]
+	// HolderCount holds the number of holders of each denom, when the top
+	// holders index is enabled.
+	HolderCount collections.Map[string, uint64]
+	// HoldersIndexProgress is set while the top holders index is dropped or
+	// built, during which the index is not ready.
+	HoldersIndexProgress collections.Item[types.HoldersIndexProgress]
+	// PaymentStreams holds the open payment streams by id.
+	PaymentStreams collections.Map[uint64, types.Stream]
+	// StreamSequence generates the ids of the payment streams.
+	StreamSequence collections.Sequence
+	// SendHooks is keyed by (exact, denom, name). Prefix attachments sort
+	// before exact ones, so iterating it yields the execution order.
+	SendHooks collections.KeySet[collections.Triple[bool, string, string]]
 }
 
 // NewBaseViewKeeper returns a new BaseViewKeeper.
 func NewBaseViewKeeper(cdc codec.BinaryCodec, storeService store.KVStoreService, ak types.AccountKeeper, logger log.Logger) BaseViewKeeper {
 	sb := collections.NewSchemaBuilder(storeService)
 	k := BaseViewKeeper{
-		cdc:           cdc,
-		storeService:  storeService,
-		ak:            ak,
-		logger:        logger,
-		Supply:        collections.NewMap(sb, types.SupplyKey, "supply", collections.StringKey, sdk.IntValue),
-		DenomMetadata: collections.NewMap(sb, types.DenomMetadataPrefix, "denom_metadata", collections.StringKey, codec.CollValue[types.Metadata](cdc)),
-		SendEnabled:   collections.NewMap(sb, types.SendEnabledPrefix, "send_enabled", collections.StringKey, codec.BoolValue), // NOTE: we use a bool value which uses protobuf to retain state backwards compat
-		Balances:      collections.NewIndexedMap(sb, types.BalancesPrefix, "balances", collections.PairKeyCodec(sdk.AccAddressKey, collections.StringKey), types.BalanceValueCodec, newBalancesIndexes(sb)),
-		Params:        collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
+		cdc:             cdc,
+		storeService:    storeService,
+		ak:              ak,
+		logger:          logger,
+		Supply:          collections.NewMap(sb, types.SupplyKey, "supply", collections.StringKey, sdk.IntValue),
+		DenomMetadata:   collections.NewMap(sb, types.DenomMetadataPrefix, "denom_metadata", collections.StringKey, codec.CollValue[types.Metadata](cdc)),
+		SendEnabled:     collections.NewMap(sb, types.SendEnabledPrefix, "send_enabled", collections.StringKey, codec.BoolValue), // NOTE: we use a bool value which uses protobuf to retain state backwards compat
+		Balances:        collections.NewIndexedMap(sb, types.BalancesPrefix, "balances", collections.PairKeyCodec(sdk.AccAddressKey, collections.StringKey), types.BalanceValueCodec, newBalancesIndexes(sb)),
+		Params:          collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
+		DenomAuthority:  collections.NewMap(sb, types.DenomAuthorityPrefix, "denom_authority", collections.StringKey, codec.CollValue[types.DenomAuthorityMetadata](cdc)),
+		DenomsByCreator: collections.NewKeySet(sb, types.DenomCreatorPrefix, "denoms_by_creator", collections.PairKeyCodec(collections.StringKey, collections.StringKey)),
+		BalanceChanges:  collections.NewIndexedMap(sb, types.BalanceChangesPrefix, "balance_changes", collections.TripleKeyCodec(sdk.AccAddressKey, collections.StringKey, collections.Uint64Key), sdk.IntValue, newBalanceChangesIndexes(sb)),
+		Holders: indexes.NewMulti(
+			sb, types.HoldersPrefix, "holders_by_balance",
+			collections.StringKey, collections.PairKeyCodec(sdk.IntKey, sdk.AccAddressKey),
+			func(_ collections.Pair[math.Int, sdk.AccAddress], balance sdk.Coin) (string, error) {
+				return balance.Denom, nil
+			},
+		),
+		HolderCount:          collections.NewMap(sb, types.HolderCountPrefix, "holder_count", collections.StringKey, collections.Uint64Value),
+		HoldersIndexProgress: collections.NewItem(sb, types.HoldersIndexProgressKey, "holders_index_progress", codec.CollValue[types.HoldersIndexProgress](cdc)),
+		PaymentStreams:       collections.NewMap(sb, types.StreamsPrefix, "streams", collections.Uint64Key, codec.CollValue[types.Stream](cdc)),
+		StreamSequence:       collections.NewSequence(sb, types.StreamSequenceKey, "stream_sequence"),
+		SendHooks:            collections.NewKeySet(sb, types.SendHooksPrefix, "send_hooks", collections.TripleKeyCodec(collections.BoolKey, collections.StringKey, collections.StringKey)),
 	}
 
 	schema, err := sb.Build()
@@ -75,11 +75,19 @@ func TestMigrateJSON(t *testing.T) {
 		}
 	],
 	"denom_metadata": [],
+	"factory_denoms": [],
+	"next_stream_id": "0",
 	"params": {
+		"balance_history_enabled": false,
+		"balance_history_retention_blocks": "0",
 		"default_send_enabled": false,
-		"send_enabled": []
+		"denom_creation_fee": [],
+		"send_enabled": [],
+		"top_holders_index_enabled": false
 	},
 	"send_enabled": [],
+	"send_hooks": [],
+	"streams": [],
 	"supply": [
 		{
 			"amount": "20",
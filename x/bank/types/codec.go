@@ -16,8 +16,18 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	legacy.RegisterAminoMsg(cdc, &MsgMultiSend{}, "cosmos-sdk/MsgMultiSend")
 	legacy.RegisterAminoMsg(cdc, &MsgUpdateParams{}, "cosmos-sdk/x/bank/MsgUpdateParams")
 	legacy.RegisterAminoMsg(cdc, &MsgSetSendEnabled{}, "cosmos-sdk/MsgSetSendEnabled")
+	legacy.RegisterAminoMsg(cdc, &MsgCreateDenom{}, "cosmos-sdk/x/bank/MsgCreateDenom")
+	legacy.RegisterAminoMsg(cdc, &MsgMint{}, "cosmos-sdk/x/bank/MsgMint")
+	legacy.RegisterAminoMsg(cdc, &MsgBurn{}, "cosmos-sdk/x/bank/MsgBurn")
+	legacy.RegisterAminoMsg(cdc, &MsgChangeAdmin{}, "cosmos-sdk/x/bank/MsgChangeAdmin")
+	legacy.RegisterAminoMsg(cdc, &MsgSetDenomMetadata{}, "cosmos-sdk/x/bank/MsgSetDenomMetadata")
+	legacy.RegisterAminoMsg(cdc, &MsgCreateStream{}, "cosmos-sdk/x/bank/MsgCreateStream")
+	legacy.RegisterAminoMsg(cdc, &MsgTopUpStream{}, "cosmos-sdk/x/bank/MsgTopUpStream")
+	legacy.RegisterAminoMsg(cdc, &MsgCancelStream{}, "cosmos-sdk/x/bank/MsgCancelStream")
+	legacy.RegisterAminoMsg(cdc, &MsgWithdrawStream{}, "cosmos-sdk/x/bank/MsgWithdrawStream")
 
 	cdc.RegisterConcrete(&SendAuthorization{}, "cosmos-sdk/SendAuthorization", nil)
+	cdc.RegisterConcrete(&PeriodicSendAuthorization{}, "cosmos-sdk/PeriodicSendAuthorization", nil)
 	cdc.RegisterConcrete(&Params{}, "cosmos-sdk/x/bank/Params", nil)
 }
 
@@ -26,10 +36,20 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgSend{},
 		&MsgMultiSend{},
 		&MsgUpdateParams{},
+		&MsgCreateDenom{},
+		&MsgMint{},
+		&MsgBurn{},
+		&MsgChangeAdmin{},
+		&MsgSetDenomMetadata{},
+		&MsgCreateStream{},
+		&MsgTopUpStream{},
+		&MsgCancelStream{},
+		&MsgWithdrawStream{},
 	)
 	registry.RegisterImplementations(
 		(*authz.Authorization)(nil),
 		&SendAuthorization{},
+		&PeriodicSendAuthorization{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
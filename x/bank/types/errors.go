@@ -12,4 +12,10 @@ var (
 	ErrInvalidKey            = errors.Register(ModuleName, 7, "invalid key")
 	ErrDuplicateEntry        = errors.Register(ModuleName, 8, "duplicate entry")
 	ErrMultipleSenders       = errors.Register(ModuleName, 9, "multiple senders not allowed")
+	ErrInvalidFactoryDenom   = errors.Register(ModuleName, 10, "invalid factory denom")
+	ErrFactoryDenomExists    = errors.Register(ModuleName, 11, "factory denom already exists")
+	ErrUnauthorizedAdmin     = errors.Register(ModuleName, 12, "unauthorized factory denom admin")
+	ErrStreamNotFound        = errors.Register(ModuleName, 13, "payment stream not found")
+	ErrInvalidStream         = errors.Register(ModuleName, 14, "invalid payment stream")
+	ErrSendHooksNotFound     = errors.Register(ModuleName, 15, "send hooks not found")
 )
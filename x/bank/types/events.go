@@ -21,6 +21,24 @@ const (
 	AttributeKeyReceiver = "receiver"
 	AttributeKeyMinter   = "minter"
 	AttributeKeyBurner   = "burner"
+
+	// factory denom events name and attributes
+	EventTypeCreateDenom      = "create_denom"
+	EventTypeChangeAdmin      = "change_admin"
+	EventTypeSetDenomMetadata = "set_denom_metadata"
+
+	AttributeKeyCreator  = "creator"
+	AttributeKeyDenom    = "denom"
+	AttributeKeyNewAdmin = "new_admin"
+
+	// payment stream events name and attributes
+	EventTypeCreateStream   = "create_stream"
+	EventTypeTopUpStream    = "top_up_stream"
+	EventTypeCancelStream   = "cancel_stream"
+	EventTypeWithdrawStream = "withdraw_stream"
+
+	AttributeKeyStreamID = "stream_id"
+	AttributeKeyRefunded = "refunded"
 )
 
 // NewCoinSpentEvent constructs a new coin spent sdk.Event
@@ -0,0 +1,85 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// FactoryDenomPrefix is the prefix of every denom created through
+	// MsgCreateDenom.
+	FactoryDenomPrefix = "factory"
+
+	// MaxSubdenomLength is the maximum length of the subdenom part of a factory
+	// denom.
+	MaxSubdenomLength = 44
+
+	// MaxCreatorLength is the maximum length of the creator part of a factory
+	// denom. Together with the prefix, the separators and the subdenom it fits
+	// within the 128 characters accepted by sdk.ValidateDenom.
+	MaxCreatorLength = 128 - len(FactoryDenomPrefix) - 2 - MaxSubdenomLength
+)
+
+// GetFactoryDenom returns the factory denom factory/{creator}/{subdenom}. It
+// returns an error if the resulting denom is not a valid coin denomination.
+func GetFactoryDenom(creator, subdenom string) (string, error) {
+	if len(subdenom) > MaxSubdenomLength {
+		return "", errorsmod.Wrapf(ErrInvalidFactoryDenom, "subdenom too long, max length is %d bytes", MaxSubdenomLength)
+	}
+	if len(creator) > MaxCreatorLength {
+		return "", errorsmod.Wrapf(ErrInvalidFactoryDenom, "creator too long, max length is %d bytes", MaxCreatorLength)
+	}
+	if strings.Contains(creator, "/") {
+		return "", errorsmod.Wrapf(ErrInvalidFactoryDenom, "creator %s cannot contain '/'", creator)
+	}
+
+	denom := strings.Join([]string{FactoryDenomPrefix, creator, subdenom}, "/")
+	if err := sdk.ValidateDenom(denom); err != nil {
+		return "", errorsmod.Wrap(ErrInvalidFactoryDenom, err.Error())
+	}
+
+	return denom, nil
+}
+
+// DeconstructFactoryDenom splits a factory denom into its creator and subdenom
+// parts. The subdenom may itself contain '/'.
+func DeconstructFactoryDenom(denom string) (creator, subdenom string, err error) {
+	if err := sdk.ValidateDenom(denom); err != nil {
+		return "", "", errorsmod.Wrap(ErrInvalidFactoryDenom, err.Error())
+	}
+
+	parts := strings.SplitN(denom, "/", 3)
+	if len(parts) < 3 || parts[0] != FactoryDenomPrefix {
+		return "", "", errorsmod.Wrapf(ErrInvalidFactoryDenom, "denom %s must be of the form %s/{creator}/{subdenom}", denom, FactoryDenomPrefix)
+	}
+	if parts[1] == "" {
+		return "", "", errorsmod.Wrapf(ErrInvalidFactoryDenom, "denom %s has an empty creator", denom)
+	}
+
+	return parts[1], parts[2], nil
+}
+
+// FactoryDenomCreatorPrefix returns the prefix shared by all the factory denoms
+// created by creator.
+func FactoryDenomCreatorPrefix(creator string) string {
+	return fmt.Sprintf("%s/%s/", FactoryDenomPrefix, creator)
+}
+
+// Validate performs a basic validation of the factory denom.
+func (fd FactoryDenom) Validate() error {
+	if _, _, err := DeconstructFactoryDenom(fd.Denom); err != nil {
+		return err
+	}
+
+	if fd.AuthorityMetadata.Admin != "" {
+		if _, err := sdk.AccAddressFromBech32(fd.AuthorityMetadata.Admin); err != nil {
+			return fmt.Errorf("invalid admin address for denom %s: %w", fd.Denom, err)
+		}
+	}
+
+	return nil
+}
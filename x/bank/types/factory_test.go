@@ -0,0 +1,55 @@
+package types_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func TestFactoryDenom(t *testing.T) {
+	creator := "cosmos1yq8lgssgxlx9smjhes6ryjasmqmd3ts2559g0t"
+
+	testCases := []struct {
+		name     string
+		creator  string
+		subdenom string
+		expDenom string
+		expErr   bool
+	}{
+		{"valid", creator, "bitcoin", "factory/" + creator + "/bitcoin", false},
+		{"subdenom with slashes", creator, "bit/coin", "factory/" + creator + "/bit/coin", false},
+		{"empty subdenom", creator, "", "factory/" + creator + "/", false},
+		{"invalid subdenom", creator, "bit%coin", "", true},
+		{"subdenom too long", creator, strings.Repeat("a", types.MaxSubdenomLength+1), "", true},
+		{"creator too long", strings.Repeat("a", types.MaxCreatorLength+1), "bitcoin", "", true},
+		{"creator with slashes", "cosmos/1", "bitcoin", "", true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			denom, err := types.GetFactoryDenom(tc.creator, tc.subdenom)
+			if tc.expErr {
+				require.ErrorIs(t, err, types.ErrInvalidFactoryDenom)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expDenom, denom)
+
+			actualCreator, actualSubdenom, err := types.DeconstructFactoryDenom(denom)
+			require.NoError(t, err)
+			require.Equal(t, tc.creator, actualCreator)
+			require.Equal(t, tc.subdenom, actualSubdenom)
+		})
+	}
+}
+
+func TestDeconstructFactoryDenom(t *testing.T) {
+	for _, denom := range []string{"uatom", "ibc/ABCDEF", "factory//bitcoin", "factory/cosmos1"} {
+		_, _, err := types.DeconstructFactoryDenom(denom)
+		require.ErrorIs(t, err, types.ErrInvalidFactoryDenom, denom)
+	}
+}
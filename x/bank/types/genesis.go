@@ -23,6 +23,9 @@ func (gs GenesisState) Validate() error {
 	seenSendEnabled := make(map[string]bool)
 	seenBalances := make(map[string]bool)
 	seenMetadatas := make(map[string]bool)
+	seenFactoryDenoms := make(map[string]bool)
+	seenStreams := make(map[uint64]bool)
+	seenSendHooks := make(map[SendHooksAttachment]bool)
 
 	totalSupply := sdk.Coins{}
 
@@ -62,6 +65,46 @@ func (gs GenesisState) Validate() error {
 		seenMetadatas[metadata.Base] = true
 	}
 
+	for _, factoryDenom := range gs.FactoryDenoms {
+		if seenFactoryDenoms[factoryDenom.Denom] {
+			return fmt.Errorf("duplicate factory denom %s", factoryDenom.Denom)
+		}
+
+		if err := factoryDenom.Validate(); err != nil {
+			return err
+		}
+
+		seenFactoryDenoms[factoryDenom.Denom] = true
+	}
+
+	for _, stream := range gs.Streams {
+		if seenStreams[stream.Id] {
+			return fmt.Errorf("duplicate stream %d", stream.Id)
+		}
+
+		if stream.Id >= gs.NextStreamId {
+			return fmt.Errorf("stream id %d must be lower than the next stream id %d", stream.Id, gs.NextStreamId)
+		}
+
+		if err := stream.Validate(); err != nil {
+			return err
+		}
+
+		seenStreams[stream.Id] = true
+	}
+
+	for _, attachment := range gs.SendHooks {
+		if seenSendHooks[attachment] {
+			return fmt.Errorf("duplicate send hooks %s attached to %s", attachment.Name, attachment.Denom)
+		}
+
+		if err := attachment.Validate(); err != nil {
+			return err
+		}
+
+		seenSendHooks[attachment] = true
+	}
+
 	if !gs.Supply.Empty() {
 		// NOTE: this errors if supply for any given coin is zero
 		err := gs.Supply.Validate()
@@ -42,6 +42,15 @@ type GenesisState struct {
 	//
 	// Since: cosmos-sdk 0.47
 	SendEnabled []SendEnabled `protobuf:"bytes,5,rep,name=send_enabled,json=sendEnabled,proto3" json:"send_enabled"`
+	// factory_denoms defines the denoms created through MsgCreateDenom along
+	// with their authority metadata.
+	FactoryDenoms []FactoryDenom `protobuf:"bytes,6,rep,name=factory_denoms,json=factoryDenoms,proto3" json:"factory_denoms"`
+	// streams defines the open payment streams.
+	Streams []Stream `protobuf:"bytes,7,rep,name=streams,proto3" json:"streams"`
+	// next_stream_id is the id of the next payment stream to be opened.
+	NextStreamId uint64 `protobuf:"varint,8,opt,name=next_stream_id,json=nextStreamId,proto3" json:"next_stream_id,omitempty"`
+	// send_hooks defines the send hooks attached to denoms and denom prefixes.
+	SendHooks []SendHooksAttachment `protobuf:"bytes,9,rep,name=send_hooks,json=sendHooks,proto3" json:"send_hooks"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -112,6 +121,34 @@ func (m *GenesisState) GetSendEnabled() []SendEnabled {
 	return nil
 }
 
+func (m *GenesisState) GetFactoryDenoms() []FactoryDenom {
+	if m != nil {
+		return m.FactoryDenoms
+	}
+	return nil
+}
+
+func (m *GenesisState) GetStreams() []Stream {
+	if m != nil {
+		return m.Streams
+	}
+	return nil
+}
+
+func (m *GenesisState) GetNextStreamId() uint64 {
+	if m != nil {
+		return m.NextStreamId
+	}
+	return 0
+}
+
+func (m *GenesisState) GetSendHooks() []SendHooksAttachment {
+	if m != nil {
+		return m.SendHooks
+	}
+	return nil
+}
+
 // Balance defines an account address and balance pair used in the bank module's
 // genesis state.
 type Balance struct {
@@ -154,44 +191,111 @@ func (m *Balance) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Balance proto.InternalMessageInfo
 
+// FactoryDenom defines a factory denom and its authority metadata, used in the
+// bank module's genesis state.
+type FactoryDenom struct {
+	// denom is the full factory denom, i.e. factory/{creator}/{subdenom}.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// authority_metadata holds the admin of the denom.
+	AuthorityMetadata DenomAuthorityMetadata `protobuf:"bytes,2,opt,name=authority_metadata,json=authorityMetadata,proto3" json:"authority_metadata"`
+}
+
+func (m *FactoryDenom) Reset()         { *m = FactoryDenom{} }
+func (m *FactoryDenom) String() string { return proto.CompactTextString(m) }
+func (*FactoryDenom) ProtoMessage()    {}
+func (*FactoryDenom) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8f007de11b420c6e, []int{2}
+}
+func (m *FactoryDenom) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FactoryDenom) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FactoryDenom.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FactoryDenom) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FactoryDenom.Merge(m, src)
+}
+func (m *FactoryDenom) XXX_Size() int {
+	return m.Size()
+}
+func (m *FactoryDenom) XXX_DiscardUnknown() {
+	xxx_messageInfo_FactoryDenom.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FactoryDenom proto.InternalMessageInfo
+
+func (m *FactoryDenom) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *FactoryDenom) GetAuthorityMetadata() DenomAuthorityMetadata {
+	if m != nil {
+		return m.AuthorityMetadata
+	}
+	return DenomAuthorityMetadata{}
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "cosmos.bank.v1beta1.GenesisState")
 	proto.RegisterType((*Balance)(nil), "cosmos.bank.v1beta1.Balance")
+	proto.RegisterType((*FactoryDenom)(nil), "cosmos.bank.v1beta1.FactoryDenom")
 }
 
 func init() { proto.RegisterFile("cosmos/bank/v1beta1/genesis.proto", fileDescriptor_8f007de11b420c6e) }
 
 var fileDescriptor_8f007de11b420c6e = []byte{
-	// 461 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x92, 0xbf, 0x6f, 0xd3, 0x40,
-	0x14, 0xc7, 0x6d, 0x42, 0xd3, 0xf6, 0x12, 0x90, 0x38, 0x3a, 0xb8, 0x05, 0x9c, 0xd0, 0x29, 0x54,
-	0xaa, 0xad, 0x86, 0x8d, 0x01, 0x09, 0x47, 0xc0, 0xc4, 0x0f, 0x35, 0x1b, 0x4b, 0x74, 0xf6, 0x3d,
-	0x19, 0xab, 0xf1, 0x9d, 0x95, 0x77, 0x05, 0xf2, 0x1f, 0x30, 0x32, 0x33, 0x75, 0x44, 0x2c, 0x74,
-	0xe0, 0x0f, 0x60, 0xec, 0x58, 0x31, 0x31, 0x01, 0x4a, 0x86, 0xf2, 0x67, 0x20, 0xdf, 0x5d, 0x5d,
-	0x4b, 0x58, 0x8c, 0x5d, 0x12, 0xeb, 0xbe, 0xdf, 0xf7, 0xf9, 0xbe, 0xf7, 0xee, 0xc8, 0xdd, 0x44,
-	0x62, 0x2e, 0x31, 0x8c, 0x99, 0x38, 0x08, 0xdf, 0xec, 0xc5, 0xa0, 0xd8, 0x5e, 0x98, 0x82, 0x00,
-	0xcc, 0x30, 0x28, 0x66, 0x52, 0x49, 0x7a, 0xd3, 0x58, 0x82, 0xd2, 0x12, 0x58, 0xcb, 0xd6, 0x46,
-	0x2a, 0x53, 0xa9, 0xf5, 0xb0, 0xfc, 0x32, 0xd6, 0x2d, 0xbf, 0xa2, 0x21, 0x54, 0xb4, 0x44, 0x66,
-	0xe2, 0x1f, 0xbd, 0x96, 0xa6, 0xb9, 0x46, 0xdf, 0x34, 0xfa, 0xc4, 0x80, 0x6d, 0xae, 0x91, 0x6e,
-	0xb0, 0x3c, 0x13, 0x32, 0xd4, 0xbf, 0xe6, 0x68, 0xfb, 0x4b, 0x8b, 0x74, 0x9f, 0x9a, 0x56, 0xc7,
-	0x8a, 0x29, 0xa0, 0x0f, 0x49, 0xbb, 0x60, 0x33, 0x96, 0xa3, 0xe7, 0xf6, 0xdd, 0x41, 0x67, 0x78,
-	0x2b, 0x68, 0x68, 0x3d, 0x78, 0xa9, 0x2d, 0xd1, 0xfa, 0xc9, 0xcf, 0x9e, 0xf3, 0xe9, 0xec, 0x78,
-	0xc7, 0xdd, 0xb7, 0x55, 0x74, 0x44, 0xd6, 0x62, 0x36, 0x65, 0x22, 0x01, 0xf4, 0xae, 0xf4, 0x5b,
-	0x83, 0xce, 0xf0, 0x76, 0x23, 0x21, 0x32, 0xa6, 0x3a, 0xa2, 0x2a, 0xa4, 0x73, 0xd2, 0xc6, 0xc3,
-	0xa2, 0x98, 0xce, 0xbd, 0x96, 0x46, 0x6c, 0x5e, 0x20, 0x10, 0x2a, 0xc4, 0x48, 0x66, 0x22, 0x7a,
-	0x52, 0xd6, 0x7f, 0xfe, 0xd5, 0x1b, 0xa4, 0x99, 0x7a, 0x7d, 0x18, 0x07, 0x89, 0xcc, 0xed, 0xd0,
-	0xf6, 0x6f, 0x17, 0xf9, 0x41, 0xa8, 0xe6, 0x05, 0xa0, 0x2e, 0xc0, 0x8f, 0x67, 0xc7, 0x3b, 0xdd,
-	0x29, 0xa4, 0x2c, 0x99, 0x4f, 0xca, 0xb5, 0xa2, 0xed, 0xdf, 0x04, 0xd2, 0x17, 0xe4, 0x3a, 0x07,
-	0x21, 0xf3, 0x49, 0x0e, 0x8a, 0x71, 0xa6, 0x98, 0x77, 0x55, 0xb7, 0x70, 0xa7, 0x71, 0x8a, 0x67,
-	0xd6, 0x54, 0x1f, 0xe3, 0x9a, 0xae, 0x3f, 0x57, 0xe8, 0x73, 0xd2, 0x45, 0x10, 0x7c, 0x02, 0x82,
-	0xc5, 0x53, 0xe0, 0xde, 0x8a, 0xc6, 0xf5, 0x1b, 0x71, 0x63, 0x10, 0xfc, 0xb1, 0xf1, 0xd5, 0x89,
-	0x1d, 0xbc, 0x38, 0xdf, 0xfe, 0xe6, 0x92, 0x55, 0xbb, 0x3c, 0x3a, 0x24, 0xab, 0x8c, 0xf3, 0x19,
-	0xa0, 0xb9, 0xad, 0xf5, 0xc8, 0xfb, 0xfe, 0x75, 0x77, 0xc3, 0x92, 0x1f, 0x19, 0x65, 0xac, 0x66,
-	0x99, 0x48, 0xf7, 0xcf, 0x8d, 0xf4, 0x2d, 0x59, 0xd1, 0x63, 0xdb, 0xdb, 0xb9, 0x84, 0xd5, 0x9a,
-	0xbc, 0x07, 0x6b, 0xef, 0x8f, 0x7a, 0xce, 0x9f, 0xa3, 0x9e, 0x13, 0x8d, 0x4e, 0x16, 0xbe, 0x7b,
-	0xba, 0xf0, 0xdd, 0xdf, 0x0b, 0xdf, 0xfd, 0xb0, 0xf4, 0x9d, 0xd3, 0xa5, 0xef, 0xfc, 0x58, 0xfa,
-	0xce, 0xab, 0x7b, 0xff, 0x8d, 0x7a, 0x67, 0x1e, 0xbd, 0x4e, 0x8c, 0xdb, 0xfa, 0x01, 0xdf, 0xff,
-	0x1b, 0x00, 0x00, 0xff, 0xff, 0x78, 0x65, 0xc7, 0x6b, 0x7e, 0x03, 0x00, 0x00,
+	// 611 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x94, 0x3f, 0x6f, 0xd3, 0x4e,
+	0x18, 0xc7, 0xed, 0xa6, 0xf9, 0x77, 0xc9, 0x2f, 0x52, 0xef, 0x97, 0xc1, 0x2d, 0xe0, 0xa4, 0x11,
+	0x43, 0x28, 0xaa, 0xad, 0x86, 0x8d, 0x01, 0x91, 0x04, 0x0a, 0x0c, 0xfc, 0x51, 0xb2, 0xb1, 0x58,
+	0x67, 0xfb, 0xea, 0x58, 0x89, 0xef, 0x22, 0xdf, 0x05, 0xea, 0x77, 0x80, 0xc4, 0xc2, 0xcc, 0xd4,
+	0x11, 0x31, 0x75, 0xe0, 0x05, 0x74, 0xec, 0x58, 0x31, 0x31, 0x01, 0x4a, 0x86, 0xf2, 0x32, 0x90,
+	0xef, 0x9c, 0xc4, 0xa8, 0x2e, 0x23, 0x4b, 0x12, 0xdf, 0xf7, 0xfb, 0x7c, 0x9e, 0xe7, 0xb9, 0xe7,
+	0x89, 0xc1, 0xae, 0x43, 0x59, 0x40, 0x99, 0x69, 0x23, 0x32, 0x36, 0xdf, 0x1c, 0xd8, 0x98, 0xa3,
+	0x03, 0xd3, 0xc3, 0x04, 0x33, 0x9f, 0x19, 0xd3, 0x90, 0x72, 0x0a, 0xff, 0x97, 0x16, 0x23, 0xb6,
+	0x18, 0x89, 0x65, 0xa7, 0xee, 0x51, 0x8f, 0x0a, 0xdd, 0x8c, 0x7f, 0x49, 0xeb, 0x8e, 0xbe, 0xa2,
+	0x31, 0xbc, 0xa2, 0x39, 0xd4, 0x27, 0x57, 0xf4, 0x54, 0x36, 0xc1, 0x95, 0xfa, 0xb6, 0xd4, 0x2d,
+	0x09, 0x4e, 0xf2, 0x4a, 0x69, 0x0b, 0x05, 0x3e, 0xa1, 0xa6, 0xf8, 0x94, 0x47, 0xad, 0xb3, 0x3c,
+	0xa8, 0x3e, 0x91, 0xa5, 0x0e, 0x39, 0xe2, 0x18, 0x3e, 0x00, 0x85, 0x29, 0x0a, 0x51, 0xc0, 0x34,
+	0xb5, 0xa9, 0xb6, 0x2b, 0x9d, 0x1b, 0x46, 0x46, 0xe9, 0xc6, 0x2b, 0x61, 0xe9, 0x95, 0xcf, 0xbf,
+	0x37, 0x94, 0x4f, 0x97, 0xa7, 0x7b, 0xea, 0x20, 0x89, 0x82, 0x7d, 0x50, 0xb2, 0xd1, 0x04, 0x11,
+	0x07, 0x33, 0x6d, 0xa3, 0x99, 0x6b, 0x57, 0x3a, 0x37, 0x33, 0x09, 0x3d, 0x69, 0x4a, 0x23, 0x56,
+	0x81, 0x30, 0x02, 0x05, 0x36, 0x9b, 0x4e, 0x27, 0x91, 0x96, 0x13, 0x88, 0xed, 0x35, 0x82, 0xe1,
+	0x15, 0xa2, 0x4f, 0x7d, 0xd2, 0x3b, 0x8c, 0xe3, 0x3f, 0xff, 0x68, 0xb4, 0x3d, 0x9f, 0x8f, 0x66,
+	0xb6, 0xe1, 0xd0, 0x20, 0x69, 0x3a, 0xf9, 0xda, 0x67, 0xee, 0xd8, 0xe4, 0xd1, 0x14, 0x33, 0x11,
+	0xc0, 0x3e, 0x5e, 0x9e, 0xee, 0x55, 0x27, 0xd8, 0x43, 0x4e, 0x64, 0xc5, 0xd7, 0xca, 0x92, 0xfa,
+	0x65, 0x42, 0xf8, 0x12, 0xd4, 0x5c, 0x4c, 0x68, 0x60, 0x05, 0x98, 0x23, 0x17, 0x71, 0xa4, 0x6d,
+	0x8a, 0x12, 0x6e, 0x65, 0x76, 0xf1, 0x3c, 0x31, 0xa5, 0xdb, 0xf8, 0x4f, 0xc4, 0x2f, 0x15, 0xf8,
+	0x02, 0x54, 0x19, 0x26, 0xae, 0x85, 0x09, 0xb2, 0x27, 0xd8, 0xd5, 0xf2, 0x02, 0xd7, 0xcc, 0xc4,
+	0x0d, 0x31, 0x71, 0x1f, 0x4b, 0x5f, 0x9a, 0x58, 0x61, 0xeb, 0x73, 0x38, 0x04, 0xb5, 0x23, 0xe4,
+	0x70, 0x1a, 0x46, 0x96, 0x48, 0xc4, 0xb4, 0x82, 0x20, 0xee, 0x66, 0x12, 0x0f, 0xa5, 0xf5, 0x51,
+	0xec, 0xfc, 0xa3, 0xc8, 0xa3, 0x94, 0xc0, 0xe0, 0x43, 0x50, 0x64, 0x3c, 0xc4, 0xf1, 0xd8, 0x8b,
+	0xcd, 0xdc, 0xb5, 0x63, 0x1f, 0x0a, 0x4f, 0x9a, 0xb3, 0x0c, 0x83, 0xb7, 0x41, 0x8d, 0xe0, 0x63,
+	0x6e, 0xc9, 0x67, 0xcb, 0x77, 0xb5, 0x52, 0x53, 0x6d, 0x6f, 0x0e, 0xaa, 0xf1, 0xa9, 0x8c, 0x7b,
+	0xe6, 0xc2, 0x01, 0x00, 0xe2, 0x32, 0x46, 0x94, 0x8e, 0x99, 0x56, 0x16, 0xa9, 0xda, 0xd7, 0x5e,
+	0xc5, 0xd3, 0xd8, 0xd5, 0xe5, 0x1c, 0x39, 0xa3, 0x00, 0x13, 0x9e, 0xce, 0x5b, 0x66, 0x4b, 0xbd,
+	0x75, 0xa6, 0x82, 0x62, 0xb2, 0x4d, 0xb0, 0x03, 0x8a, 0xc8, 0x75, 0x43, 0xcc, 0xe4, 0xfa, 0x96,
+	0x7b, 0xda, 0xd7, 0x2f, 0xfb, 0xf5, 0x84, 0xdf, 0x95, 0xca, 0x90, 0x87, 0x3e, 0xf1, 0x06, 0x4b,
+	0x23, 0x7c, 0x0b, 0xf2, 0x62, 0x0f, 0xb4, 0x8d, 0x7f, 0xb5, 0x6b, 0x32, 0xdf, 0xfd, 0xd2, 0xbb,
+	0x93, 0x86, 0xf2, 0xeb, 0xa4, 0xa1, 0xb4, 0xde, 0xab, 0xa0, 0x9a, 0x9e, 0x14, 0xac, 0x83, 0xbc,
+	0x18, 0xae, 0xec, 0x62, 0x20, 0x1f, 0x20, 0x06, 0x10, 0xcd, 0xf8, 0x88, 0x86, 0x3e, 0x8f, 0xd6,
+	0xfb, 0xb9, 0x21, 0xfe, 0xa7, 0x77, 0x33, 0x6f, 0x51, 0xd0, 0xba, 0xcb, 0x98, 0xac, 0x6d, 0xdd,
+	0x42, 0x57, 0xd4, 0xfe, 0xf9, 0x5c, 0x57, 0x2f, 0xe6, 0xba, 0xfa, 0x73, 0xae, 0xab, 0x1f, 0x16,
+	0xba, 0x72, 0xb1, 0xd0, 0x95, 0x6f, 0x0b, 0x5d, 0x79, 0x7d, 0xe7, 0xaf, 0x8d, 0x1f, 0xcb, 0x77,
+	0x92, 0xe8, 0xdf, 0x2e, 0x88, 0xf7, 0xcb, 0xbd, 0xdf, 0x03, 0x00, 0xe5, 0x46, 0x88, 0x31, 0x1d,
+	0x05, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -214,6 +318,53 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.SendHooks) > 0 {
+		for iNdEx := len(m.SendHooks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SendHooks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if m.NextStreamId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.NextStreamId))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.Streams) > 0 {
+		for iNdEx := len(m.Streams) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Streams[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.FactoryDenoms) > 0 {
+		for iNdEx := len(m.FactoryDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FactoryDenoms[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
 	if len(m.SendEnabled) > 0 {
 		for iNdEx := len(m.SendEnabled) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -327,6 +478,46 @@ func (m *Balance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *FactoryDenom) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FactoryDenom) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FactoryDenom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.AuthorityMetadata.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGenesis(v)
 	base := offset
@@ -370,6 +561,27 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.FactoryDenoms) > 0 {
+		for _, e := range m.FactoryDenoms {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Streams) > 0 {
+		for _, e := range m.Streams {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.NextStreamId != 0 {
+		n += 1 + sovGenesis(uint64(m.NextStreamId))
+	}
+	if len(m.SendHooks) > 0 {
+		for _, e := range m.SendHooks {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -392,6 +604,21 @@ func (m *Balance) Size() (n int) {
 	return n
 }
 
+func (m *FactoryDenom) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.AuthorityMetadata.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
 func sovGenesis(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -596,6 +823,127 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FactoryDenoms", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FactoryDenoms = append(m.FactoryDenoms, FactoryDenom{})
+			if err := m.FactoryDenoms[len(m.FactoryDenoms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Streams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Streams = append(m.Streams, Stream{})
+			if err := m.Streams[len(m.Streams)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextStreamId", wireType)
+			}
+			m.NextStreamId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextStreamId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SendHooks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SendHooks = append(m.SendHooks, SendHooksAttachment{})
+			if err := m.SendHooks[len(m.SendHooks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -733,6 +1081,121 @@ func (m *Balance) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *FactoryDenom) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FactoryDenom: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FactoryDenom: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthorityMetadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AuthorityMetadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipGenesis(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -2,6 +2,7 @@ package types
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -12,6 +13,20 @@ import (
 )
 
 func TestGenesisStateValidate(t *testing.T) {
+	startTime := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	stream := func(id uint64) Stream {
+		return Stream{
+			Id:          id,
+			Sender:      "cosmos1yq8lgssgxlx9smjhes6ryjasmqmd3ts2559g0t",
+			Recipient:   "cosmos1t5u0jfg3ljsjrh2m9e47d4ny2hea7eehxrzdgd",
+			Deposit:     sdk.NewInt64Coin("stake", 100),
+			Withdrawn:   sdk.NewInt64Coin("stake", 40),
+			StartTime:   startTime,
+			EndTime:     startTime.Add(time.Hour),
+			SettledTime: startTime.Add(time.Minute),
+		}
+	}
+
 	testCases := []struct {
 		name         string
 		genesisState GenesisState
@@ -143,6 +158,86 @@ func TestGenesisStateValidate(t *testing.T) {
 			},
 			true,
 		},
+		{
+			"valid factory denoms",
+			GenesisState{
+				FactoryDenoms: []FactoryDenom{
+					{
+						Denom:             "factory/cosmos1yq8lgssgxlx9smjhes6ryjasmqmd3ts2559g0t/bitcoin",
+						AuthorityMetadata: DenomAuthorityMetadata{Admin: "cosmos1yq8lgssgxlx9smjhes6ryjasmqmd3ts2559g0t"},
+					},
+					{
+						Denom: "factory/cosmos1yq8lgssgxlx9smjhes6ryjasmqmd3ts2559g0t/litecoin",
+					},
+				},
+			},
+			false,
+		},
+		{
+			"duplicate factory denoms",
+			GenesisState{
+				FactoryDenoms: []FactoryDenom{
+					{Denom: "factory/cosmos1yq8lgssgxlx9smjhes6ryjasmqmd3ts2559g0t/bitcoin"},
+					{Denom: "factory/cosmos1yq8lgssgxlx9smjhes6ryjasmqmd3ts2559g0t/bitcoin"},
+				},
+			},
+			true,
+		},
+		{
+			"invalid factory denom",
+			GenesisState{
+				FactoryDenoms: []FactoryDenom{{Denom: "uatom"}},
+			},
+			true,
+		},
+		{
+			"invalid factory denom admin",
+			GenesisState{
+				FactoryDenoms: []FactoryDenom{
+					{
+						Denom:             "factory/cosmos1yq8lgssgxlx9smjhes6ryjasmqmd3ts2559g0t/bitcoin",
+						AuthorityMetadata: DenomAuthorityMetadata{Admin: "invalid"},
+					},
+				},
+			},
+			true,
+		},
+		{
+			"valid streams",
+			GenesisState{
+				Streams:      []Stream{stream(0), stream(3)},
+				NextStreamId: 4,
+			},
+			false,
+		},
+		{
+			"duplicate streams",
+			GenesisState{
+				Streams:      []Stream{stream(0), stream(0)},
+				NextStreamId: 1,
+			},
+			true,
+		},
+		{
+			"stream id not lower than the next stream id",
+			GenesisState{
+				Streams:      []Stream{stream(1)},
+				NextStreamId: 1,
+			},
+			true,
+		},
+		{
+			"stream withdrawn amount exceeds its deposit",
+			GenesisState{
+				Streams: []Stream{func() Stream {
+					s := stream(0)
+					s.Withdrawn = sdk.NewInt64Coin("stake", 200)
+					return s
+				}()},
+				NextStreamId: 1,
+			},
+			true,
+		},
 	}
 
 	for _, tc := range testCases {
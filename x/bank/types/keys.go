@@ -17,6 +17,22 @@ const (
 
 	// RouterKey defines the module's message routing key
 	RouterKey = ModuleName
+
+	// DefaultTopHoldersLimit is the number of holders returned by the
+	// TopHolders query when no limit is given.
+	DefaultTopHoldersLimit = 10
+
+	// MaxTopHoldersLimit is the maximum number of holders returned by the
+	// TopHolders query.
+	MaxTopHoldersLimit = 100
+
+	// MaxBalanceChangesPrunedPerBlock is the maximum number of balance-change
+	// log entries pruned at the beginning of a block.
+	MaxBalanceChangesPrunedPerBlock = 1000
+
+	// HoldersIndexBatchGasLimit is the gas that can be spent at the beginning
+	// of a block to drop or build the top holders index.
+	HoldersIndexBatchGasLimit = 10_000_000
 )
 
 // KVStore keys
@@ -32,6 +48,39 @@ var (
 
 	// ParamsKey is the prefix for x/bank parameters
 	ParamsKey = collections.NewPrefix(5)
+
+	// DenomAuthorityPrefix is the prefix for the authority metadata of the
+	// denoms created through MsgCreateDenom.
+	DenomAuthorityPrefix = collections.NewPrefix(6)
+	// DenomCreatorPrefix is the prefix for the index of factory denoms by
+	// creator.
+	DenomCreatorPrefix = collections.NewPrefix(7)
+
+	// BalanceChangesPrefix is the prefix for the balance-change log.
+	BalanceChangesPrefix = collections.NewPrefix(8)
+
+	// HoldersPrefix is the prefix for the index of the holders of each denom
+	// ordered by balance.
+	HoldersPrefix = collections.NewPrefix(9)
+	// HolderCountPrefix is the prefix for the number of holders of each denom.
+	HolderCountPrefix = collections.NewPrefix(10)
+
+	// StreamsPrefix is the prefix for the payment streams.
+	StreamsPrefix = collections.NewPrefix(11)
+	// StreamSequenceKey is the key of the sequence of the payment stream ids.
+	StreamSequenceKey = collections.NewPrefix(12)
+
+	// SendHooksPrefix is the prefix for the send hooks attached to denoms and
+	// denom prefixes.
+	SendHooksPrefix = collections.NewPrefix(13)
+
+	// BalanceChangesByHeightPrefix is the prefix for the index of the
+	// balance-change log by height.
+	BalanceChangesByHeightPrefix = collections.NewPrefix(14)
+
+	// HoldersIndexProgressKey is the key of the progress of the top holders
+	// index while it is dropped or built.
+	HoldersIndexProgressKey = collections.NewPrefix(15)
 )
 
 // BalanceValueCodec is a codec for encoding bank balances in a backwards compatible way.
@@ -1,6 +1,8 @@
 package types
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -8,6 +10,15 @@ var (
 	_ sdk.Msg = &MsgSend{}
 	_ sdk.Msg = &MsgMultiSend{}
 	_ sdk.Msg = &MsgUpdateParams{}
+	_ sdk.Msg = &MsgCreateDenom{}
+	_ sdk.Msg = &MsgMint{}
+	_ sdk.Msg = &MsgBurn{}
+	_ sdk.Msg = &MsgChangeAdmin{}
+	_ sdk.Msg = &MsgSetDenomMetadata{}
+	_ sdk.Msg = &MsgCreateStream{}
+	_ sdk.Msg = &MsgTopUpStream{}
+	_ sdk.Msg = &MsgCancelStream{}
+	_ sdk.Msg = &MsgWithdrawStream{}
 )
 
 // NewMsgSend - construct a msg to send coins from one account to another.
@@ -28,3 +39,49 @@ func NewMsgSetSendEnabled(authority string, sendEnabled []*SendEnabled, useDefau
 		UseDefaultFor: useDefaultFor,
 	}
 }
+
+// NewMsgCreateDenom creates a msg to create a new factory denom.
+func NewMsgCreateDenom(sender, subdenom string) *MsgCreateDenom {
+	return &MsgCreateDenom{Sender: sender, Subdenom: subdenom}
+}
+
+// NewMsgMint creates a msg to mint coins of a factory denom to the given address.
+func NewMsgMint(sender string, amount sdk.Coin, mintToAddress string) *MsgMint {
+	return &MsgMint{Sender: sender, Amount: amount, MintToAddress: mintToAddress}
+}
+
+// NewMsgBurn creates a msg to burn coins of factory denoms.
+func NewMsgBurn(fromAddr string, amount sdk.Coins) *MsgBurn {
+	return &MsgBurn{FromAddress: fromAddr, Amount: amount}
+}
+
+// NewMsgChangeAdmin creates a msg to change the admin of a factory denom.
+func NewMsgChangeAdmin(sender, denom, newAdmin string) *MsgChangeAdmin {
+	return &MsgChangeAdmin{Sender: sender, Denom: denom, NewAdmin: newAdmin}
+}
+
+// NewMsgSetDenomMetadata creates a msg to set the metadata of a factory denom.
+func NewMsgSetDenomMetadata(sender string, metadata Metadata) *MsgSetDenomMetadata {
+	return &MsgSetDenomMetadata{Sender: sender, Metadata: metadata}
+}
+
+// NewMsgCreateStream creates a msg to open a payment stream.
+func NewMsgCreateStream(sender, recipient string, amount sdk.Coin, endTime time.Time) *MsgCreateStream {
+	return &MsgCreateStream{Sender: sender, Recipient: recipient, Amount: amount, EndTime: endTime}
+}
+
+// NewMsgTopUpStream creates a msg to add coins to a payment stream.
+func NewMsgTopUpStream(sender string, streamID uint64, amount sdk.Coin) *MsgTopUpStream {
+	return &MsgTopUpStream{Sender: sender, StreamId: streamID, Amount: amount}
+}
+
+// NewMsgCancelStream creates a msg to cancel a payment stream.
+func NewMsgCancelStream(sender string, streamID uint64) *MsgCancelStream {
+	return &MsgCancelStream{Sender: sender, StreamId: streamID}
+}
+
+// NewMsgWithdrawStream creates a msg to withdraw the coins streamed to the
+// recipient of a payment stream.
+func NewMsgWithdrawStream(recipient string, streamID uint64) *MsgWithdrawStream {
+	return &MsgWithdrawStream{Recipient: recipient, StreamId: streamID}
+}
@@ -31,7 +31,10 @@ func (p Params) Validate() error {
 	if len(p.SendEnabled) > 0 {
 		return errors.New("use of send_enabled in params is no longer supported")
 	}
-	return validateIsBool(p.DefaultSendEnabled)
+	if err := validateIsBool(p.DefaultSendEnabled); err != nil {
+		return err
+	}
+	return validateDenomCreationFee(p.DenomCreationFee)
 }
 
 // Validate gets any errors with this SendEnabled entry.
@@ -48,6 +51,14 @@ func NewSendEnabled(denom string, sendEnabled bool) *SendEnabled {
 	}
 }
 
+// validateDenomCreationFee checks that the denom creation fee is a valid set of coins.
+func validateDenomCreationFee(fee sdk.Coins) error {
+	if err := fee.Validate(); err != nil {
+		return fmt.Errorf("invalid denom creation fee: %w", err)
+	}
+	return nil
+}
+
 // validateIsBool is used by the x/params module to validate that a thing is a bool.
 func validateIsBool(i interface{}) error {
 	_, ok := i.(bool)
@@ -0,0 +1,132 @@
+package types
+
+import (
+	context "context"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+)
+
+var _ authz.Authorization = &PeriodicSendAuthorization{}
+
+// NewPeriodicSendAuthorization creates a new PeriodicSendAuthorization object.
+// An empty spendLimit sets no total limit. The first period starts with the
+// first send.
+func NewPeriodicSendAuthorization(spendLimit sdk.Coins, period time.Duration, periodSpendLimit sdk.Coins, allowed []sdk.AccAddress) *PeriodicSendAuthorization {
+	return &PeriodicSendAuthorization{
+		SpendLimit:       spendLimit,
+		Period:           period,
+		PeriodSpendLimit: periodSpendLimit,
+		PeriodCanSpend:   periodSpendLimit,
+		AllowList:        toBech32Addresses(allowed),
+	}
+}
+
+// MsgTypeURL implements Authorization.MsgTypeURL.
+func (a PeriodicSendAuthorization) MsgTypeURL() string {
+	return sdk.MsgTypeURL(&MsgSend{})
+}
+
+// Accept implements Authorization.Accept. The sent amount is deducted from
+// both the current period and the total spend limit, the period being reset
+// first if its reset time has been reached.
+func (a PeriodicSendAuthorization) Accept(ctx context.Context, msg sdk.Msg) (authz.AcceptResponse, error) {
+	mSend, ok := msg.(*MsgSend)
+	if !ok {
+		return authz.AcceptResponse{}, sdkerrors.ErrInvalidType.Wrap("type mismatch")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	toAddr := mSend.ToAddress
+	isAddrExists := false
+	for _, addr := range a.AllowList {
+		sdkCtx.GasMeter().ConsumeGas(gasCostPerIteration, "periodic send authorization")
+		if addr == toAddr {
+			isAddrExists = true
+			break
+		}
+	}
+
+	if len(a.AllowList) > 0 && !isAddrExists {
+		return authz.AcceptResponse{}, sdkerrors.ErrUnauthorized.Wrapf("cannot send to %s address", toAddr)
+	}
+
+	a.tryResetPeriod(sdkCtx.BlockTime())
+
+	var isNeg bool
+	a.PeriodCanSpend, isNeg = a.PeriodCanSpend.SafeSub(mSend.Amount...)
+	if isNeg {
+		return authz.AcceptResponse{}, errorsmod.Wrap(sdkerrors.ErrInsufficientFunds, "requested amount is more than period spend limit")
+	}
+
+	if !a.SpendLimit.Empty() {
+		a.SpendLimit, isNeg = a.SpendLimit.SafeSub(mSend.Amount...)
+		if isNeg {
+			return authz.AcceptResponse{}, errorsmod.Wrap(sdkerrors.ErrInsufficientFunds, "requested amount is more than spend limit")
+		}
+
+		if a.SpendLimit.IsZero() {
+			return authz.AcceptResponse{Accept: true, Delete: true}, nil
+		}
+	}
+
+	return authz.AcceptResponse{Accept: true, Updated: &a}, nil
+}
+
+// tryResetPeriod tops up PeriodCanSpend to the lesser of PeriodSpendLimit and
+// SpendLimit if PeriodReset has been reached, and moves PeriodReset one Period
+// forward, or one Period from blockTime if more than one Period passed since
+// the last reset.
+func (a *PeriodicSendAuthorization) tryResetPeriod(blockTime time.Time) {
+	if blockTime.Before(a.PeriodReset) {
+		return
+	}
+
+	if _, isNeg := a.SpendLimit.SafeSub(a.PeriodSpendLimit...); isNeg && !a.SpendLimit.Empty() {
+		a.PeriodCanSpend = a.SpendLimit
+	} else {
+		a.PeriodCanSpend = a.PeriodSpendLimit
+	}
+
+	a.PeriodReset = a.PeriodReset.Add(a.Period)
+	if blockTime.After(a.PeriodReset) {
+		a.PeriodReset = blockTime.Add(a.Period)
+	}
+}
+
+// ValidateBasic implements Authorization.ValidateBasic.
+func (a PeriodicSendAuthorization) ValidateBasic() error {
+	if !a.SpendLimit.IsValid() {
+		return sdkerrors.ErrInvalidCoins.Wrapf("spend limit is invalid: %s", a.SpendLimit)
+	}
+	if !a.PeriodSpendLimit.IsValid() {
+		return sdkerrors.ErrInvalidCoins.Wrapf("period spend limit is invalid: %s", a.PeriodSpendLimit)
+	}
+	if !a.PeriodSpendLimit.IsAllPositive() {
+		return sdkerrors.ErrInvalidCoins.Wrap("period spend limit must be positive")
+	}
+	if !a.PeriodCanSpend.IsValid() {
+		return sdkerrors.ErrInvalidCoins.Wrapf("period can spend is invalid: %s", a.PeriodCanSpend)
+	}
+	if !a.SpendLimit.Empty() && !a.PeriodSpendLimit.DenomsSubsetOf(a.SpendLimit) {
+		return sdkerrors.ErrInvalidCoins.Wrap("period spend limit has different denoms than spend limit")
+	}
+	if a.Period <= 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("period must be positive")
+	}
+
+	found := make(map[string]bool, 0)
+	for i := 0; i < len(a.AllowList); i++ {
+		if found[a.AllowList[i]] {
+			return ErrDuplicateEntry
+		}
+
+		found[a.AllowList[i]] = true
+	}
+
+	return nil
+}
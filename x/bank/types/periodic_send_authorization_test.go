@@ -0,0 +1,67 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func TestPeriodicSendAuthorization(t *testing.T) {
+	now := time.Now().UTC()
+	ctx := testutil.DefaultContextWithDB(t, storetypes.NewKVStoreKey(types.StoreKey), storetypes.NewTransientStoreKey("transient_test")).Ctx.WithBlockTime(now)
+	coins300 := sdk.NewCoins(sdk.NewInt64Coin("stake", 300))
+	day := 24 * time.Hour
+
+	require.Error(t, types.NewPeriodicSendAuthorization(nil, 0, coins500, nil).ValidateBasic())
+	require.Error(t, types.NewPeriodicSendAuthorization(nil, day, nil, nil).ValidateBasic())
+	require.Error(t, types.NewPeriodicSendAuthorization(sdk.NewCoins(sdk.NewInt64Coin("atom", 1000)), day, coins500, nil).ValidateBasic())
+	require.Error(t, types.NewPeriodicSendAuthorization(nil, day, coins500, []sdk.AccAddress{toAddr, toAddr}).ValidateBasic())
+
+	authorization := types.NewPeriodicSendAuthorization(coins1000, day, coins500, []sdk.AccAddress{toAddr})
+	require.Equal(t, "/cosmos.bank.v1beta1.MsgSend", authorization.MsgTypeURL())
+	require.NoError(t, authorization.ValidateBasic())
+
+	t.Log("verify the recipient must be in the allow list")
+	_, err := authorization.Accept(ctx, types.NewMsgSend(fromAddr, unknownAddr, coins300))
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	t.Log("verify the first send starts the period")
+	resp, err := authorization.Accept(ctx, types.NewMsgSend(fromAddr, toAddr, coins300))
+	require.NoError(t, err)
+	require.True(t, resp.Accept)
+	require.False(t, resp.Delete)
+	updated := resp.Updated.(*types.PeriodicSendAuthorization)
+	require.Equal(t, now.Add(day), updated.PeriodReset)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 200)), updated.PeriodCanSpend)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 700)), updated.SpendLimit)
+
+	t.Log("verify the period spend limit is enforced")
+	_, err = updated.Accept(ctx, types.NewMsgSend(fromAddr, toAddr, coins300))
+	require.ErrorIs(t, err, sdkerrors.ErrInsufficientFunds)
+
+	t.Log("verify the period spend limit is reset once the period is over")
+	ctx = ctx.WithBlockTime(now.Add(day))
+	resp, err = updated.Accept(ctx, types.NewMsgSend(fromAddr, toAddr, coins500))
+	require.NoError(t, err)
+	updated = resp.Updated.(*types.PeriodicSendAuthorization)
+	require.Equal(t, now.Add(2*day), updated.PeriodReset)
+	require.True(t, updated.PeriodCanSpend.IsZero())
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 200)), updated.SpendLimit)
+
+	t.Log("verify the period spend limit is capped to the spend limit and the authorization deleted once used up")
+	ctx = ctx.WithBlockTime(now.Add(5 * day))
+	_, err = updated.Accept(ctx, types.NewMsgSend(fromAddr, toAddr, coins300))
+	require.ErrorIs(t, err, sdkerrors.ErrInsufficientFunds)
+	resp, err = updated.Accept(ctx, types.NewMsgSend(fromAddr, toAddr, sdk.NewCoins(sdk.NewInt64Coin("stake", 200))))
+	require.NoError(t, err)
+	require.True(t, resp.Delete)
+	require.Nil(t, resp.Updated)
+}
@@ -0,0 +1,50 @@
+package types
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SendHookGasLimit is the maximum amount of gas a single send hook call can
+// consume.
+const SendHookGasLimit uint64 = 500_000
+
+// A BeforeSendHookFn is called before the coins of a transfer are credited to
+// the receiver. Returning an error aborts the transfer.
+type BeforeSendHookFn func(ctx context.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) error
+
+// An AfterReceiveHookFn is called after the coins of a transfer have been
+// credited to the receiver. Returning an error aborts the transfer.
+type AfterReceiveHookFn func(ctx context.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) error
+
+// SendHooks are the hooks registered in the bank keeper under a name, and
+// attached to denoms or denom prefixes through SendHooksAttachments. Hooks only
+// receive the coins of the transfer they are attached to. Either hook can be
+// nil.
+type SendHooks struct {
+	// Name identifies the hooks, usually the name of the module registering them.
+	Name string
+
+	BeforeSend   BeforeSendHookFn
+	AfterReceive AfterReceiveHookFn
+}
+
+// Validate performs a basic validation of the send hooks attachment.
+func (a SendHooksAttachment) Validate() error {
+	if a.Name == "" {
+		return errors.New("send hooks name cannot be empty")
+	}
+	if a.IsPrefix {
+		if a.Denom == "" {
+			return errors.New("send hooks prefix cannot be empty")
+		}
+		return nil
+	}
+	if err := sdk.ValidateDenom(a.Denom); err != nil {
+		return fmt.Errorf("invalid send hooks denom: %w", err)
+	}
+	return nil
+}
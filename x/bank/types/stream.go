@@ -0,0 +1,75 @@
+package types
+
+import (
+	"fmt"
+	"math/big"
+	"time"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
+// StreamEscrowAddress returns the address of the account escrowing the coins of
+// the payment stream with the given id.
+func StreamEscrowAddress(id uint64) sdk.AccAddress {
+	return address.Module(ModuleName, []byte("stream"), sdk.Uint64ToBigEndian(id))
+}
+
+// Remaining returns the coins of the stream which have not been paid to the
+// recipient yet.
+func (s Stream) Remaining() sdk.Coin {
+	return s.Deposit.Sub(s.Withdrawn)
+}
+
+// Streamed returns the amount streamed to the recipient between the last
+// settlement of the stream and now, which has not been paid yet.
+func (s Stream) Streamed(now time.Time) math.Int {
+	remaining := s.Remaining().Amount
+	switch {
+	case !now.Before(s.EndTime):
+		return remaining
+	case !now.After(s.SettledTime):
+		return math.ZeroInt()
+	}
+
+	// the product is computed on a big.Int, as it can exceed the bit length of
+	// math.Int, while the quotient is bounded by the remaining amount
+	elapsed := big.NewInt(now.Sub(s.SettledTime).Nanoseconds())
+	duration := big.NewInt(s.EndTime.Sub(s.SettledTime).Nanoseconds())
+	streamed := new(big.Int).Mul(remaining.BigInt(), elapsed)
+	return math.NewIntFromBigInt(streamed.Quo(streamed, duration))
+}
+
+// Validate performs a stateless validation of the stream.
+func (s Stream) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(s.Sender); err != nil {
+		return fmt.Errorf("invalid stream %d sender: %w", s.Id, err)
+	}
+	if _, err := sdk.AccAddressFromBech32(s.Recipient); err != nil {
+		return fmt.Errorf("invalid stream %d recipient: %w", s.Id, err)
+	}
+	if s.Sender == s.Recipient {
+		return fmt.Errorf("stream %d sender and recipient must be different", s.Id)
+	}
+
+	if !s.Deposit.IsValid() || !s.Deposit.IsPositive() {
+		return fmt.Errorf("invalid stream %d deposit: %s", s.Id, s.Deposit)
+	}
+	if !s.Withdrawn.IsValid() || s.Withdrawn.Denom != s.Deposit.Denom {
+		return fmt.Errorf("invalid stream %d withdrawn amount: %s", s.Id, s.Withdrawn)
+	}
+	if s.Withdrawn.Amount.GT(s.Deposit.Amount) {
+		return fmt.Errorf("stream %d withdrawn amount %s exceeds its deposit %s", s.Id, s.Withdrawn, s.Deposit)
+	}
+
+	if !s.StartTime.Before(s.EndTime) {
+		return fmt.Errorf("stream %d must end after it starts", s.Id)
+	}
+	if s.SettledTime.Before(s.StartTime) || s.SettledTime.After(s.EndTime) {
+		return fmt.Errorf("stream %d settlement time must be between its start and end times", s.Id)
+	}
+
+	return nil
+}
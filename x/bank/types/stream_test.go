@@ -0,0 +1,66 @@
+package types
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestStreamStreamed(t *testing.T) {
+	startTime := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	stream := Stream{
+		Deposit:     sdk.NewInt64Coin("stake", 1000),
+		Withdrawn:   sdk.NewInt64Coin("stake", 100),
+		StartTime:   startTime,
+		EndTime:     startTime.Add(100 * time.Second),
+		SettledTime: startTime.Add(10 * time.Second),
+	}
+
+	testCases := []struct {
+		name     string
+		elapsed  time.Duration
+		expected math.Int
+	}{
+		{"before the settlement", 5 * time.Second, math.ZeroInt()},
+		{"at the settlement", 10 * time.Second, math.ZeroInt()},
+		{"between the settlement and the end", 40 * time.Second, math.NewInt(300)},
+		{"rounded down", 10*time.Second + time.Millisecond, math.ZeroInt()},
+		{"at the end", 100 * time.Second, math.NewInt(900)},
+		{"after the end", time.Hour, math.NewInt(900)},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			streamed := stream.Streamed(startTime.Add(tc.elapsed))
+			require.True(t, tc.expected.Equal(streamed), "expected %s, got %s", tc.expected, streamed)
+		})
+	}
+}
+
+func TestStreamStreamedLargeAmount(t *testing.T) {
+	startTime := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	// the largest amount a math.Int can hold
+	amount := math.NewIntFromBigInt(new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 256), big.NewInt(1)))
+	stream := Stream{
+		Deposit:     sdk.NewCoin("stake", amount),
+		Withdrawn:   sdk.NewInt64Coin("stake", 0),
+		StartTime:   startTime,
+		EndTime:     startTime.Add(365 * 24 * time.Hour),
+		SettledTime: startTime,
+	}
+
+	streamed := stream.Streamed(startTime.Add(365 * 12 * time.Hour))
+	require.Equal(t, amount.QuoRaw(2), streamed)
+}
+
+func TestStreamEscrowAddress(t *testing.T) {
+	require.NotEqual(t, StreamEscrowAddress(0), StreamEscrowAddress(1))
+	require.Equal(t, StreamEscrowAddress(1), StreamEscrowAddress(1))
+}
@@ -13,6 +13,12 @@ type CircuitBreaker interface {
 	IsAllowed(ctx context.Context, typeURL string) (bool, error)
 }
 
+// TxMsgsRecorder is an optional interface of a circuit breaker recording the
+// messages of the transactions executed in a block.
+type TxMsgsRecorder interface {
+	RecordTxMsgs(ctx context.Context, msgs []sdk.Msg) error
+}
+
 // CircuitBreakerDecorator is an AnteDecorator that checks if the transaction type is allowed to enter the mempool or be executed
 type CircuitBreakerDecorator struct {
 	circuitKeeper CircuitBreaker
@@ -37,5 +43,11 @@ func (cbd CircuitBreakerDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simula
 		}
 	}
 
+	if recorder, ok := cbd.circuitKeeper.(TxMsgsRecorder); ok && ctx.ExecMode() == sdk.ExecModeFinalize {
+		if err := recorder.RecordTxMsgs(ctx, tx.GetMsgs()); err != nil {
+			return ctx, err
+		}
+	}
+
 	return next(ctx, tx, simulate)
 }
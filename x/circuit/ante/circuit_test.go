@@ -38,6 +38,16 @@ func (m MockCircuitBreaker) IsAllowed(ctx context.Context, typeURL string) (bool
 	return typeURL == "/cosmos.circuit.v1.MsgAuthorizeCircuitBreaker", nil
 }
 
+type MockRecordingCircuitBreaker struct {
+	MockCircuitBreaker
+	recorded []sdk.Msg
+}
+
+func (m *MockRecordingCircuitBreaker) RecordTxMsgs(ctx context.Context, msgs []sdk.Msg) error {
+	m.recorded = append(m.recorded, msgs...)
+	return nil
+}
+
 func initFixture(t *testing.T) *fixture {
 	mockStoreKey := storetypes.NewKVStoreKey("test")
 	encCfg := moduletestutil.MakeTestEncodingConfig(auth.AppModuleBasic{}, bank.AppModuleBasic{})
@@ -92,3 +102,32 @@ func TestCircuitBreakerDecorator(t *testing.T) {
 		}
 	}
 }
+
+func TestCircuitBreakerDecoratorRecordTxMsgs(t *testing.T) {
+	t.Parallel()
+	f := initFixture(t)
+
+	msg := &cbtypes.MsgAuthorizeCircuitBreaker{
+		Grantee: "cosmos1fghij",
+		Granter: "cosmos1abcde",
+	}
+	require.NoError(t, f.txBuilder.SetMsgs(msg))
+	tx := f.txBuilder.GetTx()
+
+	circuitBreaker := &MockRecordingCircuitBreaker{}
+	decorator := ante.NewCircuitBreakerDecorator(circuitBreaker)
+	next := func(ctx sdk.Context, tx sdk.Tx, simulate bool) (newCtx sdk.Context, err error) {
+		return ctx, nil
+	}
+
+	// messages are not recorded when checking a transaction
+	sdkCtx := sdk.UnwrapSDKContext(f.ctx)
+	_, err := decorator.AnteHandle(sdkCtx.WithExecMode(sdk.ExecModeCheck), tx, false, next)
+	require.NoError(t, err)
+	require.Empty(t, circuitBreaker.recorded)
+
+	// messages are recorded when executing a block
+	_, err = decorator.AnteHandle(sdkCtx.WithExecMode(sdk.ExecModeFinalize), tx, false, next)
+	require.NoError(t, err)
+	require.Len(t, circuitBreaker.recorded, 1)
+}
@@ -51,12 +51,7 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 				},
 				{
 					RpcMethod: "TripCircuitBreaker",
-					Use:       "disable [msg_type_urls]",
-					Short:     "Disable a message from being executed",
-					Example:   fmt.Sprintf(`%s circuit disable "cosmos.bank.v1beta1.MsgSend cosmos.bank.v1beta1.MsgMultiSend"`, version.AppName),
-					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
-						{ProtoField: "msg_type_urls", Varargs: true},
-					},
+					Skip:      true, // skipped because of the expiry flags of the custom disable command
 				},
 				{
 					RpcMethod: "ResetCircuitBreaker",
@@ -68,6 +63,7 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					},
 				},
 			},
+			EnhanceCustomCommand: true,
 		},
 	}
 }
@@ -0,0 +1,279 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/circuit/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+)
+
+// flag for circuit module
+const (
+	FlagMaxExecutions = "max-executions"
+	FlagMaxFailures   = "max-failures"
+	FlagMaxVolume     = "max-volume"
+	FlagVolumeField   = "volume-field"
+	FlagExpiryHeight  = "expiry-height"
+	FlagExpiryTime    = "expiry-time"
+)
+
+// GetTxCmd returns the transaction commands for circuit module
+func GetTxCmd() *cobra.Command {
+	circuitTxCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Circuit transactions sub-commands",
+		Long:                       "Trip and reset the circuit breaker of messages and manage the rules tripping it automatically",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	circuitTxCmd.AddCommand(
+		NewCmdTripCircuitBreaker(),
+		NewCmdExtendCircuitBreakerTrip(),
+		NewCmdSetTripRule(),
+		NewCmdRemoveTripRule(),
+	)
+
+	return circuitTxCmd
+}
+
+// NewCmdTripCircuitBreaker returns a CLI command handler to create a MsgTripCircuitBreaker transaction.
+func NewCmdTripCircuitBreaker() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "disable [msg_type_urls]",
+		Short: "Disable a message from being executed",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(
+				`Disable messages from being executed, until they are reset or, when set, until the
+--expiry-height or --expiry-time is reached, whichever comes first.
+
+Examples:
+%s tx %s disable "cosmos.bank.v1beta1.MsgSend cosmos.bank.v1beta1.MsgMultiSend"
+%s tx %s disable cosmos.bank.v1beta1.MsgSend --expiry-height 1000000 --from mykey
+				`, version.AppName, types.ModuleName, version.AppName, types.ModuleName,
+			),
+		),
+		Args: cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			expiryHeight, expiryTime, err := getExpiry(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgTripCircuitBreaker{
+				Authority:    clientCtx.GetFromAddress().String(),
+				MsgTypeUrls:  args,
+				ExpiryHeight: expiryHeight,
+				ExpiryTime:   expiryTime,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	addExpiryFlags(cmd)
+
+	return cmd
+}
+
+// NewCmdExtendCircuitBreakerTrip returns a CLI command handler to create a MsgExtendCircuitBreakerTrip transaction.
+func NewCmdExtendCircuitBreakerTrip() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "extend-circuit-breaker-trip [msg_type_urls] --from [super_admin]",
+		Short: "Postpone the expiry of the trip of disabled messages",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(
+				`Replace the expiry of the trip of disabled messages by a later --expiry-height or
+--expiry-time. Only super admins can extend trips.
+
+Examples:
+%s tx %s extend-circuit-breaker-trip cosmos.bank.v1beta1.MsgSend --expiry-time 2024-12-31T00:00:00Z --from mykey
+				`, version.AppName, types.ModuleName,
+			),
+		),
+		Args: cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			expiryHeight, expiryTime, err := getExpiry(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgExtendCircuitBreakerTrip{
+				Authority:    clientCtx.GetFromAddress().String(),
+				MsgTypeUrls:  args,
+				ExpiryHeight: expiryHeight,
+				ExpiryTime:   expiryTime,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	addExpiryFlags(cmd)
+
+	return cmd
+}
+
+// NewCmdSetTripRule returns a CLI command handler to create a MsgSetTripRule transaction.
+func NewCmdSetTripRule() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-trip-rule [msg_type_url] [window] --from [super_admin]",
+		Short: "Set the rule automatically tripping the circuit breaker of a message",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(
+				`Set the rule automatically tripping the circuit breaker of a message when, over the
+last [window] blocks, its successful executions exceed --max-executions, its executions in
+failed transactions exceed --max-failures or the coins it moved exceed --max-volume.
+The coins moved by a message are the ones of its field named by --volume-field.
+Only super admins can set trip rules.
+
+Examples:
+%s tx %s set-trip-rule /cosmos.bank.v1beta1.MsgSend 100 --max-volume 1000000stake --volume-field amount --from mykey
+%s tx %s set-trip-rule /cosmos.bank.v1beta1.MsgSend 10 --max-executions 500 --max-failures 50 --from mykey
+				`, version.AppName, types.ModuleName, version.AppName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			window, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			maxExecutions, err := cmd.Flags().GetUint64(FlagMaxExecutions)
+			if err != nil {
+				return err
+			}
+
+			maxFailures, err := cmd.Flags().GetUint64(FlagMaxFailures)
+			if err != nil {
+				return err
+			}
+
+			rule := &types.TripRule{
+				MsgTypeUrl:    args[0],
+				Window:        window,
+				MaxExecutions: maxExecutions,
+				MaxFailures:   maxFailures,
+				MaxVolume:     math.ZeroInt(),
+			}
+
+			maxVolume, err := cmd.Flags().GetString(FlagMaxVolume)
+			if err != nil {
+				return err
+			}
+
+			if maxVolume != "" {
+				coin, err := sdk.ParseCoinNormalized(maxVolume)
+				if err != nil {
+					return err
+				}
+				rule.VolumeDenom = coin.Denom
+				rule.MaxVolume = coin.Amount
+			}
+
+			rule.VolumeField, err = cmd.Flags().GetString(FlagVolumeField)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgSetTripRule{
+				Authority: clientCtx.GetFromAddress().String(),
+				Rule:      rule,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	cmd.Flags().Uint64(FlagMaxExecutions, 0, "Maximum successful executions of the message in the window, 0 for no limit")
+	cmd.Flags().Uint64(FlagMaxFailures, 0, "Maximum executions of the message in failed transactions in the window, 0 for no limit")
+	cmd.Flags().String(FlagMaxVolume, "", "Maximum coins of a denom moved by the message in the window (ex: 1000000stake)")
+	cmd.Flags().String(FlagVolumeField, "", "Field of the message holding the coins it moves, required by --max-volume (ex: amount)")
+
+	return cmd
+}
+
+// NewCmdRemoveTripRule returns a CLI command handler to create a MsgRemoveTripRule transaction.
+func NewCmdRemoveTripRule() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "remove-trip-rule [msg_type_url] --from [super_admin]",
+		Short: "Remove the rule automatically tripping the circuit breaker of a message",
+		Example: fmt.Sprintf(
+			`%s tx %s remove-trip-rule /cosmos.bank.v1beta1.MsgSend --from mykey`,
+			version.AppName, types.ModuleName,
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgRemoveTripRule{
+				Authority:  clientCtx.GetFromAddress().String(),
+				MsgTypeUrl: args[0],
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func addExpiryFlags(cmd *cobra.Command) {
+	cmd.Flags().Uint64(FlagExpiryHeight, 0, "Block height from which the messages are enabled again")
+	cmd.Flags().String(FlagExpiryTime, "", "Block time from which the messages are enabled again, in RFC3339 format (ex: 2024-12-31T00:00:00Z)")
+}
+
+func getExpiry(cmd *cobra.Command) (uint64, *time.Time, error) {
+	expiryHeight, err := cmd.Flags().GetUint64(FlagExpiryHeight)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	exp, err := cmd.Flags().GetString(FlagExpiryTime)
+	if err != nil || exp == "" {
+		return expiryHeight, nil, err
+	}
+
+	expiryTime, err := time.Parse(time.RFC3339, exp)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	return expiryHeight, &expiryTime, nil
+}
@@ -10,6 +10,8 @@ func (k *Keeper) ExportGenesis(ctx context.Context) (data *types.GenesisState) {
 	var (
 		permissions  []*types.GenesisAccountPermissions
 		disabledMsgs []string
+		tripRules    []*types.TripRule
+		tripExpiries []*types.TripExpiry
 	)
 
 	err := k.Permissions.Walk(ctx, nil, func(address []byte, perm types.Permissions) (stop bool, err error) {
@@ -37,9 +39,27 @@ func (k *Keeper) ExportGenesis(ctx context.Context) (data *types.GenesisState) {
 		panic(err)
 	}
 
+	err = k.TripRules.Walk(ctx, nil, func(_ string, rule types.TripRule) (stop bool, err error) {
+		tripRules = append(tripRules, &rule)
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	err = k.TripExpiries.Walk(ctx, nil, func(_ string, expiry types.TripExpiry) (stop bool, err error) {
+		tripExpiries = append(tripExpiries, &expiry)
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
 	return &types.GenesisState{
 		AccountPermissions: permissions,
 		DisabledTypeUrls:   disabledMsgs,
+		TripRules:          tripRules,
+		TripExpiries:       tripExpiries,
 	}
 }
 
@@ -62,4 +82,16 @@ func (k *Keeper) InitGenesis(ctx context.Context, genState *types.GenesisState)
 			panic(err)
 		}
 	}
+	for _, rule := range genState.TripRules {
+		// Set the trip rules
+		if err := k.TripRules.Set(ctx, rule.MsgTypeUrl, *rule); err != nil {
+			panic(err)
+		}
+	}
+	for _, expiry := range genState.TripExpiries {
+		// Set the trip expiries
+		if err := k.TripExpiries.Set(ctx, expiry.MsgTypeUrl, *expiry); err != nil {
+			panic(err)
+		}
+	}
 }
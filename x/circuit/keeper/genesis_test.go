@@ -6,6 +6,7 @@ import (
 
 	"github.com/stretchr/testify/suite"
 
+	"cosmossdk.io/math"
 	storetypes "cosmossdk.io/store/types"
 	"cosmossdk.io/x/circuit"
 	"cosmossdk.io/x/circuit/keeper"
@@ -71,6 +72,12 @@ func (s *GenesisTestSuite) TestInitExportGenesis() {
 	genesisState := &types.GenesisState{
 		AccountPermissions: accounts,
 		DisabledTypeUrls:   []string{url},
+		TripRules: []*types.TripRule{
+			{MsgTypeUrl: "trip_url", Window: 10, MaxFailures: 5, VolumeDenom: "stake", VolumeField: "amount", MaxVolume: math.NewInt(100)},
+		},
+		TripExpiries: []*types.TripExpiry{
+			{MsgTypeUrl: url, ExpiryHeight: 100},
+		},
 	}
 
 	s.keeper.InitGenesis(s.ctx, genesisState)
@@ -85,4 +92,6 @@ func (s *GenesisTestSuite) TestInitExportGenesis() {
 
 	s.Require().Equal(genesisState.AccountPermissions, exportedGenesisState.AccountPermissions)
 	s.Require().Equal(genesisState.DisabledTypeUrls, exportedGenesisState.DisabledTypeUrls)
+	s.Require().Equal(genesisState.TripRules, exportedGenesisState.TripRules)
+	s.Require().Equal(genesisState.TripExpiries, exportedGenesisState.TripExpiries)
 }
@@ -25,6 +25,12 @@ type Keeper struct {
 	Permissions collections.Map[[]byte, types.Permissions]
 	// DisableList contains the message URLs that are disabled
 	DisableList collections.KeySet[string]
+	// TripRules contains the rules automatically tripping the circuit breaker by message URL
+	TripRules collections.Map[string, types.TripRule]
+	// MsgStats contains the execution statistics of the message URLs watched by a trip rule by block height
+	MsgStats collections.Map[collections.Pair[string, int64], types.MsgStats]
+	// TripExpiries contains the expiries of the trips of the disabled message URLs, by message URL
+	TripExpiries collections.Map[string, types.TripExpiry]
 }
 
 // NewKeeper constructs a new Circuit Keeper instance
@@ -54,6 +60,27 @@ func NewKeeper(cdc codec.BinaryCodec, storeService store.KVStoreService, authori
 			"disable_list",
 			collections.StringKey,
 		),
+		TripRules: collections.NewMap(
+			sb,
+			types.TripRulePrefix,
+			"trip_rules",
+			collections.StringKey,
+			codec.CollValue[types.TripRule](cdc),
+		),
+		MsgStats: collections.NewMap(
+			sb,
+			types.MsgStatsPrefix,
+			"msg_stats",
+			collections.PairKeyCodec(collections.StringKey, collections.Int64Key),
+			codec.CollValue[types.MsgStats](cdc),
+		),
+		TripExpiries: collections.NewMap(
+			sb,
+			types.TripExpiryPrefix,
+			"trip_expiries",
+			collections.StringKey,
+			codec.CollValue[types.TripExpiry](cdc),
+		),
 	}
 
 	schema, err := sb.Build()
@@ -90,6 +90,12 @@ func (srv msgServer) TripCircuitBreaker(ctx context.Context, msg *types.MsgTripC
 		return nil, err
 	}
 
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	expiry := types.NewTripExpiry("", msg.ExpiryHeight, msg.ExpiryTime)
+	if expiry != nil && expiry.IsExpired(sdkCtx.BlockHeight(), sdkCtx.BlockTime()) {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "trip expiry must be in the future")
+	}
+
 	for _, msgTypeURL := range msg.MsgTypeUrls {
 		// check if the message is in the list of allowed messages
 		isAllowed, err := srv.IsAllowed(ctx, msgTypeURL)
@@ -117,17 +123,26 @@ func (srv msgServer) TripCircuitBreaker(ctx context.Context, msg *types.MsgTripC
 			return nil, err
 		}
 
+		if expiry != nil {
+			expiry.MsgTypeUrl = msgTypeURL
+			if err = srv.TripExpiries.Set(ctx, msgTypeURL, *expiry); err != nil {
+				return nil, err
+			}
+		}
 	}
 
 	urls := strings.Join(msg.GetMsgTypeUrls(), ",")
 
-	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	attrs := []sdk.Attribute{
+		sdk.NewAttribute("authority", msg.Authority),
+		sdk.NewAttribute("msg_url", urls),
+	}
+	if expiry != nil {
+		attrs = append(attrs, expiry.EventAttributes()...)
+	}
+
 	sdkCtx.EventManager().EmitEvents(sdk.Events{
-		sdk.NewEvent(
-			"trip_circuit_breaker",
-			sdk.NewAttribute("authority", msg.Authority),
-			sdk.NewAttribute("msg_url", urls),
-		),
+		sdk.NewEvent("trip_circuit_breaker", attrs...),
 	})
 
 	return &types.MsgTripCircuitBreakerResponse{
@@ -176,6 +191,10 @@ func (srv msgServer) ResetCircuitBreaker(ctx context.Context, msg *types.MsgRese
 		if err = srv.DisableList.Remove(ctx, msgTypeURL); err != nil {
 			return nil, err
 		}
+
+		if err = srv.TripExpiries.Remove(ctx, msgTypeURL); err != nil {
+			return nil, err
+		}
 	}
 
 	urls := strings.Join(msg.GetMsgTypeUrls(), ",")
@@ -192,6 +211,155 @@ func (srv msgServer) ResetCircuitBreaker(ctx context.Context, msg *types.MsgRese
 	return &types.MsgResetCircuitBreakerResponse{Success: true}, nil
 }
 
+// SetTripRule sets the rule automatically tripping the circuit breaker for a
+// Msg type URL, overwriting the existing one.
+func (srv msgServer) SetTripRule(ctx context.Context, msg *types.MsgSetTripRule) (*types.MsgSetTripRuleResponse, error) {
+	if err := srv.checkSuperAdmin(ctx, msg.Authority, "set trip rules"); err != nil {
+		return nil, err
+	}
+
+	if msg.Rule == nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "trip rule cannot be nil")
+	}
+
+	if err := msg.Rule.Validate(); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	if msg.Rule.VolumeField != "" {
+		if _, _, err := volumeFieldDescriptor(msg.Rule.MsgTypeUrl, msg.Rule.VolumeField); err != nil {
+			return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+		}
+	}
+
+	if err := srv.TripRules.Set(ctx, msg.Rule.MsgTypeUrl, *msg.Rule); err != nil {
+		return nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	sdkCtx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			"set_trip_rule",
+			sdk.NewAttribute("authority", msg.Authority),
+			sdk.NewAttribute("msg_url", msg.Rule.MsgTypeUrl),
+		),
+	})
+
+	return &types.MsgSetTripRuleResponse{}, nil
+}
+
+// RemoveTripRule removes the rule automatically tripping the circuit breaker
+// for a Msg type URL along with its recorded statistics.
+func (srv msgServer) RemoveTripRule(ctx context.Context, msg *types.MsgRemoveTripRule) (*types.MsgRemoveTripRuleResponse, error) {
+	if err := srv.checkSuperAdmin(ctx, msg.Authority, "remove trip rules"); err != nil {
+		return nil, err
+	}
+
+	has, err := srv.TripRules.Has(ctx, msg.MsgTypeUrl)
+	if err != nil {
+		return nil, err
+	}
+
+	if !has {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrNotFound, "no trip rule for message %s", msg.MsgTypeUrl)
+	}
+
+	if err := srv.TripRules.Remove(ctx, msg.MsgTypeUrl); err != nil {
+		return nil, err
+	}
+
+	if err := srv.MsgStats.Clear(ctx, collections.NewPrefixedPairRange[string, int64](msg.MsgTypeUrl)); err != nil {
+		return nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	sdkCtx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			"remove_trip_rule",
+			sdk.NewAttribute("authority", msg.Authority),
+			sdk.NewAttribute("msg_url", msg.MsgTypeUrl),
+		),
+	})
+
+	return &types.MsgRemoveTripRuleResponse{}, nil
+}
+
+// ExtendCircuitBreakerTrip postpones the expiry of the trips of Msg type URLs.
+func (srv msgServer) ExtendCircuitBreakerTrip(ctx context.Context, msg *types.MsgExtendCircuitBreakerTrip) (*types.MsgExtendCircuitBreakerTripResponse, error) {
+	if err := srv.checkSuperAdmin(ctx, msg.Authority, "extend trips"); err != nil {
+		return nil, err
+	}
+
+	if len(msg.MsgTypeUrls) == 0 {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "msg type urls cannot be empty")
+	}
+
+	expiry := types.NewTripExpiry("", msg.ExpiryHeight, msg.ExpiryTime)
+	if expiry == nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "trip expiry must set an expiry height or time")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if expiry.IsExpired(sdkCtx.BlockHeight(), sdkCtx.BlockTime()) {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "trip expiry must be in the future")
+	}
+
+	for _, msgTypeURL := range msg.MsgTypeUrls {
+		current, err := srv.TripExpiries.Get(ctx, msgTypeURL)
+		if err != nil {
+			if errorsmod.IsOf(err, collections.ErrNotFound) {
+				return nil, errorsmod.Wrapf(sdkerrors.ErrNotFound, "message %s has no trip expiry", msgTypeURL)
+			}
+			return nil, err
+		}
+
+		expiry.MsgTypeUrl = msgTypeURL
+		if !expiry.Extends(current) {
+			return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "trip expiry of message %s cannot be brought forward", msgTypeURL)
+		}
+
+		if err = srv.TripExpiries.Set(ctx, msgTypeURL, *expiry); err != nil {
+			return nil, err
+		}
+	}
+
+	attrs := []sdk.Attribute{
+		sdk.NewAttribute("authority", msg.Authority),
+		sdk.NewAttribute("msg_url", strings.Join(msg.MsgTypeUrls, ",")),
+	}
+	attrs = append(attrs, expiry.EventAttributes()...)
+
+	sdkCtx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent("extend_circuit_breaker_trip", attrs...),
+	})
+
+	return &types.MsgExtendCircuitBreakerTripResponse{}, nil
+}
+
+// checkSuperAdmin returns an error if the account is neither the module
+// authority nor a super admin.
+func (srv msgServer) checkSuperAdmin(ctx context.Context, account, action string) error {
+	address, err := srv.addressCodec.StringToBytes(account)
+	if err != nil {
+		return err
+	}
+
+	if bytes.Equal(address, srv.GetAuthority()) {
+		return nil
+	}
+
+	perms, err := srv.Permissions.Get(ctx, address)
+	if err != nil && !errorsmod.IsOf(err, collections.ErrNotFound) {
+		return err
+	}
+
+	if perms.Level != types.Permissions_LEVEL_SUPER_ADMIN {
+		return errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "only super admins can %s", action)
+	}
+
+	return nil
+}
+
 // hasPermissionForMsg returns true if the account can trip or reset the message.
 func hasPermissionForMsg(perms types.Permissions, msg string) bool {
 	for _, msgurl := range perms.LimitTypeUrls {
@@ -73,3 +73,37 @@ func (qs QueryServer) DisabledList(ctx context.Context, req *types.QueryDisabled
 
 	return &types.DisabledListResponse{DisabledList: msgs}, nil
 }
+
+// TripRules returns the rules automatically tripping the circuit breaker.
+func (qs QueryServer) TripRules(ctx context.Context, req *types.QueryTripRulesRequest) (*types.TripRulesResponse, error) {
+	rules, pageRes, err := query.CollectionPaginate(
+		ctx,
+		qs.keeper.TripRules,
+		req.Pagination,
+		func(_ string, value types.TripRule) (*types.TripRule, error) {
+			return &value, nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.TripRulesResponse{TripRules: rules, Pagination: pageRes}, nil
+}
+
+// TripExpiries returns the expiries of the trips of the circuit breaker.
+func (qs QueryServer) TripExpiries(ctx context.Context, req *types.QueryTripExpiriesRequest) (*types.TripExpiriesResponse, error) {
+	expiries, pageRes, err := query.CollectionPaginate(
+		ctx,
+		qs.keeper.TripExpiries,
+		req.Pagination,
+		func(_ string, value types.TripExpiry) (*types.TripExpiry, error) {
+			return &value, nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.TripExpiriesResponse{TripExpiries: expiries, Pagination: pageRes}, nil
+}
@@ -0,0 +1,45 @@
+package keeper
+
+import (
+	context "context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// BeginBlocker resets the trips of the circuit breaker which have reached
+// their expiry.
+func (k *Keeper) BeginBlocker(ctx context.Context) error {
+	iter, err := k.TripExpiries.Iterate(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	expiries, err := iter.Values()
+	if err != nil {
+		return err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	for _, expiry := range expiries {
+		if !expiry.IsExpired(sdkCtx.BlockHeight(), sdkCtx.BlockTime()) {
+			continue
+		}
+
+		if err := k.DisableList.Remove(ctx, expiry.MsgTypeUrl); err != nil {
+			return err
+		}
+
+		if err := k.TripExpiries.Remove(ctx, expiry.MsgTypeUrl); err != nil {
+			return err
+		}
+
+		sdkCtx.EventManager().EmitEvents(sdk.Events{
+			sdk.NewEvent(
+				"auto_reset_circuit_breaker",
+				sdk.NewAttribute("msg_url", expiry.MsgTypeUrl),
+			),
+		})
+	}
+
+	return nil
+}
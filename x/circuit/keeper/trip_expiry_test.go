@@ -0,0 +1,161 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/x/circuit/keeper"
+	"cosmossdk.io/x/circuit/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func TestTripCircuitBreakerExpiry(t *testing.T) {
+	ft := initFixture(t)
+
+	srv := keeper.NewMsgServerImpl(ft.keeper)
+	authority, err := ft.ac.BytesToString(ft.mockAddr)
+	require.NoError(t, err)
+
+	now := time.Now().UTC().Truncate(time.Second)
+	ctx := sdk.UnwrapSDKContext(ft.ctx).WithBlockHeight(10).WithBlockTime(now)
+
+	// an expiry in the past is rejected
+	_, err = srv.TripCircuitBreaker(ctx, &types.MsgTripCircuitBreaker{Authority: authority, MsgTypeUrls: []string{msgSend}, ExpiryHeight: 10})
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+
+	expiryTime := now.Add(time.Hour)
+	_, err = srv.TripCircuitBreaker(ctx, &types.MsgTripCircuitBreaker{Authority: authority, MsgTypeUrls: []string{msgSend}, ExpiryHeight: 20, ExpiryTime: &expiryTime})
+	require.NoError(t, err)
+	require.Equal(
+		t,
+		sdk.NewEvent(
+			"trip_circuit_breaker",
+			sdk.NewAttribute("authority", authority),
+			sdk.NewAttribute("msg_url", msgSend),
+			sdk.NewAttribute("expiry_height", "20"),
+			sdk.NewAttribute("expiry_time", expiryTime.Format(time.RFC3339)),
+		),
+		lastEvent(ctx),
+	)
+
+	expiry, err := ft.keeper.TripExpiries.Get(ctx, msgSend)
+	require.NoError(t, err)
+	require.Equal(t, types.TripExpiry{MsgTypeUrl: msgSend, ExpiryHeight: 20, ExpiryTime: &expiryTime}, expiry)
+
+	// the trip is not reset before its expiry
+	require.NoError(t, ft.keeper.BeginBlocker(ctx.WithBlockHeight(19)))
+	isAllowed, err := ft.keeper.IsAllowed(ctx, msgSend)
+	require.NoError(t, err)
+	require.False(t, isAllowed)
+
+	// the trip is reset at its expiry height
+	ctx = ctx.WithBlockHeight(20).WithEventManager(sdk.NewEventManager())
+	require.NoError(t, ft.keeper.BeginBlocker(ctx))
+	require.Equal(
+		t,
+		sdk.NewEvent("auto_reset_circuit_breaker", sdk.NewAttribute("msg_url", msgSend)),
+		lastEvent(ctx),
+	)
+
+	isAllowed, err = ft.keeper.IsAllowed(ctx, msgSend)
+	require.NoError(t, err)
+	require.True(t, isAllowed)
+
+	has, err := ft.keeper.TripExpiries.Has(ctx, msgSend)
+	require.NoError(t, err)
+	require.False(t, has)
+
+	// the trip is reset at its expiry time
+	_, err = srv.TripCircuitBreaker(ctx, &types.MsgTripCircuitBreaker{Authority: authority, MsgTypeUrls: []string{msgSend}, ExpiryTime: &expiryTime})
+	require.NoError(t, err)
+
+	require.NoError(t, ft.keeper.BeginBlocker(ctx.WithBlockHeight(1000).WithBlockTime(expiryTime.Add(-time.Second))))
+	isAllowed, err = ft.keeper.IsAllowed(ctx, msgSend)
+	require.NoError(t, err)
+	require.False(t, isAllowed)
+
+	require.NoError(t, ft.keeper.BeginBlocker(ctx.WithBlockTime(expiryTime)))
+	isAllowed, err = ft.keeper.IsAllowed(ctx, msgSend)
+	require.NoError(t, err)
+	require.True(t, isAllowed)
+
+	// a manual reset removes the expiry
+	_, err = srv.TripCircuitBreaker(ctx, &types.MsgTripCircuitBreaker{Authority: authority, MsgTypeUrls: []string{msgSend}, ExpiryHeight: 30})
+	require.NoError(t, err)
+	_, err = srv.ResetCircuitBreaker(ctx, &types.MsgResetCircuitBreaker{Authority: authority, MsgTypeUrls: []string{msgSend}})
+	require.NoError(t, err)
+
+	has, err = ft.keeper.TripExpiries.Has(ctx, msgSend)
+	require.NoError(t, err)
+	require.False(t, has)
+}
+
+func TestExtendCircuitBreakerTrip(t *testing.T) {
+	ft := initFixture(t)
+
+	srv := keeper.NewMsgServerImpl(ft.keeper)
+	authority, err := ft.ac.BytesToString(ft.mockAddr)
+	require.NoError(t, err)
+
+	ctx := sdk.UnwrapSDKContext(ft.ctx).WithBlockHeight(10)
+	expiryTime := ctx.BlockTime().Add(time.Hour)
+
+	_, err = srv.TripCircuitBreaker(ctx, &types.MsgTripCircuitBreaker{Authority: authority, MsgTypeUrls: []string{msgSend}, ExpiryHeight: 20})
+	require.NoError(t, err)
+
+	// an account with all msgs permissions cannot extend a trip
+	add1, err := ft.ac.StringToBytes(addresses[1])
+	require.NoError(t, err)
+	err = ft.keeper.Permissions.Set(ctx, add1, types.Permissions{Level: types.Permissions_LEVEL_ALL_MSGS})
+	require.NoError(t, err)
+
+	_, err = srv.ExtendCircuitBreakerTrip(ctx, &types.MsgExtendCircuitBreakerTrip{Authority: addresses[1], MsgTypeUrls: []string{msgSend}, ExpiryHeight: 30})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	// a trip cannot be brought forward
+	_, err = srv.ExtendCircuitBreakerTrip(ctx, &types.MsgExtendCircuitBreakerTrip{Authority: authority, MsgTypeUrls: []string{msgSend}, ExpiryHeight: 15})
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+
+	// a trip expiring at a height cannot be extended with an expiry time
+	_, err = srv.ExtendCircuitBreakerTrip(ctx, &types.MsgExtendCircuitBreakerTrip{Authority: authority, MsgTypeUrls: []string{msgSend}, ExpiryTime: &expiryTime})
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+	_, err = srv.ExtendCircuitBreakerTrip(ctx, &types.MsgExtendCircuitBreakerTrip{Authority: authority, MsgTypeUrls: []string{msgSend}, ExpiryHeight: 30, ExpiryTime: &expiryTime})
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+
+	// a trip without expiry cannot be extended
+	_, err = srv.TripCircuitBreaker(ctx, &types.MsgTripCircuitBreaker{Authority: authority, MsgTypeUrls: []string{"test"}})
+	require.NoError(t, err)
+	_, err = srv.ExtendCircuitBreakerTrip(ctx, &types.MsgExtendCircuitBreakerTrip{Authority: authority, MsgTypeUrls: []string{"test"}, ExpiryHeight: 30})
+	require.ErrorIs(t, err, sdkerrors.ErrNotFound)
+
+	// a super admin can extend a trip
+	err = ft.keeper.Permissions.Set(ctx, add1, types.Permissions{Level: types.Permissions_LEVEL_SUPER_ADMIN})
+	require.NoError(t, err)
+
+	_, err = srv.ExtendCircuitBreakerTrip(ctx, &types.MsgExtendCircuitBreakerTrip{Authority: addresses[1], MsgTypeUrls: []string{msgSend}, ExpiryHeight: 30})
+	require.NoError(t, err)
+	require.Equal(
+		t,
+		sdk.NewEvent(
+			"extend_circuit_breaker_trip",
+			sdk.NewAttribute("authority", addresses[1]),
+			sdk.NewAttribute("msg_url", msgSend),
+			sdk.NewAttribute("expiry_height", "30"),
+		),
+		lastEvent(ctx),
+	)
+
+	require.NoError(t, ft.keeper.BeginBlocker(ctx.WithBlockHeight(20)))
+	isAllowed, err := ft.keeper.IsAllowed(ctx, msgSend)
+	require.NoError(t, err)
+	require.False(t, isAllowed)
+
+	require.NoError(t, ft.keeper.BeginBlocker(ctx.WithBlockHeight(30)))
+	isAllowed, err = ft.keeper.IsAllowed(ctx, msgSend)
+	require.NoError(t, err)
+	require.True(t, isAllowed)
+}
@@ -0,0 +1,322 @@
+package keeper
+
+import (
+	context "context"
+	"errors"
+	"fmt"
+	"strings"
+
+	gogoproto "github.com/cosmos/gogoproto/proto"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/dynamicpb"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/circuit/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+)
+
+const coinFullName = "cosmos.base.v1beta1.Coin"
+
+// TripRuleBreaker is the circuit breaker of the message router. On top of
+// checking the DisableList, it records the executions of the messages watched
+// by a trip rule, including the messages dispatched by other messages.
+type TripRuleBreaker struct {
+	keeper *Keeper
+}
+
+// NewTripRuleBreaker returns the circuit breaker of the message router for the
+// given Keeper.
+func NewTripRuleBreaker(k *Keeper) TripRuleBreaker {
+	return TripRuleBreaker{keeper: k}
+}
+
+// IsAllowed returns true when msg URL is not found in the DisableList.
+func (b TripRuleBreaker) IsAllowed(ctx context.Context, msgURL string) (bool, error) {
+	return b.keeper.IsAllowed(ctx, msgURL)
+}
+
+// IsMsgAllowed returns true when the msg URL is not found in the DisableList.
+// When executing a block, it records the execution of the message and the
+// volume it moves, which are reverted along the message state if the
+// transaction fails.
+func (b TripRuleBreaker) IsMsgAllowed(ctx context.Context, msg sdk.Msg) (bool, error) {
+	msgURL := sdk.MsgTypeURL(msg)
+	isAllowed, err := b.keeper.IsAllowed(ctx, msgURL)
+	if err != nil || !isAllowed {
+		return isAllowed, err
+	}
+
+	if sdk.UnwrapSDKContext(ctx).ExecMode() != sdk.ExecModeFinalize {
+		return true, nil
+	}
+
+	return true, b.keeper.recordExecution(ctx, msg)
+}
+
+// RecordTxMsgs records the inclusion in the current block of the messages
+// watched by a trip rule, including the messages of an authz MsgExec which the
+// message router dispatches in the same transaction. The messages of a
+// proposal are only dispatched once it passes, so they are not recorded.
+func (k *Keeper) RecordTxMsgs(ctx context.Context, msgs []sdk.Msg) error {
+	height := sdk.UnwrapSDKContext(ctx).BlockHeight()
+	for _, msg := range msgs {
+		nested, err := nestedMsgs(msg)
+		if err != nil {
+			return err
+		}
+
+		if err := k.RecordTxMsgs(ctx, nested); err != nil {
+			return err
+		}
+
+		msgURL := sdk.MsgTypeURL(msg)
+		has, err := k.TripRules.Has(ctx, msgURL)
+		if err != nil {
+			return err
+		}
+
+		if !has {
+			continue
+		}
+
+		stats, err := k.getMsgStats(ctx, msgURL, height)
+		if err != nil {
+			return err
+		}
+
+		stats.Attempts++
+		if err := k.MsgStats.Set(ctx, collections.Join(msgURL, height), stats); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// recordExecution records the execution of a message watched by a trip rule
+// in the current block, and the volume it moves.
+func (k *Keeper) recordExecution(ctx context.Context, msg sdk.Msg) error {
+	msgURL := sdk.MsgTypeURL(msg)
+	rule, err := k.TripRules.Get(ctx, msgURL)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil
+		}
+		return err
+	}
+
+	height := sdk.UnwrapSDKContext(ctx).BlockHeight()
+	stats, err := k.getMsgStats(ctx, msgURL, height)
+	if err != nil {
+		return err
+	}
+
+	stats.Executions++
+	if rule.VolumeDenom != "" {
+		volume, err := msgVolume(msg, rule.VolumeField, rule.VolumeDenom)
+		if err != nil {
+			return err
+		}
+		stats.Volume = stats.GetVolume().Add(volume)
+	}
+
+	return k.MsgStats.Set(ctx, collections.Join(msgURL, height), stats)
+}
+
+// nestedMsgs returns the messages carried by msg which the message router
+// dispatches in the same transaction, i.e. the messages of an authz MsgExec.
+func nestedMsgs(msg sdk.Msg) ([]sdk.Msg, error) {
+	switch m := msg.(type) {
+	case *authz.MsgExec:
+		return m.GetMessages()
+	default:
+		return nil, nil
+	}
+}
+
+func (k *Keeper) getMsgStats(ctx context.Context, msgURL string, height int64) (types.MsgStats, error) {
+	stats, err := k.MsgStats.Get(ctx, collections.Join(msgURL, height))
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.NewMsgStats(), nil
+	}
+	return stats, err
+}
+
+// EndBlocker evaluates the trip rules against the statistics of their window
+// and disables the message URLs exceeding one of their thresholds.
+func (k *Keeper) EndBlocker(ctx context.Context) error {
+	iter, err := k.TripRules.Iterate(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	rules, err := iter.Values()
+	if err != nil {
+		return err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	for _, rule := range rules {
+		stats, err := k.windowMsgStats(ctx, rule, sdkCtx.BlockHeight())
+		if err != nil {
+			return err
+		}
+
+		reason := rule.Exceeded(stats)
+		if reason == "" {
+			continue
+		}
+
+		isAllowed, err := k.IsAllowed(ctx, rule.MsgTypeUrl)
+		if err != nil {
+			return err
+		}
+
+		if !isAllowed {
+			continue
+		}
+
+		if err := k.DisableList.Set(ctx, rule.MsgTypeUrl); err != nil {
+			return err
+		}
+
+		sdkCtx.EventManager().EmitEvents(sdk.Events{
+			sdk.NewEvent(
+				"auto_trip_circuit_breaker",
+				sdk.NewAttribute("msg_url", rule.MsgTypeUrl),
+				sdk.NewAttribute("reason", reason),
+			),
+		})
+	}
+
+	return nil
+}
+
+// windowMsgStats returns the sum of the statistics of the trip rule message
+// URL in its window, pruning the statistics of the blocks before it.
+func (k *Keeper) windowMsgStats(ctx context.Context, rule types.TripRule, height int64) (types.MsgStats, error) {
+	total := types.NewMsgStats()
+	var expired []collections.Pair[string, int64]
+
+	err := k.MsgStats.Walk(ctx, collections.NewPrefixedPairRange[string, int64](rule.MsgTypeUrl), func(key collections.Pair[string, int64], stats types.MsgStats) (bool, error) {
+		if uint64(height-key.K2()) >= rule.Window {
+			expired = append(expired, key)
+			return false, nil
+		}
+
+		total = total.Add(stats)
+		return false, nil
+	})
+	if err != nil {
+		return total, err
+	}
+
+	for _, key := range expired {
+		if err := k.MsgStats.Remove(ctx, key); err != nil {
+			return total, err
+		}
+	}
+
+	return total, nil
+}
+
+// volumeFieldDescriptor returns the descriptor of the field of the message
+// holding the coins counted in its volume.
+func volumeFieldDescriptor(msgTypeURL, field string) (protoreflect.MessageDescriptor, protoreflect.FieldDescriptor, error) {
+	desc, err := gogoproto.HybridResolver.FindDescriptorByName(protoreflect.FullName(strings.TrimPrefix(msgTypeURL, "/")))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	msgDesc, ok := desc.(protoreflect.MessageDescriptor)
+	if !ok {
+		return nil, nil, fmt.Errorf("%s is not a message", desc.FullName())
+	}
+
+	fd := msgDesc.Fields().ByName(protoreflect.Name(field))
+	if fd == nil || fd.Kind() != protoreflect.MessageKind || fd.IsMap() {
+		return nil, nil, fmt.Errorf("%s has no message field %s", msgDesc.FullName(), field)
+	}
+
+	return msgDesc, fd, nil
+}
+
+// msgVolume returns the amount of the given denom in the coins of the given
+// field of the message.
+func msgVolume(msg sdk.Msg, field, denom string) (math.Int, error) {
+	msgDesc, fd, err := volumeFieldDescriptor(sdk.MsgTypeURL(msg), field)
+	if err != nil {
+		return math.Int{}, err
+	}
+
+	bz, err := gogoproto.Marshal(msg)
+	if err != nil {
+		return math.Int{}, err
+	}
+
+	dynamicMsg := dynamicpb.NewMessage(msgDesc)
+	if err := proto.Unmarshal(bz, dynamicMsg); err != nil {
+		return math.Int{}, err
+	}
+
+	return fieldCoinsAmount(dynamicMsg.Get(fd), fd, denom)
+}
+
+// coinsAmount returns the amount of the given denom in the coins found in the
+// fields of the message, recursively.
+func coinsAmount(msg protoreflect.Message, denom string) (math.Int, error) {
+	if msg.Descriptor().FullName() == coinFullName {
+		fields := msg.Descriptor().Fields()
+		if msg.Get(fields.ByName("denom")).String() != denom {
+			return math.ZeroInt(), nil
+		}
+
+		amount, ok := math.NewIntFromString(msg.Get(fields.ByName("amount")).String())
+		if !ok {
+			return math.Int{}, fmt.Errorf("invalid coin amount in %s", msg.Descriptor().FullName())
+		}
+		return amount, nil
+	}
+
+	var (
+		total = math.ZeroInt()
+		err   error
+	)
+	msg.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		if fd.Kind() != protoreflect.MessageKind || fd.IsMap() {
+			return true
+		}
+
+		var amount math.Int
+		if amount, err = fieldCoinsAmount(v, fd, denom); err != nil {
+			return false
+		}
+		total = total.Add(amount)
+		return true
+	})
+
+	return total, err
+}
+
+// fieldCoinsAmount returns the amount of the given denom in the coins found in
+// the value of a message field, recursively.
+func fieldCoinsAmount(v protoreflect.Value, fd protoreflect.FieldDescriptor, denom string) (math.Int, error) {
+	if !fd.IsList() {
+		return coinsAmount(v.Message(), denom)
+	}
+
+	total := math.ZeroInt()
+	list := v.List()
+	for i := 0; i < list.Len(); i++ {
+		amount, err := coinsAmount(list.Get(i).Message(), denom)
+		if err != nil {
+			return math.Int{}, err
+		}
+		total = total.Add(amount)
+	}
+	return total, nil
+}
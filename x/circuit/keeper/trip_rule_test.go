@@ -0,0 +1,278 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/circuit/keeper"
+	"cosmossdk.io/x/circuit/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+)
+
+const (
+	msgSendURL      = "/cosmos.bank.v1beta1.MsgSend"
+	msgMultiSendURL = "/cosmos.bank.v1beta1.MsgMultiSend"
+)
+
+func TestSetAndRemoveTripRule(t *testing.T) {
+	ft := initFixture(t)
+
+	srv := keeper.NewMsgServerImpl(ft.keeper)
+	authority, err := ft.ac.BytesToString(ft.mockAddr)
+	require.NoError(t, err)
+
+	rule := &types.TripRule{MsgTypeUrl: msgSendURL, Window: 10, MaxExecutions: 100, MaxVolume: math.ZeroInt()}
+
+	// an account without permissions cannot set a trip rule
+	_, err = srv.SetTripRule(ft.ctx, &types.MsgSetTripRule{Authority: addresses[1], Rule: rule})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	// a trip rule without any threshold is invalid
+	_, err = srv.SetTripRule(ft.ctx, &types.MsgSetTripRule{
+		Authority: authority,
+		Rule:      &types.TripRule{MsgTypeUrl: msgSendURL, Window: 10, MaxVolume: math.ZeroInt()},
+	})
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+
+	// a trip rule counting the volume of a field the message does not have is invalid
+	_, err = srv.SetTripRule(ft.ctx, &types.MsgSetTripRule{
+		Authority: authority,
+		Rule:      &types.TripRule{MsgTypeUrl: msgSendURL, Window: 10, VolumeDenom: "stake", VolumeField: "limits", MaxVolume: math.NewInt(100)},
+	})
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+
+	// a super admin can set a trip rule
+	add1, err := ft.ac.StringToBytes(addresses[1])
+	require.NoError(t, err)
+	err = ft.keeper.Permissions.Set(ft.ctx, add1, types.Permissions{Level: types.Permissions_LEVEL_SUPER_ADMIN})
+	require.NoError(t, err)
+
+	_, err = srv.SetTripRule(ft.ctx, &types.MsgSetTripRule{Authority: addresses[1], Rule: rule})
+	require.NoError(t, err)
+	require.Equal(
+		t,
+		sdk.NewEvent(
+			"set_trip_rule",
+			sdk.NewAttribute("authority", addresses[1]),
+			sdk.NewAttribute("msg_url", msgSendURL),
+		),
+		lastEvent(ft.ctx),
+	)
+
+	stored, err := ft.keeper.TripRules.Get(ft.ctx, msgSendURL)
+	require.NoError(t, err)
+	require.Equal(t, *rule, stored)
+
+	// an account with some msgs permissions cannot remove a trip rule
+	add2, err := ft.ac.StringToBytes(addresses[2])
+	require.NoError(t, err)
+	err = ft.keeper.Permissions.Set(ft.ctx, add2, types.Permissions{Level: types.Permissions_LEVEL_SOME_MSGS, LimitTypeUrls: []string{msgSendURL}})
+	require.NoError(t, err)
+
+	_, err = srv.RemoveTripRule(ft.ctx, &types.MsgRemoveTripRule{Authority: addresses[2], MsgTypeUrl: msgSendURL})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	// the authority can remove a trip rule
+	_, err = srv.RemoveTripRule(ft.ctx, &types.MsgRemoveTripRule{Authority: authority, MsgTypeUrl: msgSendURL})
+	require.NoError(t, err)
+
+	has, err := ft.keeper.TripRules.Has(ft.ctx, msgSendURL)
+	require.NoError(t, err)
+	require.False(t, has)
+
+	_, err = srv.RemoveTripRule(ft.ctx, &types.MsgRemoveTripRule{Authority: authority, MsgTypeUrl: msgSendURL})
+	require.ErrorIs(t, err, sdkerrors.ErrNotFound)
+}
+
+func TestTripRules(t *testing.T) {
+	send := func(amount sdk.Coins) sdk.Msg {
+		return &banktypes.MsgSend{FromAddress: addresses[0], ToAddress: addresses[1], Amount: amount}
+	}
+	multiSend := func(amount int64) sdk.Msg {
+		coins := sdk.NewCoins(sdk.NewInt64Coin("stake", amount))
+		return &banktypes.MsgMultiSend{
+			Inputs:  []banktypes.Input{banktypes.NewInput(sdk.MustAccAddressFromBech32(addresses[0]), coins)},
+			Outputs: []banktypes.Output{banktypes.NewOutput(sdk.MustAccAddressFromBech32(addresses[1]), coins)},
+		}
+	}
+	exec := func(msgs ...sdk.Msg) sdk.Msg {
+		msg := authz.NewMsgExec(nil, msgs)
+		msg.Grantee = addresses[0]
+		return &msg
+	}
+
+	testCases := map[string]struct {
+		rule types.TripRule
+		// blocks lists the transactions executed by block, a transaction
+		// being executed successfully unless it is listed in failed
+		blocks  [][][]sdk.Msg
+		failed  map[int]bool
+		tripped []bool
+		reason  string
+	}{
+		"max executions": {
+			rule: types.TripRule{MsgTypeUrl: msgSendURL, Window: 2, MaxExecutions: 2, MaxVolume: math.ZeroInt()},
+			blocks: [][][]sdk.Msg{
+				{{send(nil), send(nil)}},
+				{{send(nil)}},
+			},
+			tripped: []bool{false, true},
+			reason:  types.TripReasonMaxExecutions,
+		},
+		"executions out of the window": {
+			rule: types.TripRule{MsgTypeUrl: msgSendURL, Window: 2, MaxExecutions: 2, MaxVolume: math.ZeroInt()},
+			blocks: [][][]sdk.Msg{
+				{{send(nil), send(nil)}},
+				{},
+				{{send(nil)}},
+			},
+			tripped: []bool{false, false, false},
+		},
+		"max failures": {
+			rule: types.TripRule{MsgTypeUrl: msgSendURL, Window: 1, MaxExecutions: 10, MaxFailures: 1, MaxVolume: math.ZeroInt()},
+			blocks: [][][]sdk.Msg{
+				{{send(nil)}, {send(nil), send(nil)}},
+			},
+			failed:  map[int]bool{1: true},
+			tripped: []bool{true},
+			reason:  types.TripReasonMaxFailures,
+		},
+		"max volume": {
+			rule: types.TripRule{MsgTypeUrl: msgSendURL, Window: 3, VolumeDenom: "stake", VolumeField: "amount", MaxVolume: math.NewInt(100)},
+			blocks: [][][]sdk.Msg{
+				{{send(sdk.NewCoins(sdk.NewInt64Coin("stake", 60), sdk.NewInt64Coin("atom", 1000)))}},
+				{{send(sdk.NewCoins(sdk.NewInt64Coin("stake", 40)))}},
+				{{send(sdk.NewCoins(sdk.NewInt64Coin("stake", 1)))}},
+			},
+			tripped: []bool{false, false, true},
+			reason:  types.TripReasonMaxVolume,
+		},
+		"nested messages": {
+			rule: types.TripRule{MsgTypeUrl: msgSendURL, Window: 1, MaxFailures: 1, VolumeDenom: "stake", VolumeField: "amount", MaxVolume: math.NewInt(100)},
+			blocks: [][][]sdk.Msg{
+				{{exec(send(sdk.NewCoins(sdk.NewInt64Coin("stake", 60))), send(sdk.NewCoins(sdk.NewInt64Coin("stake", 60))))}},
+			},
+			tripped: []bool{true},
+			reason:  types.TripReasonMaxVolume,
+		},
+		"multi send volume": {
+			rule: types.TripRule{MsgTypeUrl: msgMultiSendURL, Window: 2, VolumeDenom: "stake", VolumeField: "outputs", MaxVolume: math.NewInt(100)},
+			blocks: [][][]sdk.Msg{
+				{{multiSend(60)}},
+				{{multiSend(41)}},
+			},
+			tripped: []bool{false, true},
+			reason:  types.TripReasonMaxVolume,
+		},
+		"volume of failed transactions": {
+			rule: types.TripRule{MsgTypeUrl: msgSendURL, Window: 1, VolumeDenom: "stake", VolumeField: "amount", MaxVolume: math.NewInt(100)},
+			blocks: [][][]sdk.Msg{
+				{{send(sdk.NewCoins(sdk.NewInt64Coin("stake", 1000)))}},
+			},
+			failed:  map[int]bool{0: true},
+			tripped: []bool{false},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ft := initFixture(t)
+			breaker := keeper.NewTripRuleBreaker(&ft.keeper)
+
+			require.NoError(t, ft.keeper.TripRules.Set(ft.ctx, tc.rule.MsgTypeUrl, tc.rule))
+
+			for i, txs := range tc.blocks {
+				ctx := sdk.UnwrapSDKContext(ft.ctx).
+					WithBlockHeight(int64(i + 1)).
+					WithExecMode(sdk.ExecModeFinalize).
+					WithEventManager(sdk.NewEventManager())
+
+				for j, msgs := range txs {
+					require.NoError(t, ft.keeper.RecordTxMsgs(ctx, msgs))
+
+					// the router checks the messages as it dispatches them,
+					// including the messages of an authz MsgExec
+					var dispatch func(msgs []sdk.Msg)
+					msgCtx, write := ctx.CacheContext()
+					dispatch = func(msgs []sdk.Msg) {
+						for _, msg := range msgs {
+							isAllowed, err := breaker.IsMsgAllowed(msgCtx, msg)
+							require.NoError(t, err)
+							require.True(t, isAllowed)
+
+							if exec, ok := msg.(*authz.MsgExec); ok {
+								nested, err := exec.GetMessages()
+								require.NoError(t, err)
+								dispatch(nested)
+							}
+						}
+					}
+					dispatch(msgs)
+					if !tc.failed[j] {
+						write()
+					}
+				}
+
+				require.NoError(t, ft.keeper.EndBlocker(ctx))
+
+				isAllowed, err := ft.keeper.IsAllowed(ctx, tc.rule.MsgTypeUrl)
+				require.NoError(t, err)
+				require.Equal(t, tc.tripped[i], !isAllowed, "block %d", i+1)
+
+				if tc.tripped[i] {
+					require.Equal(
+						t,
+						sdk.NewEvent(
+							"auto_trip_circuit_breaker",
+							sdk.NewAttribute("msg_url", tc.rule.MsgTypeUrl),
+							sdk.NewAttribute("reason", tc.reason),
+						),
+						lastEvent(ctx),
+					)
+				}
+			}
+		})
+	}
+}
+
+func TestRecordTxMsgsProposal(t *testing.T) {
+	ft := initFixture(t)
+	breaker := keeper.NewTripRuleBreaker(&ft.keeper)
+
+	rule := types.TripRule{MsgTypeUrl: msgSendURL, Window: 1, MaxFailures: 1, MaxVolume: math.ZeroInt()}
+	require.NoError(t, ft.keeper.TripRules.Set(ft.ctx, rule.MsgTypeUrl, rule))
+
+	ctx := sdk.UnwrapSDKContext(ft.ctx).WithBlockHeight(1).WithExecMode(sdk.ExecModeFinalize)
+
+	send := &banktypes.MsgSend{FromAddress: addresses[0], ToAddress: addresses[1], Amount: sdk.NewCoins(sdk.NewInt64Coin("stake", 10))}
+	proposal, err := govv1.NewMsgSubmitProposal([]sdk.Msg{send, send}, nil, addresses[0], "", "title", "summary", false)
+	require.NoError(t, err)
+
+	// the messages of the proposal are not dispatched along with it, so they
+	// are not attempts
+	require.NoError(t, ft.keeper.RecordTxMsgs(ctx, []sdk.Msg{proposal, send}))
+	for _, msg := range []sdk.Msg{proposal, send} {
+		isAllowed, err := breaker.IsMsgAllowed(ctx, msg)
+		require.NoError(t, err)
+		require.True(t, isAllowed)
+	}
+
+	stats, err := ft.keeper.MsgStats.Get(ctx, collections.Join(msgSendURL, int64(1)))
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), stats.Attempts)
+	require.Equal(t, uint64(1), stats.Executions)
+	require.Zero(t, stats.Failures())
+
+	require.NoError(t, ft.keeper.EndBlocker(ctx))
+	isAllowed, err := ft.keeper.IsAllowed(ctx, msgSendURL)
+	require.NoError(t, err)
+	require.True(t, isAllowed)
+}
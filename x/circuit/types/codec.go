@@ -12,6 +12,9 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgAuthorizeCircuitBreaker{},
 		&MsgResetCircuitBreaker{},
 		&MsgTripCircuitBreaker{},
+		&MsgSetTripRule{},
+		&MsgRemoveTripRule{},
+		&MsgExtendCircuitBreakerTrip{},
 	)
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
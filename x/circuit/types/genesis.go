@@ -20,6 +20,42 @@ func (gs *GenesisState) Validate() error {
 		}
 	}
 
+	tripRules := make(map[string]bool, len(gs.TripRules))
+	for _, rule := range gs.TripRules {
+		if rule == nil {
+			return fmt.Errorf("trip rule cannot be nil")
+		}
+		if err := rule.Validate(); err != nil {
+			return err
+		}
+		if tripRules[rule.MsgTypeUrl] {
+			return fmt.Errorf("duplicate trip rule, msg type url: %s", rule.MsgTypeUrl)
+		}
+		tripRules[rule.MsgTypeUrl] = true
+	}
+
+	disabled := make(map[string]bool, len(gs.DisabledTypeUrls))
+	for _, url := range gs.DisabledTypeUrls {
+		disabled[url] = true
+	}
+
+	tripExpiries := make(map[string]bool, len(gs.TripExpiries))
+	for _, expiry := range gs.TripExpiries {
+		if expiry == nil {
+			return fmt.Errorf("trip expiry cannot be nil")
+		}
+		if err := expiry.Validate(); err != nil {
+			return err
+		}
+		if !disabled[expiry.MsgTypeUrl] {
+			return fmt.Errorf("trip expiry of a msg type url which is not disabled: %s", expiry.MsgTypeUrl)
+		}
+		if tripExpiries[expiry.MsgTypeUrl] {
+			return fmt.Errorf("duplicate trip expiry, msg type url: %s", expiry.MsgTypeUrl)
+		}
+		tripExpiries[expiry.MsgTypeUrl] = true
+	}
+
 	return nil
 }
 
@@ -17,4 +17,7 @@ const (
 var (
 	AccountPermissionPrefix = collections.NewPrefix(1)
 	DisableListPrefix       = collections.NewPrefix(2)
+	TripRulePrefix          = collections.NewPrefix(3)
+	MsgStatsPrefix          = collections.NewPrefix(4)
+	TripExpiryPrefix        = collections.NewPrefix(5)
 )
@@ -0,0 +1,74 @@
+package types
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewTripExpiry returns the expiry of the trip of a Msg type URL, or nil if
+// neither the expiry height nor the expiry time is set.
+func NewTripExpiry(msgTypeURL string, expiryHeight uint64, expiryTime *time.Time) *TripExpiry {
+	if expiryHeight == 0 && expiryTime == nil {
+		return nil
+	}
+
+	return &TripExpiry{
+		MsgTypeUrl:   msgTypeURL,
+		ExpiryHeight: expiryHeight,
+		ExpiryTime:   expiryTime,
+	}
+}
+
+// Validate performs a stateless validation of the trip expiry.
+func (e TripExpiry) Validate() error {
+	if e.MsgTypeUrl == "" {
+		return fmt.Errorf("trip expiry msg type url cannot be empty")
+	}
+	if e.ExpiryHeight == 0 && e.ExpiryTime == nil {
+		return fmt.Errorf("trip expiry must set an expiry height or time, msg type url: %s", e.MsgTypeUrl)
+	}
+
+	return nil
+}
+
+// IsExpired returns true when the block height or time has reached the
+// expiry, whichever comes first.
+func (e TripExpiry) IsExpired(height int64, blockTime time.Time) bool {
+	if e.ExpiryHeight > 0 && height >= 0 && uint64(height) >= e.ExpiryHeight {
+		return true
+	}
+
+	return e.ExpiryTime != nil && !blockTime.Before(*e.ExpiryTime)
+}
+
+// Extends returns true when the expiry sets the same expiry height and time
+// kinds as the current one, neither coming before the current one. An expiry
+// height or time set in only one of them could otherwise bring the expiry
+// forward, the trip expiring at whichever comes first.
+func (e TripExpiry) Extends(current TripExpiry) bool {
+	if (e.ExpiryHeight > 0) != (current.ExpiryHeight > 0) || (e.ExpiryTime != nil) != (current.ExpiryTime != nil) {
+		return false
+	}
+
+	if e.ExpiryHeight < current.ExpiryHeight {
+		return false
+	}
+
+	return e.ExpiryTime == nil || !e.ExpiryTime.Before(*current.ExpiryTime)
+}
+
+// EventAttributes returns the event attributes of the expiry height and time
+// set.
+func (e TripExpiry) EventAttributes() []sdk.Attribute {
+	var attrs []sdk.Attribute
+	if e.ExpiryHeight > 0 {
+		attrs = append(attrs, sdk.NewAttribute("expiry_height", strconv.FormatUint(e.ExpiryHeight, 10)))
+	}
+	if e.ExpiryTime != nil {
+		attrs = append(attrs, sdk.NewAttribute("expiry_time", e.ExpiryTime.Format(time.RFC3339)))
+	}
+	return attrs
+}
@@ -0,0 +1,99 @@
+package types
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Reasons of an automatic trip of the circuit breaker, one for each threshold
+// of a TripRule.
+const (
+	TripReasonMaxExecutions = "max_executions"
+	TripReasonMaxFailures   = "max_failures"
+	TripReasonMaxVolume     = "max_volume"
+)
+
+// Validate performs a stateless validation of the trip rule.
+func (r TripRule) Validate() error {
+	if r.MsgTypeUrl == "" {
+		return fmt.Errorf("trip rule msg type url cannot be empty")
+	}
+	if r.Window == 0 {
+		return fmt.Errorf("trip rule window cannot be zero, msg type url: %s", r.MsgTypeUrl)
+	}
+
+	maxVolume := r.maxVolume()
+	if maxVolume.IsNegative() {
+		return fmt.Errorf("trip rule max volume cannot be negative, msg type url: %s", r.MsgTypeUrl)
+	}
+	if maxVolume.IsPositive() {
+		if err := sdk.ValidateDenom(r.VolumeDenom); err != nil {
+			return fmt.Errorf("invalid trip rule volume denom, msg type url: %s: %w", r.MsgTypeUrl, err)
+		}
+		if r.VolumeField == "" {
+			return fmt.Errorf("trip rule volume field cannot be empty, msg type url: %s", r.MsgTypeUrl)
+		}
+	}
+
+	if r.MaxExecutions == 0 && r.MaxFailures == 0 && maxVolume.IsZero() {
+		return fmt.Errorf("trip rule must set at least one threshold, msg type url: %s", r.MsgTypeUrl)
+	}
+
+	return nil
+}
+
+// Exceeded returns the reason of the first threshold of the trip rule exceeded
+// by the given statistics, or an empty string if none is.
+func (r TripRule) Exceeded(stats MsgStats) string {
+	switch {
+	case r.MaxExecutions > 0 && stats.Executions > r.MaxExecutions:
+		return TripReasonMaxExecutions
+	case r.MaxFailures > 0 && stats.Failures() > r.MaxFailures:
+		return TripReasonMaxFailures
+	case r.maxVolume().IsPositive() && stats.GetVolume().GT(r.maxVolume()):
+		return TripReasonMaxVolume
+	default:
+		return ""
+	}
+}
+
+func (r TripRule) maxVolume() math.Int {
+	if r.MaxVolume.IsNil() {
+		return math.ZeroInt()
+	}
+	return r.MaxVolume
+}
+
+// NewMsgStats returns empty message statistics.
+func NewMsgStats() MsgStats {
+	return MsgStats{Volume: math.ZeroInt()}
+}
+
+// GetVolume returns the volume of the statistics, zero if unset.
+func (s MsgStats) GetVolume() math.Int {
+	if s.Volume.IsNil() {
+		return math.ZeroInt()
+	}
+	return s.Volume
+}
+
+// Failures returns the number of messages included in a block but not
+// successfully executed.
+func (s MsgStats) Failures() uint64 {
+	if s.Executions >= s.Attempts {
+		return 0
+	}
+	return s.Attempts - s.Executions
+}
+
+// Add returns the sum of both statistics.
+func (s MsgStats) Add(other MsgStats) MsgStats {
+	return MsgStats{
+		Attempts:   s.Attempts + other.Attempts,
+		Executions: s.Executions + other.Executions,
+		Volume:     s.GetVolume().Add(other.GetVolume()),
+	}
+}
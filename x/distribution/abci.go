@@ -30,5 +30,10 @@ func BeginBlocker(ctx sdk.Context, k keeper.Keeper) error {
 
 	// record the proposer for when we payout on the next block
 	consAddr := sdk.ConsAddress(ctx.BlockHeader().ProposerAddress)
-	return k.SetPreviousProposerConsAddr(ctx, consAddr)
+	if err := k.SetPreviousProposerConsAddr(ctx, consAddr); err != nil {
+		return err
+	}
+
+	// re-delegate the rewards of the delegators who opted in to auto-compounding
+	return k.AutoCompoundRewards(ctx)
 }
@@ -79,6 +79,15 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						{ProtoField: "delegator_address"},
 					},
 				},
+				{
+					RpcMethod: "DelegatorAutoCompound",
+					Use:       "auto-compound [delegator-addr]",
+					Short:     "Query whether a delegator opted in to auto-compounding",
+					Example:   fmt.Sprintf("$ %s query distribution auto-compound [delegator-address]", version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "delegator_address"},
+					},
+				},
 				{
 					RpcMethod: "CommunityPool",
 					Use:       "community-pool",
@@ -115,6 +124,21 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						{ProtoField: "validator_address"},
 					},
 				},
+				{
+					RpcMethod: "WithdrawTokenizeShareRecordReward",
+					Use:       "withdraw-tokenize-share-rewards",
+					Short:     "Withdraw the rewards of the delegation shares tokenized by the owner",
+					Example:   fmt.Sprintf("%s tx distribution withdraw-tokenize-share-rewards --from mykey", version.AppName),
+				},
+				{
+					RpcMethod: "SetAutoCompound",
+					Use:       "set-auto-compound [enabled]",
+					Short:     "Opt in or out of the auto-compounding of the staking rewards of a delegator",
+					Example:   fmt.Sprintf("%s tx distribution set-auto-compound true --from mykey", version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "enabled"},
+					},
+				},
 				{
 					RpcMethod: "DepositValidatorRewardsPool",
 					Use:       "fund-validator-rewards-pool [validator-addr] [amount]",
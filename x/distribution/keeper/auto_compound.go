@@ -0,0 +1,247 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"strconv"
+
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// SetAutoCompound opts a delegator in or out of auto-compounding. A delegator
+// can only opt in when it is its own withdraw address, as the withdrawn
+// rewards are delegated from its account.
+func (k Keeper) SetAutoCompound(ctx context.Context, delAddr sdk.AccAddress, enabled bool) error {
+	store := k.storeService.OpenKVStore(ctx)
+	if !enabled {
+		if err := store.Delete(types.GetAutoCompoundDelegatorKey(delAddr)); err != nil {
+			return err
+		}
+	} else {
+		withdrawAddr, err := k.GetDelegatorWithdrawAddr(ctx, delAddr)
+		if err != nil {
+			return err
+		}
+
+		if !withdrawAddr.Equals(delAddr) {
+			return types.ErrAutoCompoundWithdrawAddr
+		}
+
+		if err := store.Set(types.GetAutoCompoundDelegatorKey(delAddr), []byte{}); err != nil {
+			return err
+		}
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSetAutoCompound,
+			sdk.NewAttribute(types.AttributeKeyDelegator, delAddr.String()),
+			sdk.NewAttribute(types.AttributeKeyEnabled, strconv.FormatBool(enabled)),
+		),
+	)
+
+	return nil
+}
+
+// IsAutoCompoundEnabled returns whether a delegator opted in to
+// auto-compounding.
+func (k Keeper) IsAutoCompoundEnabled(ctx context.Context, delAddr sdk.AccAddress) (bool, error) {
+	store := k.storeService.OpenKVStore(ctx)
+	return store.Has(types.GetAutoCompoundDelegatorKey(delAddr))
+}
+
+// IterateAutoCompoundDelegators iterates over the delegators who opted in to
+// auto-compounding.
+func (k Keeper) IterateAutoCompoundDelegators(ctx context.Context, handler func(del sdk.AccAddress) (stop bool)) {
+	store := k.storeService.OpenKVStore(ctx)
+	iter := storetypes.KVStorePrefixIterator(runtime.KVStoreAdapter(store), types.AutoCompoundDelegatorPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		if handler(types.GetAutoCompoundDelegatorAddress(iter.Key())) {
+			break
+		}
+	}
+}
+
+// AutoCompoundRewards re-delegates the staking rewards of the delegators who
+// opted in to auto-compounding. A pass over these delegators starts every
+// AutoCompoundEpochBlocks blocks, and the delegators are processed until
+// AutoCompoundMaxGas is consumed in the block, the pass resuming from the next
+// delegator in the following block. The delegators who are not their own
+// withdraw address anymore are opted out.
+func (k Keeper) AutoCompoundRewards(ctx context.Context) error {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	if params.AutoCompoundEpochBlocks == 0 {
+		return nil
+	}
+
+	store := k.storeService.OpenKVStore(ctx)
+	start, err := store.Get(types.AutoCompoundCursorKey)
+	if err != nil {
+		return err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if start == nil {
+		// no pass in progress, start one at the epoch boundaries
+		if uint64(sdkCtx.BlockHeight())%params.AutoCompoundEpochBlocks != 0 {
+			return nil
+		}
+		start = types.AutoCompoundDelegatorPrefix
+	}
+
+	gasMeter := storetypes.NewGasMeter(params.AutoCompoundMaxGas)
+	for first := true; ; first = false {
+		// the delegators are read one at a time, so that the store is not
+		// written while being iterated
+		key, err := k.nextAutoCompoundDelegatorKey(ctx, start)
+		if err != nil {
+			return err
+		}
+
+		if key == nil {
+			return store.Delete(types.AutoCompoundCursorKey)
+		}
+
+		if gasMeter.IsOutOfGas() {
+			return store.Set(types.AutoCompoundCursorKey, key)
+		}
+
+		delAddr := types.GetAutoCompoundDelegatorAddress(key)
+		cacheCtx, writeCache := sdkCtx.WithGasMeter(gasMeter).CacheContext()
+		outOfGas, err := k.safeAutoCompound(cacheCtx, delAddr)
+		switch {
+		case outOfGas && !first:
+			// retry the delegator in the next block, with the full gas limit
+			return store.Set(types.AutoCompoundCursorKey, key)
+		case outOfGas:
+			k.Logger(ctx).Error("auto-compounding exceeds the gas limit, skipping delegator", "delegator", delAddr)
+		case errors.Is(err, types.ErrAutoCompoundWithdrawAddr):
+			if err := k.SetAutoCompound(ctx, delAddr, false); err != nil {
+				return err
+			}
+		case err != nil:
+			k.Logger(ctx).Error("failed to auto-compound rewards", "delegator", delAddr, "err", err)
+		default:
+			writeCache()
+		}
+
+		// resume right after the processed delegator
+		start = append(key, 0)
+	}
+}
+
+// nextAutoCompoundDelegatorKey returns the first auto-compounding opt-in key
+// from start, or nil if there is none.
+func (k Keeper) nextAutoCompoundDelegatorKey(ctx context.Context, start []byte) ([]byte, error) {
+	store := k.storeService.OpenKVStore(ctx)
+	iter, err := store.Iterator(start, storetypes.PrefixEndBytes(types.AutoCompoundDelegatorPrefix))
+	if err != nil {
+		return nil, err
+	}
+	defer iter.Close()
+
+	if !iter.Valid() {
+		return nil, nil
+	}
+
+	return bytes.Clone(iter.Key()), nil
+}
+
+// safeAutoCompound auto-compounds the rewards of a delegator, recovering from
+// running out of gas.
+func (k Keeper) safeAutoCompound(ctx sdk.Context, delAddr sdk.AccAddress) (outOfGas bool, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			if _, ok := r.(storetypes.ErrorOutOfGas); !ok {
+				panic(r)
+			}
+			outOfGas = true
+		}
+	}()
+
+	return false, k.autoCompound(ctx, delAddr)
+}
+
+// autoCompound withdraws the rewards of all the delegations of a delegator,
+// and delegates the rewards in the bond denom back to the same validators,
+// unless it would exceed the minimum self-bond ratio of the validator.
+func (k Keeper) autoCompound(ctx context.Context, delAddr sdk.AccAddress) error {
+	withdrawAddr, err := k.GetDelegatorWithdrawAddr(ctx, delAddr)
+	if err != nil {
+		return err
+	}
+
+	if !withdrawAddr.Equals(delAddr) {
+		return types.ErrAutoCompoundWithdrawAddr
+	}
+
+	bondDenom, err := k.stakingKeeper.BondDenom(ctx)
+	if err != nil {
+		return err
+	}
+
+	delegations, err := k.stakingKeeper.GetAllDelegatorDelegations(ctx, delAddr)
+	if err != nil {
+		return err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	for _, delegation := range delegations {
+		valAddr, err := k.stakingKeeper.ValidatorAddressCodec().StringToBytes(delegation.GetValidatorAddr())
+		if err != nil {
+			return err
+		}
+
+		rewards, err := k.WithdrawDelegationRewards(ctx, delAddr, valAddr)
+		if err != nil {
+			return err
+		}
+
+		amount := rewards.AmountOf(bondDenom)
+		if !amount.IsPositive() {
+			continue
+		}
+
+		validator, err := k.stakingKeeper.GetValidator(ctx, valAddr)
+		if err != nil {
+			return err
+		}
+
+		// the rewards are left in the delegator account when delegating them
+		// would bring the validator self-bond below the minimum ratio
+		err = k.stakingKeeper.CheckSelfBondRatio(ctx, delAddr, validator, amount)
+		if errors.Is(err, stakingtypes.ErrSelfBondRatioExceeded) {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		if _, err := k.stakingKeeper.Delegate(ctx, delAddr, amount, stakingtypes.Unbonded, validator, true); err != nil {
+			return err
+		}
+
+		sdkCtx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeAutoCompound,
+				sdk.NewAttribute(sdk.AttributeKeyAmount, sdk.NewCoin(bondDenom, amount).String()),
+				sdk.NewAttribute(types.AttributeKeyValidator, delegation.GetValidatorAddr()),
+				sdk.NewAttribute(types.AttributeKeyDelegator, delAddr.String()),
+			),
+		)
+	}
+
+	return nil
+}
@@ -0,0 +1,161 @@
+package keeper_test
+
+import (
+	"testing"
+
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/codec/address"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/distribution"
+	"github.com/cosmos/cosmos-sdk/x/distribution/keeper"
+	distrtestutil "github.com/cosmos/cosmos-sdk/x/distribution/testutil"
+	disttypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func TestAutoCompoundRewards(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	key := storetypes.NewKVStoreKey(disttypes.StoreKey)
+	storeService := runtime.NewKVStoreService(key)
+	testCtx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test"))
+	encCfg := moduletestutil.MakeTestEncodingConfig(distribution.AppModuleBasic{})
+	ctx := testCtx.Ctx.WithBlockHeader(cmtproto.Header{Height: 1})
+
+	bankKeeper := distrtestutil.NewMockBankKeeper(ctrl)
+	stakingKeeper := distrtestutil.NewMockStakingKeeper(ctrl)
+	accountKeeper := distrtestutil.NewMockAccountKeeper(ctrl)
+
+	accountKeeper.EXPECT().GetModuleAddress("distribution").Return(distrAcc.GetAddress())
+	stakingKeeper.EXPECT().ValidatorAddressCodec().Return(address.NewBech32Codec(sdk.Bech32PrefixValAddr)).AnyTimes()
+	accountKeeper.EXPECT().AddressCodec().Return(address.NewBech32Codec(sdk.Bech32MainPrefix)).AnyTimes()
+
+	distrKeeper := keeper.NewKeeper(
+		encCfg.Codec,
+		storeService,
+		accountKeeper,
+		bankKeeper,
+		stakingKeeper,
+		"fee_collector",
+		authtypes.NewModuleAddress("gov").String(),
+	)
+
+	// reset fee pool
+	require.NoError(t, distrKeeper.FeePool.Set(ctx, disttypes.InitialFeePool()))
+	params := disttypes.DefaultParams()
+	params.AutoCompoundEpochBlocks = 10
+	require.NoError(t, distrKeeper.Params.Set(ctx, params))
+
+	// create validator with 50% commission
+	valAddr := sdk.ValAddress(valConsAddr0)
+	addr := sdk.AccAddress(valAddr)
+	val, err := distrtestutil.CreateValidator(valConsPk0, math.NewInt(100))
+	require.NoError(t, err)
+	val.Commission = stakingtypes.NewCommission(math.LegacyNewDecWithPrec(5, 1), math.LegacyNewDecWithPrec(5, 1), math.LegacyNewDec(0))
+
+	del := stakingtypes.NewDelegation(addr.String(), valAddr.String(), val.DelegatorShares)
+	stakingKeeper.EXPECT().Validator(gomock.Any(), valAddr).Return(val, nil).AnyTimes()
+	stakingKeeper.EXPECT().Delegation(gomock.Any(), addr, valAddr).Return(del, nil).AnyTimes()
+
+	// run the necessary hooks manually (given that we are not running an actual staking module)
+	err = distrtestutil.CallCreateValidatorHooks(ctx, distrKeeper, addr, valAddr)
+	require.NoError(t, err)
+
+	// opt in to auto-compounding
+	require.NoError(t, distrKeeper.SetAutoCompound(ctx, addr, true))
+	enabled, err := distrKeeper.IsAutoCompoundEnabled(ctx, addr)
+	require.NoError(t, err)
+	require.True(t, enabled)
+
+	// the withdraw address of the delegator cannot be changed
+	otherAddr := sdk.AccAddress(valConsAddr1)
+	bankKeeper.EXPECT().BlockedAddr(otherAddr).Return(false)
+	require.ErrorIs(t, distrKeeper.SetWithdrawAddr(ctx, addr, otherAddr), disttypes.ErrAutoCompoundWithdrawAddr)
+
+	// allocate some rewards
+	initial := sdk.TokensFromConsensusPower(10, sdk.DefaultPowerReduction)
+	tokens := sdk.DecCoins{sdk.NewDecCoin(sdk.DefaultBondDenom, initial)}
+	require.NoError(t, distrKeeper.AllocateTokensToValidator(ctx, val, tokens))
+
+	// nothing happens outside of the epoch boundaries
+	ctx = ctx.WithBlockHeight(5)
+	require.NoError(t, distrKeeper.AutoCompoundRewards(ctx))
+
+	// the rewards are withdrawn and delegated back at the epoch boundaries
+	ctx = ctx.WithBlockHeight(10)
+	expRewards := initial.QuoRaw(2)
+	stakingKeeper.EXPECT().BondDenom(gomock.Any()).Return(sdk.DefaultBondDenom, nil)
+	stakingKeeper.EXPECT().GetAllDelegatorDelegations(gomock.Any(), addr).Return([]stakingtypes.Delegation{del}, nil)
+	stakingKeeper.EXPECT().GetValidator(gomock.Any(), valAddr).Return(val, nil)
+	bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), disttypes.ModuleName, addr, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, expRewards))).Return(nil)
+	stakingKeeper.EXPECT().CheckSelfBondRatio(gomock.Any(), addr, val, expRewards).Return(nil)
+	stakingKeeper.EXPECT().Delegate(gomock.Any(), addr, expRewards, stakingtypes.Unbonded, val, true).Return(math.LegacyNewDecFromInt(expRewards), nil)
+	require.NoError(t, distrKeeper.AutoCompoundRewards(ctx))
+
+	// a delegator which cannot be processed within the gas limit of a block
+	// is skipped, and the remaining delegators are processed in the next block
+	require.NoError(t, distrKeeper.SetAutoCompound(ctx, otherAddr, true))
+	params.AutoCompoundMaxGas = 1
+	require.NoError(t, distrKeeper.Params.Set(ctx, params))
+
+	store := storeService.OpenKVStore(ctx)
+	ctx = ctx.WithBlockHeight(20)
+	require.NoError(t, distrKeeper.AutoCompoundRewards(ctx))
+	cursor, err := store.Get(disttypes.AutoCompoundCursorKey)
+	require.NoError(t, err)
+	require.NotNil(t, cursor)
+
+	ctx = ctx.WithBlockHeight(21)
+	require.NoError(t, distrKeeper.AutoCompoundRewards(ctx))
+	has, err := store.Has(disttypes.AutoCompoundCursorKey)
+	require.NoError(t, err)
+	require.False(t, has)
+
+	// a delegator who is not its own withdraw address anymore is opted out
+	params.AutoCompoundMaxGas = disttypes.DefaultAutoCompoundMaxGas
+	require.NoError(t, distrKeeper.Params.Set(ctx, params))
+	require.NoError(t, distrKeeper.SetDelegatorWithdrawAddr(ctx, otherAddr, addr))
+
+	ctx = ctx.WithBlockHeight(30).WithEventManager(sdk.NewEventManager())
+	stakingKeeper.EXPECT().BondDenom(gomock.Any()).Return(sdk.DefaultBondDenom, nil)
+	stakingKeeper.EXPECT().GetAllDelegatorDelegations(gomock.Any(), addr).Return([]stakingtypes.Delegation{del}, nil)
+	require.NoError(t, distrKeeper.AutoCompoundRewards(ctx))
+
+	enabled, err = distrKeeper.IsAutoCompoundEnabled(ctx, otherAddr)
+	require.NoError(t, err)
+	require.False(t, enabled)
+	require.Contains(t, ctx.EventManager().Events(), sdk.NewEvent(
+		disttypes.EventTypeSetAutoCompound,
+		sdk.NewAttribute(disttypes.AttributeKeyDelegator, otherAddr.String()),
+		sdk.NewAttribute(disttypes.AttributeKeyEnabled, "false"),
+	))
+
+	// the rewards are left in the delegator account when the validator is at
+	// the limit of its self-bond ratio
+	require.NoError(t, distrKeeper.AllocateTokensToValidator(ctx, val, tokens))
+	ctx = ctx.WithBlockHeight(40).WithEventManager(sdk.NewEventManager())
+	stakingKeeper.EXPECT().BondDenom(gomock.Any()).Return(sdk.DefaultBondDenom, nil)
+	stakingKeeper.EXPECT().GetAllDelegatorDelegations(gomock.Any(), addr).Return([]stakingtypes.Delegation{del}, nil)
+	stakingKeeper.EXPECT().GetValidator(gomock.Any(), valAddr).Return(val, nil)
+	bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), disttypes.ModuleName, addr, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, expRewards))).Return(nil)
+	stakingKeeper.EXPECT().CheckSelfBondRatio(gomock.Any(), addr, val, expRewards).Return(stakingtypes.ErrSelfBondRatioExceeded)
+	require.NoError(t, distrKeeper.AutoCompoundRewards(ctx))
+	for _, event := range ctx.EventManager().Events() {
+		require.NotEqual(t, disttypes.EventTypeAutoCompound, event.Type)
+	}
+
+	// opt out of auto-compounding
+	require.NoError(t, distrKeeper.SetAutoCompound(ctx, addr, false))
+	enabled, err = distrKeeper.IsAutoCompoundEnabled(ctx, addr)
+	require.NoError(t, err)
+	require.False(t, enabled)
+}
@@ -1049,3 +1049,81 @@ func Test100PercentCommissionReward(t *testing.T) {
 	}
 	require.True(t, hasValue)
 }
+
+func TestWithdrawTokenizeShareRecordReward(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	key := storetypes.NewKVStoreKey(disttypes.StoreKey)
+	storeService := runtime.NewKVStoreService(key)
+	testCtx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test"))
+	encCfg := moduletestutil.MakeTestEncodingConfig(distribution.AppModuleBasic{})
+	ctx := testCtx.Ctx.WithBlockHeader(cmtproto.Header{Height: 1})
+
+	bankKeeper := distrtestutil.NewMockBankKeeper(ctrl)
+	stakingKeeper := distrtestutil.NewMockStakingKeeper(ctrl)
+	accountKeeper := distrtestutil.NewMockAccountKeeper(ctrl)
+
+	accountKeeper.EXPECT().GetModuleAddress("distribution").Return(distrAcc.GetAddress())
+	stakingKeeper.EXPECT().ValidatorAddressCodec().Return(address.NewBech32Codec(sdk.Bech32PrefixValAddr)).AnyTimes()
+	accountKeeper.EXPECT().AddressCodec().Return(address.NewBech32Codec(sdk.Bech32MainPrefix)).AnyTimes()
+
+	distrKeeper := keeper.NewKeeper(
+		encCfg.Codec,
+		storeService,
+		accountKeeper,
+		bankKeeper,
+		stakingKeeper,
+		"fee_collector",
+		authtypes.NewModuleAddress("gov").String(),
+	)
+
+	// reset fee pool
+	require.NoError(t, distrKeeper.FeePool.Set(ctx, disttypes.InitialFeePool()))
+	require.NoError(t, distrKeeper.Params.Set(ctx, disttypes.DefaultParams()))
+
+	// create validator with 50% commission
+	valAddr := sdk.ValAddress(valConsAddr0)
+	val, err := distrtestutil.CreateValidator(valConsPk0, math.NewInt(100))
+	require.NoError(t, err)
+
+	val.Commission = stakingtypes.NewCommission(math.LegacyNewDecWithPrec(5, 1), math.LegacyNewDecWithPrec(5, 1), math.LegacyNewDec(0))
+
+	// all the shares of the validator are delegated by a tokenize share record
+	owner := sdk.AccAddress(valConsAddr1)
+	record := stakingtypes.NewTokenizeShareRecord(1, owner.String(), valAddr.String())
+	recordAddr := record.GetModuleAddress()
+
+	del := stakingtypes.NewDelegation(recordAddr.String(), valAddr.String(), val.DelegatorShares)
+	stakingKeeper.EXPECT().Validator(gomock.Any(), valAddr).Return(val, nil).AnyTimes()
+	stakingKeeper.EXPECT().Delegation(gomock.Any(), recordAddr, valAddr).Return(del, nil).AnyTimes()
+	stakingKeeper.EXPECT().GetTokenizeShareRecordsByOwner(gomock.Any(), owner).Return([]stakingtypes.TokenizeShareRecord{record}, nil)
+
+	// run the necessary hooks manually (given that we are not running an actual staking module)
+	err = distrtestutil.CallCreateValidatorHooks(ctx, distrKeeper, recordAddr, valAddr)
+	require.NoError(t, err)
+
+	// next block
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+
+	// allocate some rewards
+	initial := sdk.TokensFromConsensusPower(10, sdk.DefaultPowerReduction)
+	tokens := sdk.DecCoins{sdk.NewDecCoin(sdk.DefaultBondDenom, initial)}
+	require.NoError(t, distrKeeper.AllocateTokensToValidator(ctx, val, tokens))
+
+	// the rewards previously withdrawn to the record module account and the
+	// rewards of its delegation are sent to the owner
+	balance := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, math.NewInt(5)))
+	bankKeeper.EXPECT().GetAllBalances(gomock.Any(), recordAddr).Return(balance)
+	bankKeeper.EXPECT().SendCoins(gomock.Any(), recordAddr, owner, balance).Return(nil)
+
+	expRewards := sdk.Coins{sdk.NewCoin(sdk.DefaultBondDenom, initial.QuoRaw(2))}
+	bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), disttypes.ModuleName, owner, expRewards).Return(nil)
+
+	rewards, err := distrKeeper.WithdrawTokenizeShareRecordReward(ctx, owner)
+	require.NoError(t, err)
+	require.Equal(t, expRewards.Add(balance...), rewards)
+
+	// the withdraw address of the record is its owner
+	withdrawAddr, err := distrKeeper.GetDelegatorWithdrawAddr(ctx, recordAddr)
+	require.NoError(t, err)
+	require.Equal(t, owner, withdrawAddr)
+}
@@ -115,6 +115,17 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data types.GenesisState) {
 		}
 	}
 
+	for _, del := range data.AutoCompoundDelegators {
+		delegatorAddress, err := k.authKeeper.AddressCodec().StringToBytes(del)
+		if err != nil {
+			panic(err)
+		}
+		err = k.SetAutoCompound(ctx, delegatorAddress, true)
+		if err != nil {
+			panic(err)
+		}
+	}
+
 	moduleHoldings = moduleHoldings.Add(data.FeePool.CommunityPool...)
 	moduleHoldingsInt, _ := moduleHoldings.TruncateDecimal()
 
@@ -230,5 +241,11 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		},
 	)
 
-	return types.NewGenesisState(params, feePool, dwi, pp, outstanding, acc, his, cur, dels, slashes)
+	autoCompound := make([]string, 0)
+	k.IterateAutoCompoundDelegators(ctx, func(del sdk.AccAddress) (stop bool) {
+		autoCompound = append(autoCompound, del.String())
+		return false
+	})
+
+	return types.NewGenesisState(params, feePool, dwi, pp, outstanding, acc, his, cur, dels, slashes, autoCompound)
 }
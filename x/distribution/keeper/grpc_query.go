@@ -361,6 +361,28 @@ func (k Querier) DelegatorWithdrawAddress(ctx context.Context, req *types.QueryD
 	return &types.QueryDelegatorWithdrawAddressResponse{WithdrawAddress: withdrawAddr.String()}, nil
 }
 
+// DelegatorAutoCompound queries whether a delegator opted in to auto-compounding
+func (k Querier) DelegatorAutoCompound(ctx context.Context, req *types.QueryDelegatorAutoCompoundRequest) (*types.QueryDelegatorAutoCompoundResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.DelegatorAddress == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty delegator address")
+	}
+	delAdr, err := k.authKeeper.AddressCodec().StringToBytes(req.DelegatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	enabled, err := k.IsAutoCompoundEnabled(ctx, delAdr)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryDelegatorAutoCompoundResponse{Enabled: enabled}, nil
+}
+
 // CommunityPool queries the community pool coins
 func (k Querier) CommunityPool(ctx context.Context, req *types.QueryCommunityPoolRequest) (*types.QueryCommunityPoolResponse, error) {
 	pool, err := k.FeePool.Get(ctx)
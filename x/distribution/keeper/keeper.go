@@ -91,6 +91,19 @@ func (k Keeper) SetWithdrawAddr(ctx context.Context, delegatorAddr, withdrawAddr
 		return types.ErrSetWithdrawAddrDisabled
 	}
 
+	// the rewards of the delegators who opted in to auto-compounding are
+	// delegated from their own account
+	if !withdrawAddr.Equals(delegatorAddr) {
+		autoCompound, err := k.IsAutoCompoundEnabled(ctx, delegatorAddr)
+		if err != nil {
+			return err
+		}
+
+		if autoCompound {
+			return types.ErrAutoCompoundWithdrawAddr
+		}
+	}
+
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 	sdkCtx.EventManager().EmitEvent(
 		sdk.NewEvent(
@@ -137,6 +150,46 @@ func (k Keeper) WithdrawDelegationRewards(ctx context.Context, delAddr sdk.AccAd
 	return rewards, nil
 }
 
+// WithdrawTokenizeShareRecordReward withdraws the rewards of all the tokenize
+// share records of an owner. The withdraw address of the records is set to
+// their owner, and the rewards withdrawn to the record module accounts
+// beforehand, e.g. when their shares were redeemed, are sent to the owner as
+// well.
+func (k Keeper) WithdrawTokenizeShareRecordReward(ctx context.Context, owner sdk.AccAddress) (sdk.Coins, error) {
+	records, err := k.stakingKeeper.GetTokenizeShareRecordsByOwner(ctx, owner)
+	if err != nil {
+		return nil, err
+	}
+
+	totalRewards := sdk.NewCoins()
+	for _, record := range records {
+		valAddr, err := k.stakingKeeper.ValidatorAddressCodec().StringToBytes(record.Validator)
+		if err != nil {
+			return nil, err
+		}
+
+		recordAddr := record.GetModuleAddress()
+		if err := k.SetDelegatorWithdrawAddr(ctx, recordAddr, owner); err != nil {
+			return nil, err
+		}
+
+		if balance := k.bankKeeper.GetAllBalances(ctx, recordAddr); !balance.IsZero() {
+			if err := k.bankKeeper.SendCoins(ctx, recordAddr, owner, balance); err != nil {
+				return nil, err
+			}
+			totalRewards = totalRewards.Add(balance...)
+		}
+
+		rewards, err := k.WithdrawDelegationRewards(ctx, recordAddr, valAddr)
+		if err != nil {
+			return nil, err
+		}
+		totalRewards = totalRewards.Add(rewards...)
+	}
+
+	return totalRewards, nil
+}
+
 // withdraw validator commission
 func (k Keeper) WithdrawValidatorCommission(ctx context.Context, valAddr sdk.ValAddress) (sdk.Coins, error) {
 	// fetch validator accumulated commission
@@ -42,6 +42,7 @@ func TestMigrateJSON(t *testing.T) {
 	require.NoError(t, err)
 
 	expected := `{
+	"auto_compound_delegators": [],
 	"delegator_starting_infos": [],
 	"delegator_withdraw_infos": [],
 	"fee_pool": {
@@ -49,6 +50,8 @@ func TestMigrateJSON(t *testing.T) {
 	},
 	"outstanding_rewards": [],
 	"params": {
+		"auto_compound_epoch_blocks": "1000",
+		"auto_compound_max_gas": "10000000",
 		"base_proposer_reward": "0.000000000000000000",
 		"bonus_proposer_reward": "0.000000000000000000",
 		"community_tax": "0.020000000000000000",
@@ -38,8 +38,10 @@ func RandomizedGenState(simState *module.SimulationState) {
 	distrGenesis := types.GenesisState{
 		FeePool: types.InitialFeePool(),
 		Params: types.Params{
-			CommunityTax:        communityTax,
-			WithdrawAddrEnabled: withdrawEnabled,
+			CommunityTax:            communityTax,
+			WithdrawAddrEnabled:     withdrawEnabled,
+			AutoCompoundEpochBlocks: types.DefaultAutoCompoundEpochBlocks,
+			AutoCompoundMaxGas:      types.DefaultAutoCompoundMaxGas,
 		},
 	}
 
@@ -38,6 +38,14 @@ type Params struct {
 	// in the x/distribution module's reward mechanism.
 	BonusProposerReward cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=bonus_proposer_reward,json=bonusProposerReward,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"bonus_proposer_reward"` // Deprecated: Do not use.
 	WithdrawAddrEnabled bool                        `protobuf:"varint,4,opt,name=withdraw_addr_enabled,json=withdrawAddrEnabled,proto3" json:"withdraw_addr_enabled,omitempty"`
+	// auto_compound_epoch_blocks defines the interval, in blocks, at which the
+	// staking rewards of the delegators who opted in are re-delegated. Zero
+	// disables auto-compounding.
+	AutoCompoundEpochBlocks uint64 `protobuf:"varint,5,opt,name=auto_compound_epoch_blocks,json=autoCompoundEpochBlocks,proto3" json:"auto_compound_epoch_blocks,omitempty"`
+	// auto_compound_max_gas defines the gas which can be consumed by
+	// auto-compounding in a block. The delegators not processed within this
+	// limit are processed in the following blocks.
+	AutoCompoundMaxGas uint64 `protobuf:"varint,6,opt,name=auto_compound_max_gas,json=autoCompoundMaxGas,proto3" json:"auto_compound_max_gas,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -80,6 +88,20 @@ func (m *Params) GetWithdrawAddrEnabled() bool {
 	return false
 }
 
+func (m *Params) GetAutoCompoundEpochBlocks() uint64 {
+	if m != nil {
+		return m.AutoCompoundEpochBlocks
+	}
+	return 0
+}
+
+func (m *Params) GetAutoCompoundMaxGas() uint64 {
+	if m != nil {
+		return m.AutoCompoundMaxGas
+	}
+	return 0
+}
+
 // ValidatorHistoricalRewards represents historical rewards for a validator.
 // Height is implicit within the store key.
 // Cumulative reward ratio is the sum from the zeroeth period
@@ -643,71 +665,75 @@ func init() {
 }
 
 var fileDescriptor_cd78a31ea281a992 = []byte{
-	// 1012 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x56, 0x41, 0x6f, 0x1b, 0x45,
-	0x14, 0xf6, 0x34, 0x89, 0xd3, 0x4c, 0xdb, 0x84, 0x4e, 0x9c, 0xd4, 0x71, 0x8b, 0x6d, 0x56, 0xaa,
-	0x30, 0x81, 0xd8, 0xa4, 0x48, 0x08, 0xe5, 0xd6, 0xd8, 0xad, 0x40, 0x2a, 0x34, 0xda, 0x20, 0x90,
-	0xe0, 0xb0, 0x1a, 0xef, 0x4e, 0xec, 0x21, 0xbb, 0x33, 0xcb, 0xcc, 0xd8, 0x49, 0x0e, 0xdc, 0x03,
-	0x07, 0xe0, 0x06, 0xea, 0xa9, 0x82, 0x4b, 0xc5, 0x29, 0x87, 0xfc, 0x88, 0x8a, 0x53, 0x55, 0x10,
-	0x42, 0x1c, 0x02, 0x24, 0x87, 0x20, 0x7e, 0x05, 0x9a, 0x9d, 0xf1, 0xae, 0x13, 0x42, 0x81, 0x22,
-	0x8b, 0x4b, 0x94, 0x79, 0x6f, 0xf6, 0x7d, 0xdf, 0xf7, 0xe6, 0x9b, 0x37, 0x86, 0x75, 0x9f, 0xcb,
-	0x88, 0xcb, 0x46, 0x40, 0xa5, 0x12, 0xb4, 0xdd, 0x53, 0x94, 0xb3, 0x46, 0x7f, 0xb9, 0x4d, 0x14,
-	0x5e, 0x3e, 0x11, 0xac, 0xc7, 0x82, 0x2b, 0x8e, 0xae, 0x9a, 0xfd, 0xf5, 0x13, 0x29, 0xbb, 0xbf,
-	0x54, 0xe8, 0xf0, 0x0e, 0x4f, 0xf6, 0x35, 0xf4, 0x7f, 0xe6, 0x93, 0x52, 0xd9, 0x42, 0xb4, 0xb1,
-	0x24, 0x69, 0x69, 0x9f, 0x53, 0x5b, 0xb2, 0xb4, 0x60, 0xf2, 0x9e, 0xf9, 0xd0, 0xd6, 0x37, 0xa9,
-	0xcb, 0x38, 0xa2, 0x8c, 0x37, 0x92, 0xbf, 0x26, 0xe4, 0xdc, 0x1b, 0x83, 0xf9, 0x35, 0x2c, 0x70,
-	0x24, 0xd1, 0xfb, 0xf0, 0x92, 0xcf, 0xa3, 0xa8, 0xc7, 0xa8, 0xda, 0xf1, 0x14, 0xde, 0x2e, 0x82,
-	0x2a, 0xa8, 0x4d, 0xad, 0xbe, 0xfa, 0xf0, 0xa0, 0x92, 0xfb, 0xe9, 0xa0, 0x62, 0xa9, 0xca, 0x60,
-	0xb3, 0x4e, 0x79, 0x23, 0xc2, 0xaa, 0x5b, 0xbf, 0x43, 0x3a, 0xd8, 0xdf, 0x69, 0x11, 0xff, 0xf1,
-	0xfe, 0x12, 0xb4, 0x48, 0x2d, 0xe2, 0x3f, 0x38, 0xde, 0x5b, 0x04, 0xee, 0xc5, 0xb4, 0xd8, 0xdb,
-	0x78, 0x1b, 0x7d, 0x00, 0x0b, 0x9a, 0xb0, 0x66, 0x15, 0x73, 0x49, 0x84, 0x27, 0xc8, 0x16, 0x16,
-	0x41, 0xf1, 0x5c, 0x82, 0xf1, 0xda, 0xd3, 0x61, 0x14, 0x81, 0x8b, 0x74, 0xd5, 0x35, 0x5b, 0xd4,
-	0x4d, 0x6a, 0xa2, 0x10, 0xce, 0xb5, 0x39, 0xeb, 0xc9, 0x3f, 0x81, 0x8d, 0xfd, 0x47, 0xb0, 0xd9,
-	0xa4, 0xec, 0x29, 0xb4, 0x1b, 0x70, 0x6e, 0x8b, 0xaa, 0x6e, 0x20, 0xf0, 0x96, 0x87, 0x83, 0x40,
-	0x78, 0x84, 0xe1, 0x76, 0x48, 0x82, 0xe2, 0x78, 0x15, 0xd4, 0xce, 0xbb, 0xb3, 0x83, 0xe4, 0xcd,
-	0x20, 0x10, 0xb7, 0x4c, 0x6a, 0xe5, 0xfa, 0x27, 0xc7, 0x7b, 0x8b, 0x55, 0x03, 0xb0, 0x24, 0x83,
-	0xcd, 0xc6, 0xf6, 0x49, 0xc7, 0x98, 0x13, 0x71, 0x7e, 0x00, 0xb0, 0xf4, 0x0e, 0x0e, 0x69, 0x80,
-	0x15, 0x17, 0xaf, 0x53, 0xa9, 0xb8, 0xa0, 0x3e, 0x0e, 0x0d, 0xb0, 0x44, 0x9f, 0x02, 0x78, 0xc5,
-	0xef, 0x45, 0xbd, 0x10, 0x2b, 0xda, 0x27, 0x56, 0xa4, 0x27, 0xb0, 0xa2, 0xbc, 0x08, 0xaa, 0x63,
-	0xb5, 0x0b, 0x37, 0xae, 0x59, 0x3f, 0xd6, 0x75, 0x97, 0x06, 0xbe, 0xd2, 0x8a, 0x9a, 0x9c, 0x32,
-	0xd3, 0x88, 0x6f, 0x7e, 0xae, 0xbc, 0xd8, 0xa1, 0xaa, 0xdb, 0x6b, 0xd7, 0x7d, 0x1e, 0x59, 0xbf,
-	0x34, 0x86, 0xa8, 0xa9, 0x9d, 0x98, 0xc8, 0xc1, 0x37, 0xd2, 0x9c, 0xed, 0x5c, 0x06, 0x6b, 0xc8,
-	0xb8, 0x1a, 0x14, 0x3d, 0x0f, 0x67, 0x04, 0xd9, 0x20, 0x82, 0x30, 0x9f, 0x78, 0x3e, 0xef, 0x31,
-	0x95, 0x9c, 0xef, 0x25, 0x77, 0x3a, 0x0d, 0x37, 0x75, 0xd4, 0xf9, 0x1a, 0xc0, 0x2b, 0xa9, 0xb0,
-	0x66, 0x4f, 0x08, 0xc2, 0xd4, 0x40, 0x55, 0x0c, 0x27, 0x8d, 0x12, 0x39, 0x62, 0x11, 0x03, 0x18,
-	0x34, 0x0f, 0xf3, 0x31, 0x11, 0x94, 0x1b, 0x37, 0x8e, 0xbb, 0x76, 0xe5, 0x7c, 0x09, 0x60, 0x39,
-	0x65, 0x79, 0xd3, 0xb7, 0x9a, 0x49, 0xd0, 0xe4, 0x51, 0x44, 0xa5, 0xa4, 0x9c, 0xa1, 0x3e, 0x84,
-	0x7e, 0xba, 0x1a, 0x31, 0xdf, 0x21, 0x24, 0xe7, 0x33, 0x00, 0xaf, 0xa6, 0xd4, 0xee, 0xf6, 0x94,
-	0x54, 0x98, 0x05, 0x94, 0x75, 0xfe, 0xb7, 0x26, 0x6a, 0x46, 0xb3, 0x29, 0xa3, 0xf5, 0x10, 0xcb,
-	0xee, 0xad, 0x3e, 0x61, 0x0a, 0xbd, 0x00, 0x9f, 0xe9, 0x0f, 0xc2, 0x9e, 0x6d, 0x33, 0x48, 0xda,
-	0x3c, 0x93, 0xc6, 0xd7, 0x92, 0x30, 0x7a, 0x13, 0x9e, 0xdf, 0x10, 0xd8, 0xd7, 0x37, 0xc0, 0xce,
-	0x85, 0xe5, 0x7f, 0x7d, 0x55, 0xdd, 0xb4, 0x84, 0xf3, 0x31, 0x80, 0x85, 0x33, 0x18, 0x49, 0xf4,
-	0x21, 0x9c, 0xcf, 0x28, 0x49, 0x9d, 0xf0, 0x48, 0x92, 0xb1, 0xbd, 0x7a, 0xb9, 0xfe, 0x84, 0xa9,
-	0x5c, 0x3f, 0xa3, 0xe4, 0xea, 0x94, 0xe6, 0x69, 0x1a, 0x52, 0xe8, 0x9f, 0x01, 0xe9, 0xec, 0x02,
-	0x38, 0x79, 0x9b, 0x90, 0x35, 0xce, 0x43, 0xf4, 0x11, 0x9c, 0xce, 0xe6, 0x6c, 0xcc, 0x79, 0x38,
-	0xe2, 0x23, 0xca, 0xa6, 0xba, 0x86, 0x77, 0xbe, 0x38, 0x07, 0x4b, 0xcd, 0xe1, 0xc8, 0x7a, 0x4c,
-	0x58, 0x60, 0x86, 0x1a, 0x0e, 0x51, 0x01, 0x4e, 0x28, 0xaa, 0x42, 0x62, 0xa6, 0xbf, 0x6b, 0x16,
-	0xa8, 0x0a, 0x2f, 0x04, 0x44, 0xfa, 0x82, 0xc6, 0xd9, 0xe9, 0xb8, 0xc3, 0x21, 0x74, 0x0d, 0x4e,
-	0x09, 0xe2, 0xd3, 0x98, 0x12, 0xa6, 0xcc, 0xa0, 0x75, 0xb3, 0x00, 0xda, 0x81, 0x79, 0x1c, 0x25,
-	0x03, 0x61, 0x3c, 0xd1, 0xba, 0x70, 0xa6, 0xd6, 0x44, 0xe8, 0x6d, 0x2b, 0xb4, 0xf6, 0x0f, 0x84,
-	0x26, 0x2a, 0xef, 0x1d, 0xef, 0x2d, 0x5e, 0x0c, 0x13, 0x3b, 0x78, 0x7e, 0x26, 0xdb, 0x02, 0xae,
-	0xd4, 0x76, 0xef, 0x57, 0x72, 0xbf, 0xdd, 0xaf, 0xe4, 0xbe, 0xdd, 0x5f, 0x2a, 0x59, 0xd4, 0x0e,
-	0xef, 0x0f, 0x81, 0x32, 0xa5, 0x39, 0x03, 0xe7, 0x7b, 0x00, 0xe7, 0x5a, 0x44, 0x57, 0xd2, 0xa7,
-	0xa7, 0xb0, 0x50, 0x94, 0x75, 0xde, 0x60, 0x1b, 0xc9, 0x60, 0x8b, 0x05, 0xe9, 0x53, 0xae, 0x1f,
-	0x95, 0x61, 0x0f, 0x4f, 0x0f, 0xc2, 0xd6, 0xc2, 0x77, 0xe0, 0x84, 0x54, 0x78, 0x93, 0x58, 0xff,
-	0x3e, 0xed, 0xdb, 0x69, 0x8a, 0xa0, 0x16, 0xcc, 0x77, 0x09, 0xed, 0x74, 0x4d, 0x43, 0xc7, 0x57,
-	0x5f, 0xfa, 0xfd, 0xa0, 0x32, 0xe3, 0x0b, 0xa2, 0x87, 0x2d, 0xf3, 0x4c, 0xea, 0xab, 0xe3, 0xbd,
-	0xc5, 0xd3, 0x31, 0xdb, 0x00, 0xb3, 0x70, 0x7e, 0x05, 0x70, 0xc1, 0xca, 0xa2, 0x9c, 0xa5, 0x02,
-	0xed, 0xf3, 0xf5, 0x16, 0xbc, 0x9c, 0x5d, 0x06, 0xfd, 0x7e, 0x11, 0x29, 0xed, 0xcb, 0xff, 0xdc,
-	0xe3, 0xfd, 0xa5, 0x67, 0x2d, 0xb5, 0x6c, 0x0e, 0x9a, 0x2d, 0xeb, 0x4a, 0xe8, 0x71, 0x93, 0xdd,
-	0x6d, 0x1b, 0x47, 0x0c, 0xe6, 0xd3, 0xa7, 0x7d, 0x94, 0xae, 0xb6, 0x28, 0x2b, 0xe3, 0xfa, 0x78,
-	0x9d, 0xef, 0x00, 0xbc, 0xfe, 0xd7, 0xa6, 0x7e, 0x97, 0xaa, 0x6e, 0x8b, 0xc4, 0x5c, 0x52, 0x35,
-	0x22, 0x7f, 0xcf, 0x0f, 0xf9, 0x5b, 0xa7, 0xec, 0x0a, 0x15, 0xe1, 0x64, 0x60, 0x80, 0x8b, 0x13,
-	0x49, 0x62, 0xb0, 0x5c, 0x71, 0x76, 0xff, 0xd6, 0x92, 0xab, 0x77, 0x1f, 0x1c, 0x96, 0xc1, 0xc3,
-	0xc3, 0x32, 0x78, 0x74, 0x58, 0x06, 0xbf, 0x1c, 0x96, 0xc1, 0xe7, 0x47, 0xe5, 0xdc, 0xa3, 0xa3,
-	0x72, 0xee, 0xc7, 0xa3, 0x72, 0xee, 0xbd, 0xe5, 0x27, 0xf6, 0xec, 0xd4, 0x2f, 0x8a, 0xa4, 0x85,
-	0xed, 0x7c, 0xf2, 0xa3, 0xef, 0x95, 0x3f, 0x02, 0x00, 0x00, 0xff, 0xff, 0x78, 0xae, 0xbc, 0xbc,
-	0xa7, 0x0a, 0x00, 0x00,
+	// 1073 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x56, 0xcf, 0x6f, 0x1b, 0x45,
+	0x14, 0xf6, 0xa4, 0x8e, 0xd3, 0x4c, 0xdb, 0xa4, 0x9d, 0xfc, 0x72, 0xdc, 0x62, 0x9b, 0x95, 0x2a,
+	0x4c, 0x20, 0x36, 0x29, 0x12, 0x42, 0xe1, 0xd4, 0x38, 0x29, 0x20, 0xb5, 0x34, 0xda, 0x20, 0x90,
+	0xe0, 0xb0, 0x1a, 0xef, 0x4e, 0xec, 0x21, 0xbb, 0x33, 0xcb, 0xcc, 0xac, 0x93, 0x1c, 0xb8, 0x87,
+	0x1e, 0x80, 0x1b, 0x88, 0x53, 0x05, 0x97, 0x8a, 0x53, 0x0e, 0xf9, 0x23, 0x2a, 0x4e, 0x55, 0x41,
+	0x08, 0x71, 0x08, 0x90, 0x1c, 0x82, 0xf8, 0x2b, 0xd0, 0xec, 0x8c, 0xd7, 0x4e, 0x08, 0xe5, 0x47,
+	0x15, 0x71, 0xb1, 0x3c, 0xef, 0xcd, 0xbc, 0xef, 0x7d, 0x6f, 0xbe, 0x7d, 0x6f, 0x60, 0xdd, 0xe7,
+	0x32, 0xe2, 0xb2, 0x11, 0x50, 0xa9, 0x04, 0x6d, 0x25, 0x8a, 0x72, 0xd6, 0xe8, 0x2e, 0xb4, 0x88,
+	0xc2, 0x0b, 0xc7, 0x8c, 0xf5, 0x58, 0x70, 0xc5, 0xd1, 0x55, 0xb3, 0xbf, 0x7e, 0xcc, 0x65, 0xf7,
+	0x97, 0x26, 0xdb, 0xbc, 0xcd, 0xd3, 0x7d, 0x0d, 0xfd, 0xcf, 0x1c, 0x29, 0x95, 0x2d, 0x44, 0x0b,
+	0x4b, 0x92, 0x85, 0xf6, 0x39, 0xb5, 0x21, 0x4b, 0xb3, 0xc6, 0xef, 0x99, 0x83, 0x36, 0xbe, 0x71,
+	0x5d, 0xc1, 0x11, 0x65, 0xbc, 0x91, 0xfe, 0x1a, 0x93, 0x73, 0x2f, 0x0f, 0x0b, 0xab, 0x58, 0xe0,
+	0x48, 0xa2, 0xf7, 0xe1, 0x25, 0x9f, 0x47, 0x51, 0xc2, 0xa8, 0xda, 0xf6, 0x14, 0xde, 0x2a, 0x82,
+	0x2a, 0xa8, 0x8d, 0x2e, 0xbd, 0xf2, 0x70, 0xbf, 0x92, 0xfb, 0x69, 0xbf, 0x62, 0x53, 0x95, 0xc1,
+	0x46, 0x9d, 0xf2, 0x46, 0x84, 0x55, 0xa7, 0x7e, 0x9b, 0xb4, 0xb1, 0xbf, 0xbd, 0x4c, 0xfc, 0xc7,
+	0x7b, 0xf3, 0xd0, 0x22, 0x2d, 0x13, 0xff, 0xc1, 0xd1, 0xee, 0x1c, 0x70, 0x2f, 0x66, 0xc1, 0xde,
+	0xc6, 0x5b, 0xe8, 0x03, 0x38, 0xa9, 0x13, 0xd6, 0x59, 0xc5, 0x5c, 0x12, 0xe1, 0x09, 0xb2, 0x89,
+	0x45, 0x50, 0x1c, 0x4a, 0x31, 0x5e, 0xfd, 0x6f, 0x18, 0x45, 0xe0, 0x22, 0x1d, 0x75, 0xd5, 0x06,
+	0x75, 0xd3, 0x98, 0x28, 0x84, 0x53, 0x2d, 0xce, 0x12, 0xf9, 0x27, 0xb0, 0x73, 0x4f, 0x09, 0x36,
+	0x91, 0x86, 0x3d, 0x81, 0x76, 0x03, 0x4e, 0x6d, 0x52, 0xd5, 0x09, 0x04, 0xde, 0xf4, 0x70, 0x10,
+	0x08, 0x8f, 0x30, 0xdc, 0x0a, 0x49, 0x50, 0xcc, 0x57, 0x41, 0xed, 0xbc, 0x3b, 0xd1, 0x73, 0xde,
+	0x0c, 0x02, 0xb1, 0x62, 0x5c, 0xe8, 0x35, 0x58, 0xc2, 0x89, 0xe2, 0x9e, 0xcf, 0xa3, 0x98, 0x27,
+	0x2c, 0xf0, 0x48, 0xcc, 0xfd, 0x8e, 0xd7, 0x0a, 0xb9, 0xbf, 0x21, 0x8b, 0xc3, 0x55, 0x50, 0xcb,
+	0xbb, 0x33, 0x7a, 0x47, 0xd3, 0x6e, 0x58, 0xd1, 0xfe, 0xa5, 0xd4, 0x8d, 0x16, 0xe0, 0xd4, 0xf1,
+	0xc3, 0x11, 0xde, 0xf2, 0xda, 0x58, 0x16, 0x0b, 0xe9, 0x39, 0x34, 0x78, 0xee, 0x0e, 0xde, 0x7a,
+	0x1d, 0xcb, 0xc5, 0xeb, 0xf7, 0x8e, 0x76, 0xe7, 0xaa, 0x86, 0xd0, 0xbc, 0x0c, 0x36, 0x1a, 0x5b,
+	0xc7, 0x15, 0x6a, 0x14, 0xe0, 0xfc, 0x00, 0x60, 0xe9, 0x1d, 0x1c, 0xd2, 0x00, 0x2b, 0x2e, 0xde,
+	0xa0, 0x52, 0x71, 0x41, 0x7d, 0x1c, 0x1a, 0xa2, 0x12, 0x7d, 0x02, 0xe0, 0x8c, 0x9f, 0x44, 0x49,
+	0x88, 0x15, 0xed, 0x12, 0x5b, 0x54, 0x4f, 0x60, 0x45, 0x79, 0x11, 0x54, 0xcf, 0xd5, 0x2e, 0xdc,
+	0xb8, 0x66, 0xf5, 0x5f, 0xd7, 0xb7, 0xd2, 0xd3, 0xb1, 0xae, 0x60, 0x93, 0x53, 0x66, 0x0a, 0xff,
+	0xcd, 0xcf, 0x95, 0x17, 0xda, 0x54, 0x75, 0x92, 0x56, 0xdd, 0xe7, 0x91, 0xd5, 0x67, 0x63, 0x20,
+	0x35, 0xb5, 0x1d, 0x13, 0xd9, 0x3b, 0x23, 0x8d, 0x96, 0xa6, 0xfa, 0xb0, 0x26, 0x19, 0x57, 0x83,
+	0xa2, 0xe7, 0xe0, 0xb8, 0x20, 0xeb, 0x44, 0x10, 0xe6, 0x13, 0xcf, 0xe7, 0x09, 0x53, 0xa9, 0x9e,
+	0x2e, 0xb9, 0x63, 0x99, 0xb9, 0xa9, 0xad, 0xce, 0xd7, 0x00, 0xce, 0x64, 0xc4, 0x9a, 0x89, 0x10,
+	0x84, 0xa9, 0x1e, 0xab, 0x18, 0x8e, 0x18, 0x26, 0xf2, 0x8c, 0x49, 0xf4, 0x60, 0xd0, 0x34, 0x2c,
+	0xc4, 0x44, 0x50, 0x6e, 0xd4, 0x9f, 0x77, 0xed, 0xca, 0xf9, 0x02, 0xc0, 0x72, 0x96, 0xe5, 0x4d,
+	0xdf, 0x72, 0x26, 0x41, 0x93, 0x47, 0x11, 0x95, 0x92, 0x72, 0x86, 0xba, 0x10, 0xfa, 0xd9, 0xea,
+	0x8c, 0xf3, 0x1d, 0x40, 0x72, 0x3e, 0x05, 0xf0, 0x6a, 0x96, 0xda, 0xdd, 0x44, 0x49, 0x85, 0x59,
+	0x40, 0x59, 0xfb, 0x7f, 0x2b, 0xa2, 0xce, 0x68, 0x22, 0xcb, 0x68, 0x2d, 0xc4, 0xb2, 0xb3, 0xd2,
+	0x25, 0x4c, 0xa1, 0xe7, 0xe1, 0xe5, 0x6e, 0xcf, 0xec, 0xd9, 0x32, 0x83, 0xb4, 0xcc, 0xe3, 0x99,
+	0x7d, 0x35, 0x35, 0xa3, 0x3b, 0xf0, 0xfc, 0xba, 0xc0, 0xbe, 0xfe, 0x02, 0x6c, 0x1f, 0x5a, 0xf8,
+	0xd7, 0xad, 0xc1, 0xcd, 0x42, 0x38, 0x1f, 0x03, 0x38, 0x79, 0x4a, 0x46, 0x12, 0x7d, 0x08, 0xa7,
+	0xfb, 0x29, 0x49, 0xed, 0xf0, 0x48, 0xea, 0xb1, 0xb5, 0x7a, 0xa9, 0xfe, 0x84, 0x29, 0x50, 0x3f,
+	0x25, 0xe4, 0xd2, 0xa8, 0xce, 0xd3, 0x14, 0x64, 0xb2, 0x7b, 0x0a, 0xa4, 0xb3, 0x03, 0xe0, 0xc8,
+	0x2d, 0x42, 0x56, 0x39, 0x0f, 0xd1, 0x47, 0x70, 0xac, 0xdf, 0xd7, 0x63, 0xce, 0xc3, 0x33, 0xbe,
+	0xa2, 0xfe, 0x14, 0xd1, 0xf0, 0xce, 0xe7, 0x43, 0xb0, 0xd4, 0x1c, 0xb4, 0xac, 0xc5, 0x84, 0x05,
+	0xa6, 0x89, 0xe2, 0x10, 0x4d, 0xc2, 0x61, 0x45, 0x55, 0x48, 0xcc, 0xb4, 0x71, 0xcd, 0x02, 0x55,
+	0xe1, 0x85, 0x80, 0x48, 0x5f, 0xd0, 0xb8, 0x7f, 0x3b, 0xee, 0xa0, 0x09, 0x5d, 0x83, 0xa3, 0x82,
+	0xf8, 0x34, 0xa6, 0x84, 0x29, 0xd3, 0xd8, 0xdd, 0xbe, 0x01, 0x6d, 0xc3, 0x02, 0x8e, 0xd2, 0x86,
+	0x90, 0x4f, 0xb9, 0xce, 0x9e, 0xca, 0x35, 0x25, 0x7a, 0xcb, 0x12, 0xad, 0xfd, 0x03, 0xa2, 0x29,
+	0xcb, 0x2f, 0x8f, 0x76, 0xe7, 0x2e, 0x86, 0xa9, 0x1c, 0x3c, 0xbf, 0x4f, 0xdb, 0x02, 0x2e, 0xd6,
+	0x76, 0xee, 0x57, 0x72, 0xbf, 0xdd, 0xaf, 0xe4, 0xbe, 0xdd, 0x9b, 0x2f, 0x59, 0xd4, 0x36, 0xef,
+	0x0e, 0x80, 0x32, 0xa5, 0x73, 0x06, 0xce, 0xf7, 0x00, 0x4e, 0x2d, 0x13, 0x1d, 0x49, 0xdf, 0x9e,
+	0xc2, 0x42, 0x51, 0xd6, 0x7e, 0x93, 0xad, 0xa7, 0x8d, 0x2d, 0x16, 0xa4, 0x4b, 0xb9, 0x1e, 0x62,
+	0x83, 0x1a, 0x1e, 0xeb, 0x99, 0xad, 0x84, 0x6f, 0xc3, 0x61, 0xa9, 0xf0, 0x06, 0x29, 0x0e, 0x3d,
+	0xd5, 0xac, 0x36, 0x41, 0xd0, 0x32, 0x2c, 0x74, 0x08, 0x6d, 0x77, 0x4c, 0x41, 0xf3, 0x4b, 0x2f,
+	0xfe, 0xbe, 0x5f, 0x19, 0xf7, 0x05, 0xd1, 0xcd, 0x96, 0x79, 0xc6, 0xf5, 0xd5, 0xd1, 0xee, 0xdc,
+	0x49, 0x9b, 0x2d, 0x80, 0x59, 0x38, 0xbf, 0x02, 0x38, 0x6b, 0x69, 0x51, 0xce, 0x32, 0x82, 0x76,
+	0x5c, 0xbe, 0x05, 0xaf, 0xf4, 0x3f, 0x06, 0x3d, 0x2f, 0x89, 0x94, 0xf6, 0xa5, 0xf1, 0xec, 0xe3,
+	0xbd, 0xf9, 0x67, 0x6c, 0x6a, 0xfd, 0x3e, 0x68, 0xb6, 0xac, 0x29, 0xa1, 0xdb, 0xcd, 0xe5, 0xee,
+	0x09, 0x3b, 0x62, 0xb0, 0x90, 0x3d, 0x25, 0xce, 0x52, 0xd5, 0x16, 0x65, 0x31, 0xaf, 0xaf, 0xd7,
+	0xf9, 0x0e, 0xc0, 0xeb, 0x7f, 0x2d, 0xea, 0x77, 0xa9, 0xea, 0x2c, 0x93, 0x98, 0x4b, 0xaa, 0xce,
+	0x48, 0xdf, 0xd3, 0x03, 0xfa, 0xd6, 0x2e, 0xbb, 0x42, 0x45, 0x38, 0x12, 0x18, 0xe0, 0xf4, 0x15,
+	0x31, 0xea, 0xf6, 0x96, 0x8b, 0xce, 0xce, 0xdf, 0x4a, 0x72, 0xe9, 0xee, 0x83, 0x83, 0x32, 0x78,
+	0x78, 0x50, 0x06, 0x8f, 0x0e, 0xca, 0xe0, 0x97, 0x83, 0x32, 0xf8, 0xec, 0xb0, 0x9c, 0x7b, 0x74,
+	0x58, 0xce, 0xfd, 0x78, 0x58, 0xce, 0xbd, 0xb7, 0xf0, 0xc4, 0x9a, 0x9d, 0x78, 0x51, 0xa4, 0x25,
+	0x6c, 0x15, 0xd2, 0x47, 0xe6, 0xcb, 0x7f, 0x0c, 0x00, 0xe9, 0x8a, 0x1a, 0x7d, 0x17, 0x0b, 0x00,
+	0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -741,6 +767,12 @@ func (this *Params) Equal(that interface{}) bool {
 	if this.WithdrawAddrEnabled != that1.WithdrawAddrEnabled {
 		return false
 	}
+	if this.AutoCompoundEpochBlocks != that1.AutoCompoundEpochBlocks {
+		return false
+	}
+	if this.AutoCompoundMaxGas != that1.AutoCompoundMaxGas {
+		return false
+	}
 	return true
 }
 func (this *ValidatorHistoricalRewards) Equal(that interface{}) bool {
@@ -1068,6 +1100,16 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.AutoCompoundMaxGas != 0 {
+		i = encodeVarintDistribution(dAtA, i, uint64(m.AutoCompoundMaxGas))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.AutoCompoundEpochBlocks != 0 {
+		i = encodeVarintDistribution(dAtA, i, uint64(m.AutoCompoundEpochBlocks))
+		i--
+		dAtA[i] = 0x28
+	}
 	if m.WithdrawAddrEnabled {
 		i--
 		if m.WithdrawAddrEnabled {
@@ -1610,6 +1652,12 @@ func (m *Params) Size() (n int) {
 	if m.WithdrawAddrEnabled {
 		n += 2
 	}
+	if m.AutoCompoundEpochBlocks != 0 {
+		n += 1 + sovDistribution(uint64(m.AutoCompoundEpochBlocks))
+	}
+	if m.AutoCompoundMaxGas != 0 {
+		n += 1 + sovDistribution(uint64(m.AutoCompoundMaxGas))
+	}
 	return n
 }
 
@@ -1972,6 +2020,44 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.WithdrawAddrEnabled = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoCompoundEpochBlocks", wireType)
+			}
+			m.AutoCompoundEpochBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AutoCompoundEpochBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoCompoundMaxGas", wireType)
+			}
+			m.AutoCompoundMaxGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AutoCompoundMaxGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDistribution(dAtA[iNdEx:])
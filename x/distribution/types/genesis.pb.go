@@ -348,6 +348,9 @@ type GenesisState struct {
 	DelegatorStartingInfos []DelegatorStartingInfoRecord `protobuf:"bytes,9,rep,name=delegator_starting_infos,json=delegatorStartingInfos,proto3" json:"delegator_starting_infos"`
 	// fee_pool defines the validator slash events at genesis.
 	ValidatorSlashEvents []ValidatorSlashEventRecord `protobuf:"bytes,10,rep,name=validator_slash_events,json=validatorSlashEvents,proto3" json:"validator_slash_events"`
+	// auto_compound_delegators defines the delegators who opted in to
+	// auto-compounding at genesis.
+	AutoCompoundDelegators []string `protobuf:"bytes,11,rep,name=auto_compound_delegators,json=autoCompoundDelegators,proto3" json:"auto_compound_delegators,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -399,66 +402,68 @@ func init() {
 }
 
 var fileDescriptor_76eed0f9489db580 = []byte{
-	// 938 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0xcf, 0x6f, 0x1b, 0x45,
-	0x14, 0xf6, 0x3a, 0x25, 0x4d, 0x26, 0x45, 0x34, 0xd3, 0x34, 0x6c, 0xd2, 0x76, 0x9d, 0x94, 0x1e,
-	0x0a, 0x28, 0x6b, 0x12, 0x10, 0x54, 0x45, 0x20, 0x35, 0x6e, 0xcb, 0x8f, 0x03, 0x8d, 0x6c, 0x09,
-	0x04, 0x42, 0xb2, 0xc6, 0xbb, 0xe3, 0xf5, 0x08, 0x7b, 0xc7, 0x9a, 0x19, 0xaf, 0x01, 0x89, 0x03,
-	0xa7, 0x22, 0xc4, 0x81, 0x13, 0xe2, 0x58, 0x71, 0xaa, 0x90, 0x90, 0x38, 0xf4, 0x8f, 0xe8, 0xb1,
-	0xaa, 0x38, 0x70, 0x02, 0xe4, 0x1c, 0x40, 0x1c, 0xf9, 0x0b, 0xd0, 0xce, 0xcc, 0xee, 0x8e, 0xb3,
-	0xdb, 0xad, 0x1b, 0xf0, 0xa5, 0x8d, 0x67, 0xde, 0x7b, 0xdf, 0xf7, 0xbd, 0xf7, 0xf6, 0xbd, 0x01,
-	0xcf, 0x7b, 0x94, 0x0f, 0x28, 0xaf, 0xfb, 0x84, 0x0b, 0x46, 0x3a, 0x23, 0x41, 0x68, 0x58, 0x8f,
-	0x76, 0x3b, 0x58, 0xa0, 0xdd, 0x7a, 0x80, 0x43, 0xcc, 0x09, 0x77, 0x87, 0x8c, 0x0a, 0x0a, 0xcf,
-	0x29, 0x53, 0xd7, 0x34, 0x75, 0xb5, 0xe9, 0xe6, 0x5a, 0x40, 0x03, 0x2a, 0xed, 0xea, 0xf1, 0x5f,
-	0xca, 0x65, 0xd3, 0xd1, 0xd1, 0x3b, 0x88, 0xe3, 0x34, 0xaa, 0x47, 0x49, 0xa8, 0xef, 0xdd, 0x32,
-	0xf4, 0x29, 0x1c, 0x65, 0xbf, 0xa1, 0xec, 0xdb, 0x0a, 0x48, 0xf3, 0x51, 0x57, 0xab, 0x68, 0x40,
-	0x42, 0x5a, 0x97, 0xff, 0xaa, 0xa3, 0x8b, 0x3f, 0x59, 0xe0, 0xec, 0x75, 0xdc, 0xc7, 0x01, 0x12,
-	0x94, 0x7d, 0x40, 0x44, 0xcf, 0x67, 0x68, 0xfc, 0x4e, 0xd8, 0xa5, 0xf0, 0x06, 0x58, 0xf5, 0x93,
-	0x8b, 0x36, 0xf2, 0x7d, 0x86, 0x39, 0xb7, 0xad, 0x2d, 0xeb, 0xf2, 0xf2, 0xbe, 0xfd, 0xf0, 0xde,
-	0xce, 0x9a, 0x8e, 0x7c, 0x4d, 0xdd, 0xb4, 0x04, 0x23, 0x61, 0xd0, 0x3c, 0x9d, 0xba, 0xe8, 0x73,
-	0xd8, 0x00, 0xa7, 0xc7, 0x3a, 0x6c, 0x1a, 0xa5, 0xfa, 0x98, 0x28, 0xcf, 0x24, 0x1e, 0xfa, 0xf8,
-	0xea, 0xd2, 0x57, 0x77, 0x6a, 0x95, 0xbf, 0xee, 0xd4, 0x2a, 0x17, 0x6f, 0x57, 0xc1, 0xf6, 0xfb,
-	0xa8, 0x4f, 0xfc, 0x18, 0xe3, 0xd6, 0x48, 0x70, 0x81, 0x42, 0x3f, 0xf6, 0xc1, 0x63, 0xc4, 0x7c,
-	0xde, 0xc4, 0x1e, 0x65, 0x3e, 0x7c, 0x0f, 0xac, 0x46, 0x89, 0xd1, 0x11, 0xee, 0xdb, 0x0f, 0xef,
-	0xed, 0x5c, 0xd0, 0xa8, 0x69, 0xa0, 0x23, 0x22, 0xa2, 0x23, 0xe7, 0xf0, 0xb6, 0x05, 0xce, 0xd0,
-	0x0c, 0xac, 0xcd, 0x14, 0x9a, 0x5d, 0xdd, 0x5a, 0xb8, 0xbc, 0xb2, 0x77, 0x5e, 0x97, 0xc8, 0x8d,
-	0x4b, 0x98, 0x54, 0xdb, 0xbd, 0x8e, 0xbd, 0x06, 0x25, 0xe1, 0xfe, 0x95, 0xfb, 0xbf, 0xd5, 0x2a,
-	0x3f, 0xfe, 0x5e, 0x7b, 0x31, 0x20, 0xa2, 0x37, 0xea, 0xb8, 0x1e, 0x1d, 0xe8, 0xaa, 0xe8, 0xff,
-	0x76, 0xb8, 0xff, 0x49, 0x5d, 0x7c, 0x36, 0xc4, 0x3c, 0xf1, 0xe1, 0x77, 0xff, 0xfc, 0xf9, 0x05,
-	0xab, 0x09, 0x69, 0x4e, 0x9f, 0x91, 0x89, 0xbf, 0x2d, 0x70, 0x29, 0x13, 0xe0, 0x79, 0xa3, 0xc1,
-	0xa8, 0x8f, 0x04, 0xf6, 0x1b, 0x74, 0x30, 0x20, 0x9c, 0x13, 0x1a, 0xce, 0x29, 0x19, 0x3d, 0xb0,
-	0x82, 0x32, 0x38, 0x59, 0xcc, 0x95, 0xbd, 0xd7, 0xdd, 0x92, 0xce, 0x77, 0xcb, 0x79, 0xee, 0x2f,
-	0xc7, 0x29, 0x52, 0x9a, 0xcd, 0xd0, 0x86, 0xd8, 0x7f, 0x2c, 0xb0, 0x95, 0x06, 0x79, 0x9b, 0x70,
-	0x41, 0x19, 0xf1, 0x50, 0x7f, 0xbe, 0x55, 0x5f, 0x07, 0x8b, 0x43, 0xcc, 0x08, 0x55, 0x1a, 0x4f,
-	0x34, 0xf5, 0x2f, 0xf8, 0x31, 0x38, 0x99, 0x34, 0xc0, 0x82, 0x14, 0xff, 0xda, 0x6c, 0xe2, 0x73,
-	0xbc, 0x4d, 0xe1, 0x49, 0x48, 0x43, 0xf4, 0x2f, 0x16, 0xb8, 0x90, 0x3a, 0x37, 0x46, 0x8c, 0xe1,
-	0x50, 0xcc, 0x57, 0xf1, 0x87, 0x99, 0x32, 0x55, 0xd6, 0x57, 0x66, 0x53, 0x36, 0x4d, 0xee, 0x31,
-	0xb2, 0x7e, 0xa8, 0x82, 0x73, 0xe9, 0xc8, 0x69, 0x09, 0xc4, 0x04, 0x09, 0x83, 0x78, 0xe4, 0x68,
-	0x51, 0xff, 0xd3, 0xe0, 0x29, 0xcc, 0x4d, 0xf5, 0xf8, 0xb9, 0xe9, 0x80, 0xa7, 0xb9, 0x26, 0xdb,
-	0x26, 0x61, 0x97, 0xea, 0xda, 0xef, 0x95, 0x66, 0xa8, 0x50, 0xa7, 0x99, 0x9f, 0x53, 0xdc, 0xb8,
-	0x30, 0x92, 0xf4, 0x5d, 0x15, 0x6c, 0xa4, 0xd4, 0x5a, 0x7d, 0xc4, 0x7b, 0x37, 0x22, 0x99, 0xe1,
-	0x79, 0x75, 0x7a, 0x0f, 0x93, 0xa0, 0x27, 0x92, 0x4e, 0x57, 0xbf, 0x8c, 0x2f, 0x60, 0x61, 0xea,
-	0x0b, 0xa0, 0xe0, 0x6c, 0x86, 0xcf, 0x63, 0x76, 0x6d, 0x1c, 0xd3, 0xb3, 0x4f, 0xc8, 0x9c, 0xbc,
-	0x34, 0x5b, 0xd7, 0x64, 0xb2, 0xcc, 0x8c, 0x9c, 0x89, 0xf2, 0xf7, 0x46, 0x62, 0xbe, 0x59, 0x06,
-	0xa7, 0xde, 0x52, 0x3b, 0xb7, 0x25, 0x90, 0xc0, 0xf0, 0x26, 0x58, 0x1c, 0x22, 0x86, 0x06, 0x2a,
-	0x01, 0x2b, 0x7b, 0xcf, 0x95, 0x82, 0x1f, 0x48, 0x53, 0x13, 0x4f, 0x7b, 0xc3, 0x77, 0xc1, 0x52,
-	0x17, 0xe3, 0xf6, 0x90, 0xd2, 0xbe, 0x6e, 0xfe, 0x4b, 0xa5, 0x91, 0x6e, 0x62, 0x7c, 0x40, 0x69,
-	0x7f, 0xaa, 0xd9, 0xbb, 0xea, 0x0c, 0x8e, 0x81, 0x9d, 0xb5, 0x70, 0xba, 0xfe, 0xe2, 0xae, 0x89,
-	0x47, 0xc6, 0xc2, 0xec, 0x6d, 0x63, 0x6e, 0x64, 0x13, 0x69, 0xdd, 0x2f, 0xb2, 0xe0, 0xf1, 0xb7,
-	0x33, 0x64, 0x38, 0x22, 0x74, 0x24, 0x1f, 0x00, 0x43, 0xca, 0x31, 0x93, 0x45, 0x29, 0xfd, 0x76,
-	0x12, 0x97, 0x03, 0xed, 0x01, 0x3f, 0x2f, 0x5e, 0x77, 0x4f, 0x49, 0xea, 0x6f, 0xce, 0x56, 0xdd,
-	0x47, 0x2d, 0x67, 0x53, 0x46, 0xc1, 0x86, 0x83, 0xdf, 0x5b, 0x60, 0xdb, 0x68, 0xee, 0x6c, 0x1d,
-	0xb4, 0xbd, 0x74, 0x63, 0x70, 0x7b, 0x51, 0x52, 0xb9, 0xf6, 0x1f, 0xb6, 0x4e, 0x9e, 0x4d, 0x2d,
-	0x2a, 0x75, 0xe0, 0xf0, 0x6b, 0x0b, 0x9c, 0xcf, 0xa8, 0xf5, 0xd2, 0x71, 0x9e, 0x26, 0xe8, 0xa4,
-	0x64, 0xf5, 0xc6, 0x31, 0xd7, 0x41, 0x9e, 0xd1, 0x66, 0xf4, 0x48, 0x63, 0xf8, 0xa5, 0x05, 0x36,
-	0x32, 0x32, 0x9e, 0x9a, 0xc0, 0x29, 0x93, 0x25, 0xc9, 0xe4, 0xea, 0x71, 0xc6, 0x77, 0x9e, 0xc6,
-	0xb3, 0x51, 0xb1, 0x25, 0xfc, 0xc2, 0xec, 0xf3, 0xa9, 0xe9, 0xc8, 0xed, 0x65, 0xc9, 0xe0, 0xca,
-	0x93, 0x8f, 0xc7, 0x3c, 0x7e, 0xd6, 0xed, 0xa6, 0x1d, 0x87, 0x63, 0xb0, 0x5e, 0x38, 0x86, 0xb8,
-	0x0d, 0x24, 0xf8, 0xab, 0x4f, 0x3a, 0x87, 0xf2, 0xd0, 0x6b, 0x05, 0xd3, 0xc8, 0x58, 0x66, 0xfb,
-	0xb7, 0xee, 0x4e, 0x1c, 0xeb, 0xfe, 0xc4, 0xb1, 0x1e, 0x4c, 0x1c, 0xeb, 0x8f, 0x89, 0x63, 0x7d,
-	0x7b, 0xe8, 0x54, 0x1e, 0x1c, 0x3a, 0x95, 0x5f, 0x0f, 0x9d, 0xca, 0x47, 0xbb, 0xa5, 0x6f, 0xbe,
-	0x4f, 0xa7, 0xdf, 0xf4, 0xf2, 0x09, 0xd8, 0x59, 0x94, 0xef, 0xf2, 0x97, 0xff, 0x0d, 0x00, 0x00,
-	0xff, 0xff, 0xbe, 0x75, 0xf7, 0x68, 0x75, 0x0c, 0x00, 0x00,
+	// 963 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0xcf, 0x6f, 0x1b, 0x45,
+	0x14, 0xf6, 0xda, 0x21, 0x4d, 0xc6, 0x45, 0x34, 0xd3, 0xd4, 0x6c, 0xd2, 0xd6, 0x4e, 0x4a, 0x0f,
+	0x05, 0x94, 0x35, 0x09, 0x08, 0xaa, 0x22, 0x90, 0x1a, 0xb7, 0xe5, 0xc7, 0x81, 0x46, 0x8e, 0x04,
+	0x02, 0x21, 0x59, 0xe3, 0xdd, 0x89, 0x3d, 0xc2, 0xde, 0x59, 0xcd, 0xcc, 0xae, 0x01, 0x89, 0x03,
+	0xa7, 0x22, 0x4e, 0x9c, 0x10, 0xc7, 0x8a, 0x53, 0x85, 0x84, 0xc4, 0xa1, 0x7f, 0x44, 0x8f, 0x55,
+	0xc5, 0x81, 0x13, 0x54, 0xc9, 0x01, 0xc4, 0x91, 0xbf, 0x00, 0xed, 0xcc, 0xec, 0xee, 0x38, 0xbb,
+	0xdd, 0xb8, 0x81, 0x5c, 0x92, 0xec, 0xce, 0xfb, 0xf1, 0x7d, 0xdf, 0x7b, 0xf3, 0xde, 0x06, 0xbc,
+	0xe8, 0x52, 0x3e, 0xa6, 0xbc, 0xed, 0x11, 0x2e, 0x18, 0xe9, 0x87, 0x82, 0x50, 0xbf, 0x1d, 0x6d,
+	0xf6, 0xb1, 0x40, 0x9b, 0xed, 0x01, 0xf6, 0x31, 0x27, 0xdc, 0x09, 0x18, 0x15, 0x14, 0x9e, 0x57,
+	0xa6, 0x8e, 0x69, 0xea, 0x68, 0xd3, 0xd5, 0xe5, 0x01, 0x1d, 0x50, 0x69, 0xd7, 0x8e, 0xff, 0x52,
+	0x2e, 0xab, 0x4d, 0x1d, 0xbd, 0x8f, 0x38, 0x4e, 0xa3, 0xba, 0x94, 0xf8, 0xfa, 0xdc, 0x29, 0xcb,
+	0x3e, 0x95, 0x47, 0xd9, 0xaf, 0x28, 0xfb, 0x9e, 0x4a, 0xa4, 0xf1, 0xa8, 0xa3, 0x25, 0x34, 0x26,
+	0x3e, 0x6d, 0xcb, 0x9f, 0xea, 0xd5, 0xa5, 0x9f, 0x2d, 0x70, 0xee, 0x06, 0x1e, 0xe1, 0x01, 0x12,
+	0x94, 0x7d, 0x44, 0xc4, 0xd0, 0x63, 0x68, 0xf2, 0x9e, 0xbf, 0x47, 0xe1, 0x4d, 0xb0, 0xe4, 0x25,
+	0x07, 0x3d, 0xe4, 0x79, 0x0c, 0x73, 0x6e, 0x5b, 0x6b, 0xd6, 0x95, 0xc5, 0x6d, 0xfb, 0xd1, 0xfd,
+	0x8d, 0x65, 0x1d, 0xf9, 0xba, 0x3a, 0xd9, 0x15, 0x8c, 0xf8, 0x83, 0xee, 0x99, 0xd4, 0x45, 0xbf,
+	0x87, 0x1d, 0x70, 0x66, 0xa2, 0xc3, 0xa6, 0x51, 0xaa, 0x47, 0x44, 0x79, 0x2e, 0xf1, 0xd0, 0xaf,
+	0xaf, 0x2d, 0x7c, 0x73, 0xb7, 0x55, 0xf9, 0xeb, 0x6e, 0xab, 0x72, 0xe9, 0x4e, 0x15, 0xac, 0x7f,
+	0x88, 0x46, 0xc4, 0x8b, 0x73, 0xdc, 0x0e, 0x05, 0x17, 0xc8, 0xf7, 0x62, 0x1f, 0x3c, 0x41, 0xcc,
+	0xe3, 0x5d, 0xec, 0x52, 0xe6, 0xc1, 0x0f, 0xc0, 0x52, 0x94, 0x18, 0x1d, 0xc2, 0xbe, 0xfe, 0xe8,
+	0xfe, 0xc6, 0x45, 0x9d, 0x35, 0x0d, 0x74, 0x88, 0x44, 0x74, 0xe8, 0x3d, 0xbc, 0x63, 0x81, 0xb3,
+	0x34, 0x4b, 0xd6, 0x63, 0x2a, 0x9b, 0x5d, 0x5d, 0xab, 0x5d, 0xa9, 0x6f, 0x5d, 0xd0, 0x25, 0x72,
+	0xe2, 0x12, 0x26, 0xd5, 0x76, 0x6e, 0x60, 0xb7, 0x43, 0x89, 0xbf, 0x7d, 0xf5, 0xc1, 0xef, 0xad,
+	0xca, 0x4f, 0x7f, 0xb4, 0x5e, 0x1e, 0x10, 0x31, 0x0c, 0xfb, 0x8e, 0x4b, 0xc7, 0xba, 0x2a, 0xfa,
+	0xd7, 0x06, 0xf7, 0x3e, 0x6b, 0x8b, 0x2f, 0x02, 0xcc, 0x13, 0x1f, 0x7e, 0xef, 0xcf, 0x5f, 0x5e,
+	0xb2, 0xba, 0x90, 0xe6, 0xf8, 0x19, 0x4a, 0xfc, 0x6d, 0x81, 0xcb, 0x19, 0x01, 0xd7, 0x0d, 0xc7,
+	0xe1, 0x08, 0x09, 0xec, 0x75, 0xe8, 0x78, 0x4c, 0x38, 0x27, 0xd4, 0x3f, 0x21, 0x31, 0x86, 0xa0,
+	0x8e, 0xb2, 0x74, 0xb2, 0x98, 0xf5, 0xad, 0x37, 0x9d, 0x92, 0xce, 0x77, 0xca, 0x71, 0x6e, 0x2f,
+	0xc6, 0x12, 0x29, 0xce, 0x66, 0x68, 0x83, 0xec, 0x3f, 0x16, 0x58, 0x4b, 0x83, 0xbc, 0x4b, 0xb8,
+	0xa0, 0x8c, 0xb8, 0x68, 0x74, 0xb2, 0x55, 0x6f, 0x80, 0xf9, 0x00, 0x33, 0x42, 0x15, 0xc7, 0xb9,
+	0xae, 0x7e, 0x82, 0x9f, 0x82, 0x53, 0x49, 0x03, 0xd4, 0x24, 0xf9, 0x37, 0x66, 0x23, 0x9f, 0xc3,
+	0x6d, 0x12, 0x4f, 0x42, 0x1a, 0xa4, 0x7f, 0xb5, 0xc0, 0xc5, 0xd4, 0xb9, 0x13, 0x32, 0x86, 0x7d,
+	0x71, 0xb2, 0x8c, 0x3f, 0xce, 0x98, 0xa9, 0xb2, 0xbe, 0x36, 0x1b, 0xb3, 0x69, 0x70, 0x47, 0xd0,
+	0xfa, 0xb1, 0x0a, 0xce, 0xa7, 0x23, 0x67, 0x57, 0x20, 0x26, 0x88, 0x3f, 0x88, 0x47, 0x8e, 0x26,
+	0xf5, 0x3f, 0x0d, 0x9e, 0x42, 0x6d, 0xaa, 0xc7, 0xd7, 0xa6, 0x0f, 0x9e, 0xe5, 0x1a, 0x6c, 0x8f,
+	0xf8, 0x7b, 0x54, 0xd7, 0x7e, 0xab, 0x54, 0xa1, 0x42, 0x9e, 0xa6, 0x3e, 0xa7, 0xb9, 0x71, 0x60,
+	0x88, 0xf4, 0x7d, 0x15, 0xac, 0xa4, 0xd0, 0x76, 0x47, 0x88, 0x0f, 0x6f, 0x46, 0x52, 0xe1, 0x93,
+	0xea, 0xf4, 0x21, 0x26, 0x83, 0xa1, 0x48, 0x3a, 0x5d, 0x3d, 0x19, 0x37, 0xa0, 0x36, 0x75, 0x03,
+	0x28, 0x38, 0x97, 0xe5, 0xe7, 0x31, 0xba, 0x1e, 0x8e, 0xe1, 0xd9, 0x73, 0x52, 0x93, 0x57, 0x66,
+	0xeb, 0x9a, 0x8c, 0x96, 0xa9, 0xc8, 0xd9, 0x28, 0x7f, 0x6e, 0x08, 0xf3, 0x78, 0x11, 0x9c, 0x7e,
+	0x47, 0xed, 0xdc, 0x5d, 0x81, 0x04, 0x86, 0xb7, 0xc0, 0x7c, 0x80, 0x18, 0x1a, 0x2b, 0x01, 0xea,
+	0x5b, 0x2f, 0x94, 0x26, 0xdf, 0x91, 0xa6, 0x66, 0x3e, 0xed, 0x0d, 0xdf, 0x07, 0x0b, 0x7b, 0x18,
+	0xf7, 0x02, 0x4a, 0x47, 0xba, 0xf9, 0x2f, 0x97, 0x46, 0xba, 0x85, 0xf1, 0x0e, 0xa5, 0xa3, 0xa9,
+	0x66, 0xdf, 0x53, 0xef, 0xe0, 0x04, 0xd8, 0x59, 0x0b, 0xa7, 0xeb, 0x2f, 0xee, 0x9a, 0x78, 0x64,
+	0xd4, 0x66, 0x6f, 0x1b, 0x73, 0x23, 0x9b, 0x99, 0x1a, 0x5e, 0x91, 0x05, 0x8f, 0xef, 0x4e, 0xc0,
+	0x70, 0x44, 0x68, 0x28, 0x3f, 0x00, 0x02, 0xca, 0x31, 0xb3, 0xe7, 0x8e, 0xba, 0x3b, 0x89, 0xcb,
+	0x8e, 0xf6, 0x80, 0x5f, 0x16, 0xaf, 0xbb, 0x67, 0x24, 0xf4, 0xb7, 0x67, 0xab, 0xee, 0x93, 0x96,
+	0xb3, 0x49, 0xa3, 0x60, 0xc3, 0xc1, 0x1f, 0x2c, 0xb0, 0x6e, 0x34, 0x77, 0xb6, 0x0e, 0x7a, 0x6e,
+	0xba, 0x31, 0xb8, 0x3d, 0x2f, 0xa1, 0x5c, 0xff, 0x0f, 0x5b, 0x27, 0x8f, 0xa6, 0x15, 0x95, 0x3a,
+	0x70, 0xf8, 0xad, 0x05, 0x2e, 0x64, 0xd0, 0x86, 0xe9, 0x38, 0x4f, 0x05, 0x3a, 0x25, 0x51, 0xbd,
+	0x75, 0xcc, 0x75, 0x90, 0x47, 0xb4, 0x1a, 0x3d, 0xd1, 0x18, 0x7e, 0x6d, 0x81, 0x95, 0x0c, 0x8c,
+	0xab, 0x26, 0x70, 0x8a, 0x64, 0x41, 0x22, 0xb9, 0x76, 0x9c, 0xf1, 0x9d, 0x87, 0xf1, 0x7c, 0x54,
+	0x6c, 0x09, 0xbf, 0x32, 0xfb, 0x7c, 0x6a, 0x3a, 0x72, 0x7b, 0x51, 0x22, 0xb8, 0xfa, 0xf4, 0xe3,
+	0x31, 0x9f, 0xbf, 0xe1, 0x15, 0xd9, 0x71, 0x38, 0x01, 0x8d, 0xc2, 0x31, 0xc4, 0x6d, 0x20, 0x93,
+	0xbf, 0xfe, 0xb4, 0x73, 0x28, 0x9f, 0x7a, 0xb9, 0x60, 0x1a, 0x71, 0xd8, 0x05, 0x36, 0x0a, 0x05,
+	0x8d, 0x3b, 0x32, 0xa0, 0xa1, 0xef, 0xf5, 0x52, 0x80, 0xdc, 0xae, 0xaf, 0xd5, 0x4a, 0x6f, 0x5b,
+	0x23, 0xf6, 0xec, 0x68, 0xc7, 0x54, 0x00, 0x63, 0x41, 0x6e, 0xdf, 0xbe, 0xb7, 0xdf, 0xb4, 0x1e,
+	0xec, 0x37, 0xad, 0x87, 0xfb, 0x4d, 0xeb, 0xf1, 0x7e, 0xd3, 0xfa, 0xee, 0xa0, 0x59, 0x79, 0x78,
+	0xd0, 0xac, 0xfc, 0x76, 0xd0, 0xac, 0x7c, 0xb2, 0x59, 0xfa, 0x1d, 0xf9, 0xf9, 0xf4, 0xff, 0x09,
+	0xf2, 0xb3, 0xb2, 0x3f, 0x2f, 0xbf, 0xf5, 0x5f, 0xfd, 0x77, 0x00, 0x67, 0xce, 0x57, 0x8e, 0xc9,
+	0x0c, 0x00, 0x00,
 }
 
 func (m *DelegatorWithdrawInfo) Marshal() (dAtA []byte, err error) {
@@ -784,6 +789,15 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.AutoCompoundDelegators) > 0 {
+		for iNdEx := len(m.AutoCompoundDelegators) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AutoCompoundDelegators[iNdEx])
+			copy(dAtA[i:], m.AutoCompoundDelegators[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.AutoCompoundDelegators[iNdEx])))
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
 	if len(m.ValidatorSlashEvents) > 0 {
 		for iNdEx := len(m.ValidatorSlashEvents) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -1103,6 +1117,12 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.AutoCompoundDelegators) > 0 {
+		for _, s := range m.AutoCompoundDelegators {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -2371,6 +2391,38 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoCompoundDelegators", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AutoCompoundDelegators = append(m.AutoCompoundDelegators, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
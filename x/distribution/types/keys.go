@@ -43,6 +43,10 @@ const (
 // - 0x08<valAddrLen (1 Byte)><valAddr_Bytes><height>: ValidatorSlashEvent
 //
 // - 0x09: Params
+//
+// - 0x0a<accAddrLen (1 Byte)><accAddr_Bytes>: []byte{} (auto-compounding opt-in)
+//
+// - 0x0b: sdk.AccAddress (next auto-compounding delegator)
 var (
 	FeePoolKey                        = collections.NewPrefix(0) // key for global distribution state
 	ProposerKey                       = []byte{0x01}             // key for the proposer operator address
@@ -56,6 +60,9 @@ var (
 	ValidatorSlashEventPrefix            = []byte{0x08} // key for validator slash fraction
 
 	ParamsKey = collections.NewPrefix(9) // key for distribution module params
+
+	AutoCompoundDelegatorPrefix = []byte{0x0a} // key for the delegators who opted in to auto-compounding
+	AutoCompoundCursorKey       = []byte{0x0b} // key for the next delegator to auto-compound in the current epoch
 )
 
 // GetValidatorOutstandingRewardsAddress creates an address from a validator's outstanding rewards key.
@@ -84,6 +91,19 @@ func GetDelegatorWithdrawInfoAddress(key []byte) (delAddr sdk.AccAddress) {
 	return sdk.AccAddress(addr)
 }
 
+// GetAutoCompoundDelegatorAddress creates an address from an auto-compounding opt-in key.
+func GetAutoCompoundDelegatorAddress(key []byte) (delAddr sdk.AccAddress) {
+	// key is in the format:
+	// 0x0a<accAddrLen (1 Byte)><accAddr_Bytes>
+
+	// Remove prefix and address length.
+	kv.AssertKeyAtLeastLength(key, 3)
+	addr := key[2:]
+	kv.AssertKeyLength(addr, int(key[1]))
+
+	return sdk.AccAddress(addr)
+}
+
 // GetDelegatorStartingInfoAddresses creates the addresses from a delegator starting info key.
 func GetDelegatorStartingInfoAddresses(key []byte) (valAddr sdk.ValAddress, delAddr sdk.AccAddress) {
 	// key is in the format:
@@ -165,6 +185,11 @@ func GetDelegatorWithdrawAddrKey(delAddr sdk.AccAddress) []byte {
 	return append(DelegatorWithdrawAddrPrefix, address.MustLengthPrefix(delAddr.Bytes())...)
 }
 
+// GetAutoCompoundDelegatorKey creates the key for a delegator's auto-compounding opt-in.
+func GetAutoCompoundDelegatorKey(delAddr sdk.AccAddress) []byte {
+	return append(AutoCompoundDelegatorPrefix, address.MustLengthPrefix(delAddr.Bytes())...)
+}
+
 // GetDelegatorStartingInfoKey creates the key for a delegator's starting info.
 func GetDelegatorStartingInfoKey(v sdk.ValAddress, d sdk.AccAddress) []byte {
 	return append(append(DelegatorStartingInfoPrefix, address.MustLengthPrefix(v.Bytes())...), address.MustLengthPrefix(d.Bytes())...)
@@ -6,19 +6,31 @@ import (
 	"cosmossdk.io/math"
 )
 
+// Default parameter values
+const (
+	DefaultAutoCompoundEpochBlocks uint64 = 1000
+	DefaultAutoCompoundMaxGas      uint64 = 10_000_000
+)
+
 // DefaultParams returns default distribution parameters
 func DefaultParams() Params {
 	return Params{
-		CommunityTax:        math.LegacyNewDecWithPrec(2, 2), // 2%
-		BaseProposerReward:  math.LegacyZeroDec(),            // deprecated
-		BonusProposerReward: math.LegacyZeroDec(),            // deprecated
-		WithdrawAddrEnabled: true,
+		CommunityTax:            math.LegacyNewDecWithPrec(2, 2), // 2%
+		BaseProposerReward:      math.LegacyZeroDec(),            // deprecated
+		BonusProposerReward:     math.LegacyZeroDec(),            // deprecated
+		WithdrawAddrEnabled:     true,
+		AutoCompoundEpochBlocks: DefaultAutoCompoundEpochBlocks,
+		AutoCompoundMaxGas:      DefaultAutoCompoundMaxGas,
 	}
 }
 
 // ValidateBasic performs basic validation on distribution parameters.
 func (p Params) ValidateBasic() error {
-	return validateCommunityTax(p.CommunityTax)
+	if err := validateCommunityTax(p.CommunityTax); err != nil {
+		return err
+	}
+
+	return validateAutoCompoundMaxGas(p.AutoCompoundEpochBlocks, p.AutoCompoundMaxGas)
 }
 
 func validateCommunityTax(i interface{}) error {
@@ -48,3 +60,11 @@ func validateWithdrawAddrEnabled(i interface{}) error {
 
 	return nil
 }
+
+func validateAutoCompoundMaxGas(epochBlocks, maxGas uint64) error {
+	if epochBlocks > 0 && maxGas == 0 {
+		return fmt.Errorf("auto-compound max gas must be positive when auto-compounding is enabled")
+	}
+
+	return nil
+}
@@ -867,6 +867,93 @@ func (m *QueryDelegatorWithdrawAddressResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryDelegatorWithdrawAddressResponse proto.InternalMessageInfo
 
+// QueryDelegatorAutoCompoundRequest is the request type for the
+// Query/DelegatorAutoCompound RPC method.
+type QueryDelegatorAutoCompoundRequest struct {
+	// delegator_address defines the delegator address to query for.
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+}
+
+func (m *QueryDelegatorAutoCompoundRequest) Reset()         { *m = QueryDelegatorAutoCompoundRequest{} }
+func (m *QueryDelegatorAutoCompoundRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDelegatorAutoCompoundRequest) ProtoMessage()    {}
+func (*QueryDelegatorAutoCompoundRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5efd02cbc06efdc9, []int{18}
+}
+func (m *QueryDelegatorAutoCompoundRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDelegatorAutoCompoundRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDelegatorAutoCompoundRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDelegatorAutoCompoundRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDelegatorAutoCompoundRequest.Merge(m, src)
+}
+func (m *QueryDelegatorAutoCompoundRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDelegatorAutoCompoundRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDelegatorAutoCompoundRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDelegatorAutoCompoundRequest proto.InternalMessageInfo
+
+// QueryDelegatorAutoCompoundResponse is the response type for the
+// Query/DelegatorAutoCompound RPC method.
+type QueryDelegatorAutoCompoundResponse struct {
+	// enabled defines whether the delegator opted in to auto-compounding.
+	Enabled bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+}
+
+func (m *QueryDelegatorAutoCompoundResponse) Reset()         { *m = QueryDelegatorAutoCompoundResponse{} }
+func (m *QueryDelegatorAutoCompoundResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDelegatorAutoCompoundResponse) ProtoMessage()    {}
+func (*QueryDelegatorAutoCompoundResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5efd02cbc06efdc9, []int{19}
+}
+func (m *QueryDelegatorAutoCompoundResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDelegatorAutoCompoundResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDelegatorAutoCompoundResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDelegatorAutoCompoundResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDelegatorAutoCompoundResponse.Merge(m, src)
+}
+func (m *QueryDelegatorAutoCompoundResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDelegatorAutoCompoundResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDelegatorAutoCompoundResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDelegatorAutoCompoundResponse proto.InternalMessageInfo
+
+func (m *QueryDelegatorAutoCompoundResponse) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return false
+}
+
 // QueryCommunityPoolRequest is the request type for the Query/CommunityPool RPC
 // method.
 type QueryCommunityPoolRequest struct {
@@ -876,7 +963,7 @@ func (m *QueryCommunityPoolRequest) Reset()         { *m = QueryCommunityPoolReq
 func (m *QueryCommunityPoolRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryCommunityPoolRequest) ProtoMessage()    {}
 func (*QueryCommunityPoolRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5efd02cbc06efdc9, []int{18}
+	return fileDescriptor_5efd02cbc06efdc9, []int{20}
 }
 func (m *QueryCommunityPoolRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -916,7 +1003,7 @@ func (m *QueryCommunityPoolResponse) Reset()         { *m = QueryCommunityPoolRe
 func (m *QueryCommunityPoolResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryCommunityPoolResponse) ProtoMessage()    {}
 func (*QueryCommunityPoolResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5efd02cbc06efdc9, []int{19}
+	return fileDescriptor_5efd02cbc06efdc9, []int{21}
 }
 func (m *QueryCommunityPoolResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -971,6 +1058,8 @@ func init() {
 	proto.RegisterType((*QueryDelegatorValidatorsResponse)(nil), "cosmos.distribution.v1beta1.QueryDelegatorValidatorsResponse")
 	proto.RegisterType((*QueryDelegatorWithdrawAddressRequest)(nil), "cosmos.distribution.v1beta1.QueryDelegatorWithdrawAddressRequest")
 	proto.RegisterType((*QueryDelegatorWithdrawAddressResponse)(nil), "cosmos.distribution.v1beta1.QueryDelegatorWithdrawAddressResponse")
+	proto.RegisterType((*QueryDelegatorAutoCompoundRequest)(nil), "cosmos.distribution.v1beta1.QueryDelegatorAutoCompoundRequest")
+	proto.RegisterType((*QueryDelegatorAutoCompoundResponse)(nil), "cosmos.distribution.v1beta1.QueryDelegatorAutoCompoundResponse")
 	proto.RegisterType((*QueryCommunityPoolRequest)(nil), "cosmos.distribution.v1beta1.QueryCommunityPoolRequest")
 	proto.RegisterType((*QueryCommunityPoolResponse)(nil), "cosmos.distribution.v1beta1.QueryCommunityPoolResponse")
 }
@@ -980,87 +1069,91 @@ func init() {
 }
 
 var fileDescriptor_5efd02cbc06efdc9 = []byte{
-	// 1269 bytes of a gzipped FileDescriptorProto
+	// 1335 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0x4f, 0x6c, 0x1b, 0xc5,
-	0x17, 0xce, 0xb8, 0x69, 0xfa, 0xcb, 0xeb, 0xaf, 0x24, 0x9e, 0x46, 0xc8, 0xd9, 0xa4, 0x4e, 0x70,
-	0x68, 0x13, 0x35, 0x8a, 0xb7, 0x49, 0xa4, 0x52, 0x12, 0x2a, 0x88, 0x9d, 0x84, 0xa2, 0x46, 0x69,
-	0xeb, 0x16, 0x22, 0x40, 0x95, 0xb5, 0xf6, 0x6e, 0x36, 0x0b, 0xf6, 0x8e, 0xb3, 0xb3, 0x8e, 0x89,
-	0xaa, 0x5e, 0xca, 0xa5, 0xf4, 0x84, 0xe0, 0xc2, 0x91, 0x23, 0xe2, 0xc4, 0xa1, 0x9c, 0xe1, 0xc0,
-	0xa1, 0xc7, 0xaa, 0x48, 0x88, 0x13, 0xa0, 0x04, 0x89, 0x72, 0x00, 0x71, 0xe3, 0x8a, 0x76, 0x66,
-	0xd6, 0xde, 0xb5, 0xd7, 0xeb, 0x7f, 0xf2, 0x25, 0xb1, 0x66, 0xe7, 0x7d, 0xdf, 0xfb, 0xde, 0x7b,
-	0x33, 0xfb, 0xd9, 0x30, 0x9b, 0x27, 0xb4, 0x48, 0xa8, 0xac, 0x1a, 0xd4, 0xb6, 0x8c, 0x5c, 0xd9,
-	0x36, 0x88, 0x29, 0x1f, 0x2c, 0xe6, 0x34, 0x5b, 0x59, 0x94, 0xf7, 0xcb, 0x9a, 0x75, 0x98, 0x2c,
-	0x59, 0xc4, 0x26, 0x78, 0x82, 0x6f, 0x4c, 0x7a, 0x37, 0x26, 0xc5, 0x46, 0xe9, 0xa2, 0x40, 0xc9,
-	0x29, 0x54, 0xe3, 0x51, 0x55, 0x8c, 0x92, 0xa2, 0x1b, 0xa6, 0xc2, 0x76, 0x33, 0x20, 0x69, 0x4c,
-	0x27, 0x3a, 0x61, 0x1f, 0x65, 0xe7, 0x93, 0x58, 0x9d, 0xd4, 0x09, 0xd1, 0x0b, 0x9a, 0xac, 0x94,
-	0x0c, 0x59, 0x31, 0x4d, 0x62, 0xb3, 0x10, 0x2a, 0x9e, 0xc6, 0xbd, 0xf8, 0x2e, 0x72, 0x9e, 0x18,
-	0x2e, 0x66, 0x32, 0x4c, 0x85, 0x2f, 0x63, 0xbe, 0x7f, 0x9c, 0xef, 0xcf, 0xf2, 0x34, 0x84, 0x32,
-	0xfe, 0x28, 0xaa, 0x14, 0x0d, 0x93, 0xc8, 0xec, 0x2f, 0x5f, 0x4a, 0x8c, 0x01, 0xbe, 0xe5, 0x68,
-	0xba, 0xa9, 0x58, 0x4a, 0x91, 0x66, 0xb4, 0xfd, 0xb2, 0x46, 0xed, 0xc4, 0x5d, 0x38, 0xeb, 0x5b,
-	0xa5, 0x25, 0x62, 0x52, 0x0d, 0x6f, 0xc2, 0x50, 0x89, 0xad, 0xc4, 0xd0, 0x34, 0x9a, 0x3b, 0xbd,
-	0x34, 0x93, 0x0c, 0x29, 0x5c, 0x92, 0x07, 0xa7, 0x86, 0x9f, 0xfc, 0x32, 0x35, 0xf0, 0xd5, 0x1f,
-	0xdf, 0x5c, 0x44, 0x19, 0x11, 0x9d, 0xa8, 0xc0, 0x79, 0x06, 0xff, 0x8e, 0x52, 0x30, 0x54, 0xc5,
-	0x26, 0xd6, 0xba, 0x27, 0xfe, 0x2d, 0x73, 0x97, 0x88, 0x3c, 0xf0, 0x36, 0x44, 0x0f, 0xdc, 0x3d,
-	0x59, 0x45, 0x55, 0x2d, 0x8d, 0x72, 0xee, 0xe1, 0xd4, 0x4b, 0xcf, 0x1e, 0x2f, 0x9c, 0x13, 0xf4,
-	0x55, 0x9c, 0x35, 0xbe, 0xe5, 0xb6, 0x6d, 0x19, 0xa6, 0x9e, 0x19, 0x3d, 0xa8, 0x5b, 0x4f, 0xfc,
-	0x1d, 0x81, 0x0b, 0xad, 0x98, 0x85, 0xd6, 0x2d, 0x18, 0x25, 0x25, 0xcd, 0xea, 0x8e, 0x79, 0xc4,
-	0x0d, 0x15, 0xcb, 0xf8, 0x01, 0x82, 0x28, 0xd5, 0x0a, 0xbb, 0xd9, 0x1c, 0x31, 0xd5, 0xac, 0xa5,
-	0x55, 0x14, 0x4b, 0xa5, 0xb1, 0xc8, 0xf4, 0x89, 0xb9, 0xd3, 0x4b, 0x93, 0x6e, 0x15, 0x9d, 0x09,
-	0xa8, 0x56, 0x6f, 0x5d, 0xcb, 0xa7, 0x89, 0x61, 0xa6, 0xae, 0x38, 0xe5, 0xfb, 0xfa, 0xd7, 0xa9,
-	0x79, 0xdd, 0xb0, 0xf7, 0xca, 0xb9, 0x64, 0x9e, 0x14, 0x45, 0x53, 0xc5, 0xbf, 0x05, 0xaa, 0x7e,
-	0x28, 0xdb, 0x87, 0x25, 0x8d, 0xba, 0x31, 0x94, 0x57, 0x7b, 0xc4, 0x21, 0x4c, 0x11, 0x53, 0xcd,
-	0x70, 0x3a, 0xbc, 0x0f, 0x90, 0x27, 0xc5, 0xa2, 0x41, 0xa9, 0x41, 0xcc, 0xd8, 0x89, 0x36, 0xc8,
-	0x97, 0xbb, 0x20, 0xcf, 0x78, 0x48, 0x12, 0x87, 0x30, 0xeb, 0xaf, 0xf7, 0x8d, 0xb2, 0x4d, 0x6d,
-	0xc5, 0x54, 0x9d, 0x2a, 0xf1, 0xb4, 0xfa, 0xd5, 0xeb, 0x4f, 0x10, 0xcc, 0xb5, 0xe6, 0x16, 0xdd,
-	0xbe, 0x0b, 0xa7, 0xdc, 0xa6, 0xf0, 0xd1, 0xbe, 0x12, 0x3a, 0xda, 0x21, 0x90, 0xde, 0x79, 0x77,
-	0x31, 0x13, 0xfb, 0x30, 0xe5, 0x4f, 0x25, 0x5d, 0x2d, 0x51, 0xbf, 0xe4, 0x3f, 0x42, 0x30, 0xdd,
-	0x9c, 0x53, 0xc8, 0xde, 0xf5, 0x4d, 0x04, 0x57, 0xbe, 0xda, 0x9e, 0xf2, 0xb5, 0x7c, 0xbe, 0x5c,
-	0x2c, 0x17, 0x14, 0x5b, 0x53, 0x6b, 0xc0, 0x5e, 0xf1, 0xde, 0x31, 0x78, 0x14, 0x81, 0x49, 0x7f,
-	0x32, 0xb7, 0x0b, 0x0a, 0xdd, 0xd3, 0xfa, 0xd5, 0x7c, 0x3c, 0x0b, 0x23, 0xd4, 0x56, 0x2c, 0xdb,
-	0x30, 0xf5, 0xec, 0x9e, 0x66, 0xe8, 0x7b, 0x76, 0x2c, 0x32, 0x8d, 0xe6, 0x06, 0x33, 0x2f, 0xb8,
-	0xcb, 0xd7, 0xd8, 0x2a, 0x9e, 0x81, 0x33, 0x1a, 0x6b, 0x9f, 0xbb, 0xed, 0x04, 0xdb, 0xf6, 0x7f,
-	0xbe, 0x28, 0x36, 0x6d, 0x02, 0xd4, 0xae, 0xfa, 0xd8, 0x20, 0x2b, 0xd3, 0x05, 0xdf, 0xc1, 0xe1,
-	0x6f, 0x93, 0xda, 0xcd, 0xa7, 0x6b, 0x42, 0x59, 0xc6, 0x13, 0xb9, 0x32, 0xf8, 0xf0, 0xcb, 0xa9,
-	0x81, 0xc4, 0x77, 0x08, 0xce, 0x35, 0x29, 0x86, 0x68, 0xcb, 0xdb, 0x70, 0x8a, 0xf2, 0xa5, 0x18,
-	0x62, 0xa7, 0xf4, 0x52, 0x7b, 0x3d, 0x61, 0x38, 0x1b, 0x07, 0x9a, 0x69, 0xfb, 0xa6, 0x50, 0x60,
-	0xe1, 0x37, 0x7d, 0x32, 0x22, 0x4c, 0xc6, 0x6c, 0x4b, 0x19, 0x3c, 0x27, 0xaf, 0x8e, 0xc4, 0xf7,
-	0xae, 0x82, 0x75, 0xad, 0xa0, 0xe9, 0x6c, 0xad, 0xee, 0x30, 0x6f, 0x40, 0x54, 0xe5, 0xcf, 0x1a,
-	0xfa, 0x19, 0x7b, 0xf6, 0x78, 0x61, 0x4c, 0x90, 0xd6, 0xb5, 0xb1, 0x1a, 0xe2, 0xb6, 0x31, 0x70,
-	0x2c, 0x22, 0x5d, 0x8f, 0xc5, 0xca, 0xff, 0x9c, 0x06, 0x3c, 0x77, 0x9a, 0xf0, 0x19, 0x82, 0x78,
-	0x33, 0x09, 0xa2, 0x0b, 0x25, 0xef, 0x9d, 0xd0, 0xcf, 0x8b, 0xba, 0x7a, 0x4d, 0x94, 0x21, 0x51,
-	0x97, 0xd3, 0x1d, 0x62, 0x2b, 0x85, 0xbe, 0xd4, 0xd6, 0x53, 0x8b, 0x7f, 0x10, 0xcc, 0x84, 0xf2,
-	0x8a, 0x82, 0xbc, 0x5f, 0x5f, 0x90, 0xcb, 0xa1, 0x63, 0x59, 0x43, 0x5b, 0x77, 0xb9, 0x39, 0x62,
-	0xd0, 0x15, 0x89, 0x0b, 0x70, 0xd2, 0x76, 0x48, 0xfb, 0xfc, 0x52, 0xe4, 0x24, 0x09, 0x4b, 0x5c,
-	0xc8, 0xd5, 0xcc, 0xaa, 0x23, 0xd4, 0xbf, 0x32, 0x6f, 0x89, 0x0b, 0x39, 0x90, 0x53, 0x94, 0x38,
-	0x0e, 0x50, 0x1d, 0x5a, 0x5e, 0xe5, 0xe1, 0x8c, 0x67, 0xc5, 0x83, 0x56, 0x81, 0x97, 0xfd, 0x68,
-	0x3b, 0x86, 0xbd, 0xa7, 0x5a, 0x4a, 0x45, 0x10, 0xf7, 0x4d, 0xc6, 0x81, 0x30, 0x6f, 0xcd, 0x89,
-	0x85, 0x96, 0x34, 0x8c, 0x56, 0xc4, 0xa3, 0xb6, 0x89, 0x47, 0x2a, 0x7e, 0x30, 0x0f, 0xef, 0x04,
-	0x8c, 0x33, 0x5e, 0xe7, 0x6d, 0x53, 0x36, 0x0d, 0xfb, 0xf0, 0x26, 0x21, 0x05, 0xd7, 0xb0, 0x3e,
-	0x44, 0x20, 0x05, 0x3d, 0x15, 0xa9, 0x7c, 0x00, 0x83, 0x25, 0x42, 0x0a, 0x7d, 0x3e, 0xc7, 0x8c,
-	0x63, 0xe9, 0x87, 0x28, 0x9c, 0x64, 0xa9, 0xe0, 0x2f, 0x10, 0x0c, 0x71, 0x13, 0x8c, 0xe5, 0xd0,
-	0x93, 0xd2, 0xe8, 0xc0, 0xa5, 0x4b, 0xed, 0x07, 0x70, 0x8d, 0x89, 0xf9, 0x07, 0x3f, 0xfe, 0xfe,
-	0x79, 0xe4, 0x3c, 0x9e, 0x91, 0xc3, 0xbe, 0x30, 0x70, 0x07, 0x8e, 0xff, 0x44, 0x30, 0xde, 0xd4,
-	0x03, 0xe3, 0x54, 0x6b, 0xf2, 0x56, 0xd6, 0x5d, 0x4a, 0xf7, 0x84, 0x21, 0x34, 0xa5, 0x99, 0xa6,
-	0xab, 0x78, 0x35, 0x54, 0x53, 0xed, 0x7c, 0xc8, 0xf7, 0x1a, 0x5e, 0x17, 0xf7, 0xf1, 0xc7, 0x11,
-	0x98, 0x08, 0x31, 0x6c, 0x78, 0xbd, 0x83, 0x4c, 0x9b, 0xda, 0x57, 0x69, 0xa3, 0x47, 0x14, 0xa1,
-	0x78, 0x87, 0x29, 0xbe, 0x85, 0x6f, 0xf4, 0xa0, 0x58, 0x26, 0x35, 0x7c, 0xf7, 0xbb, 0x06, 0x3e,
-	0x42, 0x70, 0x36, 0xc0, 0x0a, 0xe2, 0xd7, 0x3a, 0xc8, 0xbb, 0xc1, 0xb5, 0x4a, 0x57, 0xbb, 0x8c,
-	0x16, 0x6a, 0xb7, 0x99, 0xda, 0x6b, 0x78, 0xb3, 0x17, 0xb5, 0x35, 0x9f, 0x89, 0x7f, 0x42, 0x30,
-	0x5a, 0xef, 0xaa, 0xf0, 0xab, 0x1d, 0xe4, 0xe8, 0xb7, 0xa5, 0xd2, 0x4a, 0x37, 0xa1, 0x42, 0xdb,
-	0x75, 0xa6, 0x6d, 0x03, 0xa7, 0x7b, 0xd1, 0xe6, 0x5a, 0xb7, 0xbf, 0x10, 0x44, 0x1b, 0x9c, 0x0a,
-	0x6e, 0x23, 0xbd, 0x66, 0x0e, 0x4d, 0x5a, 0xed, 0x2a, 0x56, 0x68, 0xcb, 0x32, 0x6d, 0xef, 0xe2,
-	0x9d, 0x50, 0x6d, 0xd5, 0x97, 0x08, 0x95, 0xef, 0x35, 0xbc, 0x83, 0xee, 0xcb, 0x62, 0x32, 0x03,
-	0xcf, 0xec, 0x73, 0x04, 0x2f, 0x06, 0xbb, 0x11, 0xfc, 0x7a, 0x27, 0x89, 0x07, 0xf8, 0x27, 0xe9,
-	0x8d, 0xee, 0x01, 0x3a, 0x6a, 0x6d, 0x7b, 0xf2, 0xd9, 0xc1, 0x0c, 0xb0, 0x04, 0xed, 0x1c, 0xcc,
-	0xe6, 0xee, 0xa5, 0x9d, 0x83, 0x19, 0xe2, 0x43, 0xda, 0x3c, 0x98, 0x2d, 0x14, 0xd6, 0x66, 0x1b,
-	0xff, 0x8b, 0x20, 0xd6, 0xcc, 0x30, 0xe0, 0xb5, 0x0e, 0x72, 0x0d, 0x76, 0x39, 0x52, 0xaa, 0x17,
-	0x08, 0xa1, 0xf9, 0x0e, 0xd3, 0xbc, 0x8d, 0xb7, 0x7a, 0xd1, 0x5c, 0xef, 0x78, 0xf0, 0xb7, 0x08,
-	0xce, 0xf8, 0x4c, 0x09, 0xbe, 0xdc, 0x3a, 0xd7, 0x20, 0x8f, 0x23, 0xbd, 0xd2, 0x71, 0x9c, 0x10,
-	0xb6, 0xcc, 0x84, 0x2d, 0xe0, 0xf9, 0x50, 0x61, 0x79, 0x37, 0x36, 0xeb, 0xd8, 0x98, 0xd4, 0xf5,
-	0x27, 0x47, 0x71, 0xf4, 0xf4, 0x28, 0x8e, 0x7e, 0x3b, 0x8a, 0xa3, 0x4f, 0x8f, 0xe3, 0x03, 0x4f,
-	0x8f, 0xe3, 0x03, 0x3f, 0x1f, 0xc7, 0x07, 0xde, 0x5b, 0x0c, 0x35, 0x46, 0x1f, 0xf9, 0xd1, 0x99,
-	0x4f, 0xca, 0x0d, 0xb1, 0x1f, 0x1b, 0x97, 0xff, 0x0b, 0x00, 0x00, 0xff, 0xff, 0x0e, 0x2b, 0x1c,
-	0xf2, 0x92, 0x15, 0x00, 0x00,
+	0x17, 0xce, 0xb8, 0xe9, 0xbf, 0xd7, 0x5f, 0x7f, 0x49, 0xa6, 0x05, 0xb9, 0xdb, 0xd6, 0x49, 0x37,
+	0xa4, 0x89, 0x1a, 0xc5, 0xdb, 0x24, 0x52, 0x29, 0x09, 0x69, 0x89, 0x9d, 0x84, 0x56, 0x89, 0xd2,
+	0xc6, 0x2d, 0x44, 0x80, 0x2a, 0x6b, 0xed, 0xdd, 0x38, 0x0b, 0xf6, 0x8e, 0xb3, 0x3b, 0x1b, 0x13,
+	0x55, 0xbd, 0x94, 0x4b, 0xe9, 0x09, 0xc1, 0x85, 0x23, 0x47, 0xc4, 0x09, 0xa1, 0x72, 0x86, 0x63,
+	0x8f, 0x55, 0x91, 0x10, 0x27, 0x40, 0x09, 0x12, 0x45, 0x08, 0xc4, 0x8d, 0x2b, 0xf2, 0xcc, 0xac,
+	0xbd, 0x6b, 0xaf, 0xd7, 0xff, 0xe4, 0x4b, 0xeb, 0xce, 0xce, 0xfb, 0xbe, 0xf7, 0xbd, 0xf7, 0x66,
+	0xf6, 0xdb, 0xc2, 0x78, 0x96, 0xd8, 0x05, 0x62, 0x2b, 0x9a, 0x61, 0x53, 0xcb, 0xc8, 0x38, 0xd4,
+	0x20, 0xa6, 0xb2, 0x3b, 0x9d, 0xd1, 0xa9, 0x3a, 0xad, 0xec, 0x38, 0xba, 0xb5, 0x17, 0x2f, 0x5a,
+	0x84, 0x12, 0x7c, 0x96, 0x6f, 0x8c, 0x7b, 0x37, 0xc6, 0xc5, 0x46, 0xe9, 0x92, 0x40, 0xc9, 0xa8,
+	0xb6, 0xce, 0xa3, 0x2a, 0x18, 0x45, 0x35, 0x67, 0x98, 0x2a, 0xdb, 0xcd, 0x80, 0xa4, 0xd3, 0x39,
+	0x92, 0x23, 0xec, 0xa7, 0x52, 0xfe, 0x25, 0x56, 0xcf, 0xe5, 0x08, 0xc9, 0xe5, 0x75, 0x45, 0x2d,
+	0x1a, 0x8a, 0x6a, 0x9a, 0x84, 0xb2, 0x10, 0x5b, 0x3c, 0x8d, 0x79, 0xf1, 0x5d, 0xe4, 0x2c, 0x31,
+	0x5c, 0xcc, 0x78, 0x98, 0x0a, 0x5f, 0xc6, 0x7c, 0xff, 0x19, 0xbe, 0x3f, 0xcd, 0xd3, 0x10, 0xca,
+	0xf8, 0xa3, 0x21, 0xb5, 0x60, 0x98, 0x44, 0x61, 0x7f, 0xf2, 0x25, 0xf9, 0x34, 0xe0, 0x8d, 0xb2,
+	0xa6, 0xdb, 0xaa, 0xa5, 0x16, 0xec, 0x94, 0xbe, 0xe3, 0xe8, 0x36, 0x95, 0xef, 0xc1, 0x29, 0xdf,
+	0xaa, 0x5d, 0x24, 0xa6, 0xad, 0xe3, 0x15, 0x38, 0x52, 0x64, 0x2b, 0x51, 0x34, 0x82, 0x26, 0x4e,
+	0xcc, 0x8c, 0xc6, 0x43, 0x0a, 0x17, 0xe7, 0xc1, 0x89, 0xe3, 0x4f, 0x7f, 0x1e, 0xee, 0xfb, 0xf2,
+	0xf7, 0xaf, 0x2f, 0xa1, 0x94, 0x88, 0x96, 0x4b, 0x30, 0xc6, 0xe0, 0xdf, 0x56, 0xf3, 0x86, 0xa6,
+	0x52, 0x62, 0x2d, 0x79, 0xe2, 0x6f, 0x9a, 0x5b, 0x44, 0xe4, 0x81, 0xd7, 0x61, 0x68, 0xd7, 0xdd,
+	0x93, 0x56, 0x35, 0xcd, 0xd2, 0x6d, 0xce, 0x7d, 0x3c, 0x71, 0xe1, 0xf9, 0x93, 0xa9, 0xf3, 0x82,
+	0xbe, 0x82, 0xb3, 0xc8, 0xb7, 0xdc, 0xa1, 0x96, 0x61, 0xe6, 0x52, 0x83, 0xbb, 0x35, 0xeb, 0xf2,
+	0xdf, 0x11, 0xb8, 0xd8, 0x8c, 0x59, 0x68, 0x5d, 0x83, 0x41, 0x52, 0xd4, 0xad, 0xce, 0x98, 0x07,
+	0xdc, 0x50, 0xb1, 0x8c, 0x1f, 0x22, 0x18, 0xb2, 0xf5, 0xfc, 0x56, 0x3a, 0x43, 0x4c, 0x2d, 0x6d,
+	0xe9, 0x25, 0xd5, 0xd2, 0xec, 0x68, 0x64, 0xe4, 0xd0, 0xc4, 0x89, 0x99, 0x73, 0x6e, 0x15, 0xcb,
+	0x13, 0x50, 0xa9, 0xde, 0x92, 0x9e, 0x4d, 0x12, 0xc3, 0x4c, 0x5c, 0x2d, 0x97, 0xef, 0xab, 0x5f,
+	0x86, 0x27, 0x73, 0x06, 0xdd, 0x76, 0x32, 0xf1, 0x2c, 0x29, 0x88, 0xa6, 0x8a, 0xbf, 0xa6, 0x6c,
+	0xed, 0x03, 0x85, 0xee, 0x15, 0x75, 0xdb, 0x8d, 0xb1, 0x79, 0xb5, 0x07, 0xca, 0x84, 0x09, 0x62,
+	0x6a, 0x29, 0x4e, 0x87, 0x77, 0x00, 0xb2, 0xa4, 0x50, 0x30, 0x6c, 0xdb, 0x20, 0x66, 0xf4, 0x50,
+	0x0b, 0xe4, 0xb3, 0x1d, 0x90, 0xa7, 0x3c, 0x24, 0xf2, 0x1e, 0x8c, 0xfb, 0xeb, 0x7d, 0xcb, 0xa1,
+	0x36, 0x55, 0x4d, 0xad, 0x5c, 0x25, 0x9e, 0x56, 0xaf, 0x7a, 0xfd, 0x31, 0x82, 0x89, 0xe6, 0xdc,
+	0xa2, 0xdb, 0xf7, 0xe0, 0xa8, 0xdb, 0x14, 0x3e, 0xda, 0x57, 0x43, 0x47, 0x3b, 0x04, 0xd2, 0x3b,
+	0xef, 0x2e, 0xa6, 0xbc, 0x03, 0xc3, 0xfe, 0x54, 0x92, 0x95, 0x12, 0xf5, 0x4a, 0xfe, 0x63, 0x04,
+	0x23, 0x8d, 0x39, 0x85, 0xec, 0x2d, 0xdf, 0x44, 0x70, 0xe5, 0xf3, 0xad, 0x29, 0x5f, 0xcc, 0x66,
+	0x9d, 0x82, 0x93, 0x57, 0xa9, 0xae, 0x55, 0x81, 0xbd, 0xe2, 0xbd, 0x63, 0xf0, 0x38, 0x02, 0xe7,
+	0xfc, 0xc9, 0xdc, 0xc9, 0xab, 0xf6, 0xb6, 0xde, 0xab, 0xe6, 0xe3, 0x71, 0x18, 0xb0, 0xa9, 0x6a,
+	0x51, 0xc3, 0xcc, 0xa5, 0xb7, 0x75, 0x23, 0xb7, 0x4d, 0xa3, 0x91, 0x11, 0x34, 0xd1, 0x9f, 0xfa,
+	0xbf, 0xbb, 0x7c, 0x83, 0xad, 0xe2, 0x51, 0x38, 0xa9, 0x9b, 0x9a, 0x67, 0xdb, 0x21, 0xb6, 0xed,
+	0x7f, 0x7c, 0x51, 0x6c, 0x5a, 0x01, 0xa8, 0x5e, 0xf5, 0xd1, 0x7e, 0x56, 0xa6, 0x8b, 0xbe, 0x83,
+	0xc3, 0xdf, 0x26, 0xd5, 0x9b, 0x2f, 0xa7, 0x0b, 0x65, 0x29, 0x4f, 0xe4, 0x5c, 0xff, 0xa3, 0x2f,
+	0x86, 0xfb, 0xe4, 0xef, 0x10, 0x9c, 0x6f, 0x50, 0x0c, 0xd1, 0x96, 0xb7, 0xe0, 0xa8, 0xcd, 0x97,
+	0xa2, 0x88, 0x9d, 0xd2, 0xcb, 0xad, 0xf5, 0x84, 0xe1, 0x2c, 0xef, 0xea, 0x26, 0xf5, 0x4d, 0xa1,
+	0xc0, 0xc2, 0x6f, 0xfa, 0x64, 0x44, 0x98, 0x8c, 0xf1, 0xa6, 0x32, 0x78, 0x4e, 0x5e, 0x1d, 0xf2,
+	0xf7, 0xae, 0x82, 0x25, 0x3d, 0xaf, 0xe7, 0xd8, 0x5a, 0xcd, 0x61, 0x5e, 0x86, 0x21, 0x8d, 0x3f,
+	0xab, 0xeb, 0x67, 0xf4, 0xf9, 0x93, 0xa9, 0xd3, 0x82, 0xb4, 0xa6, 0x8d, 0x95, 0x10, 0xb7, 0x8d,
+	0x81, 0x63, 0x11, 0xe9, 0x78, 0x2c, 0xe6, 0x8e, 0x95, 0x1b, 0xf0, 0xa2, 0xdc, 0x84, 0x4f, 0x11,
+	0xc4, 0x1a, 0x49, 0x10, 0x5d, 0x28, 0x7a, 0xef, 0x84, 0x5e, 0x5e, 0xd4, 0x95, 0x6b, 0xc2, 0x01,
+	0xb9, 0x26, 0xa7, 0xbb, 0x84, 0xaa, 0xf9, 0x9e, 0xd4, 0xd6, 0x53, 0x8b, 0x7f, 0x10, 0x8c, 0x86,
+	0xf2, 0x8a, 0x82, 0xbc, 0x57, 0x5b, 0x90, 0x2b, 0xa1, 0x63, 0x59, 0x45, 0x5b, 0x72, 0xb9, 0x39,
+	0x62, 0xd0, 0x15, 0x89, 0xf3, 0x70, 0x98, 0x96, 0x49, 0x7b, 0xfc, 0x52, 0xe4, 0x24, 0xb2, 0x25,
+	0x2e, 0xe4, 0x4a, 0x66, 0x95, 0x11, 0xea, 0x5d, 0x99, 0xd7, 0x60, 0xa4, 0x31, 0xa7, 0x28, 0x71,
+	0x0c, 0xa0, 0x32, 0xb4, 0xbc, 0xca, 0xc7, 0x53, 0x9e, 0x15, 0x0f, 0x5a, 0x09, 0x5e, 0xf1, 0xa3,
+	0x6d, 0x1a, 0x74, 0x5b, 0xb3, 0xd4, 0x92, 0x20, 0xee, 0x99, 0x8c, 0x5d, 0x18, 0x6b, 0x42, 0x2c,
+	0xb4, 0x24, 0x61, 0xb0, 0x24, 0x1e, 0xb5, 0x4c, 0x3c, 0x50, 0xf2, 0x83, 0x79, 0x78, 0x29, 0x5c,
+	0xf0, 0xf3, 0x2e, 0x3a, 0x94, 0x24, 0x49, 0xa1, 0x48, 0x1c, 0x53, 0xeb, 0x99, 0xda, 0x6b, 0x20,
+	0x87, 0xb1, 0x0a, 0xa9, 0x51, 0x38, 0xaa, 0x9b, 0x6a, 0x26, 0xaf, 0x6b, 0x8c, 0xec, 0x58, 0xca,
+	0xfd, 0xa7, 0x7c, 0x16, 0xce, 0xb0, 0xf8, 0xf2, 0x3b, 0xd2, 0x31, 0x0d, 0xba, 0x77, 0x9b, 0x90,
+	0xbc, 0x6b, 0xb3, 0x1f, 0x21, 0x90, 0x82, 0x9e, 0x0a, 0xd4, 0xf7, 0xa1, 0xbf, 0x48, 0x48, 0xbe,
+	0xc7, 0xb7, 0x0f, 0xe3, 0x98, 0xf9, 0xe6, 0x14, 0x1c, 0x66, 0xa9, 0xe0, 0xcf, 0x11, 0x1c, 0xe1,
+	0xd6, 0x1d, 0x2b, 0xa1, 0xe7, 0xbb, 0xfe, 0xbb, 0x41, 0xba, 0xdc, 0x7a, 0x00, 0xd7, 0x28, 0x4f,
+	0x3e, 0xfc, 0xe1, 0xb7, 0xcf, 0x22, 0x63, 0x78, 0x54, 0x09, 0xfb, 0xcc, 0xe1, 0xdf, 0x0d, 0xf8,
+	0x0f, 0x04, 0x67, 0x1a, 0x3a, 0x77, 0x9c, 0x68, 0x4e, 0xde, 0xec, 0x83, 0x43, 0x4a, 0x76, 0x85,
+	0x21, 0x34, 0x25, 0x99, 0xa6, 0x05, 0x3c, 0x1f, 0xaa, 0xa9, 0x7a, 0xaa, 0x95, 0xfb, 0x75, 0x2f,
+	0xb9, 0x07, 0xf8, 0xa3, 0x08, 0x9c, 0x0d, 0xb1, 0x99, 0x78, 0xa9, 0x8d, 0x4c, 0x1b, 0x9a, 0x6e,
+	0x69, 0xb9, 0x4b, 0x14, 0xa1, 0x78, 0x93, 0x29, 0xde, 0xc0, 0xb7, 0xba, 0x50, 0xac, 0x90, 0x2a,
+	0xbe, 0xfb, 0x85, 0x84, 0xf7, 0x11, 0x9c, 0x0a, 0x30, 0xb0, 0xf8, 0xf5, 0x36, 0xf2, 0xae, 0xf3,
+	0xda, 0xd2, 0x42, 0x87, 0xd1, 0x42, 0xed, 0x3a, 0x53, 0x7b, 0x03, 0xaf, 0x74, 0xa3, 0xb6, 0xea,
+	0x8e, 0xf1, 0x8f, 0x08, 0x06, 0x6b, 0xbd, 0x20, 0x7e, 0xad, 0x8d, 0x1c, 0xfd, 0x66, 0x5a, 0x9a,
+	0xeb, 0x24, 0x54, 0x68, 0x5b, 0x65, 0xda, 0x96, 0x71, 0xb2, 0x1b, 0x6d, 0xae, 0xe1, 0xfc, 0x0b,
+	0xc1, 0x50, 0x9d, 0xbf, 0xc2, 0x2d, 0xa4, 0xd7, 0xc8, 0x57, 0x4a, 0xf3, 0x1d, 0xc5, 0x0a, 0x6d,
+	0x69, 0xa6, 0xed, 0x1d, 0xbc, 0x19, 0xaa, 0xad, 0xf2, 0x32, 0xb0, 0x95, 0xfb, 0x75, 0xef, 0x92,
+	0x07, 0x8a, 0x98, 0xcc, 0xc0, 0x33, 0xfb, 0x02, 0xc1, 0xcb, 0xc1, 0x1e, 0x0a, 0x5f, 0x6f, 0x27,
+	0xf1, 0x00, 0xd7, 0x27, 0xbd, 0xd1, 0x39, 0x40, 0x5b, 0xad, 0x6d, 0x4d, 0x3e, 0x3b, 0x98, 0x01,
+	0x46, 0xa6, 0x95, 0x83, 0xd9, 0xd8, 0x73, 0x49, 0x0b, 0x1d, 0x46, 0xb7, 0x75, 0x30, 0x9b, 0x28,
+	0xac, 0xce, 0x36, 0xfe, 0x17, 0x41, 0xb4, 0x91, 0xcd, 0xc1, 0x8b, 0x6d, 0xe4, 0x1a, 0xec, 0xcd,
+	0xa4, 0x44, 0x37, 0x10, 0x42, 0xf3, 0x5d, 0xa6, 0x79, 0x1d, 0xaf, 0x75, 0xa3, 0xb9, 0xd6, 0xa7,
+	0xe1, 0x3f, 0x11, 0xbc, 0x14, 0x68, 0x79, 0xf0, 0xb5, 0x36, 0x72, 0x0e, 0x70, 0x68, 0xd2, 0xf5,
+	0x8e, 0xe3, 0x85, 0xe0, 0x0d, 0x26, 0x78, 0x15, 0xdf, 0xec, 0x46, 0xb0, 0xea, 0x50, 0x92, 0xce,
+	0xba, 0x9a, 0xbe, 0x45, 0x70, 0xd2, 0x67, 0xc1, 0xf0, 0x95, 0xe6, 0x59, 0x06, 0x39, 0x3a, 0xe9,
+	0xd5, 0xb6, 0xe3, 0x84, 0xaa, 0x59, 0xa6, 0x6a, 0x0a, 0x4f, 0x86, 0xaa, 0xca, 0xba, 0xb1, 0xe9,
+	0xb2, 0x69, 0x4b, 0xac, 0x3e, 0xdd, 0x8f, 0xa1, 0x67, 0xfb, 0x31, 0xf4, 0xeb, 0x7e, 0x0c, 0x7d,
+	0x72, 0x10, 0xeb, 0x7b, 0x76, 0x10, 0xeb, 0xfb, 0xe9, 0x20, 0xd6, 0xf7, 0xee, 0x74, 0xa8, 0x0d,
+	0xfc, 0xd0, 0x8f, 0xce, 0x5c, 0x61, 0xe6, 0x08, 0xfb, 0x0f, 0xe1, 0xd9, 0xff, 0x06, 0x00, 0x7c,
+	0xab, 0x94, 0x80, 0x36, 0x17, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1094,6 +1187,9 @@ type QueryClient interface {
 	DelegatorValidators(ctx context.Context, in *QueryDelegatorValidatorsRequest, opts ...grpc.CallOption) (*QueryDelegatorValidatorsResponse, error)
 	// DelegatorWithdrawAddress queries withdraw address of a delegator.
 	DelegatorWithdrawAddress(ctx context.Context, in *QueryDelegatorWithdrawAddressRequest, opts ...grpc.CallOption) (*QueryDelegatorWithdrawAddressResponse, error)
+	// DelegatorAutoCompound queries whether a delegator opted in to
+	// auto-compounding.
+	DelegatorAutoCompound(ctx context.Context, in *QueryDelegatorAutoCompoundRequest, opts ...grpc.CallOption) (*QueryDelegatorAutoCompoundResponse, error)
 	// CommunityPool queries the community pool coins.
 	CommunityPool(ctx context.Context, in *QueryCommunityPoolRequest, opts ...grpc.CallOption) (*QueryCommunityPoolResponse, error)
 }
@@ -1187,6 +1283,15 @@ func (c *queryClient) DelegatorWithdrawAddress(ctx context.Context, in *QueryDel
 	return out, nil
 }
 
+func (c *queryClient) DelegatorAutoCompound(ctx context.Context, in *QueryDelegatorAutoCompoundRequest, opts ...grpc.CallOption) (*QueryDelegatorAutoCompoundResponse, error) {
+	out := new(QueryDelegatorAutoCompoundResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.distribution.v1beta1.Query/DelegatorAutoCompound", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) CommunityPool(ctx context.Context, in *QueryCommunityPoolRequest, opts ...grpc.CallOption) (*QueryCommunityPoolResponse, error) {
 	out := new(QueryCommunityPoolResponse)
 	err := c.cc.Invoke(ctx, "/cosmos.distribution.v1beta1.Query/CommunityPool", in, out, opts...)
@@ -1217,6 +1322,9 @@ type QueryServer interface {
 	DelegatorValidators(context.Context, *QueryDelegatorValidatorsRequest) (*QueryDelegatorValidatorsResponse, error)
 	// DelegatorWithdrawAddress queries withdraw address of a delegator.
 	DelegatorWithdrawAddress(context.Context, *QueryDelegatorWithdrawAddressRequest) (*QueryDelegatorWithdrawAddressResponse, error)
+	// DelegatorAutoCompound queries whether a delegator opted in to
+	// auto-compounding.
+	DelegatorAutoCompound(context.Context, *QueryDelegatorAutoCompoundRequest) (*QueryDelegatorAutoCompoundResponse, error)
 	// CommunityPool queries the community pool coins.
 	CommunityPool(context.Context, *QueryCommunityPoolRequest) (*QueryCommunityPoolResponse, error)
 }
@@ -1252,6 +1360,9 @@ func (*UnimplementedQueryServer) DelegatorValidators(ctx context.Context, req *Q
 func (*UnimplementedQueryServer) DelegatorWithdrawAddress(ctx context.Context, req *QueryDelegatorWithdrawAddressRequest) (*QueryDelegatorWithdrawAddressResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DelegatorWithdrawAddress not implemented")
 }
+func (*UnimplementedQueryServer) DelegatorAutoCompound(ctx context.Context, req *QueryDelegatorAutoCompoundRequest) (*QueryDelegatorAutoCompoundResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelegatorAutoCompound not implemented")
+}
 func (*UnimplementedQueryServer) CommunityPool(ctx context.Context, req *QueryCommunityPoolRequest) (*QueryCommunityPoolResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CommunityPool not implemented")
 }
@@ -1422,6 +1533,24 @@ func _Query_DelegatorWithdrawAddress_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_DelegatorAutoCompound_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDelegatorAutoCompoundRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DelegatorAutoCompound(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.distribution.v1beta1.Query/DelegatorAutoCompound",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DelegatorAutoCompound(ctx, req.(*QueryDelegatorAutoCompoundRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_CommunityPool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryCommunityPoolRequest)
 	if err := dec(in); err != nil {
@@ -1480,6 +1609,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DelegatorWithdrawAddress",
 			Handler:    _Query_DelegatorWithdrawAddress_Handler,
 		},
+		{
+			MethodName: "DelegatorAutoCompound",
+			Handler:    _Query_DelegatorAutoCompound_Handler,
+		},
 		{
 			MethodName: "CommunityPool",
 			Handler:    _Query_CommunityPool_Handler,
@@ -2137,6 +2270,69 @@ func (m *QueryDelegatorWithdrawAddressResponse) MarshalToSizedBuffer(dAtA []byte
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryDelegatorAutoCompoundRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDelegatorAutoCompoundRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDelegatorAutoCompoundRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDelegatorAutoCompoundResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDelegatorAutoCompoundResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDelegatorAutoCompoundResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *QueryCommunityPoolRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2476,6 +2672,31 @@ func (m *QueryDelegatorWithdrawAddressResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryDelegatorAutoCompoundRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDelegatorAutoCompoundResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Enabled {
+		n += 2
+	}
+	return n
+}
+
 func (m *QueryCommunityPoolRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -4203,6 +4424,158 @@ func (m *QueryDelegatorWithdrawAddressResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryDelegatorAutoCompoundRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDelegatorAutoCompoundRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDelegatorAutoCompoundRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDelegatorAutoCompoundResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDelegatorAutoCompoundResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDelegatorAutoCompoundResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *QueryCommunityPoolRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
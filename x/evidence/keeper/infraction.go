@@ -29,6 +29,14 @@ func (k Keeper) handleEquivocationEvidence(ctx context.Context, evidence *types.
 	logger := k.Logger(ctx)
 	consAddr := evidence.GetConsensusAddress(k.stakingKeeper.ConsensusAddressCodec())
 
+	// the infraction may have been committed with a consensus key which the
+	// validator rotated since, in which case it is resolved to the current
+	// consensus address of the validator
+	consAddr, err := k.stakingKeeper.ValidatorIdentifier(ctx, consAddr)
+	if err != nil {
+		return err
+	}
+
 	validator, err := k.stakingKeeper.ValidatorByConsAddr(ctx, consAddr)
 	if err != nil {
 		return err
@@ -112,7 +120,8 @@ func (k Keeper) handleEquivocationEvidence(ctx context.Context, evidence *types.
 	// Slash validator. The `power` is the int64 power of the validator as provided
 	// to/by CometBFT. This value is validator.Tokens as sent to CometBFT via
 	// ABCI, and now received as evidence. The fraction is passed in to separately
-	// to slash unbonding and rebonding delegations.
+	// to slash unbonding and rebonding delegations. The slashing module may raise
+	// the fraction by its correlation penalty if other validators were slashed recently.
 	slashFractionDoubleSign, err := k.slashingKeeper.SlashFractionDoubleSign(ctx)
 	if err != nil {
 		return err
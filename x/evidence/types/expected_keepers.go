@@ -20,6 +20,7 @@ type (
 		ConsensusAddressCodec() address.Codec
 		ValidatorByConsAddr(context.Context, sdk.ConsAddress) (stakingtypes.ValidatorI, error)
 		GetParams(ctx context.Context) (params stakingtypes.Params, err error)
+		ValidatorIdentifier(context.Context, sdk.ConsAddress) (sdk.ConsAddress, error)
 	}
 
 	// SlashingKeeper defines the slashing module interface contract needed by the
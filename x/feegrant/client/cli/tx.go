@@ -39,6 +39,8 @@ func GetTxCmd(ac address.Codec) *cobra.Command {
 
 	feegrantTxCmd.AddCommand(
 		NewCmdFeeGrant(ac),
+		NewCmdCreateSponsorshipPool(),
+		NewCmdCloseSponsorshipPool(ac),
 	)
 
 	return feegrantTxCmd
@@ -187,6 +189,129 @@ Examples:
 	return cmd
 }
 
+// NewCmdCreateSponsorshipPool returns a CLI command handler to create a MsgCreateSponsorshipPool transaction.
+func NewCmdCreateSponsorshipPool() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-sponsorship-pool [admin_key_or_address] [funds]",
+		Short: "Create a sponsorship pool paying the fees of any user for the allowed messages",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(
+				`Create a sponsorship pool funded from your address. The pool pays the fees of the
+transactions of any user whose messages are all allowed, when the pool address is set as
+fee granter, within the spend limit of each user and the period limit of each user.
+Note, the '--from' flag is ignored as it is implied from [admin_key_or_address].
+
+Examples:
+%s tx %s create-sponsorship-pool cosmos1skjw... 1000stake --allowed-messages "/cosmos.gov.v1beta1.MsgVote"
+	--spend-limit 100stake --period 86400 --period-limit 10stake
+				`, version.AppName, feegrant.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := cmd.Flags().Set(flags.FlagFrom, args[0]); err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			funds, err := sdk.ParseCoinsNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			allowedMsgs, err := cmd.Flags().GetStringSlice(FlagAllowedMsgs)
+			if err != nil {
+				return err
+			}
+
+			sl, err := cmd.Flags().GetString(FlagSpendLimit)
+			if err != nil {
+				return err
+			}
+
+			spendLimit, err := sdk.ParseCoinsNormalized(sl)
+			if err != nil {
+				return err
+			}
+
+			periodClock, err := cmd.Flags().GetInt64(FlagPeriod)
+			if err != nil {
+				return err
+			}
+
+			periodLimitVal, err := cmd.Flags().GetString(FlagPeriodLimit)
+			if err != nil {
+				return err
+			}
+
+			periodLimit, err := sdk.ParseCoinsNormalized(periodLimitVal)
+			if err != nil {
+				return err
+			}
+
+			msg := feegrant.NewMsgCreateSponsorshipPool(
+				clientCtx.GetFromAddress(), allowedMsgs, spendLimit, getPeriod(periodClock), periodLimit, funds,
+			)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	cmd.Flags().StringSlice(FlagAllowedMsgs, []string{}, "Set of messages for which the pool pays the fees")
+	cmd.Flags().String(FlagSpendLimit, "", "Spend limit specifies the max fees paid for each user, if not mentioned there is no limit")
+	cmd.Flags().Int64(FlagPeriod, 0, "period specifies the time duration(in seconds) in which period_limit fees can be paid for each user (ex: 3600)")
+	cmd.Flags().String(FlagPeriodLimit, "", "period limit specifies the maximum fees paid for each user in the period")
+
+	return cmd
+}
+
+// NewCmdCloseSponsorshipPool returns a CLI command handler to create a MsgCloseSponsorshipPool transaction.
+func NewCmdCloseSponsorshipPool(ac address.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "close-sponsorship-pool [admin_key_or_address] [pool]",
+		Short: "Close a sponsorship pool and return its remaining funds to the admin",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(
+				`Close a sponsorship pool and return its remaining funds to the admin.
+Note, the '--from' flag is ignored as it is implied from [admin_key_or_address].
+
+Examples:
+%s tx %s close-sponsorship-pool cosmos1skjw... cosmos1skjw...
+				`, version.AppName, feegrant.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := cmd.Flags().Set(flags.FlagFrom, args[0]); err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pool, err := ac.StringToBytes(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := feegrant.NewMsgCloseSponsorshipPool(clientCtx.GetFromAddress(), pool)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
 func getPeriodReset(duration int64) time.Time {
 	return time.Now().Add(getPeriod(duration))
 }
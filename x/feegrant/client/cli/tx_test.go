@@ -10,6 +10,7 @@ import (
 	abci "github.com/cometbft/cometbft/abci/types"
 	rpcclientmock "github.com/cometbft/cometbft/rpc/client/mock"
 	"github.com/cosmos/gogoproto/proto"
+	"github.com/spf13/cobra"
 	"github.com/stretchr/testify/suite"
 
 	_ "cosmossdk.io/api/cosmos/feegrant/v1beta1"
@@ -428,6 +429,89 @@ func (s *CLITestSuite) TestNewCmdFeeGrant() {
 	}
 }
 
+func (s *CLITestSuite) TestNewCmdSponsorshipPool() {
+	admin := s.accounts[0]
+	clientCtx := s.clientCtx
+
+	commonFlags := []string{
+		fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastSync),
+		fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+		fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin("stake", sdkmath.NewInt(10))).String()),
+	}
+
+	testCases := []struct {
+		name      string
+		cmd       *cobra.Command
+		args      []string
+		expectErr bool
+	}{
+		{
+			"create with invalid funds",
+			cli.NewCmdCreateSponsorshipPool(),
+			append([]string{admin.String(), "invalid"}, commonFlags...),
+			true,
+		},
+		{
+			"create with invalid period limit",
+			cli.NewCmdCreateSponsorshipPool(),
+			append(
+				[]string{
+					admin.String(),
+					"1000stake",
+					fmt.Sprintf("--%s=%s", cli.FlagAllowedMsgs, "/cosmos.gov.v1beta1.MsgVote"),
+					fmt.Sprintf("--%s=%d", cli.FlagPeriod, 3600),
+					fmt.Sprintf("--%s=%s", cli.FlagPeriodLimit, "invalid"),
+				},
+				commonFlags...,
+			),
+			true,
+		},
+		{
+			"valid create",
+			cli.NewCmdCreateSponsorshipPool(),
+			append(
+				[]string{
+					admin.String(),
+					"1000stake",
+					fmt.Sprintf("--%s=%s", cli.FlagAllowedMsgs, "/cosmos.gov.v1beta1.MsgVote"),
+					fmt.Sprintf("--%s=%s", cli.FlagSpendLimit, "100stake"),
+					fmt.Sprintf("--%s=%d", cli.FlagPeriod, 3600),
+					fmt.Sprintf("--%s=%s", cli.FlagPeriodLimit, "10stake"),
+				},
+				commonFlags...,
+			),
+			false,
+		},
+		{
+			"close with invalid pool address",
+			cli.NewCmdCloseSponsorshipPool(codecaddress.NewBech32Codec("cosmos")),
+			append([]string{admin.String(), "invalid_pool"}, commonFlags...),
+			true,
+		},
+		{
+			"valid close",
+			cli.NewCmdCloseSponsorshipPool(codecaddress.NewBech32Codec("cosmos")),
+			append([]string{admin.String(), "cosmos1nph3cfzk6trsmfxkeu943nvach5qw4vwstnvkl"}, commonFlags...),
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			out, err := clitestutil.ExecTestCLICmd(clientCtx, tc.cmd, tc.args)
+
+			if tc.expectErr {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err)
+				s.Require().NoError(clientCtx.Codec.UnmarshalJSON(out.Bytes(), &sdk.TxResponse{}), out.String())
+			}
+		})
+	}
+}
+
 func (s *CLITestSuite) TestTxWithFeeGrant() {
 	clientCtx := s.clientCtx
 	granter := s.addedGranter
@@ -13,6 +13,8 @@ import (
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	legacy.RegisterAminoMsg(cdc, &MsgGrantAllowance{}, "cosmos-sdk/MsgGrantAllowance")
 	legacy.RegisterAminoMsg(cdc, &MsgRevokeAllowance{}, "cosmos-sdk/MsgRevokeAllowance")
+	legacy.RegisterAminoMsg(cdc, &MsgCreateSponsorshipPool{}, "cosmos-sdk/MsgCreateSponsorshipPool")
+	legacy.RegisterAminoMsg(cdc, &MsgCloseSponsorshipPool{}, "cosmos-sdk/MsgCloseSponsorshipPool")
 
 	cdc.RegisterInterface((*FeeAllowanceI)(nil), nil)
 	cdc.RegisterConcrete(&BasicAllowance{}, "cosmos-sdk/BasicAllowance", nil)
@@ -25,6 +27,8 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgGrantAllowance{},
 		&MsgRevokeAllowance{},
+		&MsgCreateSponsorshipPool{},
+		&MsgCloseSponsorshipPool{},
 	)
 
 	registry.RegisterInterface(
@@ -8,7 +8,12 @@ const (
 	EventTypeUpdateFeeGrant = "update_feegrant"
 	EventTypePruneFeeGrant  = "prune_feegrant"
 
+	EventTypeCreateSponsorshipPool = "create_sponsorship_pool"
+	EventTypeCloseSponsorshipPool  = "close_sponsorship_pool"
+
 	AttributeKeyGranter = "granter"
 	AttributeKeyGrantee = "grantee"
 	AttributeKeyPruner  = "pruner"
+	AttributeKeyPool    = "pool"
+	AttributeKeyAdmin   = "admin"
 )
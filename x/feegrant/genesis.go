@@ -1,7 +1,10 @@
 package feegrant
 
 import (
+	errorsmod "cosmossdk.io/errors"
+
 	"github.com/cosmos/cosmos-sdk/codec/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 var _ types.UnpackInterfacesMessage = GenesisState{}
@@ -25,6 +28,19 @@ func ValidateGenesis(data GenesisState) error {
 			return err
 		}
 	}
+
+	for _, p := range data.SponsorshipPools {
+		if err := p.ValidateBasic(); err != nil {
+			return err
+		}
+	}
+
+	for _, u := range data.SponsorshipPoolUsages {
+		if !u.Spent.IsValid() || !u.PeriodSpent.IsValid() {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "invalid sponsorship pool usage of %s by %s", u.Pool, u.User)
+		}
+	}
+
 	return nil
 }
 
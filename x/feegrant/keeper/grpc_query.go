@@ -128,3 +128,50 @@ func (q Keeper) AllowancesByGranter(c context.Context, req *feegrant.QueryAllowa
 
 	return &feegrant.QueryAllowancesByGranterResponse{Allowances: grants, Pagination: pageRes}, nil
 }
+
+// SponsorshipPool returns the sponsorship pool with the given address.
+func (q Keeper) SponsorshipPool(ctx context.Context, req *feegrant.QuerySponsorshipPoolRequest) (*feegrant.QuerySponsorshipPoolResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	poolAddr, err := q.authKeeper.AddressCodec().StringToBytes(req.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	pool, err := q.GetSponsorshipPool(ctx, poolAddr)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+
+	if pool == nil {
+		return nil, status.Errorf(codes.NotFound, "sponsorship pool %s not found", req.Address)
+	}
+
+	return &feegrant.QuerySponsorshipPoolResponse{Pool: pool}, nil
+}
+
+// SponsorshipPoolUsage returns the fees paid by a sponsorship pool for a user.
+func (q Keeper) SponsorshipPoolUsage(ctx context.Context, req *feegrant.QuerySponsorshipPoolUsageRequest) (*feegrant.QuerySponsorshipPoolUsageResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	poolAddr, err := q.authKeeper.AddressCodec().StringToBytes(req.Pool)
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := q.authKeeper.AddressCodec().StringToBytes(req.User)
+	if err != nil {
+		return nil, err
+	}
+
+	usage, err := q.GetSponsorshipPoolUsage(ctx, poolAddr, user)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+
+	return &feegrant.QuerySponsorshipPoolUsageResponse{Usage: usage}, nil
+}
@@ -24,16 +24,18 @@ type Keeper struct {
 	cdc          codec.BinaryCodec
 	storeService store.KVStoreService
 	authKeeper   feegrant.AccountKeeper
+	bankKeeper   feegrant.BankKeeper
 }
 
 var _ ante.FeegrantKeeper = &Keeper{}
 
 // NewKeeper creates a feegrant Keeper
-func NewKeeper(cdc codec.BinaryCodec, storeService store.KVStoreService, ak feegrant.AccountKeeper) Keeper {
+func NewKeeper(cdc codec.BinaryCodec, storeService store.KVStoreService, ak feegrant.AccountKeeper, bk feegrant.BankKeeper) Keeper {
 	return Keeper{
 		cdc:          cdc,
 		storeService: storeService,
 		authKeeper:   ak,
+		bankKeeper:   bk,
 	}
 }
 
@@ -231,8 +233,18 @@ func (k Keeper) IterateAllFeeAllowances(ctx context.Context, cb func(grant feegr
 	return nil
 }
 
-// UseGrantedFees will try to pay the given fee from the granter's account as requested by the grantee
+// UseGrantedFees will try to pay the given fee from the granter's account as requested by the grantee.
+// If the granter is a sponsorship pool, the fee is paid by the pool within its limits for the grantee.
 func (k Keeper) UseGrantedFees(ctx context.Context, granter, grantee sdk.AccAddress, fee sdk.Coins, msgs []sdk.Msg) error {
+	pool, err := k.GetSponsorshipPool(ctx, granter)
+	if err != nil {
+		return err
+	}
+
+	if pool != nil {
+		return k.useSponsorshipPool(ctx, *pool, granter, grantee, fee, msgs)
+	}
+
 	grant, err := k.GetAllowance(ctx, granter, grantee)
 	if err != nil {
 		return err
@@ -294,6 +306,19 @@ func (k Keeper) InitGenesis(ctx context.Context, data *feegrant.GenesisState) er
 			return err
 		}
 	}
+
+	for _, p := range data.SponsorshipPools {
+		if err := k.setSponsorshipPool(ctx, p); err != nil {
+			return err
+		}
+	}
+
+	for _, u := range data.SponsorshipPoolUsages {
+		if err := k.setSponsorshipPoolUsage(ctx, u); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -305,9 +330,37 @@ func (k Keeper) ExportGenesis(ctx context.Context) (*feegrant.GenesisState, erro
 		grants = append(grants, grant)
 		return false
 	})
+	if err != nil {
+		return nil, err
+	}
+
+	var pools []feegrant.SponsorshipPool
+	err = k.IterateSponsorshipPools(ctx, func(pool feegrant.SponsorshipPool) bool {
+		pools = append(pools, pool)
+		return false
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// the usages of the closed pools which are not pruned yet are dropped
+	open := make(map[string]bool, len(pools))
+	for _, pool := range pools {
+		open[pool.Address] = true
+	}
+
+	var usages []feegrant.SponsorshipPoolUsage
+	err = k.IterateSponsorshipPoolUsages(ctx, func(usage feegrant.SponsorshipPoolUsage) bool {
+		if open[usage.Pool] {
+			usages = append(usages, usage)
+		}
+		return false
+	})
 
 	return &feegrant.GenesisState{
-		Allowances: grants,
+		Allowances:            grants,
+		SponsorshipPools:      pools,
+		SponsorshipPoolUsages: usages,
 	}, err
 }
 
@@ -108,3 +108,51 @@ func (k msgServer) PruneAllowances(ctx context.Context, req *feegrant.MsgPruneAl
 
 	return &feegrant.MsgPruneAllowancesResponse{}, nil
 }
+
+// CreateSponsorshipPool creates a sponsorship pool funded by the admin.
+func (k msgServer) CreateSponsorshipPool(ctx context.Context, msg *feegrant.MsgCreateSponsorshipPool) (*feegrant.MsgCreateSponsorshipPoolResponse, error) {
+	admin, err := k.authKeeper.AddressCodec().StringToBytes(msg.Admin)
+	if err != nil {
+		return nil, err
+	}
+
+	if !msg.Funds.IsValid() {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "funds are invalid: %s", msg.Funds)
+	}
+
+	pool := feegrant.SponsorshipPool{
+		AllowedMessages:      msg.AllowedMessages,
+		UserSpendLimit:       msg.UserSpendLimit,
+		Period:               msg.Period,
+		UserPeriodSpendLimit: msg.UserPeriodSpendLimit,
+	}
+	if err := pool.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	poolAddr, err := k.Keeper.CreateSponsorshipPool(ctx, admin, pool, msg.Funds)
+	if err != nil {
+		return nil, err
+	}
+
+	return &feegrant.MsgCreateSponsorshipPoolResponse{Address: poolAddr.String()}, nil
+}
+
+// CloseSponsorshipPool closes a sponsorship pool, returning its remaining funds to the admin.
+func (k msgServer) CloseSponsorshipPool(ctx context.Context, msg *feegrant.MsgCloseSponsorshipPool) (*feegrant.MsgCloseSponsorshipPoolResponse, error) {
+	admin, err := k.authKeeper.AddressCodec().StringToBytes(msg.Admin)
+	if err != nil {
+		return nil, err
+	}
+
+	poolAddr, err := k.authKeeper.AddressCodec().StringToBytes(msg.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.Keeper.CloseSponsorshipPool(ctx, admin, poolAddr); err != nil {
+		return nil, err
+	}
+
+	return &feegrant.MsgCloseSponsorshipPoolResponse{}, nil
+}
@@ -0,0 +1,329 @@
+package keeper
+
+import (
+	"context"
+	"encoding/binary"
+
+	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+	"cosmossdk.io/x/feegrant"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// CreateSponsorshipPool creates a sponsorship pool with a new account funded
+// by the admin, and returns the address of the pool.
+func (k Keeper) CreateSponsorshipPool(ctx context.Context, admin sdk.AccAddress, pool feegrant.SponsorshipPool, funds sdk.Coins) (sdk.AccAddress, error) {
+	var poolAddr sdk.AccAddress
+	for {
+		seq, err := k.nextSponsorshipPoolSeq(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		derivationKey := make([]byte, 8)
+		binary.BigEndian.PutUint64(derivationKey, seq)
+
+		ac, err := authtypes.NewModuleCredential(feegrant.ModuleName, feegrant.SponsorshipPoolKeyPrefix, derivationKey)
+		if err != nil {
+			return nil, err
+		}
+		poolAddr = sdk.AccAddress(ac.Address())
+		if k.authKeeper.GetAccount(ctx, poolAddr) != nil {
+			// handle a rare collision, in which case we just go on to the
+			// next sequence value and derive a new address.
+			continue
+		}
+
+		// sponsorship pool accounts are unclaimable base accounts
+		account, err := authtypes.NewBaseAccountWithPubKey(ac)
+		if err != nil {
+			return nil, errorsmod.Wrap(err, "could not create sponsorship pool account")
+		}
+
+		k.authKeeper.SetAccount(ctx, k.authKeeper.NewAccount(ctx, account))
+		break
+	}
+
+	pool.Address = poolAddr.String()
+	pool.Admin = admin.String()
+	if err := k.setSponsorshipPool(ctx, pool); err != nil {
+		return nil, err
+	}
+
+	if !funds.IsZero() {
+		if err := k.bankKeeper.SendCoins(ctx, admin, poolAddr, funds); err != nil {
+			return nil, err
+		}
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			feegrant.EventTypeCreateSponsorshipPool,
+			sdk.NewAttribute(feegrant.AttributeKeyPool, pool.Address),
+			sdk.NewAttribute(feegrant.AttributeKeyAdmin, pool.Admin),
+		),
+	)
+
+	return poolAddr, nil
+}
+
+// CloseSponsorshipPool deletes a sponsorship pool and sends the remaining
+// funds of the pool to the admin. The usages of the pool are deleted later on,
+// in bounded batches, by PruneClosedSponsorshipPoolUsages.
+func (k Keeper) CloseSponsorshipPool(ctx context.Context, admin, poolAddr sdk.AccAddress) error {
+	pool, err := k.GetSponsorshipPool(ctx, poolAddr)
+	if err != nil {
+		return err
+	}
+
+	if pool == nil {
+		return sdkerrors.ErrNotFound.Wrap("sponsorship pool not found")
+	}
+
+	if pool.Admin != admin.String() {
+		return errorsmod.Wrap(sdkerrors.ErrUnauthorized, "only the admin can close the sponsorship pool")
+	}
+
+	if funds := k.bankKeeper.SpendableCoins(ctx, poolAddr); !funds.IsZero() {
+		if err := k.bankKeeper.SendCoins(ctx, poolAddr, admin, funds); err != nil {
+			return err
+		}
+	}
+
+	store := k.storeService.OpenKVStore(ctx)
+	if err := store.Delete(feegrant.SponsorshipPoolKey(poolAddr)); err != nil {
+		return err
+	}
+
+	if err := store.Set(feegrant.ClosedSponsorshipPoolKey(poolAddr), []byte{}); err != nil {
+		return err
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			feegrant.EventTypeCloseSponsorshipPool,
+			sdk.NewAttribute(feegrant.AttributeKeyPool, pool.Address),
+			sdk.NewAttribute(feegrant.AttributeKeyAdmin, pool.Admin),
+		),
+	)
+
+	return nil
+}
+
+// PruneClosedSponsorshipPoolUsages deletes the usages of the closed
+// sponsorship pools, up to limit usages per call. A closed pool is forgotten
+// once all its usages are deleted.
+func (k Keeper) PruneClosedSponsorshipPoolUsages(ctx context.Context, limit int32) error {
+	store := k.storeService.OpenKVStore(ctx)
+	var count int32
+	for count < limit {
+		iter := storetypes.KVStorePrefixIterator(runtime.KVStoreAdapter(store), feegrant.ClosedSponsorshipPoolKeyPrefix)
+		var closedKey []byte
+		if iter.Valid() {
+			closedKey = iter.Key()
+		}
+		iter.Close()
+
+		if closedKey == nil {
+			return nil
+		}
+
+		// a pool imported again since it was closed keeps its usages
+		poolAddr := feegrant.ParsePoolFromClosedSponsorshipPoolKey(closedKey)
+		pool, err := k.GetSponsorshipPool(ctx, poolAddr)
+		if err != nil {
+			return err
+		}
+
+		var keys [][]byte
+		if pool == nil {
+			iter = storetypes.KVStorePrefixIterator(runtime.KVStoreAdapter(store), feegrant.SponsorshipPoolUsagePrefix(poolAddr))
+			for ; iter.Valid() && count+int32(len(keys)) < limit; iter.Next() {
+				keys = append(keys, iter.Key())
+			}
+			iter.Close()
+		}
+
+		for _, key := range keys {
+			if err := store.Delete(key); err != nil {
+				return err
+			}
+		}
+
+		count += int32(len(keys))
+		if count < limit {
+			// all the usages of the pool are deleted
+			if err := store.Delete(closedKey); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// GetSponsorshipPool returns the sponsorship pool with the given address.
+// If there is none, it returns nil, nil.
+func (k Keeper) GetSponsorshipPool(ctx context.Context, poolAddr sdk.AccAddress) (*feegrant.SponsorshipPool, error) {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get(feegrant.SponsorshipPoolKey(poolAddr))
+	if err != nil || bz == nil {
+		return nil, err
+	}
+
+	var pool feegrant.SponsorshipPool
+	if err := k.cdc.Unmarshal(bz, &pool); err != nil {
+		return nil, err
+	}
+
+	return &pool, nil
+}
+
+// GetSponsorshipPoolUsage returns the fees paid by a sponsorship pool for a
+// user. If the pool never paid fees for the user, it returns nil, nil.
+func (k Keeper) GetSponsorshipPoolUsage(ctx context.Context, poolAddr, user sdk.AccAddress) (*feegrant.SponsorshipPoolUsage, error) {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get(feegrant.SponsorshipPoolUsageKey(poolAddr, user))
+	if err != nil || bz == nil {
+		return nil, err
+	}
+
+	var usage feegrant.SponsorshipPoolUsage
+	if err := k.cdc.Unmarshal(bz, &usage); err != nil {
+		return nil, err
+	}
+
+	return &usage, nil
+}
+
+// IterateSponsorshipPools iterates over all the sponsorship pools in the store.
+// Callback to get all data, returns true to stop, false to keep reading
+func (k Keeper) IterateSponsorshipPools(ctx context.Context, cb func(pool feegrant.SponsorshipPool) bool) error {
+	store := k.storeService.OpenKVStore(ctx)
+	iter := storetypes.KVStorePrefixIterator(runtime.KVStoreAdapter(store), feegrant.SponsorshipPoolKeyPrefix)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var pool feegrant.SponsorshipPool
+		if err := k.cdc.Unmarshal(iter.Value(), &pool); err != nil {
+			return err
+		}
+
+		if cb(pool) {
+			break
+		}
+	}
+
+	return nil
+}
+
+// IterateSponsorshipPoolUsages iterates over all the sponsorship pool usages in the store.
+// Callback to get all data, returns true to stop, false to keep reading
+func (k Keeper) IterateSponsorshipPoolUsages(ctx context.Context, cb func(usage feegrant.SponsorshipPoolUsage) bool) error {
+	store := k.storeService.OpenKVStore(ctx)
+	iter := storetypes.KVStorePrefixIterator(runtime.KVStoreAdapter(store), feegrant.SponsorshipPoolUsageKeyPrefix)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var usage feegrant.SponsorshipPoolUsage
+		if err := k.cdc.Unmarshal(iter.Value(), &usage); err != nil {
+			return err
+		}
+
+		if cb(usage) {
+			break
+		}
+	}
+
+	return nil
+}
+
+// useSponsorshipPool pays the fee of the user from the sponsorship pool if the
+// messages and the fee are accepted by the pool, and records the usage of the
+// pool by the user.
+func (k Keeper) useSponsorshipPool(ctx context.Context, pool feegrant.SponsorshipPool, poolAddr, user sdk.AccAddress, fee sdk.Coins, msgs []sdk.Msg) error {
+	usage, err := k.GetSponsorshipPoolUsage(ctx, poolAddr, user)
+	if err != nil {
+		return err
+	}
+
+	// usages are created on the first transaction of the user
+	if usage == nil {
+		usage = &feegrant.SponsorshipPoolUsage{
+			Pool: pool.Address,
+			User: user.String(),
+		}
+	}
+
+	if err := pool.Accept(sdk.UnwrapSDKContext(ctx), usage, fee, msgs); err != nil {
+		return err
+	}
+
+	if err := k.setSponsorshipPoolUsage(ctx, *usage); err != nil {
+		return err
+	}
+
+	emitUseGrantEvent(ctx, pool.Address, usage.User)
+
+	return nil
+}
+
+func (k Keeper) setSponsorshipPool(ctx context.Context, pool feegrant.SponsorshipPool) error {
+	poolAddr, err := k.authKeeper.AddressCodec().StringToBytes(pool.Address)
+	if err != nil {
+		return err
+	}
+
+	bz, err := k.cdc.Marshal(&pool)
+	if err != nil {
+		return err
+	}
+
+	store := k.storeService.OpenKVStore(ctx)
+	return store.Set(feegrant.SponsorshipPoolKey(poolAddr), bz)
+}
+
+func (k Keeper) setSponsorshipPoolUsage(ctx context.Context, usage feegrant.SponsorshipPoolUsage) error {
+	poolAddr, err := k.authKeeper.AddressCodec().StringToBytes(usage.Pool)
+	if err != nil {
+		return err
+	}
+
+	user, err := k.authKeeper.AddressCodec().StringToBytes(usage.User)
+	if err != nil {
+		return err
+	}
+
+	bz, err := k.cdc.Marshal(&usage)
+	if err != nil {
+		return err
+	}
+
+	store := k.storeService.OpenKVStore(ctx)
+	return store.Set(feegrant.SponsorshipPoolUsageKey(poolAddr, user), bz)
+}
+
+func (k Keeper) nextSponsorshipPoolSeq(ctx context.Context) (uint64, error) {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get(feegrant.SponsorshipPoolSeqKey)
+	if err != nil {
+		return 0, err
+	}
+
+	var seq uint64
+	if bz != nil {
+		seq = binary.BigEndian.Uint64(bz)
+	}
+
+	next := make([]byte, 8)
+	binary.BigEndian.PutUint64(next, seq+1)
+	if err := store.Set(feegrant.SponsorshipPoolSeqKey, next); err != nil {
+		return 0, err
+	}
+
+	return seq + 1, nil
+}
@@ -0,0 +1,113 @@
+package keeper_test
+
+import (
+	"time"
+
+	"github.com/golang/mock/gomock"
+
+	"cosmossdk.io/x/feegrant"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func (suite *KeeperTestSuite) TestSponsorshipPool() {
+	ctx := suite.ctx.WithBlockTime(time.Now())
+	admin, user1, user2 := suite.addrs[0], suite.addrs[1], suite.addrs[2]
+	funds := sdk.NewCoins(sdk.NewInt64Coin("atom", 1000))
+	fee := sdk.NewCoins(sdk.NewInt64Coin("atom", 20))
+	send := []sdk.Msg{&banktypes.MsgSend{}}
+
+	// the pool account is created
+	suite.accountKeeper.EXPECT().GetAccount(gomock.Any(), gomock.Any()).Return(nil)
+	suite.accountKeeper.EXPECT().NewAccount(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ sdk.Context, acc sdk.AccountI) sdk.AccountI { return acc },
+	)
+	suite.accountKeeper.EXPECT().SetAccount(gomock.Any(), gomock.Any())
+	suite.bankKeeper.EXPECT().SendCoins(gomock.Any(), admin, gomock.Any(), funds).Return(nil)
+
+	msg := feegrant.NewMsgCreateSponsorshipPool(
+		admin, []string{sdk.MsgTypeURL(&banktypes.MsgSend{})}, sdk.NewCoins(sdk.NewInt64Coin("atom", 100)),
+		time.Hour, sdk.NewCoins(sdk.NewInt64Coin("atom", 30)), funds,
+	)
+	res, err := suite.msgSrvr.CreateSponsorshipPool(ctx, msg)
+	suite.Require().NoError(err)
+
+	poolAddr, err := sdk.AccAddressFromBech32(res.Address)
+	suite.Require().NoError(err)
+
+	poolRes, err := suite.feegrantKeeper.SponsorshipPool(ctx, &feegrant.QuerySponsorshipPoolRequest{Address: res.Address})
+	suite.Require().NoError(err)
+	suite.Require().Equal(admin.String(), poolRes.Pool.Admin)
+
+	// the pool pays the fees of any user within the limits of each user
+	for _, user := range []sdk.AccAddress{user1, user2} {
+		suite.Require().NoError(suite.feegrantKeeper.UseGrantedFees(ctx, poolAddr, user, fee, send))
+	}
+
+	err = suite.feegrantKeeper.UseGrantedFees(ctx, poolAddr, user1, fee, send)
+	suite.Require().ErrorIs(err, feegrant.ErrFeeLimitExceeded)
+
+	err = suite.feegrantKeeper.UseGrantedFees(ctx, poolAddr, user2, fee, []sdk.Msg{&banktypes.MsgMultiSend{}})
+	suite.Require().ErrorIs(err, feegrant.ErrMessageNotAllowed)
+
+	usageRes, err := suite.feegrantKeeper.SponsorshipPoolUsage(ctx, &feegrant.QuerySponsorshipPoolUsageRequest{
+		Pool: res.Address,
+		User: user1.String(),
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(fee, usageRes.Usage.Spent)
+	suite.Require().Equal(ctx.BlockTime().Add(time.Hour), usageRes.Usage.PeriodReset)
+
+	// the usages are exported and imported with the pool
+	genesis, err := suite.feegrantKeeper.ExportGenesis(ctx)
+	suite.Require().NoError(err)
+	suite.Require().Len(genesis.SponsorshipPools, 1)
+	suite.Require().Len(genesis.SponsorshipPoolUsages, 2)
+	suite.Require().NoError(feegrant.ValidateGenesis(*genesis))
+
+	// only the admin can close the pool
+	_, err = suite.msgSrvr.CloseSponsorshipPool(ctx, feegrant.NewMsgCloseSponsorshipPool(user1, poolAddr))
+	suite.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	remaining := sdk.NewCoins(sdk.NewInt64Coin("atom", 960))
+	suite.bankKeeper.EXPECT().SpendableCoins(gomock.Any(), poolAddr).Return(remaining)
+	suite.bankKeeper.EXPECT().SendCoins(gomock.Any(), poolAddr, admin, remaining).Return(nil)
+	_, err = suite.msgSrvr.CloseSponsorshipPool(ctx, feegrant.NewMsgCloseSponsorshipPool(admin, poolAddr))
+	suite.Require().NoError(err)
+
+	pool, err := suite.feegrantKeeper.GetSponsorshipPool(ctx, poolAddr)
+	suite.Require().NoError(err)
+	suite.Require().Nil(pool)
+
+	// the usages of the closed pool are not exported, and are pruned in
+	// bounded batches
+	closedGenesis, err := suite.feegrantKeeper.ExportGenesis(ctx)
+	suite.Require().NoError(err)
+	suite.Require().Empty(closedGenesis.SponsorshipPoolUsages)
+
+	for _, remaining := range []int{1, 0} {
+		suite.Require().NoError(suite.feegrantKeeper.PruneClosedSponsorshipPoolUsages(ctx, 1))
+
+		var usages []feegrant.SponsorshipPoolUsage
+		suite.Require().NoError(suite.feegrantKeeper.IterateSponsorshipPoolUsages(ctx, func(usage feegrant.SponsorshipPoolUsage) bool {
+			usages = append(usages, usage)
+			return false
+		}))
+		suite.Require().Len(usages, remaining)
+	}
+
+	usage, err := suite.feegrantKeeper.GetSponsorshipPoolUsage(ctx, poolAddr, user1)
+	suite.Require().NoError(err)
+	suite.Require().Nil(usage)
+
+	// the pool does not pay fees anymore
+	err = suite.feegrantKeeper.UseGrantedFees(ctx, poolAddr, user1, fee, send)
+	suite.Require().Error(err)
+
+	suite.Require().NoError(suite.feegrantKeeper.InitGenesis(ctx, genesis))
+	pool, err = suite.feegrantKeeper.GetSponsorshipPool(ctx, poolAddr)
+	suite.Require().NoError(err)
+	suite.Require().NotNil(pool)
+}
@@ -29,6 +29,22 @@ var (
 	// FeeAllowanceQueueKeyPrefix is the set of the kvstore for fee allowance keys data
 	// - 0x01<allowance_prefix_queue_key_bytes>: <empty value>
 	FeeAllowanceQueueKeyPrefix = []byte{0x01}
+
+	// SponsorshipPoolKeyPrefix is the set of the kvstore for sponsorship pools
+	// - 0x02<len(pool_address_bytes)><pool_address_bytes>: SponsorshipPool
+	SponsorshipPoolKeyPrefix = []byte{0x02}
+
+	// SponsorshipPoolUsageKeyPrefix is the set of the kvstore for the usages of sponsorship pools
+	// - 0x03<len(pool_address_bytes)><pool_address_bytes><len(user_address_bytes)><user_address_bytes>: SponsorshipPoolUsage
+	SponsorshipPoolUsageKeyPrefix = []byte{0x03}
+
+	// SponsorshipPoolSeqKey is the key of the sequence used to derive the sponsorship pool addresses
+	// - 0x04: uint64
+	SponsorshipPoolSeqKey = []byte{0x04}
+
+	// ClosedSponsorshipPoolKeyPrefix is the set of the kvstore for the closed sponsorship pools whose usages are pruned
+	// - 0x05<len(pool_address_bytes)><pool_address_bytes>: <empty value>
+	ClosedSponsorshipPoolKeyPrefix = []byte{0x05}
 )
 
 // FeeAllowanceKey is the canonical key to store a grant from granter to grantee
@@ -66,6 +82,48 @@ func AllowanceByExpTimeKey(exp *time.Time) []byte {
 	return append(FeeAllowanceQueueKeyPrefix, sdk.FormatTimeBytes(*exp)...)
 }
 
+// SponsorshipPoolKey is the key to store a sponsorship pool.
+//
+// Key format:
+// - <0x02><len(pool_address_bytes)><pool_address_bytes>
+func SponsorshipPoolKey(pool sdk.AccAddress) []byte {
+	return append(SponsorshipPoolKeyPrefix, address.MustLengthPrefix(pool.Bytes())...)
+}
+
+// SponsorshipPoolUsagePrefix returns a prefix to scan for all the usages of a sponsorship pool.
+//
+// Key format:
+// - <0x03><len(pool_address_bytes)><pool_address_bytes>
+func SponsorshipPoolUsagePrefix(pool sdk.AccAddress) []byte {
+	return append(SponsorshipPoolUsageKeyPrefix, address.MustLengthPrefix(pool.Bytes())...)
+}
+
+// SponsorshipPoolUsageKey is the key to store the usage of a sponsorship pool by a user.
+//
+// Key format:
+// - <0x03><len(pool_address_bytes)><pool_address_bytes><len(user_address_bytes)><user_address_bytes>
+func SponsorshipPoolUsageKey(pool, user sdk.AccAddress) []byte {
+	return append(SponsorshipPoolUsagePrefix(pool), address.MustLengthPrefix(user.Bytes())...)
+}
+
+// ClosedSponsorshipPoolKey is the key to store a closed sponsorship pool whose usages are pruned.
+//
+// Key format:
+// - <0x05><len(pool_address_bytes)><pool_address_bytes>
+func ClosedSponsorshipPoolKey(pool sdk.AccAddress) []byte {
+	return append(ClosedSponsorshipPoolKeyPrefix, address.MustLengthPrefix(pool.Bytes())...)
+}
+
+// ParsePoolFromClosedSponsorshipPoolKey extracts and returns the pool address from the given key.
+func ParsePoolFromClosedSponsorshipPoolKey(key []byte) sdk.AccAddress {
+	// key is of format:
+	// 0x05<poolAddressLen (1 Byte)><poolAddress_Bytes>
+	poolAddrLen, poolAddrLenEndIndex := sdk.ParseLengthPrefixedBytes(key, 1, 1) // ignore key[0] since it is a prefix key
+	pool, _ := sdk.ParseLengthPrefixedBytes(key, poolAddrLenEndIndex+1, int(poolAddrLen[0]))
+
+	return pool
+}
+
 // ParseAddressesFromFeeAllowanceKey extracts and returns the granter, grantee from the given key.
 func ParseAddressesFromFeeAllowanceKey(key []byte) (granter, grantee []byte) {
 	// key is of format:
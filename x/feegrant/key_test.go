@@ -45,3 +45,13 @@ func TestMarshalAndUnmarshalFeegrantKeyQueueKey(t *testing.T) {
 	require.Equal(t, granter, granter1)
 	require.Equal(t, grantee, grantee1)
 }
+
+func TestMarshalAndUnmarshalClosedSponsorshipPoolKey(t *testing.T) {
+	addressCodec := codecaddress.NewBech32Codec("cosmos")
+	pool, err := addressCodec.StringToBytes("cosmos1qk93t4j0yyzgqgt6k5qf8deh8fq6smpn3ntu3x")
+	require.NoError(t, err)
+
+	key := feegrant.ClosedSponsorshipPoolKey(pool)
+	require.Len(t, key, len(pool)+2)
+	require.Equal(t, sdk.AccAddress(pool), feegrant.ParsePoolFromClosedSponsorshipPoolKey(key))
+}
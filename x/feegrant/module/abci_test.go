@@ -46,7 +46,7 @@ func TestFeegrantPruning(t *testing.T) {
 
 	accountKeeper.EXPECT().AddressCodec().Return(address.NewBech32Codec("cosmos")).AnyTimes()
 
-	feegrantKeeper := keeper.NewKeeper(encCfg.Codec, runtime.NewKVStoreService(key), accountKeeper)
+	feegrantKeeper := keeper.NewKeeper(encCfg.Codec, runtime.NewKVStoreService(key), accountKeeper, feegranttestutil.NewMockBankKeeper(ctrl))
 
 	feegrantKeeper.GrantAllowance(
 		testCtx.Ctx,
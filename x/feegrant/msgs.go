@@ -12,6 +12,7 @@ import (
 
 var (
 	_, _ sdk.Msg                       = &MsgGrantAllowance{}, &MsgRevokeAllowance{}
+	_, _ sdk.Msg                       = &MsgCreateSponsorshipPool{}, &MsgCloseSponsorshipPool{}
 	_    types.UnpackInterfacesMessage = &MsgGrantAllowance{}
 )
 
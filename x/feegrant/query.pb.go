@@ -296,6 +296,199 @@ func (m *QueryAllowancesByGranterRequest) GetPagination() *query.PageRequest {
 	return nil
 }
 
+// QuerySponsorshipPoolRequest is the request type for the Query/SponsorshipPool RPC method.
+type QuerySponsorshipPoolRequest struct {
+	// address is the address of the pool.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *QuerySponsorshipPoolRequest) Reset()         { *m = QuerySponsorshipPoolRequest{} }
+func (m *QuerySponsorshipPoolRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySponsorshipPoolRequest) ProtoMessage()    {}
+func (*QuerySponsorshipPoolRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_59efc303945de53f, []int{5}
+}
+func (m *QuerySponsorshipPoolRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySponsorshipPoolRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySponsorshipPoolRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySponsorshipPoolRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySponsorshipPoolRequest.Merge(m, src)
+}
+func (m *QuerySponsorshipPoolRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySponsorshipPoolRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySponsorshipPoolRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySponsorshipPoolRequest proto.InternalMessageInfo
+
+func (m *QuerySponsorshipPoolRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+// QuerySponsorshipPoolResponse is the response type for the Query/SponsorshipPool RPC method.
+type QuerySponsorshipPoolResponse struct {
+	Pool *SponsorshipPool `protobuf:"bytes,1,opt,name=pool,proto3" json:"pool,omitempty"`
+}
+
+func (m *QuerySponsorshipPoolResponse) Reset()         { *m = QuerySponsorshipPoolResponse{} }
+func (m *QuerySponsorshipPoolResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySponsorshipPoolResponse) ProtoMessage()    {}
+func (*QuerySponsorshipPoolResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_59efc303945de53f, []int{6}
+}
+func (m *QuerySponsorshipPoolResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySponsorshipPoolResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySponsorshipPoolResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySponsorshipPoolResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySponsorshipPoolResponse.Merge(m, src)
+}
+func (m *QuerySponsorshipPoolResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySponsorshipPoolResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySponsorshipPoolResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySponsorshipPoolResponse proto.InternalMessageInfo
+
+func (m *QuerySponsorshipPoolResponse) GetPool() *SponsorshipPool {
+	if m != nil {
+		return m.Pool
+	}
+	return nil
+}
+
+// QuerySponsorshipPoolUsageRequest is the request type for the Query/SponsorshipPoolUsage RPC method.
+type QuerySponsorshipPoolUsageRequest struct {
+	// pool is the address of the pool.
+	Pool string `protobuf:"bytes,1,opt,name=pool,proto3" json:"pool,omitempty"`
+	// user is the address of the user.
+	User string `protobuf:"bytes,2,opt,name=user,proto3" json:"user,omitempty"`
+}
+
+func (m *QuerySponsorshipPoolUsageRequest) Reset()         { *m = QuerySponsorshipPoolUsageRequest{} }
+func (m *QuerySponsorshipPoolUsageRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySponsorshipPoolUsageRequest) ProtoMessage()    {}
+func (*QuerySponsorshipPoolUsageRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_59efc303945de53f, []int{7}
+}
+func (m *QuerySponsorshipPoolUsageRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySponsorshipPoolUsageRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySponsorshipPoolUsageRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySponsorshipPoolUsageRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySponsorshipPoolUsageRequest.Merge(m, src)
+}
+func (m *QuerySponsorshipPoolUsageRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySponsorshipPoolUsageRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySponsorshipPoolUsageRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySponsorshipPoolUsageRequest proto.InternalMessageInfo
+
+func (m *QuerySponsorshipPoolUsageRequest) GetPool() string {
+	if m != nil {
+		return m.Pool
+	}
+	return ""
+}
+
+func (m *QuerySponsorshipPoolUsageRequest) GetUser() string {
+	if m != nil {
+		return m.User
+	}
+	return ""
+}
+
+// QuerySponsorshipPoolUsageResponse is the response type for the Query/SponsorshipPoolUsage RPC method.
+type QuerySponsorshipPoolUsageResponse struct {
+	// usage is the usage of the pool by the user, empty if the pool never paid
+	// fees for the user.
+	Usage *SponsorshipPoolUsage `protobuf:"bytes,1,opt,name=usage,proto3" json:"usage,omitempty"`
+}
+
+func (m *QuerySponsorshipPoolUsageResponse) Reset()         { *m = QuerySponsorshipPoolUsageResponse{} }
+func (m *QuerySponsorshipPoolUsageResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySponsorshipPoolUsageResponse) ProtoMessage()    {}
+func (*QuerySponsorshipPoolUsageResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_59efc303945de53f, []int{8}
+}
+func (m *QuerySponsorshipPoolUsageResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySponsorshipPoolUsageResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySponsorshipPoolUsageResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySponsorshipPoolUsageResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySponsorshipPoolUsageResponse.Merge(m, src)
+}
+func (m *QuerySponsorshipPoolUsageResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySponsorshipPoolUsageResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySponsorshipPoolUsageResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySponsorshipPoolUsageResponse proto.InternalMessageInfo
+
+func (m *QuerySponsorshipPoolUsageResponse) GetUsage() *SponsorshipPoolUsage {
+	if m != nil {
+		return m.Usage
+	}
+	return nil
+}
+
 // QueryAllowancesByGranterResponse is the response type for the Query/AllowancesByGranter RPC method.
 //
 // Since: cosmos-sdk 0.46
@@ -310,7 +503,7 @@ func (m *QueryAllowancesByGranterResponse) Reset()         { *m = QueryAllowance
 func (m *QueryAllowancesByGranterResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryAllowancesByGranterResponse) ProtoMessage()    {}
 func (*QueryAllowancesByGranterResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_59efc303945de53f, []int{5}
+	return fileDescriptor_59efc303945de53f, []int{9}
 }
 func (m *QueryAllowancesByGranterResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -359,6 +552,10 @@ func init() {
 	proto.RegisterType((*QueryAllowancesRequest)(nil), "cosmos.feegrant.v1beta1.QueryAllowancesRequest")
 	proto.RegisterType((*QueryAllowancesResponse)(nil), "cosmos.feegrant.v1beta1.QueryAllowancesResponse")
 	proto.RegisterType((*QueryAllowancesByGranterRequest)(nil), "cosmos.feegrant.v1beta1.QueryAllowancesByGranterRequest")
+	proto.RegisterType((*QuerySponsorshipPoolRequest)(nil), "cosmos.feegrant.v1beta1.QuerySponsorshipPoolRequest")
+	proto.RegisterType((*QuerySponsorshipPoolResponse)(nil), "cosmos.feegrant.v1beta1.QuerySponsorshipPoolResponse")
+	proto.RegisterType((*QuerySponsorshipPoolUsageRequest)(nil), "cosmos.feegrant.v1beta1.QuerySponsorshipPoolUsageRequest")
+	proto.RegisterType((*QuerySponsorshipPoolUsageResponse)(nil), "cosmos.feegrant.v1beta1.QuerySponsorshipPoolUsageResponse")
 	proto.RegisterType((*QueryAllowancesByGranterResponse)(nil), "cosmos.feegrant.v1beta1.QueryAllowancesByGranterResponse")
 }
 
@@ -367,40 +564,50 @@ func init() {
 }
 
 var fileDescriptor_59efc303945de53f = []byte{
-	// 516 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x95, 0xbd, 0x8b, 0x13, 0x41,
-	0x18, 0xc6, 0x33, 0xe7, 0x17, 0x79, 0xaf, 0x1b, 0x3f, 0x2e, 0x2e, 0xb2, 0x86, 0x15, 0xce, 0x2f,
-	0x6e, 0xc6, 0x44, 0x94, 0x13, 0xe4, 0xe0, 0x52, 0x98, 0x56, 0x23, 0x58, 0xd8, 0xc8, 0xe4, 0xf2,
-	0xba, 0x2c, 0xc6, 0x99, 0xdc, 0xce, 0x46, 0x3d, 0xe4, 0x10, 0xfc, 0x0b, 0x04, 0x6d, 0x45, 0xb0,
-	0xb0, 0xd1, 0xd2, 0xd6, 0xde, 0xf2, 0xd0, 0xc6, 0x52, 0x12, 0xff, 0x10, 0xc9, 0xec, 0xcc, 0x6e,
-	0xcc, 0x65, 0xc9, 0xa2, 0x16, 0xd7, 0x65, 0xb2, 0xcf, 0xf3, 0xee, 0xef, 0x79, 0xdf, 0x99, 0x59,
-	0x38, 0xb7, 0xa5, 0xf4, 0x63, 0xa5, 0xf9, 0x43, 0xc4, 0x30, 0x16, 0x32, 0xe1, 0x4f, 0x1a, 0x5d,
-	0x4c, 0x44, 0x83, 0x6f, 0x0f, 0x31, 0xde, 0x61, 0x83, 0x58, 0x25, 0x8a, 0xae, 0xa4, 0x22, 0xe6,
-	0x44, 0xcc, 0x8a, 0xbc, 0xd5, 0x22, 0x77, 0xa6, 0x34, 0x05, 0xbc, 0x4b, 0x56, 0xd7, 0x15, 0x1a,
-	0xd3, 0xca, 0x99, 0x72, 0x20, 0xc2, 0x48, 0x8a, 0x24, 0x52, 0xd2, 0x6a, 0xcf, 0x84, 0x4a, 0x85,
-	0x7d, 0xe4, 0x62, 0x10, 0x71, 0x21, 0xa5, 0x4a, 0xcc, 0x43, 0x6d, 0x9f, 0x9e, 0x4e, 0x2b, 0x3d,
-	0x30, 0x2b, 0x6e, 0xb9, 0xcc, 0x22, 0x78, 0x01, 0x27, 0xef, 0x4c, 0x4a, 0x6f, 0xf6, 0xfb, 0xea,
-	0xa9, 0x90, 0x5b, 0xd8, 0xc1, 0xed, 0x21, 0xea, 0x84, 0x36, 0xe1, 0x98, 0x81, 0xc1, 0xb8, 0x46,
-	0xea, 0xe4, 0x42, 0xb5, 0x55, 0xfb, 0xf6, 0x79, 0xed, 0x84, 0xf5, 0x6e, 0xf6, 0x7a, 0x31, 0x6a,
-	0x7d, 0x37, 0x89, 0x23, 0x19, 0x76, 0x9c, 0x30, 0xf7, 0x60, 0x6d, 0xa9, 0x9c, 0x07, 0x83, 0x7b,
-	0x70, 0x6a, 0x16, 0x40, 0x0f, 0x94, 0xd4, 0x48, 0x6f, 0x42, 0x55, 0xb8, 0x3f, 0x0d, 0xc3, 0x72,
-	0xd3, 0x67, 0x05, 0x4d, 0x65, 0xed, 0xc9, 0xaa, 0x93, 0x1b, 0x82, 0x37, 0x64, 0xb6, 0xb0, 0xde,
-	0x17, 0x0d, 0xcb, 0x46, 0x43, 0x7a, 0x0b, 0x20, 0x6f, 0xba, 0x49, 0xb7, 0xdc, 0x5c, 0x75, 0x34,
-	0x93, 0x09, 0xb1, 0x74, 0xf6, 0x8e, 0xe7, 0xb6, 0x08, 0x5d, 0x2b, 0x3b, 0x53, 0xce, 0xe0, 0x3d,
-	0x81, 0x95, 0x7d, 0x58, 0x36, 0xf0, 0x06, 0x40, 0xc6, 0xaf, 0x6b, 0xa4, 0x7e, 0xa8, 0x44, 0xe2,
-	0x29, 0x07, 0x6d, 0xcf, 0x61, 0x3c, 0xbf, 0x90, 0x31, 0x7d, 0xf9, 0x1f, 0x90, 0x6f, 0x09, 0x9c,
-	0x9d, 0x81, 0x6c, 0xed, 0xb4, 0xd3, 0x21, 0xff, 0xcb, 0xfe, 0xf8, 0x5f, 0x4d, 0xfc, 0x48, 0xa0,
-	0x5e, 0xcc, 0x77, 0xc0, 0xba, 0xd9, 0x7c, 0x77, 0x18, 0x8e, 0x18, 0x5a, 0xfa, 0x89, 0x40, 0x35,
-	0x43, 0xa6, 0xac, 0x10, 0x66, 0xee, 0x89, 0xf4, 0x78, 0x69, 0x7d, 0x0a, 0x11, 0x6c, 0xbc, 0xfc,
-	0xfe, 0xeb, 0xf5, 0xd2, 0x3a, 0xbd, 0xce, 0x8b, 0x6e, 0x9c, 0x2c, 0x2e, 0x7f, 0x6e, 0x67, 0xb4,
-	0xeb, 0x7e, 0xe1, 0x2e, 0xfd, 0x40, 0x00, 0xf2, 0x0e, 0xd3, 0xb2, 0xef, 0x77, 0xe7, 0xcc, 0xbb,
-	0x52, 0xde, 0x60, 0x89, 0xaf, 0x19, 0x62, 0x4e, 0xd7, 0x16, 0x13, 0xeb, 0x29, 0xd0, 0x2f, 0x04,
-	0x8e, 0xcf, 0xd9, 0x0a, 0x74, 0xbd, 0x2c, 0xc0, 0xec, 0xee, 0xf6, 0x6e, 0xfc, 0x85, 0xd3, 0x66,
-	0x68, 0x98, 0x0c, 0x97, 0xe9, 0xc5, 0xc2, 0x0c, 0x91, 0xd6, 0x43, 0xec, 0xe5, 0x2d, 0x6f, 0x35,
-	0xbe, 0x8e, 0x7c, 0xb2, 0x37, 0xf2, 0xc9, 0xcf, 0x91, 0x4f, 0x5e, 0x8d, 0xfd, 0xca, 0xde, 0xd8,
-	0xaf, 0xfc, 0x18, 0xfb, 0x95, 0xfb, 0xf6, 0x23, 0xa2, 0x7b, 0x8f, 0x58, 0xa4, 0xf8, 0xb3, 0xac,
-	0x56, 0xf7, 0xa8, 0xb9, 0xbe, 0xaf, 0xfe, 0x0e, 0x00, 0x00, 0xff, 0xff, 0x3d, 0x3a, 0x28, 0x67,
-	0x8b, 0x06, 0x00, 0x00,
+	// 688 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x96, 0x4f, 0x4f, 0xd4, 0x40,
+	0x18, 0xc6, 0x19, 0x14, 0x09, 0x2f, 0x07, 0x93, 0x11, 0x65, 0xad, 0xa4, 0xae, 0x35, 0x41, 0xfc,
+	0x43, 0xc7, 0x5d, 0x81, 0x80, 0x21, 0x18, 0x30, 0x71, 0xaf, 0xb0, 0x44, 0x0f, 0xc6, 0x84, 0x14,
+	0x76, 0x2c, 0x8d, 0x6b, 0xa7, 0x74, 0xba, 0x2a, 0x21, 0xab, 0x89, 0x9f, 0xc0, 0x44, 0xaf, 0x5e,
+	0x3c, 0x78, 0xd1, 0xa3, 0x89, 0x17, 0xbd, 0x7b, 0x24, 0x9a, 0x18, 0x8f, 0x06, 0xfc, 0x20, 0xa6,
+	0xd3, 0xe9, 0x1f, 0x4b, 0xbb, 0xdb, 0x45, 0x0f, 0x9e, 0xa0, 0xed, 0xf3, 0xbc, 0xf3, 0x7b, 0x66,
+	0xde, 0xbe, 0x5b, 0x38, 0xbf, 0xc1, 0xf8, 0x43, 0xc6, 0xc9, 0x7d, 0x4a, 0x4d, 0xd7, 0xb0, 0x3d,
+	0xf2, 0xa8, 0xb2, 0x4e, 0x3d, 0xa3, 0x42, 0xb6, 0x5a, 0xd4, 0xdd, 0xd6, 0x1d, 0x97, 0x79, 0x0c,
+	0x8f, 0x06, 0x22, 0x3d, 0x14, 0xe9, 0x52, 0xa4, 0x8c, 0xe7, 0xb9, 0x23, 0xa5, 0x28, 0xa0, 0x5c,
+	0x92, 0xba, 0x75, 0x83, 0xd3, 0xa0, 0x72, 0xa4, 0x74, 0x0c, 0xd3, 0xb2, 0x0d, 0xcf, 0x62, 0xb6,
+	0xd4, 0x8e, 0x99, 0x8c, 0x99, 0x4d, 0x4a, 0x0c, 0xc7, 0x22, 0x86, 0x6d, 0x33, 0x4f, 0x3c, 0xe4,
+	0xf2, 0xe9, 0xe9, 0xa0, 0xd2, 0x9a, 0xb8, 0x22, 0x92, 0x4b, 0x5c, 0x68, 0xcf, 0xe0, 0xe4, 0x8a,
+	0x5f, 0x7a, 0xb1, 0xd9, 0x64, 0x8f, 0x0d, 0x7b, 0x83, 0xd6, 0xe9, 0x56, 0x8b, 0x72, 0x0f, 0x57,
+	0x61, 0x50, 0xc0, 0x50, 0xb7, 0x84, 0xca, 0x68, 0x62, 0x68, 0xa9, 0xf4, 0xf5, 0xc3, 0xe4, 0x88,
+	0xf4, 0x2e, 0x36, 0x1a, 0x2e, 0xe5, 0x7c, 0xd5, 0x73, 0x2d, 0xdb, 0xac, 0x87, 0xc2, 0xd8, 0x43,
+	0x4b, 0xfd, 0xc5, 0x3c, 0x54, 0xbb, 0x03, 0xa7, 0xd2, 0x00, 0xdc, 0x61, 0x36, 0xa7, 0x78, 0x1e,
+	0x86, 0x8c, 0xf0, 0xa6, 0x60, 0x18, 0xae, 0xaa, 0x7a, 0xce, 0xa6, 0xea, 0x35, 0xff, 0xaa, 0x1e,
+	0x1b, 0xb4, 0x57, 0x28, 0x5d, 0x98, 0x1f, 0x88, 0x46, 0x8b, 0x46, 0xa3, 0xf8, 0x16, 0x40, 0xbc,
+	0xe9, 0x22, 0xdd, 0x70, 0x75, 0x3c, 0xa4, 0xf1, 0x4f, 0x48, 0x0f, 0xce, 0x3e, 0xe4, 0x59, 0x36,
+	0xcc, 0x70, 0x2b, 0xeb, 0x09, 0xa7, 0xf6, 0x06, 0xc1, 0xe8, 0x01, 0x2c, 0x19, 0x78, 0x01, 0x20,
+	0xe2, 0xe7, 0x25, 0x54, 0x3e, 0x52, 0x20, 0x71, 0xc2, 0x81, 0x6b, 0x19, 0x8c, 0x17, 0xba, 0x32,
+	0x06, 0x8b, 0xff, 0x01, 0xf9, 0x1a, 0xc1, 0xd9, 0x14, 0xe4, 0xd2, 0x76, 0x2d, 0x38, 0xe4, 0xbf,
+	0xe9, 0x8f, 0x7f, 0xb5, 0x89, 0x2b, 0x70, 0x46, 0xe0, 0xad, 0xfa, 0xe8, 0xcc, 0xe5, 0x9b, 0x96,
+	0xb3, 0xcc, 0x58, 0x33, 0x81, 0x66, 0x04, 0x00, 0xdd, 0xd1, 0xa4, 0x50, 0xbb, 0x07, 0x63, 0xd9,
+	0x25, 0xa3, 0x66, 0x3c, 0xea, 0x30, 0xd6, 0x94, 0x7d, 0x38, 0x91, 0x7b, 0x2a, 0x69, 0xbf, 0x70,
+	0x69, 0x4f, 0xa1, 0x9c, 0x55, 0xfd, 0x36, 0x8f, 0x03, 0xe2, 0x2b, 0x89, 0x15, 0x3a, 0x21, 0x0b,
+	0x95, 0xaf, 0x6e, 0x71, 0xea, 0x76, 0x7d, 0xcf, 0x84, 0x4a, 0xdb, 0x84, 0x73, 0x1d, 0xd6, 0x97,
+	0x11, 0x6f, 0xc2, 0x40, 0xcb, 0xbf, 0x21, 0x33, 0x4e, 0x16, 0xcd, 0x18, 0x54, 0x09, 0xbc, 0xda,
+	0x3b, 0x04, 0xe5, 0xfc, 0xd6, 0xf9, 0xcf, 0x1a, 0xbd, 0xfa, 0x71, 0x10, 0x06, 0x04, 0x2d, 0x7e,
+	0x8f, 0x60, 0x28, 0x42, 0xc6, 0x7a, 0x2e, 0x4c, 0xe6, 0xb0, 0x54, 0x48, 0x61, 0x7d, 0x00, 0xa1,
+	0x2d, 0x3c, 0xff, 0xf6, 0xeb, 0x65, 0xff, 0x2c, 0x9e, 0x21, 0x79, 0x3f, 0x06, 0x51, 0x5c, 0xb2,
+	0x23, 0x5f, 0x9f, 0x76, 0xf8, 0x1f, 0x6d, 0xe3, 0xb7, 0x08, 0x20, 0xde, 0x61, 0x5c, 0x74, 0xfd,
+	0x70, 0x04, 0x2a, 0x57, 0x8b, 0x1b, 0x24, 0xf1, 0xb4, 0x20, 0x26, 0x78, 0xb2, 0x3b, 0x31, 0x4f,
+	0x80, 0x7e, 0x46, 0x70, 0x22, 0xa3, 0x15, 0xf0, 0x6c, 0x51, 0x80, 0xf4, 0xe0, 0x51, 0xe6, 0x0e,
+	0xe1, 0x94, 0x19, 0x2a, 0x22, 0xc3, 0x65, 0x7c, 0x31, 0x37, 0x83, 0xc5, 0x79, 0x8b, 0x36, 0xe2,
+	0x2d, 0xc7, 0x9f, 0x10, 0x1c, 0x4f, 0xf5, 0x3b, 0x9e, 0xea, 0x4c, 0x90, 0x3d, 0x95, 0x94, 0xe9,
+	0x1e, 0x5d, 0x92, 0x79, 0x5e, 0x30, 0xcf, 0xe0, 0xa9, 0x5c, 0x66, 0x1e, 0x3b, 0xd7, 0xfc, 0xd9,
+	0xc0, 0xc9, 0x8e, 0x9c, 0x6a, 0x6d, 0xfc, 0x1d, 0xc1, 0x48, 0xd6, 0xeb, 0x8a, 0xe7, 0x7a, 0xa2,
+	0x49, 0x0e, 0x2a, 0xe5, 0xfa, 0x61, 0xac, 0x32, 0x4d, 0x4d, 0xa4, 0x59, 0xc4, 0x37, 0x7a, 0x49,
+	0xe3, 0xff, 0x69, 0x13, 0x31, 0x5f, 0x38, 0xd9, 0xf1, 0x07, 0x5a, 0x7b, 0xa9, 0xf2, 0x65, 0x4f,
+	0x45, 0xbb, 0x7b, 0x2a, 0xfa, 0xb9, 0xa7, 0xa2, 0x17, 0xfb, 0x6a, 0xdf, 0xee, 0xbe, 0xda, 0xf7,
+	0x63, 0x5f, 0xed, 0xbb, 0x2b, 0xbf, 0xbb, 0x78, 0xe3, 0x81, 0x6e, 0x31, 0xf2, 0x24, 0x5a, 0x61,
+	0xfd, 0x98, 0xf8, 0xe2, 0xb9, 0xf6, 0x7b, 0x00, 0x7b, 0xe4, 0x7a, 0x0f, 0xbe, 0x09, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -423,6 +630,10 @@ type QueryClient interface {
 	//
 	// Since: cosmos-sdk 0.46
 	AllowancesByGranter(ctx context.Context, in *QueryAllowancesByGranterRequest, opts ...grpc.CallOption) (*QueryAllowancesByGranterResponse, error)
+	// SponsorshipPool returns a sponsorship pool by its address.
+	SponsorshipPool(ctx context.Context, in *QuerySponsorshipPoolRequest, opts ...grpc.CallOption) (*QuerySponsorshipPoolResponse, error)
+	// SponsorshipPoolUsage returns the fees paid by a sponsorship pool for a user.
+	SponsorshipPoolUsage(ctx context.Context, in *QuerySponsorshipPoolUsageRequest, opts ...grpc.CallOption) (*QuerySponsorshipPoolUsageResponse, error)
 }
 
 type queryClient struct {
@@ -460,6 +671,24 @@ func (c *queryClient) AllowancesByGranter(ctx context.Context, in *QueryAllowanc
 	return out, nil
 }
 
+func (c *queryClient) SponsorshipPool(ctx context.Context, in *QuerySponsorshipPoolRequest, opts ...grpc.CallOption) (*QuerySponsorshipPoolResponse, error) {
+	out := new(QuerySponsorshipPoolResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.feegrant.v1beta1.Query/SponsorshipPool", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SponsorshipPoolUsage(ctx context.Context, in *QuerySponsorshipPoolUsageRequest, opts ...grpc.CallOption) (*QuerySponsorshipPoolUsageResponse, error) {
+	out := new(QuerySponsorshipPoolUsageResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.feegrant.v1beta1.Query/SponsorshipPoolUsage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Allowance returns granted allwance to the grantee by the granter.
@@ -470,6 +699,10 @@ type QueryServer interface {
 	//
 	// Since: cosmos-sdk 0.46
 	AllowancesByGranter(context.Context, *QueryAllowancesByGranterRequest) (*QueryAllowancesByGranterResponse, error)
+	// SponsorshipPool returns a sponsorship pool by its address.
+	SponsorshipPool(context.Context, *QuerySponsorshipPoolRequest) (*QuerySponsorshipPoolResponse, error)
+	// SponsorshipPoolUsage returns the fees paid by a sponsorship pool for a user.
+	SponsorshipPoolUsage(context.Context, *QuerySponsorshipPoolUsageRequest) (*QuerySponsorshipPoolUsageResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -485,6 +718,12 @@ func (*UnimplementedQueryServer) Allowances(ctx context.Context, req *QueryAllow
 func (*UnimplementedQueryServer) AllowancesByGranter(ctx context.Context, req *QueryAllowancesByGranterRequest) (*QueryAllowancesByGranterResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AllowancesByGranter not implemented")
 }
+func (*UnimplementedQueryServer) SponsorshipPool(ctx context.Context, req *QuerySponsorshipPoolRequest) (*QuerySponsorshipPoolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SponsorshipPool not implemented")
+}
+func (*UnimplementedQueryServer) SponsorshipPoolUsage(ctx context.Context, req *QuerySponsorshipPoolUsageRequest) (*QuerySponsorshipPoolUsageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SponsorshipPoolUsage not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -544,6 +783,42 @@ func _Query_AllowancesByGranter_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_SponsorshipPool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySponsorshipPoolRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SponsorshipPool(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.feegrant.v1beta1.Query/SponsorshipPool",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SponsorshipPool(ctx, req.(*QuerySponsorshipPoolRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SponsorshipPoolUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySponsorshipPoolUsageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SponsorshipPoolUsage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.feegrant.v1beta1.Query/SponsorshipPoolUsage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SponsorshipPoolUsage(ctx, req.(*QuerySponsorshipPoolUsageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.feegrant.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -560,6 +835,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "AllowancesByGranter",
 			Handler:    _Query_AllowancesByGranter_Handler,
 		},
+		{
+			MethodName: "SponsorshipPool",
+			Handler:    _Query_SponsorshipPool_Handler,
+		},
+		{
+			MethodName: "SponsorshipPoolUsage",
+			Handler:    _Query_SponsorshipPoolUsage_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/feegrant/v1beta1/query.proto",
@@ -770,7 +1053,7 @@ func (m *QueryAllowancesByGranterRequest) MarshalToSizedBuffer(dAtA []byte) (int
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryAllowancesByGranterResponse) Marshal() (dAtA []byte, err error) {
+func (m *QuerySponsorshipPoolRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -780,19 +1063,49 @@ func (m *QueryAllowancesByGranterResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryAllowancesByGranterResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QuerySponsorshipPoolRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAllowancesByGranterResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QuerySponsorshipPoolRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySponsorshipPoolResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySponsorshipPoolResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySponsorshipPoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pool != nil {
 		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Pool.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -800,69 +1113,176 @@ func (m *QueryAllowancesByGranterResponse) MarshalToSizedBuffer(dAtA []byte) (in
 			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Allowances) > 0 {
-		for iNdEx := len(m.Allowances) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Allowances[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QuerySponsorshipPoolUsageRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryAllowanceRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *QuerySponsorshipPoolUsageRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySponsorshipPoolUsageRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Granter)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.User) > 0 {
+		i -= len(m.User)
+		copy(dAtA[i:], m.User)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.User)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.Grantee)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Pool) > 0 {
+		i -= len(m.Pool)
+		copy(dAtA[i:], m.Pool)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Pool)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryAllowanceResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Allowance != nil {
-		l = m.Allowance.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QuerySponsorshipPoolUsageResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryAllowancesRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QuerySponsorshipPoolUsageResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySponsorshipPoolUsageResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Usage != nil {
+		{
+			size, err := m.Usage.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllowancesByGranterResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllowancesByGranterResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllowancesByGranterResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Allowances) > 0 {
+		for iNdEx := len(m.Allowances) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Allowances[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryAllowanceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Granter)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Grantee)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllowanceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Allowance != nil {
+		l = m.Allowance.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllowancesRequest) Size() (n int) {
+	if m == nil {
+		return 0
 	}
 	var l int
 	_ = l
@@ -913,6 +1333,62 @@ func (m *QueryAllowancesByGranterRequest) Size() (n int) {
 	return n
 }
 
+func (m *QuerySponsorshipPoolRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySponsorshipPoolResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pool != nil {
+		l = m.Pool.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySponsorshipPoolUsageRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Pool)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.User)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySponsorshipPoolUsageResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Usage != nil {
+		l = m.Usage.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func (m *QueryAllowancesByGranterResponse) Size() (n int) {
 	if m == nil {
 		return 0
@@ -925,20 +1401,220 @@ func (m *QueryAllowancesByGranterResponse) Size() (n int) {
 			n += 1 + l + sovQuery(uint64(l))
 		}
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryAllowanceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllowanceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllowanceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Granter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Granter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Grantee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllowanceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllowanceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllowanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Allowance == nil {
+				m.Allowance = &Grant{}
+			}
+			if err := m.Allowance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryAllowanceRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAllowancesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -961,15 +1637,15 @@ func (m *QueryAllowanceRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllowanceRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllowancesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllowanceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllowancesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Granter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -997,13 +1673,13 @@ func (m *QueryAllowanceRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Granter = string(dAtA[iNdEx:postIndex])
+			m.Grantee = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1013,23 +1689,27 @@ func (m *QueryAllowanceRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Grantee = string(dAtA[iNdEx:postIndex])
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1052,7 +1732,7 @@ func (m *QueryAllowanceRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllowanceResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAllowancesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1075,15 +1755,15 @@ func (m *QueryAllowanceResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllowanceResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllowancesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllowanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllowancesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Allowance", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowances", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1110,10 +1790,44 @@ func (m *QueryAllowanceResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Allowance == nil {
-				m.Allowance = &Grant{}
+			m.Allowances = append(m.Allowances, &Grant{})
+			if err := m.Allowances[len(m.Allowances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if err := m.Allowance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1138,7 +1852,7 @@ func (m *QueryAllowanceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllowancesRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAllowancesByGranterRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1161,15 +1875,15 @@ func (m *QueryAllowancesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllowancesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllowancesByGranterRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllowancesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllowancesByGranterRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Granter", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1197,7 +1911,7 @@ func (m *QueryAllowancesRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Grantee = string(dAtA[iNdEx:postIndex])
+			m.Granter = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -1256,7 +1970,7 @@ func (m *QueryAllowancesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllowancesResponse) Unmarshal(dAtA []byte) error {
+func (m *QuerySponsorshipPoolRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1279,17 +1993,17 @@ func (m *QueryAllowancesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllowancesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySponsorshipPoolRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllowancesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySponsorshipPoolRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Allowances", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1299,29 +2013,77 @@ func (m *QueryAllowancesResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Allowances = append(m.Allowances, &Grant{})
-			if err := m.Allowances[len(m.Allowances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 2:
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySponsorshipPoolResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySponsorshipPoolResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySponsorshipPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pool", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1348,10 +2110,10 @@ func (m *QueryAllowancesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
+			if m.Pool == nil {
+				m.Pool = &SponsorshipPool{}
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Pool.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1376,7 +2138,7 @@ func (m *QueryAllowancesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllowancesByGranterRequest) Unmarshal(dAtA []byte) error {
+func (m *QuerySponsorshipPoolUsageRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1399,15 +2161,15 @@ func (m *QueryAllowancesByGranterRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllowancesByGranterRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySponsorshipPoolUsageRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllowancesByGranterRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySponsorshipPoolUsageRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Granter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pool", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1435,11 +2197,93 @@ func (m *QueryAllowancesByGranterRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Granter = string(dAtA[iNdEx:postIndex])
+			m.Pool = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field User", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.User = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySponsorshipPoolUsageResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySponsorshipPoolUsageResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySponsorshipPoolUsageResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Usage", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1466,10 +2310,10 @@ func (m *QueryAllowancesByGranterRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
+			if m.Usage == nil {
+				m.Usage = &SponsorshipPoolUsage{}
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Usage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
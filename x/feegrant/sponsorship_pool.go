@@ -0,0 +1,121 @@
+package feegrant
+
+import (
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// NewMsgCreateSponsorshipPool creates a new MsgCreateSponsorshipPool.
+func NewMsgCreateSponsorshipPool(
+	admin sdk.AccAddress, allowedMsgs []string, userSpendLimit sdk.Coins,
+	period time.Duration, userPeriodSpendLimit, funds sdk.Coins,
+) *MsgCreateSponsorshipPool {
+	return &MsgCreateSponsorshipPool{
+		Admin:                admin.String(),
+		AllowedMessages:      allowedMsgs,
+		UserSpendLimit:       userSpendLimit,
+		Period:               period,
+		UserPeriodSpendLimit: userPeriodSpendLimit,
+		Funds:                funds,
+	}
+}
+
+// NewMsgCloseSponsorshipPool creates a new MsgCloseSponsorshipPool.
+func NewMsgCloseSponsorshipPool(admin, pool sdk.AccAddress) *MsgCloseSponsorshipPool {
+	return &MsgCloseSponsorshipPool{
+		Admin:   admin.String(),
+		Address: pool.String(),
+	}
+}
+
+// Accept checks that the messages are allowed by the pool and that the fee
+// is within the limits of the pool for the user, and deducts it from the
+// usage of the user. The period of the usage is reset if it ended.
+func (p SponsorshipPool) Accept(ctx sdk.Context, usage *SponsorshipPoolUsage, fee sdk.Coins, msgs []sdk.Msg) error {
+	if !p.allMsgTypesAllowed(ctx, msgs) {
+		return errorsmod.Wrap(ErrMessageNotAllowed, "message does not exist in allowed messages")
+	}
+
+	usage.tryResetPeriod(ctx.BlockTime(), p.Period)
+
+	spent := usage.Spent.Add(fee...)
+	if !p.UserSpendLimit.Empty() && !spent.IsAllLTE(p.UserSpendLimit) {
+		return errorsmod.Wrap(ErrFeeLimitExceeded, "user spend limit")
+	}
+
+	periodSpent := usage.PeriodSpent.Add(fee...)
+	if !p.UserPeriodSpendLimit.Empty() && !periodSpent.IsAllLTE(p.UserPeriodSpendLimit) {
+		return errorsmod.Wrap(ErrFeeLimitExceeded, "user period spend limit")
+	}
+
+	usage.Spent = spent
+	usage.PeriodSpent = periodSpent
+
+	return nil
+}
+
+func (p SponsorshipPool) allMsgTypesAllowed(ctx sdk.Context, msgs []sdk.Msg) bool {
+	msgsMap := make(map[string]bool, len(p.AllowedMessages))
+	for _, msg := range p.AllowedMessages {
+		ctx.GasMeter().ConsumeGas(gasCostPerIteration, "check msg")
+		msgsMap[msg] = true
+	}
+
+	for _, msg := range msgs {
+		ctx.GasMeter().ConsumeGas(gasCostPerIteration, "check msg")
+		if !msgsMap[sdk.MsgTypeURL(msg)] {
+			return false
+		}
+	}
+
+	return true
+}
+
+// tryResetPeriod starts a new period if the current one ended, following the
+// same rules as PeriodicAllowance. It is a no-op if the pool has no period.
+func (u *SponsorshipPoolUsage) tryResetPeriod(blockTime time.Time, period time.Duration) {
+	if period == 0 || blockTime.Before(u.PeriodReset) {
+		return
+	}
+
+	u.PeriodSpent = nil
+
+	u.PeriodReset = u.PeriodReset.Add(period)
+	if blockTime.After(u.PeriodReset) {
+		u.PeriodReset = blockTime.Add(period)
+	}
+}
+
+// ValidateBasic performs basic sanity checks on the pool parameters.
+func (p SponsorshipPool) ValidateBasic() error {
+	if len(p.AllowedMessages) == 0 {
+		return errorsmod.Wrap(ErrNoMessages, "allowed messages shouldn't be empty")
+	}
+
+	if !p.UserSpendLimit.IsValid() {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "user spend limit is invalid: %s", p.UserSpendLimit)
+	}
+
+	if !p.UserPeriodSpendLimit.IsValid() {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "user period spend limit is invalid: %s", p.UserPeriodSpendLimit)
+	}
+
+	if p.Period < 0 {
+		return errorsmod.Wrap(ErrInvalidDuration, "negative clock step")
+	}
+
+	if !p.UserPeriodSpendLimit.Empty() && p.Period == 0 {
+		return errorsmod.Wrap(ErrInvalidDuration, "period must be set with a user period spend limit")
+	}
+
+	// ensure UserPeriodSpendLimit can be compared with the total limit
+	if !p.UserSpendLimit.Empty() && !p.UserPeriodSpendLimit.DenomsSubsetOf(p.UserSpendLimit) {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, "user period spend limit has different currency than user spend limit")
+	}
+
+	return nil
+}
@@ -0,0 +1,108 @@
+package feegrant_test
+
+import (
+	"testing"
+	"time"
+
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/stretchr/testify/require"
+
+	storetypes "cosmossdk.io/store/types"
+	"cosmossdk.io/x/feegrant"
+
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func TestSponsorshipPoolAccept(t *testing.T) {
+	key := storetypes.NewKVStoreKey(feegrant.StoreKey)
+	testCtx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test"))
+	ctx := testCtx.Ctx.WithBlockHeader(cmtproto.Header{Time: time.Now()})
+
+	pool := feegrant.SponsorshipPool{
+		AllowedMessages:      []string{sdk.MsgTypeURL(&banktypes.MsgSend{})},
+		UserSpendLimit:       sdk.NewCoins(sdk.NewInt64Coin("atom", 100)),
+		Period:               time.Hour,
+		UserPeriodSpendLimit: sdk.NewCoins(sdk.NewInt64Coin("atom", 30)),
+	}
+	require.NoError(t, pool.ValidateBasic())
+
+	send := []sdk.Msg{&banktypes.MsgSend{}}
+	fee := sdk.NewCoins(sdk.NewInt64Coin("atom", 20))
+	usage := &feegrant.SponsorshipPoolUsage{}
+
+	// messages must be allowed
+	err := pool.Accept(ctx, usage, fee, []sdk.Msg{&banktypes.MsgMultiSend{}})
+	require.ErrorIs(t, err, feegrant.ErrMessageNotAllowed)
+
+	require.NoError(t, pool.Accept(ctx, usage, fee, send))
+	require.Equal(t, fee, usage.Spent)
+	require.Equal(t, fee, usage.PeriodSpent)
+	require.Equal(t, ctx.BlockTime().Add(time.Hour), usage.PeriodReset)
+
+	// the period limit is reached
+	err = pool.Accept(ctx, usage, fee, send)
+	require.ErrorIs(t, err, feegrant.ErrFeeLimitExceeded)
+	require.Equal(t, fee, usage.Spent)
+
+	// the period limit is reset in the next period, up to the total limit
+	for i := 1; i <= 5; i++ {
+		ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Hour))
+		err = pool.Accept(ctx, usage, fee, send)
+		if i < 5 {
+			require.NoError(t, err)
+			require.Equal(t, fee, usage.PeriodSpent)
+		} else {
+			require.ErrorIs(t, err, feegrant.ErrFeeLimitExceeded)
+		}
+	}
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("atom", 100)), usage.Spent)
+
+	// fees in other denoms are not covered by the limits
+	err = pool.Accept(ctx, &feegrant.SponsorshipPoolUsage{}, sdk.NewCoins(sdk.NewInt64Coin("eth", 1)), send)
+	require.ErrorIs(t, err, feegrant.ErrFeeLimitExceeded)
+}
+
+func TestSponsorshipPoolValidateBasic(t *testing.T) {
+	atom := sdk.NewCoins(sdk.NewInt64Coin("atom", 100))
+	msgs := []string{sdk.MsgTypeURL(&banktypes.MsgSend{})}
+
+	cases := map[string]struct {
+		pool  feegrant.SponsorshipPool
+		valid bool
+	}{
+		"no limits": {
+			pool:  feegrant.SponsorshipPool{AllowedMessages: msgs},
+			valid: true,
+		},
+		"no allowed messages": {
+			pool: feegrant.SponsorshipPool{UserSpendLimit: atom},
+		},
+		"period limit without period": {
+			pool: feegrant.SponsorshipPool{AllowedMessages: msgs, UserPeriodSpendLimit: atom},
+		},
+		"negative period": {
+			pool: feegrant.SponsorshipPool{AllowedMessages: msgs, Period: -time.Hour},
+		},
+		"period limit in other denom": {
+			pool: feegrant.SponsorshipPool{
+				AllowedMessages:      msgs,
+				UserSpendLimit:       atom,
+				Period:               time.Hour,
+				UserPeriodSpendLimit: sdk.NewCoins(sdk.NewInt64Coin("eth", 1)),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.pool.ValidateBasic()
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
@@ -8,22 +8,29 @@ import (
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	types "github.com/cosmos/cosmos-sdk/codec/types"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types1 "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -312,6 +319,238 @@ func (m *MsgPruneAllowancesResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgPruneAllowancesResponse proto.InternalMessageInfo
 
+// MsgCreateSponsorshipPool creates a sponsorship pool.
+type MsgCreateSponsorshipPool struct {
+	// admin is the address of the account funding the pool, which can close it.
+	Admin string `protobuf:"bytes,1,opt,name=admin,proto3" json:"admin,omitempty"`
+	// allowed_messages are the messages for which the pool pays the fees.
+	AllowedMessages []string `protobuf:"bytes,2,rep,name=allowed_messages,json=allowedMessages,proto3" json:"allowed_messages,omitempty"`
+	// user_spend_limit is the maximum amount of fees the pool pays for a user in
+	// total. If empty, there is no total limit.
+	UserSpendLimit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=user_spend_limit,json=userSpendLimit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"user_spend_limit"`
+	// period is the duration of the periods in which the pool pays up to
+	// user_period_spend_limit fees for a user.
+	Period time.Duration `protobuf:"bytes,4,opt,name=period,proto3,stdduration" json:"period"`
+	// user_period_spend_limit is the maximum amount of fees the pool pays for a
+	// user in a period. If empty, there is no period limit.
+	UserPeriodSpendLimit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=user_period_spend_limit,json=userPeriodSpendLimit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"user_period_spend_limit"`
+	// funds are sent from the admin to the pool account.
+	Funds github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,6,rep,name=funds,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"funds"`
+}
+
+func (m *MsgCreateSponsorshipPool) Reset()         { *m = MsgCreateSponsorshipPool{} }
+func (m *MsgCreateSponsorshipPool) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateSponsorshipPool) ProtoMessage()    {}
+func (*MsgCreateSponsorshipPool) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd44ad7946dad783, []int{6}
+}
+func (m *MsgCreateSponsorshipPool) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateSponsorshipPool) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateSponsorshipPool.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCreateSponsorshipPool) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateSponsorshipPool.Merge(m, src)
+}
+func (m *MsgCreateSponsorshipPool) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateSponsorshipPool) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateSponsorshipPool.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCreateSponsorshipPool proto.InternalMessageInfo
+
+func (m *MsgCreateSponsorshipPool) GetAdmin() string {
+	if m != nil {
+		return m.Admin
+	}
+	return ""
+}
+
+func (m *MsgCreateSponsorshipPool) GetAllowedMessages() []string {
+	if m != nil {
+		return m.AllowedMessages
+	}
+	return nil
+}
+
+func (m *MsgCreateSponsorshipPool) GetUserSpendLimit() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.UserSpendLimit
+	}
+	return nil
+}
+
+func (m *MsgCreateSponsorshipPool) GetPeriod() time.Duration {
+	if m != nil {
+		return m.Period
+	}
+	return 0
+}
+
+func (m *MsgCreateSponsorshipPool) GetUserPeriodSpendLimit() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.UserPeriodSpendLimit
+	}
+	return nil
+}
+
+func (m *MsgCreateSponsorshipPool) GetFunds() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Funds
+	}
+	return nil
+}
+
+// MsgCreateSponsorshipPoolResponse defines the Msg/CreateSponsorshipPool response type.
+type MsgCreateSponsorshipPoolResponse struct {
+	// address is the address of the pool account, to be used as fee granter.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *MsgCreateSponsorshipPoolResponse) Reset()         { *m = MsgCreateSponsorshipPoolResponse{} }
+func (m *MsgCreateSponsorshipPoolResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateSponsorshipPoolResponse) ProtoMessage()    {}
+func (*MsgCreateSponsorshipPoolResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd44ad7946dad783, []int{7}
+}
+func (m *MsgCreateSponsorshipPoolResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateSponsorshipPoolResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateSponsorshipPoolResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCreateSponsorshipPoolResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateSponsorshipPoolResponse.Merge(m, src)
+}
+func (m *MsgCreateSponsorshipPoolResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateSponsorshipPoolResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateSponsorshipPoolResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCreateSponsorshipPoolResponse proto.InternalMessageInfo
+
+func (m *MsgCreateSponsorshipPoolResponse) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+// MsgCloseSponsorshipPool closes a sponsorship pool.
+type MsgCloseSponsorshipPool struct {
+	// admin is the address of the admin of the pool.
+	Admin string `protobuf:"bytes,1,opt,name=admin,proto3" json:"admin,omitempty"`
+	// address is the address of the pool.
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *MsgCloseSponsorshipPool) Reset()         { *m = MsgCloseSponsorshipPool{} }
+func (m *MsgCloseSponsorshipPool) String() string { return proto.CompactTextString(m) }
+func (*MsgCloseSponsorshipPool) ProtoMessage()    {}
+func (*MsgCloseSponsorshipPool) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd44ad7946dad783, []int{8}
+}
+func (m *MsgCloseSponsorshipPool) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCloseSponsorshipPool) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCloseSponsorshipPool.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCloseSponsorshipPool) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCloseSponsorshipPool.Merge(m, src)
+}
+func (m *MsgCloseSponsorshipPool) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCloseSponsorshipPool) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCloseSponsorshipPool.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCloseSponsorshipPool proto.InternalMessageInfo
+
+func (m *MsgCloseSponsorshipPool) GetAdmin() string {
+	if m != nil {
+		return m.Admin
+	}
+	return ""
+}
+
+func (m *MsgCloseSponsorshipPool) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+// MsgCloseSponsorshipPoolResponse defines the Msg/CloseSponsorshipPool response type.
+type MsgCloseSponsorshipPoolResponse struct {
+}
+
+func (m *MsgCloseSponsorshipPoolResponse) Reset()         { *m = MsgCloseSponsorshipPoolResponse{} }
+func (m *MsgCloseSponsorshipPoolResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCloseSponsorshipPoolResponse) ProtoMessage()    {}
+func (*MsgCloseSponsorshipPoolResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd44ad7946dad783, []int{9}
+}
+func (m *MsgCloseSponsorshipPoolResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCloseSponsorshipPoolResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCloseSponsorshipPoolResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCloseSponsorshipPoolResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCloseSponsorshipPoolResponse.Merge(m, src)
+}
+func (m *MsgCloseSponsorshipPoolResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCloseSponsorshipPoolResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCloseSponsorshipPoolResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCloseSponsorshipPoolResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgGrantAllowance)(nil), "cosmos.feegrant.v1beta1.MsgGrantAllowance")
 	proto.RegisterType((*MsgGrantAllowanceResponse)(nil), "cosmos.feegrant.v1beta1.MsgGrantAllowanceResponse")
@@ -319,41 +558,68 @@ func init() {
 	proto.RegisterType((*MsgRevokeAllowanceResponse)(nil), "cosmos.feegrant.v1beta1.MsgRevokeAllowanceResponse")
 	proto.RegisterType((*MsgPruneAllowances)(nil), "cosmos.feegrant.v1beta1.MsgPruneAllowances")
 	proto.RegisterType((*MsgPruneAllowancesResponse)(nil), "cosmos.feegrant.v1beta1.MsgPruneAllowancesResponse")
+	proto.RegisterType((*MsgCreateSponsorshipPool)(nil), "cosmos.feegrant.v1beta1.MsgCreateSponsorshipPool")
+	proto.RegisterType((*MsgCreateSponsorshipPoolResponse)(nil), "cosmos.feegrant.v1beta1.MsgCreateSponsorshipPoolResponse")
+	proto.RegisterType((*MsgCloseSponsorshipPool)(nil), "cosmos.feegrant.v1beta1.MsgCloseSponsorshipPool")
+	proto.RegisterType((*MsgCloseSponsorshipPoolResponse)(nil), "cosmos.feegrant.v1beta1.MsgCloseSponsorshipPoolResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/feegrant/v1beta1/tx.proto", fileDescriptor_dd44ad7946dad783) }
 
 var fileDescriptor_dd44ad7946dad783 = []byte{
-	// 455 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x48, 0xce, 0x2f, 0xce,
-	0xcd, 0x2f, 0xd6, 0x4f, 0x4b, 0x4d, 0x4d, 0x2f, 0x4a, 0xcc, 0x2b, 0xd1, 0x2f, 0x33, 0x4c, 0x4a,
-	0x2d, 0x49, 0x34, 0xd4, 0x2f, 0xa9, 0xd0, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x87, 0xa8,
-	0xd0, 0x83, 0xa9, 0xd0, 0x83, 0xaa, 0x90, 0x92, 0x4c, 0xcf, 0xcf, 0x4f, 0xcf, 0x49, 0xd5, 0x07,
-	0x2b, 0x4b, 0x2a, 0x4d, 0xd3, 0x4f, 0xcc, 0xab, 0x84, 0xe8, 0x91, 0x92, 0x84, 0xe8, 0x89, 0x07,
-	0xf3, 0xf4, 0xa1, 0x06, 0x40, 0xa4, 0xa0, 0xc6, 0xe9, 0xe7, 0x16, 0xa7, 0xeb, 0x97, 0x19, 0x82,
-	0x28, 0xa8, 0x84, 0x60, 0x62, 0x6e, 0x66, 0x5e, 0xbe, 0x3e, 0x98, 0x84, 0x08, 0x29, 0x75, 0x32,
-	0x71, 0x09, 0xfa, 0x16, 0xa7, 0xbb, 0x83, 0xac, 0x75, 0xcc, 0xc9, 0xc9, 0x2f, 0x4f, 0xcc, 0x4b,
-	0x4e, 0x15, 0x32, 0xe2, 0x62, 0x07, 0x3b, 0x24, 0xb5, 0x48, 0x82, 0x51, 0x81, 0x51, 0x83, 0xd3,
-	0x49, 0xe2, 0xd2, 0x16, 0x5d, 0x11, 0xa8, 0x25, 0x8e, 0x29, 0x29, 0x45, 0xa9, 0xc5, 0xc5, 0xc1,
-	0x25, 0x45, 0x99, 0x79, 0xe9, 0x41, 0x30, 0x85, 0x08, 0x3d, 0xa9, 0x12, 0x4c, 0xc4, 0xe9, 0x49,
-	0x15, 0x8a, 0xe5, 0xe2, 0x4c, 0x84, 0x59, 0x2a, 0xc1, 0xac, 0xc0, 0xa8, 0xc1, 0x6d, 0x24, 0xa2,
-	0x07, 0xf1, 0xb3, 0x1e, 0xcc, 0xcf, 0x7a, 0x8e, 0x79, 0x95, 0x4e, 0x9a, 0xa7, 0xb6, 0xe8, 0xaa,
-	0xe2, 0x08, 0x25, 0x3d, 0xb7, 0xd4, 0x54, 0xb8, 0xd3, 0x3d, 0x83, 0x10, 0x26, 0x5a, 0xe9, 0x36,
-	0x3d, 0xdf, 0xa0, 0x05, 0x73, 0x60, 0xd7, 0xf3, 0x0d, 0x5a, 0x32, 0x10, 0x23, 0x74, 0x8b, 0x53,
-	0xb2, 0xf5, 0x31, 0x7c, 0xad, 0x24, 0xcd, 0x25, 0x89, 0x21, 0x18, 0x94, 0x5a, 0x5c, 0x90, 0x9f,
-	0x57, 0x9c, 0xaa, 0xb4, 0x86, 0x91, 0x4b, 0xc8, 0xb7, 0x38, 0x3d, 0x28, 0xb5, 0x2c, 0x3f, 0x3b,
-	0x95, 0xee, 0x21, 0x65, 0xa5, 0x87, 0xee, 0x15, 0x59, 0x54, 0xaf, 0xa0, 0xb9, 0x4b, 0x49, 0x86,
-	0x4b, 0x0a, 0x53, 0x14, 0xee, 0x99, 0x60, 0xb0, 0x5f, 0x02, 0x8a, 0x4a, 0xf3, 0x10, 0x92, 0xc5,
-	0x42, 0x06, 0x5c, 0x6c, 0x05, 0x20, 0x21, 0xc2, 0x5e, 0x81, 0xaa, 0xb3, 0xe2, 0x06, 0xb9, 0x0a,
-	0xca, 0x81, 0x5a, 0x89, 0x66, 0x28, 0xcc, 0x4a, 0xa3, 0x17, 0x4c, 0x5c, 0xcc, 0xbe, 0xc5, 0xe9,
-	0x42, 0x05, 0x5c, 0x7c, 0x68, 0x89, 0x4d, 0x4b, 0x0f, 0x57, 0xc4, 0x62, 0xc4, 0x86, 0x94, 0x11,
-	0xf1, 0x6a, 0x61, 0x36, 0x0b, 0x15, 0x73, 0xf1, 0xa3, 0xc7, 0x9a, 0x36, 0x3e, 0x63, 0xd0, 0x14,
-	0x4b, 0x19, 0x93, 0xa0, 0x18, 0xd9, 0x52, 0xf4, 0xe0, 0xc5, 0x6b, 0x29, 0x9a, 0x62, 0xfc, 0x96,
-	0xe2, 0x08, 0x63, 0x29, 0xd6, 0x86, 0xe7, 0x1b, 0xb4, 0x18, 0x9d, 0x0c, 0x4f, 0x3c, 0x92, 0x63,
-	0xbc, 0xf0, 0x48, 0x8e, 0xf1, 0xc1, 0x23, 0x39, 0xc6, 0x09, 0x8f, 0xe5, 0x18, 0x2e, 0x3c, 0x96,
-	0x63, 0xb8, 0xf1, 0x58, 0x8e, 0x21, 0x0a, 0x5a, 0x32, 0x14, 0xa7, 0x64, 0xeb, 0x65, 0xe6, 0xeb,
-	0x57, 0xc0, 0x8b, 0xa4, 0x24, 0x36, 0x70, 0x6e, 0x33, 0x06, 0x04, 0x00, 0x00, 0xff, 0xff, 0x8d,
-	0x04, 0xdd, 0xf4, 0xac, 0x04, 0x00, 0x00,
+	// 817 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x56, 0x4f, 0x6b, 0xdb, 0x4a,
+	0x10, 0xb7, 0xec, 0xd8, 0x8f, 0x6c, 0xde, 0xcb, 0x1f, 0xe1, 0x47, 0x64, 0xbd, 0x3c, 0xdb, 0x55,
+	0x29, 0x38, 0x2e, 0x96, 0x62, 0xe7, 0xd2, 0xfa, 0xd4, 0x38, 0x25, 0xa5, 0x50, 0x43, 0xb0, 0xa1,
+	0x87, 0x42, 0x31, 0xb2, 0xb5, 0xd9, 0x88, 0xd8, 0x5a, 0xa1, 0x95, 0x93, 0xf8, 0x56, 0x02, 0x3d,
+	0xb4, 0xa5, 0xd0, 0x63, 0xe8, 0x27, 0x28, 0xa5, 0x07, 0x1f, 0xf2, 0x21, 0x42, 0x4f, 0xa1, 0xa7,
+	0x9e, 0x9a, 0x92, 0x1c, 0xfc, 0x09, 0x7a, 0x2f, 0x2b, 0xad, 0x14, 0x5b, 0xfe, 0x93, 0x98, 0x42,
+	0x2e, 0xb6, 0x77, 0xf6, 0x37, 0xf3, 0xfb, 0xcd, 0x68, 0x66, 0x2c, 0x90, 0x6e, 0x60, 0xd2, 0xc2,
+	0x44, 0xd9, 0x81, 0x10, 0x59, 0xaa, 0x61, 0x2b, 0xfb, 0xf9, 0x3a, 0xb4, 0xd5, 0xbc, 0x62, 0x1f,
+	0xca, 0xa6, 0x85, 0x6d, 0xcc, 0x2f, 0xbb, 0x08, 0xd9, 0x43, 0xc8, 0x0c, 0x21, 0xc6, 0x11, 0x46,
+	0xd8, 0xc1, 0x28, 0xf4, 0x97, 0x0b, 0x17, 0x13, 0x08, 0x63, 0xd4, 0x84, 0x8a, 0x73, 0xaa, 0xb7,
+	0x77, 0x14, 0xd5, 0xe8, 0xb0, 0xab, 0x64, 0xf0, 0x4a, 0x6b, 0x5b, 0xaa, 0xad, 0x63, 0xc3, 0x73,
+	0x75, 0x99, 0x6a, 0x6e, 0x4c, 0x46, 0xcb, 0x5c, 0x99, 0xcc, 0xba, 0x4a, 0xa0, 0x2f, 0xb1, 0x81,
+	0x75, 0xcf, 0x95, 0x89, 0x54, 0x5a, 0x04, 0x29, 0xfb, 0x79, 0xfa, 0xc5, 0x2e, 0x96, 0xd4, 0x96,
+	0x6e, 0x60, 0xc5, 0xf9, 0x74, 0x4d, 0xd2, 0x9b, 0x30, 0x58, 0x2a, 0x13, 0xf4, 0x84, 0x26, 0xb3,
+	0xd1, 0x6c, 0xe2, 0x03, 0xd5, 0x68, 0x40, 0xbe, 0x00, 0xfe, 0x72, 0xd2, 0x83, 0x96, 0xc0, 0xa5,
+	0xb9, 0xcc, 0x6c, 0x49, 0xf8, 0x76, 0x92, 0x8b, 0x33, 0x11, 0x1b, 0x9a, 0x66, 0x41, 0x42, 0xaa,
+	0xb6, 0xa5, 0x1b, 0xa8, 0xe2, 0x01, 0xaf, 0x7c, 0xa0, 0x10, 0xbe, 0x99, 0x0f, 0xe4, 0x5f, 0x82,
+	0x59, 0xd5, 0x23, 0x15, 0x22, 0x69, 0x2e, 0x33, 0x57, 0x88, 0xcb, 0x6e, 0x61, 0x64, 0xaf, 0x30,
+	0xf2, 0x86, 0xd1, 0x29, 0xad, 0x7e, 0x3d, 0xc9, 0xdd, 0x1b, 0x53, 0x7b, 0x79, 0x0b, 0x42, 0x5f,
+	0xfa, 0xd3, 0xca, 0x55, 0xc4, 0x62, 0xee, 0xa8, 0xd7, 0xcd, 0x7a, 0x02, 0xdf, 0xf6, 0xba, 0xd9,
+	0x15, 0x37, 0x44, 0x8e, 0x68, 0x7b, 0xca, 0x50, 0xd6, 0xd2, 0x7f, 0x20, 0x31, 0x64, 0xac, 0x40,
+	0x62, 0x62, 0x83, 0x40, 0xe9, 0x0b, 0x07, 0xf8, 0x32, 0x41, 0x15, 0xb8, 0x8f, 0xf7, 0xe0, 0xad,
+	0x57, 0xaa, 0x28, 0x07, 0x53, 0xf9, 0x7f, 0x30, 0x95, 0x80, 0x2e, 0x69, 0x05, 0x88, 0xc3, 0x56,
+	0x3f, 0x99, 0xaa, 0x93, 0xcb, 0xb6, 0xd5, 0x36, 0xae, 0x2e, 0x09, 0xbf, 0x06, 0x62, 0x26, 0x35,
+	0x5d, 0x9f, 0x0a, 0xc3, 0x15, 0xe7, 0xa8, 0x2a, 0x76, 0x60, 0x94, 0x81, 0xa0, 0x3e, 0xe5, 0xfb,
+	0x28, 0x10, 0xca, 0x04, 0x6d, 0x5a, 0x50, 0xb5, 0x61, 0x95, 0xda, 0xb0, 0x45, 0x76, 0x75, 0x73,
+	0x1b, 0xe3, 0x26, 0x2f, 0x83, 0xa8, 0xaa, 0xb5, 0x74, 0xe3, 0x5a, 0x62, 0x17, 0xc6, 0xaf, 0x82,
+	0x45, 0xe7, 0x29, 0x43, 0xad, 0xd6, 0x82, 0x84, 0xa8, 0x08, 0x12, 0x21, 0x9c, 0x8e, 0x64, 0x66,
+	0x2b, 0x0b, 0xcc, 0x5e, 0x66, 0x66, 0xfe, 0x1d, 0x07, 0x16, 0xdb, 0x04, 0x5a, 0x35, 0x62, 0x42,
+	0x43, 0xab, 0x35, 0xf5, 0x96, 0x6e, 0x0b, 0x91, 0x74, 0x24, 0x33, 0x57, 0x48, 0xc8, 0x8c, 0x83,
+	0x0e, 0x92, 0xdf, 0x4d, 0x9b, 0x58, 0x37, 0x4a, 0x5b, 0xa7, 0x3f, 0x52, 0xa1, 0xcf, 0xe7, 0xa9,
+	0x0c, 0xd2, 0xed, 0xdd, 0x76, 0x5d, 0x6e, 0xe0, 0x16, 0x9b, 0x41, 0xa5, 0xaf, 0xee, 0x76, 0xc7,
+	0x84, 0xc4, 0x71, 0x20, 0x1f, 0x7b, 0xdd, 0xec, 0xdf, 0x4d, 0x88, 0xd4, 0x46, 0xa7, 0x46, 0x47,
+	0x91, 0x7c, 0xea, 0x75, 0xb3, 0x5c, 0x65, 0x9e, 0x52, 0x57, 0x29, 0xf3, 0x33, 0x4a, 0xcc, 0x3f,
+	0x02, 0x31, 0x13, 0x5a, 0x3a, 0xd6, 0x84, 0x19, 0xa7, 0xdb, 0x13, 0x43, 0xdd, 0xfe, 0x98, 0xad,
+	0x81, 0xd2, 0x3f, 0x54, 0xc2, 0xf1, 0x79, 0x8a, 0x73, 0x23, 0x31, 0x3f, 0xfe, 0x98, 0x03, 0xcb,
+	0x4e, 0x3e, 0xee, 0x79, 0x20, 0xad, 0xe8, 0x6d, 0xa5, 0x15, 0xa7, 0x0a, 0xb6, 0x1d, 0x01, 0x7d,
+	0xc9, 0x1d, 0x80, 0xe8, 0x4e, 0xdb, 0xd0, 0x88, 0x10, 0xbb, 0x2d, 0x1d, 0x2e, 0x5f, 0xb1, 0x40,
+	0xdb, 0xd0, 0x6d, 0x0d, 0x3a, 0x1a, 0x77, 0x07, 0x47, 0x63, 0x64, 0xcb, 0x49, 0xcf, 0x41, 0x7a,
+	0xdc, 0x9d, 0xd7, 0xb3, 0x74, 0x50, 0x55, 0xb7, 0xfd, 0xae, 0x1f, 0x6e, 0x06, 0x94, 0xba, 0x1c,
+	0x58, 0xa6, 0x81, 0x9b, 0x98, 0xfc, 0x71, 0x9b, 0xf7, 0xf1, 0x87, 0x6f, 0xc8, 0x5f, 0xcc, 0x0f,
+	0xd6, 0x42, 0x0a, 0xd4, 0x62, 0x84, 0x2c, 0xe9, 0x0e, 0x48, 0x8d, 0xb9, 0xf2, 0x2a, 0x51, 0xf8,
+	0x35, 0x03, 0x22, 0x65, 0x82, 0x78, 0x13, 0xcc, 0x07, 0xfe, 0x2a, 0xb2, 0xf2, 0xb8, 0xb5, 0x3c,
+	0xb4, 0x4b, 0xc5, 0xc2, 0xcd, 0xb1, 0xfe, 0x33, 0x20, 0x60, 0x21, 0xb8, 0x73, 0xef, 0x4f, 0x0a,
+	0x13, 0x00, 0x8b, 0xeb, 0x53, 0x80, 0xfb, 0x49, 0x83, 0xcb, 0x71, 0x22, 0x69, 0x00, 0x2c, 0xae,
+	0x4f, 0x01, 0xf6, 0x49, 0x5f, 0x73, 0xe0, 0xdf, 0xd1, 0xeb, 0x31, 0x3f, 0x29, 0xdc, 0x48, 0x17,
+	0xf1, 0xe1, 0xd4, 0x2e, 0xbe, 0x8e, 0x23, 0x0e, 0xc4, 0x47, 0xb6, 0xef, 0xda, 0xc4, 0x98, 0x23,
+	0x3c, 0xc4, 0x07, 0xd3, 0x7a, 0x78, 0x22, 0xc4, 0xe8, 0x2b, 0x3a, 0xe0, 0xa5, 0xfc, 0xe9, 0x45,
+	0x92, 0x3b, 0xbb, 0x48, 0x72, 0x3f, 0x2f, 0x92, 0xdc, 0x87, 0xcb, 0x64, 0xe8, 0xec, 0x32, 0x19,
+	0xfa, 0x7e, 0x99, 0x0c, 0xbd, 0x60, 0x2f, 0x39, 0x44, 0xdb, 0x93, 0x75, 0xac, 0x1c, 0xfa, 0xef,
+	0x6c, 0xf5, 0x98, 0xb3, 0x4a, 0xd7, 0x7f, 0x0f, 0x00, 0xef, 0x3b, 0xe4, 0xb1, 0xcd, 0x09, 0x00,
+	0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -378,6 +644,12 @@ type MsgClient interface {
 	//
 	// Since cosmos-sdk 0.50
 	PruneAllowances(ctx context.Context, in *MsgPruneAllowances, opts ...grpc.CallOption) (*MsgPruneAllowancesResponse, error)
+	// CreateSponsorshipPool creates a sponsorship pool funded by the admin, which
+	// pays the fees of the transactions of any signer matching its message filter.
+	CreateSponsorshipPool(ctx context.Context, in *MsgCreateSponsorshipPool, opts ...grpc.CallOption) (*MsgCreateSponsorshipPoolResponse, error)
+	// CloseSponsorshipPool closes a sponsorship pool, returning its remaining
+	// funds to the admin.
+	CloseSponsorshipPool(ctx context.Context, in *MsgCloseSponsorshipPool, opts ...grpc.CallOption) (*MsgCloseSponsorshipPoolResponse, error)
 }
 
 type msgClient struct {
@@ -415,6 +687,24 @@ func (c *msgClient) PruneAllowances(ctx context.Context, in *MsgPruneAllowances,
 	return out, nil
 }
 
+func (c *msgClient) CreateSponsorshipPool(ctx context.Context, in *MsgCreateSponsorshipPool, opts ...grpc.CallOption) (*MsgCreateSponsorshipPoolResponse, error) {
+	out := new(MsgCreateSponsorshipPoolResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.feegrant.v1beta1.Msg/CreateSponsorshipPool", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CloseSponsorshipPool(ctx context.Context, in *MsgCloseSponsorshipPool, opts ...grpc.CallOption) (*MsgCloseSponsorshipPoolResponse, error) {
+	out := new(MsgCloseSponsorshipPoolResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.feegrant.v1beta1.Msg/CloseSponsorshipPool", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// GrantAllowance grants fee allowance to the grantee on the granter's
@@ -427,6 +717,12 @@ type MsgServer interface {
 	//
 	// Since cosmos-sdk 0.50
 	PruneAllowances(context.Context, *MsgPruneAllowances) (*MsgPruneAllowancesResponse, error)
+	// CreateSponsorshipPool creates a sponsorship pool funded by the admin, which
+	// pays the fees of the transactions of any signer matching its message filter.
+	CreateSponsorshipPool(context.Context, *MsgCreateSponsorshipPool) (*MsgCreateSponsorshipPoolResponse, error)
+	// CloseSponsorshipPool closes a sponsorship pool, returning its remaining
+	// funds to the admin.
+	CloseSponsorshipPool(context.Context, *MsgCloseSponsorshipPool) (*MsgCloseSponsorshipPoolResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -442,6 +738,12 @@ func (*UnimplementedMsgServer) RevokeAllowance(ctx context.Context, req *MsgRevo
 func (*UnimplementedMsgServer) PruneAllowances(ctx context.Context, req *MsgPruneAllowances) (*MsgPruneAllowancesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PruneAllowances not implemented")
 }
+func (*UnimplementedMsgServer) CreateSponsorshipPool(ctx context.Context, req *MsgCreateSponsorshipPool) (*MsgCreateSponsorshipPoolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSponsorshipPool not implemented")
+}
+func (*UnimplementedMsgServer) CloseSponsorshipPool(ctx context.Context, req *MsgCloseSponsorshipPool) (*MsgCloseSponsorshipPoolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CloseSponsorshipPool not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -501,6 +803,42 @@ func _Msg_PruneAllowances_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_CreateSponsorshipPool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateSponsorshipPool)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateSponsorshipPool(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.feegrant.v1beta1.Msg/CreateSponsorshipPool",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateSponsorshipPool(ctx, req.(*MsgCreateSponsorshipPool))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CloseSponsorshipPool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCloseSponsorshipPool)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CloseSponsorshipPool(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.feegrant.v1beta1.Msg/CloseSponsorshipPool",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CloseSponsorshipPool(ctx, req.(*MsgCloseSponsorshipPool))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.feegrant.v1beta1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -517,6 +855,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "PruneAllowances",
 			Handler:    _Msg_PruneAllowances_Handler,
 		},
+		{
+			MethodName: "CreateSponsorshipPool",
+			Handler:    _Msg_CreateSponsorshipPool_Handler,
+		},
+		{
+			MethodName: "CloseSponsorshipPool",
+			Handler:    _Msg_CloseSponsorshipPool_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/feegrant/v1beta1/tx.proto",
@@ -707,67 +1053,246 @@ func (m *MsgPruneAllowancesResponse) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgCreateSponsorshipPool) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgGrantAllowance) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgCreateSponsorshipPool) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateSponsorshipPool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Granter)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Funds) > 0 {
+		for iNdEx := len(m.Funds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Funds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
 	}
-	l = len(m.Grantee)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.UserPeriodSpendLimit) > 0 {
+		for iNdEx := len(m.UserPeriodSpendLimit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UserPeriodSpendLimit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
 	}
-	if m.Allowance != nil {
-		l = m.Allowance.Size()
-		n += 1 + l + sovTx(uint64(l))
+	n2, err2 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Period, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Period):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintTx(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x22
+	if len(m.UserSpendLimit) > 0 {
+		for iNdEx := len(m.UserSpendLimit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UserSpendLimit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
 	}
-	return n
+	if len(m.AllowedMessages) > 0 {
+		for iNdEx := len(m.AllowedMessages) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedMessages[iNdEx])
+			copy(dAtA[i:], m.AllowedMessages[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.AllowedMessages[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Admin) > 0 {
+		i -= len(m.Admin)
+		copy(dAtA[i:], m.Admin)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Admin)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgGrantAllowanceResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgCreateSponsorshipPoolResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgRevokeAllowance) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgCreateSponsorshipPoolResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateSponsorshipPoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Granter)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Grantee)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgRevokeAllowanceResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgCloseSponsorshipPool) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCloseSponsorshipPool) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCloseSponsorshipPool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Admin) > 0 {
+		i -= len(m.Admin)
+		copy(dAtA[i:], m.Admin)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Admin)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCloseSponsorshipPoolResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCloseSponsorshipPoolResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCloseSponsorshipPoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgGrantAllowance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Granter)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Grantee)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Allowance != nil {
+		l = m.Allowance.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgGrantAllowanceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRevokeAllowance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Granter)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Grantee)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRevokeAllowanceResponse) Size() (n int) {
+	if m == nil {
+		return 0
 	}
 	var l int
 	_ = l
@@ -796,6 +1321,84 @@ func (m *MsgPruneAllowancesResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgCreateSponsorshipPool) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Admin)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.AllowedMessages) > 0 {
+		for _, s := range m.AllowedMessages {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.UserSpendLimit) > 0 {
+		for _, e := range m.UserSpendLimit {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Period)
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.UserPeriodSpendLimit) > 0 {
+		for _, e := range m.UserPeriodSpendLimit {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.Funds) > 0 {
+		for _, e := range m.Funds {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgCreateSponsorshipPoolResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCloseSponsorshipPool) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Admin)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCloseSponsorshipPoolResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1298,6 +1901,501 @@ func (m *MsgPruneAllowancesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgCreateSponsorshipPool) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateSponsorshipPool: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateSponsorshipPool: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Admin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedMessages", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedMessages = append(m.AllowedMessages, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserSpendLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UserSpendLimit = append(m.UserSpendLimit, types1.Coin{})
+			if err := m.UserSpendLimit[len(m.UserSpendLimit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Period, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserPeriodSpendLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UserPeriodSpendLimit = append(m.UserPeriodSpendLimit, types1.Coin{})
+			if err := m.UserPeriodSpendLimit[len(m.UserPeriodSpendLimit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Funds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Funds = append(m.Funds, types1.Coin{})
+			if err := m.Funds[len(m.Funds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateSponsorshipPoolResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateSponsorshipPoolResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateSponsorshipPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCloseSponsorshipPool) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCloseSponsorshipPool: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCloseSponsorshipPool: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Admin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCloseSponsorshipPoolResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCloseSponsorshipPoolResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCloseSponsorshipPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
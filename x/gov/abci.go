@@ -147,58 +147,14 @@ func EndBlocker(ctx sdk.Context, keeper *keeper.Keeper) error {
 		}
 
 		switch {
+		case passes && !keeper.IsExecutionDue(ctx, proposal):
+			// the messages of the proposal are executed once its execution
+			// time or height is reached
+			proposal.Status = v1.StatusScheduled
+			tagValue = types.AttributeValueProposalScheduled
+			logMsg = "passed, scheduled for execution"
 		case passes:
-			var (
-				idx    int
-				events sdk.Events
-				msg    sdk.Msg
-			)
-
-			// attempt to execute all messages within the passed proposal
-			// Messages may mutate state thus we use a cached context. If one of
-			// the handlers fails, no state mutation is written and the error
-			// message is logged.
-			cacheCtx, writeCache := ctx.CacheContext()
-			messages, err := proposal.GetMsgs()
-			if err != nil {
-				proposal.Status = v1.StatusFailed
-				proposal.FailedReason = err.Error()
-				tagValue = types.AttributeValueProposalFailed
-				logMsg = fmt.Sprintf("passed proposal (%v) failed to execute; msgs: %s", proposal, err)
-
-				break
-			}
-
-			// execute all messages
-			for idx, msg = range messages {
-				handler := keeper.Router().Handler(msg)
-				var res *sdk.Result
-				res, err = safeExecuteHandler(cacheCtx, msg, handler)
-				if err != nil {
-					break
-				}
-
-				events = append(events, res.GetEvents()...)
-			}
-
-			// `err == nil` when all handlers passed.
-			// Or else, `idx` and `err` are populated with the msg index and error.
-			if err == nil {
-				proposal.Status = v1.StatusPassed
-				tagValue = types.AttributeValueProposalPassed
-				logMsg = "passed"
-
-				// write state to the underlying multi-store
-				writeCache()
-
-				// propagate the msg events to the current context
-				ctx.EventManager().EmitEvents(events)
-			} else {
-				proposal.Status = v1.StatusFailed
-				proposal.FailedReason = err.Error()
-				tagValue = types.AttributeValueProposalFailed
-				logMsg = fmt.Sprintf("passed, but msg %d (%s) failed on execution: %s", idx, sdk.MsgTypeURL(msg), err)
-			}
+			tagValue, logMsg = executeProposal(ctx, keeper, &proposal)
 		case proposal.Expedited:
 			// When expedited proposal fails, it is converted
 			// to a regular proposal. As a result, the voting period is extended, and,
@@ -219,6 +175,11 @@ func EndBlocker(ctx sdk.Context, keeper *keeper.Keeper) error {
 
 			tagValue = types.AttributeValueExpeditedProposalRejected
 			logMsg = "expedited proposal converted to regular"
+		case proposal.ProposalType == v1.ProposalType_PROPOSAL_TYPE_OPTIMISTIC:
+			proposal.Status = v1.StatusRejected
+			proposal.FailedReason = "optimistic proposal got enough votes against it to be rejected"
+			tagValue = types.AttributeValueOptimisticProposalRejected
+			logMsg = "optimistic proposal rejected"
 		default:
 			proposal.Status = v1.StatusRejected
 			proposal.FailedReason = "proposal did not get enough votes to pass"
@@ -265,9 +226,111 @@ func EndBlocker(ctx sdk.Context, keeper *keeper.Keeper) error {
 	if err != nil {
 		return err
 	}
+
+	// execute the scheduled proposals which are due
+	var dueProposalIDs []uint64
+	err = keeper.ScheduledProposals.Walk(ctx, nil, func(proposalID uint64, _ []byte) (bool, error) {
+		proposal, err := keeper.Proposals.Get(ctx, proposalID)
+		if err != nil {
+			return false, err
+		}
+		if keeper.IsExecutionDue(ctx, proposal) {
+			dueProposalIDs = append(dueProposalIDs, proposalID)
+		}
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, proposalID := range dueProposalIDs {
+		// the execution may have been canceled by a previously executed proposal
+		proposal, err := keeper.Proposals.Get(ctx, proposalID)
+		if err != nil {
+			return err
+		}
+		if proposal.Status != v1.StatusScheduled {
+			continue
+		}
+
+		tagValue, logMsg := executeProposal(ctx, keeper, &proposal)
+		if err := keeper.SetProposal(ctx, proposal); err != nil {
+			return err
+		}
+
+		logger.Info(
+			"scheduled proposal executed",
+			"proposal", proposal.Id,
+			"status", proposal.Status.String(),
+			"title", proposal.Title,
+			"results", logMsg,
+		)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeScheduledProposal,
+				sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposal.Id)),
+				sdk.NewAttribute(types.AttributeKeyProposalResult, tagValue),
+				sdk.NewAttribute(types.AttributeKeyProposalLog, logMsg),
+			),
+		)
+	}
+
 	return nil
 }
 
+// executeProposal executes the messages of a passed proposal, and sets its
+// status accordingly. It returns the proposal result and log of the execution.
+func executeProposal(ctx sdk.Context, keeper *keeper.Keeper, proposal *v1.Proposal) (tagValue, logMsg string) {
+	var (
+		idx    int
+		events sdk.Events
+		msg    sdk.Msg
+	)
+
+	// attempt to execute all messages within the passed proposal
+	// Messages may mutate state thus we use a cached context. If one of
+	// the handlers fails, no state mutation is written and the error
+	// message is logged.
+	cacheCtx, writeCache := ctx.CacheContext()
+	messages, err := proposal.GetMsgs()
+	if err != nil {
+		proposal.Status = v1.StatusFailed
+		proposal.FailedReason = err.Error()
+		return types.AttributeValueProposalFailed, fmt.Sprintf("passed proposal (%v) failed to execute; msgs: %s", *proposal, err)
+	}
+
+	// execute all messages
+	for idx, msg = range messages {
+		handler := keeper.Router().Handler(msg)
+		var res *sdk.Result
+		res, err = safeExecuteHandler(cacheCtx, msg, handler)
+		if err != nil {
+			break
+		}
+
+		events = append(events, res.GetEvents()...)
+	}
+
+	// `err == nil` when all handlers passed.
+	// Or else, `idx` and `err` are populated with the msg index and error.
+	if err != nil {
+		proposal.Status = v1.StatusFailed
+		proposal.FailedReason = err.Error()
+		return types.AttributeValueProposalFailed, fmt.Sprintf("passed, but msg %d (%s) failed on execution: %s", idx, sdk.MsgTypeURL(msg), err)
+	}
+
+	proposal.Status = v1.StatusPassed
+
+	// write state to the underlying multi-store
+	writeCache()
+
+	// propagate the msg events to the current context
+	ctx.EventManager().EmitEvents(events)
+
+	return types.AttributeValueProposalPassed, "passed"
+}
+
 // executes handle(msg) and recovers from panic.
 func safeExecuteHandler(ctx sdk.Context, msg sdk.Msg, handler baseapp.MsgServiceHandler,
 ) (res *sdk.Result, err error) {
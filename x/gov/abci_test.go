@@ -660,6 +660,85 @@ func TestExpeditedProposal_PassAndConversionToRegular(t *testing.T) {
 	}
 }
 
+func TestScheduledProposal(t *testing.T) {
+	suite := createTestSuite(t)
+	app := suite.App
+	ctx := app.BaseApp.NewContext(false)
+	addrs := simtestutil.AddTestAddrs(suite.BankKeeper, suite.StakingKeeper, ctx, 1, valTokens)
+
+	stakingMsgSvr := stakingkeeper.NewMsgServerImpl(suite.StakingKeeper)
+	govMsgSvr := keeper.NewMsgServerImpl(suite.GovKeeper)
+
+	app.FinalizeBlock(&abci.RequestFinalizeBlock{
+		Height: app.LastBlockHeight() + 1,
+		Hash:   app.LastCommitID().Hash,
+	})
+
+	createValidators(t, stakingMsgSvr, ctx, []sdk.ValAddress{sdk.ValAddress(addrs[0])}, []int64{10})
+	suite.StakingKeeper.EndBlocker(ctx)
+
+	ctx = ctx.WithBlockHeight(10)
+	params, err := suite.GovKeeper.Params.Get(ctx)
+	require.NoError(t, err)
+	votingEndTime := ctx.BlockTime().Add(*params.MaxDepositPeriod).Add(*params.VotingPeriod)
+	executionTime := votingEndTime.Add(time.Hour)
+
+	// submit two proposals, executed one hour after the end of their voting period
+	proposalIDs := make([]uint64, 2)
+	for i := range proposalIDs {
+		proposal, err := suite.GovKeeper.SubmitProposal(ctx, []sdk.Msg{mkTestLegacyContent(t)}, "", "title", "summary", addrs[0], false)
+		require.NoError(t, err)
+		proposalIDs[i] = proposal.Id
+
+		require.Error(t, suite.GovKeeper.SetProposalExecution(ctx, proposal.Id, &executionTime, ctx.BlockHeight()+1))
+		require.Error(t, suite.GovKeeper.SetProposalExecution(ctx, proposal.Id, nil, ctx.BlockHeight()))
+		require.NoError(t, suite.GovKeeper.SetProposalExecution(ctx, proposal.Id, &executionTime, 0))
+
+		proposalCoins := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, suite.StakingKeeper.TokensFromConsensusPower(ctx, 10)))
+		_, err = govMsgSvr.Deposit(ctx, v1.NewMsgDeposit(addrs[0], proposal.Id, proposalCoins))
+		require.NoError(t, err)
+		require.NoError(t, suite.GovKeeper.AddVote(ctx, proposal.Id, addrs[0], v1.NewNonSplitVoteOption(v1.OptionYes), ""))
+	}
+
+	// the passed proposals are scheduled
+	ctx = ctx.WithBlockTime(votingEndTime)
+	require.NoError(t, gov.EndBlocker(ctx, suite.GovKeeper))
+
+	res, err := keeper.NewQueryServer(suite.GovKeeper).ScheduledProposals(ctx, &v1.QueryScheduledProposalsRequest{})
+	require.NoError(t, err)
+	require.Len(t, res.Proposals, 2)
+	for _, proposal := range res.Proposals {
+		require.Equal(t, v1.StatusScheduled, proposal.Status)
+	}
+
+	// the execution of the second proposal is canceled by governance
+	_, err = govMsgSvr.CancelScheduledExecution(ctx, v1.NewMsgCancelScheduledExecution(addrs[0].String(), proposalIDs[1]))
+	require.ErrorIs(t, err, types.ErrInvalidSigner)
+	_, err = govMsgSvr.CancelScheduledExecution(ctx, v1.NewMsgCancelScheduledExecution(suite.GovKeeper.GetAuthority(), proposalIDs[1]))
+	require.NoError(t, err)
+
+	// the first proposal is executed once its execution time is reached
+	ctx = ctx.WithBlockTime(executionTime.Add(-time.Second))
+	require.NoError(t, gov.EndBlocker(ctx, suite.GovKeeper))
+	proposal, err := suite.GovKeeper.Proposals.Get(ctx, proposalIDs[0])
+	require.NoError(t, err)
+	require.Equal(t, v1.StatusScheduled, proposal.Status)
+
+	ctx = ctx.WithBlockTime(executionTime)
+	require.NoError(t, gov.EndBlocker(ctx, suite.GovKeeper))
+	proposal, err = suite.GovKeeper.Proposals.Get(ctx, proposalIDs[0])
+	require.NoError(t, err)
+	require.Equal(t, v1.StatusPassed, proposal.Status)
+
+	proposal, err = suite.GovKeeper.Proposals.Get(ctx, proposalIDs[1])
+	require.NoError(t, err)
+	require.Equal(t, v1.StatusFailed, proposal.Status)
+
+	res, err = keeper.NewQueryServer(suite.GovKeeper).ScheduledProposals(ctx, &v1.QueryScheduledProposalsRequest{})
+	require.NoError(t, err)
+	require.Empty(t, res.Proposals)
+}
+
 func createValidators(t *testing.T, stakingMsgSvr stakingtypes.MsgServer, ctx sdk.Context, addrs []sdk.ValAddress, powerAmt []int64) {
 	require.True(t, len(addrs) <= len(pubkeys), "Not enough pubkeys specified at top of file.")
 
@@ -40,6 +40,11 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						{ProtoField: "proposal_id"},
 					},
 				},
+				{
+					RpcMethod: "ScheduledProposals",
+					Use:       "scheduled-proposals",
+					Short:     "Query the passed proposals whose messages are scheduled for execution",
+				},
 				{
 					RpcMethod: "Vote",
 					Use:       "vote [proposal-id] [voter-addr]",
@@ -120,7 +125,7 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					RpcMethod: "Vote",
 					Use:       "vote [proposal-id] [option]",
 					Short:     "Vote for an active proposal, options: yes/no/no-with-veto/abstain",
-					Long:      fmt.Sprintf(`Submit a vote for an active proposal. Use the --metadata to optionally give a reason. You can find the proposal-id by running "%s query gov proposals"`, version.AppName),
+					Long:      fmt.Sprintf(`Submit a vote for an active proposal. Use the --metadata to optionally give a reason. You can find the proposal-id by running "%s query gov proposals". Multiple choice proposals are voted on with the options one/two/three/four.`, version.AppName),
 					Example:   fmt.Sprintf("$ %s tx gov vote 1 yes --from mykey", version.AppName),
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
 						{ProtoField: "proposal_id"},
@@ -134,6 +139,10 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					RpcMethod: "UpdateParams",
 					Skip:      true, // skipped because authority gated
 				},
+				{
+					RpcMethod: "CancelScheduledExecution",
+					Skip:      true, // skipped because authority gated
+				},
 			},
 			EnhanceCustomCommand: false, // use custom commands only until v0.51
 		},
@@ -111,7 +111,28 @@ Where proposal.json contains:
   "deposit": "10stake",
   "title": "My proposal",
   "summary": "A short summary of my proposal",
-  "expedited": false
+  "expedited": false,
+  // optional, one of PROPOSAL_TYPE_STANDARD (default), PROPOSAL_TYPE_OPTIMISTIC
+  // or PROPOSAL_TYPE_MULTIPLE_CHOICE
+  "proposal_type": "PROPOSAL_TYPE_STANDARD",
+  // optional, executes the messages from the given time or height once the proposal passes
+  "execution_time": "2025-01-01T00:00:00Z"
+}
+
+Multiple choice proposals have no messages, and set two to four vote options
+instead, which are voted on with the options one/two/three/four:
+
+{
+  "metadata": "4pIMOgIGx1vZGU=",
+  "deposit": "10stake",
+  "title": "My proposal",
+  "summary": "A short summary of my proposal",
+  "proposal_type": "PROPOSAL_TYPE_MULTIPLE_CHOICE",
+  "vote_options": {
+    "option_one": "First option",
+    "option_two": "Second option",
+    "option_three": "Third option"
+  }
 }
 
 metadata example: 
@@ -138,10 +159,19 @@ metadata example:
 				return err
 			}
 
+			proposalType, err := proposal.parseProposalType()
+			if err != nil {
+				return err
+			}
+
 			msg, err := v1.NewMsgSubmitProposal(msgs, deposit, clientCtx.GetFromAddress().String(), proposal.Metadata, proposal.Title, proposal.Summary, proposal.Expedited)
 			if err != nil {
 				return fmt.Errorf("invalid message: %w", err)
 			}
+			msg.ProposalType = proposalType
+			msg.VoteOptions = proposal.VoteOptions
+			msg.ExecutionTime = proposal.ExecutionTime
+			msg.ExecutionHeight = proposal.ExecutionHeight
 
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
@@ -308,6 +338,7 @@ func NewCmdVote() *cobra.Command {
 		Long: strings.TrimSpace(
 			fmt.Sprintf(`Submit a vote for an active proposal. You can
 find the proposal-id by running "%s query gov proposals".
+Multiple choice proposals are voted on with the options one/two/three/four.
 
 Example:
 $ %s tx gov vote 1 yes --from mykey
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
+	"time"
 
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
@@ -88,6 +89,29 @@ type proposal struct {
 	Title     string            `json:"title"`
 	Summary   string            `json:"summary"`
 	Expedited bool              `json:"expedited"`
+	// ProposalType defines the proposal type, e.g. PROPOSAL_TYPE_OPTIMISTIC.
+	// It defaults to PROPOSAL_TYPE_STANDARD.
+	ProposalType string `json:"proposal_type,omitempty"`
+	// VoteOptions defines the vote options of a multiple choice proposal.
+	VoteOptions *govv1.ProposalVoteOptions `json:"vote_options,omitempty"`
+	// ExecutionTime and ExecutionHeight optionally schedule the execution of
+	// the messages of the proposal once it passes.
+	ExecutionTime   *time.Time `json:"execution_time,omitempty"`
+	ExecutionHeight int64      `json:"execution_height,omitempty"`
+}
+
+// parseProposalType parses the proposal type of a proposal.
+func (p proposal) parseProposalType() (govv1.ProposalType, error) {
+	if p.ProposalType == "" {
+		return govv1.ProposalType_PROPOSAL_TYPE_STANDARD, nil
+	}
+
+	proposalType, ok := govv1.ProposalType_value[p.ProposalType]
+	if !ok {
+		return govv1.ProposalType_PROPOSAL_TYPE_UNSPECIFIED, fmt.Errorf("invalid proposal type: %s", p.ProposalType)
+	}
+
+	return govv1.ProposalType(proposalType), nil
 }
 
 // parseSubmitProposal reads and parses the proposal.
@@ -206,6 +206,16 @@ func TestParseSubmitProposal(t *testing.T) {
 	require.Equal(t, "My awesome title", proposal.Title)
 	require.Equal(t, "My awesome summary", proposal.Summary)
 	require.Equal(t, true, proposal.Expedited)
+	proposalType, err := proposal.parseProposalType()
+	require.NoError(t, err)
+	require.Equal(t, v1.ProposalType_PROPOSAL_TYPE_STANDARD, proposalType)
+	proposal.ProposalType = "PROPOSAL_TYPE_OPTIMISTIC"
+	proposalType, err = proposal.parseProposalType()
+	require.NoError(t, err)
+	require.Equal(t, v1.ProposalType_PROPOSAL_TYPE_OPTIMISTIC, proposalType)
+	proposal.ProposalType = "optimistic"
+	_, err = proposal.parseProposalType()
+	require.Error(t, err)
 
 	err = okJSON.Close()
 	require.Nil(t, err, "unexpected error")
@@ -21,6 +21,20 @@ func NormalizeVoteOption(option string) string {
 	case "NoWithVeto", "no_with_veto":
 		return v1beta1.OptionNoWithVeto.String()
 
+	// the options of multiple choice proposals share their values with the
+	// standard options
+	case "One", "one":
+		return v1beta1.OptionYes.String()
+
+	case "Two", "two":
+		return v1beta1.OptionAbstain.String()
+
+	case "Three", "three":
+		return v1beta1.OptionNo.String()
+
+	case "Four", "four":
+		return v1beta1.OptionNoWithVeto.String()
+
 	default:
 		return option
 	}
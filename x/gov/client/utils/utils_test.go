@@ -37,6 +37,10 @@ func TestNormalizeWeightedVoteOptions(t *testing.T) {
 			options:    "Yes=0.5,No=0.5,NoWithVeto=0",
 			normalized: "VOTE_OPTION_YES=0.5,VOTE_OPTION_NO=0.5,VOTE_OPTION_NO_WITH_VETO=0",
 		},
+		"multiple choice options": {
+			options:    "one=0.5,Two=0.3,three=0.2",
+			normalized: "VOTE_OPTION_YES=0.5,VOTE_OPTION_ABSTAIN=0.3,VOTE_OPTION_NO=0.2",
+		},
 		"minus weight option": {
 			options:    "Yes=0.5,No=0.6,NoWithVeto=-0.1",
 			normalized: "VOTE_OPTION_YES=0.5,VOTE_OPTION_NO=0.6,VOTE_OPTION_NO_WITH_VETO=-0.1",
@@ -73,6 +73,11 @@ func InitGenesis(ctx sdk.Context, ak types.AccountKeeper, bk types.BankKeeper, k
 		}
 	}
 
+	// the running tallies are not exported, rebuild them from the votes
+	if err := k.ResetVoteTallies(ctx); err != nil {
+		panic(err)
+	}
+
 	// if account has zero balance it probably means it's not set, so we set it
 	balance := bk.GetAllBalances(ctx, moduleAcc.GetAddress())
 	if balance.IsZero() {
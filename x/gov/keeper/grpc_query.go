@@ -104,6 +104,26 @@ func (q queryServer) Proposals(ctx context.Context, req *v1.QueryProposalsReques
 	return &v1.QueryProposalsResponse{Proposals: filteredProposals, Pagination: pageRes}, nil
 }
 
+// ScheduledProposals implements the Query/ScheduledProposals gRPC method
+func (q queryServer) ScheduledProposals(ctx context.Context, req *v1.QueryScheduledProposalsRequest) (*v1.QueryScheduledProposalsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	proposals, pageRes, err := query.CollectionPaginate(ctx, q.k.ScheduledProposals, req.Pagination, func(proposalID uint64, _ []byte) (*v1.Proposal, error) {
+		proposal, err := q.k.Proposals.Get(ctx, proposalID)
+		if err != nil {
+			return nil, err
+		}
+		return &proposal, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &v1.QueryScheduledProposalsResponse{Proposals: proposals, Pagination: pageRes}, nil
+}
+
 // Vote returns Voted information based on proposalID, voterAddr
 func (q queryServer) Vote(ctx context.Context, req *v1.QueryVoteRequest) (*v1.QueryVoteResponse, error) {
 	if req == nil {
@@ -272,7 +292,12 @@ func (q queryServer) TallyResult(ctx context.Context, req *v1.QueryTallyResultRe
 		}
 	}
 
-	return &v1.QueryTallyResultResponse{Tally: &tallyResult}, nil
+	res := &v1.QueryTallyResultResponse{Tally: &tallyResult}
+	if proposal.VoteOptions != nil {
+		res.OptionTallies = proposal.VoteOptions.OptionTallies(tallyResult)
+	}
+
+	return res, nil
 }
 
 var _ v1beta1.QueryServer = legacyQueryServer{}
@@ -0,0 +1,73 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/math"
+
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// StakingHooks keeps the running tallies of the proposals in voting period up
+// to date with the delegations of their voters.
+type StakingHooks struct {
+	k Keeper
+}
+
+var _ stakingtypes.StakingHooks = StakingHooks{}
+
+// StakingHooks returns the staking hooks of the governance keeper.
+func (k Keeper) StakingHooks() StakingHooks {
+	return StakingHooks{k}
+}
+
+// AfterDelegationModified updates the shares of the delegation in the running
+// tallies of the proposals the delegator voted on.
+func (h StakingHooks) AfterDelegationModified(ctx context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) error {
+	delegation, err := h.k.sk.Delegation(ctx, delAddr, valAddr)
+	if err != nil {
+		return err
+	}
+
+	return h.k.syncVoteShares(ctx, delAddr, valAddr, delegation.GetShares())
+}
+
+// BeforeDelegationRemoved removes the delegation from the running tallies of
+// the proposals the delegator voted on.
+func (h StakingHooks) BeforeDelegationRemoved(ctx context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) error {
+	return h.k.syncVoteShares(ctx, delAddr, valAddr, math.LegacyZeroDec())
+}
+
+func (StakingHooks) AfterValidatorCreated(context.Context, sdk.ValAddress) error   { return nil }
+func (StakingHooks) BeforeValidatorModified(context.Context, sdk.ValAddress) error { return nil }
+func (StakingHooks) AfterValidatorRemoved(context.Context, sdk.ConsAddress, sdk.ValAddress) error {
+	return nil
+}
+
+func (StakingHooks) AfterValidatorBonded(context.Context, sdk.ConsAddress, sdk.ValAddress) error {
+	return nil
+}
+
+func (StakingHooks) AfterValidatorBeginUnbonding(context.Context, sdk.ConsAddress, sdk.ValAddress) error {
+	return nil
+}
+
+func (StakingHooks) BeforeDelegationCreated(context.Context, sdk.AccAddress, sdk.ValAddress) error {
+	return nil
+}
+
+func (StakingHooks) BeforeDelegationSharesModified(context.Context, sdk.AccAddress, sdk.ValAddress) error {
+	return nil
+}
+
+func (StakingHooks) BeforeValidatorSlashed(context.Context, sdk.ValAddress, math.LegacyDec) error {
+	return nil
+}
+
+func (StakingHooks) AfterUnbondingInitiated(context.Context, uint64) error { return nil }
+
+func (StakingHooks) AfterConsensusPubKeyUpdate(context.Context, cryptotypes.PubKey, cryptotypes.PubKey, sdk.Coin) error {
+	return nil
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/gov/types"
@@ -13,6 +14,7 @@ import (
 // RegisterInvariants registers all governance invariants
 func RegisterInvariants(ir sdk.InvariantRegistry, keeper *Keeper, bk types.BankKeeper) {
 	ir.RegisterRoute(types.ModuleName, "module-account", ModuleAccountInvariant(keeper, bk))
+	ir.RegisterRoute(types.ModuleName, "vote-tallies", VoteTalliesInvariant(keeper))
 }
 
 // ModuleAccountInvariant checks that the module account coins reflects the sum of
@@ -42,3 +44,53 @@ func ModuleAccountInvariant(keeper *Keeper, bk types.BankKeeper) sdk.Invariant {
 				balances, expectedDeposits)), broken
 	}
 }
+
+// VoteTalliesInvariant checks that the running tallies of the proposals in
+// voting period match a recount of their votes.
+func VoteTalliesInvariant(keeper *Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg    string
+			broken bool
+		)
+
+		err := keeper.VotingPeriodProposals.Walk(ctx, nil, func(proposalID uint64, _ []byte) (stop bool, err error) {
+			shares, deductions, err := keeper.voteTally(ctx, proposalID)
+			if err != nil {
+				return true, err
+			}
+
+			expShares, expDeductions, err := keeper.recountVoteTally(ctx, proposalID)
+			if err != nil {
+				return true, err
+			}
+
+			if !equalTallies(shares, expShares) || !equalTallies(deductions, expDeductions) {
+				broken = true
+				msg += fmt.Sprintf("\tproposal %d running tally:\n\t\tshares: %v, deductions: %v\n\trecount:\n\t\tshares: %v, deductions: %v\n",
+					proposalID, shares, deductions, expShares, expDeductions)
+			}
+
+			return false, nil
+		})
+		if err != nil {
+			panic(err)
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "vote tallies", msg), broken
+	}
+}
+
+func equalTallies(a, b map[string]math.LegacyDec) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for key, value := range a {
+		if other, ok := b[key]; !ok || !value.Equal(other) {
+			return false
+		}
+	}
+
+	return true
+}
@@ -8,6 +8,7 @@ import (
 	"cosmossdk.io/collections"
 	corestoretypes "cosmossdk.io/core/store"
 	"cosmossdk.io/log"
+	"cosmossdk.io/math"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -29,6 +30,10 @@ type Keeper struct {
 	// GovHooks
 	hooks types.GovHooks
 
+	// tally functions, by proposal message type URL, and the default one
+	proposalTallyFns map[string]TallyFn
+	tallyFn          TallyFn
+
 	// The (unexposed) keys used to access the stores from the Context.
 	storeService corestoretypes.KVStoreService
 
@@ -57,6 +62,14 @@ type Keeper struct {
 	ActiveProposalsQueue   collections.Map[collections.Pair[time.Time, uint64], uint64] // TODO(tip): this should be simplified and go into an index.
 	InactiveProposalsQueue collections.Map[collections.Pair[time.Time, uint64], uint64] // TODO(tip): this should be simplified and go into an index.
 	VotingPeriodProposals  collections.Map[uint64, []byte]                              // TODO(tip): this could be a keyset or index.
+	// VoteShares, TallyShares and TallyDeductions hold the running tallies of
+	// the proposals in voting period, see vote_tally.go.
+	VoteShares      collections.Map[collections.Triple[uint64, sdk.AccAddress, sdk.ValAddress], math.LegacyDec]
+	TallyShares     collections.Map[collections.Triple[uint64, sdk.ValAddress, int32], math.LegacyDec]
+	TallyDeductions collections.Map[collections.Pair[uint64, sdk.ValAddress], math.LegacyDec]
+	// ScheduledProposals holds the passed proposals whose messages are queued
+	// for execution, see schedule.go.
+	ScheduledProposals collections.Map[uint64, []byte]
 }
 
 // GetAuthority returns the x/gov module's authority.
@@ -101,6 +114,8 @@ func NewKeeper(
 		router:                 router,
 		config:                 config,
 		authority:              authority,
+		proposalTallyFns:       make(map[string]TallyFn),
+		tallyFn:                DefaultTallyFn,
 		Constitution:           collections.NewItem(sb, types.ConstitutionKey, "constitution", collections.StringValue),
 		Params:                 collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[v1.Params](cdc)),
 		Deposits:               collections.NewMap(sb, types.DepositsKeyPrefix, "deposits", collections.PairKeyCodec(collections.Uint64Key, sdk.LengthPrefixedAddressKey(sdk.AccAddressKey)), codec.CollValue[v1.Deposit](cdc)), // nolint: staticcheck // sdk.LengthPrefixedAddressKey is needed to retain state compatibility
@@ -110,6 +125,10 @@ func NewKeeper(
 		ActiveProposalsQueue:   collections.NewMap(sb, types.ActiveProposalQueuePrefix, "active_proposals_queue", collections.PairKeyCodec(sdk.TimeKey, collections.Uint64Key), collections.Uint64Value),     // sdk.TimeKey is needed to retain state compatibility
 		InactiveProposalsQueue: collections.NewMap(sb, types.InactiveProposalQueuePrefix, "inactive_proposals_queue", collections.PairKeyCodec(sdk.TimeKey, collections.Uint64Key), collections.Uint64Value), // sdk.TimeKey is needed to retain state compatibility
 		VotingPeriodProposals:  collections.NewMap(sb, types.VotingPeriodProposalKeyPrefix, "voting_period_proposals", collections.Uint64Key, collections.BytesValue),
+		VoteShares:             collections.NewMap(sb, types.VoteSharesKeyPrefix, "vote_shares", collections.TripleKeyCodec(collections.Uint64Key, sdk.AccAddressKey, sdk.ValAddressKey), sdk.LegacyDecValue),
+		TallyShares:            collections.NewMap(sb, types.TallySharesKeyPrefix, "tally_shares", collections.TripleKeyCodec(collections.Uint64Key, sdk.ValAddressKey, collections.Int32Key), sdk.LegacyDecValue),
+		TallyDeductions:        collections.NewMap(sb, types.TallyDeductionsKeyPrefix, "tally_deductions", collections.PairKeyCodec(collections.Uint64Key, sdk.ValAddressKey), sdk.LegacyDecValue),
+		ScheduledProposals:     collections.NewMap(sb, types.ScheduledProposalsKeyPrefix, "scheduled_proposals", collections.Uint64Key, collections.BytesValue),
 	}
 	schema, err := sb.Build()
 	if err != nil {
@@ -7,6 +7,7 @@ import (
 	v3 "github.com/cosmos/cosmos-sdk/x/gov/migrations/v3"
 	v4 "github.com/cosmos/cosmos-sdk/x/gov/migrations/v4"
 	v5 "github.com/cosmos/cosmos-sdk/x/gov/migrations/v5"
+	v6 "github.com/cosmos/cosmos-sdk/x/gov/migrations/v6"
 )
 
 // Migrator is a struct for handling in-place store migrations.
@@ -42,3 +43,12 @@ func (m Migrator) Migrate3to4(ctx sdk.Context) error {
 func (m Migrator) Migrate4to5(ctx sdk.Context) error {
 	return v5.MigrateStore(ctx, m.keeper.storeService, m.keeper.cdc, m.keeper.Constitution)
 }
+
+// Migrate5to6 migrates from version 5 to 6. It sets the optimistic proposal
+// params and builds the running tallies of the proposals in voting period.
+func (m Migrator) Migrate5to6(ctx sdk.Context) error {
+	if err := v6.MigrateStore(ctx, m.keeper.storeService, m.keeper.cdc); err != nil {
+		return err
+	}
+	return m.keeper.ResetVoteTallies(ctx)
+}
@@ -41,8 +41,8 @@ func (k msgServer) SubmitProposal(goCtx context.Context, msg *v1.MsgSubmitPropos
 		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid proposer address: %s", err)
 	}
 
-	// check that either metadata or Msgs length is non nil.
-	if len(msg.Messages) == 0 && len(msg.Metadata) == 0 {
+	// check that either metadata, Msgs or vote options are non nil.
+	if len(msg.Messages) == 0 && len(msg.Metadata) == 0 && msg.VoteOptions == nil {
 		return nil, errors.Wrap(govtypes.ErrNoProposalMsgs, "either metadata or Msgs length must be non-nil")
 	}
 
@@ -84,11 +84,31 @@ func (k msgServer) SubmitProposal(goCtx context.Context, msg *v1.MsgSubmitPropos
 		return nil, err
 	}
 
-	proposal, err := k.Keeper.SubmitProposal(ctx, proposalMsgs, msg.Metadata, msg.Title, msg.Summary, proposer, msg.Expedited)
+	var proposal v1.Proposal
+	if msg.ProposalType == v1.ProposalType_PROPOSAL_TYPE_MULTIPLE_CHOICE {
+		if msg.VoteOptions == nil {
+			return nil, errors.Wrap(govtypes.ErrInvalidProposalType, "multiple choice proposals must have vote options")
+		}
+		if len(proposalMsgs) != 0 || msg.Expedited {
+			return nil, errors.Wrap(govtypes.ErrInvalidProposalType, "multiple choice proposals cannot have messages or be expedited")
+		}
+		proposal, err = k.Keeper.SubmitMultipleChoiceProposal(ctx, *msg.VoteOptions, msg.Metadata, msg.Title, msg.Summary, proposer)
+	} else {
+		if msg.VoteOptions != nil {
+			return nil, errors.Wrap(govtypes.ErrInvalidProposalType, "only multiple choice proposals have vote options")
+		}
+		proposal, err = k.Keeper.SubmitProposalWithType(ctx, proposalMsgs, msg.Metadata, msg.Title, msg.Summary, proposer, msg.Expedited, msg.ProposalType)
+	}
 	if err != nil {
 		return nil, err
 	}
 
+	if msg.ExecutionTime != nil || msg.ExecutionHeight != 0 {
+		if err := k.Keeper.SetProposalExecution(ctx, proposal.Id, msg.ExecutionTime, msg.ExecutionHeight); err != nil {
+			return nil, err
+		}
+	}
+
 	bytes, err := proposal.Marshal()
 	if err != nil {
 		return nil, err
@@ -284,6 +304,20 @@ func (k msgServer) UpdateParams(goCtx context.Context, msg *v1.MsgUpdateParams)
 	return &v1.MsgUpdateParamsResponse{}, nil
 }
 
+// CancelScheduledExecution implements the MsgServer.CancelScheduledExecution method.
+func (k msgServer) CancelScheduledExecution(goCtx context.Context, msg *v1.MsgCancelScheduledExecution) (*v1.MsgCancelScheduledExecutionResponse, error) {
+	if k.authority != msg.Authority {
+		return nil, errors.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, msg.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.Keeper.CancelScheduledExecution(ctx, msg.ProposalId); err != nil {
+		return nil, err
+	}
+
+	return &v1.MsgCancelScheduledExecutionResponse{}, nil
+}
+
 type legacyMsgServer struct {
 	govAcct string
 	server  v1.MsgServer
@@ -15,8 +15,26 @@ import (
 	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
 )
 
-// SubmitProposal creates a new proposal given an array of messages
+// SubmitProposal creates a new standard proposal given an array of messages
 func (keeper Keeper) SubmitProposal(ctx context.Context, messages []sdk.Msg, metadata, title, summary string, proposer sdk.AccAddress, expedited bool) (v1.Proposal, error) {
+	return keeper.SubmitProposalWithType(ctx, messages, metadata, title, summary, proposer, expedited, v1.ProposalType_PROPOSAL_TYPE_STANDARD)
+}
+
+// SubmitProposalWithType creates a new proposal of the given type given an
+// array of messages. Optimistic proposals can only be submitted by the
+// addresses authorized in the params, and cannot be expedited. Multiple choice
+// proposals are submitted with SubmitMultipleChoiceProposal.
+func (keeper Keeper) SubmitProposalWithType(ctx context.Context, messages []sdk.Msg, metadata, title, summary string, proposer sdk.AccAddress, expedited bool, proposalType v1.ProposalType) (v1.Proposal, error) {
+	return keeper.submitProposal(ctx, messages, metadata, title, summary, proposer, expedited, proposalType, nil)
+}
+
+// SubmitMultipleChoiceProposal creates a new multiple choice proposal given
+// its vote options. Multiple choice proposals execute no messages.
+func (keeper Keeper) SubmitMultipleChoiceProposal(ctx context.Context, voteOptions v1.ProposalVoteOptions, metadata, title, summary string, proposer sdk.AccAddress) (v1.Proposal, error) {
+	return keeper.submitProposal(ctx, nil, metadata, title, summary, proposer, false, v1.ProposalType_PROPOSAL_TYPE_MULTIPLE_CHOICE, &voteOptions)
+}
+
+func (keeper Keeper) submitProposal(ctx context.Context, messages []sdk.Msg, metadata, title, summary string, proposer sdk.AccAddress, expedited bool, proposalType v1.ProposalType, voteOptions *v1.ProposalVoteOptions) (v1.Proposal, error) {
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 	err := keeper.assertMetadataLength(metadata)
 	if err != nil {
@@ -96,6 +114,13 @@ func (keeper Keeper) SubmitProposal(ctx context.Context, messages []sdk.Msg, met
 		return v1.Proposal{}, err
 	}
 
+	if proposalType == v1.ProposalType_PROPOSAL_TYPE_UNSPECIFIED {
+		proposalType = v1.ProposalType_PROPOSAL_TYPE_STANDARD
+	}
+	if err := keeper.assertProposalType(params, proposalType, messages, proposer, expedited, voteOptions); err != nil {
+		return v1.Proposal{}, err
+	}
+
 	submitTime := sdkCtx.BlockHeader().Time
 	depositPeriod := params.MaxDepositPeriod
 
@@ -103,6 +128,8 @@ func (keeper Keeper) SubmitProposal(ctx context.Context, messages []sdk.Msg, met
 	if err != nil {
 		return v1.Proposal{}, err
 	}
+	proposal.ProposalType = proposalType
+	proposal.VoteOptions = voteOptions
 
 	err = keeper.SetProposal(ctx, proposal)
 	if err != nil {
@@ -124,12 +151,57 @@ func (keeper Keeper) SubmitProposal(ctx context.Context, messages []sdk.Msg, met
 			types.EventTypeSubmitProposal,
 			sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposalID)),
 			sdk.NewAttribute(types.AttributeKeyProposalMessages, msgsStr),
+			sdk.NewAttribute(types.AttributeKeyProposalType, proposalType.String()),
 		),
 	)
 
 	return proposal, nil
 }
 
+// assertProposalType returns an error if the proposer cannot submit a proposal
+// of the given type, or if the proposal does not fit its type.
+func (keeper Keeper) assertProposalType(params v1.Params, proposalType v1.ProposalType, messages []sdk.Msg, proposer sdk.AccAddress, expedited bool, voteOptions *v1.ProposalVoteOptions) error {
+	if voteOptions != nil && proposalType != v1.ProposalType_PROPOSAL_TYPE_MULTIPLE_CHOICE {
+		return errorsmod.Wrap(types.ErrInvalidProposalType, "only multiple choice proposals have vote options")
+	}
+
+	switch proposalType {
+	case v1.ProposalType_PROPOSAL_TYPE_STANDARD:
+		return nil
+	case v1.ProposalType_PROPOSAL_TYPE_OPTIMISTIC:
+		if expedited {
+			return errorsmod.Wrap(types.ErrInvalidProposalType, "optimistic proposals cannot be expedited")
+		}
+		for _, addr := range params.OptimisticAuthorizedAddresses {
+			if addr == proposer.String() {
+				return nil
+			}
+		}
+		return errorsmod.Wrapf(types.ErrInvalidProposer, "%s is not authorized to submit optimistic proposals", proposer)
+	case v1.ProposalType_PROPOSAL_TYPE_MULTIPLE_CHOICE:
+		if expedited {
+			return errorsmod.Wrap(types.ErrInvalidProposalType, "multiple choice proposals cannot be expedited")
+		}
+		if len(messages) != 0 {
+			return errorsmod.Wrap(types.ErrInvalidProposalType, "multiple choice proposals cannot have messages")
+		}
+		if voteOptions == nil {
+			return errorsmod.Wrap(types.ErrInvalidProposalType, "multiple choice proposals must have vote options")
+		}
+		if err := voteOptions.ValidateBasic(); err != nil {
+			return errorsmod.Wrap(types.ErrInvalidProposalType, err.Error())
+		}
+		for _, option := range []string{voteOptions.OptionOne, voteOptions.OptionTwo, voteOptions.OptionThree, voteOptions.OptionFour} {
+			if err := keeper.assertMetadataLength(option); err != nil {
+				return err
+			}
+		}
+		return nil
+	default:
+		return errorsmod.Wrapf(types.ErrInvalidProposalType, "%s", proposalType)
+	}
+}
+
 // CancelProposal will cancel proposal before the voting period ends
 func (keeper Keeper) CancelProposal(ctx context.Context, proposalID uint64, proposer string) error {
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
@@ -206,6 +278,18 @@ func (keeper Keeper) SetProposal(ctx context.Context, proposal v1.Proposal) erro
 		}
 	}
 
+	if proposal.Status == v1.StatusScheduled {
+		err := keeper.ScheduledProposals.Set(ctx, proposal.Id, []byte{1})
+		if err != nil {
+			return err
+		}
+	} else {
+		err := keeper.ScheduledProposals.Remove(ctx, proposal.Id)
+		if err != nil {
+			return err
+		}
+	}
+
 	return keeper.Proposals.Set(ctx, proposal.Id, proposal)
 }
 
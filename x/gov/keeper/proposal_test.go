@@ -8,6 +8,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
 
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -173,6 +174,72 @@ func (suite *KeeperTestSuite) TestSubmitProposal() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestSubmitOptimisticProposal() {
+	params, err := suite.govKeeper.Params.Get(suite.ctx)
+	suite.Require().NoError(err)
+	params.OptimisticAuthorizedAddresses = []string{suite.addrs[0].String()}
+	suite.Require().NoError(suite.govKeeper.Params.Set(suite.ctx, params))
+
+	testCases := []struct {
+		name         string
+		proposer     sdk.AccAddress
+		expedited    bool
+		proposalType v1.ProposalType
+		expectedType v1.ProposalType
+		expectedErr  error
+	}{
+		{"unspecified proposal type", suite.addrs[1], false, v1.ProposalType_PROPOSAL_TYPE_UNSPECIFIED, v1.ProposalType_PROPOSAL_TYPE_STANDARD, nil},
+		{"authorized proposer", suite.addrs[0], false, v1.ProposalType_PROPOSAL_TYPE_OPTIMISTIC, v1.ProposalType_PROPOSAL_TYPE_OPTIMISTIC, nil},
+		{"unauthorized proposer", suite.addrs[1], false, v1.ProposalType_PROPOSAL_TYPE_OPTIMISTIC, 0, types.ErrInvalidProposer},
+		{"expedited optimistic proposal", suite.addrs[0], true, v1.ProposalType_PROPOSAL_TYPE_OPTIMISTIC, 0, types.ErrInvalidProposalType},
+		{"invalid proposal type", suite.addrs[0], false, v1.ProposalType(100), 0, types.ErrInvalidProposalType},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			proposal, err := suite.govKeeper.SubmitProposalWithType(suite.ctx, TestProposal, "", "title", "summary", tc.proposer, tc.expedited, tc.proposalType)
+			if tc.expectedErr != nil {
+				suite.Require().ErrorIs(err, tc.expectedErr)
+				return
+			}
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.expectedType, proposal.ProposalType)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestMultipleChoiceProposal() {
+	voteOptions := v1.ProposalVoteOptions{OptionOne: "first", OptionTwo: "second"}
+
+	_, err := suite.govKeeper.SubmitProposalWithType(suite.ctx, nil, "", "title", "summary", suite.addrs[0], false, v1.ProposalType_PROPOSAL_TYPE_MULTIPLE_CHOICE)
+	suite.Require().ErrorIs(err, types.ErrInvalidProposalType)
+	_, err = suite.govKeeper.SubmitMultipleChoiceProposal(suite.ctx, v1.ProposalVoteOptions{OptionOne: "first"}, "", "title", "summary", suite.addrs[0])
+	suite.Require().ErrorIs(err, types.ErrInvalidProposalType)
+
+	proposal, err := suite.govKeeper.SubmitMultipleChoiceProposal(suite.ctx, voteOptions, "", "title", "summary", suite.addrs[0])
+	suite.Require().NoError(err)
+	suite.Require().Equal(v1.ProposalType_PROPOSAL_TYPE_MULTIPLE_CHOICE, proposal.ProposalType)
+	suite.Require().Equal(&voteOptions, proposal.VoteOptions)
+	suite.Require().NoError(suite.govKeeper.ActivateVotingPeriod(suite.ctx, proposal))
+
+	// votes are restricted to the named options, and can be split among them
+	err = suite.govKeeper.AddVote(suite.ctx, proposal.Id, suite.addrs[0], v1.NewNonSplitVoteOption(v1.OptionThree), "")
+	suite.Require().ErrorIs(err, types.ErrInvalidVote)
+	err = suite.govKeeper.AddVote(suite.ctx, proposal.Id, suite.addrs[0], v1.WeightedVoteOptions{
+		v1.NewWeightedVoteOption(v1.OptionOne, math.LegacyNewDecWithPrec(7, 1)),
+		v1.NewWeightedVoteOption(v1.OptionTwo, math.LegacyNewDecWithPrec(3, 1)),
+	}, "")
+	suite.Require().NoError(err)
+
+	// the tally is reported by option name
+	res, err := suite.queryClient.TallyResult(suite.ctx, &v1.QueryTallyResultRequest{ProposalId: proposal.Id})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]*v1.OptionTallyResult{
+		{Option: "first", Count: res.Tally.YesCount},
+		{Option: "second", Count: res.Tally.AbstainCount},
+	}, res.OptionTallies)
+}
+
 func (suite *KeeperTestSuite) TestCancelProposal() {
 	govAcct := suite.govKeeper.GetGovernanceAccount(suite.ctx).GetAddress().String()
 	tp := v1beta1.TextProposal{Title: "title", Description: "description"}
@@ -0,0 +1,91 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
+	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+)
+
+// A proposal can carry an execution time or height, from which its messages
+// are executed once it passes. Until then, the passed proposal is scheduled:
+// its status is StatusScheduled and it is indexed in ScheduledProposals, which
+// the EndBlocker goes through to execute the proposals that are due. The
+// execution of a scheduled proposal can be canceled by governance with
+// MsgCancelScheduledExecution.
+
+// SetProposalExecution sets the execution time or height of a proposal in
+// deposit period. At most one of them can be set, and it must be in the
+// future.
+func (keeper Keeper) SetProposalExecution(ctx context.Context, proposalID uint64, executionTime *time.Time, executionHeight int64) error {
+	proposal, err := keeper.Proposals.Get(ctx, proposalID)
+	if err != nil {
+		return err
+	}
+
+	if proposal.Status != v1.StatusDepositPeriod {
+		return errorsmod.Wrapf(types.ErrInvalidProposal, "proposal %d is not in deposit period", proposalID)
+	}
+	if len(proposal.Messages) == 0 {
+		return errorsmod.Wrapf(types.ErrInvalidProposal, "proposal %d has no messages to schedule", proposalID)
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	switch {
+	case executionTime != nil && executionHeight != 0:
+		return errorsmod.Wrap(types.ErrInvalidProposal, "only one of execution time and height can be set")
+	case executionTime != nil && !executionTime.After(sdkCtx.BlockTime()):
+		return errorsmod.Wrapf(types.ErrInvalidProposal, "execution time %s must be in the future", executionTime)
+	case executionHeight < 0 || (executionHeight != 0 && executionHeight <= sdkCtx.BlockHeight()):
+		return errorsmod.Wrapf(types.ErrInvalidProposal, "execution height %d must be in the future", executionHeight)
+	}
+
+	proposal.ExecutionTime = executionTime
+	proposal.ExecutionHeight = executionHeight
+	return keeper.SetProposal(ctx, proposal)
+}
+
+// IsExecutionDue returns true if the messages of a passed proposal can be
+// executed, that is if the proposal has no execution time or height, or if it
+// has been reached.
+func (keeper Keeper) IsExecutionDue(ctx context.Context, proposal v1.Proposal) bool {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if proposal.ExecutionTime != nil && sdkCtx.BlockTime().Before(*proposal.ExecutionTime) {
+		return false
+	}
+	return proposal.ExecutionHeight <= sdkCtx.BlockHeight()
+}
+
+// CancelScheduledExecution cancels the execution of a scheduled proposal,
+// which fails without executing its messages.
+func (keeper Keeper) CancelScheduledExecution(ctx context.Context, proposalID uint64) error {
+	proposal, err := keeper.Proposals.Get(ctx, proposalID)
+	if err != nil {
+		return err
+	}
+
+	if proposal.Status != v1.StatusScheduled {
+		return errorsmod.Wrapf(types.ErrInvalidProposal, "proposal %d is not scheduled for execution", proposalID)
+	}
+
+	proposal.Status = v1.StatusFailed
+	proposal.FailedReason = "scheduled execution canceled by governance"
+	if err := keeper.SetProposal(ctx, proposal); err != nil {
+		return err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCancelScheduledExecution,
+			sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposalID)),
+		),
+	)
+
+	return nil
+}
@@ -2,6 +2,8 @@ package keeper
 
 import (
 	"context"
+	"errors"
+	"fmt"
 
 	"cosmossdk.io/collections"
 	"cosmossdk.io/math"
@@ -11,106 +13,81 @@ import (
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
-// TODO: Break into several smaller functions for clarity
-
-// Tally iterates over the votes and updates the tally of a proposal based on the voting power of the
-// voters
-func (keeper Keeper) Tally(ctx context.Context, proposal v1.Proposal) (passes, burnDeposits bool, tallyResults v1.TallyResult, err error) {
-	results := make(map[v1.VoteOption]math.LegacyDec)
-	results[v1.OptionYes] = math.LegacyZeroDec()
-	results[v1.OptionAbstain] = math.LegacyZeroDec()
-	results[v1.OptionNo] = math.LegacyZeroDec()
-	results[v1.OptionNoWithVeto] = math.LegacyZeroDec()
+// TallyFn computes the voting power cast for each vote option of a proposal.
+// It returns the results by vote option, the total voting power cast, and the
+// total voting power of the electorate, against which the quorum is checked.
+//
+// A TallyFn must not modify the state: the votes of the proposal are removed
+// by the keeper once tallied.
+type TallyFn func(ctx context.Context, k Keeper, proposal v1.Proposal) (results map[v1.VoteOption]math.LegacyDec, totalVotingPower, electorate math.LegacyDec, err error)
+
+// SetTallyFn sets the tally function used for the proposals which have no
+// tally function registered for their messages. It defaults to DefaultTallyFn.
+func (k *Keeper) SetTallyFn(fn TallyFn) *Keeper {
+	if fn == nil {
+		panic("cannot set a nil tally function")
+	}
 
-	totalVotingPower := math.LegacyZeroDec()
-	currValidators := make(map[string]v1.ValidatorGovInfo)
+	k.tallyFn = fn
 
-	// fetch all the bonded validators, insert them into currValidators
-	err = keeper.sk.IterateBondedValidatorsByPower(ctx, func(index int64, validator stakingtypes.ValidatorI) (stop bool) {
-		valBz, err := keeper.sk.ValidatorAddressCodec().StringToBytes(validator.GetOperator())
-		if err != nil {
-			return false
-		}
-		currValidators[validator.GetOperator()] = v1.NewValidatorGovInfo(
-			valBz,
-			validator.GetBondedTokens(),
-			validator.GetDelegatorShares(),
-			math.LegacyZeroDec(),
-			v1.WeightedVoteOptions{},
-		)
+	return k
+}
 
-		return false
-	})
-	if err != nil {
-		return false, false, tallyResults, err
+// SetProposalTallyFn registers the tally function used for the proposals whose
+// messages are all of the given type URL, e.g. "/cosmos.upgrade.v1beta1.MsgSoftwareUpgrade".
+func (k *Keeper) SetProposalTallyFn(msgTypeURL string, fn TallyFn) *Keeper {
+	if msgTypeURL == "" || fn == nil {
+		panic("cannot set a tally function without message type or function")
 	}
 
-	rng := collections.NewPrefixedPairRange[uint64, sdk.AccAddress](proposal.Id)
-	err = keeper.Votes.Walk(ctx, rng, func(key collections.Pair[uint64, sdk.AccAddress], vote v1.Vote) (bool, error) {
-		// if validator, just record it in the map
-		voter, err := keeper.authKeeper.AddressCodec().StringToBytes(vote.Voter)
-		if err != nil {
-			return false, err
-		}
-
-		valAddrStr, err := keeper.sk.ValidatorAddressCodec().BytesToString(voter)
-		if err != nil {
-			return false, err
-		}
-		if val, ok := currValidators[valAddrStr]; ok {
-			val.Vote = vote.Options
-			currValidators[valAddrStr] = val
-		}
-
-		// iterate over all delegations from voter, deduct from any delegated-to validators
-		err = keeper.sk.IterateDelegations(ctx, voter, func(index int64, delegation stakingtypes.DelegationI) (stop bool) {
-			valAddrStr := delegation.GetValidatorAddr()
+	if _, ok := k.proposalTallyFns[msgTypeURL]; ok {
+		panic(fmt.Sprintf("tally function already set for %s", msgTypeURL))
+	}
 
-			if val, ok := currValidators[valAddrStr]; ok {
-				// There is no need to handle the special case that validator address equal to voter address.
-				// Because voter's voting power will tally again even if there will be deduction of voter's voting power from validator.
-				val.DelegatorDeductions = val.DelegatorDeductions.Add(delegation.GetShares())
-				currValidators[valAddrStr] = val
+	k.proposalTallyFns[msgTypeURL] = fn
 
-				// delegation shares * bonded / total shares
-				votingPower := delegation.GetShares().MulInt(val.BondedTokens).Quo(val.DelegatorShares)
-
-				for _, option := range vote.Options {
-					weight, _ := math.LegacyNewDecFromStr(option.Weight)
-					subPower := votingPower.Mul(weight)
-					results[option.Option] = results[option.Option].Add(subPower)
-				}
-				totalVotingPower = totalVotingPower.Add(votingPower)
-			}
+	return k
+}
 
-			return false
-		})
-		if err != nil {
-			return false, err
+// getTallyFn returns the tally function of proposal: the one registered for
+// its messages when they are all of the same type, the keeper tally function
+// otherwise.
+func (keeper Keeper) getTallyFn(proposal v1.Proposal) TallyFn {
+	var typeURL string
+	for i, msg := range proposal.Messages {
+		if i > 0 && msg.TypeUrl != typeURL {
+			typeURL = ""
+			break
 		}
+		typeURL = msg.TypeUrl
+	}
 
-		return false, keeper.Votes.Remove(ctx, collections.Join(vote.ProposalId, sdk.AccAddress(voter)))
-	})
+	if fn, ok := keeper.proposalTallyFns[typeURL]; ok {
+		return fn
+	}
 
+	return keeper.tallyFn
+}
+
+// Tally computes the tally of a proposal based on the voting power of the voters, as computed by the
+// tally function of the proposal, and removes its votes
+func (keeper Keeper) Tally(ctx context.Context, proposal v1.Proposal) (passes, burnDeposits bool, tallyResults v1.TallyResult, err error) {
+	results, totalVotingPower, electorate, err := keeper.getTallyFn(proposal)(ctx, keeper, proposal)
 	if err != nil {
 		return false, false, tallyResults, err
 	}
 
-	// iterate over the validators again to tally their voting power
-	for _, val := range currValidators {
-		if len(val.Vote) == 0 {
-			continue
-		}
-
-		sharesAfterDeductions := val.DelegatorShares.Sub(val.DelegatorDeductions)
-		votingPower := sharesAfterDeductions.MulInt(val.BondedTokens).Quo(val.DelegatorShares)
+	if err := keeper.deleteVotes(ctx, proposal.Id); err != nil {
+		return false, false, tallyResults, err
+	}
 
-		for _, option := range val.Vote {
-			weight, _ := math.LegacyNewDecFromStr(option.Weight)
-			subPower := votingPower.Mul(weight)
-			results[option.Option] = results[option.Option].Add(subPower)
+	if results == nil {
+		results = make(map[v1.VoteOption]math.LegacyDec)
+	}
+	for _, option := range []v1.VoteOption{v1.OptionYes, v1.OptionAbstain, v1.OptionNo, v1.OptionNoWithVeto} {
+		if _, ok := results[option]; !ok {
+			results[option] = math.LegacyZeroDec()
 		}
-		totalVotingPower = totalVotingPower.Add(votingPower)
 	}
 
 	params, err := keeper.Params.Get(ctx)
@@ -120,23 +97,35 @@ func (keeper Keeper) Tally(ctx context.Context, proposal v1.Proposal) (passes, b
 	tallyResults = v1.NewTallyResultFromMap(results)
 
 	// TODO: Upgrade the spec to cover all of these cases & remove pseudocode.
-	// If there is no staked coins, the proposal fails
-	totalBonded, err := keeper.sk.TotalBondedTokens(ctx)
-	if err != nil {
-		return false, false, tallyResults, err
+	// If there is no voting power in the electorate, the proposal fails
+	if !electorate.IsPositive() {
+		return false, false, tallyResults, nil
 	}
 
-	if totalBonded.IsZero() {
-		return false, false, tallyResults, nil
+	// An optimistic proposal passes unless enough of the electorate votes No or
+	// NoWithVeto, and its deposits are never burned
+	if proposal.ProposalType == v1.ProposalType_PROPOSAL_TYPE_OPTIMISTIC {
+		rejectedThreshold, _ := math.LegacyNewDecFromStr(params.OptimisticRejectedThreshold)
+		against := results[v1.OptionNo].Add(results[v1.OptionNoWithVeto])
+		if against.Quo(electorate).GTE(rejectedThreshold) {
+			return false, false, tallyResults, nil
+		}
+		return true, false, tallyResults, nil
 	}
 
 	// If there is not enough quorum of votes, the proposal fails
-	percentVoting := totalVotingPower.Quo(math.LegacyNewDecFromInt(totalBonded))
+	percentVoting := totalVotingPower.Quo(electorate)
 	quorum, _ := math.LegacyNewDecFromStr(params.Quorum)
 	if percentVoting.LT(quorum) {
 		return false, params.BurnVoteQuorum, tallyResults, nil
 	}
 
+	// A multiple choice proposal passes once the quorum is reached, its result
+	// being the tally of its vote options
+	if proposal.ProposalType == v1.ProposalType_PROPOSAL_TYPE_MULTIPLE_CHOICE {
+		return true, false, tallyResults, nil
+	}
+
 	// If no one votes (everyone abstains), proposal fails
 	if totalVotingPower.Sub(results[v1.OptionAbstain]).Equal(math.LegacyZeroDec()) {
 		return false, false, tallyResults, nil
@@ -166,3 +155,89 @@ func (keeper Keeper) Tally(ctx context.Context, proposal v1.Proposal) (passes, b
 	// If more than 1/2 of non-abstaining voters vote No, proposal fails
 	return false, false, tallyResults, nil
 }
+
+// DefaultTallyFn is the stake-weighted tally function: the voting power of a
+// voter is the bonded tokens it delegates, and validators vote with the tokens
+// delegated to them by the delegators which did not vote themselves. The
+// electorate is the total bonded tokens.
+//
+// It relies on the running tallies of the proposal, and only goes through the
+// bonded validators.
+func DefaultTallyFn(ctx context.Context, keeper Keeper, proposal v1.Proposal) (results map[v1.VoteOption]math.LegacyDec, totalVotingPower, electorate math.LegacyDec, err error) {
+	results = make(map[v1.VoteOption]math.LegacyDec)
+	results[v1.OptionYes] = math.LegacyZeroDec()
+	results[v1.OptionAbstain] = math.LegacyZeroDec()
+	results[v1.OptionNo] = math.LegacyZeroDec()
+	results[v1.OptionNoWithVeto] = math.LegacyZeroDec()
+
+	totalVotingPower = math.LegacyZeroDec()
+	var currValidators []v1.ValidatorGovInfo
+
+	// fetch all the bonded validators
+	err = keeper.sk.IterateBondedValidatorsByPower(ctx, func(index int64, validator stakingtypes.ValidatorI) (stop bool) {
+		valBz, err := keeper.sk.ValidatorAddressCodec().StringToBytes(validator.GetOperator())
+		if err != nil {
+			return false
+		}
+		currValidators = append(currValidators, v1.NewValidatorGovInfo(
+			valBz,
+			validator.GetBondedTokens(),
+			validator.GetDelegatorShares(),
+			math.LegacyZeroDec(),
+			v1.WeightedVoteOptions{},
+		))
+
+		return false
+	})
+	if err != nil {
+		return nil, totalVotingPower, electorate, err
+	}
+
+	for _, val := range currValidators {
+		// tally the voting power of the voters delegating to the validator
+		deductions, err := keeper.TallyDeductions.Get(ctx, collections.Join(proposal.Id, val.Address))
+		switch {
+		case errors.Is(err, collections.ErrNotFound):
+			deductions = math.LegacyZeroDec()
+		case err != nil:
+			return nil, totalVotingPower, electorate, err
+		default:
+			rng := collections.NewSuperPrefixedTripleRange[uint64, sdk.ValAddress, int32](proposal.Id, val.Address)
+			err = keeper.TallyShares.Walk(ctx, rng, func(key collections.Triple[uint64, sdk.ValAddress, int32], shares math.LegacyDec) (bool, error) {
+				// delegation shares * bonded / total shares
+				option := v1.VoteOption(key.K3())
+				results[option] = results[option].Add(shares.MulInt(val.BondedTokens).Quo(val.DelegatorShares))
+				return false, nil
+			})
+			if err != nil {
+				return nil, totalVotingPower, electorate, err
+			}
+			totalVotingPower = totalVotingPower.Add(deductions.MulInt(val.BondedTokens).Quo(val.DelegatorShares))
+		}
+
+		// tally the voting power of the validator, if it voted
+		vote, err := keeper.Votes.Get(ctx, collections.Join(proposal.Id, sdk.AccAddress(val.Address)))
+		if errors.Is(err, collections.ErrNotFound) {
+			continue
+		} else if err != nil {
+			return nil, totalVotingPower, electorate, err
+		}
+
+		sharesAfterDeductions := val.DelegatorShares.Sub(deductions)
+		votingPower := sharesAfterDeductions.MulInt(val.BondedTokens).Quo(val.DelegatorShares)
+
+		for _, option := range vote.Options {
+			weight, _ := math.LegacyNewDecFromStr(option.Weight)
+			subPower := votingPower.Mul(weight)
+			results[option.Option] = results[option.Option].Add(subPower)
+		}
+		totalVotingPower = totalVotingPower.Add(votingPower)
+	}
+
+	totalBonded, err := keeper.sk.TotalBondedTokens(ctx)
+	if err != nil {
+		return nil, totalVotingPower, electorate, err
+	}
+
+	return results, totalVotingPower, math.LegacyNewDecFromInt(totalBonded), nil
+}
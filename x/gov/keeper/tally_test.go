@@ -0,0 +1,190 @@
+package keeper_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/codec/address"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/gov/keeper"
+	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+)
+
+// councilTallyFn returns a one-account-one-vote tally function for the given council.
+func councilTallyFn(council []sdk.AccAddress) keeper.TallyFn {
+	return func(ctx context.Context, k keeper.Keeper, proposal v1.Proposal) (map[v1.VoteOption]math.LegacyDec, math.LegacyDec, math.LegacyDec, error) {
+		results := make(map[v1.VoteOption]math.LegacyDec)
+		totalVotingPower := math.LegacyZeroDec()
+		for _, member := range council {
+			vote, err := k.Votes.Get(ctx, collections.Join(proposal.Id, member))
+			if err != nil {
+				continue
+			}
+			for _, option := range vote.Options {
+				weight := math.LegacyMustNewDecFromStr(option.Weight)
+				if power, ok := results[option.Option]; ok {
+					weight = weight.Add(power)
+				}
+				results[option.Option] = weight
+			}
+			totalVotingPower = totalVotingPower.Add(math.LegacyOneDec())
+		}
+		return results, totalVotingPower, math.LegacyNewDec(int64(len(council))), nil
+	}
+}
+
+func TestTallyFn(t *testing.T) {
+	govKeeper, _, _, stakingKeeper, _, _, ctx := setupGovKeeper(t)
+	stakingKeeper.EXPECT().ValidatorAddressCodec().Return(address.NewBech32Codec("cosmosvaloper")).AnyTimes()
+	addrs := simtestutil.CreateIncrementalAccounts(4)
+	council := addrs[:3]
+
+	msgSend := banktypes.NewMsgSend(govAcct, addr, sdk.NewCoins(sdk.NewInt64Coin("stake", 1000)))
+	govKeeper.SetProposalTallyFn(sdk.MsgTypeURL(msgSend), councilTallyFn(council))
+	require.Panics(t, func() {
+		govKeeper.SetProposalTallyFn(sdk.MsgTypeURL(msgSend), councilTallyFn(council))
+	})
+
+	newProposal := func(id uint64, msgs ...sdk.Msg) v1.Proposal {
+		proposal, err := v1.NewProposal(msgs, id, time.Now(), time.Now(), "", "title", "summary", addrs[0], false)
+		require.NoError(t, err)
+		return proposal
+	}
+	vote := func(id uint64, voter sdk.AccAddress, option v1.VoteOption) {
+		require.NoError(t, govKeeper.Votes.Set(ctx, collections.Join(id, voter), v1.NewVote(id, voter, v1.NewNonSplitVoteOption(option), "")))
+	}
+
+	// two of the three council members vote yes, a non member votes no
+	proposal := newProposal(1, msgSend)
+	vote(1, council[0], v1.OptionYes)
+	vote(1, council[1], v1.OptionYes)
+	vote(1, addrs[3], v1.OptionNo)
+
+	passes, burnDeposits, tallyResults, err := govKeeper.Tally(ctx, proposal)
+	require.NoError(t, err)
+	require.True(t, passes)
+	require.False(t, burnDeposits)
+	require.Equal(t, v1.NewTallyResult(math.NewInt(2), math.ZeroInt(), math.ZeroInt(), math.ZeroInt()), tallyResults)
+
+	// the votes are removed once tallied
+	has, err := govKeeper.Votes.Has(ctx, collections.Join(uint64(1), addrs[3]))
+	require.NoError(t, err)
+	require.False(t, has)
+
+	// a single council vote does not reach the quorum
+	proposal = newProposal(2, msgSend)
+	vote(2, council[0], v1.OptionYes)
+	passes, _, _, err = govKeeper.Tally(ctx, proposal)
+	require.NoError(t, err)
+	require.False(t, passes)
+
+	// proposals with other messages use the default stake-weighted tally, in
+	// which nobody has voting power
+	proposal = newProposal(3, append([]sdk.Msg{msgSend}, TestProposal...)...)
+	vote(3, council[0], v1.OptionYes)
+	vote(3, council[1], v1.OptionYes)
+	passes, _, tallyResults, err = govKeeper.Tally(ctx, proposal)
+	require.NoError(t, err)
+	require.False(t, passes)
+	require.Equal(t, v1.EmptyTallyResult(), tallyResults)
+
+	// the default tally function can be replaced
+	govKeeper.SetTallyFn(councilTallyFn(council))
+	proposal = newProposal(4, TestProposal...)
+	vote(4, council[0], v1.OptionYes)
+	vote(4, council[1], v1.OptionNoWithVeto)
+	vote(4, council[2], v1.OptionNoWithVeto)
+	passes, burnDeposits, _, err = govKeeper.Tally(ctx, proposal)
+	require.NoError(t, err)
+	require.False(t, passes)
+	require.True(t, burnDeposits)
+}
+
+func TestTallyOptimisticProposal(t *testing.T) {
+	govKeeper, _, _, stakingKeeper, _, _, ctx := setupGovKeeper(t)
+	stakingKeeper.EXPECT().ValidatorAddressCodec().Return(address.NewBech32Codec("cosmosvaloper")).AnyTimes()
+	addrs := simtestutil.CreateIncrementalAccounts(10)
+	govKeeper.SetTallyFn(councilTallyFn(addrs))
+
+	params, err := govKeeper.Params.Get(ctx)
+	require.NoError(t, err)
+	params.OptimisticRejectedThreshold = "0.2"
+	require.NoError(t, govKeeper.Params.Set(ctx, params))
+
+	newProposal := func(id uint64) v1.Proposal {
+		proposal, err := v1.NewProposal(TestProposal, id, time.Now(), time.Now(), "", "title", "summary", addrs[0], false)
+		require.NoError(t, err)
+		proposal.ProposalType = v1.ProposalType_PROPOSAL_TYPE_OPTIMISTIC
+		return proposal
+	}
+	vote := func(id uint64, voter sdk.AccAddress, option v1.VoteOption) {
+		require.NoError(t, govKeeper.Votes.Set(ctx, collections.Join(id, voter), v1.NewVote(id, voter, v1.NewNonSplitVoteOption(option), "")))
+	}
+
+	// an optimistic proposal passes without quorum
+	passes, burnDeposits, _, err := govKeeper.Tally(ctx, newProposal(1))
+	require.NoError(t, err)
+	require.True(t, passes)
+	require.False(t, burnDeposits)
+
+	// and even when most of the voters vote no, as long as they are less than
+	// the rejected threshold of the electorate
+	vote(2, addrs[0], v1.OptionNo)
+	passes, _, _, err = govKeeper.Tally(ctx, newProposal(2))
+	require.NoError(t, err)
+	require.True(t, passes)
+
+	// no and no with veto votes both count towards the rejection, which
+	// never burns the deposits
+	vote(3, addrs[0], v1.OptionNo)
+	vote(3, addrs[1], v1.OptionNoWithVeto)
+	vote(3, addrs[2], v1.OptionYes)
+	passes, burnDeposits, tallyResults, err := govKeeper.Tally(ctx, newProposal(3))
+	require.NoError(t, err)
+	require.False(t, passes)
+	require.False(t, burnDeposits)
+	require.Equal(t, v1.NewTallyResult(math.NewInt(1), math.ZeroInt(), math.NewInt(1), math.NewInt(1)), tallyResults)
+}
+
+func TestTallyMultipleChoiceProposal(t *testing.T) {
+	govKeeper, _, _, stakingKeeper, _, _, ctx := setupGovKeeper(t)
+	stakingKeeper.EXPECT().ValidatorAddressCodec().Return(address.NewBech32Codec("cosmosvaloper")).AnyTimes()
+	addrs := simtestutil.CreateIncrementalAccounts(6)
+	govKeeper.SetTallyFn(councilTallyFn(addrs))
+
+	newProposal := func(id uint64) v1.Proposal {
+		proposal, err := v1.NewProposal(nil, id, time.Now(), time.Now(), "", "title", "summary", addrs[0], false)
+		require.NoError(t, err)
+		proposal.ProposalType = v1.ProposalType_PROPOSAL_TYPE_MULTIPLE_CHOICE
+		proposal.VoteOptions = &v1.ProposalVoteOptions{OptionOne: "first", OptionTwo: "second", OptionThree: "third"}
+		return proposal
+	}
+	vote := func(id uint64, voter sdk.AccAddress, option v1.VoteOption) {
+		require.NoError(t, govKeeper.Votes.Set(ctx, collections.Join(id, voter), v1.NewVote(id, voter, v1.NewNonSplitVoteOption(option), "")))
+	}
+
+	// a multiple choice proposal without quorum fails
+	vote(1, addrs[0], v1.OptionOne)
+	passes, _, _, err := govKeeper.Tally(ctx, newProposal(1))
+	require.NoError(t, err)
+	require.False(t, passes)
+
+	// otherwise it passes, whatever the options voted for, with the results
+	// of each option
+	vote(2, addrs[0], v1.OptionThree)
+	vote(2, addrs[1], v1.OptionThree)
+	vote(2, addrs[2], v1.OptionTwo)
+	passes, burnDeposits, tallyResults, err := govKeeper.Tally(ctx, newProposal(2))
+	require.NoError(t, err)
+	require.True(t, passes)
+	require.False(t, burnDeposits)
+	require.Equal(t, v1.NewTallyResult(math.ZeroInt(), math.NewInt(1), math.NewInt(2), math.ZeroInt()), tallyResults)
+}
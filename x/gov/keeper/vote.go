@@ -29,10 +29,32 @@ func (keeper Keeper) AddVote(ctx context.Context, proposalID uint64, voterAddr s
 		return err
 	}
 
+	proposal, err := keeper.Proposals.Get(ctx, proposalID)
+	if err != nil {
+		return err
+	}
+
 	for _, option := range options {
 		if !v1.ValidWeightedVoteOption(*option) {
 			return errors.Wrap(types.ErrInvalidVote, option.String())
 		}
+
+		// the votes on a multiple choice proposal must be for its named options
+		if proposal.ProposalType == v1.ProposalType_PROPOSAL_TYPE_MULTIPLE_CHOICE && !proposal.VoteOptions.HasOption(option.Option) {
+			return errors.Wrapf(types.ErrInvalidVote, "%s is not an option of multiple choice proposal %d", option.Option, proposalID)
+		}
+	}
+
+	// replace the previous vote of the voter, if any, in the running tally
+	previous, err := keeper.Votes.Get(ctx, collections.Join(proposalID, voterAddr))
+	switch {
+	case err == nil:
+		err = keeper.removeVoteFromTally(ctx, proposalID, voterAddr, previous.Options)
+		if err != nil {
+			return err
+		}
+	case !errors.IsOf(err, collections.ErrNotFound):
+		return err
 	}
 
 	vote := v1.NewVote(proposalID, voterAddr, options, metadata)
@@ -41,6 +63,11 @@ func (keeper Keeper) AddVote(ctx context.Context, proposalID uint64, voterAddr s
 		return err
 	}
 
+	err = keeper.addVoteToTally(ctx, proposalID, voterAddr, options)
+	if err != nil {
+		return err
+	}
+
 	// called after a vote on a proposal is cast
 	err = keeper.Hooks().AfterProposalVote(ctx, proposalID, voterAddr)
 	if err != nil {
@@ -60,7 +87,7 @@ func (keeper Keeper) AddVote(ctx context.Context, proposalID uint64, voterAddr s
 	return nil
 }
 
-// deleteVotes deletes all the votes from a given proposalID.
+// deleteVotes deletes all the votes from a given proposalID, and its running tally.
 func (keeper Keeper) deleteVotes(ctx context.Context, proposalID uint64) error {
 	rng := collections.NewPrefixedPairRange[uint64, sdk.AccAddress](proposalID)
 	err := keeper.Votes.Clear(ctx, rng)
@@ -68,5 +95,5 @@ func (keeper Keeper) deleteVotes(ctx context.Context, proposalID uint64) error {
 		return err
 	}
 
-	return nil
+	return keeper.deleteVoteTally(ctx, proposalID)
 }
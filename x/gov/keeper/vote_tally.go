@@ -0,0 +1,295 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// The running tallies of the proposals in voting period record, for every
+// voter, the shares of each of its delegations counted in the tally
+// (VoteShares). They are aggregated by validator and vote option, weighted by
+// the vote (TallyShares), and by validator (TallyDeductions). The running
+// tallies are updated when a vote is cast and, through the staking hooks, when
+// the delegations of a voter change, so that the final tally only goes through
+// the bonded validators.
+
+// addVoteToTally adds the delegations of voter to the running tally of a
+// proposal, with the given vote options.
+func (keeper Keeper) addVoteToTally(ctx context.Context, proposalID uint64, voter sdk.AccAddress, options v1.WeightedVoteOptions) error {
+	type delegationShares struct {
+		valAddr sdk.ValAddress
+		shares  math.LegacyDec
+	}
+
+	var (
+		delegations []delegationShares
+		decodeErr   error
+	)
+	err := keeper.sk.IterateDelegations(ctx, voter, func(_ int64, delegation stakingtypes.DelegationI) (stop bool) {
+		valAddr, err := keeper.sk.ValidatorAddressCodec().StringToBytes(delegation.GetValidatorAddr())
+		if err != nil {
+			decodeErr = err
+			return true
+		}
+		delegations = append(delegations, delegationShares{valAddr: valAddr, shares: delegation.GetShares()})
+		return false
+	})
+	if err != nil {
+		return err
+	}
+	if decodeErr != nil {
+		return decodeErr
+	}
+
+	for _, delegation := range delegations {
+		if err := keeper.setVoteShares(ctx, proposalID, voter, delegation.valAddr, options, delegation.shares); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// removeVoteFromTally removes the delegations of voter, which voted with the
+// given vote options, from the running tally of a proposal.
+func (keeper Keeper) removeVoteFromTally(ctx context.Context, proposalID uint64, voter sdk.AccAddress, options v1.WeightedVoteOptions) error {
+	rng := collections.NewSuperPrefixedTripleRange[uint64, sdk.AccAddress, sdk.ValAddress](proposalID, voter)
+	iter, err := keeper.VoteShares.Iterate(ctx, rng)
+	if err != nil {
+		return err
+	}
+	keys, err := iter.Keys()
+	if err != nil {
+		return err
+	}
+
+	for _, key := range keys {
+		if err := keeper.setVoteShares(ctx, proposalID, voter, key.K3(), options, math.LegacyZeroDec()); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// syncVoteShares updates the shares delegated by voter to valAddr in the
+// running tallies of all the proposals in voting period the voter voted on.
+func (keeper Keeper) syncVoteShares(ctx context.Context, voter sdk.AccAddress, valAddr sdk.ValAddress, shares math.LegacyDec) error {
+	iter, err := keeper.VotingPeriodProposals.Iterate(ctx, nil)
+	if err != nil {
+		return err
+	}
+	proposalIDs, err := iter.Keys()
+	if err != nil {
+		return err
+	}
+
+	for _, proposalID := range proposalIDs {
+		vote, err := keeper.Votes.Get(ctx, collections.Join(proposalID, voter))
+		if errors.Is(err, collections.ErrNotFound) {
+			continue
+		} else if err != nil {
+			return err
+		}
+
+		if err := keeper.setVoteShares(ctx, proposalID, voter, valAddr, vote.Options, shares); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// setVoteShares sets the shares delegated by voter to valAddr counted in the
+// running tally of a proposal, and updates the aggregates of the validator.
+// The previous shares are removed with the same weights they were added with,
+// so that the aggregates always equal a recount of the votes.
+func (keeper Keeper) setVoteShares(ctx context.Context, proposalID uint64, voter sdk.AccAddress, valAddr sdk.ValAddress, options v1.WeightedVoteOptions, shares math.LegacyDec) error {
+	key := collections.Join3(proposalID, voter, valAddr)
+	current, err := keeper.VoteShares.Get(ctx, key)
+	if errors.Is(err, collections.ErrNotFound) {
+		current = math.LegacyZeroDec()
+	} else if err != nil {
+		return err
+	}
+
+	if current.Equal(shares) {
+		return nil
+	}
+
+	for _, option := range options {
+		weight, _ := math.LegacyNewDecFromStr(option.Weight)
+		delta := shares.Mul(weight).Sub(current.Mul(weight))
+		if err := addToTally(ctx, keeper.TallyShares, collections.Join3(proposalID, valAddr, int32(option.Option)), delta); err != nil {
+			return err
+		}
+	}
+
+	if err := addToTally(ctx, keeper.TallyDeductions, collections.Join(proposalID, valAddr), shares.Sub(current)); err != nil {
+		return err
+	}
+
+	if shares.IsZero() {
+		return keeper.VoteShares.Remove(ctx, key)
+	}
+	return keeper.VoteShares.Set(ctx, key, shares)
+}
+
+// addToTally adds delta to the value of key in m, removing the value once it
+// reaches zero.
+func addToTally[K any](ctx context.Context, m collections.Map[K, math.LegacyDec], key K, delta math.LegacyDec) error {
+	if delta.IsZero() {
+		return nil
+	}
+
+	value, err := m.Get(ctx, key)
+	if errors.Is(err, collections.ErrNotFound) {
+		value = math.LegacyZeroDec()
+	} else if err != nil {
+		return err
+	}
+
+	value = value.Add(delta)
+	if value.IsZero() {
+		return m.Remove(ctx, key)
+	}
+	return m.Set(ctx, key, value)
+}
+
+// deleteVoteTally deletes the running tally of a proposal.
+func (keeper Keeper) deleteVoteTally(ctx context.Context, proposalID uint64) error {
+	err := keeper.VoteShares.Clear(ctx, collections.NewPrefixedTripleRange[uint64, sdk.AccAddress, sdk.ValAddress](proposalID))
+	if err != nil {
+		return err
+	}
+
+	err = keeper.TallyShares.Clear(ctx, collections.NewPrefixedTripleRange[uint64, sdk.ValAddress, int32](proposalID))
+	if err != nil {
+		return err
+	}
+
+	return keeper.TallyDeductions.Clear(ctx, collections.NewPrefixedPairRange[uint64, sdk.ValAddress](proposalID))
+}
+
+// ResetVoteTallies rebuilds the running tallies of all the proposals in voting
+// period from their votes and the current delegations of their voters. It is
+// used when importing the genesis state and when migrating the store.
+func (keeper Keeper) ResetVoteTallies(ctx context.Context) error {
+	if err := keeper.VoteShares.Clear(ctx, nil); err != nil {
+		return err
+	}
+	if err := keeper.TallyShares.Clear(ctx, nil); err != nil {
+		return err
+	}
+	if err := keeper.TallyDeductions.Clear(ctx, nil); err != nil {
+		return err
+	}
+
+	iter, err := keeper.VotingPeriodProposals.Iterate(ctx, nil)
+	if err != nil {
+		return err
+	}
+	proposalIDs, err := iter.Keys()
+	if err != nil {
+		return err
+	}
+
+	for _, proposalID := range proposalIDs {
+		iter, err := keeper.Votes.Iterate(ctx, collections.NewPrefixedPairRange[uint64, sdk.AccAddress](proposalID))
+		if err != nil {
+			return err
+		}
+		votes, err := iter.KeyValues()
+		if err != nil {
+			return err
+		}
+
+		for _, vote := range votes {
+			if err := keeper.addVoteToTally(ctx, proposalID, vote.Key.K2(), vote.Value.Options); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// recountVoteTally recounts the running tally of a proposal from its votes and
+// the current delegations of its voters. It returns the aggregated shares by
+// validator and vote option, and by validator.
+func (keeper Keeper) recountVoteTally(ctx context.Context, proposalID uint64) (shares, deductions map[string]math.LegacyDec, err error) {
+	shares = make(map[string]math.LegacyDec)
+	deductions = make(map[string]math.LegacyDec)
+
+	rng := collections.NewPrefixedPairRange[uint64, sdk.AccAddress](proposalID)
+	err = keeper.Votes.Walk(ctx, rng, func(key collections.Pair[uint64, sdk.AccAddress], vote v1.Vote) (bool, error) {
+		return false, keeper.sk.IterateDelegations(ctx, key.K2(), func(_ int64, delegation stakingtypes.DelegationI) (stop bool) {
+			valAddr := delegation.GetValidatorAddr()
+			for _, option := range vote.Options {
+				weight, _ := math.LegacyNewDecFromStr(option.Weight)
+				addToRecount(shares, tallySharesKey(valAddr, option.Option), delegation.GetShares().Mul(weight))
+			}
+			addToRecount(deductions, valAddr, delegation.GetShares())
+			return false
+		})
+	})
+
+	return shares, deductions, err
+}
+
+// voteTally returns the running tally of a proposal, in the format of
+// recountVoteTally.
+func (keeper Keeper) voteTally(ctx context.Context, proposalID uint64) (shares, deductions map[string]math.LegacyDec, err error) {
+	shares = make(map[string]math.LegacyDec)
+	deductions = make(map[string]math.LegacyDec)
+
+	valCodec := keeper.sk.ValidatorAddressCodec()
+	err = keeper.TallyShares.Walk(ctx, collections.NewPrefixedTripleRange[uint64, sdk.ValAddress, int32](proposalID), func(key collections.Triple[uint64, sdk.ValAddress, int32], value math.LegacyDec) (bool, error) {
+		valAddr, err := valCodec.BytesToString(key.K2())
+		if err != nil {
+			return true, err
+		}
+		shares[tallySharesKey(valAddr, v1.VoteOption(key.K3()))] = value
+		return false, nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	err = keeper.TallyDeductions.Walk(ctx, collections.NewPrefixedPairRange[uint64, sdk.ValAddress](proposalID), func(key collections.Pair[uint64, sdk.ValAddress], value math.LegacyDec) (bool, error) {
+		valAddr, err := valCodec.BytesToString(key.K2())
+		if err != nil {
+			return true, err
+		}
+		deductions[valAddr] = value
+		return false, nil
+	})
+
+	return shares, deductions, err
+}
+
+func tallySharesKey(valAddr string, option v1.VoteOption) string {
+	return fmt.Sprintf("%s/%s", valAddr, option)
+}
+
+func addToRecount(m map[string]math.LegacyDec, key string, delta math.LegacyDec) {
+	value, ok := m[key]
+	if !ok {
+		value = math.LegacyZeroDec()
+	}
+
+	value = value.Add(delta)
+	if value.IsZero() {
+		delete(m, key)
+		return
+	}
+	m[key] = value
+}
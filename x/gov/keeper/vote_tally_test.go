@@ -0,0 +1,178 @@
+package keeper_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/address"
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	addresscodec "github.com/cosmos/cosmos-sdk/codec/address"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/gov/keeper"
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
+	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// fakeStakingKeeper is an in-memory staking keeper, holding bonded validators
+// and delegations.
+type fakeStakingKeeper struct {
+	validators  []stakingtypes.Validator
+	delegations []stakingtypes.Delegation
+}
+
+var _ types.StakingKeeper = &fakeStakingKeeper{}
+
+func (sk *fakeStakingKeeper) ValidatorAddressCodec() address.Codec {
+	return addresscodec.NewBech32Codec("cosmosvaloper")
+}
+
+func (sk *fakeStakingKeeper) IterateBondedValidatorsByPower(_ context.Context, fn func(int64, stakingtypes.ValidatorI) bool) error {
+	for i, validator := range sk.validators {
+		if fn(int64(i), validator) {
+			break
+		}
+	}
+	return nil
+}
+
+func (sk *fakeStakingKeeper) TotalBondedTokens(context.Context) (math.Int, error) {
+	total := math.ZeroInt()
+	for _, validator := range sk.validators {
+		total = total.Add(validator.Tokens)
+	}
+	return total, nil
+}
+
+func (sk *fakeStakingKeeper) IterateDelegations(_ context.Context, delegator sdk.AccAddress, fn func(int64, stakingtypes.DelegationI) bool) error {
+	for i, delegation := range sk.delegations {
+		if delegation.DelegatorAddress == delegator.String() && fn(int64(i), delegation) {
+			break
+		}
+	}
+	return nil
+}
+
+func (sk *fakeStakingKeeper) Delegation(_ context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) (stakingtypes.DelegationI, error) {
+	for _, delegation := range sk.delegations {
+		if delegation.DelegatorAddress == delAddr.String() && delegation.ValidatorAddress == valAddr.String() {
+			return delegation, nil
+		}
+	}
+	return nil, stakingtypes.ErrNoDelegation
+}
+
+// setDelegation sets the shares of a delegation, removing it when they are zero.
+func (sk *fakeStakingKeeper) setDelegation(delAddr sdk.AccAddress, valAddr sdk.ValAddress, shares int64) {
+	for i, delegation := range sk.delegations {
+		if delegation.DelegatorAddress == delAddr.String() && delegation.ValidatorAddress == valAddr.String() {
+			if shares == 0 {
+				sk.delegations = append(sk.delegations[:i], sk.delegations[i+1:]...)
+			} else {
+				sk.delegations[i].Shares = math.LegacyNewDec(shares)
+			}
+			return
+		}
+	}
+	sk.delegations = append(sk.delegations, stakingtypes.NewDelegation(delAddr.String(), valAddr.String(), math.LegacyNewDec(shares)))
+}
+
+func TestVoteTallies(t *testing.T) {
+	_, acctKeeper, bankKeeper, _, distrKeeper, encCfg, _ := setupGovKeeper(t)
+	key := storetypes.NewKVStoreKey(types.StoreKey)
+	ctx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test")).Ctx
+
+	addrs := simtestutil.CreateIncrementalAccounts(4)
+	del1, del2, valAcc := addrs[0], addrs[1], addrs[2]
+	val1, val2 := sdk.ValAddress(valAcc), sdk.ValAddress(addrs[3])
+
+	sk := &fakeStakingKeeper{
+		validators: []stakingtypes.Validator{
+			{OperatorAddress: val1.String(), Status: stakingtypes.Bonded, Tokens: math.NewInt(200), DelegatorShares: math.LegacyNewDec(200)},
+			{OperatorAddress: val2.String(), Status: stakingtypes.Bonded, Tokens: math.NewInt(50), DelegatorShares: math.LegacyNewDec(100)},
+		},
+	}
+	sk.setDelegation(del1, val1, 30)
+	sk.setDelegation(del2, val1, 20)
+	sk.setDelegation(del2, val2, 40)
+	sk.setDelegation(valAcc, val1, 50)
+
+	govKeeper := keeper.NewKeeper(encCfg.Codec, runtime.NewKVStoreService(key), acctKeeper, bankKeeper, sk, distrKeeper, baseapp.NewMsgServiceRouter(), types.DefaultConfig(), govAcct.String())
+	require.NoError(t, govKeeper.Params.Set(ctx, v1.DefaultParams()))
+	hooks := govKeeper.StakingHooks()
+	invariant := keeper.VoteTalliesInvariant(govKeeper)
+	requireConsistent := func() {
+		msg, broken := invariant(ctx)
+		require.False(t, broken, msg)
+	}
+
+	proposal, err := v1.NewProposal(TestProposal, 1, time.Now(), time.Now(), "", "title", "summary", del1, false)
+	require.NoError(t, err)
+	proposal.Status = v1.StatusVotingPeriod
+	require.NoError(t, govKeeper.SetProposal(ctx, proposal))
+
+	require.NoError(t, govKeeper.AddVote(ctx, 1, del1, v1.NewNonSplitVoteOption(v1.OptionYes), ""))
+	require.NoError(t, govKeeper.AddVote(ctx, 1, del2, v1.NewNonSplitVoteOption(v1.OptionNo), ""))
+	require.NoError(t, govKeeper.AddVote(ctx, 1, valAcc, v1.NewNonSplitVoteOption(v1.OptionYes), ""))
+	requireConsistent()
+
+	// votes can be changed
+	require.NoError(t, govKeeper.AddVote(ctx, 1, del2, v1.WeightedVoteOptions{
+		v1.NewWeightedVoteOption(v1.OptionYes, math.LegacyNewDecWithPrec(5, 1)),
+		v1.NewWeightedVoteOption(v1.OptionNo, math.LegacyNewDecWithPrec(5, 1)),
+	}, ""))
+	requireConsistent()
+
+	// the running tallies follow the delegations of the voters
+	sk.setDelegation(del1, val1, 60)
+	require.NoError(t, hooks.AfterDelegationModified(ctx, del1, val1))
+	require.NoError(t, hooks.BeforeDelegationRemoved(ctx, del2, val2))
+	sk.setDelegation(del2, val2, 0)
+	requireConsistent()
+
+	// new delegations of voters are counted, those of non voters are ignored
+	sk.setDelegation(del1, val2, 10)
+	require.NoError(t, hooks.AfterDelegationModified(ctx, del1, val2))
+	sk.setDelegation(addrs[3], val2, 10)
+	require.NoError(t, hooks.AfterDelegationModified(ctx, addrs[3], val2))
+	requireConsistent()
+
+	// del1 (60 shares), del2 (20 shares, half yes) and the validator
+	// account (50 shares) delegate 130 of the 200 shares of val1, which votes
+	// yes with the remaining 70. del1 delegates 10 of the 100 shares of val2,
+	// worth 5 tokens.
+	results, totalVotingPower, electorate, err := keeper.DefaultTallyFn(ctx, *govKeeper, proposal)
+	require.NoError(t, err)
+	require.Equal(t, math.LegacyNewDec(195).String(), results[v1.OptionYes].String())
+	require.Equal(t, math.LegacyNewDec(10).String(), results[v1.OptionNo].String())
+	require.Equal(t, math.LegacyNewDec(205).String(), totalVotingPower.String())
+	require.Equal(t, math.LegacyNewDec(250).String(), electorate.String())
+
+	// a tampered running tally breaks the invariant
+	require.NoError(t, govKeeper.TallyDeductions.Set(ctx, collections.Join(uint64(1), val2), math.LegacyOneDec()))
+	_, broken := invariant(ctx)
+	require.True(t, broken)
+	require.NoError(t, govKeeper.ResetVoteTallies(ctx))
+	requireConsistent()
+
+	// the running tally is removed once tallied
+	passes, _, _, err := govKeeper.Tally(ctx, proposal)
+	require.NoError(t, err)
+	require.True(t, passes)
+
+	iter, err := govKeeper.VoteShares.Iterate(ctx, nil)
+	require.NoError(t, err)
+	keys, err := iter.Keys()
+	require.NoError(t, err)
+	require.Empty(t, keys)
+}
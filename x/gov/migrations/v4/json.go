@@ -30,6 +30,8 @@ func MigrateJSON(oldState *v1.GenesisState) (*v1.GenesisState, error) {
 		defaultParams.BurnVoteQuorum,
 		defaultParams.BurnVoteVeto,
 		defaultParams.MinDepositRatio,
+		defaultParams.OptimisticAuthorizedAddresses,
+		defaultParams.OptimisticRejectedThreshold,
 	)
 
 	return &v1.GenesisState{
@@ -81,6 +81,8 @@ func TestMigrateJSON(t *testing.T) {
 		],
 		"min_deposit_ratio": "0.010000000000000000",
 		"min_initial_deposit_ratio": "0.000000000000000000",
+		"optimistic_authorized_addresses": [],
+		"optimistic_rejected_threshold": "0.100000000000000000",
 		"proposal_cancel_dest": "",
 		"proposal_cancel_ratio": "1.000000000000000000",
 		"quorum": "0.334000000000000000",
@@ -43,6 +43,8 @@ func migrateParams(ctx sdk.Context, store storetypes.KVStore, legacySubspace exp
 		defaultParams.BurnVoteQuorum,
 		defaultParams.BurnVoteVeto,
 		defaultParams.MinDepositRatio,
+		defaultParams.OptimisticAuthorizedAddresses,
+		defaultParams.OptimisticRejectedThreshold,
 	)
 
 	bz, err := cdc.Marshal(&params)
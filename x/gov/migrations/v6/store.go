@@ -0,0 +1,40 @@
+package v6
+
+import (
+	corestoretypes "cosmossdk.io/core/store"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	v5 "github.com/cosmos/cosmos-sdk/x/gov/migrations/v5"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+)
+
+// MigrateStore performs in-place store migrations from v5 (v0.50) to v6. The
+// migration includes:
+//
+// Addition of the new optimistic proposal parameters that are set to their
+// default values.
+func MigrateStore(ctx sdk.Context, storeService corestoretypes.KVStoreService, cdc codec.BinaryCodec) error {
+	store := storeService.OpenKVStore(ctx)
+	paramsBz, err := store.Get(v5.ParamsKey)
+	if err != nil {
+		return err
+	}
+
+	var params govv1.Params
+	err = cdc.Unmarshal(paramsBz, &params)
+	if err != nil {
+		return err
+	}
+
+	defaultParams := govv1.DefaultParams()
+	params.OptimisticAuthorizedAddresses = defaultParams.OptimisticAuthorizedAddresses
+	params.OptimisticRejectedThreshold = defaultParams.OptimisticRejectedThreshold
+
+	bz, err := cdc.Marshal(&params)
+	if err != nil {
+		return err
+	}
+
+	return store.Set(v5.ParamsKey, bz)
+}
@@ -0,0 +1,42 @@
+package v6_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	"github.com/cosmos/cosmos-sdk/x/gov"
+	v5 "github.com/cosmos/cosmos-sdk/x/gov/migrations/v5"
+	v6 "github.com/cosmos/cosmos-sdk/x/gov/migrations/v6"
+	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+)
+
+func TestMigrateStore(t *testing.T) {
+	cdc := moduletestutil.MakeTestEncodingConfig(gov.AppModuleBasic{}, bank.AppModuleBasic{}).Codec
+	govKey := storetypes.NewKVStoreKey("gov")
+	ctx := testutil.DefaultContext(govKey, storetypes.NewTransientStoreKey("transient_test"))
+	store := ctx.KVStore(govKey)
+	storeService := runtime.NewKVStoreService(govKey)
+
+	params := v1.DefaultParams()
+	params.OptimisticRejectedThreshold = ""
+	bz, err := cdc.Marshal(&params)
+	require.NoError(t, err)
+	store.Set(v5.ParamsKey, bz)
+
+	// Run migrations.
+	require.NoError(t, v6.MigrateStore(ctx, storeService, cdc))
+
+	// Check params
+	bz = store.Get(v5.ParamsKey)
+	require.NoError(t, cdc.Unmarshal(bz, &params))
+	require.Equal(t, "0.100000000000000000", params.OptimisticRejectedThreshold)
+	require.Empty(t, params.OptimisticAuthorizedAddresses)
+	require.NoError(t, params.ValidateBasic())
+}
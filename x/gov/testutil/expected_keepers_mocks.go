@@ -7,6 +7,7 @@ package testutil
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	address "cosmossdk.io/core/address"
 	math "cosmossdk.io/math"
@@ -171,6 +172,20 @@ func (mr *MockBankKeeperMockRecorder) AppendSendRestriction(restriction interfac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AppendSendRestriction", reflect.TypeOf((*MockBankKeeper)(nil).AppendSendRestriction), restriction)
 }
 
+// AttachSendHooks mocks base method.
+func (m *MockBankKeeper) AttachSendHooks(ctx context.Context, attachment types0.SendHooksAttachment) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AttachSendHooks", ctx, attachment)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AttachSendHooks indicates an expected call of AttachSendHooks.
+func (mr *MockBankKeeperMockRecorder) AttachSendHooks(ctx, attachment interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AttachSendHooks", reflect.TypeOf((*MockBankKeeper)(nil).AttachSendHooks), ctx, attachment)
+}
+
 // Balance mocks base method.
 func (m *MockBankKeeper) Balance(arg0 context.Context, arg1 *types0.QueryBalanceRequest) (*types0.QueryBalanceResponse, error) {
 	m.ctrl.T.Helper()
@@ -186,6 +201,35 @@ func (mr *MockBankKeeperMockRecorder) Balance(arg0, arg1 interface{}) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Balance", reflect.TypeOf((*MockBankKeeper)(nil).Balance), arg0, arg1)
 }
 
+// BalanceHistory mocks base method.
+func (m *MockBankKeeper) BalanceHistory(arg0 context.Context, arg1 *types0.QueryBalanceHistoryRequest) (*types0.QueryBalanceHistoryResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BalanceHistory", arg0, arg1)
+	ret0, _ := ret[0].(*types0.QueryBalanceHistoryResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BalanceHistory indicates an expected call of BalanceHistory.
+func (mr *MockBankKeeperMockRecorder) BalanceHistory(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BalanceHistory", reflect.TypeOf((*MockBankKeeper)(nil).BalanceHistory), arg0, arg1)
+}
+
+// BeginBlocker mocks base method.
+func (m *MockBankKeeper) BeginBlocker(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BeginBlocker", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// BeginBlocker indicates an expected call of BeginBlocker.
+func (mr *MockBankKeeperMockRecorder) BeginBlocker(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BeginBlocker", reflect.TypeOf((*MockBankKeeper)(nil).BeginBlocker), ctx)
+}
+
 // BlockedAddr mocks base method.
 func (m *MockBankKeeper) BlockedAddr(addr types.AccAddress) bool {
 	m.ctrl.T.Helper()
@@ -214,6 +258,49 @@ func (mr *MockBankKeeperMockRecorder) BurnCoins(ctx, moduleName, amt interface{}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BurnCoins", reflect.TypeOf((*MockBankKeeper)(nil).BurnCoins), ctx, moduleName, amt)
 }
 
+// BurnFactoryCoins mocks base method.
+func (m *MockBankKeeper) BurnFactoryCoins(ctx context.Context, admin types.AccAddress, amounts types.Coins) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BurnFactoryCoins", ctx, admin, amounts)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// BurnFactoryCoins indicates an expected call of BurnFactoryCoins.
+func (mr *MockBankKeeperMockRecorder) BurnFactoryCoins(ctx, admin, amounts interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BurnFactoryCoins", reflect.TypeOf((*MockBankKeeper)(nil).BurnFactoryCoins), ctx, admin, amounts)
+}
+
+// CancelStream mocks base method.
+func (m *MockBankKeeper) CancelStream(ctx context.Context, sender types.AccAddress, id uint64) (types.Coin, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelStream", ctx, sender, id)
+	ret0, _ := ret[0].(types.Coin)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CancelStream indicates an expected call of CancelStream.
+func (mr *MockBankKeeperMockRecorder) CancelStream(ctx, sender, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelStream", reflect.TypeOf((*MockBankKeeper)(nil).CancelStream), ctx, sender, id)
+}
+
+// ChangeFactoryDenomAdmin mocks base method.
+func (m *MockBankKeeper) ChangeFactoryDenomAdmin(ctx context.Context, admin types.AccAddress, denom, newAdmin string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ChangeFactoryDenomAdmin", ctx, admin, denom, newAdmin)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ChangeFactoryDenomAdmin indicates an expected call of ChangeFactoryDenomAdmin.
+func (mr *MockBankKeeperMockRecorder) ChangeFactoryDenomAdmin(ctx, admin, denom, newAdmin interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangeFactoryDenomAdmin", reflect.TypeOf((*MockBankKeeper)(nil).ChangeFactoryDenomAdmin), ctx, admin, denom, newAdmin)
+}
+
 // ClearSendRestriction mocks base method.
 func (m *MockBankKeeper) ClearSendRestriction() {
 	m.ctrl.T.Helper()
@@ -226,6 +313,36 @@ func (mr *MockBankKeeperMockRecorder) ClearSendRestriction() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClearSendRestriction", reflect.TypeOf((*MockBankKeeper)(nil).ClearSendRestriction))
 }
 
+// CreateFactoryDenom mocks base method.
+func (m *MockBankKeeper) CreateFactoryDenom(ctx context.Context, creator types.AccAddress, subdenom string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateFactoryDenom", ctx, creator, subdenom)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateFactoryDenom indicates an expected call of CreateFactoryDenom.
+func (mr *MockBankKeeperMockRecorder) CreateFactoryDenom(ctx, creator, subdenom interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateFactoryDenom", reflect.TypeOf((*MockBankKeeper)(nil).CreateFactoryDenom), ctx, creator, subdenom)
+}
+
+// CreateStream mocks base method.
+func (m *MockBankKeeper) CreateStream(ctx context.Context, sender, recipient types.AccAddress, amount types.Coin, endTime time.Time) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateStream", ctx, sender, recipient, amount, endTime)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateStream indicates an expected call of CreateStream.
+func (mr *MockBankKeeperMockRecorder) CreateStream(ctx, sender, recipient, amount, endTime interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateStream", reflect.TypeOf((*MockBankKeeper)(nil).CreateStream), ctx, sender, recipient, amount, endTime)
+}
+
 // DelegateCoins mocks base method.
 func (m *MockBankKeeper) DelegateCoins(ctx context.Context, delegatorAddr, moduleAccAddr types.AccAddress, amt types.Coins) error {
 	m.ctrl.T.Helper()
@@ -271,6 +388,21 @@ func (mr *MockBankKeeperMockRecorder) DeleteSendEnabled(ctx interface{}, denoms
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSendEnabled", reflect.TypeOf((*MockBankKeeper)(nil).DeleteSendEnabled), varargs...)
 }
 
+// DenomAuthorityMetadata mocks base method.
+func (m *MockBankKeeper) DenomAuthorityMetadata(arg0 context.Context, arg1 *types0.QueryDenomAuthorityMetadataRequest) (*types0.QueryDenomAuthorityMetadataResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DenomAuthorityMetadata", arg0, arg1)
+	ret0, _ := ret[0].(*types0.QueryDenomAuthorityMetadataResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DenomAuthorityMetadata indicates an expected call of DenomAuthorityMetadata.
+func (mr *MockBankKeeperMockRecorder) DenomAuthorityMetadata(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DenomAuthorityMetadata", reflect.TypeOf((*MockBankKeeper)(nil).DenomAuthorityMetadata), arg0, arg1)
+}
+
 // DenomMetadata mocks base method.
 func (m *MockBankKeeper) DenomMetadata(arg0 context.Context, arg1 *types0.QueryDenomMetadataRequest) (*types0.QueryDenomMetadataResponse, error) {
 	m.ctrl.T.Helper()
@@ -331,6 +463,21 @@ func (mr *MockBankKeeperMockRecorder) DenomOwnersByQuery(arg0, arg1 interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DenomOwnersByQuery", reflect.TypeOf((*MockBankKeeper)(nil).DenomOwnersByQuery), arg0, arg1)
 }
 
+// DenomsFromCreator mocks base method.
+func (m *MockBankKeeper) DenomsFromCreator(arg0 context.Context, arg1 *types0.QueryDenomsFromCreatorRequest) (*types0.QueryDenomsFromCreatorResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DenomsFromCreator", arg0, arg1)
+	ret0, _ := ret[0].(*types0.QueryDenomsFromCreatorResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DenomsFromCreator indicates an expected call of DenomsFromCreator.
+func (mr *MockBankKeeperMockRecorder) DenomsFromCreator(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DenomsFromCreator", reflect.TypeOf((*MockBankKeeper)(nil).DenomsFromCreator), arg0, arg1)
+}
+
 // DenomsMetadata mocks base method.
 func (m *MockBankKeeper) DenomsMetadata(arg0 context.Context, arg1 *types0.QueryDenomsMetadataRequest) (*types0.QueryDenomsMetadataResponse, error) {
 	m.ctrl.T.Helper()
@@ -346,6 +493,20 @@ func (mr *MockBankKeeperMockRecorder) DenomsMetadata(arg0, arg1 interface{}) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DenomsMetadata", reflect.TypeOf((*MockBankKeeper)(nil).DenomsMetadata), arg0, arg1)
 }
 
+// DetachSendHooks mocks base method.
+func (m *MockBankKeeper) DetachSendHooks(ctx context.Context, attachment types0.SendHooksAttachment) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DetachSendHooks", ctx, attachment)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DetachSendHooks indicates an expected call of DetachSendHooks.
+func (mr *MockBankKeeperMockRecorder) DetachSendHooks(ctx, attachment interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DetachSendHooks", reflect.TypeOf((*MockBankKeeper)(nil).DetachSendHooks), ctx, attachment)
+}
+
 // ExportGenesis mocks base method.
 func (m *MockBankKeeper) ExportGenesis(arg0 context.Context) *types0.GenesisState {
 	m.ctrl.T.Helper()
@@ -402,6 +563,20 @@ func (mr *MockBankKeeperMockRecorder) GetAllDenomMetaData(ctx interface{}) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllDenomMetaData", reflect.TypeOf((*MockBankKeeper)(nil).GetAllDenomMetaData), ctx)
 }
 
+// GetAllFactoryDenoms mocks base method.
+func (m *MockBankKeeper) GetAllFactoryDenoms(ctx context.Context) []types0.FactoryDenom {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllFactoryDenoms", ctx)
+	ret0, _ := ret[0].([]types0.FactoryDenom)
+	return ret0
+}
+
+// GetAllFactoryDenoms indicates an expected call of GetAllFactoryDenoms.
+func (mr *MockBankKeeperMockRecorder) GetAllFactoryDenoms(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllFactoryDenoms", reflect.TypeOf((*MockBankKeeper)(nil).GetAllFactoryDenoms), ctx)
+}
+
 // GetAllSendEnabledEntries mocks base method.
 func (m *MockBankKeeper) GetAllSendEnabledEntries(ctx context.Context) []types0.SendEnabled {
 	m.ctrl.T.Helper()
@@ -416,6 +591,34 @@ func (mr *MockBankKeeperMockRecorder) GetAllSendEnabledEntries(ctx interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllSendEnabledEntries", reflect.TypeOf((*MockBankKeeper)(nil).GetAllSendEnabledEntries), ctx)
 }
 
+// GetAllSendHooksAttachments mocks base method.
+func (m *MockBankKeeper) GetAllSendHooksAttachments(ctx context.Context) []types0.SendHooksAttachment {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllSendHooksAttachments", ctx)
+	ret0, _ := ret[0].([]types0.SendHooksAttachment)
+	return ret0
+}
+
+// GetAllSendHooksAttachments indicates an expected call of GetAllSendHooksAttachments.
+func (mr *MockBankKeeperMockRecorder) GetAllSendHooksAttachments(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllSendHooksAttachments", reflect.TypeOf((*MockBankKeeper)(nil).GetAllSendHooksAttachments), ctx)
+}
+
+// GetAllStreams mocks base method.
+func (m *MockBankKeeper) GetAllStreams(ctx context.Context) []types0.Stream {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllStreams", ctx)
+	ret0, _ := ret[0].([]types0.Stream)
+	return ret0
+}
+
+// GetAllStreams indicates an expected call of GetAllStreams.
+func (mr *MockBankKeeperMockRecorder) GetAllStreams(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllStreams", reflect.TypeOf((*MockBankKeeper)(nil).GetAllStreams), ctx)
+}
+
 // GetAuthority mocks base method.
 func (m *MockBankKeeper) GetAuthority() string {
 	m.ctrl.T.Helper()
@@ -458,6 +661,21 @@ func (mr *MockBankKeeperMockRecorder) GetBlockedAddresses() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBlockedAddresses", reflect.TypeOf((*MockBankKeeper)(nil).GetBlockedAddresses))
 }
 
+// GetDenomAuthorityMetadata mocks base method.
+func (m *MockBankKeeper) GetDenomAuthorityMetadata(ctx context.Context, denom string) (types0.DenomAuthorityMetadata, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDenomAuthorityMetadata", ctx, denom)
+	ret0, _ := ret[0].(types0.DenomAuthorityMetadata)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDenomAuthorityMetadata indicates an expected call of GetDenomAuthorityMetadata.
+func (mr *MockBankKeeperMockRecorder) GetDenomAuthorityMetadata(ctx, denom interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDenomAuthorityMetadata", reflect.TypeOf((*MockBankKeeper)(nil).GetDenomAuthorityMetadata), ctx, denom)
+}
+
 // GetDenomMetaData mocks base method.
 func (m *MockBankKeeper) GetDenomMetaData(ctx context.Context, denom string) (types0.Metadata, bool) {
 	m.ctrl.T.Helper()
@@ -518,6 +736,36 @@ func (mr *MockBankKeeperMockRecorder) GetSendEnabledEntry(ctx, denom interface{}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSendEnabledEntry", reflect.TypeOf((*MockBankKeeper)(nil).GetSendEnabledEntry), ctx, denom)
 }
 
+// GetSendHooks mocks base method.
+func (m *MockBankKeeper) GetSendHooks(ctx context.Context, denom string) ([]types0.SendHook, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSendHooks", ctx, denom)
+	ret0, _ := ret[0].([]types0.SendHook)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSendHooks indicates an expected call of GetSendHooks.
+func (mr *MockBankKeeperMockRecorder) GetSendHooks(ctx, denom interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSendHooks", reflect.TypeOf((*MockBankKeeper)(nil).GetSendHooks), ctx, denom)
+}
+
+// GetStream mocks base method.
+func (m *MockBankKeeper) GetStream(ctx context.Context, id uint64) (types0.Stream, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStream", ctx, id)
+	ret0, _ := ret[0].(types0.Stream)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStream indicates an expected call of GetStream.
+func (mr *MockBankKeeperMockRecorder) GetStream(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStream", reflect.TypeOf((*MockBankKeeper)(nil).GetStream), ctx, id)
+}
+
 // GetSupply mocks base method.
 func (m *MockBankKeeper) GetSupply(ctx context.Context, denom string) types.Coin {
 	m.ctrl.T.Helper()
@@ -574,6 +822,21 @@ func (mr *MockBankKeeperMockRecorder) HasSupply(ctx, denom interface{}) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HasSupply", reflect.TypeOf((*MockBankKeeper)(nil).HasSupply), ctx, denom)
 }
 
+// HolderCount mocks base method.
+func (m *MockBankKeeper) HolderCount(arg0 context.Context, arg1 *types0.QueryHolderCountRequest) (*types0.QueryHolderCountResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HolderCount", arg0, arg1)
+	ret0, _ := ret[0].(*types0.QueryHolderCountResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// HolderCount indicates an expected call of HolderCount.
+func (mr *MockBankKeeperMockRecorder) HolderCount(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HolderCount", reflect.TypeOf((*MockBankKeeper)(nil).HolderCount), arg0, arg1)
+}
+
 // InitGenesis mocks base method.
 func (m *MockBankKeeper) InitGenesis(arg0 context.Context, arg1 *types0.GenesisState) {
 	m.ctrl.T.Helper()
@@ -735,6 +998,20 @@ func (mr *MockBankKeeperMockRecorder) MintCoins(ctx, moduleName, amt interface{}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MintCoins", reflect.TypeOf((*MockBankKeeper)(nil).MintCoins), ctx, moduleName, amt)
 }
 
+// MintFactoryCoins mocks base method.
+func (m *MockBankKeeper) MintFactoryCoins(ctx context.Context, admin types.AccAddress, amount types.Coin, recipient types.AccAddress) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MintFactoryCoins", ctx, admin, amount, recipient)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MintFactoryCoins indicates an expected call of MintFactoryCoins.
+func (mr *MockBankKeeperMockRecorder) MintFactoryCoins(ctx, admin, amount, recipient interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MintFactoryCoins", reflect.TypeOf((*MockBankKeeper)(nil).MintFactoryCoins), ctx, admin, amount, recipient)
+}
+
 // Params mocks base method.
 func (m *MockBankKeeper) Params(arg0 context.Context, arg1 *types0.QueryParamsRequest) (*types0.QueryParamsResponse, error) {
 	m.ctrl.T.Helper()
@@ -762,6 +1039,18 @@ func (mr *MockBankKeeperMockRecorder) PrependSendRestriction(restriction interfa
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PrependSendRestriction", reflect.TypeOf((*MockBankKeeper)(nil).PrependSendRestriction), restriction)
 }
 
+// RegisterSendHooks mocks base method.
+func (m *MockBankKeeper) RegisterSendHooks(hooks types0.SendHooks) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "RegisterSendHooks", hooks)
+}
+
+// RegisterSendHooks indicates an expected call of RegisterSendHooks.
+func (mr *MockBankKeeperMockRecorder) RegisterSendHooks(hooks interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterSendHooks", reflect.TypeOf((*MockBankKeeper)(nil).RegisterSendHooks), hooks)
+}
+
 // SendCoins mocks base method.
 func (m *MockBankKeeper) SendCoins(ctx context.Context, fromAddr, toAddr types.AccAddress, amt types.Coins) error {
 	m.ctrl.T.Helper()
@@ -833,6 +1122,21 @@ func (mr *MockBankKeeperMockRecorder) SendEnabled(arg0, arg1 interface{}) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendEnabled", reflect.TypeOf((*MockBankKeeper)(nil).SendEnabled), arg0, arg1)
 }
 
+// SendHooks mocks base method.
+func (m *MockBankKeeper) SendHooks(arg0 context.Context, arg1 *types0.QuerySendHooksRequest) (*types0.QuerySendHooksResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendHooks", arg0, arg1)
+	ret0, _ := ret[0].(*types0.QuerySendHooksResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SendHooks indicates an expected call of SendHooks.
+func (mr *MockBankKeeperMockRecorder) SendHooks(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendHooks", reflect.TypeOf((*MockBankKeeper)(nil).SendHooks), arg0, arg1)
+}
+
 // SetAllSendEnabled mocks base method.
 func (m *MockBankKeeper) SetAllSendEnabled(ctx context.Context, sendEnableds []*types0.SendEnabled) {
 	m.ctrl.T.Helper()
@@ -845,6 +1149,20 @@ func (mr *MockBankKeeperMockRecorder) SetAllSendEnabled(ctx, sendEnableds interf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetAllSendEnabled", reflect.TypeOf((*MockBankKeeper)(nil).SetAllSendEnabled), ctx, sendEnableds)
 }
 
+// SetDenomAuthorityMetadata mocks base method.
+func (m *MockBankKeeper) SetDenomAuthorityMetadata(ctx context.Context, denom string, metadata types0.DenomAuthorityMetadata) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetDenomAuthorityMetadata", ctx, denom, metadata)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetDenomAuthorityMetadata indicates an expected call of SetDenomAuthorityMetadata.
+func (mr *MockBankKeeperMockRecorder) SetDenomAuthorityMetadata(ctx, denom, metadata interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetDenomAuthorityMetadata", reflect.TypeOf((*MockBankKeeper)(nil).SetDenomAuthorityMetadata), ctx, denom, metadata)
+}
+
 // SetDenomMetaData mocks base method.
 func (m *MockBankKeeper) SetDenomMetaData(ctx context.Context, denomMetaData types0.Metadata) {
 	m.ctrl.T.Helper()
@@ -857,6 +1175,20 @@ func (mr *MockBankKeeperMockRecorder) SetDenomMetaData(ctx, denomMetaData interf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetDenomMetaData", reflect.TypeOf((*MockBankKeeper)(nil).SetDenomMetaData), ctx, denomMetaData)
 }
 
+// SetFactoryDenomMetadata mocks base method.
+func (m *MockBankKeeper) SetFactoryDenomMetadata(ctx context.Context, admin types.AccAddress, metadata types0.Metadata) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetFactoryDenomMetadata", ctx, admin, metadata)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetFactoryDenomMetadata indicates an expected call of SetFactoryDenomMetadata.
+func (mr *MockBankKeeperMockRecorder) SetFactoryDenomMetadata(ctx, admin, metadata interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetFactoryDenomMetadata", reflect.TypeOf((*MockBankKeeper)(nil).SetFactoryDenomMetadata), ctx, admin, metadata)
+}
+
 // SetParams mocks base method.
 func (m *MockBankKeeper) SetParams(ctx context.Context, params types0.Params) error {
 	m.ctrl.T.Helper()
@@ -941,6 +1273,36 @@ func (mr *MockBankKeeperMockRecorder) SpendableCoins(ctx, addr interface{}) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SpendableCoins", reflect.TypeOf((*MockBankKeeper)(nil).SpendableCoins), ctx, addr)
 }
 
+// Stream mocks base method.
+func (m *MockBankKeeper) Stream(arg0 context.Context, arg1 *types0.QueryStreamRequest) (*types0.QueryStreamResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Stream", arg0, arg1)
+	ret0, _ := ret[0].(*types0.QueryStreamResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Stream indicates an expected call of Stream.
+func (mr *MockBankKeeperMockRecorder) Stream(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Stream", reflect.TypeOf((*MockBankKeeper)(nil).Stream), arg0, arg1)
+}
+
+// Streams mocks base method.
+func (m *MockBankKeeper) Streams(arg0 context.Context, arg1 *types0.QueryStreamsRequest) (*types0.QueryStreamsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Streams", arg0, arg1)
+	ret0, _ := ret[0].(*types0.QueryStreamsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Streams indicates an expected call of Streams.
+func (mr *MockBankKeeperMockRecorder) Streams(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Streams", reflect.TypeOf((*MockBankKeeper)(nil).Streams), arg0, arg1)
+}
+
 // SupplyOf mocks base method.
 func (m *MockBankKeeper) SupplyOf(arg0 context.Context, arg1 *types0.QuerySupplyOfRequest) (*types0.QuerySupplyOfResponse, error) {
 	m.ctrl.T.Helper()
@@ -956,6 +1318,35 @@ func (mr *MockBankKeeperMockRecorder) SupplyOf(arg0, arg1 interface{}) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SupplyOf", reflect.TypeOf((*MockBankKeeper)(nil).SupplyOf), arg0, arg1)
 }
 
+// TopHolders mocks base method.
+func (m *MockBankKeeper) TopHolders(arg0 context.Context, arg1 *types0.QueryTopHoldersRequest) (*types0.QueryTopHoldersResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TopHolders", arg0, arg1)
+	ret0, _ := ret[0].(*types0.QueryTopHoldersResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TopHolders indicates an expected call of TopHolders.
+func (mr *MockBankKeeperMockRecorder) TopHolders(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TopHolders", reflect.TypeOf((*MockBankKeeper)(nil).TopHolders), arg0, arg1)
+}
+
+// TopUpStream mocks base method.
+func (m *MockBankKeeper) TopUpStream(ctx context.Context, sender types.AccAddress, id uint64, amount types.Coin) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TopUpStream", ctx, sender, id, amount)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// TopUpStream indicates an expected call of TopUpStream.
+func (mr *MockBankKeeperMockRecorder) TopUpStream(ctx, sender, id, amount interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TopUpStream", reflect.TypeOf((*MockBankKeeper)(nil).TopUpStream), ctx, sender, id, amount)
+}
+
 // TotalSupply mocks base method.
 func (m *MockBankKeeper) TotalSupply(arg0 context.Context, arg1 *types0.QueryTotalSupplyRequest) (*types0.QueryTotalSupplyResponse, error) {
 	m.ctrl.T.Helper()
@@ -1027,6 +1418,21 @@ func (mr *MockBankKeeperMockRecorder) WithMintCoinsRestriction(arg0 interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WithMintCoinsRestriction", reflect.TypeOf((*MockBankKeeper)(nil).WithMintCoinsRestriction), arg0)
 }
 
+// WithdrawStream mocks base method.
+func (m *MockBankKeeper) WithdrawStream(ctx context.Context, recipient types.AccAddress, id uint64) (types.Coin, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WithdrawStream", ctx, recipient, id)
+	ret0, _ := ret[0].(types.Coin)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WithdrawStream indicates an expected call of WithdrawStream.
+func (mr *MockBankKeeperMockRecorder) WithdrawStream(ctx, recipient, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WithdrawStream", reflect.TypeOf((*MockBankKeeper)(nil).WithdrawStream), ctx, recipient, id)
+}
+
 // MockStakingKeeper is a mock of StakingKeeper interface.
 type MockStakingKeeper struct {
 	ctrl     *gomock.Controller
@@ -1065,6 +1471,21 @@ func (mr *MockStakingKeeperMockRecorder) BondDenom(ctx interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BondDenom", reflect.TypeOf((*MockStakingKeeper)(nil).BondDenom), ctx)
 }
 
+// Delegation mocks base method.
+func (m *MockStakingKeeper) Delegation(ctx context.Context, delAddr types.AccAddress, valAddr types.ValAddress) (types1.DelegationI, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delegation", ctx, delAddr, valAddr)
+	ret0, _ := ret[0].(types1.DelegationI)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Delegation indicates an expected call of Delegation.
+func (mr *MockStakingKeeperMockRecorder) Delegation(ctx, delAddr, valAddr interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delegation", reflect.TypeOf((*MockStakingKeeper)(nil).Delegation), ctx, delAddr, valAddr)
+}
+
 // IterateBondedValidatorsByPower mocks base method.
 func (m *MockStakingKeeper) IterateBondedValidatorsByPower(arg0 context.Context, arg1 func(int64, types1.ValidatorI) bool) error {
 	m.ctrl.T.Helper()
@@ -25,4 +25,8 @@ var (
 	VotesKeyPrefix                = collections.NewPrefix(32) // VotesKeyPrefix stores the votes of proposals.
 	ParamsKey                     = collections.NewPrefix(48) // ParamsKey stores the module's params.
 	ConstitutionKey               = collections.NewPrefix(49) // ConstitutionKey stores a chain's constitution.
+	VoteSharesKeyPrefix           = collections.NewPrefix(64) // VoteSharesKeyPrefix stores the delegation shares of each voter counted in the running tallies.
+	TallySharesKeyPrefix          = collections.NewPrefix(65) // TallySharesKeyPrefix stores the running tallies: the voters' delegation shares by validator and vote option.
+	TallyDeductionsKeyPrefix      = collections.NewPrefix(66) // TallyDeductionsKeyPrefix stores the voters' delegation shares by validator, deducted from the validators' votes.
+	ScheduledProposalsKeyPrefix   = collections.NewPrefix(67) // ScheduledProposalsKeyPrefix stores which passed proposals are scheduled for execution.
 )
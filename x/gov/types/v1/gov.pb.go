@@ -46,6 +46,14 @@ const (
 	VoteOption_VOTE_OPTION_NO VoteOption = 3
 	// VOTE_OPTION_NO_WITH_VETO defines a no with veto vote option.
 	VoteOption_VOTE_OPTION_NO_WITH_VETO VoteOption = 4
+	// VOTE_OPTION_ONE defines the first option of a multiple choice proposal.
+	VoteOption_VOTE_OPTION_ONE VoteOption = 1
+	// VOTE_OPTION_TWO defines the second option of a multiple choice proposal.
+	VoteOption_VOTE_OPTION_TWO VoteOption = 2
+	// VOTE_OPTION_THREE defines the third option of a multiple choice proposal.
+	VoteOption_VOTE_OPTION_THREE VoteOption = 3
+	// VOTE_OPTION_FOUR defines the fourth option of a multiple choice proposal.
+	VoteOption_VOTE_OPTION_FOUR VoteOption = 4
 )
 
 var VoteOption_name = map[int32]string{
@@ -54,6 +62,10 @@ var VoteOption_name = map[int32]string{
 	2: "VOTE_OPTION_ABSTAIN",
 	3: "VOTE_OPTION_NO",
 	4: "VOTE_OPTION_NO_WITH_VETO",
+	// Duplicate value: 1: "VOTE_OPTION_ONE",
+	// Duplicate value: 2: "VOTE_OPTION_TWO",
+	// Duplicate value: 3: "VOTE_OPTION_THREE",
+	// Duplicate value: 4: "VOTE_OPTION_FOUR",
 }
 
 var VoteOption_value = map[string]int32{
@@ -62,6 +74,10 @@ var VoteOption_value = map[string]int32{
 	"VOTE_OPTION_ABSTAIN":      2,
 	"VOTE_OPTION_NO":           3,
 	"VOTE_OPTION_NO_WITH_VETO": 4,
+	"VOTE_OPTION_ONE":          1,
+	"VOTE_OPTION_TWO":          2,
+	"VOTE_OPTION_THREE":        3,
+	"VOTE_OPTION_FOUR":         4,
 }
 
 func (x VoteOption) String() string {
@@ -72,6 +88,47 @@ func (VoteOption) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_e05cb1c0d030febb, []int{0}
 }
 
+// ProposalType enumerates the valid proposal types.
+type ProposalType int32
+
+const (
+	// PROPOSAL_TYPE_UNSPECIFIED defines no proposal type, which fallback to PROPOSAL_TYPE_STANDARD.
+	ProposalType_PROPOSAL_TYPE_UNSPECIFIED ProposalType = 0
+	// PROPOSAL_TYPE_STANDARD defines the type for a standard proposal, which
+	// requires a quorum and a threshold of Yes votes to pass.
+	ProposalType_PROPOSAL_TYPE_STANDARD ProposalType = 1
+	// PROPOSAL_TYPE_OPTIMISTIC defines the type for an optimistic proposal,
+	// which can only be submitted by authorized addresses and passes at the end
+	// of its voting period unless enough stake votes against it.
+	ProposalType_PROPOSAL_TYPE_OPTIMISTIC ProposalType = 2
+	// PROPOSAL_TYPE_MULTIPLE_CHOICE defines the type for a multiple choice
+	// proposal, which executes no messages and whose voters choose among its
+	// vote options.
+	ProposalType_PROPOSAL_TYPE_MULTIPLE_CHOICE ProposalType = 3
+)
+
+var ProposalType_name = map[int32]string{
+	0: "PROPOSAL_TYPE_UNSPECIFIED",
+	1: "PROPOSAL_TYPE_STANDARD",
+	2: "PROPOSAL_TYPE_OPTIMISTIC",
+	3: "PROPOSAL_TYPE_MULTIPLE_CHOICE",
+}
+
+var ProposalType_value = map[string]int32{
+	"PROPOSAL_TYPE_UNSPECIFIED":     0,
+	"PROPOSAL_TYPE_STANDARD":        1,
+	"PROPOSAL_TYPE_OPTIMISTIC":      2,
+	"PROPOSAL_TYPE_MULTIPLE_CHOICE": 3,
+}
+
+func (x ProposalType) String() string {
+	return proto.EnumName(ProposalType_name, int32(x))
+}
+
+func (ProposalType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_e05cb1c0d030febb, []int{1}
+}
+
 // ProposalStatus enumerates the valid statuses of a proposal.
 type ProposalStatus int32
 
@@ -93,6 +150,9 @@ const (
 	// PROPOSAL_STATUS_FAILED defines a proposal status of a proposal that has
 	// failed.
 	ProposalStatus_PROPOSAL_STATUS_FAILED ProposalStatus = 5
+	// PROPOSAL_STATUS_SCHEDULED defines a proposal status of a proposal that has
+	// passed, and whose messages are executed at its execution time or height.
+	ProposalStatus_PROPOSAL_STATUS_SCHEDULED ProposalStatus = 6
 )
 
 var ProposalStatus_name = map[int32]string{
@@ -102,6 +162,7 @@ var ProposalStatus_name = map[int32]string{
 	3: "PROPOSAL_STATUS_PASSED",
 	4: "PROPOSAL_STATUS_REJECTED",
 	5: "PROPOSAL_STATUS_FAILED",
+	6: "PROPOSAL_STATUS_SCHEDULED",
 }
 
 var ProposalStatus_value = map[string]int32{
@@ -111,6 +172,7 @@ var ProposalStatus_value = map[string]int32{
 	"PROPOSAL_STATUS_PASSED":         3,
 	"PROPOSAL_STATUS_REJECTED":       4,
 	"PROPOSAL_STATUS_FAILED":         5,
+	"PROPOSAL_STATUS_SCHEDULED":      6,
 }
 
 func (x ProposalStatus) String() string {
@@ -118,7 +180,7 @@ func (x ProposalStatus) String() string {
 }
 
 func (ProposalStatus) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_e05cb1c0d030febb, []int{1}
+	return fileDescriptor_e05cb1c0d030febb, []int{2}
 }
 
 // WeightedVoteOption defines a unit of vote for vote split.
@@ -287,6 +349,16 @@ type Proposal struct {
 	//
 	// Since: cosmos-sdk 0.50
 	FailedReason string `protobuf:"bytes,15,opt,name=failed_reason,json=failedReason,proto3" json:"failed_reason,omitempty"`
+	// proposal_type defines the type of the proposal
+	ProposalType ProposalType `protobuf:"varint,16,opt,name=proposal_type,json=proposalType,proto3,enum=cosmos.gov.v1.ProposalType" json:"proposal_type,omitempty"`
+	// vote_options defines the named vote options of a multiple choice proposal.
+	VoteOptions *ProposalVoteOptions `protobuf:"bytes,17,opt,name=vote_options,json=voteOptions,proto3" json:"vote_options,omitempty"`
+	// execution_time is the time from which the messages of the proposal are
+	// executed once it passes. The proposal is scheduled until then.
+	ExecutionTime *time.Time `protobuf:"bytes,18,opt,name=execution_time,json=executionTime,proto3,stdtime" json:"execution_time,omitempty"`
+	// execution_height is the block height from which the messages of the
+	// proposal are executed once it passes. The proposal is scheduled until then.
+	ExecutionHeight int64 `protobuf:"varint,19,opt,name=execution_height,json=executionHeight,proto3" json:"execution_height,omitempty"`
 }
 
 func (m *Proposal) Reset()         { *m = Proposal{} }
@@ -427,6 +499,112 @@ func (m *Proposal) GetFailedReason() string {
 	return ""
 }
 
+func (m *Proposal) GetProposalType() ProposalType {
+	if m != nil {
+		return m.ProposalType
+	}
+	return ProposalType_PROPOSAL_TYPE_UNSPECIFIED
+}
+
+func (m *Proposal) GetVoteOptions() *ProposalVoteOptions {
+	if m != nil {
+		return m.VoteOptions
+	}
+	return nil
+}
+
+func (m *Proposal) GetExecutionTime() *time.Time {
+	if m != nil {
+		return m.ExecutionTime
+	}
+	return nil
+}
+
+func (m *Proposal) GetExecutionHeight() int64 {
+	if m != nil {
+		return m.ExecutionHeight
+	}
+	return 0
+}
+
+// ProposalVoteOptions defines the named vote options of a multiple choice
+// proposal. They are voted on with VOTE_OPTION_ONE to VOTE_OPTION_FOUR, so a
+// multiple choice proposal has at most four options. Their results are stored
+// as the yes, abstain, no and no with veto counts of the tally result,
+// respectively, and are reported by option name in the option tallies of the
+// Query/TallyResult response.
+type ProposalVoteOptions struct {
+	// option_one is the name of the first option.
+	OptionOne string `protobuf:"bytes,1,opt,name=option_one,json=optionOne,proto3" json:"option_one,omitempty"`
+	// option_two is the name of the second option.
+	OptionTwo string `protobuf:"bytes,2,opt,name=option_two,json=optionTwo,proto3" json:"option_two,omitempty"`
+	// option_three is the name of the third option, if any.
+	OptionThree string `protobuf:"bytes,3,opt,name=option_three,json=optionThree,proto3" json:"option_three,omitempty"`
+	// option_four is the name of the fourth option, if any.
+	OptionFour string `protobuf:"bytes,4,opt,name=option_four,json=optionFour,proto3" json:"option_four,omitempty"`
+}
+
+func (m *ProposalVoteOptions) Reset()         { *m = ProposalVoteOptions{} }
+func (m *ProposalVoteOptions) String() string { return proto.CompactTextString(m) }
+func (*ProposalVoteOptions) ProtoMessage()    {}
+func (*ProposalVoteOptions) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e05cb1c0d030febb, []int{3}
+}
+func (m *ProposalVoteOptions) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProposalVoteOptions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProposalVoteOptions.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ProposalVoteOptions) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProposalVoteOptions.Merge(m, src)
+}
+func (m *ProposalVoteOptions) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProposalVoteOptions) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProposalVoteOptions.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProposalVoteOptions proto.InternalMessageInfo
+
+func (m *ProposalVoteOptions) GetOptionOne() string {
+	if m != nil {
+		return m.OptionOne
+	}
+	return ""
+}
+
+func (m *ProposalVoteOptions) GetOptionTwo() string {
+	if m != nil {
+		return m.OptionTwo
+	}
+	return ""
+}
+
+func (m *ProposalVoteOptions) GetOptionThree() string {
+	if m != nil {
+		return m.OptionThree
+	}
+	return ""
+}
+
+func (m *ProposalVoteOptions) GetOptionFour() string {
+	if m != nil {
+		return m.OptionFour
+	}
+	return ""
+}
+
 // TallyResult defines a standard tally for a governance proposal.
 type TallyResult struct {
 	// yes_count is the number of yes votes on a proposal.
@@ -443,7 +621,7 @@ func (m *TallyResult) Reset()         { *m = TallyResult{} }
 func (m *TallyResult) String() string { return proto.CompactTextString(m) }
 func (*TallyResult) ProtoMessage()    {}
 func (*TallyResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e05cb1c0d030febb, []int{3}
+	return fileDescriptor_e05cb1c0d030febb, []int{4}
 }
 func (m *TallyResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -500,6 +678,62 @@ func (m *TallyResult) GetNoWithVetoCount() string {
 	return ""
 }
 
+// OptionTallyResult defines the tally of a named vote option of a multiple
+// choice proposal.
+type OptionTallyResult struct {
+	// option is the name of the vote option.
+	Option string `protobuf:"bytes,1,opt,name=option,proto3" json:"option,omitempty"`
+	// count is the number of votes for the vote option.
+	Count string `protobuf:"bytes,2,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (m *OptionTallyResult) Reset()         { *m = OptionTallyResult{} }
+func (m *OptionTallyResult) String() string { return proto.CompactTextString(m) }
+func (*OptionTallyResult) ProtoMessage()    {}
+func (*OptionTallyResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e05cb1c0d030febb, []int{5}
+}
+func (m *OptionTallyResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OptionTallyResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OptionTallyResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OptionTallyResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OptionTallyResult.Merge(m, src)
+}
+func (m *OptionTallyResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *OptionTallyResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_OptionTallyResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OptionTallyResult proto.InternalMessageInfo
+
+func (m *OptionTallyResult) GetOption() string {
+	if m != nil {
+		return m.Option
+	}
+	return ""
+}
+
+func (m *OptionTallyResult) GetCount() string {
+	if m != nil {
+		return m.Count
+	}
+	return ""
+}
+
 // Vote defines a vote on a governance proposal.
 // A Vote consists of a proposal ID, the voter, and the vote option.
 type Vote struct {
@@ -518,7 +752,7 @@ func (m *Vote) Reset()         { *m = Vote{} }
 func (m *Vote) String() string { return proto.CompactTextString(m) }
 func (*Vote) ProtoMessage()    {}
 func (*Vote) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e05cb1c0d030febb, []int{4}
+	return fileDescriptor_e05cb1c0d030febb, []int{6}
 }
 func (m *Vote) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -590,7 +824,7 @@ func (m *DepositParams) Reset()         { *m = DepositParams{} }
 func (m *DepositParams) String() string { return proto.CompactTextString(m) }
 func (*DepositParams) ProtoMessage()    {}
 func (*DepositParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e05cb1c0d030febb, []int{5}
+	return fileDescriptor_e05cb1c0d030febb, []int{7}
 }
 func (m *DepositParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -645,7 +879,7 @@ func (m *VotingParams) Reset()         { *m = VotingParams{} }
 func (m *VotingParams) String() string { return proto.CompactTextString(m) }
 func (*VotingParams) ProtoMessage()    {}
 func (*VotingParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e05cb1c0d030febb, []int{6}
+	return fileDescriptor_e05cb1c0d030febb, []int{8}
 }
 func (m *VotingParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -699,7 +933,7 @@ func (m *TallyParams) Reset()         { *m = TallyParams{} }
 func (m *TallyParams) String() string { return proto.CompactTextString(m) }
 func (*TallyParams) ProtoMessage()    {}
 func (*TallyParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e05cb1c0d030febb, []int{7}
+	return fileDescriptor_e05cb1c0d030febb, []int{9}
 }
 func (m *TallyParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -760,15 +994,15 @@ type Params struct {
 	MaxDepositPeriod *time.Duration `protobuf:"bytes,2,opt,name=max_deposit_period,json=maxDepositPeriod,proto3,stdduration" json:"max_deposit_period,omitempty"`
 	// Duration of the voting period.
 	VotingPeriod *time.Duration `protobuf:"bytes,3,opt,name=voting_period,json=votingPeriod,proto3,stdduration" json:"voting_period,omitempty"`
-	// Minimum percentage of total stake needed to vote for a result to be
-	// considered valid.
+	//  Minimum percentage of total stake needed to vote for a result to be
+	//  considered valid.
 	Quorum string `protobuf:"bytes,4,opt,name=quorum,proto3" json:"quorum,omitempty"`
-	// Minimum proportion of Yes votes for proposal to pass. Default value: 0.5.
+	//  Minimum proportion of Yes votes for proposal to pass. Default value: 0.5.
 	Threshold string `protobuf:"bytes,5,opt,name=threshold,proto3" json:"threshold,omitempty"`
-	// Minimum value of Veto votes to Total votes ratio for proposal to be
-	// vetoed. Default value: 1/3.
+	//  Minimum value of Veto votes to Total votes ratio for proposal to be
+	//  vetoed. Default value: 1/3.
 	VetoThreshold string `protobuf:"bytes,6,opt,name=veto_threshold,json=vetoThreshold,proto3" json:"veto_threshold,omitempty"`
-	// The ratio representing the proportion of the deposit value that must be paid at proposal submission.
+	//  The ratio representing the proportion of the deposit value that must be paid at proposal submission.
 	MinInitialDepositRatio string `protobuf:"bytes,7,opt,name=min_initial_deposit_ratio,json=minInitialDepositRatio,proto3" json:"min_initial_deposit_ratio,omitempty"`
 	// The cancel ratio which will not be returned back to the depositors when a proposal is cancelled.
 	//
@@ -787,7 +1021,7 @@ type Params struct {
 	//
 	// Since: cosmos-sdk 0.50
 	ExpeditedThreshold string `protobuf:"bytes,11,opt,name=expedited_threshold,json=expeditedThreshold,proto3" json:"expedited_threshold,omitempty"`
-	// Minimum expedited deposit for a proposal to enter voting period.
+	//  Minimum expedited deposit for a proposal to enter voting period.
 	ExpeditedMinDeposit []types.Coin `protobuf:"bytes,12,rep,name=expedited_min_deposit,json=expeditedMinDeposit,proto3" json:"expedited_min_deposit"`
 	// burn deposits if a proposal does not meet quorum
 	BurnVoteQuorum bool `protobuf:"varint,13,opt,name=burn_vote_quorum,json=burnVoteQuorum,proto3" json:"burn_vote_quorum,omitempty"`
@@ -801,13 +1035,20 @@ type Params struct {
 	//
 	// Since: cosmos-sdk 0.50
 	MinDepositRatio string `protobuf:"bytes,16,opt,name=min_deposit_ratio,json=minDepositRatio,proto3" json:"min_deposit_ratio,omitempty"`
+	// optimistic_authorized_addresses is the list of the addresses allowed to
+	// submit optimistic proposals.
+	OptimisticAuthorizedAddresses []string `protobuf:"bytes,17,rep,name=optimistic_authorized_addresses,json=optimisticAuthorizedAddresses,proto3" json:"optimistic_authorized_addresses,omitempty"`
+	// optimistic_rejected_threshold is the minimum proportion of the total
+	// bonded stake voting No or NoWithVeto for an optimistic proposal to be
+	// rejected. Default value: 0.1.
+	OptimisticRejectedThreshold string `protobuf:"bytes,18,opt,name=optimistic_rejected_threshold,json=optimisticRejectedThreshold,proto3" json:"optimistic_rejected_threshold,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
 func (m *Params) String() string { return proto.CompactTextString(m) }
 func (*Params) ProtoMessage()    {}
 func (*Params) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e05cb1c0d030febb, []int{8}
+	return fileDescriptor_e05cb1c0d030febb, []int{10}
 }
 func (m *Params) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -948,13 +1189,30 @@ func (m *Params) GetMinDepositRatio() string {
 	return ""
 }
 
+func (m *Params) GetOptimisticAuthorizedAddresses() []string {
+	if m != nil {
+		return m.OptimisticAuthorizedAddresses
+	}
+	return nil
+}
+
+func (m *Params) GetOptimisticRejectedThreshold() string {
+	if m != nil {
+		return m.OptimisticRejectedThreshold
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterEnum("cosmos.gov.v1.VoteOption", VoteOption_name, VoteOption_value)
+	proto.RegisterEnum("cosmos.gov.v1.ProposalType", ProposalType_name, ProposalType_value)
 	proto.RegisterEnum("cosmos.gov.v1.ProposalStatus", ProposalStatus_name, ProposalStatus_value)
 	proto.RegisterType((*WeightedVoteOption)(nil), "cosmos.gov.v1.WeightedVoteOption")
 	proto.RegisterType((*Deposit)(nil), "cosmos.gov.v1.Deposit")
 	proto.RegisterType((*Proposal)(nil), "cosmos.gov.v1.Proposal")
+	proto.RegisterType((*ProposalVoteOptions)(nil), "cosmos.gov.v1.ProposalVoteOptions")
 	proto.RegisterType((*TallyResult)(nil), "cosmos.gov.v1.TallyResult")
+	proto.RegisterType((*OptionTallyResult)(nil), "cosmos.gov.v1.OptionTallyResult")
 	proto.RegisterType((*Vote)(nil), "cosmos.gov.v1.Vote")
 	proto.RegisterType((*DepositParams)(nil), "cosmos.gov.v1.DepositParams")
 	proto.RegisterType((*VotingParams)(nil), "cosmos.gov.v1.VotingParams")
@@ -965,97 +1223,118 @@ func init() {
 func init() { proto.RegisterFile("cosmos/gov/v1/gov.proto", fileDescriptor_e05cb1c0d030febb) }
 
 var fileDescriptor_e05cb1c0d030febb = []byte{
-	// 1432 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x57, 0x4b, 0x6f, 0xdb, 0xc6,
-	0x16, 0x36, 0x25, 0x59, 0x96, 0x8f, 0x1e, 0x56, 0xc6, 0x4e, 0x4c, 0x3b, 0xb1, 0xec, 0xe8, 0x06,
-	0x81, 0x6f, 0x1e, 0xd2, 0x75, 0x72, 0x73, 0x17, 0xc9, 0x05, 0x0a, 0xd9, 0x62, 0x1a, 0x1a, 0x89,
-	0xa5, 0x52, 0x8a, 0x9d, 0x74, 0x43, 0xd0, 0xe6, 0x44, 0x1e, 0x54, 0xe4, 0xa8, 0x9c, 0x91, 0x63,
-	0xed, 0xbb, 0xe9, 0xaa, 0x59, 0x76, 0x55, 0x74, 0xd9, 0x65, 0x17, 0x41, 0x7f, 0x43, 0x56, 0x45,
-	0x90, 0x4d, 0xbb, 0x69, 0x5a, 0x24, 0x8b, 0x02, 0xf9, 0x15, 0x05, 0x87, 0x43, 0x91, 0x96, 0xd5,
-	0xda, 0xce, 0xc6, 0x16, 0xcf, 0xf9, 0xbe, 0x6f, 0xce, 0x9c, 0xc7, 0x0c, 0x09, 0xf3, 0x7b, 0x94,
-	0x39, 0x94, 0x55, 0x3b, 0xf4, 0xa0, 0x7a, 0xb0, 0xe6, 0xff, 0xab, 0xf4, 0x3c, 0xca, 0x29, 0xca,
-	0x07, 0x8e, 0x8a, 0x6f, 0x39, 0x58, 0x5b, 0x2c, 0x49, 0xdc, 0xae, 0xc5, 0x70, 0xf5, 0x60, 0x6d,
-	0x17, 0x73, 0x6b, 0xad, 0xba, 0x47, 0x89, 0x1b, 0xc0, 0x17, 0xe7, 0x3a, 0xb4, 0x43, 0xc5, 0xcf,
-	0xaa, 0xff, 0x4b, 0x5a, 0x97, 0x3b, 0x94, 0x76, 0xba, 0xb8, 0x2a, 0x9e, 0x76, 0xfb, 0xcf, 0xaa,
-	0x9c, 0x38, 0x98, 0x71, 0xcb, 0xe9, 0x49, 0xc0, 0xc2, 0x28, 0xc0, 0x72, 0x07, 0xd2, 0x55, 0x1a,
-	0x75, 0xd9, 0x7d, 0xcf, 0xe2, 0x84, 0x86, 0x2b, 0x2e, 0x04, 0x11, 0x99, 0xc1, 0xa2, 0x32, 0xda,
-	0xc0, 0x75, 0xce, 0x72, 0x88, 0x4b, 0xab, 0xe2, 0x6f, 0x60, 0x2a, 0x53, 0x40, 0x3b, 0x98, 0x74,
-	0xf6, 0x39, 0xb6, 0xb7, 0x29, 0xc7, 0x8d, 0x9e, 0xaf, 0x84, 0xd6, 0x20, 0x4d, 0xc5, 0x2f, 0x55,
-	0x59, 0x51, 0x56, 0x0b, 0xb7, 0x16, 0x2a, 0x47, 0x76, 0x5d, 0x89, 0xa0, 0x86, 0x04, 0xa2, 0xab,
-	0x90, 0x7e, 0x2e, 0x84, 0xd4, 0xc4, 0x8a, 0xb2, 0x3a, 0xbd, 0x5e, 0x78, 0xf3, 0xf2, 0x26, 0x48,
-	0x56, 0x1d, 0xef, 0x19, 0xd2, 0x5b, 0xfe, 0x5e, 0x81, 0xa9, 0x3a, 0xee, 0x51, 0x46, 0x38, 0x5a,
-	0x86, 0x6c, 0xcf, 0xa3, 0x3d, 0xca, 0xac, 0xae, 0x49, 0x6c, 0xb1, 0x56, 0xca, 0x80, 0xd0, 0xa4,
-	0xdb, 0xe8, 0x7f, 0x30, 0x6d, 0x07, 0x58, 0xea, 0x49, 0x5d, 0xf5, 0xcd, 0xcb, 0x9b, 0x73, 0x52,
-	0xb7, 0x66, 0xdb, 0x1e, 0x66, 0xac, 0xc5, 0x3d, 0xe2, 0x76, 0x8c, 0x08, 0x8a, 0xfe, 0x0f, 0x69,
-	0xcb, 0xa1, 0x7d, 0x97, 0xab, 0xc9, 0x95, 0xe4, 0x6a, 0x36, 0x8a, 0xdf, 0x2f, 0x53, 0x45, 0x96,
-	0xa9, 0xb2, 0x41, 0x89, 0xbb, 0x3e, 0xfd, 0xea, 0xed, 0xf2, 0xc4, 0x0f, 0x7f, 0xfe, 0x78, 0x4d,
-	0x31, 0x24, 0xa7, 0xfc, 0x55, 0x1a, 0x32, 0x4d, 0x19, 0x04, 0x2a, 0x40, 0x62, 0x18, 0x5a, 0x82,
-	0xd8, 0xe8, 0x3f, 0x90, 0x71, 0x30, 0x63, 0x56, 0x07, 0x33, 0x35, 0x21, 0xc4, 0xe7, 0x2a, 0x41,
-	0x45, 0x2a, 0x61, 0x45, 0x2a, 0x35, 0x77, 0x60, 0x0c, 0x51, 0xe8, 0x0e, 0xa4, 0x19, 0xb7, 0x78,
-	0x9f, 0xa9, 0x49, 0x91, 0xcc, 0xa5, 0x91, 0x64, 0x86, 0x4b, 0xb5, 0x04, 0xc8, 0x90, 0x60, 0xf4,
-	0x00, 0xd0, 0x33, 0xe2, 0x5a, 0x5d, 0x93, 0x5b, 0xdd, 0xee, 0xc0, 0xf4, 0x30, 0xeb, 0x77, 0xb9,
-	0x9a, 0x5a, 0x51, 0x56, 0xb3, 0xb7, 0x16, 0x47, 0x24, 0xda, 0x3e, 0xc4, 0x10, 0x08, 0xa3, 0x28,
-	0x58, 0x31, 0x0b, 0xaa, 0x41, 0x96, 0xf5, 0x77, 0x1d, 0xc2, 0x4d, 0xbf, 0xcd, 0xd4, 0x49, 0x29,
-	0x31, 0x1a, 0x75, 0x3b, 0xec, 0xc1, 0xf5, 0xd4, 0x8b, 0xdf, 0x97, 0x15, 0x03, 0x02, 0x92, 0x6f,
-	0x46, 0x9b, 0x50, 0x94, 0xd9, 0x35, 0xb1, 0x6b, 0x07, 0x3a, 0xe9, 0x53, 0xea, 0x14, 0x24, 0x53,
-	0x73, 0x6d, 0xa1, 0xa5, 0x43, 0x9e, 0x53, 0x6e, 0x75, 0x4d, 0x69, 0x57, 0xa7, 0xce, 0x50, 0xa3,
-	0x9c, 0xa0, 0x86, 0x0d, 0xf4, 0x10, 0xce, 0x1d, 0x50, 0x4e, 0xdc, 0x8e, 0xc9, 0xb8, 0xe5, 0xc9,
-	0xfd, 0x65, 0x4e, 0x19, 0xd7, 0x4c, 0x40, 0x6d, 0xf9, 0x4c, 0x11, 0xd8, 0x03, 0x90, 0xa6, 0x68,
-	0x8f, 0xd3, 0xa7, 0xd4, 0xca, 0x07, 0xc4, 0x70, 0x8b, 0x8b, 0x7e, 0x93, 0x70, 0xcb, 0xb6, 0xb8,
-	0xa5, 0x82, 0xdf, 0xb6, 0xc6, 0xf0, 0x19, 0xcd, 0xc1, 0x24, 0x27, 0xbc, 0x8b, 0xd5, 0xac, 0x70,
-	0x04, 0x0f, 0x48, 0x85, 0x29, 0xd6, 0x77, 0x1c, 0xcb, 0x1b, 0xa8, 0x39, 0x61, 0x0f, 0x1f, 0xd1,
-	0x7f, 0x21, 0x13, 0x4c, 0x04, 0xf6, 0xd4, 0xfc, 0x09, 0x23, 0x30, 0x44, 0xa2, 0x4b, 0x30, 0x8d,
-	0x0f, 0x7b, 0xd8, 0x26, 0x1c, 0xdb, 0x6a, 0x61, 0x45, 0x59, 0xcd, 0x18, 0x91, 0x01, 0xfd, 0x0b,
-	0xf2, 0xcf, 0x2c, 0xd2, 0xc5, 0xb6, 0xe9, 0x61, 0x8b, 0x51, 0x57, 0x9d, 0x11, 0x6b, 0xe6, 0x02,
-	0xa3, 0x21, 0x6c, 0xe5, 0x5f, 0x14, 0xc8, 0xc6, 0xdb, 0xe8, 0x3a, 0x4c, 0x0f, 0x30, 0x33, 0xf7,
-	0xc4, 0x5c, 0x29, 0xc7, 0x86, 0x5c, 0x77, 0xb9, 0x91, 0x19, 0x60, 0xb6, 0xe1, 0xfb, 0xd1, 0x6d,
-	0xc8, 0x5b, 0xbb, 0x8c, 0x5b, 0xc4, 0x95, 0x84, 0xc4, 0x58, 0x42, 0x4e, 0x82, 0x02, 0xd2, 0xbf,
-	0x21, 0xe3, 0x52, 0x89, 0x4f, 0x8e, 0xc5, 0x4f, 0xb9, 0x34, 0x80, 0xde, 0x03, 0xe4, 0x52, 0xf3,
-	0x39, 0xe1, 0xfb, 0xe6, 0x01, 0xe6, 0x21, 0x29, 0x35, 0x96, 0x34, 0xe3, 0xd2, 0x1d, 0xc2, 0xf7,
-	0xb7, 0x31, 0x0f, 0xc8, 0xe5, 0x9f, 0x14, 0x48, 0xf9, 0x47, 0xd8, 0xc9, 0x07, 0x50, 0x05, 0x26,
-	0x0f, 0x28, 0xc7, 0x27, 0x1f, 0x3e, 0x01, 0x0c, 0xdd, 0x83, 0xa9, 0xe0, 0x3c, 0x64, 0x6a, 0x4a,
-	0x74, 0xf5, 0xe5, 0x91, 0x49, 0x3d, 0x7e, 0xd8, 0x1a, 0x21, 0xe3, 0x48, 0xd7, 0x4c, 0x1e, 0xed,
-	0x9a, 0xcd, 0x54, 0x26, 0x59, 0x4c, 0x95, 0x7f, 0x53, 0x20, 0x2f, 0x7b, 0xbf, 0x69, 0x79, 0x96,
-	0xc3, 0xd0, 0x53, 0xc8, 0x3a, 0xc4, 0x1d, 0x8e, 0x92, 0x72, 0xd2, 0x28, 0x2d, 0xf9, 0xa3, 0xf4,
-	0xe1, 0xed, 0xf2, 0xf9, 0x18, 0xeb, 0x06, 0x75, 0x08, 0xc7, 0x4e, 0x8f, 0x0f, 0x0c, 0x70, 0x88,
-	0x1b, 0x0e, 0x97, 0x03, 0xc8, 0xb1, 0x0e, 0x43, 0x90, 0xd9, 0xc3, 0x1e, 0xa1, 0xb6, 0x48, 0x84,
-	0xbf, 0xc2, 0xe8, 0x44, 0xd4, 0xe5, 0x2d, 0xb4, 0x7e, 0xe5, 0xc3, 0xdb, 0xe5, 0x4b, 0xc7, 0x89,
-	0xd1, 0x22, 0xdf, 0xfa, 0x03, 0x53, 0x74, 0xac, 0xc3, 0x70, 0x27, 0xc2, 0x7f, 0x37, 0xa1, 0x2a,
-	0xe5, 0x27, 0x90, 0xdb, 0x16, 0x83, 0x24, 0x77, 0x57, 0x07, 0x39, 0x58, 0xe1, 0xea, 0xca, 0x49,
-	0xab, 0xa7, 0x84, 0x7a, 0x2e, 0x60, 0xc5, 0x94, 0xbf, 0x0b, 0x9b, 0x59, 0x2a, 0x5f, 0x85, 0xf4,
-	0x97, 0x7d, 0xea, 0xf5, 0x9d, 0x31, 0x9d, 0x2c, 0xae, 0xab, 0xc0, 0x8b, 0x6e, 0xc0, 0x34, 0xdf,
-	0xf7, 0x30, 0xdb, 0xa7, 0x5d, 0xfb, 0x6f, 0x6e, 0xb6, 0x08, 0x80, 0xee, 0x40, 0x41, 0x74, 0x63,
-	0x44, 0x49, 0x8e, 0xa5, 0xe4, 0x7d, 0x54, 0x3b, 0x04, 0x89, 0x00, 0xbf, 0xc9, 0x40, 0x5a, 0xc6,
-	0xa6, 0x9d, 0xb1, 0xa6, 0xb1, 0xe3, 0x31, 0x5e, 0xbf, 0x47, 0x1f, 0x57, 0xbf, 0xd4, 0xf8, 0xfa,
-	0x1c, 0xaf, 0x45, 0xf2, 0x23, 0x6a, 0x11, 0xcb, 0x7b, 0xea, 0xf4, 0x79, 0x9f, 0x3c, 0x7b, 0xde,
-	0xd3, 0xa7, 0xc8, 0x3b, 0xd2, 0x61, 0xc1, 0x4f, 0x34, 0x71, 0x09, 0x27, 0xd1, 0x7d, 0x64, 0x8a,
-	0xf0, 0xd5, 0xa9, 0xb1, 0x0a, 0x17, 0x1c, 0xe2, 0xea, 0x01, 0x5e, 0xa6, 0xc7, 0xf0, 0xd1, 0x68,
-	0x1d, 0xce, 0x0f, 0x4f, 0x92, 0x3d, 0xcb, 0xdd, 0xc3, 0x5d, 0x29, 0x93, 0x19, 0x2b, 0x33, 0x1b,
-	0x82, 0x37, 0x04, 0x36, 0xd0, 0xd8, 0x84, 0xb9, 0x51, 0x0d, 0x1b, 0x33, 0x2e, 0x2e, 0xa1, 0x7f,
-	0x3a, 0x7b, 0xd0, 0x51, 0xb1, 0x3a, 0x66, 0x1c, 0xed, 0xc0, 0xfc, 0xf0, 0xb8, 0x37, 0x8f, 0xd6,
-	0x0d, 0x4e, 0x57, 0xb7, 0xf3, 0x43, 0xfe, 0x76, 0xbc, 0x80, 0x9f, 0xc0, 0x6c, 0x24, 0x1c, 0xe5,
-	0x3b, 0x3b, 0x76, 0x9b, 0x68, 0x08, 0x8d, 0x92, 0xfe, 0x04, 0x22, 0x65, 0x33, 0xde, 0xe7, 0xb9,
-	0x33, 0xf4, 0x79, 0x14, 0xc3, 0xa3, 0xa8, 0xe1, 0x57, 0xa1, 0xb8, 0xdb, 0xf7, 0x5c, 0x7f, 0xbb,
-	0xd8, 0x94, 0x5d, 0x96, 0x17, 0x57, 0x5f, 0xc1, 0xb7, 0xfb, 0x47, 0xee, 0x67, 0x41, 0x77, 0xd5,
-	0x60, 0x49, 0x20, 0x87, 0xe9, 0x1e, 0x0e, 0x89, 0x87, 0x7d, 0xb6, 0xbc, 0x31, 0x17, 0x7d, 0x50,
-	0xf8, 0x7a, 0x16, 0x4e, 0x43, 0x80, 0x40, 0x57, 0xa0, 0x10, 0x2d, 0xe6, 0xb7, 0x95, 0xb8, 0x43,
-	0x33, 0x46, 0x2e, 0x5c, 0xca, 0xbf, 0x6e, 0xd0, 0x5d, 0x38, 0x17, 0xdb, 0xa2, 0x6c, 0x89, 0xe2,
-	0xd8, 0x5c, 0xcd, 0x44, 0xa3, 0x2b, 0xda, 0xe1, 0xda, 0xd7, 0x0a, 0x40, 0xec, 0x9d, 0xfc, 0x22,
-	0xcc, 0x6f, 0x37, 0xda, 0x9a, 0xd9, 0x68, 0xb6, 0xf5, 0xc6, 0x96, 0xf9, 0x78, 0xab, 0xd5, 0xd4,
-	0x36, 0xf4, 0xfb, 0xba, 0x56, 0x2f, 0x4e, 0xa0, 0x59, 0x98, 0x89, 0x3b, 0x9f, 0x6a, 0xad, 0xa2,
-	0x82, 0xe6, 0x61, 0x36, 0x6e, 0xac, 0xad, 0xb7, 0xda, 0x35, 0x7d, 0xab, 0x98, 0x40, 0x08, 0x0a,
-	0x71, 0xc7, 0x56, 0xa3, 0x98, 0x44, 0x97, 0x40, 0x3d, 0x6a, 0x33, 0x77, 0xf4, 0xf6, 0x03, 0x73,
-	0x5b, 0x6b, 0x37, 0x8a, 0xa9, 0x6b, 0x3f, 0x2b, 0x50, 0x38, 0xfa, 0x9e, 0x8a, 0x96, 0xe1, 0x62,
-	0xd3, 0x68, 0x34, 0x1b, 0xad, 0xda, 0x43, 0xb3, 0xd5, 0xae, 0xb5, 0x1f, 0xb7, 0x46, 0x62, 0x2a,
-	0x43, 0x69, 0x14, 0x50, 0xd7, 0x9a, 0x8d, 0x96, 0xde, 0x36, 0x9b, 0x9a, 0xa1, 0x37, 0xea, 0x45,
-	0x05, 0x5d, 0x86, 0xa5, 0x51, 0xcc, 0x76, 0xa3, 0xad, 0x6f, 0x7d, 0x1a, 0x42, 0x12, 0x68, 0x11,
-	0x2e, 0x8c, 0x42, 0x9a, 0xb5, 0x56, 0x4b, 0xab, 0x07, 0x41, 0x8f, 0xfa, 0x0c, 0x6d, 0x53, 0xdb,
-	0x68, 0x6b, 0xf5, 0x62, 0x6a, 0x1c, 0xf3, 0x7e, 0x4d, 0x7f, 0xa8, 0xd5, 0x8b, 0x93, 0xeb, 0xda,
-	0xab, 0x77, 0x25, 0xe5, 0xf5, 0xbb, 0x92, 0xf2, 0xc7, 0xbb, 0x92, 0xf2, 0xe2, 0x7d, 0x69, 0xe2,
-	0xf5, 0xfb, 0xd2, 0xc4, 0xaf, 0xef, 0x4b, 0x13, 0x9f, 0x5f, 0xef, 0x10, 0xbe, 0xdf, 0xdf, 0xad,
-	0xec, 0x51, 0x47, 0x7e, 0x3d, 0xc9, 0x7f, 0x37, 0x99, 0xfd, 0x45, 0xf5, 0x50, 0x7c, 0x11, 0xf2,
-	0x41, 0x0f, 0x33, 0xff, 0x73, 0x2f, 0x2d, 0xa6, 0xe7, 0xf6, 0x5f, 0x01, 0x00, 0x00, 0xff, 0xff,
-	0x08, 0xe1, 0xbb, 0x1f, 0x2f, 0x0e, 0x00, 0x00,
+	// 1770 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x58, 0xcd, 0x6f, 0x1b, 0xc7,
+	0x15, 0xf7, 0x92, 0x14, 0x45, 0x3e, 0x7e, 0x68, 0x35, 0x92, 0xed, 0x95, 0x6c, 0x7d, 0x98, 0x35,
+	0x02, 0xc5, 0x89, 0xa9, 0x2a, 0x69, 0x7a, 0x48, 0x0a, 0xb4, 0x94, 0xb8, 0x8e, 0xd6, 0x90, 0x45,
+	0x66, 0xb9, 0x92, 0xe2, 0x5e, 0xb6, 0x2b, 0xee, 0x98, 0xda, 0x96, 0xbb, 0xc3, 0xee, 0x0e, 0x65,
+	0xa9, 0xb7, 0x5e, 0x7b, 0xca, 0xad, 0x3d, 0x15, 0x3d, 0xf6, 0xd8, 0x43, 0xd0, 0xff, 0xa0, 0x40,
+	0x8e, 0x41, 0x2e, 0xed, 0xa5, 0x6e, 0x61, 0x17, 0x28, 0x10, 0xa0, 0xff, 0x43, 0x31, 0x1f, 0xfb,
+	0x41, 0x9a, 0x8e, 0xe4, 0x5c, 0x24, 0xce, 0x7b, 0xbf, 0xdf, 0x9b, 0xf7, 0x35, 0x6f, 0x86, 0x84,
+	0xdb, 0x7d, 0x12, 0xf9, 0x24, 0xda, 0x1e, 0x90, 0xf3, 0xed, 0xf3, 0x1d, 0xf6, 0xaf, 0x39, 0x0a,
+	0x09, 0x25, 0xa8, 0x26, 0x14, 0x4d, 0x26, 0x39, 0xdf, 0x59, 0x5d, 0x97, 0xb8, 0x53, 0x27, 0xc2,
+	0xdb, 0xe7, 0x3b, 0xa7, 0x98, 0x3a, 0x3b, 0xdb, 0x7d, 0xe2, 0x05, 0x02, 0xbe, 0xba, 0x3c, 0x20,
+	0x03, 0xc2, 0x3f, 0x6e, 0xb3, 0x4f, 0x52, 0xba, 0x31, 0x20, 0x64, 0x30, 0xc4, 0xdb, 0x7c, 0x75,
+	0x3a, 0x7e, 0xb6, 0x4d, 0x3d, 0x1f, 0x47, 0xd4, 0xf1, 0x47, 0x12, 0xb0, 0x32, 0x0d, 0x70, 0x82,
+	0x4b, 0xa9, 0x5a, 0x9f, 0x56, 0xb9, 0xe3, 0xd0, 0xa1, 0x1e, 0x89, 0x77, 0x5c, 0x11, 0x1e, 0xd9,
+	0x62, 0x53, 0xe9, 0xad, 0x50, 0x2d, 0x3a, 0xbe, 0x17, 0x90, 0x6d, 0xfe, 0x57, 0x88, 0x1a, 0x04,
+	0xd0, 0x09, 0xf6, 0x06, 0x67, 0x14, 0xbb, 0xc7, 0x84, 0xe2, 0xce, 0x88, 0x59, 0x42, 0x3b, 0x50,
+	0x24, 0xfc, 0x93, 0xa6, 0x6c, 0x2a, 0x5b, 0xf5, 0x0f, 0x56, 0x9a, 0x13, 0x51, 0x37, 0x53, 0xa8,
+	0x29, 0x81, 0xe8, 0x1d, 0x28, 0x3e, 0xe7, 0x86, 0xb4, 0xdc, 0xa6, 0xb2, 0x55, 0xde, 0xad, 0x7f,
+	0xf3, 0xe5, 0x43, 0x90, 0xac, 0x36, 0xee, 0x9b, 0x52, 0xdb, 0xf8, 0x93, 0x02, 0xf3, 0x6d, 0x3c,
+	0x22, 0x91, 0x47, 0xd1, 0x06, 0x54, 0x46, 0x21, 0x19, 0x91, 0xc8, 0x19, 0xda, 0x9e, 0xcb, 0xf7,
+	0x2a, 0x98, 0x10, 0x8b, 0x0c, 0x17, 0xfd, 0x18, 0xca, 0xae, 0xc0, 0x92, 0x50, 0xda, 0xd5, 0xbe,
+	0xf9, 0xf2, 0xe1, 0xb2, 0xb4, 0xdb, 0x72, 0xdd, 0x10, 0x47, 0x51, 0x8f, 0x86, 0x5e, 0x30, 0x30,
+	0x53, 0x28, 0xfa, 0x09, 0x14, 0x1d, 0x9f, 0x8c, 0x03, 0xaa, 0xe5, 0x37, 0xf3, 0x5b, 0x95, 0xd4,
+	0x7f, 0x56, 0xa6, 0xa6, 0x2c, 0x53, 0x73, 0x8f, 0x78, 0xc1, 0x6e, 0xf9, 0xab, 0x17, 0x1b, 0x37,
+	0xfe, 0xfc, 0xdf, 0xbf, 0x3c, 0x50, 0x4c, 0xc9, 0x69, 0xfc, 0xb6, 0x04, 0xa5, 0xae, 0x74, 0x02,
+	0xd5, 0x21, 0x97, 0xb8, 0x96, 0xf3, 0x5c, 0xf4, 0x43, 0x28, 0xf9, 0x38, 0x8a, 0x9c, 0x01, 0x8e,
+	0xb4, 0x1c, 0x37, 0xbe, 0xdc, 0x14, 0x15, 0x69, 0xc6, 0x15, 0x69, 0xb6, 0x82, 0x4b, 0x33, 0x41,
+	0xa1, 0x8f, 0xa0, 0x18, 0x51, 0x87, 0x8e, 0x23, 0x2d, 0xcf, 0x93, 0xb9, 0x36, 0x95, 0xcc, 0x78,
+	0xab, 0x1e, 0x07, 0x99, 0x12, 0x8c, 0xf6, 0x01, 0x3d, 0xf3, 0x02, 0x67, 0x68, 0x53, 0x67, 0x38,
+	0xbc, 0xb4, 0x43, 0x1c, 0x8d, 0x87, 0x54, 0x2b, 0x6c, 0x2a, 0x5b, 0x95, 0x0f, 0x56, 0xa7, 0x4c,
+	0x58, 0x0c, 0x62, 0x72, 0x84, 0xa9, 0x72, 0x56, 0x46, 0x82, 0x5a, 0x50, 0x89, 0xc6, 0xa7, 0xbe,
+	0x47, 0x6d, 0xd6, 0x66, 0xda, 0x9c, 0x34, 0x31, 0xed, 0xb5, 0x15, 0xf7, 0xe0, 0x6e, 0xe1, 0x8b,
+	0x7f, 0x6d, 0x28, 0x26, 0x08, 0x12, 0x13, 0xa3, 0xc7, 0xa0, 0xca, 0xec, 0xda, 0x38, 0x70, 0x85,
+	0x9d, 0xe2, 0x35, 0xed, 0xd4, 0x25, 0x53, 0x0f, 0x5c, 0x6e, 0xcb, 0x80, 0x1a, 0x25, 0xd4, 0x19,
+	0xda, 0x52, 0xae, 0xcd, 0xbf, 0x45, 0x8d, 0xaa, 0x9c, 0x1a, 0x37, 0xd0, 0x01, 0x2c, 0x9e, 0x13,
+	0xea, 0x05, 0x03, 0x3b, 0xa2, 0x4e, 0x28, 0xe3, 0x2b, 0x5d, 0xd3, 0xaf, 0x05, 0x41, 0xed, 0x31,
+	0x26, 0x77, 0x6c, 0x1f, 0xa4, 0x28, 0x8d, 0xb1, 0x7c, 0x4d, 0x5b, 0x35, 0x41, 0x8c, 0x43, 0x5c,
+	0x65, 0x4d, 0x42, 0x1d, 0xd7, 0xa1, 0x8e, 0x06, 0xac, 0x6d, 0xcd, 0x64, 0x8d, 0x96, 0x61, 0x8e,
+	0x7a, 0x74, 0x88, 0xb5, 0x0a, 0x57, 0x88, 0x05, 0xd2, 0x60, 0x3e, 0x1a, 0xfb, 0xbe, 0x13, 0x5e,
+	0x6a, 0x55, 0x2e, 0x8f, 0x97, 0xe8, 0x47, 0x50, 0x12, 0x27, 0x02, 0x87, 0x5a, 0xed, 0x8a, 0x23,
+	0x90, 0x20, 0xd1, 0x5d, 0x28, 0xe3, 0x8b, 0x11, 0x76, 0x3d, 0x8a, 0x5d, 0xad, 0xbe, 0xa9, 0x6c,
+	0x95, 0xcc, 0x54, 0x80, 0x7e, 0x00, 0xb5, 0x67, 0x8e, 0x37, 0xc4, 0xae, 0x1d, 0x62, 0x27, 0x22,
+	0x81, 0xb6, 0xc0, 0xf7, 0xac, 0x0a, 0xa1, 0xc9, 0x65, 0xe8, 0x67, 0x50, 0x4b, 0x4e, 0x27, 0xbd,
+	0x1c, 0x61, 0x4d, 0xe5, 0xed, 0x7b, 0xe7, 0x0d, 0xed, 0x6b, 0x5d, 0x8e, 0xb0, 0x59, 0x1d, 0x65,
+	0x56, 0x48, 0x87, 0xea, 0x39, 0xa1, 0xd8, 0x16, 0x23, 0x22, 0xd2, 0x16, 0x79, 0x36, 0x1b, 0x6f,
+	0x30, 0x90, 0x0e, 0x95, 0xc8, 0xac, 0x9c, 0xa7, 0x0b, 0xf4, 0x29, 0xd4, 0xf1, 0x05, 0xee, 0x8f,
+	0xd9, 0x4a, 0x94, 0x05, 0x5d, 0xb7, 0x2c, 0x09, 0x8f, 0x97, 0xe5, 0x5d, 0x50, 0x53, 0x43, 0x67,
+	0x62, 0x5a, 0x2d, 0x6d, 0x2a, 0x5b, 0x79, 0x73, 0x21, 0x91, 0xef, 0x8b, 0x31, 0xf5, 0x7b, 0x05,
+	0x96, 0x66, 0x38, 0x86, 0xd6, 0x00, 0x44, 0x34, 0x36, 0x09, 0x30, 0x1f, 0x0b, 0x65, 0xb3, 0x2c,
+	0x24, 0x9d, 0x00, 0x67, 0xd4, 0xf4, 0x39, 0xd1, 0x72, 0x59, 0xb5, 0xf5, 0x9c, 0xa0, 0x7b, 0x50,
+	0x8d, 0xd5, 0x67, 0x21, 0xc6, 0x7c, 0x20, 0x94, 0xcd, 0x8a, 0x04, 0x30, 0x11, 0x9b, 0x89, 0x12,
+	0xf2, 0x8c, 0x8c, 0x43, 0x7e, 0xde, 0xcb, 0xa6, 0x34, 0xfa, 0x88, 0x8c, 0xc3, 0xc6, 0xdf, 0x15,
+	0xa8, 0x64, 0x4f, 0xf7, 0x7b, 0x50, 0xbe, 0xc4, 0x91, 0xdd, 0xe7, 0xe3, 0x4e, 0x79, 0x6d, 0xf6,
+	0x1a, 0x01, 0x35, 0x4b, 0x97, 0x38, 0xda, 0x63, 0x7a, 0xf4, 0x21, 0xd4, 0x9c, 0xd3, 0x88, 0x3a,
+	0x5e, 0x20, 0x09, 0xb9, 0x99, 0x84, 0xaa, 0x04, 0x09, 0xd2, 0xbb, 0x50, 0x0a, 0x88, 0xc4, 0xe7,
+	0x67, 0xe2, 0xe7, 0x03, 0x22, 0xa0, 0x9f, 0x00, 0x0a, 0x88, 0xfd, 0xdc, 0xa3, 0x67, 0xf6, 0x39,
+	0xa6, 0x31, 0xa9, 0x30, 0x93, 0xb4, 0x10, 0x90, 0x13, 0x8f, 0x9e, 0x1d, 0x63, 0x2a, 0xc8, 0x8d,
+	0xcf, 0x60, 0x51, 0xa4, 0x39, 0x1b, 0xde, 0xad, 0x89, 0xab, 0xa8, 0x9c, 0xdc, 0x37, 0xf7, 0x61,
+	0xee, 0xbb, 0x22, 0x10, 0xca, 0xc6, 0x5f, 0x15, 0x28, 0xb0, 0xf2, 0x5d, 0x7d, 0xd5, 0x34, 0x61,
+	0x8e, 0xf5, 0xdc, 0xd5, 0xd7, 0x8c, 0x80, 0xa1, 0x4f, 0x60, 0x3e, 0x6e, 0xeb, 0x02, 0x9f, 0x5f,
+	0xf7, 0xa6, 0xda, 0xfa, 0xf5, 0x6b, 0xd5, 0x8c, 0x19, 0x13, 0xf3, 0x61, 0x6e, 0x72, 0x3e, 0x3c,
+	0x2e, 0x94, 0xf2, 0x6a, 0xa1, 0xf1, 0x4f, 0x05, 0x6a, 0x72, 0xca, 0x75, 0x9d, 0xd0, 0xf1, 0x23,
+	0xf4, 0x14, 0x2a, 0xbe, 0x17, 0x24, 0x43, 0x53, 0xb9, 0x6a, 0x68, 0xae, 0xb1, 0xa1, 0xf9, 0xed,
+	0x8b, 0x8d, 0x9b, 0x19, 0xd6, 0xfb, 0xc4, 0xf7, 0x28, 0xf6, 0x47, 0xf4, 0xd2, 0x04, 0xdf, 0x0b,
+	0xe2, 0x31, 0xea, 0x03, 0xf2, 0x9d, 0x8b, 0x18, 0x64, 0x8f, 0x70, 0xe8, 0x11, 0x97, 0x27, 0x82,
+	0xed, 0x30, 0x7d, 0xc8, 0xda, 0xf2, 0xbd, 0xb1, 0x7b, 0xff, 0xdb, 0x17, 0x1b, 0x77, 0x5f, 0x27,
+	0xa6, 0x9b, 0xfc, 0x81, 0x9d, 0x41, 0xd5, 0x77, 0x2e, 0xe2, 0x48, 0xb8, 0xfe, 0xe3, 0x9c, 0xa6,
+	0x34, 0x3e, 0x87, 0xea, 0x31, 0x1f, 0x99, 0x32, 0xba, 0x36, 0xc8, 0x11, 0x1a, 0xef, 0xae, 0x5c,
+	0xb5, 0x7b, 0x81, 0x5b, 0xaf, 0x0a, 0x56, 0xc6, 0xf2, 0x1f, 0xe3, 0xf3, 0x21, 0x2d, 0xbf, 0x03,
+	0xc5, 0x5f, 0x8f, 0x49, 0x38, 0xf6, 0x35, 0x65, 0xf6, 0xc3, 0x44, 0x68, 0xd1, 0xfb, 0x50, 0x66,
+	0x87, 0x32, 0x3a, 0x23, 0x43, 0xf7, 0x0d, 0x6f, 0x98, 0x14, 0x80, 0x3e, 0x82, 0x3a, 0x6f, 0xf0,
+	0x94, 0x92, 0x9f, 0x49, 0xa9, 0x31, 0x94, 0x15, 0x83, 0xb8, 0x83, 0x7f, 0x2b, 0x43, 0x51, 0xfa,
+	0xa6, 0xbf, 0x65, 0x4d, 0x33, 0x17, 0x61, 0xb6, 0x7e, 0x4f, 0xbe, 0x5f, 0xfd, 0x0a, 0xb3, 0xeb,
+	0xf3, 0x7a, 0x2d, 0xf2, 0xdf, 0xa3, 0x16, 0x99, 0xbc, 0x17, 0xae, 0x9f, 0xf7, 0xb9, 0xb7, 0xcf,
+	0x7b, 0xf1, 0x1a, 0x79, 0x47, 0x06, 0xac, 0xb0, 0x44, 0x7b, 0x81, 0x47, 0xbd, 0xf4, 0xe5, 0x61,
+	0x73, 0xf7, 0xb5, 0xf9, 0x99, 0x16, 0x6e, 0xf9, 0x5e, 0x60, 0x08, 0xbc, 0x4c, 0x8f, 0xc9, 0xd0,
+	0x68, 0x17, 0x6e, 0x26, 0x93, 0xa4, 0xef, 0x04, 0x7d, 0x3c, 0x94, 0x66, 0x4a, 0x33, 0xcd, 0x2c,
+	0xc5, 0xe0, 0x3d, 0x8e, 0x15, 0x36, 0x1e, 0xc3, 0xf2, 0xb4, 0x0d, 0x17, 0x47, 0x54, 0x2b, 0x5f,
+	0x31, 0x7b, 0xd0, 0xa4, 0xb1, 0x36, 0x8e, 0x28, 0x3a, 0x81, 0xdb, 0xc9, 0xc5, 0x6e, 0x4f, 0xd6,
+	0x0d, 0xae, 0x57, 0xb7, 0x9b, 0x09, 0xff, 0x38, 0x5b, 0xc0, 0x9f, 0xc2, 0x52, 0x6a, 0x38, 0xcd,
+	0x77, 0x65, 0x66, 0x98, 0x28, 0x81, 0xa6, 0x49, 0xff, 0x1c, 0x52, 0xcb, 0x76, 0xb6, 0xcf, 0xab,
+	0x6f, 0xd1, 0xe7, 0xa9, 0x0f, 0x4f, 0xd2, 0x86, 0xdf, 0x02, 0xf5, 0x74, 0x1c, 0x06, 0x36, 0x7f,
+	0x5d, 0xc8, 0x2e, 0xab, 0xf1, 0x47, 0x4e, 0x9d, 0xc9, 0xd9, 0xc8, 0xfd, 0x4c, 0x74, 0x57, 0x0b,
+	0xd6, 0x38, 0x32, 0x49, 0x77, 0x72, 0x48, 0x42, 0xcc, 0xd8, 0xf2, 0x6d, 0xb4, 0xca, 0x40, 0xf1,
+	0x7d, 0x1f, 0x9f, 0x06, 0x81, 0x40, 0xf7, 0xa1, 0x9e, 0x6e, 0xc6, 0xda, 0x8a, 0xbf, 0x96, 0x4a,
+	0x66, 0x35, 0xde, 0x8a, 0xdd, 0x60, 0xe8, 0x63, 0x58, 0xcc, 0x84, 0x28, 0x5b, 0x42, 0x9d, 0x99,
+	0xab, 0x85, 0xf4, 0xe8, 0x8a, 0x76, 0xf8, 0x05, 0x6c, 0xb0, 0x9b, 0xc1, 0xf7, 0x22, 0xea, 0xf5,
+	0x6d, 0x67, 0x4c, 0xcf, 0x48, 0xe8, 0xfd, 0x06, 0xbb, 0xb6, 0x23, 0xaa, 0x8f, 0xd9, 0xd3, 0x29,
+	0xff, 0x9d, 0x9d, 0xb1, 0x96, 0x1a, 0x68, 0x25, 0xfc, 0x56, 0x4c, 0x47, 0x26, 0x64, 0x00, 0x76,
+	0x88, 0x7f, 0x89, 0xfb, 0x93, 0x55, 0x45, 0x33, 0x3d, 0xbd, 0x93, 0x92, 0x4c, 0xc9, 0x49, 0xca,
+	0xfb, 0xe0, 0x3f, 0x0a, 0x40, 0xe6, 0x3b, 0xe3, 0x1d, 0xb8, 0x7d, 0xdc, 0xb1, 0x74, 0xbb, 0xd3,
+	0xb5, 0x8c, 0xce, 0xa1, 0x7d, 0x74, 0xd8, 0xeb, 0xea, 0x7b, 0xc6, 0x23, 0x43, 0x6f, 0xab, 0x37,
+	0xd0, 0x12, 0x2c, 0x64, 0x95, 0x4f, 0xf5, 0x9e, 0xaa, 0xa0, 0xdb, 0xb0, 0x94, 0x15, 0xb6, 0x76,
+	0x7b, 0x56, 0xcb, 0x38, 0x54, 0x73, 0x08, 0x41, 0x3d, 0xab, 0x38, 0xec, 0xa8, 0x79, 0x74, 0x17,
+	0xb4, 0x49, 0x99, 0x7d, 0x62, 0x58, 0xfb, 0xf6, 0xb1, 0x6e, 0x75, 0xd4, 0xc2, 0xb4, 0xfd, 0xce,
+	0xa1, 0xae, 0x2a, 0xd3, 0x42, 0xeb, 0xa4, 0xa3, 0xe6, 0xd0, 0x4d, 0x58, 0x9c, 0x10, 0xee, 0x9b,
+	0xba, 0xae, 0xe6, 0xd1, 0x32, 0xa8, 0x59, 0xf1, 0xa3, 0xce, 0x91, 0xa9, 0x16, 0x56, 0x73, 0xaa,
+	0xf2, 0xe0, 0x77, 0x0a, 0x54, 0xb3, 0x6f, 0x5c, 0xb4, 0x06, 0x2b, 0x5d, 0xb3, 0xd3, 0xed, 0xf4,
+	0x5a, 0x07, 0xb6, 0xf5, 0xb4, 0xab, 0x4f, 0x85, 0xba, 0x0a, 0xb7, 0x26, 0xd5, 0x3d, 0xab, 0x75,
+	0xd8, 0x6e, 0x99, 0x6d, 0x55, 0x61, 0x41, 0x4c, 0xea, 0xd8, 0x76, 0x4f, 0x8c, 0x9e, 0x65, 0xec,
+	0xa9, 0x39, 0x74, 0x0f, 0xd6, 0x26, 0xb5, 0x4f, 0x8e, 0x0e, 0x2c, 0xa3, 0x7b, 0xa0, 0xdb, 0x7b,
+	0xfb, 0x1d, 0x63, 0x4f, 0x57, 0xf3, 0x0f, 0xfe, 0xa7, 0x40, 0x7d, 0xf2, 0xfb, 0x22, 0xda, 0x80,
+	0x3b, 0x09, 0xab, 0x67, 0xb5, 0xac, 0xa3, 0xde, 0x94, 0x43, 0x0d, 0x58, 0x9f, 0x06, 0xb4, 0xf5,
+	0x6e, 0xa7, 0x67, 0x58, 0x76, 0x57, 0x37, 0x8d, 0x0e, 0x73, 0x2c, 0xbb, 0xb5, 0xc4, 0x1c, 0x77,
+	0x2c, 0xe3, 0xf0, 0xd3, 0x18, 0x92, 0x9b, 0x88, 0x4b, 0x42, 0xba, 0xad, 0x5e, 0x4f, 0x6f, 0x8b,
+	0xe2, 0x4c, 0xeb, 0x4c, 0xfd, 0xb1, 0xbe, 0x67, 0xe9, 0x6d, 0xb5, 0x30, 0x8b, 0xf9, 0xa8, 0x65,
+	0x1c, 0xe8, 0x6d, 0x75, 0x6e, 0x22, 0x99, 0x52, 0xd7, 0xdb, 0xdb, 0xd7, 0xdb, 0x47, 0x4c, 0x5d,
+	0xdc, 0xd5, 0xbf, 0x7a, 0xb9, 0xae, 0x7c, 0xfd, 0x72, 0x5d, 0xf9, 0xf7, 0xcb, 0x75, 0xe5, 0x8b,
+	0x57, 0xeb, 0x37, 0xbe, 0x7e, 0xb5, 0x7e, 0xe3, 0x1f, 0xaf, 0xd6, 0x6f, 0xfc, 0xfc, 0xbd, 0x81,
+	0x47, 0xcf, 0xc6, 0xa7, 0xcd, 0x3e, 0xf1, 0xe5, 0x8f, 0x1c, 0xf2, 0xdf, 0xc3, 0xc8, 0xfd, 0xd5,
+	0xf6, 0x05, 0xff, 0xe1, 0x86, 0x7d, 0x6b, 0x89, 0xd8, 0xaf, 0x32, 0x45, 0x3e, 0xfa, 0x3e, 0xfc,
+	0xff, 0x00, 0xdf, 0x26, 0xfe, 0x40, 0xd6, 0x11, 0x00, 0x00,
 }
 
 func (m *WeightedVoteOption) Marshal() (dAtA []byte, err error) {
@@ -1162,6 +1441,46 @@ func (m *Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ExecutionHeight != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.ExecutionHeight))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x98
+	}
+	if m.ExecutionTime != nil {
+		n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.ExecutionTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ExecutionTime):])
+		if err1 != nil {
+			return 0, err1
+		}
+		i -= n1
+		i = encodeVarintGov(dAtA, i, uint64(n1))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x92
+	}
+	if m.VoteOptions != nil {
+		{
+			size, err := m.VoteOptions.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGov(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
+	}
+	if m.ProposalType != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.ProposalType))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
 	if len(m.FailedReason) > 0 {
 		i -= len(m.FailedReason)
 		copy(dAtA[i:], m.FailedReason)
@@ -1208,22 +1527,22 @@ func (m *Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x52
 	}
 	if m.VotingEndTime != nil {
-		n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.VotingEndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.VotingEndTime):])
-		if err1 != nil {
-			return 0, err1
+		n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.VotingEndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.VotingEndTime):])
+		if err3 != nil {
+			return 0, err3
 		}
-		i -= n1
-		i = encodeVarintGov(dAtA, i, uint64(n1))
+		i -= n3
+		i = encodeVarintGov(dAtA, i, uint64(n3))
 		i--
 		dAtA[i] = 0x4a
 	}
 	if m.VotingStartTime != nil {
-		n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.VotingStartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.VotingStartTime):])
-		if err2 != nil {
-			return 0, err2
+		n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.VotingStartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.VotingStartTime):])
+		if err4 != nil {
+			return 0, err4
 		}
-		i -= n2
-		i = encodeVarintGov(dAtA, i, uint64(n2))
+		i -= n4
+		i = encodeVarintGov(dAtA, i, uint64(n4))
 		i--
 		dAtA[i] = 0x42
 	}
@@ -1242,22 +1561,22 @@ func (m *Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		}
 	}
 	if m.DepositEndTime != nil {
-		n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.DepositEndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.DepositEndTime):])
-		if err3 != nil {
-			return 0, err3
+		n5, err5 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.DepositEndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.DepositEndTime):])
+		if err5 != nil {
+			return 0, err5
 		}
-		i -= n3
-		i = encodeVarintGov(dAtA, i, uint64(n3))
+		i -= n5
+		i = encodeVarintGov(dAtA, i, uint64(n5))
 		i--
 		dAtA[i] = 0x32
 	}
 	if m.SubmitTime != nil {
-		n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.SubmitTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.SubmitTime):])
-		if err4 != nil {
-			return 0, err4
+		n6, err6 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.SubmitTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.SubmitTime):])
+		if err6 != nil {
+			return 0, err6
 		}
-		i -= n4
-		i = encodeVarintGov(dAtA, i, uint64(n4))
+		i -= n6
+		i = encodeVarintGov(dAtA, i, uint64(n6))
 		i--
 		dAtA[i] = 0x2a
 	}
@@ -1300,6 +1619,57 @@ func (m *Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ProposalVoteOptions) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProposalVoteOptions) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProposalVoteOptions) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.OptionFour) > 0 {
+		i -= len(m.OptionFour)
+		copy(dAtA[i:], m.OptionFour)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.OptionFour)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.OptionThree) > 0 {
+		i -= len(m.OptionThree)
+		copy(dAtA[i:], m.OptionThree)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.OptionThree)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.OptionTwo) > 0 {
+		i -= len(m.OptionTwo)
+		copy(dAtA[i:], m.OptionTwo)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.OptionTwo)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.OptionOne) > 0 {
+		i -= len(m.OptionOne)
+		copy(dAtA[i:], m.OptionOne)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.OptionOne)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *TallyResult) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1351,6 +1721,43 @@ func (m *TallyResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *OptionTallyResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OptionTallyResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OptionTallyResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Count) > 0 {
+		i -= len(m.Count)
+		copy(dAtA[i:], m.Count)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Count)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Option) > 0 {
+		i -= len(m.Option)
+		copy(dAtA[i:], m.Option)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Option)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Vote) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1428,12 +1835,12 @@ func (m *DepositParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	if m.MaxDepositPeriod != nil {
-		n6, err6 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.MaxDepositPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.MaxDepositPeriod):])
-		if err6 != nil {
-			return 0, err6
+		n8, err8 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.MaxDepositPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.MaxDepositPeriod):])
+		if err8 != nil {
+			return 0, err8
 		}
-		i -= n6
-		i = encodeVarintGov(dAtA, i, uint64(n6))
+		i -= n8
+		i = encodeVarintGov(dAtA, i, uint64(n8))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -1475,12 +1882,12 @@ func (m *VotingParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	if m.VotingPeriod != nil {
-		n7, err7 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.VotingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.VotingPeriod):])
-		if err7 != nil {
-			return 0, err7
+		n9, err9 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.VotingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.VotingPeriod):])
+		if err9 != nil {
+			return 0, err9
 		}
-		i -= n7
-		i = encodeVarintGov(dAtA, i, uint64(n7))
+		i -= n9
+		i = encodeVarintGov(dAtA, i, uint64(n9))
 		i--
 		dAtA[i] = 0xa
 	}
@@ -1551,7 +1958,27 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	if len(m.MinDepositRatio) > 0 {
+	if len(m.OptimisticRejectedThreshold) > 0 {
+		i -= len(m.OptimisticRejectedThreshold)
+		copy(dAtA[i:], m.OptimisticRejectedThreshold)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.OptimisticRejectedThreshold)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x92
+	}
+	if len(m.OptimisticAuthorizedAddresses) > 0 {
+		for iNdEx := len(m.OptimisticAuthorizedAddresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.OptimisticAuthorizedAddresses[iNdEx])
+			copy(dAtA[i:], m.OptimisticAuthorizedAddresses[iNdEx])
+			i = encodeVarintGov(dAtA, i, uint64(len(m.OptimisticAuthorizedAddresses[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
+	if len(m.MinDepositRatio) > 0 {
 		i -= len(m.MinDepositRatio)
 		copy(dAtA[i:], m.MinDepositRatio)
 		i = encodeVarintGov(dAtA, i, uint64(len(m.MinDepositRatio)))
@@ -1612,12 +2039,12 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x5a
 	}
 	if m.ExpeditedVotingPeriod != nil {
-		n8, err8 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.ExpeditedVotingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.ExpeditedVotingPeriod):])
-		if err8 != nil {
-			return 0, err8
+		n10, err10 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.ExpeditedVotingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.ExpeditedVotingPeriod):])
+		if err10 != nil {
+			return 0, err10
 		}
-		i -= n8
-		i = encodeVarintGov(dAtA, i, uint64(n8))
+		i -= n10
+		i = encodeVarintGov(dAtA, i, uint64(n10))
 		i--
 		dAtA[i] = 0x52
 	}
@@ -1664,22 +2091,22 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x22
 	}
 	if m.VotingPeriod != nil {
-		n9, err9 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.VotingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.VotingPeriod):])
-		if err9 != nil {
-			return 0, err9
+		n11, err11 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.VotingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.VotingPeriod):])
+		if err11 != nil {
+			return 0, err11
 		}
-		i -= n9
-		i = encodeVarintGov(dAtA, i, uint64(n9))
+		i -= n11
+		i = encodeVarintGov(dAtA, i, uint64(n11))
 		i--
 		dAtA[i] = 0x1a
 	}
 	if m.MaxDepositPeriod != nil {
-		n10, err10 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.MaxDepositPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.MaxDepositPeriod):])
-		if err10 != nil {
-			return 0, err10
+		n12, err12 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.MaxDepositPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.MaxDepositPeriod):])
+		if err12 != nil {
+			return 0, err12
 		}
-		i -= n10
-		i = encodeVarintGov(dAtA, i, uint64(n10))
+		i -= n12
+		i = encodeVarintGov(dAtA, i, uint64(n12))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -1816,6 +2243,45 @@ func (m *Proposal) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovGov(uint64(l))
 	}
+	if m.ProposalType != 0 {
+		n += 2 + sovGov(uint64(m.ProposalType))
+	}
+	if m.VoteOptions != nil {
+		l = m.VoteOptions.Size()
+		n += 2 + l + sovGov(uint64(l))
+	}
+	if m.ExecutionTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ExecutionTime)
+		n += 2 + l + sovGov(uint64(l))
+	}
+	if m.ExecutionHeight != 0 {
+		n += 2 + sovGov(uint64(m.ExecutionHeight))
+	}
+	return n
+}
+
+func (m *ProposalVoteOptions) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OptionOne)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.OptionTwo)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.OptionThree)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.OptionFour)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
 	return n
 }
 
@@ -1844,6 +2310,23 @@ func (m *TallyResult) Size() (n int) {
 	return n
 }
 
+func (m *OptionTallyResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Option)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Count)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	return n
+}
+
 func (m *Vote) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1994,6 +2477,16 @@ func (m *Params) Size() (n int) {
 	if l > 0 {
 		n += 2 + l + sovGov(uint64(l))
 	}
+	if len(m.OptimisticAuthorizedAddresses) > 0 {
+		for _, s := range m.OptimisticAuthorizedAddresses {
+			l = len(s)
+			n += 2 + l + sovGov(uint64(l))
+		}
+	}
+	l = len(m.OptimisticRejectedThreshold)
+	if l > 0 {
+		n += 2 + l + sovGov(uint64(l))
+	}
 	return n
 }
 
@@ -2558,7 +3051,278 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
 			}
-			var stringLen uint64
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Metadata = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Summary", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Summary = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proposer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expedited", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Expedited = bool(v != 0)
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailedReason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FailedReason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalType", wireType)
+			}
+			m.ProposalType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalType |= ProposalType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoteOptions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.VoteOptions == nil {
+				m.VoteOptions = &ProposalVoteOptions{}
+			}
+			if err := m.VoteOptions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExecutionTime == nil {
+				m.ExecutionTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.ExecutionTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 19:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionHeight", wireType)
+			}
+			m.ExecutionHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -2568,27 +3332,64 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.ExecutionHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGov
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGov
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Metadata = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 11:
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProposalVoteOptions) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProposalVoteOptions: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProposalVoteOptions: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionOne", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2616,11 +3417,11 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Title = string(dAtA[iNdEx:postIndex])
+			m.OptionOne = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 12:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Summary", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionTwo", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2648,11 +3449,11 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Summary = string(dAtA[iNdEx:postIndex])
+			m.OptionTwo = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 13:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Proposer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionThree", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2680,31 +3481,11 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Proposer = string(dAtA[iNdEx:postIndex])
+			m.OptionThree = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 14:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Expedited", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGov
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Expedited = bool(v != 0)
-		case 15:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FailedReason", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionFour", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2732,7 +3513,7 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FailedReason = string(dAtA[iNdEx:postIndex])
+			m.OptionFour = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2933,6 +3714,120 @@ func (m *TallyResult) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *OptionTallyResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OptionTallyResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OptionTallyResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Option", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Option = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Count = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *Vote) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -3973,6 +4868,70 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			}
 			m.MinDepositRatio = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptimisticAuthorizedAddresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OptimisticAuthorizedAddresses = append(m.OptimisticAuthorizedAddresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptimisticRejectedThreshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OptimisticRejectedThreshold = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGov(dAtA[iNdEx:])
@@ -14,8 +14,8 @@ import (
 )
 
 var (
-	_, _, _, _, _, _, _ sdk.Msg                            = &MsgSubmitProposal{}, &MsgDeposit{}, &MsgVote{}, &MsgVoteWeighted{}, &MsgExecLegacyContent{}, &MsgUpdateParams{}, &MsgCancelProposal{}
-	_, _                codectypes.UnpackInterfacesMessage = &MsgSubmitProposal{}, &MsgExecLegacyContent{}
+	_, _, _, _, _, _, _, _ sdk.Msg                            = &MsgSubmitProposal{}, &MsgDeposit{}, &MsgVote{}, &MsgVoteWeighted{}, &MsgExecLegacyContent{}, &MsgUpdateParams{}, &MsgCancelProposal{}, &MsgCancelScheduledExecution{}
+	_, _                   codectypes.UnpackInterfacesMessage = &MsgSubmitProposal{}, &MsgExecLegacyContent{}
 )
 
 // NewMsgSubmitProposal creates a new MsgSubmitProposal.
@@ -84,8 +84,8 @@ func (m *MsgSubmitProposal) ValidateBasic() error {
 		return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, deposit.String())
 	}
 
-	// Check that either metadata or Msgs length is non nil.
-	if len(m.Messages) == 0 && len(m.Metadata) == 0 {
+	// Check that either metadata, Msgs or vote options are non nil.
+	if len(m.Messages) == 0 && len(m.Metadata) == 0 && m.VoteOptions == nil {
 		return errorsmod.Wrap(types.ErrNoProposalMsgs, "either metadata or Msgs length must be non-nil")
 	}
 
@@ -160,3 +160,11 @@ func NewMsgCancelProposal(proposalID uint64, proposer string) *MsgCancelProposal
 		Proposer:   proposer,
 	}
 }
+
+// NewMsgCancelScheduledExecution creates a new MsgCancelScheduledExecution instance.
+func NewMsgCancelScheduledExecution(authority string, proposalID uint64) *MsgCancelScheduledExecution {
+	return &MsgCancelScheduledExecution{
+		Authority:  authority,
+		ProposalId: proposalID,
+	}
+}
@@ -39,6 +39,11 @@ var (
 	DefaultBurnVoteVeto              = true  // set to true to replicate behavior of when this change was made (0.47)
 	// (New default value for v0.50 migration) 1% of `min_deposit` is required to make a deposit.
 	DefaultMinDepositRatio = sdkmath.LegacyMustNewDecFromStr("0.01")
+	// (New default value for the v6 store migration) optimistic proposals are rejected
+	// when 10% of the bonded stake votes against them.
+	DefaultOptimisticRejectedThreshold = sdkmath.LegacyNewDecWithPrec(1, 1)
+	// (New default value for the v6 store migration) nobody is allowed to submit optimistic proposals.
+	DefaultOptimisticAuthorizedAddresses = []string(nil)
 )
 
 // Deprecated: NewDepositParams creates a new DepositParams object
@@ -70,24 +75,27 @@ func NewParams(
 	minDeposit, expeditedminDeposit sdk.Coins, maxDepositPeriod, votingPeriod, expeditedVotingPeriod time.Duration,
 	quorum, threshold, expeditedThreshold, vetoThreshold, minInitialDepositRatio, proposalCancelRatio, proposalCancelDest string,
 	burnProposalDeposit, burnVoteQuorum, burnVoteVeto bool, minDepositRatio string,
+	optimisticAuthorizedAddresses []string, optimisticRejectedThreshold string,
 ) Params {
 	return Params{
-		MinDeposit:                 minDeposit,
-		ExpeditedMinDeposit:        expeditedminDeposit,
-		MaxDepositPeriod:           &maxDepositPeriod,
-		VotingPeriod:               &votingPeriod,
-		ExpeditedVotingPeriod:      &expeditedVotingPeriod,
-		Quorum:                     quorum,
-		Threshold:                  threshold,
-		ExpeditedThreshold:         expeditedThreshold,
-		VetoThreshold:              vetoThreshold,
-		MinInitialDepositRatio:     minInitialDepositRatio,
-		ProposalCancelRatio:        proposalCancelRatio,
-		ProposalCancelDest:         proposalCancelDest,
-		BurnProposalDepositPrevote: burnProposalDeposit,
-		BurnVoteQuorum:             burnVoteQuorum,
-		BurnVoteVeto:               burnVoteVeto,
-		MinDepositRatio:            minDepositRatio,
+		MinDeposit:                    minDeposit,
+		ExpeditedMinDeposit:           expeditedminDeposit,
+		MaxDepositPeriod:              &maxDepositPeriod,
+		VotingPeriod:                  &votingPeriod,
+		ExpeditedVotingPeriod:         &expeditedVotingPeriod,
+		Quorum:                        quorum,
+		Threshold:                     threshold,
+		ExpeditedThreshold:            expeditedThreshold,
+		VetoThreshold:                 vetoThreshold,
+		MinInitialDepositRatio:        minInitialDepositRatio,
+		ProposalCancelRatio:           proposalCancelRatio,
+		ProposalCancelDest:            proposalCancelDest,
+		BurnProposalDepositPrevote:    burnProposalDeposit,
+		BurnVoteQuorum:                burnVoteQuorum,
+		BurnVoteVeto:                  burnVoteVeto,
+		MinDepositRatio:               minDepositRatio,
+		OptimisticAuthorizedAddresses: optimisticAuthorizedAddresses,
+		OptimisticRejectedThreshold:   optimisticRejectedThreshold,
 	}
 }
 
@@ -110,6 +118,8 @@ func DefaultParams() Params {
 		DefaultBurnVoteQuorom,
 		DefaultBurnVoteVeto,
 		DefaultMinDepositRatio.String(),
+		DefaultOptimisticAuthorizedAddresses,
+		DefaultOptimisticRejectedThreshold.String(),
 	)
 }
 
@@ -227,5 +237,22 @@ func (p Params) ValidateBasic() error {
 		}
 	}
 
+	optimisticRejectedThreshold, err := sdkmath.LegacyNewDecFromStr(p.OptimisticRejectedThreshold)
+	if err != nil {
+		return fmt.Errorf("invalid optimistic rejected threshold string: %w", err)
+	}
+	if !optimisticRejectedThreshold.IsPositive() {
+		return fmt.Errorf("optimistic rejected threshold must be positive: %s", optimisticRejectedThreshold)
+	}
+	if optimisticRejectedThreshold.GT(sdkmath.LegacyOneDec()) {
+		return fmt.Errorf("optimistic rejected threshold too large: %s", optimisticRejectedThreshold)
+	}
+
+	for _, addr := range p.OptimisticAuthorizedAddresses {
+		if _, err := sdk.AccAddressFromBech32(addr); err != nil {
+			return fmt.Errorf("invalid optimistic authorized address: %s", addr)
+		}
+	}
+
 	return nil
 }
@@ -1,6 +1,7 @@
 package v1
 
 import (
+	"errors"
 	"fmt"
 	"strings"
 	"time"
@@ -18,6 +19,7 @@ const (
 	StatusDepositPeriod = ProposalStatus_PROPOSAL_STATUS_DEPOSIT_PERIOD
 	StatusVotingPeriod  = ProposalStatus_PROPOSAL_STATUS_VOTING_PERIOD
 	StatusPassed        = ProposalStatus_PROPOSAL_STATUS_PASSED
+	StatusScheduled     = ProposalStatus_PROPOSAL_STATUS_SCHEDULED
 	StatusRejected      = ProposalStatus_PROPOSAL_STATUS_REJECTED
 	StatusFailed        = ProposalStatus_PROPOSAL_STATUS_FAILED
 )
@@ -125,9 +127,67 @@ func ValidProposalStatus(status ProposalStatus) bool {
 	if status == StatusDepositPeriod ||
 		status == StatusVotingPeriod ||
 		status == StatusPassed ||
+		status == StatusScheduled ||
 		status == StatusRejected ||
 		status == StatusFailed {
 		return true
 	}
 	return false
 }
+
+// MaxProposalVoteOptions is the maximum number of vote options of a multiple
+// choice proposal, which are voted on with OptionOne to OptionFour.
+const MaxProposalVoteOptions = 4
+
+// ValidateBasic performs basic validation of the vote options of a multiple
+// choice proposal: the first two options are required, and the options must be
+// set in order.
+func (o ProposalVoteOptions) ValidateBasic() error {
+	if o.OptionOne == "" || o.OptionTwo == "" {
+		return errors.New("multiple choice proposals must have at least two vote options")
+	}
+	if o.OptionThree == "" && o.OptionFour != "" {
+		return errors.New("vote option four cannot be set without vote option three")
+	}
+	return nil
+}
+
+// OptionTallies returns the counts of the tally of a multiple choice proposal
+// by option name, in the order of the options. The counts of OptionOne to
+// OptionFour are stored as the yes, abstain, no and no with veto counts.
+func (o ProposalVoteOptions) OptionTallies(tally TallyResult) []*OptionTallyResult {
+	options := []struct {
+		name  string
+		count string
+	}{
+		{o.OptionOne, tally.YesCount},
+		{o.OptionTwo, tally.AbstainCount},
+		{o.OptionThree, tally.NoCount},
+		{o.OptionFour, tally.NoWithVetoCount},
+	}
+
+	tallies := make([]*OptionTallyResult, 0, MaxProposalVoteOptions)
+	for _, option := range options {
+		if option.name == "" {
+			continue
+		}
+		tallies = append(tallies, &OptionTallyResult{Option: option.name, Count: option.count})
+	}
+	return tallies
+}
+
+// HasOption returns true if the vote option is one of the named options.
+func (o ProposalVoteOptions) HasOption(option VoteOption) bool {
+	switch option {
+	case OptionOne:
+		return o.OptionOne != ""
+	case OptionTwo:
+		return o.OptionTwo != ""
+	case OptionThree:
+		return o.OptionThree != ""
+	case OptionFour:
+		return o.OptionFour != ""
+	default:
+		return false
+	}
+}
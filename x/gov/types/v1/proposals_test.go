@@ -81,3 +81,22 @@ func TestProposalGetMinDepositFromParams(t *testing.T) {
 		require.Equal(t, tc.expectedMinDeposit, actualMinDeposit[0].Amount)
 	}
 }
+
+func TestProposalVoteOptions(t *testing.T) {
+	require.Error(t, v1.ProposalVoteOptions{OptionOne: "a"}.ValidateBasic())
+	require.Error(t, v1.ProposalVoteOptions{OptionOne: "a", OptionTwo: "b", OptionFour: "d"}.ValidateBasic())
+
+	voteOptions := v1.ProposalVoteOptions{OptionOne: "a", OptionTwo: "b", OptionThree: "c"}
+	require.NoError(t, voteOptions.ValidateBasic())
+	require.True(t, voteOptions.HasOption(v1.OptionOne))
+	require.True(t, voteOptions.HasOption(v1.OptionThree))
+	require.False(t, voteOptions.HasOption(v1.OptionFour))
+	require.False(t, voteOptions.HasOption(v1.OptionEmpty))
+
+	tally := v1.NewTallyResult(math.NewInt(1), math.NewInt(2), math.NewInt(3), math.NewInt(4))
+	require.Equal(t, []*v1.OptionTallyResult{
+		{Option: "a", Count: "1"},
+		{Option: "b", Count: "2"},
+		{Option: "c", Count: "3"},
+	}, voteOptions.OptionTallies(tally))
+}
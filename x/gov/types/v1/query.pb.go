@@ -333,6 +333,109 @@ func (m *QueryProposalsResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
+// QueryScheduledProposalsRequest is the request type for the
+// Query/ScheduledProposals RPC method.
+type QueryScheduledProposalsRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryScheduledProposalsRequest) Reset()         { *m = QueryScheduledProposalsRequest{} }
+func (m *QueryScheduledProposalsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryScheduledProposalsRequest) ProtoMessage()    {}
+func (*QueryScheduledProposalsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_46a436d1109b50d0, []int{6}
+}
+func (m *QueryScheduledProposalsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryScheduledProposalsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryScheduledProposalsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryScheduledProposalsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryScheduledProposalsRequest.Merge(m, src)
+}
+func (m *QueryScheduledProposalsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryScheduledProposalsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryScheduledProposalsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryScheduledProposalsRequest proto.InternalMessageInfo
+
+func (m *QueryScheduledProposalsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryScheduledProposalsResponse is the response type for the
+// Query/ScheduledProposals RPC method.
+type QueryScheduledProposalsResponse struct {
+	// proposals defines the scheduled proposals.
+	Proposals []*Proposal `protobuf:"bytes,1,rep,name=proposals,proto3" json:"proposals,omitempty"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryScheduledProposalsResponse) Reset()         { *m = QueryScheduledProposalsResponse{} }
+func (m *QueryScheduledProposalsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryScheduledProposalsResponse) ProtoMessage()    {}
+func (*QueryScheduledProposalsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_46a436d1109b50d0, []int{7}
+}
+func (m *QueryScheduledProposalsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryScheduledProposalsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryScheduledProposalsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryScheduledProposalsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryScheduledProposalsResponse.Merge(m, src)
+}
+func (m *QueryScheduledProposalsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryScheduledProposalsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryScheduledProposalsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryScheduledProposalsResponse proto.InternalMessageInfo
+
+func (m *QueryScheduledProposalsResponse) GetProposals() []*Proposal {
+	if m != nil {
+		return m.Proposals
+	}
+	return nil
+}
+
+func (m *QueryScheduledProposalsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 // QueryVoteRequest is the request type for the Query/Vote RPC method.
 type QueryVoteRequest struct {
 	// proposal_id defines the unique id of the proposal.
@@ -345,7 +448,7 @@ func (m *QueryVoteRequest) Reset()         { *m = QueryVoteRequest{} }
 func (m *QueryVoteRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryVoteRequest) ProtoMessage()    {}
 func (*QueryVoteRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_46a436d1109b50d0, []int{6}
+	return fileDescriptor_46a436d1109b50d0, []int{8}
 }
 func (m *QueryVoteRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -398,7 +501,7 @@ func (m *QueryVoteResponse) Reset()         { *m = QueryVoteResponse{} }
 func (m *QueryVoteResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryVoteResponse) ProtoMessage()    {}
 func (*QueryVoteResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_46a436d1109b50d0, []int{7}
+	return fileDescriptor_46a436d1109b50d0, []int{9}
 }
 func (m *QueryVoteResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -446,7 +549,7 @@ func (m *QueryVotesRequest) Reset()         { *m = QueryVotesRequest{} }
 func (m *QueryVotesRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryVotesRequest) ProtoMessage()    {}
 func (*QueryVotesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_46a436d1109b50d0, []int{8}
+	return fileDescriptor_46a436d1109b50d0, []int{10}
 }
 func (m *QueryVotesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -501,7 +604,7 @@ func (m *QueryVotesResponse) Reset()         { *m = QueryVotesResponse{} }
 func (m *QueryVotesResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryVotesResponse) ProtoMessage()    {}
 func (*QueryVotesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_46a436d1109b50d0, []int{9}
+	return fileDescriptor_46a436d1109b50d0, []int{11}
 }
 func (m *QueryVotesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -555,7 +658,7 @@ func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
 func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryParamsRequest) ProtoMessage()    {}
 func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_46a436d1109b50d0, []int{10}
+	return fileDescriptor_46a436d1109b50d0, []int{12}
 }
 func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -612,7 +715,7 @@ func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
 func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryParamsResponse) ProtoMessage()    {}
 func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_46a436d1109b50d0, []int{11}
+	return fileDescriptor_46a436d1109b50d0, []int{13}
 }
 func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -684,7 +787,7 @@ func (m *QueryDepositRequest) Reset()         { *m = QueryDepositRequest{} }
 func (m *QueryDepositRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryDepositRequest) ProtoMessage()    {}
 func (*QueryDepositRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_46a436d1109b50d0, []int{12}
+	return fileDescriptor_46a436d1109b50d0, []int{14}
 }
 func (m *QueryDepositRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -737,7 +840,7 @@ func (m *QueryDepositResponse) Reset()         { *m = QueryDepositResponse{} }
 func (m *QueryDepositResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryDepositResponse) ProtoMessage()    {}
 func (*QueryDepositResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_46a436d1109b50d0, []int{13}
+	return fileDescriptor_46a436d1109b50d0, []int{15}
 }
 func (m *QueryDepositResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -785,7 +888,7 @@ func (m *QueryDepositsRequest) Reset()         { *m = QueryDepositsRequest{} }
 func (m *QueryDepositsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryDepositsRequest) ProtoMessage()    {}
 func (*QueryDepositsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_46a436d1109b50d0, []int{14}
+	return fileDescriptor_46a436d1109b50d0, []int{16}
 }
 func (m *QueryDepositsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -840,7 +943,7 @@ func (m *QueryDepositsResponse) Reset()         { *m = QueryDepositsResponse{} }
 func (m *QueryDepositsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryDepositsResponse) ProtoMessage()    {}
 func (*QueryDepositsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_46a436d1109b50d0, []int{15}
+	return fileDescriptor_46a436d1109b50d0, []int{17}
 }
 func (m *QueryDepositsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -893,7 +996,7 @@ func (m *QueryTallyResultRequest) Reset()         { *m = QueryTallyResultRequest
 func (m *QueryTallyResultRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryTallyResultRequest) ProtoMessage()    {}
 func (*QueryTallyResultRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_46a436d1109b50d0, []int{16}
+	return fileDescriptor_46a436d1109b50d0, []int{18}
 }
 func (m *QueryTallyResultRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -933,13 +1036,16 @@ func (m *QueryTallyResultRequest) GetProposalId() uint64 {
 type QueryTallyResultResponse struct {
 	// tally defines the requested tally.
 	Tally *TallyResult `protobuf:"bytes,1,opt,name=tally,proto3" json:"tally,omitempty"`
+	// option_tallies defines the requested tally by option name, in the order
+	// of the options, for a multiple choice proposal.
+	OptionTallies []*OptionTallyResult `protobuf:"bytes,2,rep,name=option_tallies,json=optionTallies,proto3" json:"option_tallies,omitempty"`
 }
 
 func (m *QueryTallyResultResponse) Reset()         { *m = QueryTallyResultResponse{} }
 func (m *QueryTallyResultResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryTallyResultResponse) ProtoMessage()    {}
 func (*QueryTallyResultResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_46a436d1109b50d0, []int{17}
+	return fileDescriptor_46a436d1109b50d0, []int{19}
 }
 func (m *QueryTallyResultResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -975,6 +1081,13 @@ func (m *QueryTallyResultResponse) GetTally() *TallyResult {
 	return nil
 }
 
+func (m *QueryTallyResultResponse) GetOptionTallies() []*OptionTallyResult {
+	if m != nil {
+		return m.OptionTallies
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryConstitutionRequest)(nil), "cosmos.gov.v1.QueryConstitutionRequest")
 	proto.RegisterType((*QueryConstitutionResponse)(nil), "cosmos.gov.v1.QueryConstitutionResponse")
@@ -982,6 +1095,8 @@ func init() {
 	proto.RegisterType((*QueryProposalResponse)(nil), "cosmos.gov.v1.QueryProposalResponse")
 	proto.RegisterType((*QueryProposalsRequest)(nil), "cosmos.gov.v1.QueryProposalsRequest")
 	proto.RegisterType((*QueryProposalsResponse)(nil), "cosmos.gov.v1.QueryProposalsResponse")
+	proto.RegisterType((*QueryScheduledProposalsRequest)(nil), "cosmos.gov.v1.QueryScheduledProposalsRequest")
+	proto.RegisterType((*QueryScheduledProposalsResponse)(nil), "cosmos.gov.v1.QueryScheduledProposalsResponse")
 	proto.RegisterType((*QueryVoteRequest)(nil), "cosmos.gov.v1.QueryVoteRequest")
 	proto.RegisterType((*QueryVoteResponse)(nil), "cosmos.gov.v1.QueryVoteResponse")
 	proto.RegisterType((*QueryVotesRequest)(nil), "cosmos.gov.v1.QueryVotesRequest")
@@ -999,71 +1114,77 @@ func init() {
 func init() { proto.RegisterFile("cosmos/gov/v1/query.proto", fileDescriptor_46a436d1109b50d0) }
 
 var fileDescriptor_46a436d1109b50d0 = []byte{
-	// 1023 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0x5b, 0x6f, 0xdc, 0x44,
-	0x14, 0x8e, 0x37, 0x97, 0x66, 0x4f, 0x2e, 0xc0, 0xe9, 0x25, 0xae, 0xdb, 0x6e, 0x83, 0x43, 0x93,
-	0x40, 0x89, 0xcd, 0xa6, 0x37, 0x09, 0x8a, 0x50, 0xd3, 0x92, 0x82, 0xc4, 0x43, 0x70, 0x2b, 0x1e,
-	0x78, 0x89, 0x9c, 0xac, 0x65, 0x2c, 0x36, 0x1e, 0x77, 0x67, 0x76, 0x45, 0x48, 0x23, 0xa4, 0x4a,
-	0x5c, 0x9e, 0x00, 0x89, 0x0a, 0xf8, 0x21, 0xfc, 0x08, 0x1e, 0x2b, 0x78, 0xe1, 0x11, 0x25, 0xfc,
-	0x10, 0xe4, 0x99, 0x63, 0xaf, 0xed, 0x78, 0x6f, 0x55, 0xd5, 0xa7, 0x95, 0x67, 0xbe, 0xf3, 0x9d,
-	0xef, 0x9c, 0x39, 0x73, 0xce, 0x2c, 0x9c, 0xdf, 0x65, 0x7c, 0x8f, 0x71, 0xdb, 0x67, 0x1d, 0xbb,
-	0x53, 0xb7, 0x1f, 0xb5, 0xbd, 0xd6, 0xbe, 0x15, 0xb5, 0x98, 0x60, 0x38, 0xa7, 0xb6, 0x2c, 0x9f,
-	0x75, 0xac, 0x4e, 0xdd, 0x78, 0x8b, 0x90, 0x3b, 0x2e, 0xf7, 0x14, 0xce, 0xee, 0xd4, 0x77, 0x3c,
-	0xe1, 0xd6, 0xed, 0xc8, 0xf5, 0x83, 0xd0, 0x15, 0x01, 0x0b, 0x95, 0xa9, 0x71, 0xd1, 0x67, 0xcc,
-	0x6f, 0x7a, 0xb6, 0x1b, 0x05, 0xb6, 0x1b, 0x86, 0x4c, 0xc8, 0x4d, 0x4e, 0xbb, 0x0b, 0x79, 0x9f,
-	0x31, 0xbf, 0xda, 0x20, 0x31, 0xdb, 0xf2, 0xcb, 0x26, 0xf7, 0xf2, 0xc3, 0x34, 0x40, 0xff, 0x34,
-	0xf6, 0x79, 0x97, 0x85, 0x5c, 0x04, 0xa2, 0x1d, 0xf3, 0x39, 0xde, 0xa3, 0xb6, 0xc7, 0x85, 0xf9,
-	0x01, 0x9c, 0x2f, 0xd9, 0xe3, 0x11, 0x0b, 0xb9, 0x87, 0x26, 0xcc, 0xee, 0x66, 0xd6, 0x75, 0x6d,
-	0x51, 0x5b, 0xad, 0x3a, 0xb9, 0x35, 0xf3, 0x16, 0x9c, 0x91, 0x04, 0x5b, 0x2d, 0x16, 0x31, 0xee,
-	0x36, 0x89, 0x18, 0x2f, 0xc3, 0x4c, 0x44, 0x4b, 0xdb, 0x41, 0x43, 0x9a, 0x4e, 0x38, 0x90, 0x2c,
-	0x7d, 0xdc, 0x30, 0x3f, 0x81, 0xb3, 0x05, 0x43, 0xf2, 0x7a, 0x0d, 0xa6, 0x13, 0x98, 0x34, 0x9b,
-	0x59, 0x5f, 0xb0, 0x72, 0xe9, 0xb4, 0x52, 0x93, 0x14, 0x68, 0xfe, 0x54, 0x29, 0xd0, 0xf1, 0x44,
-	0xc8, 0x26, 0xbc, 0x92, 0x0a, 0xe1, 0xc2, 0x15, 0x6d, 0x2e, 0x59, 0xe7, 0xd7, 0x2f, 0xf5, 0x60,
-	0x7d, 0x20, 0x41, 0xce, 0x7c, 0x94, 0xfb, 0x46, 0x0b, 0x26, 0x3b, 0x4c, 0x78, 0x2d, 0xbd, 0x12,
-	0x67, 0x61, 0x43, 0xff, 0xeb, 0x8f, 0xb5, 0x33, 0x44, 0x70, 0xa7, 0xd1, 0x68, 0x79, 0x9c, 0x3f,
-	0x10, 0xad, 0x20, 0xf4, 0x1d, 0x05, 0xc3, 0x9b, 0x50, 0x6d, 0x78, 0x11, 0xe3, 0x81, 0x60, 0x2d,
-	0x7d, 0x7c, 0x80, 0x4d, 0x17, 0x8a, 0x9b, 0x00, 0xdd, 0x9a, 0xd0, 0x27, 0x64, 0x02, 0x96, 0x13,
-	0xa9, 0x71, 0x01, 0x59, 0xaa, 0xd0, 0xa8, 0x80, 0xac, 0x2d, 0xd7, 0xf7, 0x28, 0x56, 0x27, 0x63,
-	0x69, 0xfe, 0xae, 0xc1, 0xb9, 0x62, 0x46, 0x28, 0xc3, 0x37, 0xa0, 0x9a, 0x04, 0x17, 0x27, 0x63,
-	0xbc, 0x5f, 0x8a, 0xbb, 0x48, 0xbc, 0x9f, 0x53, 0x56, 0x91, 0xca, 0x56, 0x06, 0x2a, 0x53, 0x3e,
-	0x73, 0xd2, 0x76, 0xe1, 0x55, 0xa9, 0xec, 0x33, 0x26, 0xbc, 0x61, 0xeb, 0x65, 0xd4, 0xfc, 0x9b,
-	0xb7, 0xe1, 0xb5, 0x8c, 0x13, 0x8a, 0x7c, 0x05, 0x26, 0xe2, 0x5d, 0xaa, 0xab, 0xd3, 0x85, 0xa0,
-	0x25, 0x54, 0x02, 0xcc, 0xc7, 0x19, 0x6b, 0x3e, 0xb4, 0xc6, 0xcd, 0x92, 0x0c, 0x3d, 0xcf, 0xd9,
-	0xfd, 0xa0, 0x01, 0x66, 0xdd, 0x93, 0xfa, 0x37, 0x55, 0x0a, 0x92, 0x33, 0x2b, 0x95, 0xaf, 0x10,
-	0x2f, 0xee, 0xac, 0x6e, 0x90, 0x92, 0x2d, 0xb7, 0xe5, 0xee, 0xe5, 0x32, 0x21, 0x17, 0xb6, 0xc5,
-	0x7e, 0xe4, 0x51, 0x63, 0x00, 0xb5, 0xf4, 0x70, 0x3f, 0xf2, 0xcc, 0x5f, 0x2b, 0x70, 0x3a, 0x67,
-	0x47, 0x21, 0xdc, 0x83, 0xb9, 0x0e, 0x13, 0x41, 0xe8, 0x6f, 0x2b, 0x30, 0x9d, 0xc4, 0x85, 0x93,
-	0xa1, 0x04, 0xa1, 0xaf, 0x6c, 0x37, 0x2a, 0xba, 0xe6, 0xcc, 0x76, 0x32, 0x2b, 0x78, 0x1f, 0xe6,
-	0xe9, 0xc2, 0x24, 0x34, 0x2a, 0xc2, 0x8b, 0x05, 0x9a, 0x7b, 0x0a, 0x94, 0xe1, 0x99, 0x6b, 0x64,
-	0x97, 0xf0, 0x0e, 0xcc, 0x0a, 0xb7, 0xd9, 0xdc, 0x4f, 0x68, 0xc6, 0x25, 0x8d, 0x51, 0xa0, 0x79,
-	0x18, 0x43, 0x32, 0x24, 0x33, 0xa2, 0xbb, 0x80, 0x6b, 0x30, 0x45, 0xc6, 0xea, 0xae, 0x9e, 0x2d,
-	0xde, 0x24, 0x95, 0x00, 0x02, 0x99, 0x21, 0xe5, 0x85, 0xa4, 0x0d, 0x5d, 0x5a, 0xb9, 0x76, 0x52,
-	0x19, 0xba, 0x9d, 0x98, 0x1f, 0x51, 0x7f, 0x4e, 0xfd, 0xd1, 0x41, 0xbc, 0x03, 0xa7, 0x08, 0x44,
-	0x47, 0x70, 0xae, 0x3c, 0x77, 0x4e, 0x02, 0x33, 0xbf, 0xc9, 0x33, 0xbd, 0xfc, 0x5b, 0xf1, 0x54,
-	0xa3, 0x1e, 0xdf, 0x55, 0x40, 0xc1, 0xac, 0xc3, 0x34, 0xa9, 0x4c, 0xee, 0x46, 0xaf, 0x68, 0x52,
-	0xdc, 0x8b, 0xbb, 0x21, 0xef, 0xc2, 0x82, 0x54, 0x25, 0xab, 0xc4, 0xf1, 0x78, 0xbb, 0x29, 0x46,
-	0x18, 0x82, 0xfa, 0x49, 0xdb, 0xf4, 0x84, 0x26, 0x65, 0x9d, 0xd1, 0xf9, 0x94, 0x16, 0x25, 0x99,
-	0x28, 0xe0, 0xfa, 0x71, 0x15, 0x26, 0x25, 0x1d, 0x7e, 0xa7, 0xc1, 0x6c, 0x76, 0xa4, 0xe3, 0x4a,
-	0xc1, 0xba, 0xd7, 0x83, 0xc0, 0x58, 0x1d, 0x0c, 0x54, 0xfa, 0xcc, 0xa5, 0x27, 0x7f, 0xff, 0xf7,
-	0x4b, 0xe5, 0x12, 0x5e, 0xb0, 0xf3, 0x6f, 0x92, 0xec, 0xf3, 0x00, 0xbf, 0xd5, 0x60, 0x3a, 0x99,
-	0x25, 0xb8, 0x54, 0xc6, 0x5d, 0x78, 0x38, 0x18, 0x6f, 0xf4, 0x07, 0x91, 0x73, 0x4b, 0x3a, 0x5f,
-	0xc5, 0xe5, 0x82, 0xf3, 0x74, 0x5a, 0xd9, 0x07, 0x99, 0xcc, 0x1f, 0xe2, 0xd7, 0x50, 0x4d, 0xe7,
-	0x20, 0xf6, 0x75, 0x91, 0xd4, 0xb5, 0x71, 0x65, 0x00, 0x8a, 0x94, 0x2c, 0x4a, 0x25, 0x06, 0xea,
-	0xbd, 0x94, 0xe0, 0xf7, 0x1a, 0x4c, 0xc4, 0xbd, 0x19, 0x2f, 0x97, 0x31, 0x66, 0x86, 0xa0, 0xb1,
-	0xd8, 0x1b, 0x40, 0xde, 0x6e, 0x4b, 0x6f, 0x37, 0xf1, 0xfa, 0x70, 0x71, 0xdb, 0x72, 0x1a, 0xd8,
-	0x07, 0x72, 0x24, 0x1e, 0xe2, 0x13, 0x0d, 0x26, 0xe5, 0x48, 0xc1, 0x9e, 0x9e, 0xd2, 0xf0, 0x5f,
-	0xef, 0x83, 0x20, 0x31, 0xd7, 0xa5, 0x18, 0x0b, 0xdf, 0x1e, 0x45, 0x0c, 0x3e, 0x86, 0x29, 0x6a,
-	0x9d, 0xa5, 0x2e, 0x72, 0x83, 0xc6, 0x30, 0xfb, 0x41, 0x48, 0xc6, 0x55, 0x29, 0xe3, 0x0a, 0x2e,
-	0x15, 0x65, 0x48, 0x98, 0x7d, 0x90, 0x99, 0x54, 0x87, 0xf8, 0x9b, 0x06, 0xa7, 0xa8, 0x19, 0x60,
-	0x29, 0x79, 0xbe, 0x31, 0x1b, 0x4b, 0x7d, 0x31, 0xa4, 0xe0, 0xae, 0x54, 0xf0, 0x3e, 0xbe, 0x37,
-	0x64, 0x22, 0x92, 0x26, 0x64, 0x1f, 0xa4, 0x8d, 0xfa, 0x10, 0x7f, 0xd4, 0x60, 0x3a, 0xe9, 0x6c,
-	0xd8, 0xcf, 0x2d, 0xef, 0x7b, 0x55, 0x8a, 0xcd, 0xd1, 0xbc, 0x25, 0xc5, 0xd5, 0xd1, 0x1e, 0x51,
-	0x1c, 0x3e, 0xd5, 0x60, 0x26, 0xd3, 0x65, 0x70, 0xb9, 0xcc, 0xdd, 0xc9, 0xae, 0x67, 0xac, 0x0c,
-	0xc4, 0x3d, 0x67, 0xfd, 0xc8, 0x2e, 0xb7, 0xf1, 0xe1, 0x9f, 0x47, 0x35, 0xed, 0xd9, 0x51, 0x4d,
-	0xfb, 0xf7, 0xa8, 0xa6, 0xfd, 0x7c, 0x5c, 0x1b, 0x7b, 0x76, 0x5c, 0x1b, 0xfb, 0xe7, 0xb8, 0x36,
-	0xf6, 0xf9, 0x55, 0x3f, 0x10, 0x5f, 0xb4, 0x77, 0xac, 0x5d, 0xb6, 0x97, 0x30, 0xaa, 0x9f, 0x35,
-	0xde, 0xf8, 0xd2, 0xfe, 0x4a, 0xd2, 0xc7, 0x55, 0xc0, 0xe3, 0x7f, 0x5f, 0x53, 0xf2, 0xcf, 0xd1,
-	0xb5, 0xff, 0x03, 0x00, 0x00, 0xff, 0xff, 0x34, 0xfb, 0x7f, 0x51, 0xc6, 0x0d, 0x00, 0x00,
+	// 1113 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x57, 0x5b, 0x6f, 0xdc, 0x44,
+	0x14, 0xce, 0x6c, 0x2e, 0x4d, 0x4e, 0x2e, 0xc0, 0x69, 0xd2, 0xb8, 0x6e, 0xbb, 0x09, 0x4e, 0x9b,
+	0x84, 0x96, 0xd8, 0x24, 0xbd, 0x49, 0x50, 0x84, 0x9a, 0x96, 0x14, 0x24, 0x24, 0xc2, 0xa6, 0xe2,
+	0x81, 0x97, 0x95, 0xb3, 0x6b, 0x39, 0x16, 0x1b, 0x8f, 0xbb, 0xe3, 0x5d, 0x11, 0xd2, 0x08, 0xa9,
+	0x52, 0x81, 0x27, 0x40, 0xa2, 0x5c, 0xde, 0x10, 0xff, 0x81, 0x1f, 0xc1, 0x63, 0x05, 0x2f, 0x3c,
+	0xa2, 0x84, 0x1f, 0x82, 0x3c, 0x73, 0xbc, 0x6b, 0x3b, 0xde, 0x4b, 0xa2, 0x0a, 0xf1, 0xb4, 0xf2,
+	0xcc, 0x77, 0xbe, 0xef, 0x9b, 0x33, 0x67, 0xe6, 0xcc, 0xc2, 0xf9, 0x0a, 0x17, 0xbb, 0x5c, 0x58,
+	0x2e, 0x6f, 0x5a, 0xcd, 0x55, 0xeb, 0x51, 0xc3, 0xa9, 0xef, 0x99, 0x41, 0x9d, 0x87, 0x1c, 0x27,
+	0xd5, 0x94, 0xe9, 0xf2, 0xa6, 0xd9, 0x5c, 0xd5, 0xaf, 0x12, 0x72, 0xdb, 0x16, 0x8e, 0xc2, 0x59,
+	0xcd, 0xd5, 0x6d, 0x27, 0xb4, 0x57, 0xad, 0xc0, 0x76, 0x3d, 0xdf, 0x0e, 0x3d, 0xee, 0xab, 0x50,
+	0xfd, 0xa2, 0xcb, 0xb9, 0x5b, 0x73, 0x2c, 0x3b, 0xf0, 0x2c, 0xdb, 0xf7, 0x79, 0x28, 0x27, 0x05,
+	0xcd, 0xce, 0xa6, 0x35, 0x23, 0x7e, 0x35, 0x41, 0x66, 0xca, 0xf2, 0xcb, 0x22, 0x79, 0xf9, 0x61,
+	0xe8, 0xa0, 0x7d, 0x14, 0x69, 0xde, 0xe3, 0xbe, 0x08, 0xbd, 0xb0, 0x11, 0xf1, 0x95, 0x9c, 0x47,
+	0x0d, 0x47, 0x84, 0xc6, 0x3b, 0x70, 0x3e, 0x67, 0x4e, 0x04, 0xdc, 0x17, 0x0e, 0x1a, 0x30, 0x51,
+	0x49, 0x8c, 0x6b, 0x6c, 0x9e, 0x2d, 0x8f, 0x95, 0x52, 0x63, 0xc6, 0x6d, 0x98, 0x96, 0x04, 0x9b,
+	0x75, 0x1e, 0x70, 0x61, 0xd7, 0x88, 0x18, 0xe7, 0x60, 0x3c, 0xa0, 0xa1, 0xb2, 0x57, 0x95, 0xa1,
+	0x43, 0x25, 0x88, 0x87, 0xde, 0xaf, 0x1a, 0x1f, 0xc0, 0x4c, 0x26, 0x90, 0x54, 0xaf, 0xc3, 0x68,
+	0x0c, 0x93, 0x61, 0xe3, 0x6b, 0xb3, 0x66, 0x2a, 0x9d, 0x66, 0x2b, 0xa4, 0x05, 0x34, 0xbe, 0x2d,
+	0x64, 0xe8, 0x44, 0x6c, 0x64, 0x03, 0x5e, 0x6a, 0x19, 0x11, 0xa1, 0x1d, 0x36, 0x84, 0x64, 0x9d,
+	0x5a, 0xbb, 0xd4, 0x81, 0x75, 0x4b, 0x82, 0x4a, 0x53, 0x41, 0xea, 0x1b, 0x4d, 0x18, 0x6e, 0xf2,
+	0xd0, 0xa9, 0x6b, 0x85, 0x28, 0x0b, 0xeb, 0xda, 0x1f, 0xbf, 0xad, 0x4c, 0x13, 0xc1, 0xdd, 0x6a,
+	0xb5, 0xee, 0x08, 0xb1, 0x15, 0xd6, 0x3d, 0xdf, 0x2d, 0x29, 0x18, 0xde, 0x82, 0xb1, 0xaa, 0x13,
+	0x70, 0xe1, 0x85, 0xbc, 0xae, 0x0d, 0xf6, 0x88, 0x69, 0x43, 0x71, 0x03, 0xa0, 0x5d, 0x13, 0xda,
+	0x90, 0x4c, 0xc0, 0x62, 0x6c, 0x35, 0x2a, 0x20, 0x53, 0x15, 0x1a, 0x15, 0x90, 0xb9, 0x69, 0xbb,
+	0x0e, 0xad, 0xb5, 0x94, 0x88, 0x34, 0x7e, 0x66, 0x70, 0x2e, 0x9b, 0x11, 0xca, 0xf0, 0x4d, 0x18,
+	0x8b, 0x17, 0x17, 0x25, 0x63, 0xb0, 0x5b, 0x8a, 0xdb, 0x48, 0x7c, 0x90, 0x72, 0x56, 0x90, 0xce,
+	0x96, 0x7a, 0x3a, 0x53, 0x9a, 0x29, 0x6b, 0x3b, 0x50, 0x94, 0xce, 0xb6, 0x2a, 0x3b, 0x4e, 0xb5,
+	0x51, 0x73, 0xaa, 0x39, 0x9b, 0x96, 0x94, 0x62, 0xa7, 0x4e, 0xc2, 0xaf, 0x0c, 0xe6, 0x3a, 0x4a,
+	0xfd, 0x4f, 0xb2, 0x51, 0x81, 0x97, 0xa5, 0xc5, 0x8f, 0x79, 0xe8, 0xf4, 0x7b, 0x7a, 0x4e, 0x5a,
+	0x8d, 0xc6, 0x1d, 0x78, 0x25, 0x21, 0x42, 0x2b, 0x5f, 0x82, 0xa1, 0x68, 0x96, 0xf2, 0x7b, 0x36,
+	0xb3, 0x68, 0x09, 0x95, 0x00, 0xe3, 0x71, 0x22, 0x5a, 0xf4, 0xed, 0x71, 0x23, 0x27, 0x43, 0xa7,
+	0xd9, 0xc4, 0xaf, 0x19, 0x60, 0x52, 0x9e, 0xdc, 0xbf, 0xa6, 0x52, 0x10, 0xef, 0x59, 0xae, 0x7d,
+	0x85, 0x78, 0x71, 0x7b, 0x75, 0x93, 0x9c, 0x6c, 0xda, 0x75, 0x7b, 0x37, 0x95, 0x09, 0x39, 0x50,
+	0x0e, 0xf7, 0x02, 0x87, 0xae, 0x49, 0x50, 0x43, 0x0f, 0xf7, 0x02, 0xc7, 0xf8, 0xb1, 0x00, 0x67,
+	0x53, 0x71, 0xb4, 0x84, 0xfb, 0x30, 0xd9, 0xe4, 0xa1, 0xe7, 0xbb, 0x65, 0x05, 0xa6, 0x9d, 0xb8,
+	0x70, 0x7c, 0x29, 0x9e, 0xef, 0xaa, 0xd8, 0xf5, 0x82, 0xc6, 0x4a, 0x13, 0xcd, 0xc4, 0x08, 0x3e,
+	0x80, 0x29, 0xba, 0x3e, 0x62, 0x1a, 0xb5, 0xc2, 0x8b, 0x19, 0x9a, 0xfb, 0x0a, 0x94, 0xe0, 0x99,
+	0xac, 0x26, 0x87, 0xf0, 0x2e, 0x4c, 0x84, 0x76, 0xad, 0xb6, 0x17, 0xd3, 0x0c, 0x4a, 0x1a, 0x3d,
+	0x43, 0xf3, 0x30, 0x82, 0x24, 0x48, 0xc6, 0xc3, 0xf6, 0x00, 0xae, 0xc0, 0x08, 0x05, 0xab, 0x9b,
+	0x6b, 0x26, 0x7b, 0x92, 0x54, 0x02, 0x08, 0x64, 0xf8, 0x94, 0x17, 0xb2, 0xd6, 0x77, 0x69, 0xa5,
+	0x2e, 0xd7, 0x42, 0xdf, 0x97, 0xab, 0xf1, 0x1e, 0x4c, 0xa7, 0xf5, 0x68, 0x23, 0xde, 0x80, 0x33,
+	0x04, 0xa2, 0x2d, 0x38, 0x97, 0x9f, 0xbb, 0x52, 0x0c, 0x33, 0xbe, 0x48, 0x33, 0xfd, 0xf7, 0xa7,
+	0xe2, 0x19, 0x83, 0x99, 0x8c, 0x03, 0x5a, 0xcc, 0x1a, 0x8c, 0x92, 0xcb, 0xf8, 0x6c, 0x74, 0x5a,
+	0x4d, 0x0b, 0xf7, 0xe2, 0x4e, 0xc8, 0x9b, 0x30, 0x2b, 0x5d, 0xc9, 0x2a, 0x29, 0x39, 0xa2, 0x51,
+	0xeb, 0x7b, 0x57, 0x8d, 0x1f, 0x18, 0x68, 0xc7, 0x83, 0x5b, 0x5b, 0x34, 0x2c, 0x0b, 0x4d, 0x63,
+	0x9d, 0xab, 0x92, 0x42, 0x14, 0x30, 0x3a, 0x17, 0x3c, 0x88, 0x4c, 0x95, 0xa3, 0x6f, 0xcf, 0x89,
+	0xce, 0x45, 0x94, 0x8d, 0xf9, 0x4c, 0xe8, 0x87, 0x12, 0x94, 0x24, 0x98, 0xe4, 0xad, 0x21, 0xcf,
+	0x11, 0x6b, 0x4f, 0xc7, 0x61, 0x58, 0xfa, 0xc2, 0x2f, 0x19, 0x4c, 0x24, 0x9f, 0x4a, 0xb8, 0x94,
+	0xe1, 0xea, 0xf4, 0xd0, 0xd2, 0x97, 0x7b, 0x03, 0xd5, 0x42, 0x8d, 0x85, 0x27, 0x7f, 0xfe, 0xf3,
+	0x7d, 0xe1, 0x12, 0x5e, 0xb0, 0xd2, 0x6f, 0xbd, 0xe4, 0xb3, 0x0b, 0x9f, 0x32, 0x18, 0x8d, 0xbb,
+	0x12, 0x2e, 0xe4, 0x71, 0x67, 0x1e, 0x64, 0xfa, 0xe5, 0xee, 0x20, 0x12, 0x37, 0xa5, 0xf8, 0x32,
+	0x2e, 0x66, 0xc4, 0x5b, 0x7d, 0xcf, 0xda, 0x4f, 0xec, 0xe1, 0x01, 0x7e, 0x0e, 0x63, 0x31, 0x87,
+	0xc0, 0xae, 0x12, 0xf1, 0x09, 0xd1, 0xaf, 0xf4, 0x40, 0x91, 0x93, 0x79, 0xe9, 0x44, 0x47, 0xad,
+	0x93, 0x13, 0xfc, 0x85, 0x01, 0x1e, 0xef, 0xeb, 0xb8, 0x92, 0xc7, 0xdf, 0xf1, 0xa9, 0xa1, 0x9b,
+	0xfd, 0xc2, 0xc9, 0xd7, 0x55, 0xe9, 0xeb, 0x32, 0x1a, 0x19, 0x5f, 0x22, 0x0e, 0x29, 0xb7, 0x1d,
+	0x7e, 0xc5, 0x60, 0x28, 0xea, 0x43, 0x38, 0x97, 0x27, 0x92, 0x68, 0xf8, 0xfa, 0x7c, 0x67, 0x00,
+	0xe9, 0xde, 0x91, 0xba, 0xb7, 0xf0, 0x46, 0x7f, 0x3b, 0x63, 0xc9, 0xce, 0x67, 0xed, 0x47, 0x3f,
+	0xf5, 0x03, 0x7c, 0xc2, 0x60, 0x38, 0xa2, 0x13, 0xd8, 0x51, 0xa9, 0x95, 0x91, 0x57, 0xbb, 0x20,
+	0xc8, 0xcc, 0x0d, 0x69, 0xc6, 0xc4, 0xd7, 0x4f, 0x62, 0x06, 0x1f, 0xc3, 0x08, 0xb5, 0x89, 0x5c,
+	0x89, 0x54, 0x53, 0xd5, 0x8d, 0x6e, 0x10, 0xb2, 0x71, 0x4d, 0xda, 0xb8, 0x82, 0x0b, 0x59, 0x1b,
+	0x12, 0x66, 0xed, 0x27, 0xba, 0xf2, 0x01, 0xfe, 0xc4, 0xe0, 0x0c, 0x5d, 0x7c, 0x98, 0x4b, 0x9e,
+	0x6e, 0x42, 0xfa, 0x42, 0x57, 0x0c, 0x39, 0xb8, 0x27, 0x1d, 0xbc, 0x8d, 0x6f, 0xf5, 0x99, 0x88,
+	0xf8, 0xc2, 0xb5, 0xf6, 0x5b, 0x4d, 0xe9, 0x00, 0xbf, 0x61, 0x30, 0x4a, 0xc4, 0x02, 0xbb, 0xc9,
+	0x8a, 0xae, 0x87, 0x39, 0xdb, 0x08, 0x8c, 0xdb, 0xd2, 0xdc, 0x2a, 0x5a, 0x27, 0x34, 0x87, 0xcf,
+	0x18, 0x8c, 0x27, 0xee, 0x43, 0x5c, 0xcc, 0x93, 0x3b, 0x7e, 0xc3, 0xeb, 0x4b, 0x3d, 0x71, 0xa7,
+	0xac, 0x1f, 0x79, 0xa1, 0xaf, 0xbf, 0xfb, 0xfb, 0x61, 0x91, 0x3d, 0x3f, 0x2c, 0xb2, 0xbf, 0x0f,
+	0x8b, 0xec, 0xbb, 0xa3, 0xe2, 0xc0, 0xf3, 0xa3, 0xe2, 0xc0, 0x5f, 0x47, 0xc5, 0x81, 0x4f, 0xae,
+	0xb9, 0x5e, 0xb8, 0xd3, 0xd8, 0x36, 0x2b, 0x7c, 0x37, 0x66, 0x54, 0x3f, 0x2b, 0xa2, 0xfa, 0xa9,
+	0xf5, 0x99, 0xa4, 0x8f, 0xaa, 0x40, 0x44, 0xff, 0xbb, 0x47, 0xe4, 0xdf, 0xe2, 0xeb, 0xff, 0x0e,
+	0x00, 0x68, 0x8b, 0x64, 0xdc, 0xc0, 0x0f, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1084,6 +1205,9 @@ type QueryClient interface {
 	Proposal(ctx context.Context, in *QueryProposalRequest, opts ...grpc.CallOption) (*QueryProposalResponse, error)
 	// Proposals queries all proposals based on given status.
 	Proposals(ctx context.Context, in *QueryProposalsRequest, opts ...grpc.CallOption) (*QueryProposalsResponse, error)
+	// ScheduledProposals queries the passed proposals whose messages are queued
+	// for execution, by ascending proposal id.
+	ScheduledProposals(ctx context.Context, in *QueryScheduledProposalsRequest, opts ...grpc.CallOption) (*QueryScheduledProposalsResponse, error)
 	// Vote queries voted information based on proposalID, voterAddr.
 	Vote(ctx context.Context, in *QueryVoteRequest, opts ...grpc.CallOption) (*QueryVoteResponse, error)
 	// Votes queries votes of a given proposal.
@@ -1133,6 +1257,15 @@ func (c *queryClient) Proposals(ctx context.Context, in *QueryProposalsRequest,
 	return out, nil
 }
 
+func (c *queryClient) ScheduledProposals(ctx context.Context, in *QueryScheduledProposalsRequest, opts ...grpc.CallOption) (*QueryScheduledProposalsResponse, error) {
+	out := new(QueryScheduledProposalsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.gov.v1.Query/ScheduledProposals", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) Vote(ctx context.Context, in *QueryVoteRequest, opts ...grpc.CallOption) (*QueryVoteResponse, error) {
 	out := new(QueryVoteResponse)
 	err := c.cc.Invoke(ctx, "/cosmos.gov.v1.Query/Vote", in, out, opts...)
@@ -1195,6 +1328,9 @@ type QueryServer interface {
 	Proposal(context.Context, *QueryProposalRequest) (*QueryProposalResponse, error)
 	// Proposals queries all proposals based on given status.
 	Proposals(context.Context, *QueryProposalsRequest) (*QueryProposalsResponse, error)
+	// ScheduledProposals queries the passed proposals whose messages are queued
+	// for execution, by ascending proposal id.
+	ScheduledProposals(context.Context, *QueryScheduledProposalsRequest) (*QueryScheduledProposalsResponse, error)
 	// Vote queries voted information based on proposalID, voterAddr.
 	Vote(context.Context, *QueryVoteRequest) (*QueryVoteResponse, error)
 	// Votes queries votes of a given proposal.
@@ -1222,6 +1358,9 @@ func (*UnimplementedQueryServer) Proposal(ctx context.Context, req *QueryProposa
 func (*UnimplementedQueryServer) Proposals(ctx context.Context, req *QueryProposalsRequest) (*QueryProposalsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Proposals not implemented")
 }
+func (*UnimplementedQueryServer) ScheduledProposals(ctx context.Context, req *QueryScheduledProposalsRequest) (*QueryScheduledProposalsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduledProposals not implemented")
+}
 func (*UnimplementedQueryServer) Vote(ctx context.Context, req *QueryVoteRequest) (*QueryVoteResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Vote not implemented")
 }
@@ -1299,6 +1438,24 @@ func _Query_Proposals_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ScheduledProposals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryScheduledProposalsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ScheduledProposals(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.gov.v1.Query/ScheduledProposals",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ScheduledProposals(ctx, req.(*QueryScheduledProposalsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_Vote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryVoteRequest)
 	if err := dec(in); err != nil {
@@ -1423,6 +1580,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Proposals",
 			Handler:    _Query_Proposals_Handler,
 		},
+		{
+			MethodName: "ScheduledProposals",
+			Handler:    _Query_ScheduledProposals_Handler,
+		},
 		{
 			MethodName: "Vote",
 			Handler:    _Query_Vote_Handler,
@@ -1671,6 +1832,90 @@ func (m *QueryProposalsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryScheduledProposalsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryScheduledProposalsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryScheduledProposalsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryScheduledProposalsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryScheduledProposalsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryScheduledProposalsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Proposals) > 0 {
+		for iNdEx := len(m.Proposals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Proposals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *QueryVoteRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2138,6 +2383,20 @@ func (m *QueryTallyResultResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	_ = i
 	var l int
 	_ = l
+	if len(m.OptionTallies) > 0 {
+		for iNdEx := len(m.OptionTallies) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OptionTallies[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	if m.Tally != nil {
 		{
 			size, err := m.Tally.MarshalToSizedBuffer(dAtA[:i])
@@ -2254,6 +2513,38 @@ func (m *QueryProposalsResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryScheduledProposalsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryScheduledProposalsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Proposals) > 0 {
+		for _, e := range m.Proposals {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func (m *QueryVoteRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2442,6 +2733,12 @@ func (m *QueryTallyResultResponse) Size() (n int) {
 		l = m.Tally.Size()
 		n += 1 + l + sovQuery(uint64(l))
 	}
+	if len(m.OptionTallies) > 0 {
+		for _, e := range m.OptionTallies {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -3027,6 +3324,212 @@ func (m *QueryProposalsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryScheduledProposalsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryScheduledProposalsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryScheduledProposalsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryScheduledProposalsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryScheduledProposalsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryScheduledProposalsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proposals = append(m.Proposals, &Proposal{})
+			if err := m.Proposals[len(m.Proposals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *QueryVoteRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -4261,6 +4764,40 @@ func (m *QueryTallyResultResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionTallies", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OptionTallies = append(m.OptionTallies, &OptionTallyResult{})
+			if err := m.OptionTallies[len(m.OptionTallies)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -61,6 +61,19 @@ type MsgSubmitProposal struct {
 	//
 	// Since: cosmos-sdk 0.50
 	Expedited bool `protobuf:"varint,7,opt,name=expedited,proto3" json:"expedited,omitempty"`
+	// proposal_type defines the type of the proposal. Optimistic proposals
+	// cannot be expedited.
+	ProposalType ProposalType `protobuf:"varint,8,opt,name=proposal_type,json=proposalType,proto3,enum=cosmos.gov.v1.ProposalType" json:"proposal_type,omitempty"`
+	// vote_options defines the named vote options of a multiple choice
+	// proposal. It must be set for multiple choice proposals only, which must
+	// not have messages.
+	VoteOptions *ProposalVoteOptions `protobuf:"bytes,9,opt,name=vote_options,json=voteOptions,proto3" json:"vote_options,omitempty"`
+	// execution_time optionally defines the time from which the messages of the
+	// proposal are executed once it passes.
+	ExecutionTime *time.Time `protobuf:"bytes,10,opt,name=execution_time,json=executionTime,proto3,stdtime" json:"execution_time,omitempty"`
+	// execution_height optionally defines the block height from which the
+	// messages of the proposal are executed once it passes.
+	ExecutionHeight int64 `protobuf:"varint,11,opt,name=execution_height,json=executionHeight,proto3" json:"execution_height,omitempty"`
 }
 
 func (m *MsgSubmitProposal) Reset()         { *m = MsgSubmitProposal{} }
@@ -145,6 +158,34 @@ func (m *MsgSubmitProposal) GetExpedited() bool {
 	return false
 }
 
+func (m *MsgSubmitProposal) GetProposalType() ProposalType {
+	if m != nil {
+		return m.ProposalType
+	}
+	return ProposalType_PROPOSAL_TYPE_UNSPECIFIED
+}
+
+func (m *MsgSubmitProposal) GetVoteOptions() *ProposalVoteOptions {
+	if m != nil {
+		return m.VoteOptions
+	}
+	return nil
+}
+
+func (m *MsgSubmitProposal) GetExecutionTime() *time.Time {
+	if m != nil {
+		return m.ExecutionTime
+	}
+	return nil
+}
+
+func (m *MsgSubmitProposal) GetExecutionHeight() int64 {
+	if m != nil {
+		return m.ExecutionHeight
+	}
+	return 0
+}
+
 // MsgSubmitProposalResponse defines the Msg/SubmitProposal response type.
 type MsgSubmitProposalResponse struct {
 	// proposal_id defines the unique id of the proposal.
@@ -828,6 +869,99 @@ func (m *MsgCancelProposalResponse) GetCanceledHeight() uint64 {
 	return 0
 }
 
+// MsgCancelScheduledExecution is the Msg/CancelScheduledExecution request type.
+type MsgCancelScheduledExecution struct {
+	// authority is the address that controls the module (defaults to x/gov unless overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// proposal_id defines the unique id of the scheduled proposal.
+	ProposalId uint64 `protobuf:"varint,2,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id"`
+}
+
+func (m *MsgCancelScheduledExecution) Reset()         { *m = MsgCancelScheduledExecution{} }
+func (m *MsgCancelScheduledExecution) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelScheduledExecution) ProtoMessage()    {}
+func (*MsgCancelScheduledExecution) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9ff8f4a63b6fc9a9, []int{14}
+}
+func (m *MsgCancelScheduledExecution) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelScheduledExecution) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelScheduledExecution.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelScheduledExecution) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelScheduledExecution.Merge(m, src)
+}
+func (m *MsgCancelScheduledExecution) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelScheduledExecution) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelScheduledExecution.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelScheduledExecution proto.InternalMessageInfo
+
+func (m *MsgCancelScheduledExecution) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgCancelScheduledExecution) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
+	}
+	return 0
+}
+
+// MsgCancelScheduledExecutionResponse defines the response structure for
+// executing a MsgCancelScheduledExecution message.
+type MsgCancelScheduledExecutionResponse struct {
+}
+
+func (m *MsgCancelScheduledExecutionResponse) Reset()         { *m = MsgCancelScheduledExecutionResponse{} }
+func (m *MsgCancelScheduledExecutionResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelScheduledExecutionResponse) ProtoMessage()    {}
+func (*MsgCancelScheduledExecutionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9ff8f4a63b6fc9a9, []int{15}
+}
+func (m *MsgCancelScheduledExecutionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelScheduledExecutionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelScheduledExecutionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelScheduledExecutionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelScheduledExecutionResponse.Merge(m, src)
+}
+func (m *MsgCancelScheduledExecutionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelScheduledExecutionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelScheduledExecutionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelScheduledExecutionResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgSubmitProposal)(nil), "cosmos.gov.v1.MsgSubmitProposal")
 	proto.RegisterType((*MsgSubmitProposalResponse)(nil), "cosmos.gov.v1.MsgSubmitProposalResponse")
@@ -843,80 +977,92 @@ func init() {
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "cosmos.gov.v1.MsgUpdateParamsResponse")
 	proto.RegisterType((*MsgCancelProposal)(nil), "cosmos.gov.v1.MsgCancelProposal")
 	proto.RegisterType((*MsgCancelProposalResponse)(nil), "cosmos.gov.v1.MsgCancelProposalResponse")
+	proto.RegisterType((*MsgCancelScheduledExecution)(nil), "cosmos.gov.v1.MsgCancelScheduledExecution")
+	proto.RegisterType((*MsgCancelScheduledExecutionResponse)(nil), "cosmos.gov.v1.MsgCancelScheduledExecutionResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/gov/v1/tx.proto", fileDescriptor_9ff8f4a63b6fc9a9) }
 
 var fileDescriptor_9ff8f4a63b6fc9a9 = []byte{
-	// 1076 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x56, 0xcd, 0x6f, 0x1b, 0x45,
-	0x14, 0xcf, 0xe6, 0xc3, 0x4e, 0x26, 0x5f, 0xca, 0xca, 0x6d, 0xd7, 0xab, 0xb2, 0x76, 0xb7, 0x08,
-	0xac, 0x84, 0xec, 0xe2, 0x40, 0x2b, 0x64, 0x2a, 0xa4, 0x3a, 0x14, 0xa8, 0x84, 0xa1, 0xda, 0x42,
-	0x91, 0x10, 0x92, 0xb5, 0xf6, 0x0e, 0x9b, 0x15, 0xde, 0x9d, 0x95, 0x67, 0x6c, 0xc5, 0x37, 0xc4,
-	0x31, 0xa7, 0x9e, 0xb9, 0x23, 0x21, 0x4e, 0x39, 0xf4, 0xd6, 0x13, 0xb7, 0x8a, 0x53, 0xc5, 0x89,
-	0x53, 0x8b, 0x12, 0x41, 0x10, 0xff, 0x04, 0x68, 0x3e, 0x76, 0xbd, 0x5f, 0x71, 0x0a, 0x87, 0x5e,
-	0x12, 0xcf, 0xfb, 0x9a, 0xf7, 0xfb, 0xbd, 0x79, 0xef, 0x2d, 0xb8, 0xdc, 0x47, 0xd8, 0x47, 0xd8,
-	0x74, 0xd1, 0xd8, 0x1c, 0x37, 0x4d, 0x72, 0x68, 0x84, 0x43, 0x44, 0x90, 0xbc, 0xce, 0xe5, 0x86,
-	0x8b, 0xc6, 0xc6, 0xb8, 0xa9, 0x6a, 0xc2, 0xac, 0x67, 0x63, 0x68, 0x8e, 0x9b, 0x3d, 0x48, 0xec,
-	0xa6, 0xd9, 0x47, 0x5e, 0xc0, 0xcd, 0xd5, 0x2b, 0xe9, 0x30, 0xd4, 0x8b, 0x2b, 0x2a, 0x2e, 0x72,
-	0x11, 0xfb, 0x69, 0xd2, 0x5f, 0x42, 0x5a, 0xe5, 0xe6, 0x5d, 0xae, 0x10, 0x57, 0x09, 0x95, 0x8b,
-	0x90, 0x3b, 0x80, 0x26, 0x3b, 0xf5, 0x46, 0x5f, 0x9b, 0x76, 0x30, 0xc9, 0x5c, 0xe2, 0x63, 0x97,
-	0x5e, 0xe2, 0x63, 0x57, 0x28, 0xb6, 0x6c, 0xdf, 0x0b, 0x90, 0xc9, 0xfe, 0x0a, 0x51, 0x2d, 0x1b,
-	0x86, 0x78, 0x3e, 0xc4, 0xc4, 0xf6, 0x43, 0x6e, 0xa0, 0xff, 0xb0, 0x00, 0xb6, 0x3a, 0xd8, 0xbd,
-	0x3f, 0xea, 0xf9, 0x1e, 0xb9, 0x37, 0x44, 0x21, 0xc2, 0xf6, 0x40, 0x7e, 0x13, 0x2c, 0xfb, 0x10,
-	0x63, 0xdb, 0x85, 0x58, 0x91, 0xea, 0x0b, 0x8d, 0xd5, 0xbd, 0x8a, 0xc1, 0x23, 0x19, 0x51, 0x24,
-	0xe3, 0x76, 0x30, 0xb1, 0x62, 0x2b, 0xf9, 0x48, 0x02, 0x9b, 0x5e, 0xe0, 0x11, 0xcf, 0x1e, 0x74,
-	0x1d, 0x18, 0x22, 0xec, 0x11, 0x65, 0x9e, 0x79, 0x56, 0x0d, 0x01, 0x8c, 0x92, 0x66, 0x08, 0xd2,
-	0x8c, 0x7d, 0xe4, 0x05, 0xed, 0x0f, 0x9e, 0x3c, 0xab, 0xcd, 0xfd, 0xf4, 0xbc, 0xd6, 0x70, 0x3d,
-	0x72, 0x30, 0xea, 0x19, 0x7d, 0xe4, 0x0b, 0x16, 0xc4, 0xbf, 0x5d, 0xec, 0x7c, 0x63, 0x92, 0x49,
-	0x08, 0x31, 0x73, 0xc0, 0xdf, 0x9f, 0x1d, 0x6f, 0xaf, 0x0d, 0xa0, 0x6b, 0xf7, 0x27, 0x5d, 0x4a,
-	0x3b, 0xfe, 0xf1, 0xec, 0x78, 0x5b, 0xb2, 0x36, 0xc4, 0xcd, 0xef, 0xf3, 0x8b, 0xe5, 0xb7, 0xc1,
-	0x72, 0xc8, 0xa0, 0xc0, 0xa1, 0xb2, 0x50, 0x97, 0x1a, 0x2b, 0x6d, 0xe5, 0xd7, 0x47, 0xbb, 0x15,
-	0x91, 0xc7, 0x6d, 0xc7, 0x19, 0x42, 0x8c, 0xef, 0x93, 0xa1, 0x17, 0xb8, 0x56, 0x6c, 0x29, 0xab,
-	0x14, 0x34, 0xb1, 0x1d, 0x9b, 0xd8, 0xca, 0x22, 0xf5, 0xb2, 0xe2, 0xb3, 0x5c, 0x01, 0x4b, 0xc4,
-	0x23, 0x03, 0xa8, 0x2c, 0x31, 0x05, 0x3f, 0xc8, 0x0a, 0x28, 0xe3, 0x91, 0xef, 0xdb, 0xc3, 0x89,
-	0x52, 0x62, 0xf2, 0xe8, 0x28, 0x5f, 0x05, 0x2b, 0xf0, 0x30, 0x84, 0x8e, 0x47, 0xa0, 0xa3, 0x94,
-	0xeb, 0x52, 0x63, 0xd9, 0x9a, 0x0a, 0x5a, 0xcd, 0xef, 0xce, 0x8e, 0xb7, 0xe3, 0x8b, 0x8f, 0xce,
-	0x8e, 0xb7, 0x6b, 0x09, 0xbc, 0xe3, 0xa6, 0x99, 0xab, 0x88, 0x7e, 0x0b, 0x54, 0x73, 0x42, 0x0b,
-	0xe2, 0x10, 0x05, 0x18, 0xca, 0x35, 0xb0, 0x1a, 0x0a, 0x59, 0xd7, 0x73, 0x14, 0xa9, 0x2e, 0x35,
-	0x16, 0x2d, 0x10, 0x89, 0xee, 0x3a, 0xfa, 0x63, 0x09, 0x54, 0x3a, 0xd8, 0xbd, 0x73, 0x08, 0xfb,
-	0x1f, 0x33, 0xf6, 0xf6, 0x51, 0x40, 0x60, 0x40, 0xe4, 0x4f, 0x40, 0xb9, 0xcf, 0x7f, 0x32, 0xaf,
-	0x73, 0xea, 0xdc, 0xd6, 0x7e, 0x79, 0xb4, 0xab, 0xa6, 0x5a, 0x21, 0xaa, 0x22, 0xf3, 0xb5, 0xa2,
-	0x20, 0x14, 0xb7, 0x3d, 0x22, 0x07, 0x68, 0xe8, 0x91, 0x89, 0x32, 0xcf, 0x38, 0x99, 0x0a, 0x5a,
-	0x37, 0x28, 0xee, 0xe9, 0x99, 0x02, 0xd7, 0x73, 0xc0, 0x73, 0x49, 0xea, 0x1a, 0xb8, 0x5a, 0x24,
-	0x8f, 0xe0, 0xeb, 0x7f, 0x48, 0xa0, 0xdc, 0xc1, 0xee, 0x03, 0x44, 0xa0, 0x7c, 0xa3, 0x80, 0x8a,
-	0x76, 0xe5, 0xef, 0x67, 0xb5, 0xa4, 0x98, 0xbf, 0x9a, 0x04, 0x41, 0xb2, 0x01, 0x96, 0xc6, 0x88,
-	0xc0, 0x21, 0xcf, 0x79, 0xc6, 0x73, 0xe1, 0x66, 0x72, 0x13, 0x94, 0x50, 0x48, 0x3c, 0x14, 0xb0,
-	0xf7, 0xb5, 0x31, 0x7d, 0xe4, 0x9c, 0x1d, 0x83, 0xe6, 0xf2, 0x29, 0x33, 0xb0, 0x84, 0xe1, 0xac,
-	0xe7, 0xd5, 0x7a, 0x95, 0x12, 0xc3, 0x43, 0x53, 0x52, 0x2e, 0xe5, 0x48, 0xa1, 0xf1, 0xf4, 0x2d,
-	0xb0, 0x29, 0x7e, 0xc6, 0xd0, 0xff, 0x91, 0x62, 0xd9, 0x17, 0xd0, 0x73, 0x0f, 0x08, 0x74, 0x5e,
-	0x16, 0x05, 0xef, 0x82, 0x32, 0x47, 0x86, 0x95, 0x05, 0xd6, 0xe8, 0xd7, 0x32, 0x1c, 0x44, 0x09,
-	0x25, 0xb8, 0x88, 0x3c, 0x66, 0x92, 0xf1, 0x46, 0x9a, 0x8c, 0x57, 0x0a, 0xc9, 0x88, 0x82, 0xeb,
-	0x55, 0x70, 0x25, 0x23, 0x8a, 0xc9, 0xf9, 0x53, 0x02, 0xa0, 0x83, 0xdd, 0x68, 0x2a, 0xfc, 0x4f,
-	0x5e, 0x6e, 0x82, 0x15, 0x31, 0xd0, 0xd0, 0xc5, 0xdc, 0x4c, 0x4d, 0xe5, 0x5b, 0xa0, 0x64, 0xfb,
-	0x68, 0x14, 0x10, 0x41, 0xcf, 0x8c, 0x39, 0xb8, 0x42, 0xe7, 0x20, 0xbf, 0x59, 0xf8, 0xb4, 0x76,
-	0x58, 0xab, 0xc4, 0xd1, 0x28, 0x11, 0x4a, 0x8e, 0x08, 0x81, 0x4c, 0xaf, 0x00, 0x79, 0x7a, 0x8a,
-	0xe1, 0x3f, 0xe6, 0x6f, 0xe3, 0xf3, 0xd0, 0xb1, 0x09, 0xbc, 0x67, 0x0f, 0x6d, 0x1f, 0x53, 0x30,
-	0xd3, 0xfe, 0x94, 0x2e, 0x02, 0x13, 0x9b, 0xca, 0xef, 0x80, 0x52, 0xc8, 0x22, 0x30, 0x06, 0x56,
-	0xf7, 0x2e, 0x65, 0x6a, 0xcd, 0xc3, 0xa7, 0x80, 0x70, 0xfb, 0xd6, 0xcd, 0x7c, 0xcf, 0x5f, 0x4f,
-	0x00, 0x39, 0x8c, 0x76, 0x65, 0x26, 0x53, 0x51, 0xd7, 0xa4, 0x28, 0x06, 0x76, 0x24, 0xb1, 0x9d,
-	0xb5, 0x6f, 0x07, 0x7d, 0x38, 0x48, 0xec, 0xac, 0x82, 0xf2, 0x6e, 0x66, 0xca, 0x9b, 0xaa, 0x6c,
-	0x72, 0x4d, 0xcc, 0xbf, 0xe8, 0x9a, 0x68, 0xad, 0xa7, 0x86, 0xb7, 0xfe, 0xb3, 0xc4, 0x26, 0x73,
-	0x3a, 0x99, 0x78, 0x32, 0xff, 0xf7, 0xa4, 0xee, 0x82, 0xf5, 0x3e, 0x8b, 0x05, 0x9d, 0x2e, 0x5d,
-	0xd6, 0x82, 0x70, 0x35, 0x37, 0x97, 0x3f, 0x8b, 0x36, 0x79, 0x7b, 0x99, 0xb2, 0xfe, 0xf0, 0x79,
-	0x4d, 0xb2, 0xd6, 0x22, 0x57, 0xaa, 0x94, 0x5f, 0x07, 0x9b, 0x71, 0xa8, 0x03, 0xd6, 0x1c, 0x6c,
-	0x5a, 0x2d, 0x5a, 0x1b, 0x91, 0xf8, 0x23, 0x26, 0xdd, 0xfb, 0x6b, 0x11, 0x2c, 0x74, 0xb0, 0x2b,
-	0x7f, 0x05, 0x36, 0x32, 0x1f, 0x02, 0xf5, 0x4c, 0x9d, 0x73, 0x3b, 0x48, 0x6d, 0x5c, 0x64, 0x11,
-	0x73, 0x01, 0xc1, 0x56, 0x7e, 0x01, 0x5d, 0xcf, 0xbb, 0xe7, 0x8c, 0xd4, 0x9d, 0x17, 0x30, 0x8a,
-	0xaf, 0x79, 0x0f, 0x2c, 0xb2, 0x4d, 0x70, 0x39, 0xef, 0x44, 0xe5, 0xaa, 0x56, 0x2c, 0x8f, 0xfd,
-	0x1f, 0x80, 0xb5, 0xd4, 0x38, 0x3d, 0xc7, 0x3e, 0xd2, 0xab, 0xaf, 0xcd, 0xd6, 0xc7, 0x71, 0x3f,
-	0x04, 0xe5, 0x68, 0x12, 0x55, 0xf3, 0x2e, 0x42, 0xa5, 0x5e, 0x3b, 0x57, 0x95, 0x4c, 0x30, 0xd5,
-	0xd3, 0x05, 0x09, 0x26, 0xf5, 0x45, 0x09, 0x16, 0xb5, 0x15, 0xad, 0x7e, 0xa6, 0xa5, 0x0a, 0xaa,
-	0x9f, 0xb6, 0x28, 0xaa, 0x7e, 0x71, 0x27, 0xa8, 0x4b, 0xdf, 0xd2, 0xb1, 0xd0, 0xbe, 0xf3, 0xe4,
-	0x44, 0x93, 0x9e, 0x9e, 0x68, 0xd2, 0xef, 0x27, 0x9a, 0xf4, 0xf0, 0x54, 0x9b, 0x7b, 0x7a, 0xaa,
-	0xcd, 0xfd, 0x76, 0xaa, 0xcd, 0x7d, 0xb9, 0x33, 0xf3, 0x23, 0x90, 0xcf, 0x09, 0xf6, 0x29, 0x48,
-	0x3f, 0xbc, 0x4b, 0xac, 0x0d, 0xde, 0xfa, 0x37, 0x00, 0x00, 0xff, 0xff, 0xa6, 0x6f, 0x84, 0xd5,
-	0xb8, 0x0b, 0x00, 0x00,
+	// 1237 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0xcd, 0x6f, 0x1b, 0x45,
+	0x14, 0xcf, 0xda, 0x71, 0x9c, 0x8c, 0x13, 0x87, 0xac, 0xdc, 0x76, 0xbd, 0x2d, 0xb6, 0xbb, 0x2d,
+	0xc5, 0x24, 0x64, 0x8d, 0x0d, 0x2d, 0xc8, 0x54, 0x88, 0x3a, 0x84, 0x52, 0x89, 0x40, 0xb5, 0x29,
+	0x45, 0x42, 0x95, 0xac, 0x8d, 0x77, 0xd8, 0xac, 0xf0, 0xee, 0xac, 0x3c, 0x63, 0x2b, 0xe6, 0x84,
+	0x38, 0xe6, 0xd4, 0x33, 0x47, 0x4e, 0x88, 0x53, 0x0e, 0xbd, 0xf5, 0xc4, 0x01, 0xa9, 0xe2, 0x54,
+	0x71, 0xe2, 0xd4, 0xa2, 0x04, 0x88, 0xc4, 0x3f, 0x01, 0x9a, 0xd9, 0xd9, 0xb1, 0xbd, 0xeb, 0x8f,
+	0xd0, 0x03, 0x97, 0x76, 0xe7, 0xbd, 0xdf, 0x7b, 0x33, 0xef, 0xf7, 0xbe, 0x62, 0x70, 0xbe, 0x85,
+	0xb0, 0x8b, 0x70, 0xc5, 0x46, 0xbd, 0x4a, 0xaf, 0x5a, 0x21, 0x07, 0xba, 0xdf, 0x41, 0x04, 0xc9,
+	0x2b, 0x81, 0x5c, 0xb7, 0x51, 0x4f, 0xef, 0x55, 0xd5, 0x02, 0x87, 0xed, 0x99, 0x18, 0x56, 0x7a,
+	0xd5, 0x3d, 0x48, 0xcc, 0x6a, 0xa5, 0x85, 0x1c, 0x2f, 0x80, 0xab, 0x17, 0x46, 0xdd, 0x50, 0xab,
+	0x40, 0x91, 0xb3, 0x91, 0x8d, 0xd8, 0x67, 0x85, 0x7e, 0x71, 0x69, 0x3e, 0x80, 0x37, 0x03, 0x05,
+	0xbf, 0x8a, 0xab, 0x6c, 0x84, 0xec, 0x36, 0xac, 0xb0, 0xd3, 0x5e, 0xf7, 0xcb, 0x8a, 0xe9, 0xf5,
+	0x23, 0x97, 0xb8, 0xd8, 0xa6, 0x97, 0xb8, 0xd8, 0xe6, 0x8a, 0x35, 0xd3, 0x75, 0x3c, 0x54, 0x61,
+	0xff, 0x72, 0x51, 0x31, 0xea, 0x86, 0x38, 0x2e, 0xc4, 0xc4, 0x74, 0xfd, 0x00, 0xa0, 0x7d, 0x9f,
+	0x02, 0x6b, 0x3b, 0xd8, 0xde, 0xed, 0xee, 0xb9, 0x0e, 0xb9, 0xdb, 0x41, 0x3e, 0xc2, 0x66, 0x5b,
+	0x7e, 0x03, 0x2c, 0xba, 0x10, 0x63, 0xd3, 0x86, 0x58, 0x91, 0x4a, 0xc9, 0x72, 0xa6, 0x96, 0xd3,
+	0x03, 0x4f, 0x7a, 0xe8, 0x49, 0xbf, 0xe5, 0xf5, 0x0d, 0x81, 0x92, 0x0f, 0x25, 0xb0, 0xea, 0x78,
+	0x0e, 0x71, 0xcc, 0x76, 0xd3, 0x82, 0x3e, 0xc2, 0x0e, 0x51, 0x12, 0xcc, 0x32, 0xaf, 0xf3, 0xc0,
+	0x28, 0x69, 0x3a, 0x27, 0x4d, 0xdf, 0x42, 0x8e, 0xd7, 0xf8, 0xf0, 0xc9, 0xb3, 0xe2, 0xdc, 0x8f,
+	0xcf, 0x8b, 0x65, 0xdb, 0x21, 0xfb, 0xdd, 0x3d, 0xbd, 0x85, 0x5c, 0xce, 0x02, 0xff, 0x6f, 0x13,
+	0x5b, 0x5f, 0x55, 0x48, 0xdf, 0x87, 0x98, 0x19, 0xe0, 0xef, 0x4e, 0x8f, 0xd6, 0x97, 0xdb, 0xd0,
+	0x36, 0x5b, 0xfd, 0x26, 0xa5, 0x1d, 0xff, 0x70, 0x7a, 0xb4, 0x2e, 0x19, 0x59, 0x7e, 0xf3, 0x07,
+	0xc1, 0xc5, 0xf2, 0x5b, 0x60, 0xd1, 0x67, 0xa1, 0xc0, 0x8e, 0x92, 0x2c, 0x49, 0xe5, 0xa5, 0x86,
+	0xf2, 0xeb, 0xa3, 0xcd, 0x1c, 0x7f, 0xc7, 0x2d, 0xcb, 0xea, 0x40, 0x8c, 0x77, 0x49, 0xc7, 0xf1,
+	0x6c, 0x43, 0x20, 0x65, 0x95, 0x06, 0x4d, 0x4c, 0xcb, 0x24, 0xa6, 0x32, 0x4f, 0xad, 0x0c, 0x71,
+	0x96, 0x73, 0x20, 0x45, 0x1c, 0xd2, 0x86, 0x4a, 0x8a, 0x29, 0x82, 0x83, 0xac, 0x80, 0x34, 0xee,
+	0xba, 0xae, 0xd9, 0xe9, 0x2b, 0x0b, 0x4c, 0x1e, 0x1e, 0xe5, 0x4b, 0x60, 0x09, 0x1e, 0xf8, 0xd0,
+	0x72, 0x08, 0xb4, 0x94, 0x74, 0x49, 0x2a, 0x2f, 0x1a, 0x03, 0x81, 0xfc, 0x3e, 0x58, 0xf1, 0x39,
+	0xd5, 0x4d, 0x1a, 0x9d, 0xb2, 0x58, 0x92, 0xca, 0xd9, 0xda, 0x45, 0x7d, 0xa4, 0xda, 0xf4, 0x30,
+	0x1d, 0xf7, 0xfa, 0x3e, 0x34, 0x96, 0xfd, 0xa1, 0x93, 0xbc, 0x0d, 0x96, 0x7b, 0x88, 0xc0, 0x26,
+	0xf2, 0x89, 0x83, 0x3c, 0xac, 0x2c, 0x95, 0xa4, 0x72, 0xa6, 0xa6, 0x4d, 0x70, 0x70, 0x1f, 0x11,
+	0xf8, 0x69, 0x80, 0x34, 0x32, 0xbd, 0xc1, 0x41, 0xbe, 0x0d, 0xb2, 0xf0, 0x00, 0xb6, 0xba, 0xf4,
+	0xd4, 0xa4, 0xa5, 0xa1, 0x00, 0xe6, 0x48, 0x8d, 0x65, 0xfb, 0x5e, 0x58, 0x37, 0x8d, 0xf9, 0x87,
+	0xcf, 0x8b, 0x92, 0xb1, 0x22, 0xec, 0xa8, 0x46, 0x7e, 0x0d, 0xbc, 0x34, 0x70, 0xb4, 0x0f, 0x1d,
+	0x7b, 0x9f, 0x28, 0x99, 0x92, 0x54, 0x4e, 0x1a, 0xab, 0x42, 0xfe, 0x11, 0x13, 0xd7, 0xab, 0xdf,
+	0x9e, 0x1e, 0xad, 0x0b, 0xd6, 0x0f, 0x4f, 0x8f, 0xd6, 0x8b, 0x43, 0xc9, 0xee, 0x55, 0x2b, 0xb1,
+	0x72, 0xd4, 0x6e, 0x82, 0x7c, 0x4c, 0x68, 0x40, 0xec, 0x23, 0x0f, 0x43, 0xb9, 0x08, 0x32, 0x82,
+	0x4c, 0xc7, 0x52, 0xa4, 0x92, 0x54, 0x9e, 0x37, 0x40, 0x28, 0xba, 0x63, 0x69, 0x8f, 0x25, 0x90,
+	0xdb, 0xc1, 0xf6, 0xf6, 0x01, 0x6c, 0x7d, 0xcc, 0x4a, 0x67, 0x0b, 0x79, 0x04, 0x7a, 0x44, 0xfe,
+	0x04, 0xa4, 0x5b, 0xc1, 0x27, 0xb3, 0x9a, 0x50, 0xe4, 0x8d, 0xc2, 0x2f, 0x8f, 0x36, 0xd5, 0x11,
+	0x62, 0xc3, 0x12, 0x66, 0xb6, 0x46, 0xe8, 0x84, 0x26, 0xdd, 0xec, 0x92, 0x7d, 0xd4, 0x71, 0x48,
+	0x5f, 0x49, 0xb0, 0x82, 0x18, 0x08, 0xea, 0xd7, 0x69, 0xdc, 0x83, 0x33, 0x0d, 0x5c, 0x8b, 0x05,
+	0x1e, 0x7b, 0xa4, 0x56, 0x00, 0x97, 0xc6, 0xc9, 0xc3, 0xf0, 0xb5, 0x3f, 0x25, 0x90, 0xde, 0xc1,
+	0x36, 0x4d, 0xb1, 0x7c, 0x7d, 0x0c, 0x15, 0x8d, 0xdc, 0xdf, 0xcf, 0x8a, 0xc3, 0xe2, 0xa0, 0x65,
+	0x86, 0x08, 0x92, 0x75, 0x90, 0xa2, 0x45, 0xd1, 0x51, 0x12, 0x33, 0x7a, 0x25, 0x80, 0xc9, 0x55,
+	0xb0, 0x10, 0xd4, 0x1d, 0x6b, 0xae, 0xec, 0xa0, 0xc3, 0x79, 0xd9, 0x0d, 0xca, 0xcd, 0xe0, 0xc0,
+	0x69, 0xbd, 0x55, 0xbf, 0x4a, 0x89, 0x09, 0x5c, 0x53, 0x52, 0xce, 0xc5, 0x48, 0xa1, 0xfe, 0xb4,
+	0x35, 0xb0, 0xca, 0x3f, 0x45, 0xe8, 0xff, 0x48, 0x42, 0xf6, 0x39, 0xab, 0x2d, 0x68, 0xfd, 0x5f,
+	0x14, 0xbc, 0x0b, 0xd2, 0x61, 0xeb, 0x25, 0xd9, 0x94, 0xbb, 0x1c, 0xe1, 0x20, 0x7c, 0xd0, 0x10,
+	0x17, 0xa1, 0xc5, 0x54, 0x32, 0x5e, 0x1f, 0x25, 0xe3, 0xe5, 0xb1, 0x64, 0x84, 0xce, 0xb5, 0x3c,
+	0xb8, 0x10, 0x11, 0x09, 0x72, 0xfe, 0x92, 0x00, 0xd8, 0xc1, 0x76, 0x38, 0x12, 0x5f, 0x90, 0x97,
+	0x1b, 0x60, 0x89, 0x4f, 0x73, 0x34, 0x9b, 0x9b, 0x01, 0x54, 0xbe, 0x09, 0x16, 0x4c, 0x17, 0x75,
+	0x3d, 0xc2, 0xe9, 0x99, 0xb2, 0x04, 0x96, 0xe8, 0x12, 0x08, 0x6e, 0xe6, 0x36, 0xf5, 0x0d, 0xd6,
+	0x2a, 0xc2, 0x1b, 0x25, 0x42, 0x89, 0x11, 0xc1, 0x23, 0xd3, 0x72, 0x40, 0x1e, 0x9c, 0x44, 0xf8,
+	0x8f, 0x83, 0xda, 0xf8, 0xcc, 0xb7, 0x4c, 0x02, 0xef, 0x9a, 0x1d, 0xd3, 0xc5, 0x34, 0x98, 0x41,
+	0x7f, 0x4a, 0xb3, 0x82, 0x11, 0x50, 0xf9, 0x1d, 0xb0, 0xe0, 0x33, 0x0f, 0x8c, 0x81, 0x4c, 0xed,
+	0x5c, 0x74, 0xcc, 0x32, 0xe5, 0x48, 0x20, 0x01, 0xbe, 0x7e, 0x23, 0xde, 0xf3, 0x57, 0x86, 0x02,
+	0x39, 0x08, 0xff, 0x50, 0x88, 0xbc, 0x94, 0xe7, 0x75, 0x58, 0x24, 0x02, 0x3b, 0x94, 0xd8, 0xc2,
+	0xde, 0x32, 0xbd, 0x16, 0x6c, 0x0f, 0x2d, 0xec, 0x31, 0xe9, 0x5d, 0x8d, 0xa4, 0x77, 0x24, 0xb3,
+	0xc3, 0x3b, 0x32, 0x71, 0xd6, 0x1d, 0x59, 0x5f, 0x19, 0x19, 0xde, 0xda, 0x4f, 0x12, 0xc8, 0xc7,
+	0x1e, 0x23, 0x26, 0xf3, 0x7f, 0x7f, 0xd4, 0x1d, 0xb0, 0xd2, 0x62, 0xbe, 0xa0, 0x15, 0xac, 0xa3,
+	0xc4, 0xcc, 0x75, 0xb4, 0x48, 0x59, 0x67, 0x2b, 0x69, 0x39, 0x34, 0xa5, 0x4a, 0xf9, 0x55, 0xb0,
+	0x2a, 0x5c, 0xf1, 0x85, 0x94, 0x64, 0xab, 0x21, 0x1b, 0x8a, 0x83, 0x7d, 0xa4, 0xfd, 0x2c, 0x81,
+	0x8b, 0x22, 0x86, 0xdd, 0xd6, 0x3e, 0xb4, 0xba, 0x6d, 0x68, 0x6d, 0x87, 0x5b, 0xeb, 0x85, 0xab,
+	0x26, 0xd2, 0x71, 0x89, 0xb3, 0x75, 0x5c, 0xfd, 0xed, 0x78, 0xc9, 0x5c, 0x8d, 0xd5, 0xfe, 0x98,
+	0xc7, 0x6a, 0xaf, 0x80, 0x2b, 0x53, 0xc2, 0x08, 0x93, 0x52, 0xfb, 0x23, 0x05, 0x92, 0x3b, 0xd8,
+	0x96, 0x1f, 0x80, 0x6c, 0xe4, 0x8f, 0xbe, 0x52, 0xa4, 0xac, 0x63, 0x2b, 0x57, 0x2d, 0xcf, 0x42,
+	0x88, 0xd4, 0x43, 0xb0, 0x16, 0xdf, 0xb7, 0x57, 0xe2, 0xe6, 0x31, 0x90, 0xba, 0x71, 0x06, 0x90,
+	0xb8, 0xe6, 0x3d, 0x30, 0xcf, 0x16, 0xdf, 0xf9, 0xb8, 0x11, 0x95, 0xab, 0x85, 0xf1, 0x72, 0x61,
+	0x7f, 0x1f, 0x2c, 0x8f, 0x6c, 0x8f, 0x09, 0xf8, 0x50, 0xaf, 0x5e, 0x9b, 0xae, 0x17, 0x7e, 0x6f,
+	0x83, 0x74, 0x38, 0x78, 0xf3, 0x71, 0x13, 0xae, 0x52, 0x2f, 0x4f, 0x54, 0x0d, 0x3f, 0x70, 0x64,
+	0x84, 0x8d, 0x79, 0xe0, 0xb0, 0x5e, 0xbd, 0x36, 0x5d, 0x2f, 0xfc, 0x3e, 0x00, 0xd9, 0xc8, 0x04,
+	0x19, 0x93, 0xfd, 0x51, 0x84, 0x5a, 0x9e, 0x85, 0x10, 0xde, 0xbf, 0x06, 0xca, 0xc4, 0x76, 0x5a,
+	0x9f, 0xe4, 0x25, 0x8e, 0x55, 0x6b, 0x67, 0xc7, 0x86, 0x77, 0xab, 0xa9, 0x6f, 0x68, 0x4b, 0x35,
+	0xb6, 0x9f, 0x1c, 0x17, 0xa4, 0xa7, 0xc7, 0x05, 0xe9, 0xf7, 0xe3, 0x82, 0xf4, 0xf0, 0xa4, 0x30,
+	0xf7, 0xf4, 0xa4, 0x30, 0xf7, 0xdb, 0x49, 0x61, 0xee, 0x8b, 0x8d, 0xa9, 0x3f, 0x36, 0x82, 0x91,
+	0xcc, 0x7e, 0x72, 0xd0, 0x1f, 0x78, 0x0b, 0x6c, 0xe2, 0xbc, 0xf9, 0xef, 0x00, 0x6b, 0xd5, 0x54,
+	0x51, 0x20, 0x0e, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -951,6 +1097,9 @@ type MsgClient interface {
 	//
 	// Since: cosmos-sdk 0.50
 	CancelProposal(ctx context.Context, in *MsgCancelProposal, opts ...grpc.CallOption) (*MsgCancelProposalResponse, error)
+	// CancelScheduledExecution defines a governance operation for canceling the
+	// execution of a scheduled proposal. The authority is defined in the keeper.
+	CancelScheduledExecution(ctx context.Context, in *MsgCancelScheduledExecution, opts ...grpc.CallOption) (*MsgCancelScheduledExecutionResponse, error)
 }
 
 type msgClient struct {
@@ -1024,6 +1173,15 @@ func (c *msgClient) CancelProposal(ctx context.Context, in *MsgCancelProposal, o
 	return out, nil
 }
 
+func (c *msgClient) CancelScheduledExecution(ctx context.Context, in *MsgCancelScheduledExecution, opts ...grpc.CallOption) (*MsgCancelScheduledExecutionResponse, error) {
+	out := new(MsgCancelScheduledExecutionResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.gov.v1.Msg/CancelScheduledExecution", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// SubmitProposal defines a method to create new proposal given the messages.
@@ -1046,6 +1204,9 @@ type MsgServer interface {
 	//
 	// Since: cosmos-sdk 0.50
 	CancelProposal(context.Context, *MsgCancelProposal) (*MsgCancelProposalResponse, error)
+	// CancelScheduledExecution defines a governance operation for canceling the
+	// execution of a scheduled proposal. The authority is defined in the keeper.
+	CancelScheduledExecution(context.Context, *MsgCancelScheduledExecution) (*MsgCancelScheduledExecutionResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -1073,6 +1234,9 @@ func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateP
 func (*UnimplementedMsgServer) CancelProposal(ctx context.Context, req *MsgCancelProposal) (*MsgCancelProposalResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CancelProposal not implemented")
 }
+func (*UnimplementedMsgServer) CancelScheduledExecution(ctx context.Context, req *MsgCancelScheduledExecution) (*MsgCancelScheduledExecutionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelScheduledExecution not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -1204,6 +1368,24 @@ func _Msg_CancelProposal_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_CancelScheduledExecution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelScheduledExecution)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelScheduledExecution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.gov.v1.Msg/CancelScheduledExecution",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelScheduledExecution(ctx, req.(*MsgCancelScheduledExecution))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.gov.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -1236,6 +1418,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "CancelProposal",
 			Handler:    _Msg_CancelProposal_Handler,
 		},
+		{
+			MethodName: "CancelScheduledExecution",
+			Handler:    _Msg_CancelScheduledExecution_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/gov/v1/tx.proto",
@@ -1261,6 +1447,38 @@ func (m *MsgSubmitProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ExecutionHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ExecutionHeight))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.ExecutionTime != nil {
+		n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.ExecutionTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ExecutionTime):])
+		if err1 != nil {
+			return 0, err1
+		}
+		i -= n1
+		i = encodeVarintTx(dAtA, i, uint64(n1))
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.VoteOptions != nil {
+		{
+			size, err := m.VoteOptions.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.ProposalType != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ProposalType))
+		i--
+		dAtA[i] = 0x40
+	}
 	if m.Expedited {
 		i--
 		if m.Expedited {
@@ -1767,12 +1985,12 @@ func (m *MsgCancelProposalResponse) MarshalToSizedBuffer(dAtA []byte) (int, erro
 		i--
 		dAtA[i] = 0x18
 	}
-	n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CanceledTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CanceledTime):])
-	if err3 != nil {
-		return 0, err3
+	n5, err5 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CanceledTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CanceledTime):])
+	if err5 != nil {
+		return 0, err5
 	}
-	i -= n3
-	i = encodeVarintTx(dAtA, i, uint64(n3))
+	i -= n5
+	i = encodeVarintTx(dAtA, i, uint64(n5))
 	i--
 	dAtA[i] = 0x12
 	if m.ProposalId != 0 {
@@ -1783,6 +2001,64 @@ func (m *MsgCancelProposalResponse) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgCancelScheduledExecution) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelScheduledExecution) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelScheduledExecution) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelScheduledExecutionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelScheduledExecutionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelScheduledExecutionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -1831,6 +2107,20 @@ func (m *MsgSubmitProposal) Size() (n int) {
 	if m.Expedited {
 		n += 2
 	}
+	if m.ProposalType != 0 {
+		n += 1 + sovTx(uint64(m.ProposalType))
+	}
+	if m.VoteOptions != nil {
+		l = m.VoteOptions.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ExecutionTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ExecutionTime)
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ExecutionHeight != 0 {
+		n += 1 + sovTx(uint64(m.ExecutionHeight))
+	}
 	return n
 }
 
@@ -2027,6 +2317,31 @@ func (m *MsgCancelProposalResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgCancelScheduledExecution) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ProposalId != 0 {
+		n += 1 + sovTx(uint64(m.ProposalId))
+	}
+	return n
+}
+
+func (m *MsgCancelScheduledExecutionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -2278,6 +2593,116 @@ func (m *MsgSubmitProposal) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.Expedited = bool(v != 0)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalType", wireType)
+			}
+			m.ProposalType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalType |= ProposalType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoteOptions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.VoteOptions == nil {
+				m.VoteOptions = &ProposalVoteOptions{}
+			}
+			if err := m.VoteOptions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExecutionTime == nil {
+				m.ExecutionTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.ExecutionTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionHeight", wireType)
+			}
+			m.ExecutionHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExecutionHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3527,6 +3952,157 @@ func (m *MsgCancelProposalResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgCancelScheduledExecution) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelScheduledExecution: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelScheduledExecution: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelScheduledExecutionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelScheduledExecutionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelScheduledExecutionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 
@@ -22,6 +23,7 @@ const (
 	FlagExec               = "exec"
 	ExecTry                = "try"
 	FlagGroupPolicyAsAdmin = "group-policy-as-admin"
+	FlagGuardian           = "guardian"
 )
 
 var errZeroGroupID = errors.New("group id cannot be 0")
@@ -46,11 +48,13 @@ func TxCmd(name string, ac address.Codec) *cobra.Command {
 		MsgUpdateGroupPolicyAdminCmd(),
 		MsgUpdateGroupPolicyDecisionPolicyCmd(ac),
 		MsgUpdateGroupPolicyMetadataCmd(),
+		MsgUpdateGroupPolicyGuardianCmd(),
 		MsgWithdrawProposalCmd(),
 		MsgSubmitProposalCmd(),
 		MsgVoteCmd(),
 		MsgExecCmd(),
 		MsgLeaveGroupCmd(),
+		MsgVetoProposalCmd(),
 		NewCmdDraftProposal(),
 	)
 
@@ -567,6 +571,63 @@ func MsgUpdateGroupPolicyMetadataCmd() *cobra.Command {
 	return cmd
 }
 
+// MsgUpdateGroupPolicyGuardianCmd creates a CLI command for Msg/UpdateGroupPolicyGuardian.
+func MsgUpdateGroupPolicyGuardianCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-group-policy-guardian [admin] [group-policy-account] [timelock]",
+		Short: "Update a group policy guardian and timelock",
+		Long: `Update a group policy guardian and timelock.
+
+Accepted proposals of the group policy can only be executed once the timelock
+ended, and can be vetoed by the guardian until then. A zero timelock disables
+it, and the guardian is removed if the --guardian flag is omitted.
+
+Parameters:
+		   admin: account address of the group policy admin
+		   group-policy-account: account address of the group policy
+		   timelock: duration of the timelock of accepted proposals
+		   Note, the '--from' flag is ignored as it is implied from [admin]
+`,
+		Example: fmt.Sprintf(`
+%s tx group update-group-policy-guardian [admin] [group-policy-account] 48h --guardian [guardian]`, version.AppName),
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := cmd.Flags().Set(flags.FlagFrom, args[0]); err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			timelock, err := time.ParseDuration(args[2])
+			if err != nil {
+				return err
+			}
+
+			guardian, err := cmd.Flags().GetString(FlagGuardian)
+			if err != nil {
+				return err
+			}
+
+			msg := &group.MsgUpdateGroupPolicyGuardian{
+				Admin:              clientCtx.GetFromAddress().String(),
+				GroupPolicyAddress: args[1],
+				Guardian:           guardian,
+				Timelock:           timelock,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagGuardian, "", "Account address of the group policy guardian")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // MsgSubmitProposalCmd creates a CLI command for Msg/SubmitProposal.
 func MsgSubmitProposalCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -836,3 +897,45 @@ Parameters:
 
 	return cmd
 }
+
+// MsgVetoProposalCmd creates a CLI command for Msg/VetoProposal.
+func MsgVetoProposalCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "veto-proposal [proposal-id] [guardian]",
+		Short: "Veto an accepted proposal during its timelock",
+		Long: `Veto an accepted proposal during its timelock
+
+Parameters:
+		   proposal-id: unique ID of the proposal
+		   guardian: account address of the group policy guardian
+		   Note, the '--from' flag is ignored as it is implied from [guardian]
+		`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := cmd.Flags().Set(flags.FlagFrom, args[1]); err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			proposalID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := &group.MsgVetoProposal{
+				ProposalId: proposalID,
+				Guardian:   clientCtx.GetFromAddress().String(),
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
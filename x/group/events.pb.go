@@ -6,16 +6,21 @@ package group
 import (
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -468,7 +473,7 @@ func (m *EventLeaveGroup) GetAddress() string {
 type EventProposalPruned struct {
 	// proposal_id is the unique ID of the proposal.
 	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
-	// status is the proposal status (UNSPECIFIED, SUBMITTED, ACCEPTED, REJECTED, ABORTED, WITHDRAWN).
+	// status is the proposal status (UNSPECIFIED, SUBMITTED, ACCEPTED, REJECTED, ABORTED, WITHDRAWN, VETOED).
 	Status ProposalStatus `protobuf:"varint,2,opt,name=status,proto3,enum=cosmos.group.v1.ProposalStatus" json:"status,omitempty"`
 	// tally_result is the proposal tally result (when applicable).
 	TallyResult *TallyResult `protobuf:"bytes,3,opt,name=tally_result,json=tallyResult,proto3" json:"tally_result,omitempty"`
@@ -528,6 +533,118 @@ func (m *EventProposalPruned) GetTallyResult() *TallyResult {
 	return nil
 }
 
+// EventProposalTimelocked is an event emitted when a proposal of a group
+// policy with a timelock is accepted.
+type EventProposalTimelocked struct {
+	// proposal_id is the unique ID of the proposal.
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	// timelock_end is the timestamp before which the proposal can't be executed.
+	TimelockEnd time.Time `protobuf:"bytes,2,opt,name=timelock_end,json=timelockEnd,proto3,stdtime" json:"timelock_end"`
+}
+
+func (m *EventProposalTimelocked) Reset()         { *m = EventProposalTimelocked{} }
+func (m *EventProposalTimelocked) String() string { return proto.CompactTextString(m) }
+func (*EventProposalTimelocked) ProtoMessage()    {}
+func (*EventProposalTimelocked) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e8d753981546f032, []int{10}
+}
+func (m *EventProposalTimelocked) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventProposalTimelocked) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventProposalTimelocked.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventProposalTimelocked) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventProposalTimelocked.Merge(m, src)
+}
+func (m *EventProposalTimelocked) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventProposalTimelocked) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventProposalTimelocked.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventProposalTimelocked proto.InternalMessageInfo
+
+func (m *EventProposalTimelocked) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
+	}
+	return 0
+}
+
+func (m *EventProposalTimelocked) GetTimelockEnd() time.Time {
+	if m != nil {
+		return m.TimelockEnd
+	}
+	return time.Time{}
+}
+
+// EventVetoProposal is an event emitted when a proposal is vetoed by the
+// guardian of its group policy.
+type EventVetoProposal struct {
+	// proposal_id is the unique ID of the proposal.
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	// guardian is the account address of the group policy guardian.
+	Guardian string `protobuf:"bytes,2,opt,name=guardian,proto3" json:"guardian,omitempty"`
+}
+
+func (m *EventVetoProposal) Reset()         { *m = EventVetoProposal{} }
+func (m *EventVetoProposal) String() string { return proto.CompactTextString(m) }
+func (*EventVetoProposal) ProtoMessage()    {}
+func (*EventVetoProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e8d753981546f032, []int{11}
+}
+func (m *EventVetoProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventVetoProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventVetoProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventVetoProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventVetoProposal.Merge(m, src)
+}
+func (m *EventVetoProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventVetoProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventVetoProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventVetoProposal proto.InternalMessageInfo
+
+func (m *EventVetoProposal) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
+	}
+	return 0
+}
+
+func (m *EventVetoProposal) GetGuardian() string {
+	if m != nil {
+		return m.Guardian
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*EventCreateGroup)(nil), "cosmos.group.v1.EventCreateGroup")
 	proto.RegisterType((*EventUpdateGroup)(nil), "cosmos.group.v1.EventUpdateGroup")
@@ -539,40 +656,49 @@ func init() {
 	proto.RegisterType((*EventExec)(nil), "cosmos.group.v1.EventExec")
 	proto.RegisterType((*EventLeaveGroup)(nil), "cosmos.group.v1.EventLeaveGroup")
 	proto.RegisterType((*EventProposalPruned)(nil), "cosmos.group.v1.EventProposalPruned")
+	proto.RegisterType((*EventProposalTimelocked)(nil), "cosmos.group.v1.EventProposalTimelocked")
+	proto.RegisterType((*EventVetoProposal)(nil), "cosmos.group.v1.EventVetoProposal")
 }
 
 func init() { proto.RegisterFile("cosmos/group/v1/events.proto", fileDescriptor_e8d753981546f032) }
 
 var fileDescriptor_e8d753981546f032 = []byte{
-	// 442 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x93, 0x4f, 0xef, 0xd2, 0x30,
-	0x18, 0xc7, 0xe9, 0x4f, 0x02, 0x52, 0x8c, 0x98, 0xfa, 0x27, 0x03, 0xc9, 0x20, 0xc4, 0x44, 0x0e,
-	0xb2, 0x05, 0x4c, 0xd4, 0x93, 0x44, 0x0c, 0x31, 0x24, 0x1c, 0xc8, 0xf0, 0x4f, 0xe2, 0x05, 0xc7,
-	0xda, 0x8c, 0xc5, 0x41, 0x97, 0xb6, 0x9b, 0x70, 0xf4, 0x1d, 0xf8, 0x52, 0x3c, 0xf8, 0x22, 0x3c,
-	0x12, 0x4f, 0x1e, 0x0d, 0xbc, 0x11, 0xb3, 0xae, 0x03, 0x82, 0x31, 0x23, 0xf9, 0x9d, 0x68, 0xfb,
-	0xfd, 0x7c, 0xbf, 0x3c, 0x4f, 0x9f, 0x15, 0xd6, 0x1d, 0xca, 0x97, 0x94, 0x9b, 0x2e, 0xa3, 0x61,
-	0x60, 0x46, 0x5d, 0x93, 0x44, 0x64, 0x25, 0xb8, 0x11, 0x30, 0x2a, 0x28, 0xaa, 0x24, 0xaa, 0x21,
-	0x55, 0x23, 0xea, 0xd6, 0xaa, 0xc9, 0xc1, 0x4c, 0xca, 0xa6, 0x52, 0xe5, 0xa6, 0xf6, 0xf0, 0x3c,
-	0x49, 0x6c, 0x02, 0xa2, 0xc4, 0x56, 0x07, 0xde, 0x19, 0xc6, 0xc1, 0xaf, 0x19, 0xb1, 0x05, 0x79,
-	0x13, 0x23, 0xa8, 0x0a, 0x6f, 0x4a, 0x76, 0xe6, 0x61, 0x0d, 0x34, 0x41, 0x3b, 0x6f, 0x15, 0xe5,
-	0x7e, 0x84, 0x0f, 0xf8, 0xbb, 0x00, 0x5f, 0x82, 0x8f, 0xe1, 0x83, 0xf3, 0xf4, 0x09, 0xf5, 0x3d,
-	0x67, 0x83, 0x7a, 0xb0, 0x68, 0x63, 0xcc, 0x08, 0xe7, 0xd2, 0x53, 0x1a, 0x68, 0xbf, 0x7e, 0x74,
-	0xee, 0xa9, 0xba, 0x5f, 0x25, 0xca, 0x54, 0x30, 0x6f, 0xe5, 0x5a, 0x29, 0x78, 0x48, 0x3b, 0xf9,
-	0xf3, 0x6b, 0xa4, 0x3d, 0x83, 0x77, 0x65, 0xda, 0x34, 0x9c, 0x2f, 0x3d, 0x31, 0x61, 0x34, 0xa0,
-	0xdc, 0xf6, 0x51, 0x03, 0x96, 0x03, 0xb5, 0x3e, 0x36, 0x04, 0xd3, 0xa3, 0x11, 0x6e, 0xbd, 0x80,
-	0xf7, 0xa5, 0xef, 0x83, 0x27, 0x16, 0x98, 0xd9, 0x5f, 0x2e, 0x77, 0x3e, 0x81, 0x25, 0xe9, 0x7c,
-	0x4f, 0x05, 0xc9, 0xa6, 0xbf, 0x02, 0x85, 0x0f, 0xd7, 0xc4, 0xc9, 0xc4, 0x51, 0x1f, 0x16, 0x18,
-	0xe1, 0xa1, 0x2f, 0xb4, 0xab, 0x26, 0x68, 0xdf, 0xee, 0x3d, 0x36, 0xce, 0x3e, 0x11, 0x23, 0x2d,
-	0x34, 0xce, 0x0b, 0x05, 0x65, 0x96, 0xc4, 0x2d, 0x65, 0x43, 0x08, 0xe6, 0x7d, 0xea, 0x72, 0xed,
-	0x46, 0x7c, 0x81, 0x96, 0x5c, 0xb7, 0x3e, 0xc1, 0x8a, 0x2c, 0x61, 0x4c, 0xec, 0x28, 0x73, 0xda,
-	0xa7, 0x53, 0xb8, 0xba, 0x74, 0x0a, 0xdf, 0x81, 0x1a, 0x43, 0x5a, 0xdd, 0x84, 0x85, 0x2b, 0x82,
-	0xb3, 0xfb, 0x7d, 0x0e, 0x0b, 0x5c, 0xd8, 0x22, 0xe4, 0xaa, 0xdf, 0xc6, 0x7f, 0xfb, 0x9d, 0x4a,
-	0xcc, 0x52, 0x38, 0xea, 0xc3, 0x5b, 0xc2, 0xf6, 0xfd, 0xcd, 0x4c, 0x5d, 0x57, 0xdc, 0x6f, 0xb9,
-	0x57, 0xff, 0xc7, 0xfe, 0x36, 0x86, 0xd4, 0x1d, 0x95, 0xc5, 0x71, 0x33, 0x78, 0xf9, 0x73, 0xa7,
-	0x83, 0xed, 0x4e, 0x07, 0x7f, 0x76, 0x3a, 0xf8, 0xb6, 0xd7, 0x73, 0xdb, 0xbd, 0x9e, 0xfb, 0xbd,
-	0xd7, 0x73, 0x1f, 0x1f, 0xb9, 0x9e, 0x58, 0x84, 0x73, 0xc3, 0xa1, 0x4b, 0xf5, 0x04, 0xd5, 0x4f,
-	0x87, 0xe3, 0xcf, 0xe6, 0x3a, 0x79, 0x81, 0xf3, 0x82, 0x7c, 0x79, 0x4f, 0xff, 0x06, 0x00, 0x00,
-	0xff, 0xff, 0xa5, 0x1a, 0x1c, 0xb9, 0xe2, 0x03, 0x00, 0x00,
+	// 548 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x54, 0x41, 0x6f, 0xd3, 0x30,
+	0x18, 0xad, 0xc7, 0xb4, 0x75, 0xce, 0xc4, 0x20, 0x0c, 0xe8, 0xca, 0x94, 0x4c, 0x11, 0x12, 0x3b,
+	0xd0, 0x44, 0x2b, 0x08, 0x38, 0x31, 0x51, 0x54, 0x4d, 0x93, 0x76, 0xa8, 0xd2, 0x02, 0x12, 0x97,
+	0xe2, 0xc6, 0x26, 0x0b, 0x4b, 0xe2, 0xc8, 0x76, 0xca, 0x7a, 0x44, 0xfc, 0x81, 0xfd, 0x14, 0x0e,
+	0xfc, 0x88, 0x1d, 0x27, 0x4e, 0x9c, 0x00, 0xb5, 0x7f, 0x04, 0xc5, 0x71, 0xba, 0x51, 0x84, 0x12,
+	0x89, 0x53, 0x6c, 0x7f, 0xef, 0xbd, 0xbc, 0xe7, 0xcf, 0x36, 0xdc, 0xf6, 0x28, 0x8f, 0x28, 0x77,
+	0x7c, 0x46, 0xd3, 0xc4, 0x19, 0xef, 0x39, 0x64, 0x4c, 0x62, 0xc1, 0xed, 0x84, 0x51, 0x41, 0xf5,
+	0x8d, 0xbc, 0x6a, 0xcb, 0xaa, 0x3d, 0xde, 0x6b, 0x6e, 0xe5, 0x0b, 0x43, 0x59, 0x76, 0x54, 0x55,
+	0x4e, 0x9a, 0xf7, 0x16, 0x95, 0xc4, 0x24, 0x21, 0x45, 0x71, 0xd3, 0xa7, 0x3e, 0xcd, 0x49, 0xd9,
+	0x48, 0xad, 0x9a, 0x3e, 0xa5, 0x7e, 0x48, 0x1c, 0x39, 0x1b, 0xa5, 0xef, 0x1d, 0x11, 0x44, 0x84,
+	0x0b, 0x14, 0x25, 0x39, 0xc0, 0x6a, 0xc1, 0x1b, 0xdd, 0xcc, 0xcf, 0x4b, 0x46, 0x90, 0x20, 0x07,
+	0x99, 0xb2, 0xbe, 0x05, 0xeb, 0xf2, 0x17, 0xc3, 0x00, 0x37, 0xc0, 0x0e, 0xd8, 0x5d, 0x76, 0x57,
+	0xe5, 0xfc, 0x10, 0xcf, 0xe1, 0xaf, 0x12, 0x5c, 0x05, 0x7e, 0x04, 0xef, 0x2c, 0xaa, 0xf7, 0x68,
+	0x18, 0x78, 0x13, 0xbd, 0x0d, 0x57, 0x11, 0xc6, 0x8c, 0x70, 0x2e, 0x39, 0x6b, 0x9d, 0xc6, 0xb7,
+	0xaf, 0xad, 0x4d, 0x15, 0xf7, 0x45, 0x5e, 0xe9, 0x0b, 0x16, 0xc4, 0xbe, 0x5b, 0x00, 0xe7, 0x6a,
+	0x57, 0x7e, 0xfe, 0x1f, 0x6a, 0x4f, 0xe0, 0x2d, 0xa9, 0xd6, 0x4f, 0x47, 0x51, 0x20, 0x7a, 0x8c,
+	0x26, 0x94, 0xa3, 0x50, 0x37, 0xa1, 0x96, 0xa8, 0xf1, 0x65, 0x20, 0x58, 0x2c, 0x1d, 0x62, 0xeb,
+	0x19, 0xbc, 0x2d, 0x79, 0x6f, 0x02, 0x71, 0x8c, 0x19, 0xfa, 0x58, 0x9d, 0xf9, 0x10, 0xae, 0x49,
+	0xe6, 0x6b, 0x2a, 0x48, 0x39, 0xfa, 0x13, 0x50, 0xf0, 0xee, 0x29, 0xf1, 0x4a, 0xe1, 0xfa, 0x3e,
+	0x5c, 0x61, 0x84, 0xa7, 0xa1, 0x68, 0x2c, 0xed, 0x80, 0xdd, 0xeb, 0xed, 0x07, 0xf6, 0xc2, 0xc9,
+	0xb2, 0x0b, 0xa3, 0x99, 0x5e, 0x2a, 0x28, 0x73, 0x25, 0xdc, 0x55, 0x34, 0x5d, 0x87, 0xcb, 0x21,
+	0xf5, 0x79, 0xe3, 0x5a, 0xb6, 0x81, 0xae, 0x1c, 0x5b, 0xef, 0xe0, 0x86, 0xb4, 0x70, 0x44, 0xd0,
+	0xb8, 0xb4, 0xdb, 0x57, 0xbb, 0xb0, 0x54, 0xb5, 0x0b, 0x5f, 0x80, 0x6a, 0x43, 0xe1, 0xae, 0xc7,
+	0xd2, 0x98, 0xe0, 0xf2, 0xbc, 0x4f, 0xe1, 0x0a, 0x17, 0x48, 0xa4, 0x5c, 0xe5, 0x35, 0xff, 0x99,
+	0xb7, 0x2f, 0x61, 0xae, 0x82, 0xeb, 0xfb, 0x70, 0x5d, 0xa0, 0x30, 0x9c, 0x0c, 0xd5, 0x76, 0x65,
+	0x79, 0xb5, 0xf6, 0xf6, 0x5f, 0xf4, 0x41, 0x06, 0x52, 0x7b, 0xa4, 0x89, 0xcb, 0x89, 0xf5, 0x19,
+	0xc0, 0xbb, 0x7f, 0x58, 0x1e, 0x04, 0x11, 0x09, 0xa9, 0x77, 0x52, 0xc5, 0xf6, 0x01, 0x5c, 0x17,
+	0x0a, 0x3e, 0x24, 0x31, 0x96, 0xe6, 0xb5, 0x76, 0xd3, 0xce, 0xef, 0xa9, 0x5d, 0xdc, 0x53, 0x7b,
+	0x50, 0xdc, 0xd3, 0x4e, 0xfd, 0xfc, 0x87, 0x59, 0x3b, 0xfb, 0x69, 0x02, 0x57, 0x2b, 0x98, 0xdd,
+	0x18, 0x5b, 0x1f, 0xe0, 0xcd, 0xfc, 0x30, 0x11, 0x41, 0x2b, 0x1f, 0x41, 0xfd, 0x31, 0xac, 0xfb,
+	0x29, 0x62, 0x38, 0x40, 0x71, 0x69, 0x8f, 0xe6, 0xc8, 0xce, 0xf3, 0xf3, 0xa9, 0x01, 0x2e, 0xa6,
+	0x06, 0xf8, 0x35, 0x35, 0xc0, 0xd9, 0xcc, 0xa8, 0x5d, 0xcc, 0x8c, 0xda, 0xf7, 0x99, 0x51, 0x7b,
+	0x7b, 0xdf, 0x0f, 0xc4, 0x71, 0x3a, 0xb2, 0x3d, 0x1a, 0xa9, 0xb7, 0x4a, 0x7d, 0x5a, 0x1c, 0x9f,
+	0x38, 0xa7, 0xf9, 0x53, 0x35, 0x5a, 0x91, 0xb1, 0x1e, 0xfd, 0x1e, 0x00, 0x61, 0x06, 0x1e, 0x98,
+	0x0b, 0x05, 0x00, 0x00,
 }
 
 func (m *EventCreateGroup) Marshal() (dAtA []byte, err error) {
@@ -895,6 +1021,77 @@ func (m *EventProposalPruned) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *EventProposalTimelocked) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventProposalTimelocked) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventProposalTimelocked) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.TimelockEnd, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.TimelockEnd):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintEvents(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x12
+	if m.ProposalId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventVetoProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventVetoProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventVetoProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Guardian) > 0 {
+		i -= len(m.Guardian)
+		copy(dAtA[i:], m.Guardian)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Guardian)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ProposalId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
 	offset -= sovEvents(v)
 	base := offset
@@ -1046,6 +1243,36 @@ func (m *EventProposalPruned) Size() (n int) {
 	return n
 }
 
+func (m *EventProposalTimelocked) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovEvents(uint64(m.ProposalId))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.TimelockEnd)
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
+func (m *EventVetoProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovEvents(uint64(m.ProposalId))
+	}
+	l = len(m.Guardian)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
 func sovEvents(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1906,6 +2133,209 @@ func (m *EventProposalPruned) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *EventProposalTimelocked) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventProposalTimelocked: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventProposalTimelocked: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimelockEnd", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.TimelockEnd, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventVetoProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventVetoProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventVetoProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Guardian", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Guardian = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipEvents(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -79,6 +79,17 @@ func (s GenesisState) Validate() error {
 			return errorsmod.Wrap(sdkerrors.ErrNotFound, fmt.Sprintf("proposal with ProposalId %d doesn't exist", v.ProposalId))
 		}
 	}
+
+	for _, w := range s.ProposalMemberWeights {
+		if err := w.ValidateBasic(); err != nil {
+			return errorsmod.Wrap(err, "ProposalMemberWeight validation failed")
+		}
+
+		// check that proposal exists
+		if _, exists := proposals[w.ProposalId]; !exists {
+			return errorsmod.Wrap(sdkerrors.ErrNotFound, fmt.Sprintf("proposal with ProposalId %d doesn't exist", w.ProposalId))
+		}
+	}
 	return nil
 }
 
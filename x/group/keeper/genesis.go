@@ -41,6 +41,10 @@ func (k Keeper) InitGenesis(ctx types.Context, cdc codec.JSONCodec, data json.Ra
 		panic(errors.Wrap(err, "votes"))
 	}
 
+	if err := k.proposalMemberWeightTable.Import(ctx.KVStore(k.key), genesisState.ProposalMemberWeights, 0); err != nil {
+		panic(errors.Wrap(err, "proposal member weights"))
+	}
+
 	return []abci.ValidatorUpdate{}
 }
 
@@ -87,5 +91,12 @@ func (k Keeper) ExportGenesis(ctx types.Context, _ codec.JSONCodec) *group.Genes
 	}
 	genesisState.Votes = votes
 
+	var proposalMemberWeights []*group.ProposalMemberWeight
+	_, err = k.proposalMemberWeightTable.Export(ctx.KVStore(k.key), &proposalMemberWeights)
+	if err != nil {
+		panic(errors.Wrap(err, "proposal member weights"))
+	}
+	genesisState.ProposalMemberWeights = proposalMemberWeights
+
 	return genesisState
 }
@@ -386,3 +386,30 @@ func (k Keeper) Groups(goCtx context.Context, request *group.QueryGroupsRequest)
 		Pagination: pageRes,
 	}, nil
 }
+
+// PendingExecutionsByGroupPolicy returns the accepted proposals of a group
+// policy with a timelock which are not successfully executed yet.
+func (k Keeper) PendingExecutionsByGroupPolicy(goCtx context.Context, request *group.QueryPendingExecutionsByGroupPolicyRequest) (*group.QueryPendingExecutionsByGroupPolicyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	addr, err := k.accKeeper.AddressCodec().StringToBytes(request.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	it, err := k.proposalsPendingExecution.GetPaginated(ctx.KVStore(k.key), addr, request.Pagination)
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	var proposals []*group.Proposal
+	pageRes, err := orm.Paginate(it, request.Pagination, &proposals)
+	if err != nil {
+		return nil, err
+	}
+
+	return &group.QueryPendingExecutionsByGroupPolicyResponse{
+		Proposals:  proposals,
+		Pagination: pageRes,
+	}, nil
+}
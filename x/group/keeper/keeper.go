@@ -38,17 +38,23 @@ const (
 	ProposalTableSeqPrefix           byte = 0x31
 	ProposalByGroupPolicyIndexPrefix byte = 0x32
 	ProposalsByVotingPeriodEndPrefix byte = 0x33
+	ProposalsPendingExecutionPrefix  byte = 0x34
 
 	// Vote Table
 	VoteTablePrefix           byte = 0x40
 	VoteByProposalIndexPrefix byte = 0x41
 	VoteByVoterIndexPrefix    byte = 0x42
+
+	ProposalMemberWeightTablePrefix           byte = 0x50
+	ProposalMemberWeightByProposalIndexPrefix byte = 0x51
 )
 
 type Keeper struct {
 	key storetypes.StoreKey
 
-	accKeeper group.AccountKeeper
+	accKeeper     group.AccountKeeper
+	bankKeeper    group.BankKeeper
+	stakingKeeper group.StakingKeeper
 
 	// Group Table
 	groupTable        orm.AutoUInt64Table
@@ -69,12 +75,16 @@ type Keeper struct {
 	proposalTable              orm.AutoUInt64Table
 	proposalByGroupPolicyIndex orm.Index
 	proposalsByVotingPeriodEnd orm.Index
+	proposalsPendingExecution  orm.Index
 
 	// Vote Table
 	voteTable           orm.PrimaryKeyTable
 	voteByProposalIndex orm.Index
 	voteByVoterIndex    orm.Index
 
+	proposalMemberWeightTable           orm.PrimaryKeyTable
+	proposalMemberWeightByProposalIndex orm.Index
+
 	router baseapp.MessageRouter
 
 	config group.Config
@@ -83,12 +93,16 @@ type Keeper struct {
 }
 
 // NewKeeper creates a new group keeper.
-func NewKeeper(storeKey storetypes.StoreKey, cdc codec.Codec, router baseapp.MessageRouter, accKeeper group.AccountKeeper, config group.Config) Keeper {
+func NewKeeper(storeKey storetypes.StoreKey, cdc codec.Codec, router baseapp.MessageRouter, accKeeper group.AccountKeeper,
+	bankKeeper group.BankKeeper, stakingKeeper group.StakingKeeper, config group.Config,
+) Keeper {
 	k := Keeper{
-		key:       storeKey,
-		router:    router,
-		accKeeper: accKeeper,
-		cdc:       cdc,
+		key:           storeKey,
+		router:        router,
+		accKeeper:     accKeeper,
+		bankKeeper:    bankKeeper,
+		stakingKeeper: stakingKeeper,
+		cdc:           cdc,
 	}
 
 	groupTable, err := orm.NewAutoUInt64Table([2]byte{GroupTablePrefix}, GroupTableSeqPrefix, &group.GroupInfo{}, cdc)
@@ -180,6 +194,20 @@ func NewKeeper(storeKey storetypes.StoreKey, cdc codec.Codec, router baseapp.Mes
 	if err != nil {
 		panic(err.Error())
 	}
+	k.proposalsPendingExecution, err = orm.NewIndex(proposalTable, ProposalsPendingExecutionPrefix, func(value interface{}) ([]interface{}, error) {
+		proposal := value.(*group.Proposal)
+		if !proposal.IsPendingExecution() {
+			return nil, nil
+		}
+		addr, err := accKeeper.AddressCodec().StringToBytes(proposal.GroupPolicyAddress)
+		if err != nil {
+			return nil, err
+		}
+		return []interface{}{addr}, nil
+	}, []byte{})
+	if err != nil {
+		panic(err.Error())
+	}
 	k.proposalTable = *proposalTable
 
 	// Vote Table
@@ -205,6 +233,19 @@ func NewKeeper(storeKey storetypes.StoreKey, cdc codec.Codec, router baseapp.Mes
 	}
 	k.voteTable = *voteTable
 
+	// ProposalMemberWeight Table
+	proposalMemberWeightTable, err := orm.NewPrimaryKeyTable([2]byte{ProposalMemberWeightTablePrefix}, &group.ProposalMemberWeight{}, cdc)
+	if err != nil {
+		panic(err.Error())
+	}
+	k.proposalMemberWeightByProposalIndex, err = orm.NewIndex(proposalMemberWeightTable, ProposalMemberWeightByProposalIndexPrefix, func(value interface{}) ([]interface{}, error) {
+		return []interface{}{value.(*group.ProposalMemberWeight).ProposalId}, nil
+	}, group.ProposalMemberWeight{}.ProposalId)
+	if err != nil {
+		panic(err.Error())
+	}
+	k.proposalMemberWeightTable = *proposalMemberWeightTable
+
 	if config.MaxMetadataLen == 0 {
 		config.MaxMetadataLen = group.DefaultConfig().MaxMetadataLen
 	}
@@ -419,6 +460,9 @@ func (k Keeper) TallyProposalsAtVPEnd(ctx sdk.Context) error {
 			if err := k.pruneVotes(ctx, proposalID); err != nil {
 				return err
 			}
+			if err := k.pruneProposalMemberWeights(ctx, proposalID); err != nil {
+				return err
+			}
 			// Emit event for proposal finalized with its result
 			if err := ctx.EventManager().EmitTypedEvent(
 				&group.EventProposalPruned{
@@ -43,6 +43,7 @@ type TestSuite struct {
 	groupKeeper     keeper.Keeper
 	blockTime       time.Time
 	bankKeeper      *grouptestutil.MockBankKeeper
+	stakingKeeper   *grouptestutil.MockStakingKeeper
 	accountKeeper   *grouptestutil.MockAccountKeeper
 }
 
@@ -63,6 +64,7 @@ func (s *TestSuite) SetupTest() {
 	s.accountKeeper.EXPECT().AddressCodec().Return(address.NewBech32Codec("cosmos")).AnyTimes()
 
 	s.bankKeeper = grouptestutil.NewMockBankKeeper(ctrl)
+	s.stakingKeeper = grouptestutil.NewMockStakingKeeper(ctrl)
 
 	bApp := baseapp.NewBaseApp(
 		"group",
@@ -74,7 +76,7 @@ func (s *TestSuite) SetupTest() {
 	banktypes.RegisterMsgServer(bApp.MsgServiceRouter(), s.bankKeeper)
 
 	config := group.DefaultConfig()
-	s.groupKeeper = keeper.NewKeeper(key, encCfg.Codec, bApp.MsgServiceRouter(), s.accountKeeper, config)
+	s.groupKeeper = keeper.NewKeeper(key, encCfg.Codec, bApp.MsgServiceRouter(), s.accountKeeper, s.bankKeeper, s.stakingKeeper, config)
 	s.ctx = testCtx.Ctx.WithBlockTime(s.blockTime)
 	s.sdkCtx = sdk.UnwrapSDKContext(s.ctx)
 
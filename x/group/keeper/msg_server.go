@@ -507,6 +507,42 @@ func (k Keeper) UpdateGroupPolicyMetadata(goCtx context.Context, msg *group.MsgU
 	return &group.MsgUpdateGroupPolicyMetadataResponse{}, nil
 }
 
+func (k Keeper) UpdateGroupPolicyGuardian(goCtx context.Context, msg *group.MsgUpdateGroupPolicyGuardian) (*group.MsgUpdateGroupPolicyGuardianResponse, error) {
+	if msg.Guardian != "" {
+		if _, err := k.accKeeper.AddressCodec().StringToBytes(msg.Guardian); err != nil {
+			return nil, errorsmod.Wrap(err, "guardian address")
+		}
+
+		if msg.Timelock == 0 {
+			return nil, errorsmod.Wrap(errors.ErrInvalid, "a guardian requires a timelock to veto proposals")
+		}
+	}
+
+	if msg.Timelock < 0 {
+		return nil, errorsmod.Wrap(errors.ErrInvalid, "timelock must not be negative")
+	}
+
+	// accepted proposals are pruned after the max execution period, so they
+	// must be executable before.
+	if msg.Timelock >= k.config.MaxExecutionPeriod {
+		return nil, errorsmod.Wrapf(errors.ErrInvalid, "timelock must be less than the max execution period %s", k.config.MaxExecutionPeriod)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	action := func(groupPolicy *group.GroupPolicyInfo) error {
+		groupPolicy.Guardian = msg.Guardian
+		groupPolicy.Timelock = msg.Timelock
+		groupPolicy.Version++
+		return k.groupPolicyTable.Update(ctx.KVStore(k.key), groupPolicy)
+	}
+
+	if err := k.doUpdateGroupPolicy(ctx, msg.GroupPolicyAddress, msg.Admin, action, "group policy guardian updated"); err != nil {
+		return nil, err
+	}
+
+	return &group.MsgUpdateGroupPolicyGuardianResponse{}, nil
+}
+
 func (k Keeper) SubmitProposal(goCtx context.Context, msg *group.MsgSubmitProposal) (*group.MsgSubmitProposalResponse, error) {
 	if len(msg.Proposers) == 0 {
 		return nil, errorsmod.Wrap(errors.ErrEmpty, "proposers")
@@ -617,6 +653,13 @@ func (k Keeper) SubmitProposal(goCtx context.Context, msg *group.MsgSubmitPropos
 		return nil, errorsmod.Wrap(err, "create proposal")
 	}
 
+	// Snapshot the token weights of the members, used to tally the proposal.
+	if tokenPolicy, ok := policy.(*group.TokenWeightedDecisionPolicy); ok {
+		if err := k.snapshotMemberWeights(ctx, id, groupInfo.Id, tokenPolicy); err != nil {
+			return nil, err
+		}
+	}
+
 	if err := ctx.EventManager().EmitTypedEvent(&group.EventSubmitProposal{ProposalId: id}); err != nil {
 		return nil, err
 	}
@@ -788,7 +831,15 @@ func (k Keeper) doTallyAndUpdate(ctx sdk.Context, p *group.Proposal, groupInfo g
 		return err
 	}
 
-	result, err := policy.Allow(tallyResult, groupInfo.TotalWeight)
+	totalWeight := groupInfo.TotalWeight
+	if _, ok := policy.(*group.TokenWeightedDecisionPolicy); ok {
+		totalWeight, err = k.proposalTotalWeight(ctx, p.Id)
+		if err != nil {
+			return err
+		}
+	}
+
+	result, err := policy.Allow(tallyResult, totalWeight)
 	if err != nil {
 		return errorsmod.Wrap(err, "policy allow")
 	}
@@ -799,6 +850,9 @@ func (k Keeper) doTallyAndUpdate(ctx sdk.Context, p *group.Proposal, groupInfo g
 		if err := k.pruneVotes(ctx, p.Id); err != nil {
 			return err
 		}
+		if err := k.pruneProposalMemberWeights(ctx, p.Id); err != nil {
+			return err
+		}
 		p.FinalTallyResult = tallyResult
 		if result.Allow {
 			p.Status = group.PROPOSAL_STATUS_ACCEPTED
@@ -806,6 +860,18 @@ func (k Keeper) doTallyAndUpdate(ctx sdk.Context, p *group.Proposal, groupInfo g
 			p.Status = group.PROPOSAL_STATUS_REJECTED
 		}
 
+		// The timelock of an accepted proposal starts when it is accepted.
+		if p.Status == group.PROPOSAL_STATUS_ACCEPTED && policyInfo.Timelock > 0 {
+			timelockEnd := ctx.BlockTime().Add(policyInfo.Timelock)
+			p.TimelockEnd = &timelockEnd
+
+			if err := ctx.EventManager().EmitTypedEvent(&group.EventProposalTimelocked{
+				ProposalId:  p.Id,
+				TimelockEnd: timelockEnd,
+			}); err != nil {
+				return err
+			}
+		}
 	}
 
 	return nil
@@ -846,9 +912,11 @@ func (k Keeper) Exec(goCtx context.Context, msg *group.MsgExec) (*group.MsgExecR
 		}
 	}
 
-	// Execute proposal payload.
+	// Execute proposal payload, unless the proposal is still timelocked.
 	var logs string
-	if proposal.Status == group.PROPOSAL_STATUS_ACCEPTED && proposal.ExecutorResult != group.PROPOSAL_EXECUTOR_RESULT_SUCCESS {
+	if proposal.Status == group.PROPOSAL_STATUS_ACCEPTED && proposal.TimelockEnd != nil && ctx.BlockTime().Before(*proposal.TimelockEnd) {
+		logs = fmt.Sprintf("proposal %d is timelocked until %s", proposal.Id, proposal.TimelockEnd)
+	} else if proposal.Status == group.PROPOSAL_STATUS_ACCEPTED && proposal.ExecutorResult != group.PROPOSAL_EXECUTOR_RESULT_SUCCESS {
 		// Caching context so that we don't update the store in case of failure.
 		cacheCtx, flush := ctx.CacheContext()
 
@@ -976,6 +1044,54 @@ func (k Keeper) LeaveGroup(goCtx context.Context, msg *group.MsgLeaveGroup) (*gr
 	return &group.MsgLeaveGroupResponse{}, nil
 }
 
+// VetoProposal implements the MsgServer/VetoProposal method.
+func (k Keeper) VetoProposal(goCtx context.Context, msg *group.MsgVetoProposal) (*group.MsgVetoProposalResponse, error) {
+	if msg.ProposalId == 0 {
+		return nil, errorsmod.Wrap(errors.ErrEmpty, "proposal id")
+	}
+
+	if _, err := k.accKeeper.AddressCodec().StringToBytes(msg.Guardian); err != nil {
+		return nil, errorsmod.Wrap(err, "guardian address")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	proposal, err := k.getProposal(ctx, msg.ProposalId)
+	if err != nil {
+		return nil, err
+	}
+
+	policyInfo, err := k.getGroupPolicyInfo(ctx, proposal.GroupPolicyAddress)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "load group policy")
+	}
+
+	if policyInfo.Guardian == "" || msg.Guardian != policyInfo.Guardian {
+		return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "not group policy guardian")
+	}
+
+	// Ensure the proposal is still timelocked.
+	if !proposal.IsPendingExecution() {
+		return nil, errorsmod.Wrapf(errors.ErrInvalid, "cannot veto a proposal with the status of %s and executor result of %s", proposal.Status, proposal.ExecutorResult)
+	}
+	if !ctx.BlockTime().Before(*proposal.TimelockEnd) {
+		return nil, errorsmod.Wrapf(errors.ErrExpired, "proposal timelock ended at %s", proposal.TimelockEnd)
+	}
+
+	proposal.Status = group.PROPOSAL_STATUS_VETOED
+	if err := k.proposalTable.Update(ctx.KVStore(k.key), proposal.Id, &proposal); err != nil {
+		return nil, err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&group.EventVetoProposal{
+		ProposalId: proposal.Id,
+		Guardian:   msg.Guardian,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &group.MsgVetoProposalResponse{}, nil
+}
+
 func (k Keeper) getGroupMember(ctx sdk.Context, member *group.GroupMember) (*group.GroupMember, error) {
 	var groupMember group.GroupMember
 	switch err := k.groupMemberTable.GetOne(ctx.KVStore(k.key),
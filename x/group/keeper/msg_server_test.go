@@ -3021,6 +3021,105 @@ func (s *TestSuite) TestExecPrunedProposalsAndVotes() {
 	}
 }
 
+func (s *TestSuite) TestVetoProposal() {
+	admin, proposer, guardian := s.addrs[0], s.addrs[1], s.addrs[3]
+	timelock := 10 * time.Second
+	sdkCtx, _ := s.sdkCtx.CacheContext()
+
+	_, err := s.groupKeeper.UpdateGroupPolicyGuardian(sdkCtx, &group.MsgUpdateGroupPolicyGuardian{
+		Admin:              proposer.String(),
+		GroupPolicyAddress: s.groupPolicyAddr.String(),
+		Guardian:           guardian.String(),
+		Timelock:           timelock,
+	})
+	s.Require().ErrorContains(err, "not group policy admin")
+
+	_, err = s.groupKeeper.UpdateGroupPolicyGuardian(sdkCtx, &group.MsgUpdateGroupPolicyGuardian{
+		Admin:              admin.String(),
+		GroupPolicyAddress: s.groupPolicyAddr.String(),
+		Guardian:           guardian.String(),
+	})
+	s.Require().ErrorContains(err, "a guardian requires a timelock")
+
+	_, err = s.groupKeeper.UpdateGroupPolicyGuardian(sdkCtx, &group.MsgUpdateGroupPolicyGuardian{
+		Admin:              admin.String(),
+		GroupPolicyAddress: s.groupPolicyAddr.String(),
+		Guardian:           guardian.String(),
+		Timelock:           group.DefaultConfig().MaxExecutionPeriod,
+	})
+	s.Require().ErrorContains(err, "timelock must be less than the max execution period")
+
+	_, err = s.groupKeeper.UpdateGroupPolicyGuardian(sdkCtx, &group.MsgUpdateGroupPolicyGuardian{
+		Admin:              admin.String(),
+		GroupPolicyAddress: s.groupPolicyAddr.String(),
+		Guardian:           guardian.String(),
+		Timelock:           timelock,
+	})
+	s.Require().NoError(err)
+
+	msgSend := &banktypes.MsgSend{
+		FromAddress: s.groupPolicyAddr.String(),
+		ToAddress:   proposer.String(),
+		Amount:      sdk.Coins{sdk.NewInt64Coin("test", 100)},
+	}
+	vetoedID := submitProposalAndVote(sdkCtx, s, []sdk.Msg{msgSend}, []string{proposer.String()}, group.VOTE_OPTION_YES)
+	executedID := submitProposalAndVote(sdkCtx, s, []sdk.Msg{msgSend}, []string{proposer.String()}, group.VOTE_OPTION_YES)
+
+	// the accepted proposals are not executed during the timelock
+	acceptedAt := sdkCtx.BlockTime().Add(minExecutionPeriod)
+	sdkCtx = sdkCtx.WithBlockTime(acceptedAt)
+	for _, id := range []uint64{vetoedID, executedID} {
+		res, err := s.groupKeeper.Exec(sdkCtx, &group.MsgExec{Executor: admin.String(), ProposalId: id})
+		s.Require().NoError(err)
+		s.Require().Equal(group.PROPOSAL_EXECUTOR_RESULT_NOT_RUN, res.Result)
+
+		proposal, err := s.groupKeeper.Proposal(sdkCtx, &group.QueryProposalRequest{ProposalId: id})
+		s.Require().NoError(err)
+		s.Require().Equal(group.PROPOSAL_STATUS_ACCEPTED, proposal.Proposal.Status)
+		s.Require().Equal(acceptedAt.Add(timelock), *proposal.Proposal.TimelockEnd)
+	}
+	s.Require().True(eventTypeFound(sdkCtx.EventManager().ABCIEvents(), "cosmos.group.v1.EventProposalTimelocked"))
+
+	pending, err := s.groupKeeper.PendingExecutionsByGroupPolicy(sdkCtx, &group.QueryPendingExecutionsByGroupPolicyRequest{Address: s.groupPolicyAddr.String()})
+	s.Require().NoError(err)
+	s.Require().Len(pending.Proposals, 2)
+
+	// only the guardian can veto a proposal
+	_, err = s.groupKeeper.VetoProposal(sdkCtx, &group.MsgVetoProposal{ProposalId: vetoedID, Guardian: admin.String()})
+	s.Require().ErrorContains(err, "not group policy guardian")
+
+	_, err = s.groupKeeper.VetoProposal(sdkCtx, &group.MsgVetoProposal{ProposalId: vetoedID, Guardian: guardian.String()})
+	s.Require().NoError(err)
+	s.Require().True(eventTypeFound(sdkCtx.EventManager().ABCIEvents(), "cosmos.group.v1.EventVetoProposal"))
+
+	proposal, err := s.groupKeeper.Proposal(sdkCtx, &group.QueryProposalRequest{ProposalId: vetoedID})
+	s.Require().NoError(err)
+	s.Require().Equal(group.PROPOSAL_STATUS_VETOED, proposal.Proposal.Status)
+
+	pending, err = s.groupKeeper.PendingExecutionsByGroupPolicy(sdkCtx, &group.QueryPendingExecutionsByGroupPolicyRequest{Address: s.groupPolicyAddr.String()})
+	s.Require().NoError(err)
+	s.Require().Len(pending.Proposals, 1)
+	s.Require().Equal(executedID, pending.Proposals[0].Id)
+
+	// a vetoed proposal can't be executed, and a proposal can't be vetoed
+	// once its timelock ended
+	sdkCtx = sdkCtx.WithBlockTime(acceptedAt.Add(timelock))
+	_, err = s.groupKeeper.Exec(sdkCtx, &group.MsgExec{Executor: admin.String(), ProposalId: vetoedID})
+	s.Require().ErrorContains(err, "not possible to exec with proposal status PROPOSAL_STATUS_VETOED")
+
+	_, err = s.groupKeeper.VetoProposal(sdkCtx, &group.MsgVetoProposal{ProposalId: executedID, Guardian: guardian.String()})
+	s.Require().ErrorContains(err, "proposal timelock ended")
+
+	s.bankKeeper.EXPECT().Send(gomock.Any(), msgSend).Return(nil, nil)
+	res, err := s.groupKeeper.Exec(sdkCtx, &group.MsgExec{Executor: admin.String(), ProposalId: executedID})
+	s.Require().NoError(err)
+	s.Require().Equal(group.PROPOSAL_EXECUTOR_RESULT_SUCCESS, res.Result)
+
+	pending, err = s.groupKeeper.PendingExecutionsByGroupPolicy(sdkCtx, &group.QueryPendingExecutionsByGroupPolicyRequest{Address: s.groupPolicyAddr.String()})
+	s.Require().NoError(err)
+	s.Require().Empty(pending.Proposals)
+}
+
 func (s *TestSuite) TestLeaveGroup() {
 	addrs := simtestutil.CreateIncrementalAccounts(7)
 
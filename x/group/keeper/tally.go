@@ -28,6 +28,20 @@ func (k Keeper) Tally(ctx sdk.Context, p group.Proposal, groupID uint64) (group.
 	}
 	defer it.Close()
 
+	policyInfo, err := k.getGroupPolicyInfo(ctx, p.GroupPolicyAddress)
+	if err != nil {
+		return group.TallyResult{}, err
+	}
+
+	policy, err := policyInfo.GetDecisionPolicy()
+	if err != nil {
+		return group.TallyResult{}, err
+	}
+
+	// Token weighted proposals are tallied with the weights of the members
+	// snapshotted at submission.
+	_, tokenWeighted := policy.(*group.TokenWeightedDecisionPolicy)
+
 	tallyResult := group.DefaultTallyResult()
 
 	for {
@@ -56,7 +70,21 @@ func (k Keeper) Tally(ctx sdk.Context, p group.Proposal, groupID uint64) (group.
 			return group.TallyResult{}, err
 		}
 
-		if err := tallyResult.Add(vote, member.Member.Weight); err != nil {
+		weight := member.Member.Weight
+		if tokenWeighted {
+			snapshot, err := k.getProposalMemberWeight(ctx, p.Id, vote.Voter)
+			switch {
+			case sdkerrors.ErrNotFound.Is(err):
+				// If the member joined the group after the proposal
+				// submission, then it has no weight.
+				continue
+			case err != nil:
+				return group.TallyResult{}, err
+			}
+			weight = snapshot.Weight
+		}
+
+		if err := tallyResult.Add(vote, weight); err != nil {
 			return group.TallyResult{}, errorsmod.Wrap(err, "add new vote")
 		}
 	}
@@ -4,6 +4,10 @@ import (
 	"context"
 	"time"
 
+	"github.com/golang/mock/gomock"
+
+	"cosmossdk.io/math"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/cosmos/cosmos-sdk/x/group"
@@ -86,3 +90,69 @@ func (s *TestSuite) TestTally() {
 		})
 	}
 }
+
+func (s *TestSuite) TestTokenWeightedTally() {
+	addrs := s.addrs
+	members := []group.MemberRequest{
+		{Address: addrs[2].String(), Weight: "1"},
+		{Address: addrs[3].String(), Weight: "2"},
+	}
+
+	specs := map[string]struct {
+		source      group.TokenWeightSource
+		denom       string
+		setupWeight func(addr sdk.AccAddress, amount int64)
+	}{
+		"balance": {
+			source: group.TOKEN_WEIGHT_SOURCE_BALANCE,
+			denom:  "test",
+			setupWeight: func(addr sdk.AccAddress, amount int64) {
+				s.bankKeeper.EXPECT().GetBalance(gomock.Any(), addr, "test").Return(sdk.NewInt64Coin("test", amount))
+			},
+		},
+		"staked": {
+			source: group.TOKEN_WEIGHT_SOURCE_STAKED,
+			setupWeight: func(addr sdk.AccAddress, amount int64) {
+				s.stakingKeeper.EXPECT().GetDelegatorBonded(gomock.Any(), addr).Return(math.NewInt(amount), nil)
+			},
+		},
+	}
+
+	for msg, spec := range specs {
+		spec := spec
+		s.Run(msg, func() {
+			policy := group.NewTokenWeightedDecisionPolicy(spec.source, spec.denom, "0.5", time.Hour, 0)
+			policyAddr, _ := s.createGroupAndGroupPolicy(addrs[0], members, policy)
+
+			// the member with the lowest group weight holds the most tokens
+			spec.setupWeight(addrs[2], 100)
+			spec.setupWeight(addrs[3], 10)
+
+			proposalRes, err := s.groupKeeper.SubmitProposal(s.ctx, &group.MsgSubmitProposal{
+				GroupPolicyAddress: policyAddr,
+				Proposers:          []string{addrs[3].String()},
+			})
+			s.Require().NoError(err)
+
+			_, err = s.groupKeeper.Vote(s.ctx, &group.MsgVote{
+				ProposalId: proposalRes.ProposalId,
+				Voter:      addrs[2].String(),
+				Option:     group.VOTE_OPTION_YES,
+			})
+			s.Require().NoError(err)
+
+			tallyRes, err := s.groupKeeper.TallyResult(s.ctx, &group.QueryTallyResultRequest{ProposalId: proposalRes.ProposalId})
+			s.Require().NoError(err)
+			s.Require().Equal("100", tallyRes.Tally.YesCount)
+
+			// the proposal passes with the snapshotted token weights
+			execRes, err := s.groupKeeper.Exec(s.ctx, &group.MsgExec{ProposalId: proposalRes.ProposalId, Executor: addrs[2].String()})
+			s.Require().NoError(err)
+			s.Require().Equal(group.PROPOSAL_EXECUTOR_RESULT_SUCCESS, execRes.Result)
+
+			// the snapshotted weights are pruned with the votes
+			genesis := s.groupKeeper.ExportGenesis(s.sdkCtx, nil)
+			s.Require().Empty(genesis.ProposalMemberWeights)
+		})
+	}
+}
@@ -0,0 +1,149 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/group"
+	"github.com/cosmos/cosmos-sdk/x/group/errors"
+	"github.com/cosmos/cosmos-sdk/x/group/internal/math"
+	"github.com/cosmos/cosmos-sdk/x/group/internal/orm"
+)
+
+// snapshotMemberWeights stores the token weights of all the members of a
+// group when a proposal of a token weighted group policy is submitted. The
+// snapshotted weights are used instead of the member weights to tally the
+// proposal.
+func (k Keeper) snapshotMemberWeights(ctx sdk.Context, proposalID, groupID uint64, policy *group.TokenWeightedDecisionPolicy) error {
+	members, err := k.groupMemberByGroupIndex.Get(ctx.KVStore(k.key), groupID)
+	if err != nil {
+		return err
+	}
+	defer members.Close()
+
+	for {
+		var member group.GroupMember
+		_, err = members.LoadNext(&member)
+		if errors.ErrORMIteratorDone.Is(err) {
+			break
+		}
+		if err != nil {
+			return err
+		}
+
+		ctx.GasMeter().ConsumeGas(gasCostPerIteration, "snapshot member weight")
+		weight, err := k.tokenWeight(ctx, member.Member.Address, policy)
+		if err != nil {
+			return err
+		}
+
+		if err := k.proposalMemberWeightTable.Create(ctx.KVStore(k.key), &group.ProposalMemberWeight{
+			ProposalId: proposalID,
+			Address:    member.Member.Address,
+			Weight:     weight,
+		}); err != nil {
+			return errorsmod.Wrap(err, "snapshot member weight")
+		}
+	}
+
+	return nil
+}
+
+// tokenWeight returns the token weight of a member, taken from its balance of
+// the policy denom or from its bonded tokens.
+func (k Keeper) tokenWeight(ctx sdk.Context, member string, policy *group.TokenWeightedDecisionPolicy) (string, error) {
+	addr, err := k.accKeeper.AddressCodec().StringToBytes(member)
+	if err != nil {
+		return "", err
+	}
+
+	switch policy.Source {
+	case group.TOKEN_WEIGHT_SOURCE_BALANCE:
+		return k.bankKeeper.GetBalance(ctx, addr, policy.Denom).Amount.String(), nil
+	case group.TOKEN_WEIGHT_SOURCE_STAKED:
+		bonded, err := k.stakingKeeper.GetDelegatorBonded(ctx, addr)
+		if err != nil {
+			return "", err
+		}
+		return bonded.String(), nil
+	default:
+		return "", errorsmod.Wrapf(errors.ErrInvalid, "token weight source %s", policy.Source)
+	}
+}
+
+// getProposalMemberWeight returns the weight of a member snapshotted for a
+// proposal.
+func (k Keeper) getProposalMemberWeight(ctx sdk.Context, proposalID uint64, member string) (group.ProposalMemberWeight, error) {
+	var weight group.ProposalMemberWeight
+	err := k.proposalMemberWeightTable.GetOne(ctx.KVStore(k.key), orm.PrimaryKey(&group.ProposalMemberWeight{
+		ProposalId: proposalID,
+		Address:    member,
+	}), &weight)
+	return weight, err
+}
+
+// proposalMemberWeights returns all the member weights snapshotted for a
+// proposal.
+func (k Keeper) proposalMemberWeights(ctx sdk.Context, proposalID uint64) ([]group.ProposalMemberWeight, error) {
+	it, err := k.proposalMemberWeightByProposalIndex.Get(ctx.KVStore(k.key), proposalID)
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	var weights []group.ProposalMemberWeight
+	for {
+		var weight group.ProposalMemberWeight
+		_, err = it.LoadNext(&weight)
+		if errors.ErrORMIteratorDone.Is(err) {
+			break
+		}
+		if err != nil {
+			return weights, err
+		}
+		weights = append(weights, weight)
+	}
+	return weights, nil
+}
+
+// proposalTotalWeight returns the sum of the member weights snapshotted for a
+// proposal.
+func (k Keeper) proposalTotalWeight(ctx sdk.Context, proposalID uint64) (string, error) {
+	weights, err := k.proposalMemberWeights(ctx, proposalID)
+	if err != nil {
+		return "", err
+	}
+
+	totalWeight := math.NewDecFromInt64(0)
+	for _, w := range weights {
+		weight, err := math.NewNonNegativeDecFromString(w.Weight)
+		if err != nil {
+			return "", err
+		}
+
+		totalWeight, err = totalWeight.Add(weight)
+		if err != nil {
+			return "", err
+		}
+	}
+
+	return totalWeight.String(), nil
+}
+
+// pruneProposalMemberWeights prunes all the member weights snapshotted for a
+// proposal from state.
+func (k Keeper) pruneProposalMemberWeights(ctx sdk.Context, proposalID uint64) error {
+	weights, err := k.proposalMemberWeights(ctx, proposalID)
+	if err != nil {
+		return err
+	}
+
+	//nolint:gosec // "implicit memory aliasing in the for loop (because of the pointer on &w)"
+	for _, w := range weights {
+		if err := k.proposalMemberWeightTable.Delete(ctx.KVStore(k.key), &w); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -121,6 +121,14 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Use:       "groups",
 					Short:     "Query for all groups on chain",
 				},
+				{
+					RpcMethod: "PendingExecutionsByGroupPolicy",
+					Use:       "pending-executions-by-group-policy [group-policy-account]",
+					Short:     "Query for accepted proposals of a group policy pending execution after their timelock",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "address"},
+					},
+				},
 			},
 		},
 		Tx: &autocliv1.ServiceCommandDescriptor{
@@ -159,6 +167,14 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						{ProtoField: "admin"}, {ProtoField: "group_policy_address"}, {ProtoField: "metadata"},
 					},
 				},
+				{
+					RpcMethod: "UpdateGroupPolicyGuardian",
+					Use:       "update-group-policy-guardian [admin] [group-policy-account] [timelock]",
+					Short:     "Update a group policy guardian and timelock",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "admin"}, {ProtoField: "group_policy_address"}, {ProtoField: "timelock"},
+					},
+				},
 				{
 					RpcMethod: "WithdrawProposal",
 					Use:       "withdraw-proposal [proposal-id] [group-policy-admin-or-proposer]",
@@ -205,6 +221,14 @@ Parameters:
 						{ProtoField: "address"}, {ProtoField: "group_id"},
 					},
 				},
+				{
+					RpcMethod: "VetoProposal",
+					Use:       "veto-proposal [proposal-id] [guardian]",
+					Short:     "Veto an accepted proposal during its timelock",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "proposal_id"}, {ProtoField: "guardian"},
+					},
+				},
 			},
 		},
 	}
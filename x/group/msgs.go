@@ -20,7 +20,9 @@ var (
 	_ sdk.Msg = &MsgUpdateGroupPolicyAdmin{}
 	_ sdk.Msg = &MsgUpdateGroupPolicyDecisionPolicy{}
 	_ sdk.Msg = &MsgUpdateGroupPolicyMetadata{}
+	_ sdk.Msg = &MsgUpdateGroupPolicyGuardian{}
 	_ sdk.Msg = &MsgLeaveGroup{}
+	_ sdk.Msg = &MsgVetoProposal{}
 	_ sdk.Msg = &MsgExec{}
 	_ sdk.Msg = &MsgVote{}
 	_ sdk.Msg = &MsgWithdrawProposal{}
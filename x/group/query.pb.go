@@ -1486,6 +1486,124 @@ func (m *QueryGroupsResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
+// QueryPendingExecutionsByGroupPolicyRequest is the Query/PendingExecutionsByGroupPolicy request type.
+type QueryPendingExecutionsByGroupPolicyRequest struct {
+	// address is the account address of the group policy related to proposals.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryPendingExecutionsByGroupPolicyRequest) Reset() {
+	*m = QueryPendingExecutionsByGroupPolicyRequest{}
+}
+func (m *QueryPendingExecutionsByGroupPolicyRequest) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryPendingExecutionsByGroupPolicyRequest) ProtoMessage() {}
+func (*QueryPendingExecutionsByGroupPolicyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0fcf9f1d74302290, []int{28}
+}
+func (m *QueryPendingExecutionsByGroupPolicyRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingExecutionsByGroupPolicyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingExecutionsByGroupPolicyRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPendingExecutionsByGroupPolicyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingExecutionsByGroupPolicyRequest.Merge(m, src)
+}
+func (m *QueryPendingExecutionsByGroupPolicyRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingExecutionsByGroupPolicyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingExecutionsByGroupPolicyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPendingExecutionsByGroupPolicyRequest proto.InternalMessageInfo
+
+func (m *QueryPendingExecutionsByGroupPolicyRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *QueryPendingExecutionsByGroupPolicyRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryPendingExecutionsByGroupPolicyResponse is the Query/PendingExecutionsByGroupPolicy response type.
+type QueryPendingExecutionsByGroupPolicyResponse struct {
+	// proposals are the accepted proposals of the group policy pending execution.
+	Proposals []*Proposal `protobuf:"bytes,1,rep,name=proposals,proto3" json:"proposals,omitempty"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryPendingExecutionsByGroupPolicyResponse) Reset() {
+	*m = QueryPendingExecutionsByGroupPolicyResponse{}
+}
+func (m *QueryPendingExecutionsByGroupPolicyResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryPendingExecutionsByGroupPolicyResponse) ProtoMessage() {}
+func (*QueryPendingExecutionsByGroupPolicyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0fcf9f1d74302290, []int{29}
+}
+func (m *QueryPendingExecutionsByGroupPolicyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingExecutionsByGroupPolicyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingExecutionsByGroupPolicyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPendingExecutionsByGroupPolicyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingExecutionsByGroupPolicyResponse.Merge(m, src)
+}
+func (m *QueryPendingExecutionsByGroupPolicyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingExecutionsByGroupPolicyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingExecutionsByGroupPolicyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPendingExecutionsByGroupPolicyResponse proto.InternalMessageInfo
+
+func (m *QueryPendingExecutionsByGroupPolicyResponse) GetProposals() []*Proposal {
+	if m != nil {
+		return m.Proposals
+	}
+	return nil
+}
+
+func (m *QueryPendingExecutionsByGroupPolicyResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryGroupInfoRequest)(nil), "cosmos.group.v1.QueryGroupInfoRequest")
 	proto.RegisterType((*QueryGroupInfoResponse)(nil), "cosmos.group.v1.QueryGroupInfoResponse")
@@ -1515,94 +1633,100 @@ func init() {
 	proto.RegisterType((*QueryTallyResultResponse)(nil), "cosmos.group.v1.QueryTallyResultResponse")
 	proto.RegisterType((*QueryGroupsRequest)(nil), "cosmos.group.v1.QueryGroupsRequest")
 	proto.RegisterType((*QueryGroupsResponse)(nil), "cosmos.group.v1.QueryGroupsResponse")
+	proto.RegisterType((*QueryPendingExecutionsByGroupPolicyRequest)(nil), "cosmos.group.v1.QueryPendingExecutionsByGroupPolicyRequest")
+	proto.RegisterType((*QueryPendingExecutionsByGroupPolicyResponse)(nil), "cosmos.group.v1.QueryPendingExecutionsByGroupPolicyResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/group/v1/query.proto", fileDescriptor_0fcf9f1d74302290) }
 
 var fileDescriptor_0fcf9f1d74302290 = []byte{
-	// 1310 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x98, 0xcf, 0x6f, 0x1b, 0x45,
-	0x14, 0xc7, 0x33, 0x25, 0x3f, 0x5f, 0xda, 0x46, 0x9d, 0xa6, 0x6d, 0xb2, 0x8d, 0x9c, 0xb0, 0x2d,
-	0xf9, 0x9d, 0xdd, 0xd8, 0x49, 0x53, 0x04, 0x14, 0x54, 0x4b, 0x10, 0x72, 0x28, 0x4a, 0x4d, 0x85,
-	0x04, 0x42, 0x8a, 0xd6, 0xf1, 0xc6, 0xac, 0xb0, 0x77, 0x5c, 0xef, 0x26, 0xc2, 0x8a, 0x7c, 0x41,
-	0x82, 0x03, 0xe2, 0x00, 0x2d, 0x42, 0x25, 0xe2, 0xd0, 0x03, 0x12, 0x3d, 0x72, 0x00, 0x21, 0x71,
-	0xeb, 0xad, 0xc7, 0x0a, 0x2e, 0x9c, 0x10, 0x4a, 0x90, 0xf8, 0x23, 0xb8, 0xa0, 0x9d, 0x79, 0x6b,
-	0xef, 0x6f, 0x6f, 0x84, 0x45, 0x73, 0x69, 0xeb, 0x9d, 0x37, 0xf3, 0x3e, 0xf3, 0x7d, 0x6f, 0x67,
-	0xbf, 0x53, 0xb8, 0xbc, 0xcd, 0xac, 0x2a, 0xb3, 0xd4, 0x72, 0x9d, 0xed, 0xd6, 0xd4, 0xbd, 0xac,
-	0x7a, 0x77, 0x57, 0xaf, 0x37, 0x94, 0x5a, 0x9d, 0xd9, 0x8c, 0x8e, 0x88, 0x41, 0x85, 0x0f, 0x2a,
-	0x7b, 0x59, 0x69, 0xb4, 0xcc, 0xca, 0x8c, 0x8f, 0xa9, 0xce, 0xbf, 0x44, 0x98, 0x34, 0x51, 0x66,
-	0xac, 0x5c, 0xd1, 0x55, 0xad, 0x66, 0xa8, 0x9a, 0x69, 0x32, 0x5b, 0xb3, 0x0d, 0x66, 0x5a, 0x38,
-	0x1a, 0xca, 0x60, 0x37, 0x6a, 0xba, 0x3b, 0x38, 0x8f, 0x83, 0x45, 0xcd, 0xd2, 0x45, 0x6a, 0x75,
-	0x2f, 0x5b, 0xd4, 0x6d, 0x2d, 0xab, 0xd6, 0xb4, 0xb2, 0x61, 0xf2, 0x95, 0x30, 0x76, 0x5c, 0xc4,
-	0x6e, 0x89, 0xfc, 0x88, 0x26, 0x86, 0xce, 0x69, 0x55, 0xc3, 0x64, 0x2a, 0xff, 0x53, 0x3c, 0x92,
-	0x73, 0x70, 0xe1, 0xb6, 0xb3, 0xde, 0xba, 0x93, 0x76, 0xc3, 0xdc, 0x61, 0x05, 0xfd, 0xee, 0xae,
-	0x6e, 0xd9, 0x74, 0x1c, 0x06, 0x39, 0xca, 0x96, 0x51, 0x1a, 0x23, 0x53, 0x64, 0xb6, 0xb7, 0x30,
-	0xc0, 0x7f, 0x6f, 0x94, 0xe4, 0x37, 0xe1, 0x62, 0x70, 0x8e, 0x55, 0x63, 0xa6, 0xa5, 0x53, 0x05,
-	0x7a, 0x0d, 0x73, 0x87, 0xf1, 0x09, 0xc3, 0x39, 0x49, 0x09, 0x08, 0xa3, 0xb4, 0x67, 0xf0, 0x38,
-	0xf9, 0x36, 0x5c, 0x6e, 0xaf, 0xb4, 0xc9, 0x2a, 0xc6, 0x76, 0xc3, 0xcb, 0x90, 0x83, 0x01, 0xad,
-	0x54, 0xaa, 0xeb, 0x96, 0xc5, 0x57, 0x1c, 0xca, 0x8f, 0xfd, 0xfa, 0xe3, 0xd2, 0x28, 0x2e, 0x7a,
-	0x53, 0x8c, 0xbc, 0x6d, 0xd7, 0x0d, 0xb3, 0x5c, 0x70, 0x03, 0xe5, 0x3b, 0x30, 0x11, 0xbd, 0x24,
-	0x22, 0xae, 0xfa, 0x10, 0xa7, 0xa2, 0x11, 0x3d, 0xf3, 0x04, 0x68, 0x13, 0xc6, 0xda, 0xab, 0xde,
-	0xd2, 0xab, 0x45, 0xbd, 0x6e, 0x75, 0x56, 0x8a, 0xbe, 0x01, 0xd0, 0xae, 0xcf, 0xd8, 0x29, 0x9e,
-	0x72, 0xda, 0x4d, 0xe9, 0x14, 0x53, 0x11, 0x7d, 0x84, 0xc5, 0x54, 0x36, 0xb5, 0xb2, 0x8e, 0xcb,
-	0x16, 0x3c, 0x33, 0xe5, 0x6f, 0x09, 0x8c, 0x47, 0xe4, 0xc7, 0x2d, 0xad, 0xc1, 0x40, 0x55, 0x3c,
-	0x1a, 0x23, 0x53, 0xcf, 0xcd, 0x0e, 0xe7, 0x26, 0xa2, 0x77, 0x25, 0xe6, 0x15, 0xdc, 0x60, 0xba,
-	0x1e, 0x41, 0x37, 0xd3, 0x91, 0x4e, 0x24, 0xf5, 0xe1, 0xdd, 0xf7, 0xe1, 0x59, 0xf9, 0xc6, 0xcd,
-	0x52, 0xd5, 0x30, 0x5d, 0x7d, 0x14, 0xe8, 0xd3, 0x9c, 0xdf, 0x1d, 0x6b, 0x28, 0xc2, 0xba, 0x26,
-	0xda, 0x37, 0x04, 0xa4, 0x28, 0x2a, 0x54, 0x2d, 0x07, 0xfd, 0x5c, 0x1e, 0x57, 0xb4, 0xa4, 0x6e,
-	0xc5, 0xc8, 0xee, 0x29, 0xf6, 0x09, 0x81, 0xa9, 0x40, 0x9b, 0x1a, 0xba, 0x95, 0x17, 0x3f, 0xff,
-	0xc7, 0xc6, 0xfa, 0x89, 0xc0, 0xf3, 0x09, 0x1c, 0x28, 0xd5, 0x3a, 0x9c, 0x15, 0x20, 0x35, 0x0c,
-	0x40, 0xc9, 0x3a, 0xbf, 0x3d, 0x67, 0xca, 0xde, 0x75, 0xbb, 0xa7, 0xdf, 0x41, 0x8c, 0x7e, 0x27,
-	0xa2, 0xf1, 0xe2, 0x44, 0xf5, 0xf7, 0xdf, 0xc9, 0x13, 0xf5, 0x3a, 0x8c, 0x72, 0xec, 0xcd, 0x3a,
-	0xab, 0x31, 0x4b, 0xab, 0xb8, 0x3a, 0x4e, 0xc2, 0x70, 0x0d, 0x1f, 0xb5, 0x5b, 0x11, 0xdc, 0x47,
-	0x1b, 0x25, 0xf9, 0x2d, 0xfc, 0x88, 0xb4, 0x27, 0xe2, 0x1e, 0xaf, 0xc1, 0xa0, 0x1b, 0x86, 0x07,
-	0xee, 0x78, 0x68, 0x77, 0xad, 0x49, 0xad, 0x50, 0xf9, 0x21, 0x01, 0xd9, 0xb7, 0xa0, 0xdb, 0x91,
-	0x42, 0x84, 0xff, 0xf0, 0x79, 0xe8, 0x5a, 0x8d, 0xbf, 0x27, 0x70, 0x25, 0x11, 0x11, 0x15, 0xb8,
-	0x0e, 0x43, 0xee, 0xb6, 0xdc, 0x02, 0x27, 0x48, 0xd0, 0x8e, 0xed, 0x5e, 0x55, 0xeb, 0x30, 0xc9,
-	0x41, 0xdf, 0x61, 0xb6, 0x9e, 0x6f, 0xe1, 0x3a, 0xbf, 0xea, 0x69, 0x0b, 0xec, 0xbc, 0x49, 0x7b,
-	0xce, 0x04, 0xce, 0x91, 0xf8, 0x26, 0xf1, 0x30, 0xf9, 0x16, 0xbe, 0x9d, 0x91, 0x39, 0x51, 0x99,
-	0x39, 0xe8, 0x75, 0x82, 0xb1, 0x2f, 0x2e, 0x84, 0x44, 0x71, 0xa2, 0x0b, 0x3c, 0x44, 0xfe, 0x94,
-	0xa0, 0x4f, 0x70, 0x9e, 0x59, 0xf9, 0x63, 0x37, 0x68, 0xd7, 0xaa, 0xfe, 0x15, 0x41, 0x77, 0x11,
-	0x02, 0xc1, 0x4d, 0x2d, 0x08, 0xa1, 0xdc, 0x52, 0xc7, 0xec, 0x4a, 0xc4, 0x74, 0xaf, 0xc4, 0xf7,
-	0x08, 0xda, 0x13, 0xc4, 0xf2, 0x15, 0xb7, 0x55, 0x3b, 0x92, 0xaa, 0x76, 0x5d, 0xd3, 0xea, 0x4b,
-	0xd7, 0x14, 0xf8, 0xa1, 0x9e, 0xa9, 0x50, 0x0f, 0x82, 0x96, 0x00, 0x2d, 0xd1, 0x09, 0x38, 0x50,
-	0x0e, 0x88, 0xd7, 0x0b, 0x7b, 0xd0, 0x4e, 0x82, 0x5d, 0x79, 0x09, 0x2e, 0x71, 0xb6, 0x3b, 0x5a,
-	0xa5, 0xe2, 0x9c, 0x6d, 0xbb, 0x15, 0x3b, 0xf5, 0xc7, 0xe1, 0x5d, 0xec, 0x4d, 0xdf, 0x5c, 0xdc,
-	0xd4, 0x0d, 0xe8, 0xb3, 0x9d, 0xc7, 0x78, 0x08, 0x84, 0x7d, 0xab, 0x67, 0x52, 0x7e, 0xe8, 0xc9,
-	0x1f, 0x93, 0x3d, 0x8f, 0xfe, 0xfe, 0x61, 0x9e, 0x14, 0xc4, 0x2c, 0xf9, 0x7d, 0xa0, 0x1e, 0xc9,
-	0x5c, 0xa2, 0x6e, 0x55, 0xe4, 0x1e, 0x81, 0xf3, 0xbe, 0xe5, 0x4f, 0x40, 0x25, 0x72, 0xff, 0x9c,
-	0x83, 0x3e, 0x0e, 0x45, 0x3f, 0x27, 0x30, 0xd4, 0x4a, 0x44, 0xa7, 0x43, 0x10, 0x91, 0xd7, 0x3a,
-	0x69, 0xa6, 0x63, 0x9c, 0x48, 0x2a, 0x2b, 0x1f, 0xff, 0xf6, 0xd7, 0xfd, 0x53, 0xb3, 0x74, 0x5a,
-	0x0d, 0x5e, 0x4c, 0xd1, 0x93, 0x9a, 0x3b, 0x4c, 0xdd, 0x77, 0xfd, 0x69, 0x93, 0x7e, 0x47, 0x60,
-	0x24, 0x60, 0x54, 0xe8, 0x62, 0x42, 0xb2, 0xd0, 0x6d, 0x4f, 0x5a, 0x4a, 0x19, 0x8d, 0x80, 0xab,
-	0x1c, 0x50, 0xa1, 0x8b, 0x31, 0x80, 0xdc, 0x56, 0x35, 0x90, 0x13, 0xdf, 0xd6, 0x26, 0x7d, 0x40,
-	0xe0, 0xb4, 0xf7, 0x12, 0x45, 0xe7, 0x12, 0xb2, 0xfa, 0x2f, 0x7a, 0xd2, 0x7c, 0x9a, 0x50, 0xa4,
-	0xcb, 0x72, 0xba, 0x05, 0x3a, 0x17, 0x43, 0x87, 0x77, 0x30, 0xaf, 0x82, 0x07, 0x04, 0xce, 0xf8,
-	0xae, 0x2a, 0x34, 0x29, 0x61, 0xc0, 0xec, 0x4a, 0x0b, 0xa9, 0x62, 0x91, 0x6e, 0x99, 0xd3, 0xcd,
-	0xd3, 0xd9, 0x68, 0x3a, 0x6b, 0xab, 0xd8, 0xd8, 0xe2, 0x9e, 0xd8, 0x51, 0xae, 0x6a, 0x98, 0x4d,
-	0xfa, 0x0b, 0x81, 0xd1, 0xa8, 0x3b, 0x02, 0xcd, 0x76, 0xaa, 0x5a, 0xe8, 0x5e, 0x23, 0xe5, 0x8e,
-	0x33, 0x05, 0x89, 0x5f, 0xe6, 0xc4, 0xd7, 0xe8, 0x4a, 0x52, 0xb5, 0x0d, 0x9d, 0x93, 0x8b, 0x21,
-	0x8f, 0xb2, 0x3f, 0x87, 0xe1, 0x85, 0xc0, 0xe9, 0xe0, 0x7d, 0x3a, 0xe7, 0x8e, 0x33, 0x05, 0xe1,
-	0x5f, 0xe4, 0xf0, 0x39, 0xba, 0x9c, 0x02, 0xde, 0x2f, 0xfb, 0x67, 0x04, 0x06, 0x5d, 0x93, 0x41,
-	0x5f, 0x88, 0x4e, 0x1d, 0x70, 0x43, 0xd2, 0x74, 0xa7, 0x30, 0xa4, 0x52, 0x39, 0xd5, 0x1c, 0x9d,
-	0x09, 0x51, 0xb9, 0xa7, 0xb7, 0xba, 0xef, 0x39, 0xda, 0x9b, 0xf4, 0x31, 0x81, 0x8b, 0xd1, 0x76,
-	0x97, 0xae, 0x24, 0xe7, 0x8c, 0xf4, 0xef, 0xd2, 0xea, 0xf1, 0x26, 0x21, 0xf6, 0x2b, 0x1c, 0x7b,
-	0x8d, 0xae, 0xc6, 0x62, 0xb7, 0x9b, 0x00, 0x0f, 0x01, 0xcf, 0xfb, 0xff, 0x98, 0xc0, 0xf9, 0x08,
-	0x57, 0x4a, 0x97, 0xa3, 0x59, 0xe2, 0x4d, 0xb3, 0x94, 0x3d, 0xc6, 0x0c, 0x44, 0x7f, 0x9d, 0xa3,
-	0xbf, 0x46, 0x6f, 0x84, 0xd0, 0x1d, 0x9f, 0xe3, 0x50, 0xb7, 0xf4, 0xe6, 0x5e, 0xcc, 0xaf, 0xbf,
-	0xba, 0xcf, 0x1f, 0x36, 0xe9, 0x23, 0x02, 0x23, 0x01, 0x03, 0x1a, 0x77, 0xd4, 0x46, 0x1b, 0xe6,
-	0xb8, 0xa3, 0x36, 0xc6, 0xd5, 0x26, 0xf4, 0x2f, 0xf7, 0x67, 0x5e, 0xf0, 0x40, 0xcb, 0x7c, 0x4d,
-	0xe0, 0xb4, 0xd7, 0xff, 0xc5, 0x1d, 0xb7, 0x11, 0xc6, 0x35, 0xee, 0xb8, 0x8d, 0xb2, 0x93, 0x09,
-	0xbd, 0xdc, 0x22, 0x44, 0x45, 0x51, 0xc3, 0x87, 0x04, 0xce, 0xfa, 0x9d, 0x16, 0xed, 0x70, 0x82,
-	0xfa, 0xac, 0xa2, 0xb4, 0x98, 0x2e, 0x18, 0xf1, 0x56, 0x38, 0xde, 0x12, 0x5d, 0x48, 0x38, 0x6f,
-	0xc5, 0x17, 0xc1, 0xd3, 0xaa, 0x07, 0x04, 0x86, 0x3d, 0xfe, 0x87, 0xce, 0x46, 0xa7, 0x0c, 0x7b,
-	0x32, 0x69, 0x2e, 0x45, 0x24, 0x92, 0xad, 0x71, 0xb2, 0x65, 0xaa, 0xc4, 0xbf, 0x4d, 0x81, 0x2e,
-	0xe4, 0xd6, 0x8b, 0xda, 0xd0, 0x2f, 0xf6, 0x4a, 0xaf, 0x24, 0x29, 0xe1, 0x12, 0x5d, 0x4d, 0x0e,
-	0x42, 0x98, 0x49, 0x0e, 0x33, 0x4e, 0x2f, 0xc5, 0xc8, 0x94, 0x7f, 0xf5, 0xc9, 0x61, 0x86, 0x3c,
-	0x3d, 0xcc, 0x90, 0x3f, 0x0f, 0x33, 0xe4, 0x8b, 0xa3, 0x4c, 0xcf, 0xd3, 0xa3, 0x4c, 0xcf, 0xef,
-	0x47, 0x99, 0x9e, 0xf7, 0xae, 0x96, 0x0d, 0xfb, 0x83, 0xdd, 0xa2, 0xb2, 0xcd, 0xaa, 0xee, 0x64,
-	0xf1, 0xd7, 0x92, 0x55, 0xfa, 0x50, 0xfd, 0x48, 0x2c, 0x50, 0xec, 0xe7, 0xff, 0xe9, 0xbd, 0xf2,
-	0x6f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x22, 0xb3, 0x86, 0xf8, 0xcf, 0x17, 0x00, 0x00,
+	// 1364 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x99, 0xcf, 0x6f, 0x1b, 0x45,
+	0x14, 0xc7, 0x33, 0xa5, 0x3f, 0x92, 0x97, 0xb6, 0x11, 0x93, 0xa4, 0x75, 0x36, 0x91, 0x13, 0xb6,
+	0x25, 0xbf, 0xb3, 0x1b, 0x3b, 0x69, 0x8a, 0x68, 0x0b, 0xaa, 0xa5, 0x12, 0x72, 0x28, 0x4a, 0x4d,
+	0x85, 0x04, 0x42, 0x8a, 0xd6, 0xf1, 0xc6, 0xac, 0xb0, 0x77, 0x5d, 0xef, 0x3a, 0xaa, 0x15, 0xf9,
+	0x82, 0x04, 0x07, 0xc4, 0x01, 0x5a, 0x84, 0x4a, 0xc4, 0xa1, 0x07, 0x24, 0x72, 0x83, 0x03, 0x08,
+	0x89, 0x5b, 0x6f, 0x3d, 0x56, 0x70, 0xe1, 0x84, 0xaa, 0x04, 0x89, 0x7f, 0x03, 0xed, 0xcc, 0x5b,
+	0x7b, 0x7f, 0x7b, 0x03, 0x16, 0xf8, 0xd2, 0x7a, 0x67, 0xde, 0x9b, 0xf7, 0x99, 0xef, 0x7b, 0x3b,
+	0xfb, 0x46, 0x81, 0xf1, 0x6d, 0xc3, 0xac, 0x18, 0xa6, 0x5c, 0xaa, 0x19, 0xf5, 0xaa, 0xbc, 0x9b,
+	0x91, 0xef, 0xd5, 0xd5, 0x5a, 0x43, 0xaa, 0xd6, 0x0c, 0xcb, 0xa0, 0x43, 0x7c, 0x52, 0x62, 0x93,
+	0xd2, 0x6e, 0x46, 0x18, 0x29, 0x19, 0x25, 0x83, 0xcd, 0xc9, 0xf6, 0x2f, 0x6e, 0x26, 0x4c, 0x94,
+	0x0c, 0xa3, 0x54, 0x56, 0x65, 0xa5, 0xaa, 0xc9, 0x8a, 0xae, 0x1b, 0x96, 0x62, 0x69, 0x86, 0x6e,
+	0xe2, 0x6c, 0x20, 0x82, 0xd5, 0xa8, 0xaa, 0xce, 0xe4, 0x3c, 0x4e, 0x16, 0x14, 0x53, 0xe5, 0xa1,
+	0xe5, 0xdd, 0x4c, 0x41, 0xb5, 0x94, 0x8c, 0x5c, 0x55, 0x4a, 0x9a, 0xce, 0x56, 0x42, 0xdb, 0x31,
+	0x6e, 0xbb, 0xc5, 0xe3, 0x23, 0x1a, 0x9f, 0x7a, 0x51, 0xa9, 0x68, 0xba, 0x21, 0xb3, 0x7f, 0xf9,
+	0x90, 0x98, 0x85, 0xd1, 0x3b, 0xf6, 0x7a, 0xeb, 0x76, 0xd8, 0x0d, 0x7d, 0xc7, 0xc8, 0xab, 0xf7,
+	0xea, 0xaa, 0x69, 0xd1, 0x31, 0xe8, 0x67, 0x28, 0x5b, 0x5a, 0x31, 0x45, 0xa6, 0xc8, 0xec, 0xc9,
+	0xfc, 0x19, 0xf6, 0xbc, 0x51, 0x14, 0xdf, 0x84, 0x0b, 0x7e, 0x1f, 0xb3, 0x6a, 0xe8, 0xa6, 0x4a,
+	0x25, 0x38, 0xa9, 0xe9, 0x3b, 0x06, 0x73, 0x18, 0xcc, 0x0a, 0x92, 0x4f, 0x18, 0xa9, 0xed, 0xc1,
+	0xec, 0xc4, 0x3b, 0x30, 0xde, 0x5e, 0x69, 0xd3, 0x28, 0x6b, 0xdb, 0x0d, 0x37, 0x43, 0x16, 0xce,
+	0x28, 0xc5, 0x62, 0x4d, 0x35, 0x4d, 0xb6, 0xe2, 0x40, 0x2e, 0xf5, 0xeb, 0x8f, 0x4b, 0x23, 0xb8,
+	0xe8, 0x4d, 0x3e, 0xf3, 0xb6, 0x55, 0xd3, 0xf4, 0x52, 0xde, 0x31, 0x14, 0xef, 0xc2, 0x44, 0xf8,
+	0x92, 0x88, 0xb8, 0xea, 0x41, 0x9c, 0x0a, 0x47, 0x74, 0xf9, 0x71, 0xd0, 0x26, 0xa4, 0xda, 0xab,
+	0xde, 0x56, 0x2b, 0x05, 0xb5, 0x66, 0x76, 0x56, 0x8a, 0xbe, 0x01, 0xd0, 0xce, 0x4f, 0xea, 0x04,
+	0x0b, 0x39, 0xed, 0x84, 0xb4, 0x93, 0x29, 0xf1, 0x3a, 0xc2, 0x64, 0x4a, 0x9b, 0x4a, 0x49, 0xc5,
+	0x65, 0xf3, 0x2e, 0x4f, 0xf1, 0x1b, 0x02, 0x63, 0x21, 0xf1, 0x71, 0x4b, 0x6b, 0x70, 0xa6, 0xc2,
+	0x87, 0x52, 0x64, 0xea, 0x85, 0xd9, 0xc1, 0xec, 0x44, 0xf8, 0xae, 0xb8, 0x5f, 0xde, 0x31, 0xa6,
+	0xeb, 0x21, 0x74, 0x33, 0x1d, 0xe9, 0x78, 0x50, 0x0f, 0xde, 0x43, 0x0f, 0x9e, 0x99, 0x6b, 0xdc,
+	0x2c, 0x56, 0x34, 0xdd, 0xd1, 0x47, 0x82, 0x53, 0x8a, 0xfd, 0xdc, 0x31, 0x87, 0xdc, 0xac, 0x6b,
+	0xa2, 0x7d, 0x4d, 0x40, 0x08, 0xa3, 0x42, 0xd5, 0xb2, 0x70, 0x9a, 0xc9, 0xe3, 0x88, 0x16, 0x57,
+	0xad, 0x68, 0xd9, 0x3d, 0xc5, 0x3e, 0x26, 0x30, 0xe5, 0x2b, 0x53, 0x4d, 0x35, 0x73, 0xfc, 0xf1,
+	0x3f, 0x2c, 0xac, 0x9f, 0x08, 0xbc, 0x14, 0xc3, 0x81, 0x52, 0xad, 0xc3, 0x79, 0x0e, 0x52, 0x45,
+	0x03, 0x94, 0xac, 0xf3, 0xdb, 0x73, 0xae, 0xe4, 0x5e, 0xb7, 0x7b, 0xfa, 0xed, 0x47, 0xe8, 0xd7,
+	0x13, 0x85, 0x17, 0x25, 0xaa, 0xb7, 0xfe, 0x7a, 0x4f, 0xd4, 0xab, 0x30, 0xc2, 0xb0, 0x37, 0x6b,
+	0x46, 0xd5, 0x30, 0x95, 0xb2, 0xa3, 0xe3, 0x24, 0x0c, 0x56, 0x71, 0xa8, 0x5d, 0x8a, 0xe0, 0x0c,
+	0x6d, 0x14, 0xc5, 0xb7, 0x60, 0xd4, 0xe7, 0x88, 0x7b, 0xbc, 0x02, 0xfd, 0x8e, 0x19, 0x1e, 0xb8,
+	0x63, 0x81, 0xdd, 0xb5, 0x9c, 0x5a, 0xa6, 0xe2, 0x63, 0x02, 0xa2, 0x67, 0x41, 0xa7, 0x22, 0xb9,
+	0x08, 0xff, 0xe2, 0xf3, 0xd0, 0xb5, 0x1c, 0x7f, 0x47, 0xe0, 0x52, 0x2c, 0x22, 0x2a, 0x70, 0x15,
+	0x06, 0x9c, 0x6d, 0x39, 0x09, 0x8e, 0x91, 0xa0, 0x6d, 0xdb, 0xbd, 0xac, 0xd6, 0x60, 0x92, 0x81,
+	0xbe, 0x63, 0x58, 0x6a, 0xae, 0x85, 0x6b, 0x3f, 0xd5, 0x92, 0x26, 0xd8, 0x7e, 0x93, 0x76, 0x6d,
+	0x87, 0xd4, 0x89, 0x0e, 0x3a, 0x73, 0x33, 0xf1, 0x36, 0xbe, 0x9d, 0xa1, 0x31, 0x51, 0x99, 0x39,
+	0x38, 0x69, 0x1b, 0x63, 0x5d, 0x8c, 0x06, 0x44, 0xb1, 0xad, 0xf3, 0xcc, 0x44, 0xfc, 0x84, 0x60,
+	0x9f, 0x60, 0x8f, 0x99, 0xb9, 0x63, 0x17, 0x68, 0xd7, 0xb2, 0xfe, 0x25, 0x81, 0x89, 0x70, 0x10,
+	0xdc, 0xd4, 0x02, 0x17, 0xca, 0x49, 0x75, 0xc4, 0xae, 0xb8, 0x4d, 0xf7, 0x52, 0xfc, 0x80, 0x60,
+	0x7b, 0x82, 0x58, 0x9e, 0xe4, 0xb6, 0x72, 0x47, 0x12, 0xe5, 0xae, 0x6b, 0x5a, 0x7d, 0xe1, 0x34,
+	0x05, 0x5e, 0xa8, 0xff, 0x55, 0xa8, 0x47, 0xfe, 0x96, 0x00, 0x5b, 0xa2, 0x1e, 0x38, 0x50, 0xf6,
+	0x09, 0x8c, 0x87, 0xa2, 0xf5, 0x42, 0xbb, 0xf2, 0x2a, 0x5c, 0x64, 0x6c, 0x77, 0x95, 0x72, 0xd9,
+	0x3e, 0xdb, 0xea, 0x65, 0x2b, 0xf1, 0xc7, 0xe1, 0x5d, 0x48, 0x05, 0x7d, 0x71, 0x53, 0x37, 0xe0,
+	0x94, 0x65, 0x0f, 0xe3, 0x21, 0x10, 0xec, 0x5b, 0x5d, 0x4e, 0xb9, 0x81, 0xa7, 0x7f, 0x4c, 0xf6,
+	0x1d, 0xfc, 0xf5, 0xc3, 0x3c, 0xc9, 0x73, 0x2f, 0xf1, 0x7d, 0xa0, 0x2e, 0xc9, 0x1c, 0xa2, 0x6e,
+	0x65, 0xe4, 0x01, 0x81, 0x61, 0xcf, 0xf2, 0xbd, 0x90, 0x89, 0x03, 0x02, 0xf3, 0xfc, 0xbb, 0xa3,
+	0xea, 0x45, 0x4d, 0x2f, 0xdd, 0xba, 0xaf, 0x6e, 0xd7, 0xed, 0x99, 0xde, 0xfb, 0x44, 0x7e, 0x4f,
+	0x60, 0x21, 0x11, 0x6a, 0xaf, 0x7c, 0x2a, 0xb3, 0xcf, 0x87, 0xe1, 0x14, 0x23, 0xa6, 0x9f, 0x11,
+	0x18, 0x68, 0x65, 0x91, 0x4e, 0x07, 0x30, 0x42, 0xef, 0xcc, 0xc2, 0x4c, 0x47, 0x3b, 0x1e, 0x54,
+	0x94, 0x3e, 0xfa, 0xed, 0xcf, 0x87, 0x27, 0x66, 0xe9, 0xb4, 0xec, 0xbf, 0xf5, 0x63, 0xc3, 0xaf,
+	0xef, 0x18, 0xf2, 0x1e, 0xfe, 0x2e, 0x36, 0xe9, 0xb7, 0x04, 0x86, 0x7c, 0x5d, 0x20, 0x5d, 0x8c,
+	0x09, 0x16, 0xb8, 0x4a, 0x0b, 0x4b, 0x09, 0xad, 0x11, 0x70, 0x95, 0x01, 0x4a, 0x74, 0x31, 0x02,
+	0x90, 0xf5, 0xac, 0x0d, 0xe4, 0xc4, 0xc2, 0x69, 0xd2, 0x47, 0x04, 0xce, 0xba, 0x6f, 0xa8, 0x74,
+	0x2e, 0x26, 0xaa, 0xf7, 0x16, 0x2d, 0xcc, 0x27, 0x31, 0x45, 0xba, 0x0c, 0xa3, 0x5b, 0xa0, 0x73,
+	0x11, 0x74, 0x78, 0xc1, 0x75, 0x2b, 0xb8, 0x4f, 0xe0, 0x9c, 0xe7, 0x1e, 0x48, 0xe3, 0x02, 0xfa,
+	0x6e, 0x12, 0xc2, 0x42, 0x22, 0x5b, 0xa4, 0x5b, 0x66, 0x74, 0xf3, 0x74, 0x36, 0x9c, 0xce, 0xdc,
+	0x2a, 0x34, 0xb6, 0xd8, 0x85, 0xc3, 0x56, 0xae, 0xa2, 0xe9, 0x4d, 0xfa, 0x0b, 0x81, 0x91, 0xb0,
+	0x0b, 0x18, 0xcd, 0x74, 0xca, 0x5a, 0xe0, 0xd2, 0x28, 0x64, 0x8f, 0xe3, 0x82, 0xc4, 0xd7, 0x18,
+	0xf1, 0x15, 0xba, 0x12, 0x97, 0x6d, 0x4d, 0x65, 0xe4, 0x7c, 0xca, 0xa5, 0xec, 0xcf, 0x41, 0x78,
+	0x2e, 0x70, 0x32, 0x78, 0x8f, 0xce, 0xd9, 0xe3, 0xb8, 0x20, 0xfc, 0x2b, 0x0c, 0x3e, 0x4b, 0x97,
+	0x13, 0xc0, 0x7b, 0x65, 0xff, 0x94, 0x40, 0xbf, 0x73, 0x9e, 0xd0, 0x97, 0xc3, 0x43, 0xfb, 0x5a,
+	0x4d, 0x61, 0xba, 0x93, 0x19, 0x52, 0xc9, 0x8c, 0x6a, 0x8e, 0xce, 0x04, 0xa8, 0x9c, 0x73, 0x4b,
+	0xde, 0x73, 0x7d, 0x37, 0x9b, 0xf4, 0x09, 0x81, 0x0b, 0xe1, 0x77, 0x09, 0xba, 0x12, 0x1f, 0x33,
+	0xf4, 0xe4, 0x17, 0x56, 0x8f, 0xe7, 0x84, 0xd8, 0xd7, 0x19, 0xf6, 0x1a, 0x5d, 0x8d, 0xc4, 0x6e,
+	0x17, 0x01, 0x1e, 0x02, 0xae, 0xf7, 0xff, 0x09, 0x81, 0xe1, 0x90, 0x96, 0x9f, 0x2e, 0x87, 0xb3,
+	0x44, 0xdf, 0x48, 0x84, 0xcc, 0x31, 0x3c, 0x10, 0xfd, 0x16, 0x43, 0x7f, 0x9d, 0xde, 0x08, 0xa0,
+	0xdb, 0x4d, 0xa4, 0x4d, 0xdd, 0xd2, 0xdb, 0x1e, 0xa8, 0x79, 0xf5, 0x97, 0xf7, 0xd8, 0x60, 0x93,
+	0x1e, 0x10, 0x18, 0xf2, 0x75, 0xf7, 0x51, 0x47, 0x6d, 0xf8, 0x6d, 0x44, 0x58, 0x4a, 0x68, 0xdd,
+	0xb1, 0x7e, 0x6d, 0x22, 0xd3, 0x0d, 0xee, 0x2b, 0x99, 0xaf, 0x08, 0x9c, 0x75, 0x37, 0xd7, 0x51,
+	0xc7, 0x6d, 0xc8, 0xad, 0x20, 0xea, 0xb8, 0x0d, 0xeb, 0xd5, 0x63, 0x6a, 0xb9, 0x45, 0x88, 0x8a,
+	0xa2, 0x86, 0x8f, 0x09, 0x9c, 0xf7, 0xb6, 0xb1, 0xb4, 0xc3, 0x09, 0xea, 0xe9, 0xc3, 0x85, 0xc5,
+	0x64, 0xc6, 0x88, 0xb7, 0xc2, 0xf0, 0x96, 0xe8, 0x42, 0xcc, 0x79, 0xcb, 0xbf, 0x08, 0xae, 0x52,
+	0xdd, 0x27, 0x30, 0xe8, 0x6a, 0x2e, 0xe9, 0x6c, 0x78, 0xc8, 0x60, 0xc3, 0x2b, 0xcc, 0x25, 0xb0,
+	0x44, 0xb2, 0x35, 0x46, 0xb6, 0x4c, 0xa5, 0xe8, 0xb7, 0xc9, 0x57, 0x85, 0xac, 0xaf, 0xa5, 0x16,
+	0x9c, 0xe6, 0x7b, 0xa5, 0x97, 0xe2, 0x94, 0x70, 0x88, 0x2e, 0xc7, 0x1b, 0x21, 0xcc, 0x24, 0x83,
+	0x19, 0xa3, 0x17, 0x23, 0x64, 0xa2, 0x87, 0x04, 0xd2, 0xf1, 0xad, 0x1a, 0xbd, 0x16, 0x71, 0xa8,
+	0x24, 0xe9, 0x45, 0x85, 0xeb, 0xff, 0xcc, 0xb9, 0xe3, 0xeb, 0x5d, 0xe5, 0x0b, 0x6c, 0xa9, 0xad,
+	0x15, 0xa2, 0x8f, 0xa8, 0xdc, 0x6b, 0x4f, 0x0f, 0xd3, 0xe4, 0xd9, 0x61, 0x9a, 0x3c, 0x3f, 0x4c,
+	0x93, 0xcf, 0x8f, 0xd2, 0x7d, 0xcf, 0x8e, 0xd2, 0x7d, 0xbf, 0x1f, 0xa5, 0xfb, 0xde, 0xbb, 0x5c,
+	0xd2, 0xac, 0x0f, 0xea, 0x05, 0x69, 0xdb, 0xa8, 0x38, 0x21, 0xf8, 0x7f, 0x4b, 0x66, 0xf1, 0x43,
+	0xf9, 0x3e, 0x8f, 0x57, 0x38, 0xcd, 0xfe, 0x6c, 0xb2, 0xf2, 0xf7, 0x00, 0x71, 0xd8, 0x89, 0x91,
+	0x11, 0x1a, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1651,6 +1775,9 @@ type QueryClient interface {
 	//
 	// Since: cosmos-sdk 0.47.1
 	Groups(ctx context.Context, in *QueryGroupsRequest, opts ...grpc.CallOption) (*QueryGroupsResponse, error)
+	// PendingExecutionsByGroupPolicy queries the accepted proposals of a group
+	// policy with a timelock which are not executed yet.
+	PendingExecutionsByGroupPolicy(ctx context.Context, in *QueryPendingExecutionsByGroupPolicyRequest, opts ...grpc.CallOption) (*QueryPendingExecutionsByGroupPolicyResponse, error)
 }
 
 type queryClient struct {
@@ -1787,6 +1914,15 @@ func (c *queryClient) Groups(ctx context.Context, in *QueryGroupsRequest, opts .
 	return out, nil
 }
 
+func (c *queryClient) PendingExecutionsByGroupPolicy(ctx context.Context, in *QueryPendingExecutionsByGroupPolicyRequest, opts ...grpc.CallOption) (*QueryPendingExecutionsByGroupPolicyResponse, error) {
+	out := new(QueryPendingExecutionsByGroupPolicyResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.group.v1.Query/PendingExecutionsByGroupPolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// GroupInfo queries group info based on group id.
@@ -1823,6 +1959,9 @@ type QueryServer interface {
 	//
 	// Since: cosmos-sdk 0.47.1
 	Groups(context.Context, *QueryGroupsRequest) (*QueryGroupsResponse, error)
+	// PendingExecutionsByGroupPolicy queries the accepted proposals of a group
+	// policy with a timelock which are not executed yet.
+	PendingExecutionsByGroupPolicy(context.Context, *QueryPendingExecutionsByGroupPolicyRequest) (*QueryPendingExecutionsByGroupPolicyResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -1871,6 +2010,9 @@ func (*UnimplementedQueryServer) TallyResult(ctx context.Context, req *QueryTall
 func (*UnimplementedQueryServer) Groups(ctx context.Context, req *QueryGroupsRequest) (*QueryGroupsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Groups not implemented")
 }
+func (*UnimplementedQueryServer) PendingExecutionsByGroupPolicy(ctx context.Context, req *QueryPendingExecutionsByGroupPolicyRequest) (*QueryPendingExecutionsByGroupPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PendingExecutionsByGroupPolicy not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -2128,6 +2270,24 @@ func _Query_Groups_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_PendingExecutionsByGroupPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPendingExecutionsByGroupPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PendingExecutionsByGroupPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.group.v1.Query/PendingExecutionsByGroupPolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PendingExecutionsByGroupPolicy(ctx, req.(*QueryPendingExecutionsByGroupPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.group.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -2188,6 +2348,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Groups",
 			Handler:    _Query_Groups_Handler,
 		},
+		{
+			MethodName: "PendingExecutionsByGroupPolicy",
+			Handler:    _Query_PendingExecutionsByGroupPolicy_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/group/v1/query.proto",
@@ -3321,6 +3485,97 @@ func (m *QueryGroupsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryPendingExecutionsByGroupPolicyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingExecutionsByGroupPolicyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingExecutionsByGroupPolicyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingExecutionsByGroupPolicyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingExecutionsByGroupPolicyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingExecutionsByGroupPolicyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Proposals) > 0 {
+		for iNdEx := len(m.Proposals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Proposals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -3777,6 +4032,42 @@ func (m *QueryGroupsResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryPendingExecutionsByGroupPolicyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPendingExecutionsByGroupPolicyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Proposals) > 0 {
+		for _, e := range m.Proposals {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -6671,6 +6962,244 @@ func (m *QueryGroupsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryPendingExecutionsByGroupPolicyRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPendingExecutionsByGroupPolicyRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPendingExecutionsByGroupPolicyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPendingExecutionsByGroupPolicyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPendingExecutionsByGroupPolicyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPendingExecutionsByGroupPolicyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proposals = append(m.Proposals, &Proposal{})
+			if err := m.Proposals[len(m.Proposals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
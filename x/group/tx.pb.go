@@ -13,18 +13,22 @@ import (
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -960,6 +964,117 @@ func (m *MsgUpdateGroupPolicyMetadataResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateGroupPolicyMetadataResponse proto.InternalMessageInfo
 
+// MsgUpdateGroupPolicyGuardian is the Msg/UpdateGroupPolicyGuardian request type.
+type MsgUpdateGroupPolicyGuardian struct {
+	// admin is the account address of the group admin.
+	Admin string `protobuf:"bytes,1,opt,name=admin,proto3" json:"admin,omitempty"`
+	// group_policy_address is the account address of group policy.
+	GroupPolicyAddress string `protobuf:"bytes,2,opt,name=group_policy_address,json=groupPolicyAddress,proto3" json:"group_policy_address,omitempty"`
+	// guardian is the updated group policy guardian, it can be empty to remove
+	// the guardian.
+	Guardian string `protobuf:"bytes,3,opt,name=guardian,proto3" json:"guardian,omitempty"`
+	// timelock is the updated group policy timelock.
+	Timelock time.Duration `protobuf:"bytes,4,opt,name=timelock,proto3,stdduration" json:"timelock"`
+}
+
+func (m *MsgUpdateGroupPolicyGuardian) Reset()         { *m = MsgUpdateGroupPolicyGuardian{} }
+func (m *MsgUpdateGroupPolicyGuardian) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateGroupPolicyGuardian) ProtoMessage()    {}
+func (*MsgUpdateGroupPolicyGuardian) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6b8d3d629f136420, []int{18}
+}
+func (m *MsgUpdateGroupPolicyGuardian) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateGroupPolicyGuardian) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateGroupPolicyGuardian.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateGroupPolicyGuardian) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateGroupPolicyGuardian.Merge(m, src)
+}
+func (m *MsgUpdateGroupPolicyGuardian) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateGroupPolicyGuardian) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateGroupPolicyGuardian.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateGroupPolicyGuardian proto.InternalMessageInfo
+
+func (m *MsgUpdateGroupPolicyGuardian) GetAdmin() string {
+	if m != nil {
+		return m.Admin
+	}
+	return ""
+}
+
+func (m *MsgUpdateGroupPolicyGuardian) GetGroupPolicyAddress() string {
+	if m != nil {
+		return m.GroupPolicyAddress
+	}
+	return ""
+}
+
+func (m *MsgUpdateGroupPolicyGuardian) GetGuardian() string {
+	if m != nil {
+		return m.Guardian
+	}
+	return ""
+}
+
+func (m *MsgUpdateGroupPolicyGuardian) GetTimelock() time.Duration {
+	if m != nil {
+		return m.Timelock
+	}
+	return 0
+}
+
+// MsgUpdateGroupPolicyGuardianResponse is the Msg/UpdateGroupPolicyGuardian response type.
+type MsgUpdateGroupPolicyGuardianResponse struct {
+}
+
+func (m *MsgUpdateGroupPolicyGuardianResponse) Reset()         { *m = MsgUpdateGroupPolicyGuardianResponse{} }
+func (m *MsgUpdateGroupPolicyGuardianResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateGroupPolicyGuardianResponse) ProtoMessage()    {}
+func (*MsgUpdateGroupPolicyGuardianResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6b8d3d629f136420, []int{19}
+}
+func (m *MsgUpdateGroupPolicyGuardianResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateGroupPolicyGuardianResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateGroupPolicyGuardianResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateGroupPolicyGuardianResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateGroupPolicyGuardianResponse.Merge(m, src)
+}
+func (m *MsgUpdateGroupPolicyGuardianResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateGroupPolicyGuardianResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateGroupPolicyGuardianResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateGroupPolicyGuardianResponse proto.InternalMessageInfo
+
 // MsgSubmitProposal is the Msg/SubmitProposal request type.
 type MsgSubmitProposal struct {
 	// group_policy_address is the account address of group policy.
@@ -989,7 +1104,7 @@ func (m *MsgSubmitProposal) Reset()         { *m = MsgSubmitProposal{} }
 func (m *MsgSubmitProposal) String() string { return proto.CompactTextString(m) }
 func (*MsgSubmitProposal) ProtoMessage()    {}
 func (*MsgSubmitProposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6b8d3d629f136420, []int{18}
+	return fileDescriptor_6b8d3d629f136420, []int{20}
 }
 func (m *MsgSubmitProposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1028,7 +1143,7 @@ func (m *MsgSubmitProposalResponse) Reset()         { *m = MsgSubmitProposalResp
 func (m *MsgSubmitProposalResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgSubmitProposalResponse) ProtoMessage()    {}
 func (*MsgSubmitProposalResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6b8d3d629f136420, []int{19}
+	return fileDescriptor_6b8d3d629f136420, []int{21}
 }
 func (m *MsgSubmitProposalResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1076,7 +1191,7 @@ func (m *MsgWithdrawProposal) Reset()         { *m = MsgWithdrawProposal{} }
 func (m *MsgWithdrawProposal) String() string { return proto.CompactTextString(m) }
 func (*MsgWithdrawProposal) ProtoMessage()    {}
 func (*MsgWithdrawProposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6b8d3d629f136420, []int{20}
+	return fileDescriptor_6b8d3d629f136420, []int{22}
 }
 func (m *MsgWithdrawProposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1127,7 +1242,7 @@ func (m *MsgWithdrawProposalResponse) Reset()         { *m = MsgWithdrawProposal
 func (m *MsgWithdrawProposalResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgWithdrawProposalResponse) ProtoMessage()    {}
 func (*MsgWithdrawProposalResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6b8d3d629f136420, []int{21}
+	return fileDescriptor_6b8d3d629f136420, []int{23}
 }
 func (m *MsgWithdrawProposalResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1175,7 +1290,7 @@ func (m *MsgVote) Reset()         { *m = MsgVote{} }
 func (m *MsgVote) String() string { return proto.CompactTextString(m) }
 func (*MsgVote) ProtoMessage()    {}
 func (*MsgVote) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6b8d3d629f136420, []int{22}
+	return fileDescriptor_6b8d3d629f136420, []int{24}
 }
 func (m *MsgVote) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1247,7 +1362,7 @@ func (m *MsgVoteResponse) Reset()         { *m = MsgVoteResponse{} }
 func (m *MsgVoteResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgVoteResponse) ProtoMessage()    {}
 func (*MsgVoteResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6b8d3d629f136420, []int{23}
+	return fileDescriptor_6b8d3d629f136420, []int{25}
 }
 func (m *MsgVoteResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1288,7 +1403,7 @@ func (m *MsgExec) Reset()         { *m = MsgExec{} }
 func (m *MsgExec) String() string { return proto.CompactTextString(m) }
 func (*MsgExec) ProtoMessage()    {}
 func (*MsgExec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6b8d3d629f136420, []int{24}
+	return fileDescriptor_6b8d3d629f136420, []int{26}
 }
 func (m *MsgExec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1341,7 +1456,7 @@ func (m *MsgExecResponse) Reset()         { *m = MsgExecResponse{} }
 func (m *MsgExecResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgExecResponse) ProtoMessage()    {}
 func (*MsgExecResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6b8d3d629f136420, []int{25}
+	return fileDescriptor_6b8d3d629f136420, []int{27}
 }
 func (m *MsgExecResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1389,7 +1504,7 @@ func (m *MsgLeaveGroup) Reset()         { *m = MsgLeaveGroup{} }
 func (m *MsgLeaveGroup) String() string { return proto.CompactTextString(m) }
 func (*MsgLeaveGroup) ProtoMessage()    {}
 func (*MsgLeaveGroup) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6b8d3d629f136420, []int{26}
+	return fileDescriptor_6b8d3d629f136420, []int{28}
 }
 func (m *MsgLeaveGroup) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1440,7 +1555,7 @@ func (m *MsgLeaveGroupResponse) Reset()         { *m = MsgLeaveGroupResponse{} }
 func (m *MsgLeaveGroupResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgLeaveGroupResponse) ProtoMessage()    {}
 func (*MsgLeaveGroupResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6b8d3d629f136420, []int{27}
+	return fileDescriptor_6b8d3d629f136420, []int{29}
 }
 func (m *MsgLeaveGroupResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1469,6 +1584,98 @@ func (m *MsgLeaveGroupResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgLeaveGroupResponse proto.InternalMessageInfo
 
+// MsgVetoProposal is the Msg/VetoProposal request type.
+type MsgVetoProposal struct {
+	// proposal is the unique ID of the proposal.
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	// guardian is the account address of the group policy guardian.
+	Guardian string `protobuf:"bytes,2,opt,name=guardian,proto3" json:"guardian,omitempty"`
+}
+
+func (m *MsgVetoProposal) Reset()         { *m = MsgVetoProposal{} }
+func (m *MsgVetoProposal) String() string { return proto.CompactTextString(m) }
+func (*MsgVetoProposal) ProtoMessage()    {}
+func (*MsgVetoProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6b8d3d629f136420, []int{30}
+}
+func (m *MsgVetoProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgVetoProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgVetoProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgVetoProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgVetoProposal.Merge(m, src)
+}
+func (m *MsgVetoProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgVetoProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgVetoProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgVetoProposal proto.InternalMessageInfo
+
+func (m *MsgVetoProposal) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
+	}
+	return 0
+}
+
+func (m *MsgVetoProposal) GetGuardian() string {
+	if m != nil {
+		return m.Guardian
+	}
+	return ""
+}
+
+// MsgVetoProposalResponse is the Msg/VetoProposal response type.
+type MsgVetoProposalResponse struct {
+}
+
+func (m *MsgVetoProposalResponse) Reset()         { *m = MsgVetoProposalResponse{} }
+func (m *MsgVetoProposalResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgVetoProposalResponse) ProtoMessage()    {}
+func (*MsgVetoProposalResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6b8d3d629f136420, []int{31}
+}
+func (m *MsgVetoProposalResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgVetoProposalResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgVetoProposalResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgVetoProposalResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgVetoProposalResponse.Merge(m, src)
+}
+func (m *MsgVetoProposalResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgVetoProposalResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgVetoProposalResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgVetoProposalResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterEnum("cosmos.group.v1.Exec", Exec_name, Exec_value)
 	proto.RegisterType((*MsgCreateGroup)(nil), "cosmos.group.v1.MsgCreateGroup")
@@ -1489,6 +1696,8 @@ func init() {
 	proto.RegisterType((*MsgUpdateGroupPolicyDecisionPolicyResponse)(nil), "cosmos.group.v1.MsgUpdateGroupPolicyDecisionPolicyResponse")
 	proto.RegisterType((*MsgUpdateGroupPolicyMetadata)(nil), "cosmos.group.v1.MsgUpdateGroupPolicyMetadata")
 	proto.RegisterType((*MsgUpdateGroupPolicyMetadataResponse)(nil), "cosmos.group.v1.MsgUpdateGroupPolicyMetadataResponse")
+	proto.RegisterType((*MsgUpdateGroupPolicyGuardian)(nil), "cosmos.group.v1.MsgUpdateGroupPolicyGuardian")
+	proto.RegisterType((*MsgUpdateGroupPolicyGuardianResponse)(nil), "cosmos.group.v1.MsgUpdateGroupPolicyGuardianResponse")
 	proto.RegisterType((*MsgSubmitProposal)(nil), "cosmos.group.v1.MsgSubmitProposal")
 	proto.RegisterType((*MsgSubmitProposalResponse)(nil), "cosmos.group.v1.MsgSubmitProposalResponse")
 	proto.RegisterType((*MsgWithdrawProposal)(nil), "cosmos.group.v1.MsgWithdrawProposal")
@@ -1499,103 +1708,114 @@ func init() {
 	proto.RegisterType((*MsgExecResponse)(nil), "cosmos.group.v1.MsgExecResponse")
 	proto.RegisterType((*MsgLeaveGroup)(nil), "cosmos.group.v1.MsgLeaveGroup")
 	proto.RegisterType((*MsgLeaveGroupResponse)(nil), "cosmos.group.v1.MsgLeaveGroupResponse")
+	proto.RegisterType((*MsgVetoProposal)(nil), "cosmos.group.v1.MsgVetoProposal")
+	proto.RegisterType((*MsgVetoProposalResponse)(nil), "cosmos.group.v1.MsgVetoProposalResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/group/v1/tx.proto", fileDescriptor_6b8d3d629f136420) }
 
 var fileDescriptor_6b8d3d629f136420 = []byte{
-	// 1442 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x58, 0xcf, 0x6f, 0x1b, 0xc5,
-	0x17, 0xcf, 0xda, 0xce, 0xaf, 0x97, 0x6f, 0xdd, 0x64, 0x9b, 0xb4, 0x9b, 0x6d, 0x6b, 0xbb, 0xd3,
-	0xb4, 0x49, 0xad, 0xc6, 0x6e, 0x9c, 0x6f, 0x2b, 0x61, 0x10, 0xa8, 0x49, 0x0d, 0x0a, 0xc2, 0x10,
-	0x6d, 0x5b, 0x0a, 0x5c, 0xcc, 0x26, 0xde, 0x6e, 0x57, 0x64, 0xbd, 0xc6, 0xb3, 0x4e, 0x93, 0x1b,
-	0x3f, 0x2e, 0x80, 0x90, 0x40, 0x82, 0x3f, 0x00, 0x6e, 0x1c, 0x8b, 0xd4, 0x03, 0x37, 0x6e, 0xa8,
-	0x2a, 0x97, 0x8a, 0x13, 0x27, 0x04, 0xad, 0x50, 0x6f, 0xfc, 0x0b, 0xa0, 0x9d, 0xd9, 0x1d, 0xef,
-	0x78, 0x77, 0xbd, 0x5b, 0xcb, 0x82, 0x4b, 0x94, 0x9d, 0xf7, 0x79, 0xbf, 0x3e, 0xef, 0xcd, 0x9b,
-	0x19, 0x83, 0xb4, 0x6b, 0x61, 0xd3, 0xc2, 0x65, 0xbd, 0x63, 0x75, 0xdb, 0xe5, 0xfd, 0xb5, 0xb2,
-	0x7d, 0x50, 0x6a, 0x77, 0x2c, 0xdb, 0x12, 0x8f, 0x52, 0x49, 0x89, 0x48, 0x4a, 0xfb, 0x6b, 0xf2,
-	0xbc, 0x6e, 0xe9, 0x16, 0x91, 0x95, 0x9d, 0xff, 0x28, 0x4c, 0x5e, 0xa4, 0xb0, 0x06, 0x15, 0xb8,
-	0x3a, 0xae, 0x48, 0xb7, 0x2c, 0x7d, 0x4f, 0x2b, 0x93, 0xaf, 0x9d, 0xee, 0xed, 0xb2, 0xda, 0x3a,
-	0x74, 0x45, 0x27, 0x03, 0x6e, 0x0f, 0xdb, 0x9a, 0xa7, 0x77, 0xc2, 0x15, 0x9a, 0x58, 0x77, 0x44,
-	0x26, 0xd6, 0x5d, 0xc1, 0x9c, 0x6a, 0x1a, 0x2d, 0xab, 0x4c, 0xfe, 0xd2, 0x25, 0xf4, 0xb3, 0x00,
-	0xd9, 0x3a, 0xd6, 0x37, 0x3b, 0x9a, 0x6a, 0x6b, 0xaf, 0x38, 0xd6, 0xc4, 0x12, 0x8c, 0xab, 0x4d,
-	0xd3, 0x68, 0x49, 0x42, 0x41, 0x58, 0x99, 0xde, 0x90, 0x7e, 0xb9, 0xbf, 0x3a, 0xef, 0xc6, 0x75,
-	0xb5, 0xd9, 0xec, 0x68, 0x18, 0x5f, 0xb7, 0x3b, 0x46, 0x4b, 0x57, 0x28, 0x4c, 0xdc, 0x84, 0x49,
-	0x53, 0x33, 0x77, 0xb4, 0x0e, 0x96, 0x52, 0x85, 0xf4, 0xca, 0x4c, 0x25, 0x57, 0xea, 0x4b, 0xbd,
-	0x54, 0x27, 0x72, 0x45, 0x7b, 0xbf, 0xab, 0x61, 0x7b, 0x63, 0xfa, 0xc1, 0x6f, 0xf9, 0xb1, 0xef,
-	0x9e, 0xde, 0x2b, 0x0a, 0x8a, 0xa7, 0x29, 0xca, 0x30, 0x65, 0x6a, 0xb6, 0xda, 0x54, 0x6d, 0x55,
-	0x4a, 0x3b, 0x7e, 0x15, 0xf6, 0x5d, 0x5d, 0xf9, 0xe8, 0xe9, 0xbd, 0x22, 0x75, 0xf6, 0xd9, 0xd3,
-	0x7b, 0x45, 0x97, 0xb1, 0x55, 0xdc, 0x7c, 0xaf, 0xcc, 0x87, 0x8e, 0xd6, 0xe1, 0x38, 0xbf, 0xa2,
-	0x68, 0xb8, 0x6d, 0xb5, 0xb0, 0x26, 0x2e, 0xc2, 0x14, 0x89, 0xa6, 0x61, 0x34, 0x49, 0x5e, 0x19,
-	0x65, 0x92, 0x7c, 0x6f, 0x35, 0xd1, 0x9f, 0x02, 0x2c, 0xd4, 0xb1, 0x7e, 0xb3, 0xdd, 0xf4, 0xb4,
-	0xea, 0x6e, 0x50, 0xcf, 0xca, 0x84, 0xdf, 0x49, 0x8a, 0x73, 0x22, 0x6e, 0x43, 0x96, 0xa6, 0xda,
-	0xe8, 0x12, 0x3f, 0x58, 0x4a, 0x3f, 0x2b, 0x57, 0x47, 0xa8, 0x01, 0x1a, 0x27, 0xae, 0x96, 0x79,
-	0x56, 0x0a, 0x3c, 0x2b, 0xc1, 0x6c, 0x50, 0x1e, 0x4e, 0x87, 0x0a, 0x3c, 0x8e, 0xd0, 0x4f, 0x02,
-	0x1c, 0xe3, 0x11, 0x57, 0x49, 0x5a, 0x23, 0xa4, 0xe1, 0x32, 0x4c, 0xb7, 0xb4, 0xbb, 0x0d, 0x6a,
-	0x2e, 0x1d, 0x63, 0x6e, 0xaa, 0xa5, 0xdd, 0x25, 0x11, 0x54, 0x57, 0xf9, 0x5c, 0x73, 0x91, 0xb9,
-	0x12, 0x38, 0x3a, 0x0d, 0x27, 0x43, 0x96, 0x59, 0x9e, 0xdf, 0x0b, 0xa4, 0x4d, 0x38, 0x26, 0x68,
-	0xab, 0x8d, 0x32, 0xd5, 0x41, 0x1d, 0x7d, 0x89, 0xcf, 0xe7, 0xcc, 0x80, 0xda, 0x51, 0x0d, 0x54,
-	0x80, 0x5c, 0xb8, 0x84, 0x65, 0xf5, 0x75, 0x0a, 0xe6, 0xf9, 0xe6, 0xdf, 0xb6, 0xf6, 0x8c, 0xdd,
-	0xc3, 0x7f, 0x29, 0x27, 0x51, 0x85, 0xa3, 0x4d, 0x6d, 0xd7, 0xc0, 0x86, 0xd5, 0x6a, 0xb4, 0x89,
-	0x67, 0x29, 0x53, 0x10, 0x56, 0x66, 0x2a, 0xf3, 0x25, 0x3a, 0xc7, 0x4a, 0xde, 0x1c, 0x2b, 0x5d,
-	0x6d, 0x1d, 0x6e, 0xa0, 0x87, 0xf7, 0x57, 0x73, 0xfd, 0xbd, 0x7f, 0xcd, 0x35, 0x40, 0x23, 0x57,
-	0xb2, 0x4d, 0xee, 0xbb, 0x5a, 0xf9, 0xe4, 0x9b, 0xfc, 0x18, 0x4f, 0x5d, 0x3e, 0x72, 0x18, 0x50,
-	0x1d, 0xa4, 0xc0, 0xa9, 0xb0, 0x75, 0x36, 0x18, 0x2a, 0x30, 0xa9, 0x52, 0x16, 0x62, 0xf9, 0xf1,
-	0x80, 0xe8, 0xe3, 0x14, 0x2c, 0xf2, 0xd5, 0xa0, 0x46, 0x87, 0xdb, 0x2e, 0xaf, 0xc2, 0x3c, 0xe5,
-	0x9b, 0xb2, 0xd6, 0xf0, 0xc2, 0x49, 0xc5, 0xa8, 0x8b, 0xba, 0xdf, 0x33, 0x91, 0x0c, 0xbb, 0xbf,
-	0xd6, 0x79, 0x52, 0x97, 0x22, 0xfb, 0xd1, 0x97, 0x27, 0x3a, 0x0b, 0x67, 0x22, 0x85, 0xac, 0x2b,
-	0x7f, 0x48, 0x83, 0xc4, 0xf3, 0x7f, 0xcb, 0xb0, 0xef, 0x0c, 0xd9, 0x99, 0x23, 0x39, 0x69, 0xce,
-	0x41, 0x96, 0xd2, 0xdd, 0xd7, 0xc9, 0x47, 0x74, 0x6e, 0x12, 0x54, 0x60, 0x81, 0xab, 0x0a, 0x43,
-	0x67, 0x08, 0xfa, 0x98, 0x8f, 0x7c, 0xa6, 0xb3, 0xd6, 0xa7, 0xa3, 0x62, 0xb7, 0x12, 0xe3, 0x05,
-	0x61, 0x65, 0x8a, 0x2f, 0x18, 0xa6, 0xcd, 0x12, 0xb2, 0x6b, 0x26, 0x46, 0xbc, 0x6b, 0xae, 0x04,
-	0x77, 0xcd, 0xd9, 0xc8, 0x5d, 0xd3, 0xab, 0x0e, 0xfa, 0x54, 0x80, 0x42, 0x94, 0x30, 0xc1, 0xb9,
-	0x3a, 0xca, 0xbe, 0x46, 0x3f, 0xa6, 0x00, 0x85, 0x35, 0x1b, 0x9f, 0xfa, 0x7f, 0xba, 0xf5, 0x42,
-	0x2a, 0x99, 0x1e, 0x71, 0x25, 0xab, 0xc1, 0x4a, 0x2e, 0x47, 0x6e, 0x55, 0xde, 0x16, 0xba, 0x08,
-	0xc5, 0x78, 0x02, 0xd9, 0xb6, 0xfd, 0x4b, 0x20, 0x63, 0x33, 0x00, 0x1f, 0xfa, 0xa0, 0x1c, 0x25,
-	0xd3, 0x83, 0x4e, 0xd6, 0x2b, 0x49, 0xe9, 0xe1, 0xf3, 0x41, 0xe7, 0x61, 0x69, 0x90, 0x9c, 0x11,
-	0xf3, 0x47, 0x0a, 0xe6, 0xea, 0x58, 0xbf, 0xde, 0xdd, 0x31, 0x0d, 0x7b, 0xbb, 0x63, 0xb5, 0x2d,
-	0xac, 0xee, 0x45, 0x66, 0x27, 0x0c, 0x91, 0xdd, 0x29, 0x98, 0x6e, 0x13, 0xbb, 0xde, 0x98, 0x9b,
-	0x56, 0x7a, 0x0b, 0x03, 0x4f, 0xe0, 0x4b, 0x8e, 0x0c, 0x63, 0x55, 0xd7, 0xb0, 0x94, 0x21, 0xf3,
-	0x31, 0xb4, 0xf5, 0x14, 0x86, 0x12, 0x2f, 0x40, 0x46, 0x3b, 0xd0, 0x76, 0xc9, 0x7c, 0xca, 0x56,
-	0x16, 0x02, 0xd3, 0xb4, 0x76, 0xa0, 0xed, 0x2a, 0x04, 0x22, 0xce, 0xc3, 0xb8, 0x6d, 0xd8, 0x7b,
-	0x1a, 0x19, 0x4f, 0xd3, 0x0a, 0xfd, 0x10, 0x25, 0x98, 0xc4, 0x5d, 0xd3, 0x54, 0x3b, 0x87, 0xd2,
-	0x24, 0x59, 0xf7, 0x3e, 0xab, 0xcf, 0x79, 0xbd, 0xda, 0x0b, 0xde, 0x29, 0x08, 0xf2, 0x15, 0x84,
-	0x3e, 0x5e, 0x02, 0x6c, 0xa2, 0x17, 0xc8, 0xe9, 0xca, 0x2f, 0xb2, 0x81, 0x93, 0x87, 0x99, 0xb6,
-	0xbb, 0xd6, 0x9b, 0x39, 0xe0, 0x2d, 0x6d, 0x35, 0xd1, 0xb7, 0xf4, 0x16, 0xeb, 0xcc, 0xaa, 0x66,
-	0x47, 0xbd, 0xcb, 0x6a, 0x14, 0xa7, 0xe8, 0xbf, 0x09, 0xa4, 0x12, 0xde, 0x04, 0xaa, 0x97, 0x9d,
-	0x0c, 0xbd, 0xaf, 0xfe, 0xa3, 0x93, 0xe5, 0xd7, 0x1f, 0x8b, 0x7b, 0x41, 0xed, 0x5f, 0x66, 0x4d,
-	0xf6, 0xb7, 0x00, 0x93, 0x75, 0xac, 0xbf, 0x69, 0xd9, 0xf1, 0xf9, 0x3a, 0x3b, 0x71, 0xdf, 0xb2,
-	0xb5, 0x4e, 0x6c, 0xd0, 0x14, 0x26, 0xae, 0xc3, 0x84, 0xd5, 0xb6, 0x0d, 0x8b, 0xde, 0x0f, 0xb2,
-	0x95, 0x93, 0x81, 0xaa, 0x3b, 0x7e, 0xdf, 0x20, 0x10, 0xc5, 0x85, 0x72, 0x6d, 0x97, 0xe9, 0x6b,
-	0xbb, 0xe4, 0x4d, 0x54, 0x5d, 0x26, 0xbb, 0x93, 0xc4, 0xe1, 0x90, 0x25, 0x85, 0x91, 0xe5, 0x78,
-	0x47, 0x73, 0x70, 0xd4, 0xfd, 0x97, 0x91, 0xf2, 0x39, 0x25, 0xc5, 0xb1, 0x16, 0x4f, 0xca, 0xff,
-	0x61, 0xca, 0x71, 0xd8, 0xb5, 0xad, 0x78, 0x5e, 0x18, 0xb2, 0x5a, 0x74, 0xc2, 0x63, 0x9f, 0x91,
-	0x11, 0x3a, 0x21, 0x20, 0x85, 0x44, 0x48, 0x72, 0xf3, 0x5a, 0xf3, 0x25, 0x98, 0xe8, 0x68, 0xb8,
-	0xbb, 0x67, 0x13, 0x97, 0xd9, 0xca, 0x72, 0x80, 0x0a, 0xaf, 0xd2, 0x35, 0xd7, 0x85, 0x42, 0xe0,
-	0x8a, 0xab, 0x86, 0xbe, 0x10, 0xe0, 0x48, 0x1d, 0xeb, 0xaf, 0x69, 0xea, 0xbe, 0xfb, 0x16, 0x1f,
-	0xe2, 0x76, 0x3a, 0xe0, 0xfe, 0x4e, 0xdf, 0x8c, 0xfe, 0x76, 0xcd, 0x85, 0xe5, 0xd7, 0xf3, 0x8f,
-	0x4e, 0x90, 0xa7, 0x71, 0x6f, 0xc1, 0xcb, 0xb5, 0x58, 0x84, 0x4c, 0x8d, 0x8e, 0x85, 0xd9, 0xda,
-	0x5b, 0xb5, 0xcd, 0xc6, 0xcd, 0xd7, 0xaf, 0x6f, 0xd7, 0x36, 0xb7, 0x5e, 0xde, 0xaa, 0x5d, 0x9b,
-	0x1d, 0x13, 0xff, 0x07, 0x53, 0x64, 0xf5, 0x86, 0xf2, 0xf6, 0xac, 0x50, 0x79, 0x38, 0x03, 0xe9,
-	0x3a, 0xd6, 0xc5, 0x5b, 0x30, 0xe3, 0xff, 0x9d, 0x21, 0x1f, 0xbc, 0xbc, 0x71, 0xb7, 0x0d, 0x79,
-	0x39, 0x06, 0xc0, 0x88, 0xdf, 0x03, 0x31, 0xe4, 0xf5, 0x7e, 0x3e, 0x4c, 0x3d, 0x88, 0x93, 0x4b,
-	0xc9, 0x70, 0xcc, 0xdb, 0x6d, 0x98, 0x0d, 0x3c, 0x91, 0x97, 0x62, 0x6c, 0x10, 0x94, 0x7c, 0x31,
-	0x09, 0x8a, 0xf9, 0xb1, 0xe0, 0x58, 0xd8, 0x13, 0x75, 0x39, 0x36, 0x5c, 0x0a, 0x94, 0xcb, 0x09,
-	0x81, 0xcc, 0xa1, 0x01, 0x73, 0xc1, 0xd7, 0xe3, 0xb9, 0x98, 0x22, 0x50, 0x98, 0xbc, 0x9a, 0x08,
-	0xc6, 0x5c, 0x75, 0x61, 0x21, 0xfc, 0x49, 0x70, 0x21, 0xc6, 0x4e, 0x0f, 0x2a, 0xaf, 0x25, 0x86,
-	0x32, 0xb7, 0x07, 0x70, 0x3c, 0xe2, 0xd1, 0x56, 0x8c, 0x21, 0xcb, 0x87, 0x95, 0x2b, 0xc9, 0xb1,
-	0xcc, 0xf3, 0x57, 0x02, 0xe4, 0xe3, 0x6e, 0xaf, 0xeb, 0x89, 0xec, 0xf2, 0x4a, 0xf2, 0xf3, 0x43,
-	0x28, 0xb1, 0xa8, 0x3e, 0x14, 0x60, 0x31, 0xfa, 0x8e, 0xb7, 0x9a, 0xc8, 0x34, 0xeb, 0xb7, 0xcb,
-	0xcf, 0x04, 0x67, 0x31, 0xbc, 0x0b, 0xd9, 0xbe, 0xdb, 0x14, 0x0a, 0x33, 0xc4, 0x63, 0xe4, 0x62,
-	0x3c, 0xc6, 0xbf, 0x61, 0x03, 0xb7, 0x81, 0xd0, 0x0d, 0xdb, 0x8f, 0x0a, 0xdf, 0xb0, 0x51, 0xc7,
-	0xb6, 0xb8, 0x01, 0x19, 0x72, 0x64, 0x4b, 0x61, 0x5a, 0x8e, 0x44, 0x2e, 0x44, 0x49, 0xfc, 0x36,
-	0xc8, 0x5c, 0x0d, 0xb5, 0xe1, 0x48, 0xc2, 0x6d, 0x70, 0xe7, 0xd0, 0x0d, 0x00, 0xdf, 0x11, 0x92,
-	0x0b, 0xc3, 0xf7, 0xe4, 0xf2, 0xf9, 0xc1, 0x72, 0xcf, 0xaa, 0x3c, 0xfe, 0x81, 0xf3, 0x8e, 0xde,
-	0x78, 0xf1, 0xc1, 0xe3, 0x9c, 0xf0, 0xe8, 0x71, 0x4e, 0xf8, 0xfd, 0x71, 0x4e, 0xf8, 0xf2, 0x49,
-	0x6e, 0xec, 0xd1, 0x93, 0xdc, 0xd8, 0xaf, 0x4f, 0x72, 0x63, 0xef, 0x2c, 0xe9, 0x86, 0x7d, 0xa7,
-	0xbb, 0x53, 0xda, 0xb5, 0x4c, 0xf7, 0x77, 0xec, 0xb2, 0xef, 0x74, 0x39, 0xa0, 0xe7, 0xcb, 0xce,
-	0x04, 0xb9, 0x8a, 0xae, 0xff, 0x13, 0x00, 0x00, 0xff, 0xff, 0x9a, 0x17, 0xcf, 0x29, 0x39, 0x17,
-	0x00, 0x00,
+	// 1585 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x59, 0xcf, 0x6f, 0x1b, 0xc5,
+	0x17, 0xcf, 0xda, 0x4e, 0xe2, 0xbc, 0x34, 0x4e, 0xb2, 0x4d, 0x5a, 0x67, 0xdb, 0xda, 0xee, 0x36,
+	0x6d, 0x52, 0xab, 0xb1, 0x1b, 0xa7, 0xa9, 0xf4, 0xf5, 0x17, 0x81, 0x9a, 0x1f, 0x54, 0x41, 0x18,
+	0xa2, 0x6d, 0x4b, 0xa1, 0x97, 0xb0, 0xf1, 0x6e, 0xb7, 0xab, 0xda, 0x5e, 0xe3, 0x59, 0xa7, 0xc9,
+	0x8d, 0x1f, 0x17, 0x40, 0x48, 0x20, 0xc1, 0x81, 0x03, 0x07, 0xb8, 0x21, 0x4e, 0x45, 0xea, 0x81,
+	0x1b, 0x37, 0x54, 0x81, 0x84, 0x2a, 0x4e, 0x9c, 0xf8, 0xd1, 0x0a, 0xf5, 0xc6, 0xbf, 0x00, 0xda,
+	0x99, 0xdd, 0xf1, 0x8e, 0x77, 0xd7, 0xbb, 0xb1, 0x2c, 0x7a, 0x89, 0x3c, 0xf3, 0x3e, 0xf3, 0x7e,
+	0x7c, 0xde, 0x9b, 0x79, 0x33, 0x1b, 0x48, 0x57, 0x0d, 0x54, 0x37, 0x50, 0x51, 0x6b, 0x19, 0xed,
+	0x66, 0x71, 0x6f, 0xb9, 0x68, 0xee, 0x17, 0x9a, 0x2d, 0xc3, 0x34, 0xf8, 0x49, 0x22, 0x29, 0x60,
+	0x49, 0x61, 0x6f, 0x59, 0x98, 0xd1, 0x0c, 0xcd, 0xc0, 0xb2, 0xa2, 0xf5, 0x8b, 0xc0, 0x84, 0x39,
+	0x02, 0xdb, 0x21, 0x02, 0x7b, 0x8d, 0x2d, 0xd2, 0x0c, 0x43, 0xab, 0xa9, 0x45, 0x3c, 0xda, 0x6d,
+	0xdf, 0x2e, 0xca, 0x8d, 0x03, 0x5b, 0x94, 0xe9, 0x16, 0x29, 0xed, 0x96, 0x6c, 0xea, 0x46, 0xc3,
+	0x96, 0x9f, 0xf0, 0xb8, 0x75, 0xd0, 0x54, 0x1d, 0xbd, 0xc7, 0x6d, 0x61, 0x1d, 0x69, 0x96, 0xa8,
+	0x8e, 0x34, 0x5b, 0x30, 0x2d, 0xd7, 0xf5, 0x86, 0x51, 0xc4, 0x7f, 0xc9, 0x94, 0xf8, 0x13, 0x07,
+	0xa9, 0x0a, 0xd2, 0xd6, 0x5b, 0xaa, 0x6c, 0xaa, 0x57, 0x2d, 0x6d, 0x7c, 0x01, 0x86, 0x65, 0xa5,
+	0xae, 0x37, 0xd2, 0x5c, 0x8e, 0x5b, 0x1c, 0x5b, 0x4b, 0xff, 0xf2, 0x60, 0x69, 0xc6, 0xf6, 0xfb,
+	0x8a, 0xa2, 0xb4, 0x54, 0x84, 0xae, 0x99, 0x2d, 0xbd, 0xa1, 0x49, 0x04, 0xc6, 0xaf, 0xc3, 0x68,
+	0x5d, 0xad, 0xef, 0xaa, 0x2d, 0x94, 0x8e, 0xe5, 0xe2, 0x8b, 0xe3, 0xa5, 0x4c, 0xa1, 0x8b, 0x9a,
+	0x42, 0x05, 0xcb, 0x25, 0xf5, 0xad, 0xb6, 0x8a, 0xcc, 0xb5, 0xb1, 0x87, 0xbf, 0x65, 0x87, 0xbe,
+	0x7e, 0x7a, 0x3f, 0xcf, 0x49, 0xce, 0x4a, 0x5e, 0x80, 0x64, 0x5d, 0x35, 0x65, 0x45, 0x36, 0xe5,
+	0x74, 0xdc, 0xb2, 0x2b, 0xd1, 0x71, 0x79, 0xf1, 0xdd, 0xa7, 0xf7, 0xf3, 0xc4, 0xd8, 0x87, 0x4f,
+	0xef, 0xe7, 0x6d, 0x46, 0x97, 0x90, 0x72, 0xb7, 0xc8, 0xba, 0x2e, 0xae, 0xc0, 0x31, 0x76, 0x46,
+	0x52, 0x51, 0xd3, 0x68, 0x20, 0x95, 0x9f, 0x83, 0x24, 0xf6, 0x66, 0x47, 0x57, 0x70, 0x5c, 0x09,
+	0x69, 0x14, 0x8f, 0xb7, 0x14, 0xf1, 0x2f, 0x0e, 0x66, 0x2b, 0x48, 0xbb, 0xd1, 0x54, 0x9c, 0x55,
+	0x15, 0xdb, 0xa9, 0xc3, 0x32, 0xe1, 0x36, 0x12, 0x63, 0x8c, 0xf0, 0xdb, 0x90, 0x22, 0xa1, 0xee,
+	0xb4, 0xb1, 0x1d, 0x94, 0x8e, 0x1f, 0x96, 0xab, 0x09, 0xa2, 0x80, 0xf8, 0x89, 0xca, 0x45, 0x96,
+	0x95, 0x1c, 0xcb, 0x8a, 0x37, 0x1a, 0x31, 0x0b, 0xa7, 0x7c, 0x05, 0x0e, 0x47, 0xe2, 0x0f, 0x1c,
+	0x1c, 0x65, 0x11, 0x57, 0x70, 0x58, 0x03, 0xa4, 0x61, 0x15, 0xc6, 0x1a, 0xea, 0xbd, 0x1d, 0xa2,
+	0x2e, 0x1e, 0xa2, 0x2e, 0xd9, 0x50, 0xef, 0x61, 0x0f, 0xca, 0x4b, 0x6c, 0xac, 0x99, 0xc0, 0x58,
+	0x31, 0x5c, 0x3c, 0x05, 0x27, 0x7c, 0xa6, 0x69, 0x9c, 0xdf, 0x72, 0x70, 0x8c, 0x95, 0x57, 0xec,
+	0x52, 0x1b, 0x64, 0xa8, 0xbd, 0x2a, 0xfa, 0x22, 0x1b, 0xcf, 0xe9, 0x1e, 0xb9, 0x23, 0x2b, 0xc4,
+	0x1c, 0x64, 0xfc, 0x25, 0x34, 0xaa, 0xcf, 0x62, 0x30, 0xc3, 0x16, 0xff, 0xb6, 0x51, 0xd3, 0xab,
+	0x07, 0xff, 0x51, 0x4c, 0xbc, 0x0c, 0x93, 0x8a, 0x5a, 0xd5, 0x91, 0x6e, 0x34, 0x76, 0x9a, 0xd8,
+	0x72, 0x3a, 0x91, 0xe3, 0x16, 0xc7, 0x4b, 0x33, 0x05, 0x72, 0x98, 0x15, 0x9c, 0xc3, 0xac, 0x70,
+	0xa5, 0x71, 0xb0, 0x26, 0xfe, 0xf8, 0x60, 0x29, 0xd3, 0x5d, 0xfb, 0x1b, 0xb6, 0x02, 0xe2, 0xb9,
+	0x94, 0x52, 0x98, 0x71, 0xb9, 0xf4, 0xfe, 0x97, 0xd9, 0x21, 0x96, 0xba, 0x6c, 0xe0, 0x61, 0x40,
+	0xd6, 0x88, 0x12, 0x9c, 0xf4, 0x9b, 0xa7, 0x07, 0x43, 0x09, 0x46, 0x65, 0xc2, 0x42, 0x28, 0x3f,
+	0x0e, 0x50, 0x7c, 0x2f, 0x06, 0x73, 0x6c, 0x36, 0x88, 0xd2, 0xfe, 0xb6, 0xcb, 0x4b, 0x30, 0x43,
+	0xf8, 0x26, 0xac, 0xed, 0x38, 0xee, 0xc4, 0x42, 0x96, 0xf3, 0x9a, 0xdb, 0x32, 0x96, 0xf4, 0xbb,
+	0xbf, 0x56, 0x58, 0x52, 0xe7, 0x03, 0xeb, 0xd1, 0x15, 0xa7, 0x78, 0x06, 0x4e, 0x07, 0x0a, 0x69,
+	0x55, 0x7e, 0x17, 0x87, 0x34, 0xcb, 0xff, 0x4d, 0xdd, 0xbc, 0xd3, 0x67, 0x65, 0x0e, 0xa4, 0xd3,
+	0x9c, 0x85, 0x14, 0xa1, 0xbb, 0xab, 0x92, 0x27, 0x34, 0xe6, 0x24, 0x28, 0xc1, 0x2c, 0x93, 0x15,
+	0x8a, 0x4e, 0x60, 0xf4, 0x51, 0x17, 0xf9, 0x74, 0xcd, 0x72, 0xd7, 0x1a, 0x19, 0xd9, 0x99, 0x18,
+	0xce, 0x71, 0x8b, 0x49, 0x36, 0x61, 0x88, 0x14, 0x8b, 0xcf, 0xae, 0x19, 0x19, 0xf0, 0xae, 0xb9,
+	0xec, 0xdd, 0x35, 0x67, 0x02, 0x77, 0x4d, 0x27, 0x3b, 0xe2, 0x07, 0x1c, 0xe4, 0x82, 0x84, 0x11,
+	0xfa, 0xea, 0x20, 0xeb, 0x5a, 0xfc, 0x3e, 0x06, 0xa2, 0x5f, 0xb1, 0xb1, 0xa1, 0x3f, 0xd3, 0xad,
+	0xe7, 0x93, 0xc9, 0xf8, 0x80, 0x33, 0x59, 0xf6, 0x66, 0x72, 0x21, 0x70, 0xab, 0xb2, 0xba, 0xc4,
+	0x0b, 0x90, 0x0f, 0x27, 0x90, 0x6e, 0xdb, 0xbf, 0x39, 0x38, 0xe9, 0x07, 0xef, 0xbb, 0x51, 0x0e,
+	0x92, 0xe9, 0x5e, 0x9d, 0xf5, 0x72, 0x54, 0x7a, 0xd8, 0x78, 0xc4, 0x73, 0x30, 0xdf, 0x4b, 0x4e,
+	0x89, 0xf9, 0x39, 0xe6, 0x4f, 0xcc, 0xd5, 0xb6, 0xdc, 0x52, 0x74, 0xf9, 0xd9, 0x9e, 0xfe, 0x97,
+	0x20, 0xa9, 0xd9, 0x7e, 0x84, 0x1f, 0xfe, 0x0e, 0x92, 0xdf, 0x80, 0xa4, 0xa9, 0xd7, 0xd5, 0x9a,
+	0x51, 0xbd, 0x6b, 0x77, 0xec, 0x39, 0x4f, 0xc5, 0x6e, 0xd8, 0xcf, 0x8f, 0xb5, 0x09, 0xeb, 0x44,
+	0xfd, 0xfc, 0xf7, 0x2c, 0x47, 0x4e, 0x55, 0xba, 0xf2, 0xb0, 0xc4, 0x3b, 0x7c, 0x05, 0x11, 0xef,
+	0xc8, 0x29, 0xf1, 0x7f, 0xc6, 0x60, 0xba, 0x82, 0xb4, 0x6b, 0xed, 0xdd, 0xba, 0x6e, 0x6e, 0xb7,
+	0x8c, 0xa6, 0x81, 0xe4, 0x5a, 0x20, 0x7b, 0x5c, 0x1f, 0xec, 0x9d, 0x84, 0xb1, 0x26, 0xd6, 0xeb,
+	0xf4, 0x97, 0x31, 0xa9, 0x33, 0xd1, 0xf3, 0xea, 0x73, 0xd1, 0x92, 0x21, 0x24, 0x6b, 0x2a, 0x4a,
+	0x27, 0x72, 0xf1, 0xa0, 0x3d, 0x2f, 0x51, 0x14, 0x7f, 0x1e, 0x12, 0xea, 0xbe, 0x5a, 0xc5, 0x8d,
+	0x21, 0x55, 0x9a, 0xf5, 0xb4, 0xb1, 0xcd, 0x7d, 0xb5, 0x2a, 0x61, 0x08, 0x3f, 0x03, 0xc3, 0xa6,
+	0x6e, 0xd6, 0x54, 0xdc, 0x17, 0xc6, 0x24, 0x32, 0xe0, 0xd3, 0x30, 0x8a, 0xda, 0xf5, 0xba, 0xdc,
+	0x3a, 0x48, 0x8f, 0xe2, 0x79, 0x67, 0x58, 0xfe, 0x9f, 0x73, 0x48, 0x74, 0x9c, 0xb7, 0x12, 0x22,
+	0xba, 0x12, 0x42, 0x5e, 0x8d, 0x1e, 0x36, 0xc5, 0xe7, 0x60, 0xce, 0x33, 0x49, 0x4f, 0xfa, 0x2c,
+	0x8c, 0x37, 0xed, 0xb9, 0xce, 0x61, 0x0f, 0xce, 0xd4, 0x96, 0x22, 0x7e, 0x45, 0x9e, 0x0f, 0x56,
+	0x93, 0x50, 0x5a, 0xf2, 0x3d, 0x9a, 0xa3, 0xb0, 0x85, 0xee, 0x2b, 0x58, 0x2c, 0xe2, 0x15, 0xac,
+	0xbc, 0x6a, 0x45, 0xe8, 0x8c, 0xba, 0xef, 0x2c, 0x34, 0xbe, 0x6e, 0x5f, 0xec, 0x97, 0x41, 0xf7,
+	0x34, 0x2d, 0xb2, 0x7f, 0x38, 0x18, 0xad, 0x20, 0xed, 0x35, 0xc3, 0x0c, 0x8f, 0xd7, 0xda, 0xe9,
+	0x7b, 0x86, 0xa9, 0xb6, 0x42, 0x9d, 0x26, 0x30, 0x7e, 0x05, 0x46, 0x8c, 0xa6, 0xb5, 0x89, 0x70,
+	0xfd, 0xa4, 0x4a, 0x27, 0x3c, 0x59, 0xb7, 0xec, 0xbe, 0x8a, 0x21, 0x92, 0x0d, 0x65, 0xca, 0x2e,
+	0xd1, 0x55, 0x76, 0xd1, 0x8b, 0xa8, 0xbc, 0x80, 0x77, 0x27, 0xf6, 0xc3, 0x22, 0x2b, 0xed, 0x47,
+	0x96, 0x65, 0x5d, 0x9c, 0x86, 0x49, 0xfb, 0x27, 0x25, 0xe5, 0x23, 0x42, 0x8a, 0xa5, 0x2d, 0x9c,
+	0x94, 0x4b, 0x90, 0xb4, 0x0c, 0xb6, 0x4d, 0x23, 0x9c, 0x17, 0x8a, 0x2c, 0xe7, 0x2d, 0xf7, 0xe8,
+	0x30, 0xd0, 0x43, 0xcb, 0x05, 0x51, 0x82, 0x49, 0xfb, 0x27, 0x2d, 0xcd, 0x17, 0x60, 0xa4, 0xa5,
+	0xa2, 0x76, 0xcd, 0xc4, 0x26, 0x53, 0xa5, 0x05, 0x0f, 0x15, 0x4e, 0xa6, 0x37, 0x6d, 0x13, 0x12,
+	0x86, 0x4b, 0xf6, 0x32, 0xf1, 0x63, 0x0e, 0x26, 0x2a, 0x48, 0x7b, 0x59, 0x95, 0xf7, 0xec, 0x8f,
+	0x20, 0x7d, 0x3c, 0x0b, 0x7a, 0x3c, 0x9c, 0xc8, 0x63, 0xdd, 0x5d, 0xae, 0x19, 0xbf, 0xf8, 0x3a,
+	0xf6, 0xc5, 0xe3, 0x30, 0xcb, 0x4c, 0xd0, 0x6c, 0x7c, 0xc1, 0x91, 0x0c, 0xa9, 0xa6, 0x11, 0x7d,
+	0x87, 0xb9, 0x1b, 0x43, 0x2c, 0x6a, 0x63, 0x28, 0x97, 0x70, 0x56, 0x9c, 0x61, 0xf7, 0x47, 0x86,
+	0x4e, 0xdd, 0xb8, 0x5c, 0x11, 0xe7, 0xe0, 0x78, 0xd7, 0x94, 0xe3, 0x79, 0x3e, 0x0f, 0x89, 0x4d,
+	0x72, 0xa0, 0x4d, 0x6d, 0xbe, 0xbe, 0xb9, 0xbe, 0x73, 0xe3, 0x95, 0x6b, 0xdb, 0x9b, 0xeb, 0x5b,
+	0x2f, 0x6e, 0x6d, 0x6e, 0x4c, 0x0d, 0xf1, 0x47, 0x20, 0x89, 0x67, 0xaf, 0x4b, 0x6f, 0x4c, 0x71,
+	0xa5, 0x6f, 0x26, 0x20, 0x5e, 0x41, 0x1a, 0x7f, 0x13, 0xc6, 0xdd, 0x9f, 0xa6, 0xb2, 0xde, 0xfb,
+	0x3e, 0x73, 0x41, 0x15, 0x16, 0x42, 0x00, 0xb4, 0x64, 0x6a, 0xc0, 0xfb, 0x7c, 0xf0, 0x39, 0xe7,
+	0xb7, 0xdc, 0x8b, 0x13, 0x0a, 0xd1, 0x70, 0xd4, 0xda, 0x6d, 0x98, 0xf2, 0x7c, 0x55, 0x99, 0x0f,
+	0xd1, 0x81, 0x51, 0xc2, 0x85, 0x28, 0x28, 0x6a, 0xc7, 0x80, 0xa3, 0x7e, 0x5f, 0x35, 0x16, 0x42,
+	0xdd, 0x25, 0x40, 0xa1, 0x18, 0x11, 0x48, 0x0d, 0xea, 0x30, 0xed, 0xfd, 0xe0, 0x70, 0x36, 0x24,
+	0x09, 0x04, 0x26, 0x2c, 0x45, 0x82, 0x51, 0x53, 0x6d, 0x98, 0xf5, 0x7f, 0x45, 0x9e, 0x0f, 0xd1,
+	0xd3, 0x81, 0x0a, 0xcb, 0x91, 0xa1, 0xd4, 0xec, 0x3e, 0x1c, 0x0b, 0x78, 0xe7, 0xe7, 0x43, 0xc8,
+	0x72, 0x61, 0x85, 0x52, 0x74, 0x2c, 0xb5, 0xfc, 0x29, 0x07, 0xd9, 0xb0, 0x07, 0xcf, 0x4a, 0x24,
+	0xbd, 0xec, 0x22, 0xe1, 0xff, 0x7d, 0x2c, 0xa2, 0x5e, 0xbd, 0xc3, 0xc1, 0x5c, 0xf0, 0xb3, 0x60,
+	0x29, 0x92, 0x6a, 0x5a, 0x6f, 0xab, 0x87, 0x82, 0xf7, 0xf6, 0x81, 0xde, 0xc0, 0xa3, 0xf9, 0xe0,
+	0xc0, 0x85, 0xd5, 0x43, 0xc1, 0xa9, 0x0f, 0x6f, 0x42, 0xaa, 0xeb, 0x2e, 0x2a, 0xfa, 0x29, 0x62,
+	0x31, 0x42, 0x3e, 0x1c, 0xe3, 0x3e, 0x34, 0x3c, 0x77, 0x29, 0xdf, 0x43, 0xa3, 0x1b, 0x25, 0x5c,
+	0x88, 0x82, 0xa2, 0x76, 0xd6, 0x20, 0x81, 0x2f, 0x3c, 0x69, 0xbf, 0x55, 0x96, 0x44, 0xc8, 0x05,
+	0x49, 0xdc, 0x3a, 0xf0, 0xd9, 0xee, 0xab, 0xc3, 0x92, 0x08, 0xb9, 0x20, 0x09, 0xd5, 0x71, 0x1d,
+	0xc0, 0xd5, 0x80, 0x33, 0x7e, 0xf8, 0x8e, 0x5c, 0x38, 0xd7, 0x5b, 0x4e, 0xb5, 0xde, 0x82, 0x23,
+	0x4c, 0xaf, 0xf4, 0x8f, 0xc5, 0x85, 0x10, 0x16, 0xc3, 0x10, 0x8e, 0x6e, 0x61, 0xf8, 0x6d, 0xeb,
+	0x11, 0xb4, 0xf6, 0xfc, 0xc3, 0xc7, 0x19, 0xee, 0xd1, 0xe3, 0x0c, 0xf7, 0xc7, 0xe3, 0x0c, 0xf7,
+	0xc9, 0x93, 0xcc, 0xd0, 0xa3, 0x27, 0x99, 0xa1, 0x5f, 0x9f, 0x64, 0x86, 0x6e, 0xcd, 0x6b, 0xba,
+	0x79, 0xa7, 0xbd, 0x5b, 0xa8, 0x1a, 0x75, 0xfb, 0x5f, 0x3f, 0x45, 0x57, 0x07, 0xdd, 0x27, 0x3d,
+	0x74, 0x77, 0x04, 0x3f, 0x12, 0x56, 0xfe, 0x1d, 0x00, 0xd7, 0x50, 0xeb, 0x6a, 0x6c, 0x1a, 0x00,
+	0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1628,6 +1848,8 @@ type MsgClient interface {
 	UpdateGroupPolicyDecisionPolicy(ctx context.Context, in *MsgUpdateGroupPolicyDecisionPolicy, opts ...grpc.CallOption) (*MsgUpdateGroupPolicyDecisionPolicyResponse, error)
 	// UpdateGroupPolicyMetadata updates a group policy metadata.
 	UpdateGroupPolicyMetadata(ctx context.Context, in *MsgUpdateGroupPolicyMetadata, opts ...grpc.CallOption) (*MsgUpdateGroupPolicyMetadataResponse, error)
+	// UpdateGroupPolicyGuardian updates the guardian and the timelock of a group policy.
+	UpdateGroupPolicyGuardian(ctx context.Context, in *MsgUpdateGroupPolicyGuardian, opts ...grpc.CallOption) (*MsgUpdateGroupPolicyGuardianResponse, error)
 	// SubmitProposal submits a new proposal.
 	SubmitProposal(ctx context.Context, in *MsgSubmitProposal, opts ...grpc.CallOption) (*MsgSubmitProposalResponse, error)
 	// WithdrawProposal withdraws a proposal.
@@ -1638,6 +1860,9 @@ type MsgClient interface {
 	Exec(ctx context.Context, in *MsgExec, opts ...grpc.CallOption) (*MsgExecResponse, error)
 	// LeaveGroup allows a group member to leave the group.
 	LeaveGroup(ctx context.Context, in *MsgLeaveGroup, opts ...grpc.CallOption) (*MsgLeaveGroupResponse, error)
+	// VetoProposal allows the guardian of a group policy to veto an accepted
+	// proposal during its timelock.
+	VetoProposal(ctx context.Context, in *MsgVetoProposal, opts ...grpc.CallOption) (*MsgVetoProposalResponse, error)
 }
 
 type msgClient struct {
@@ -1729,6 +1954,15 @@ func (c *msgClient) UpdateGroupPolicyMetadata(ctx context.Context, in *MsgUpdate
 	return out, nil
 }
 
+func (c *msgClient) UpdateGroupPolicyGuardian(ctx context.Context, in *MsgUpdateGroupPolicyGuardian, opts ...grpc.CallOption) (*MsgUpdateGroupPolicyGuardianResponse, error) {
+	out := new(MsgUpdateGroupPolicyGuardianResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.group.v1.Msg/UpdateGroupPolicyGuardian", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) SubmitProposal(ctx context.Context, in *MsgSubmitProposal, opts ...grpc.CallOption) (*MsgSubmitProposalResponse, error) {
 	out := new(MsgSubmitProposalResponse)
 	err := c.cc.Invoke(ctx, "/cosmos.group.v1.Msg/SubmitProposal", in, out, opts...)
@@ -1774,6 +2008,15 @@ func (c *msgClient) LeaveGroup(ctx context.Context, in *MsgLeaveGroup, opts ...g
 	return out, nil
 }
 
+func (c *msgClient) VetoProposal(ctx context.Context, in *MsgVetoProposal, opts ...grpc.CallOption) (*MsgVetoProposalResponse, error) {
+	out := new(MsgVetoProposalResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.group.v1.Msg/VetoProposal", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// CreateGroup creates a new group with an admin account address, a list of members and some optional metadata.
@@ -1794,6 +2037,8 @@ type MsgServer interface {
 	UpdateGroupPolicyDecisionPolicy(context.Context, *MsgUpdateGroupPolicyDecisionPolicy) (*MsgUpdateGroupPolicyDecisionPolicyResponse, error)
 	// UpdateGroupPolicyMetadata updates a group policy metadata.
 	UpdateGroupPolicyMetadata(context.Context, *MsgUpdateGroupPolicyMetadata) (*MsgUpdateGroupPolicyMetadataResponse, error)
+	// UpdateGroupPolicyGuardian updates the guardian and the timelock of a group policy.
+	UpdateGroupPolicyGuardian(context.Context, *MsgUpdateGroupPolicyGuardian) (*MsgUpdateGroupPolicyGuardianResponse, error)
 	// SubmitProposal submits a new proposal.
 	SubmitProposal(context.Context, *MsgSubmitProposal) (*MsgSubmitProposalResponse, error)
 	// WithdrawProposal withdraws a proposal.
@@ -1804,6 +2049,9 @@ type MsgServer interface {
 	Exec(context.Context, *MsgExec) (*MsgExecResponse, error)
 	// LeaveGroup allows a group member to leave the group.
 	LeaveGroup(context.Context, *MsgLeaveGroup) (*MsgLeaveGroupResponse, error)
+	// VetoProposal allows the guardian of a group policy to veto an accepted
+	// proposal during its timelock.
+	VetoProposal(context.Context, *MsgVetoProposal) (*MsgVetoProposalResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -1837,6 +2085,9 @@ func (*UnimplementedMsgServer) UpdateGroupPolicyDecisionPolicy(ctx context.Conte
 func (*UnimplementedMsgServer) UpdateGroupPolicyMetadata(ctx context.Context, req *MsgUpdateGroupPolicyMetadata) (*MsgUpdateGroupPolicyMetadataResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateGroupPolicyMetadata not implemented")
 }
+func (*UnimplementedMsgServer) UpdateGroupPolicyGuardian(ctx context.Context, req *MsgUpdateGroupPolicyGuardian) (*MsgUpdateGroupPolicyGuardianResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateGroupPolicyGuardian not implemented")
+}
 func (*UnimplementedMsgServer) SubmitProposal(ctx context.Context, req *MsgSubmitProposal) (*MsgSubmitProposalResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SubmitProposal not implemented")
 }
@@ -1852,6 +2103,9 @@ func (*UnimplementedMsgServer) Exec(ctx context.Context, req *MsgExec) (*MsgExec
 func (*UnimplementedMsgServer) LeaveGroup(ctx context.Context, req *MsgLeaveGroup) (*MsgLeaveGroupResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method LeaveGroup not implemented")
 }
+func (*UnimplementedMsgServer) VetoProposal(ctx context.Context, req *MsgVetoProposal) (*MsgVetoProposalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VetoProposal not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -2019,6 +2273,24 @@ func _Msg_UpdateGroupPolicyMetadata_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_UpdateGroupPolicyGuardian_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateGroupPolicyGuardian)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateGroupPolicyGuardian(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.group.v1.Msg/UpdateGroupPolicyGuardian",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateGroupPolicyGuardian(ctx, req.(*MsgUpdateGroupPolicyGuardian))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_SubmitProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgSubmitProposal)
 	if err := dec(in); err != nil {
@@ -2109,6 +2381,24 @@ func _Msg_LeaveGroup_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_VetoProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgVetoProposal)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).VetoProposal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.group.v1.Msg/VetoProposal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).VetoProposal(ctx, req.(*MsgVetoProposal))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.group.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -2149,6 +2439,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateGroupPolicyMetadata",
 			Handler:    _Msg_UpdateGroupPolicyMetadata_Handler,
 		},
+		{
+			MethodName: "UpdateGroupPolicyGuardian",
+			Handler:    _Msg_UpdateGroupPolicyGuardian_Handler,
+		},
 		{
 			MethodName: "SubmitProposal",
 			Handler:    _Msg_SubmitProposal_Handler,
@@ -2169,6 +2463,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "LeaveGroup",
 			Handler:    _Msg_LeaveGroup_Handler,
 		},
+		{
+			MethodName: "VetoProposal",
+			Handler:    _Msg_VetoProposal_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/group/v1/tx.proto",
@@ -2860,6 +3158,81 @@ func (m *MsgUpdateGroupPolicyMetadataResponse) MarshalToSizedBuffer(dAtA []byte)
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgUpdateGroupPolicyGuardian) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateGroupPolicyGuardian) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateGroupPolicyGuardian) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n4, err4 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Timelock, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Timelock):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintTx(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x22
+	if len(m.Guardian) > 0 {
+		i -= len(m.Guardian)
+		copy(dAtA[i:], m.Guardian)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Guardian)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.GroupPolicyAddress) > 0 {
+		i -= len(m.GroupPolicyAddress)
+		copy(dAtA[i:], m.GroupPolicyAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.GroupPolicyAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Admin) > 0 {
+		i -= len(m.Admin)
+		copy(dAtA[i:], m.Admin)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Admin)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateGroupPolicyGuardianResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateGroupPolicyGuardianResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateGroupPolicyGuardianResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func (m *MsgSubmitProposal) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -3221,13 +3594,71 @@ func (m *MsgLeaveGroupResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgVetoProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgVetoProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgVetoProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Guardian) > 0 {
+		i -= len(m.Guardian)
+		copy(dAtA[i:], m.Guardian)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Guardian)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ProposalId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgVetoProposalResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgVetoProposalResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgVetoProposalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
 	}
 	dAtA[offset] = uint8(v)
 	return base
@@ -3533,6 +3964,38 @@ func (m *MsgUpdateGroupPolicyMetadataResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgUpdateGroupPolicyGuardian) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Admin)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.GroupPolicyAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Guardian)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Timelock)
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateGroupPolicyGuardianResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func (m *MsgSubmitProposal) Size() (n int) {
 	if m == nil {
 		return 0
@@ -3698,6 +4161,31 @@ func (m *MsgLeaveGroupResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgVetoProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovTx(uint64(m.ProposalId))
+	}
+	l = len(m.Guardian)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgVetoProposalResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -5652,6 +6140,235 @@ func (m *MsgUpdateGroupPolicyMetadataResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgUpdateGroupPolicyGuardian) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateGroupPolicyGuardian: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateGroupPolicyGuardian: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Admin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupPolicyAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GroupPolicyAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Guardian", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Guardian = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timelock", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Timelock, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateGroupPolicyGuardianResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateGroupPolicyGuardianResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateGroupPolicyGuardianResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *MsgSubmitProposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -6677,6 +7394,157 @@ func (m *MsgLeaveGroupResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgVetoProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgVetoProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgVetoProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Guardian", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Guardian = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgVetoProposalResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgVetoProposalResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgVetoProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
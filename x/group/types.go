@@ -241,6 +241,69 @@ func (p PercentageDecisionPolicy) Allow(tally TallyResult, totalPower string) (D
 	return DecisionPolicyResult{Allow: false, Final: false}, nil
 }
 
+// Implements DecisionPolicy Interface
+var _ DecisionPolicy = &TokenWeightedDecisionPolicy{}
+
+// NewTokenWeightedDecisionPolicy creates a new token weighted DecisionPolicy
+func NewTokenWeightedDecisionPolicy(source TokenWeightSource, denom, percentage string, votingPeriod, executionPeriod time.Duration) DecisionPolicy {
+	return &TokenWeightedDecisionPolicy{denom, source, percentage, &DecisionPolicyWindows{votingPeriod, executionPeriod}}
+}
+
+// GetVotingPeriod returns the voting period of TokenWeightedDecisionPolicy
+func (p TokenWeightedDecisionPolicy) GetVotingPeriod() time.Duration {
+	return p.Windows.VotingPeriod
+}
+
+// GetMinExecutionPeriod returns the minimum execution period of TokenWeightedDecisionPolicy
+func (p TokenWeightedDecisionPolicy) GetMinExecutionPeriod() time.Duration {
+	return p.Windows.MinExecutionPeriod
+}
+
+// ValidateBasic does basic validation on TokenWeightedDecisionPolicy
+func (p TokenWeightedDecisionPolicy) ValidateBasic() error {
+	switch p.Source {
+	case TOKEN_WEIGHT_SOURCE_BALANCE:
+		if err := sdk.ValidateDenom(p.Denom); err != nil {
+			return errorsmod.Wrap(err, "denom")
+		}
+	case TOKEN_WEIGHT_SOURCE_STAKED:
+		if p.Denom != "" {
+			return errorsmod.Wrap(errors.ErrInvalid, "denom must be empty for staked token weights")
+		}
+	default:
+		return errorsmod.Wrapf(errors.ErrInvalid, "token weight source %s", p.Source)
+	}
+
+	return p.percentagePolicy().ValidateBasic()
+}
+
+// Validate validates the policy against the group.
+func (p *TokenWeightedDecisionPolicy) Validate(g GroupInfo, config Config) error {
+	return p.percentagePolicy().Validate(g, config)
+}
+
+// Allow allows a proposal to pass when the tally of yes votes equals or
+// exceeds the percentage threshold of the total token weight before the
+// timeout. The tally and the total power must be computed from the token
+// weights snapshotted at the proposal submission.
+func (p TokenWeightedDecisionPolicy) Allow(tally TallyResult, totalPower string) (DecisionPolicyResult, error) {
+	totalPowerDec, err := math.NewNonNegativeDecFromString(totalPower)
+	if err != nil {
+		return DecisionPolicyResult{}, errorsmod.Wrap(err, "total power")
+	}
+
+	// no member holds tokens, the proposal can never pass
+	if totalPowerDec.IsZero() {
+		return DecisionPolicyResult{Allow: false, Final: true}, nil
+	}
+
+	return p.percentagePolicy().Allow(tally, totalPower)
+}
+
+func (p TokenWeightedDecisionPolicy) percentagePolicy() *PercentageDecisionPolicy {
+	return &PercentageDecisionPolicy{Percentage: p.Percentage, Windows: p.Windows}
+}
+
 var _ orm.Validateable = GroupPolicyInfo{}
 
 // NewGroupPolicyInfo creates a new GroupPolicyInfo instance
@@ -324,6 +387,13 @@ func (g Proposal) PrimaryKeyFields() []interface{} {
 	return []interface{}{g.Id}
 }
 
+// IsPendingExecution returns true if the proposal was accepted with a
+// timelock and is not successfully executed yet.
+func (g Proposal) IsPendingExecution() bool {
+	return g.TimelockEnd != nil && g.Status == PROPOSAL_STATUS_ACCEPTED &&
+		g.ExecutorResult != PROPOSAL_EXECUTOR_RESULT_SUCCESS
+}
+
 // ValidateBasic does basic validation on group policy info.
 func (g GroupPolicyInfo) ValidateBasic() error {
 	_, err := sdk.AccAddressFromBech32(g.Admin)
@@ -349,6 +419,15 @@ func (g GroupPolicyInfo) ValidateBasic() error {
 	if err := policy.ValidateBasic(); err != nil {
 		return errorsmod.Wrap(err, "group policy's decision policy")
 	}
+
+	if g.Guardian != "" {
+		if _, err := sdk.AccAddressFromBech32(g.Guardian); err != nil {
+			return errorsmod.Wrap(err, "group policy guardian")
+		}
+	}
+	if g.Timelock < 0 {
+		return errorsmod.Wrap(errors.ErrInvalid, "group policy timelock must not be negative")
+	}
 	return nil
 }
 
@@ -447,6 +526,28 @@ func (v Vote) ValidateBasic() error {
 	return nil
 }
 
+func (w ProposalMemberWeight) PrimaryKeyFields() []interface{} {
+	addr := sdk.MustAccAddressFromBech32(w.Address)
+
+	return []interface{}{w.ProposalId, addr.Bytes()}
+}
+
+var _ orm.Validateable = ProposalMemberWeight{}
+
+// ValidateBasic does basic validation on proposal member weight.
+func (w ProposalMemberWeight) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(w.Address); err != nil {
+		return errorsmod.Wrap(err, "address")
+	}
+	if w.ProposalId == 0 {
+		return errorsmod.Wrap(errors.ErrEmpty, "proposal member weight ProposalId")
+	}
+	if _, err := math.NewNonNegativeDecFromString(w.Weight); err != nil {
+		return errorsmod.Wrap(err, "weight")
+	}
+	return nil
+}
+
 // UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
 func (q QueryGroupPoliciesByGroupResponse) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
 	return unpackGroupPolicies(unpacker, q.GroupPolicies)
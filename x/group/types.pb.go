@@ -31,6 +31,41 @@ var _ = time.Kitchen
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// TokenWeightSource enumerates where the token weights of the members are
+// taken from.
+type TokenWeightSource int32
+
+const (
+	// TOKEN_WEIGHT_SOURCE_UNSPECIFIED defines an unspecified source which will
+	// return an error.
+	TOKEN_WEIGHT_SOURCE_UNSPECIFIED TokenWeightSource = 0
+	// TOKEN_WEIGHT_SOURCE_BALANCE weights the members by their balance of the
+	// policy denom.
+	TOKEN_WEIGHT_SOURCE_BALANCE TokenWeightSource = 1
+	// TOKEN_WEIGHT_SOURCE_STAKED weights the members by their bonded tokens.
+	TOKEN_WEIGHT_SOURCE_STAKED TokenWeightSource = 2
+)
+
+var TokenWeightSource_name = map[int32]string{
+	0: "TOKEN_WEIGHT_SOURCE_UNSPECIFIED",
+	1: "TOKEN_WEIGHT_SOURCE_BALANCE",
+	2: "TOKEN_WEIGHT_SOURCE_STAKED",
+}
+
+var TokenWeightSource_value = map[string]int32{
+	"TOKEN_WEIGHT_SOURCE_UNSPECIFIED": 0,
+	"TOKEN_WEIGHT_SOURCE_BALANCE":     1,
+	"TOKEN_WEIGHT_SOURCE_STAKED":      2,
+}
+
+func (x TokenWeightSource) String() string {
+	return proto.EnumName(TokenWeightSource_name, int32(x))
+}
+
+func (TokenWeightSource) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_f5bddd15d7a54a9d, []int{0}
+}
+
 // VoteOption enumerates the valid vote options for a given proposal.
 type VoteOption int32
 
@@ -69,7 +104,7 @@ func (x VoteOption) String() string {
 }
 
 func (VoteOption) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_f5bddd15d7a54a9d, []int{0}
+	return fileDescriptor_f5bddd15d7a54a9d, []int{1}
 }
 
 // ProposalStatus defines proposal statuses.
@@ -92,6 +127,9 @@ const (
 	// A proposal can be withdrawn before the voting start time by the owner.
 	// When this happens the final status is Withdrawn.
 	PROPOSAL_STATUS_WITHDRAWN ProposalStatus = 5
+	// Final status of an accepted proposal vetoed by the guardian of the group
+	// policy during its timelock.
+	PROPOSAL_STATUS_VETOED ProposalStatus = 6
 )
 
 var ProposalStatus_name = map[int32]string{
@@ -101,6 +139,7 @@ var ProposalStatus_name = map[int32]string{
 	3: "PROPOSAL_STATUS_REJECTED",
 	4: "PROPOSAL_STATUS_ABORTED",
 	5: "PROPOSAL_STATUS_WITHDRAWN",
+	6: "PROPOSAL_STATUS_VETOED",
 }
 
 var ProposalStatus_value = map[string]int32{
@@ -110,6 +149,7 @@ var ProposalStatus_value = map[string]int32{
 	"PROPOSAL_STATUS_REJECTED":    3,
 	"PROPOSAL_STATUS_ABORTED":     4,
 	"PROPOSAL_STATUS_WITHDRAWN":   5,
+	"PROPOSAL_STATUS_VETOED":      6,
 }
 
 func (x ProposalStatus) String() string {
@@ -117,7 +157,7 @@ func (x ProposalStatus) String() string {
 }
 
 func (ProposalStatus) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_f5bddd15d7a54a9d, []int{1}
+	return fileDescriptor_f5bddd15d7a54a9d, []int{2}
 }
 
 // ProposalExecutorResult defines types of proposal executor results.
@@ -153,7 +193,7 @@ func (x ProposalExecutorResult) String() string {
 }
 
 func (ProposalExecutorResult) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_f5bddd15d7a54a9d, []int{2}
+	return fileDescriptor_f5bddd15d7a54a9d, []int{3}
 }
 
 // Member represents a group member with an account address,
@@ -418,6 +458,88 @@ func (m *PercentageDecisionPolicy) GetWindows() *DecisionPolicyWindows {
 	return nil
 }
 
+// TokenWeightedDecisionPolicy is a decision policy where the weights of the
+// members are taken from their tokens when a proposal is submitted, instead of
+// the weights set by the group admin, and a proposal passes when it satisfies
+// the two following conditions:
+//  1. The percentage of all `YES` voters' token weights out of the total token
+//     weight of the group members is greater or equal than the given `percentage`.
+//  2. The voting and execution periods of the proposal respect the parameters
+//     given by `windows`.
+type TokenWeightedDecisionPolicy struct {
+	// denom is the denom of the balances weighting the members, used when
+	// source is TOKEN_WEIGHT_SOURCE_BALANCE.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// source defines where the token weights of the members are taken from.
+	Source TokenWeightSource `protobuf:"varint,2,opt,name=source,proto3,enum=cosmos.group.v1.TokenWeightSource" json:"source,omitempty"`
+	// percentage is the minimum percentage of the token weighted sum of `YES`
+	// votes must meet for a proposal to succeed.
+	Percentage string `protobuf:"bytes,3,opt,name=percentage,proto3" json:"percentage,omitempty"`
+	// windows defines the different windows for voting and execution.
+	Windows *DecisionPolicyWindows `protobuf:"bytes,4,opt,name=windows,proto3" json:"windows,omitempty"`
+}
+
+func (m *TokenWeightedDecisionPolicy) Reset()         { *m = TokenWeightedDecisionPolicy{} }
+func (m *TokenWeightedDecisionPolicy) String() string { return proto.CompactTextString(m) }
+func (*TokenWeightedDecisionPolicy) ProtoMessage()    {}
+func (*TokenWeightedDecisionPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f5bddd15d7a54a9d, []int{4}
+}
+func (m *TokenWeightedDecisionPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TokenWeightedDecisionPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TokenWeightedDecisionPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TokenWeightedDecisionPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TokenWeightedDecisionPolicy.Merge(m, src)
+}
+func (m *TokenWeightedDecisionPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *TokenWeightedDecisionPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_TokenWeightedDecisionPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TokenWeightedDecisionPolicy proto.InternalMessageInfo
+
+func (m *TokenWeightedDecisionPolicy) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *TokenWeightedDecisionPolicy) GetSource() TokenWeightSource {
+	if m != nil {
+		return m.Source
+	}
+	return TOKEN_WEIGHT_SOURCE_UNSPECIFIED
+}
+
+func (m *TokenWeightedDecisionPolicy) GetPercentage() string {
+	if m != nil {
+		return m.Percentage
+	}
+	return ""
+}
+
+func (m *TokenWeightedDecisionPolicy) GetWindows() *DecisionPolicyWindows {
+	if m != nil {
+		return m.Windows
+	}
+	return nil
+}
+
 // DecisionPolicyWindows defines the different windows for voting and execution.
 type DecisionPolicyWindows struct {
 	// voting_period is the duration from submission of a proposal to the end of voting period
@@ -441,7 +563,7 @@ func (m *DecisionPolicyWindows) Reset()         { *m = DecisionPolicyWindows{} }
 func (m *DecisionPolicyWindows) String() string { return proto.CompactTextString(m) }
 func (*DecisionPolicyWindows) ProtoMessage()    {}
 func (*DecisionPolicyWindows) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f5bddd15d7a54a9d, []int{4}
+	return fileDescriptor_f5bddd15d7a54a9d, []int{5}
 }
 func (m *DecisionPolicyWindows) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -508,7 +630,7 @@ func (m *GroupInfo) Reset()         { *m = GroupInfo{} }
 func (m *GroupInfo) String() string { return proto.CompactTextString(m) }
 func (*GroupInfo) ProtoMessage()    {}
 func (*GroupInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f5bddd15d7a54a9d, []int{5}
+	return fileDescriptor_f5bddd15d7a54a9d, []int{6}
 }
 func (m *GroupInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -591,7 +713,7 @@ func (m *GroupMember) Reset()         { *m = GroupMember{} }
 func (m *GroupMember) String() string { return proto.CompactTextString(m) }
 func (*GroupMember) ProtoMessage()    {}
 func (*GroupMember) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f5bddd15d7a54a9d, []int{6}
+	return fileDescriptor_f5bddd15d7a54a9d, []int{7}
 }
 func (m *GroupMember) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -653,13 +775,20 @@ type GroupPolicyInfo struct {
 	DecisionPolicy *types.Any `protobuf:"bytes,6,opt,name=decision_policy,json=decisionPolicy,proto3" json:"decision_policy,omitempty"`
 	// created_at is a timestamp specifying when a group policy was created.
 	CreatedAt time.Time `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3,stdtime" json:"created_at"`
+	// guardian is the optional account address allowed to veto the accepted
+	// proposals of the group policy during their timelock.
+	Guardian string `protobuf:"bytes,8,opt,name=guardian,proto3" json:"guardian,omitempty"`
+	// timelock is the duration during which an accepted proposal can't be
+	// executed, starting when the proposal is accepted. A zero timelock
+	// disables it.
+	Timelock time.Duration `protobuf:"bytes,9,opt,name=timelock,proto3,stdduration" json:"timelock"`
 }
 
 func (m *GroupPolicyInfo) Reset()         { *m = GroupPolicyInfo{} }
 func (m *GroupPolicyInfo) String() string { return proto.CompactTextString(m) }
 func (*GroupPolicyInfo) ProtoMessage()    {}
 func (*GroupPolicyInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f5bddd15d7a54a9d, []int{7}
+	return fileDescriptor_f5bddd15d7a54a9d, []int{8}
 }
 func (m *GroupPolicyInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -738,13 +867,17 @@ type Proposal struct {
 	//
 	// Since: cosmos-sdk 0.47
 	Summary string `protobuf:"bytes,14,opt,name=summary,proto3" json:"summary,omitempty"`
+	// timelock_end is the timestamp before which an accepted proposal can't be
+	// executed, and can be vetoed by the guardian of the group policy. It is
+	// only set for the accepted proposals of a group policy with a timelock.
+	TimelockEnd *time.Time `protobuf:"bytes,15,opt,name=timelock_end,json=timelockEnd,proto3,stdtime" json:"timelock_end,omitempty"`
 }
 
 func (m *Proposal) Reset()         { *m = Proposal{} }
 func (m *Proposal) String() string { return proto.CompactTextString(m) }
 func (*Proposal) ProtoMessage()    {}
 func (*Proposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f5bddd15d7a54a9d, []int{8}
+	return fileDescriptor_f5bddd15d7a54a9d, []int{9}
 }
 func (m *Proposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -789,7 +922,7 @@ func (m *TallyResult) Reset()         { *m = TallyResult{} }
 func (m *TallyResult) String() string { return proto.CompactTextString(m) }
 func (*TallyResult) ProtoMessage()    {}
 func (*TallyResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f5bddd15d7a54a9d, []int{9}
+	return fileDescriptor_f5bddd15d7a54a9d, []int{10}
 }
 func (m *TallyResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -818,6 +951,71 @@ func (m *TallyResult) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_TallyResult proto.InternalMessageInfo
 
+// ProposalMemberWeight is the weight of a group member snapshotted when a
+// proposal of a group policy with a TokenWeightedDecisionPolicy is submitted.
+type ProposalMemberWeight struct {
+	// proposal_id is the unique ID of the proposal.
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	// address is the account address of the group member.
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	// weight is the token weight of the member at the proposal submission.
+	Weight string `protobuf:"bytes,3,opt,name=weight,proto3" json:"weight,omitempty"`
+}
+
+func (m *ProposalMemberWeight) Reset()         { *m = ProposalMemberWeight{} }
+func (m *ProposalMemberWeight) String() string { return proto.CompactTextString(m) }
+func (*ProposalMemberWeight) ProtoMessage()    {}
+func (*ProposalMemberWeight) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f5bddd15d7a54a9d, []int{11}
+}
+func (m *ProposalMemberWeight) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProposalMemberWeight) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProposalMemberWeight.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ProposalMemberWeight) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProposalMemberWeight.Merge(m, src)
+}
+func (m *ProposalMemberWeight) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProposalMemberWeight) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProposalMemberWeight.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProposalMemberWeight proto.InternalMessageInfo
+
+func (m *ProposalMemberWeight) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
+	}
+	return 0
+}
+
+func (m *ProposalMemberWeight) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *ProposalMemberWeight) GetWeight() string {
+	if m != nil {
+		return m.Weight
+	}
+	return ""
+}
+
 // Vote represents a vote for a proposal.string metadata
 type Vote struct {
 	// proposal is the unique ID of the proposal.
@@ -837,7 +1035,7 @@ func (m *Vote) Reset()         { *m = Vote{} }
 func (m *Vote) String() string { return proto.CompactTextString(m) }
 func (*Vote) ProtoMessage()    {}
 func (*Vote) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f5bddd15d7a54a9d, []int{10}
+	return fileDescriptor_f5bddd15d7a54a9d, []int{12}
 }
 func (m *Vote) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -902,6 +1100,7 @@ func (m *Vote) GetSubmitTime() time.Time {
 }
 
 func init() {
+	proto.RegisterEnum("cosmos.group.v1.TokenWeightSource", TokenWeightSource_name, TokenWeightSource_value)
 	proto.RegisterEnum("cosmos.group.v1.VoteOption", VoteOption_name, VoteOption_value)
 	proto.RegisterEnum("cosmos.group.v1.ProposalStatus", ProposalStatus_name, ProposalStatus_value)
 	proto.RegisterEnum("cosmos.group.v1.ProposalExecutorResult", ProposalExecutorResult_name, ProposalExecutorResult_value)
@@ -909,105 +1108,119 @@ func init() {
 	proto.RegisterType((*MemberRequest)(nil), "cosmos.group.v1.MemberRequest")
 	proto.RegisterType((*ThresholdDecisionPolicy)(nil), "cosmos.group.v1.ThresholdDecisionPolicy")
 	proto.RegisterType((*PercentageDecisionPolicy)(nil), "cosmos.group.v1.PercentageDecisionPolicy")
+	proto.RegisterType((*TokenWeightedDecisionPolicy)(nil), "cosmos.group.v1.TokenWeightedDecisionPolicy")
 	proto.RegisterType((*DecisionPolicyWindows)(nil), "cosmos.group.v1.DecisionPolicyWindows")
 	proto.RegisterType((*GroupInfo)(nil), "cosmos.group.v1.GroupInfo")
 	proto.RegisterType((*GroupMember)(nil), "cosmos.group.v1.GroupMember")
 	proto.RegisterType((*GroupPolicyInfo)(nil), "cosmos.group.v1.GroupPolicyInfo")
 	proto.RegisterType((*Proposal)(nil), "cosmos.group.v1.Proposal")
 	proto.RegisterType((*TallyResult)(nil), "cosmos.group.v1.TallyResult")
+	proto.RegisterType((*ProposalMemberWeight)(nil), "cosmos.group.v1.ProposalMemberWeight")
 	proto.RegisterType((*Vote)(nil), "cosmos.group.v1.Vote")
 }
 
 func init() { proto.RegisterFile("cosmos/group/v1/types.proto", fileDescriptor_f5bddd15d7a54a9d) }
 
 var fileDescriptor_f5bddd15d7a54a9d = []byte{
-	// 1369 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0x3b, 0x6f, 0x1b, 0xc7,
-	0x16, 0xd6, 0x92, 0x14, 0x1f, 0x87, 0x12, 0x49, 0x8f, 0x75, 0xad, 0x95, 0xe4, 0x4b, 0xea, 0xd2,
-	0xc6, 0xbd, 0x82, 0x2e, 0x4c, 0xda, 0x32, 0x90, 0x00, 0x2e, 0x82, 0x90, 0xd4, 0x3a, 0xa6, 0x60,
-	0x93, 0xc4, 0x72, 0x29, 0xc5, 0x6e, 0x16, 0x2b, 0xee, 0x98, 0x5a, 0x84, 0xbb, 0xc3, 0xec, 0x0e,
-	0x25, 0xf3, 0x1f, 0x18, 0x69, 0xe2, 0x32, 0x4d, 0x00, 0x03, 0x69, 0x52, 0xba, 0x30, 0x52, 0xa4,
-	0x0c, 0x52, 0x18, 0x29, 0x02, 0x23, 0x55, 0xaa, 0x24, 0xb0, 0x0b, 0xa7, 0x4a, 0x95, 0x36, 0x41,
-	0xb0, 0x33, 0xb3, 0x14, 0x1f, 0x12, 0x1d, 0x19, 0x46, 0x1a, 0x41, 0x33, 0xdf, 0x77, 0xce, 0x9c,
-	0xef, 0xbc, 0x48, 0xc2, 0x5a, 0x9b, 0x78, 0x36, 0xf1, 0x8a, 0x1d, 0x97, 0xf4, 0x7b, 0xc5, 0xc3,
-	0x6b, 0x45, 0x3a, 0xe8, 0x61, 0xaf, 0xd0, 0x73, 0x09, 0x25, 0x28, 0xcd, 0xc1, 0x02, 0x03, 0x0b,
-	0x87, 0xd7, 0x56, 0x97, 0x3a, 0xa4, 0x43, 0x18, 0x56, 0xf4, 0xff, 0xe3, 0xb4, 0xd5, 0x6c, 0x87,
-	0x90, 0x4e, 0x17, 0x17, 0xd9, 0x69, 0xbf, 0x7f, 0xbf, 0x68, 0xf6, 0x5d, 0x83, 0x5a, 0xc4, 0x11,
-	0x78, 0x6e, 0x12, 0xa7, 0x96, 0x8d, 0x3d, 0x6a, 0xd8, 0x3d, 0x41, 0x58, 0xe1, 0xef, 0xe8, 0xdc,
-	0xb3, 0x78, 0x54, 0x40, 0x93, 0xb6, 0x86, 0x33, 0x10, 0xd0, 0x39, 0xc3, 0xb6, 0x1c, 0x52, 0x64,
-	0x7f, 0xf9, 0x55, 0xfe, 0x2b, 0x09, 0xa2, 0x77, 0xb0, 0xbd, 0x8f, 0x5d, 0xb4, 0x05, 0x31, 0xc3,
-	0x34, 0x5d, 0xec, 0x79, 0xb2, 0xb4, 0x2e, 0x6d, 0x24, 0xca, 0xf2, 0x0f, 0x4f, 0xaf, 0x2c, 0x09,
-	0xdf, 0x25, 0x8e, 0x34, 0xa9, 0x6b, 0x39, 0x1d, 0x35, 0x20, 0xa2, 0x0b, 0x10, 0x3d, 0xc2, 0x56,
-	0xe7, 0x80, 0xca, 0x21, 0xdf, 0x44, 0x15, 0x27, 0xb4, 0x0a, 0x71, 0x1b, 0x53, 0xc3, 0x34, 0xa8,
-	0x21, 0x87, 0x19, 0x32, 0x3c, 0xa3, 0x6d, 0x88, 0x1b, 0xa6, 0x89, 0x4d, 0xdd, 0xa0, 0x72, 0x64,
-	0x5d, 0xda, 0x48, 0x6e, 0xad, 0x16, 0x78, 0xcc, 0x85, 0x20, 0xe6, 0x82, 0x16, 0xe8, 0x2d, 0x2f,
-	0x3e, 0xfb, 0x29, 0x37, 0xf7, 0xe8, 0xe7, 0x9c, 0xf4, 0xe5, 0xab, 0x27, 0x9b, 0x12, 0x7b, 0x19,
-	0x9b, 0x25, 0x9a, 0x3f, 0x82, 0x45, 0x1e, 0xb7, 0x8a, 0x3f, 0xee, 0x63, 0x8f, 0xfe, 0x53, 0xe1,
-	0xe7, 0xbf, 0x95, 0x60, 0x59, 0x3b, 0x70, 0xb1, 0x77, 0x40, 0xba, 0xe6, 0x36, 0x6e, 0x5b, 0x9e,
-	0x45, 0x9c, 0x06, 0xe9, 0x5a, 0xed, 0x01, 0xba, 0x08, 0x09, 0x1a, 0x40, 0x3c, 0x0a, 0xf5, 0xf8,
-	0x02, 0xbd, 0x0f, 0xb1, 0x23, 0xcb, 0x31, 0xc9, 0x91, 0xc7, 0x9e, 0x4b, 0x6e, 0xfd, 0xb7, 0x30,
-	0xd1, 0x2e, 0x85, 0x71, 0x7f, 0x7b, 0x9c, 0xad, 0x06, 0x66, 0x37, 0xaa, 0xdf, 0x3d, 0xbd, 0x92,
-	0x9d, 0x6d, 0xf3, 0xc9, 0xab, 0x27, 0x9b, 0x79, 0x4e, 0xb9, 0xe2, 0x99, 0x1f, 0x15, 0x4f, 0x09,
-	0x35, 0xff, 0x4c, 0x02, 0xb9, 0x81, 0xdd, 0x36, 0x76, 0xa8, 0xd1, 0xc1, 0x13, 0x3a, 0xb2, 0x00,
-	0xbd, 0x21, 0x26, 0x84, 0x8c, 0xdc, 0xbc, 0x05, 0x25, 0x3b, 0x7f, 0x4f, 0xc9, 0xa5, 0x11, 0x25,
-	0xa7, 0x45, 0x9b, 0xff, 0x46, 0x82, 0x7f, 0x9d, 0xf8, 0x1c, 0xba, 0x03, 0x8b, 0x87, 0x84, 0x5a,
-	0x4e, 0x47, 0xef, 0x61, 0xd7, 0x22, 0xbc, 0x26, 0xc9, 0xad, 0x95, 0xa9, 0x7e, 0xdb, 0x16, 0xf3,
-	0xc7, 0xdb, 0xed, 0xb3, 0x61, 0xbb, 0x2d, 0x70, 0xf3, 0x06, 0xb3, 0x46, 0xf7, 0x60, 0xc9, 0xb6,
-	0x1c, 0x1d, 0x3f, 0xc0, 0xed, 0xbe, 0xcf, 0x0e, 0xbc, 0x86, 0xce, 0xe8, 0x15, 0xd9, 0x96, 0xa3,
-	0x04, 0x4e, 0xb8, 0xef, 0xfc, 0x6f, 0x12, 0x24, 0x3e, 0xf0, 0x13, 0x51, 0x75, 0xee, 0x13, 0x94,
-	0x82, 0x90, 0xc5, 0xa3, 0x8d, 0xa8, 0x21, 0xcb, 0x44, 0x05, 0x98, 0x37, 0x4c, 0xdb, 0x72, 0x78,
-	0x9f, 0xce, 0x68, 0x6d, 0x4e, 0x9b, 0x39, 0x7f, 0x32, 0xc4, 0x0e, 0xb1, 0xeb, 0x27, 0x8b, 0x8d,
-	0x5f, 0x44, 0x0d, 0x8e, 0xe8, 0x3f, 0xb0, 0x40, 0x09, 0x35, 0xba, 0xba, 0x18, 0x8a, 0x79, 0x66,
-	0x99, 0x64, 0x77, 0x7b, 0x7c, 0x32, 0x6e, 0x01, 0xb4, 0x5d, 0x6c, 0x50, 0x3e, 0xbe, 0xd1, 0xb3,
-	0x8e, 0x6f, 0x42, 0x18, 0x97, 0x68, 0xfe, 0x2e, 0x24, 0x99, 0x5e, 0xb1, 0x7d, 0x56, 0x20, 0xce,
-	0xfa, 0x40, 0x1f, 0xea, 0x8e, 0xb1, 0x73, 0xd5, 0x44, 0x45, 0x88, 0xda, 0x8c, 0x24, 0x12, 0xbd,
-	0x3c, 0xd5, 0x6c, 0x62, 0x13, 0x08, 0x5a, 0xfe, 0x8f, 0x10, 0xa4, 0x99, 0x6f, 0xde, 0x0d, 0x2c,
-	0xa3, 0x6f, 0xb2, 0x1e, 0x46, 0x63, 0x0a, 0x8d, 0xc7, 0x34, 0x2c, 0x48, 0xf8, 0xec, 0x05, 0x89,
-	0x9c, 0x5e, 0x90, 0xf9, 0xf1, 0x82, 0x18, 0x90, 0x36, 0x45, 0x63, 0xeb, 0x3d, 0xa6, 0x45, 0xa4,
-	0x7c, 0x69, 0x2a, 0xe5, 0x25, 0x67, 0x50, 0xce, 0xbf, 0x7e, 0xa8, 0xd4, 0x94, 0x39, 0x3e, 0xea,
-	0xe3, 0x05, 0x8d, 0xbd, 0x79, 0x41, 0x6f, 0xc4, 0x1f, 0x3e, 0xce, 0xcd, 0xfd, 0xfa, 0x38, 0x27,
-	0xe5, 0xff, 0x9c, 0x87, 0x78, 0xc3, 0x25, 0x3d, 0xe2, 0x19, 0xdd, 0xa9, 0x56, 0xde, 0x81, 0x25,
-	0x9e, 0x54, 0x2e, 0x48, 0x0f, 0xaa, 0xf2, 0xba, 0xce, 0x46, 0x9d, 0xe3, 0x8a, 0x0a, 0x64, 0x66,
-	0x9b, 0xbf, 0x03, 0x89, 0x1e, 0x8b, 0x01, 0xbb, 0x9e, 0x1c, 0x59, 0x0f, 0xcf, 0x74, 0x7e, 0x4c,
-	0x45, 0x3b, 0x90, 0xf4, 0xfa, 0xfb, 0xb6, 0x45, 0x75, 0xff, 0x43, 0x97, 0x55, 0xe4, 0x4c, 0x19,
-	0x01, 0x6e, 0xed, 0xe3, 0xe8, 0x12, 0x2c, 0x72, 0xad, 0x41, 0x7d, 0xa3, 0x2c, 0x0d, 0x0b, 0xec,
-	0x72, 0x57, 0x14, 0xf9, 0xea, 0x44, 0x42, 0x02, 0x6e, 0x8c, 0x71, 0x47, 0x65, 0x07, 0x16, 0xef,
-	0x42, 0xd4, 0xa3, 0x06, 0xed, 0x7b, 0x72, 0x7c, 0x5d, 0xda, 0x48, 0x6d, 0xe5, 0xa6, 0x06, 0x22,
-	0xc8, 0x7e, 0x93, 0xd1, 0x54, 0x41, 0x47, 0x2d, 0x40, 0xf7, 0x2d, 0xc7, 0xe8, 0xea, 0xd4, 0xe8,
-	0x76, 0x07, 0xba, 0x8b, 0xbd, 0x7e, 0x97, 0xca, 0x09, 0x26, 0xf1, 0xe2, 0x94, 0x13, 0xcd, 0x27,
-	0xa9, 0x8c, 0x53, 0x4e, 0xf8, 0x22, 0xb9, 0xc0, 0x0c, 0x73, 0x31, 0x02, 0xa2, 0x16, 0x9c, 0x1b,
-	0x5b, 0xb3, 0x3a, 0x76, 0x4c, 0x19, 0xce, 0x9a, 0xb8, 0xf4, 0xe8, 0xae, 0x55, 0x1c, 0x13, 0x35,
-	0x20, 0xcd, 0x57, 0x2d, 0x71, 0x83, 0x50, 0x93, 0x4c, 0xef, 0xff, 0x4e, 0xd5, 0xab, 0x08, 0x3e,
-	0x0f, 0x4c, 0x4d, 0xe1, 0xb1, 0x33, 0xba, 0xea, 0xf7, 0x8b, 0xe7, 0x19, 0x1d, 0xec, 0xc9, 0x0b,
-	0xeb, 0xe1, 0xd3, 0x06, 0x49, 0x1d, 0xb2, 0xd0, 0x12, 0xcc, 0x53, 0x8b, 0x76, 0xb1, 0xbc, 0xc8,
-	0xda, 0x8b, 0x1f, 0xfc, 0x89, 0xf5, 0xfa, 0xb6, 0x6d, 0xb8, 0x03, 0x39, 0xc5, 0xee, 0x83, 0xe3,
-	0x8d, 0x88, 0x3f, 0x04, 0xf9, 0xcf, 0x25, 0x48, 0x8e, 0x26, 0x68, 0x0d, 0x12, 0x03, 0xec, 0xe9,
-	0x6d, 0xd2, 0x77, 0xa8, 0xf8, 0x38, 0x8d, 0x0f, 0xb0, 0x57, 0xf1, 0xcf, 0x7e, 0x93, 0x18, 0xfb,
-	0x1e, 0x35, 0x2c, 0x47, 0x10, 0xf8, 0x77, 0x91, 0x05, 0x71, 0xc9, 0x49, 0x2b, 0x10, 0x77, 0x88,
-	0xc0, 0x79, 0xa7, 0xc7, 0x1c, 0xc2, 0xa1, 0xff, 0x03, 0x72, 0x88, 0x7e, 0x64, 0xd1, 0x03, 0xfd,
-	0x10, 0xd3, 0x80, 0xc4, 0x97, 0x4c, 0xda, 0x21, 0x7b, 0x16, 0x3d, 0xd8, 0xc5, 0x94, 0x93, 0x45,
-	0x7c, 0xbf, 0x4b, 0x10, 0xd9, 0x25, 0x14, 0xa3, 0x1c, 0x24, 0x7b, 0x22, 0x75, 0xc7, 0x8b, 0x17,
-	0x82, 0x2b, 0xbe, 0xe7, 0x0e, 0x09, 0x15, 0xab, 0x77, 0xe6, 0x9e, 0x63, 0x34, 0x74, 0x1d, 0xa2,
-	0xa4, 0xe7, 0x7f, 0xac, 0xb1, 0x28, 0x53, 0x5b, 0x6b, 0x53, 0xa5, 0xf2, 0xdf, 0xad, 0x33, 0x8a,
-	0x2a, 0xa8, 0x33, 0x97, 0xe3, 0x5b, 0x1c, 0xc7, 0xcd, 0x4f, 0x25, 0x80, 0xe3, 0xe7, 0xd1, 0x1a,
-	0x2c, 0xef, 0xd6, 0x35, 0x45, 0xaf, 0x37, 0xb4, 0x6a, 0xbd, 0xa6, 0xb7, 0x6a, 0xcd, 0x86, 0x52,
-	0xa9, 0xde, 0xac, 0x2a, 0xdb, 0x99, 0x39, 0x74, 0x1e, 0xd2, 0xa3, 0xe0, 0x5d, 0xa5, 0x99, 0x91,
-	0xd0, 0x32, 0x9c, 0x1f, 0xbd, 0x2c, 0x95, 0x9b, 0x5a, 0xa9, 0x5a, 0xcb, 0x84, 0x10, 0x82, 0xd4,
-	0x28, 0x50, 0xab, 0x67, 0xc2, 0xe8, 0x22, 0xc8, 0xe3, 0x77, 0xfa, 0x5e, 0x55, 0xbb, 0xa5, 0xef,
-	0x2a, 0x5a, 0x3d, 0x13, 0x59, 0x8d, 0x3c, 0xfc, 0x22, 0x3b, 0xb7, 0xf9, 0xbd, 0x04, 0xa9, 0xf1,
-	0x59, 0x45, 0x39, 0x58, 0x6b, 0xa8, 0xf5, 0x46, 0xbd, 0x59, 0xba, 0xad, 0x37, 0xb5, 0x92, 0xd6,
-	0x6a, 0x4e, 0x44, 0xf6, 0x6f, 0x58, 0x99, 0x24, 0x34, 0x5b, 0xe5, 0x3b, 0x55, 0x4d, 0x53, 0xb6,
-	0x33, 0x92, 0xff, 0xec, 0x24, 0x5c, 0xaa, 0x54, 0x94, 0x86, 0x8f, 0x86, 0x4e, 0x42, 0x55, 0x65,
-	0x47, 0xa9, 0xf8, 0x68, 0xd8, 0xcf, 0xc8, 0x94, 0x6d, 0xb9, 0xae, 0xfa, 0x60, 0xe4, 0xa4, 0x77,
-	0x7d, 0x41, 0xdb, 0x6a, 0x69, 0xaf, 0x96, 0x99, 0x17, 0x82, 0xbe, 0x96, 0xe0, 0xc2, 0xc9, 0xc3,
-	0x88, 0x36, 0xe0, 0xf2, 0xd0, 0x5e, 0xf9, 0x50, 0xa9, 0xb4, 0xb4, 0xba, 0xaa, 0xab, 0x4a, 0xb3,
-	0x75, 0x5b, 0x9b, 0x50, 0x78, 0x19, 0xd6, 0x4f, 0x65, 0xd6, 0xea, 0x9a, 0xae, 0xb6, 0x6a, 0x19,
-	0x69, 0x26, 0xab, 0xd9, 0xaa, 0x54, 0x94, 0x66, 0x33, 0x13, 0x9a, 0xc9, 0xba, 0x59, 0xaa, 0xde,
-	0x6e, 0xa9, 0x4a, 0x26, 0xcc, 0x83, 0x2f, 0xbf, 0xf7, 0xec, 0x45, 0x56, 0x7a, 0xfe, 0x22, 0x2b,
-	0xfd, 0xf2, 0x22, 0x2b, 0x3d, 0x7a, 0x99, 0x9d, 0x7b, 0xfe, 0x32, 0x3b, 0xf7, 0xe3, 0xcb, 0xec,
-	0xdc, 0xbd, 0xcb, 0x1d, 0x8b, 0x1e, 0xf4, 0xf7, 0x0b, 0x6d, 0x62, 0x8b, 0x5f, 0x5b, 0xc5, 0x91,
-	0x6f, 0xa6, 0x0f, 0xf8, 0x8f, 0xc1, 0xfd, 0x28, 0x6b, 0xc7, 0xeb, 0x7f, 0x05, 0x00, 0x00, 0xff,
-	0xff, 0x98, 0xe9, 0x54, 0xdb, 0x23, 0x0e, 0x00, 0x00,
+	// 1567 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x58, 0x3d, 0x6c, 0x1b, 0xc9,
+	0x15, 0xd6, 0x92, 0x14, 0x45, 0x3e, 0x4a, 0x24, 0x3d, 0x56, 0xac, 0x95, 0xe4, 0x90, 0x0a, 0x6d,
+	0x38, 0x86, 0x02, 0x93, 0xb6, 0x1c, 0x24, 0x80, 0x8a, 0x20, 0xfc, 0x59, 0xdb, 0x94, 0x25, 0x92,
+	0x58, 0x2e, 0xa5, 0xd8, 0xcd, 0x62, 0xc5, 0x1d, 0x53, 0x0b, 0x93, 0x3b, 0xcc, 0xee, 0x50, 0x32,
+	0xab, 0x14, 0x69, 0x8c, 0x34, 0x71, 0x99, 0x26, 0x80, 0x81, 0x34, 0x29, 0x5d, 0x18, 0x57, 0x5c,
+	0x79, 0x77, 0x85, 0x71, 0x95, 0x71, 0xd5, 0x55, 0x77, 0x07, 0xbb, 0xf0, 0xe1, 0x8a, 0xab, 0xae,
+	0xb9, 0xee, 0xb0, 0x33, 0xb3, 0x14, 0x7f, 0x24, 0xca, 0x32, 0x8c, 0x6b, 0x0c, 0xcf, 0xbc, 0xef,
+	0xcd, 0xbc, 0xef, 0x7b, 0x3f, 0xb3, 0x22, 0xac, 0x36, 0x89, 0xdb, 0x21, 0x6e, 0xae, 0xe5, 0x90,
+	0x5e, 0x37, 0x77, 0x78, 0x2b, 0x47, 0xfb, 0x5d, 0xec, 0x66, 0xbb, 0x0e, 0xa1, 0x04, 0x25, 0xb8,
+	0x31, 0xcb, 0x8c, 0xd9, 0xc3, 0x5b, 0x2b, 0x8b, 0x2d, 0xd2, 0x22, 0xcc, 0x96, 0xf3, 0xfe, 0xc7,
+	0x61, 0x2b, 0xa9, 0x16, 0x21, 0xad, 0x36, 0xce, 0xb1, 0xd5, 0x7e, 0xef, 0x51, 0xce, 0xec, 0x39,
+	0x06, 0xb5, 0x88, 0x2d, 0xec, 0xe9, 0x71, 0x3b, 0xb5, 0x3a, 0xd8, 0xa5, 0x46, 0xa7, 0x2b, 0x00,
+	0xcb, 0xfc, 0x1e, 0x9d, 0x9f, 0x2c, 0x2e, 0x15, 0xa6, 0x71, 0x5f, 0xc3, 0xee, 0x0b, 0xd3, 0x05,
+	0xa3, 0x63, 0xd9, 0x24, 0xc7, 0xfe, 0xe5, 0x5b, 0x99, 0x4f, 0x24, 0x08, 0xef, 0xe0, 0xce, 0x3e,
+	0x76, 0xd0, 0x06, 0xcc, 0x19, 0xa6, 0xe9, 0x60, 0xd7, 0x95, 0xa5, 0x35, 0xe9, 0x7a, 0xb4, 0x20,
+	0x7f, 0xf5, 0xf2, 0xc6, 0xa2, 0x38, 0x3b, 0xcf, 0x2d, 0x75, 0xea, 0x58, 0x76, 0x4b, 0xf5, 0x81,
+	0xe8, 0x12, 0x84, 0x8f, 0xb0, 0xd5, 0x3a, 0xa0, 0x72, 0xc0, 0x73, 0x51, 0xc5, 0x0a, 0xad, 0x40,
+	0xa4, 0x83, 0xa9, 0x61, 0x1a, 0xd4, 0x90, 0x83, 0xcc, 0x32, 0x58, 0xa3, 0x12, 0x44, 0x0c, 0xd3,
+	0xc4, 0xa6, 0x6e, 0x50, 0x39, 0xb4, 0x26, 0x5d, 0x8f, 0x6d, 0xac, 0x64, 0x79, 0xcc, 0x59, 0x3f,
+	0xe6, 0xac, 0xe6, 0xf3, 0x2d, 0x2c, 0xbc, 0xfa, 0x26, 0x3d, 0xf3, 0xec, 0xdb, 0xb4, 0xf4, 0xff,
+	0x77, 0x2f, 0xd6, 0x25, 0x76, 0x33, 0x36, 0xf3, 0x34, 0x73, 0x04, 0x0b, 0x3c, 0x6e, 0x15, 0xff,
+	0xbd, 0x87, 0x5d, 0xfa, 0x6b, 0x85, 0x9f, 0xf9, 0x42, 0x82, 0x25, 0xed, 0xc0, 0xc1, 0xee, 0x01,
+	0x69, 0x9b, 0x25, 0xdc, 0xb4, 0x5c, 0x8b, 0xd8, 0x35, 0xd2, 0xb6, 0x9a, 0x7d, 0x74, 0x19, 0xa2,
+	0xd4, 0x37, 0xf1, 0x28, 0xd4, 0xe3, 0x0d, 0xf4, 0x57, 0x98, 0x3b, 0xb2, 0x6c, 0x93, 0x1c, 0xb9,
+	0xec, 0xba, 0xd8, 0xc6, 0xb5, 0xec, 0x58, 0xb9, 0x64, 0x47, 0xcf, 0xdb, 0xe3, 0x68, 0xd5, 0x77,
+	0xdb, 0x2c, 0x7f, 0xf9, 0xf2, 0x46, 0x6a, 0xba, 0xcf, 0xbf, 0xde, 0xbd, 0x58, 0xcf, 0x70, 0xc8,
+	0x0d, 0xd7, 0x7c, 0x9c, 0x3b, 0x25, 0xd4, 0xcc, 0x2b, 0x09, 0xe4, 0x1a, 0x76, 0x9a, 0xd8, 0xa6,
+	0x46, 0x0b, 0x8f, 0xf1, 0x48, 0x01, 0x74, 0x07, 0x36, 0x41, 0x64, 0x68, 0xe7, 0x23, 0x30, 0xd9,
+	0x7a, 0x3f, 0x26, 0x57, 0x86, 0x98, 0x9c, 0x16, 0x6d, 0xe6, 0x79, 0x00, 0x56, 0x35, 0xf2, 0x18,
+	0xdb, 0x7b, 0x2c, 0x7b, 0x78, 0x3c, 0x2b, 0x8b, 0x30, 0x6b, 0x62, 0x9b, 0x74, 0x04, 0x11, 0xbe,
+	0x40, 0x9b, 0x10, 0x76, 0x49, 0xcf, 0x69, 0x62, 0x46, 0x21, 0xbe, 0x91, 0x99, 0xa0, 0x30, 0x74,
+	0x66, 0x9d, 0x21, 0x55, 0xe1, 0x31, 0xa6, 0x4f, 0x70, 0x9a, 0x3e, 0xa1, 0x0f, 0xd3, 0x67, 0xe7,
+	0xfd, 0xf4, 0xb9, 0x36, 0x9c, 0xe9, 0xd3, 0x25, 0xc8, 0x7c, 0x26, 0xc1, 0x6f, 0x4e, 0xbc, 0x11,
+	0xed, 0xc0, 0xc2, 0x21, 0xa1, 0x96, 0xdd, 0xd2, 0xbb, 0xd8, 0xb1, 0x08, 0x2f, 0xdb, 0xd8, 0xc6,
+	0xf2, 0x44, 0x4b, 0x96, 0xc4, 0x88, 0xe2, 0x1d, 0xf9, 0x9f, 0x41, 0x47, 0xce, 0x73, 0xf7, 0x1a,
+	0xf3, 0x46, 0x0f, 0x61, 0xb1, 0x63, 0xd9, 0x3a, 0x7e, 0x82, 0x9b, 0x3d, 0x0f, 0xed, 0x9f, 0x1a,
+	0x38, 0xe7, 0xa9, 0xa8, 0x63, 0xd9, 0x8a, 0x7f, 0x08, 0x3f, 0x3b, 0xf3, 0xa3, 0x04, 0xd1, 0xbb,
+	0x9e, 0x16, 0x65, 0xfb, 0x11, 0x41, 0x71, 0x08, 0x58, 0x3c, 0xda, 0x90, 0x1a, 0xb0, 0x4c, 0x94,
+	0x85, 0x59, 0xc3, 0xec, 0x58, 0xb6, 0x1c, 0x38, 0xa3, 0xfb, 0x39, 0x6c, 0xea, 0x88, 0x92, 0x61,
+	0xee, 0x10, 0x3b, 0x9e, 0x58, 0x2c, 0x7f, 0x21, 0xd5, 0x5f, 0xa2, 0xdf, 0xc1, 0x3c, 0x25, 0xd4,
+	0x68, 0xeb, 0x62, 0x6e, 0xcc, 0x32, 0xcf, 0x18, 0xdb, 0xe3, 0xda, 0xa3, 0x7b, 0x00, 0x4d, 0x07,
+	0x1b, 0x94, 0x4f, 0xb8, 0xf0, 0x79, 0x27, 0x5c, 0x54, 0x38, 0xe7, 0x69, 0xe6, 0x01, 0xc4, 0x18,
+	0x5f, 0x31, 0xa0, 0x97, 0x21, 0xc2, 0x4a, 0x41, 0x1f, 0xf0, 0x9e, 0x63, 0xeb, 0xb2, 0x89, 0x72,
+	0x10, 0xee, 0x30, 0x90, 0x10, 0x7a, 0x69, 0xa2, 0xde, 0xc4, 0xb0, 0x14, 0xb0, 0xcc, 0xcf, 0x41,
+	0x48, 0xb0, 0xb3, 0x79, 0x35, 0x30, 0x45, 0x3f, 0x64, 0x82, 0x0e, 0xc7, 0x14, 0x18, 0x8d, 0x69,
+	0x90, 0x90, 0xe0, 0xf9, 0x13, 0x12, 0x3a, 0x3d, 0x21, 0xb3, 0xa3, 0x09, 0x31, 0x20, 0x61, 0x8a,
+	0xc2, 0xd6, 0xbb, 0x8c, 0x8b, 0x90, 0x7c, 0x71, 0x42, 0xf2, 0xbc, 0xdd, 0x2f, 0x64, 0xce, 0xee,
+	0x2b, 0x35, 0x6e, 0x8e, 0xac, 0xc7, 0x12, 0x3a, 0xf7, 0xe1, 0x09, 0x45, 0x7f, 0x84, 0x48, 0xab,
+	0x67, 0x38, 0xa6, 0x65, 0xd8, 0x72, 0xe4, 0x0c, 0x55, 0x06, 0x48, 0xef, 0xc1, 0xf4, 0xde, 0xff,
+	0x36, 0x69, 0x3e, 0x96, 0xa3, 0xe7, 0xec, 0xa3, 0x81, 0xe7, 0x66, 0xe4, 0xe9, 0xf3, 0xf4, 0xcc,
+	0xf7, 0xcf, 0xd3, 0x52, 0xe6, 0xf3, 0x30, 0x44, 0x6a, 0x0e, 0xe9, 0x12, 0xd7, 0x68, 0x4f, 0xb4,
+	0xd1, 0x16, 0x2c, 0xf2, 0x84, 0x72, 0x31, 0x75, 0xbf, 0x22, 0xce, 0xea, 0x2a, 0xd4, 0x3a, 0xae,
+	0x26, 0x61, 0x99, 0xda, 0x62, 0x7f, 0x82, 0x68, 0x97, 0xc5, 0x80, 0x1d, 0x6f, 0x48, 0x06, 0xa7,
+	0x1e, 0x7e, 0x0c, 0x45, 0x5b, 0x10, 0x73, 0x7b, 0xfb, 0x1d, 0x8b, 0xea, 0x1e, 0x33, 0x79, 0xf6,
+	0xbc, 0xd9, 0x00, 0xee, 0xed, 0xd9, 0xd1, 0x15, 0x58, 0xe0, 0x5c, 0xfd, 0xda, 0x0a, 0x33, 0x19,
+	0xe6, 0xd9, 0xe6, 0xae, 0x28, 0xb0, 0x9b, 0x63, 0x82, 0xf8, 0xd8, 0x39, 0x86, 0x1d, 0xa6, 0xed,
+	0x7b, 0xfc, 0x19, 0xc2, 0x2e, 0x35, 0x68, 0xcf, 0x65, 0x39, 0x8e, 0x6f, 0xa4, 0x27, 0x9a, 0xd1,
+	0x57, 0xbf, 0xce, 0x60, 0xaa, 0x80, 0xa3, 0x06, 0xa0, 0x47, 0x96, 0x6d, 0xb4, 0x75, 0x6a, 0xb4,
+	0xdb, 0x7d, 0xdd, 0xc1, 0x6e, 0xaf, 0x4d, 0x45, 0xca, 0x2f, 0x4f, 0x3e, 0x4f, 0x1e, 0x48, 0x65,
+	0x98, 0x42, 0xd4, 0x23, 0xc9, 0x09, 0x26, 0xd9, 0x11, 0x43, 0x46, 0xd4, 0x80, 0x0b, 0x23, 0x23,
+	0x5e, 0xc7, 0xb6, 0x29, 0xc3, 0x79, 0x85, 0x4b, 0x0c, 0xcf, 0x79, 0xc5, 0x36, 0x51, 0x0d, 0x12,
+	0x7c, 0xcc, 0x13, 0xc7, 0x0f, 0x35, 0xc6, 0xf8, 0xfe, 0xfe, 0x54, 0xbe, 0x8a, 0xc0, 0xf3, 0xc0,
+	0xd4, 0x38, 0x1e, 0x59, 0xa3, 0x9b, 0x5e, 0xbd, 0xb8, 0xae, 0xd1, 0xc2, 0xae, 0x3c, 0xbf, 0x16,
+	0x3c, 0xad, 0x89, 0xd5, 0x01, 0xca, 0x7b, 0xda, 0xa9, 0x45, 0xdb, 0x58, 0x5e, 0xe0, 0x4f, 0x3b,
+	0x5b, 0x78, 0xd3, 0xc2, 0xed, 0x75, 0x3a, 0x86, 0xd3, 0x97, 0xe3, 0x6c, 0xdf, 0x5f, 0xa2, 0x22,
+	0xcc, 0xfb, 0x0d, 0xc1, 0x54, 0x48, 0x9c, 0xa9, 0x42, 0xc8, 0x53, 0x40, 0x8d, 0xf9, 0x5e, 0x8a,
+	0x6d, 0x6e, 0x86, 0xbc, 0x4e, 0xca, 0xfc, 0x57, 0x82, 0xd8, 0xb0, 0xca, 0xab, 0x10, 0xed, 0x63,
+	0x57, 0x6f, 0x92, 0x9e, 0x4d, 0xc5, 0x97, 0x46, 0xa4, 0x8f, 0xdd, 0xa2, 0xb7, 0xf6, 0x2a, 0xcd,
+	0xd8, 0x77, 0xa9, 0x61, 0xd9, 0x02, 0xc0, 0xbf, 0x37, 0xe7, 0xc5, 0x26, 0x07, 0x2d, 0x43, 0xc4,
+	0x26, 0xc2, 0xce, 0xdb, 0x65, 0xce, 0x26, 0xdc, 0xf4, 0x07, 0x40, 0x36, 0xd1, 0x8f, 0x2c, 0x7a,
+	0xa0, 0x1f, 0x62, 0xea, 0x83, 0xf8, 0x94, 0x4c, 0xd8, 0x64, 0xcf, 0xa2, 0x07, 0xbb, 0x98, 0x72,
+	0xb0, 0x88, 0xef, 0x9f, 0x12, 0x2c, 0xfa, 0xba, 0xf3, 0xe1, 0x2f, 0xde, 0xa7, 0x34, 0xc4, 0xba,
+	0x62, 0xff, 0xf8, 0x25, 0x01, 0x7f, 0xab, 0x6c, 0x0e, 0xbf, 0x03, 0x81, 0xf3, 0x7f, 0x49, 0x07,
+	0x87, 0xbf, 0xa4, 0x33, 0x3f, 0x49, 0x10, 0xda, 0x25, 0x14, 0x9f, 0x7d, 0x6b, 0x16, 0x66, 0x0f,
+	0x09, 0xc5, 0xce, 0x99, 0x77, 0x72, 0x18, 0xba, 0x0d, 0x61, 0xd2, 0xf5, 0x46, 0x1e, 0xbb, 0x31,
+	0xbe, 0xb1, 0x3a, 0x51, 0x75, 0xde, 0xbd, 0x55, 0x06, 0x51, 0x05, 0x74, 0xea, 0x1b, 0xf3, 0x11,
+	0x27, 0xcb, 0xfa, 0x3f, 0xe0, 0xc2, 0xc4, 0xd7, 0x23, 0xba, 0x02, 0x69, 0xad, 0x7a, 0x5f, 0xa9,
+	0xe8, 0x7b, 0x4a, 0xf9, 0xee, 0x3d, 0x4d, 0xaf, 0x57, 0x1b, 0x6a, 0x51, 0xd1, 0x1b, 0x95, 0x7a,
+	0x4d, 0x29, 0x96, 0xef, 0x94, 0x95, 0x52, 0x72, 0x06, 0xa5, 0x61, 0xf5, 0x24, 0x50, 0x21, 0xbf,
+	0x9d, 0xaf, 0x14, 0x95, 0xa4, 0x84, 0x52, 0xb0, 0x72, 0x12, 0xa0, 0xae, 0xe5, 0xef, 0x2b, 0xa5,
+	0x64, 0x60, 0x25, 0xf4, 0xf4, 0x7f, 0xa9, 0x99, 0xf5, 0x7f, 0x4b, 0x00, 0xc7, 0xfc, 0xd1, 0x2a,
+	0x2c, 0xed, 0x56, 0x35, 0x45, 0xaf, 0xd6, 0xb4, 0x72, 0xb5, 0x32, 0x76, 0xe5, 0x45, 0x48, 0x0c,
+	0x1b, 0x1f, 0x28, 0xf5, 0xa4, 0x84, 0x96, 0xe0, 0xe2, 0xf0, 0x66, 0xbe, 0x50, 0xd7, 0xf2, 0xe5,
+	0x4a, 0x32, 0x80, 0x10, 0xc4, 0x87, 0x0d, 0x95, 0x6a, 0x32, 0x88, 0x2e, 0x83, 0x3c, 0xba, 0xa7,
+	0xef, 0x95, 0xb5, 0x7b, 0xfa, 0xae, 0xa2, 0x55, 0x93, 0x21, 0x11, 0xd1, 0x0f, 0x12, 0xc4, 0x47,
+	0xe7, 0x9e, 0xc7, 0xb5, 0xa6, 0x56, 0x6b, 0xd5, 0x7a, 0x7e, 0xdb, 0x8b, 0x5f, 0x6b, 0xd4, 0xc7,
+	0x22, 0xfb, 0x2d, 0x2c, 0x8f, 0x03, 0xea, 0x8d, 0xc2, 0x4e, 0x59, 0xd3, 0x94, 0x52, 0x52, 0xf2,
+	0xae, 0x1d, 0x37, 0xe7, 0x8b, 0x45, 0xa5, 0xe6, 0x59, 0x03, 0x27, 0x59, 0x55, 0x65, 0x4b, 0x29,
+	0x7a, 0xd6, 0xa0, 0xa7, 0xc8, 0x84, 0x6f, 0xa1, 0xaa, 0x7a, 0xc6, 0xd0, 0x49, 0xf7, 0x7a, 0x84,
+	0x4a, 0x6a, 0x7e, 0xaf, 0x92, 0x9c, 0x45, 0x2b, 0x70, 0x69, 0xdc, 0xec, 0x51, 0x55, 0x4a, 0xc9,
+	0xb0, 0x20, 0xfb, 0xa9, 0x04, 0x97, 0x4e, 0x1e, 0x7a, 0xe8, 0x3a, 0x5c, 0x1d, 0x38, 0x2b, 0x7f,
+	0x53, 0x8a, 0x0d, 0xad, 0xaa, 0xea, 0xaa, 0x52, 0x6f, 0x6c, 0x6b, 0x63, 0xec, 0xaf, 0xc2, 0xda,
+	0xa9, 0xc8, 0x4a, 0x55, 0xd3, 0xd5, 0x46, 0x25, 0x29, 0x4d, 0x45, 0xd5, 0x1b, 0xc5, 0xa2, 0x52,
+	0xaf, 0x27, 0x03, 0x53, 0x51, 0x77, 0xf2, 0xe5, 0xed, 0x86, 0xaa, 0x24, 0x83, 0x3c, 0xf8, 0xc2,
+	0x5f, 0x5e, 0xbd, 0x49, 0x49, 0xaf, 0xdf, 0xa4, 0xa4, 0xef, 0xde, 0xa4, 0xa4, 0x67, 0x6f, 0x53,
+	0x33, 0xaf, 0xdf, 0xa6, 0x66, 0xbe, 0x7e, 0x9b, 0x9a, 0x79, 0x78, 0xb5, 0x65, 0xd1, 0x83, 0xde,
+	0x7e, 0xb6, 0x49, 0x3a, 0xe2, 0x47, 0x87, 0xdc, 0xd0, 0x1f, 0x20, 0x4f, 0xf8, 0x6f, 0x22, 0xfb,
+	0x61, 0xd6, 0x2b, 0xb7, 0x7f, 0x19, 0x00, 0xbe, 0x7f, 0xbd, 0x18, 0x2a, 0x11, 0x00, 0x00,
 }
 
 func (this *GroupPolicyInfo) Equal(that interface{}) bool {
@@ -1050,6 +1263,12 @@ func (this *GroupPolicyInfo) Equal(that interface{}) bool {
 	if !this.CreatedAt.Equal(that1.CreatedAt) {
 		return false
 	}
+	if this.Guardian != that1.Guardian {
+		return false
+	}
+	if this.Timelock != that1.Timelock {
+		return false
+	}
 	return true
 }
 func (m *Member) Marshal() (dAtA []byte, err error) {
@@ -1232,6 +1451,60 @@ func (m *PercentageDecisionPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
+func (m *TokenWeightedDecisionPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TokenWeightedDecisionPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TokenWeightedDecisionPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Windows != nil {
+		{
+			size, err := m.Windows.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Percentage) > 0 {
+		i -= len(m.Percentage)
+		copy(dAtA[i:], m.Percentage)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Percentage)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Source != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Source))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *DecisionPolicyWindows) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1252,21 +1525,21 @@ func (m *DecisionPolicyWindows) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	n4, err4 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.MinExecutionPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MinExecutionPeriod):])
-	if err4 != nil {
-		return 0, err4
-	}
-	i -= n4
-	i = encodeVarintTypes(dAtA, i, uint64(n4))
-	i--
-	dAtA[i] = 0x12
-	n5, err5 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.VotingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.VotingPeriod):])
+	n5, err5 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.MinExecutionPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MinExecutionPeriod):])
 	if err5 != nil {
 		return 0, err5
 	}
 	i -= n5
 	i = encodeVarintTypes(dAtA, i, uint64(n5))
 	i--
+	dAtA[i] = 0x12
+	n6, err6 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.VotingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.VotingPeriod):])
+	if err6 != nil {
+		return 0, err6
+	}
+	i -= n6
+	i = encodeVarintTypes(dAtA, i, uint64(n6))
+	i--
 	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
@@ -1291,12 +1564,12 @@ func (m *GroupInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	n6, err6 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CreatedAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CreatedAt):])
-	if err6 != nil {
-		return 0, err6
+	n7, err7 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CreatedAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CreatedAt):])
+	if err7 != nil {
+		return 0, err7
 	}
-	i -= n6
-	i = encodeVarintTypes(dAtA, i, uint64(n6))
+	i -= n7
+	i = encodeVarintTypes(dAtA, i, uint64(n7))
 	i--
 	dAtA[i] = 0x32
 	if len(m.TotalWeight) > 0 {
@@ -1393,12 +1666,27 @@ func (m *GroupPolicyInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	n8, err8 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CreatedAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CreatedAt):])
-	if err8 != nil {
-		return 0, err8
+	n9, err9 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Timelock, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Timelock):])
+	if err9 != nil {
+		return 0, err9
+	}
+	i -= n9
+	i = encodeVarintTypes(dAtA, i, uint64(n9))
+	i--
+	dAtA[i] = 0x4a
+	if len(m.Guardian) > 0 {
+		i -= len(m.Guardian)
+		copy(dAtA[i:], m.Guardian)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Guardian)))
+		i--
+		dAtA[i] = 0x42
+	}
+	n10, err10 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CreatedAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CreatedAt):])
+	if err10 != nil {
+		return 0, err10
 	}
-	i -= n8
-	i = encodeVarintTypes(dAtA, i, uint64(n8))
+	i -= n10
+	i = encodeVarintTypes(dAtA, i, uint64(n10))
 	i--
 	dAtA[i] = 0x3a
 	if m.DecisionPolicy != nil {
@@ -1467,6 +1755,16 @@ func (m *Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.TimelockEnd != nil {
+		n12, err12 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.TimelockEnd, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.TimelockEnd):])
+		if err12 != nil {
+			return 0, err12
+		}
+		i -= n12
+		i = encodeVarintTypes(dAtA, i, uint64(n12))
+		i--
+		dAtA[i] = 0x7a
+	}
 	if len(m.Summary) > 0 {
 		i -= len(m.Summary)
 		copy(dAtA[i:], m.Summary)
@@ -1500,12 +1798,12 @@ func (m *Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x58
 	}
-	n10, err10 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.VotingPeriodEnd, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.VotingPeriodEnd):])
-	if err10 != nil {
-		return 0, err10
+	n13, err13 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.VotingPeriodEnd, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.VotingPeriodEnd):])
+	if err13 != nil {
+		return 0, err13
 	}
-	i -= n10
-	i = encodeVarintTypes(dAtA, i, uint64(n10))
+	i -= n13
+	i = encodeVarintTypes(dAtA, i, uint64(n13))
 	i--
 	dAtA[i] = 0x52
 	{
@@ -1533,12 +1831,12 @@ func (m *Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x30
 	}
-	n12, err12 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.SubmitTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.SubmitTime):])
-	if err12 != nil {
-		return 0, err12
+	n15, err15 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.SubmitTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.SubmitTime):])
+	if err15 != nil {
+		return 0, err15
 	}
-	i -= n12
-	i = encodeVarintTypes(dAtA, i, uint64(n12))
+	i -= n15
+	i = encodeVarintTypes(dAtA, i, uint64(n15))
 	i--
 	dAtA[i] = 0x2a
 	if len(m.Proposers) > 0 {
@@ -1623,6 +1921,48 @@ func (m *TallyResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ProposalMemberWeight) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProposalMemberWeight) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProposalMemberWeight) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Weight) > 0 {
+		i -= len(m.Weight)
+		copy(dAtA[i:], m.Weight)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Weight)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ProposalId != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Vote) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1643,12 +1983,12 @@ func (m *Vote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	n13, err13 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.SubmitTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.SubmitTime):])
-	if err13 != nil {
-		return 0, err13
+	n16, err16 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.SubmitTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.SubmitTime):])
+	if err16 != nil {
+		return 0, err16
 	}
-	i -= n13
-	i = encodeVarintTypes(dAtA, i, uint64(n13))
+	i -= n16
+	i = encodeVarintTypes(dAtA, i, uint64(n16))
 	i--
 	dAtA[i] = 0x2a
 	if len(m.Metadata) > 0 {
@@ -1767,22 +2107,46 @@ func (m *PercentageDecisionPolicy) Size() (n int) {
 	return n
 }
 
-func (m *DecisionPolicyWindows) Size() (n int) {
+func (m *TokenWeightedDecisionPolicy) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.VotingPeriod)
-	n += 1 + l + sovTypes(uint64(l))
-	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MinExecutionPeriod)
-	n += 1 + l + sovTypes(uint64(l))
-	return n
-}
-
-func (m *GroupInfo) Size() (n int) {
-	if m == nil {
-		return 0
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Source != 0 {
+		n += 1 + sovTypes(uint64(m.Source))
+	}
+	l = len(m.Percentage)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Windows != nil {
+		l = m.Windows.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *DecisionPolicyWindows) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.VotingPeriod)
+	n += 1 + l + sovTypes(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MinExecutionPeriod)
+	n += 1 + l + sovTypes(uint64(l))
+	return n
+}
+
+func (m *GroupInfo) Size() (n int) {
+	if m == nil {
+		return 0
 	}
 	var l int
 	_ = l
@@ -1855,6 +2219,12 @@ func (m *GroupPolicyInfo) Size() (n int) {
 	}
 	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CreatedAt)
 	n += 1 + l + sovTypes(uint64(l))
+	l = len(m.Guardian)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Timelock)
+	n += 1 + l + sovTypes(uint64(l))
 	return n
 }
 
@@ -1913,6 +2283,10 @@ func (m *Proposal) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTypes(uint64(l))
 	}
+	if m.TimelockEnd != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.TimelockEnd)
+		n += 1 + l + sovTypes(uint64(l))
+	}
 	return n
 }
 
@@ -1941,6 +2315,26 @@ func (m *TallyResult) Size() (n int) {
 	return n
 }
 
+func (m *ProposalMemberWeight) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovTypes(uint64(m.ProposalId))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Weight)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
 func (m *Vote) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2533,6 +2927,175 @@ func (m *PercentageDecisionPolicy) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *TokenWeightedDecisionPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TokenWeightedDecisionPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TokenWeightedDecisionPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+			}
+			m.Source = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Source |= TokenWeightSource(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Percentage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Percentage = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Windows", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Windows == nil {
+				m.Windows = &DecisionPolicyWindows{}
+			}
+			if err := m.Windows.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *DecisionPolicyWindows) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -3203,6 +3766,71 @@ func (m *GroupPolicyInfo) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Guardian", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Guardian = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timelock", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Timelock, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -3641,6 +4269,42 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 			}
 			m.Summary = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimelockEnd", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TimelockEnd == nil {
+				m.TimelockEnd = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.TimelockEnd, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -3840,6 +4504,139 @@ func (m *TallyResult) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ProposalMemberWeight) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProposalMemberWeight: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProposalMemberWeight: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Weight = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *Vote) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -386,3 +386,75 @@ func TestThresholdDecisionPolicyAllow(t *testing.T) {
 		})
 	}
 }
+
+func TestTokenWeightedDecisionPolicyValidateBasic(t *testing.T) {
+	windows := &group.DecisionPolicyWindows{VotingPeriod: time.Hour}
+	testCases := []struct {
+		name   string
+		policy group.TokenWeightedDecisionPolicy
+		expErr bool
+	}{
+		{
+			"unspecified source",
+			group.TokenWeightedDecisionPolicy{Denom: "stake", Percentage: "0.5", Windows: windows},
+			true,
+		},
+		{
+			"invalid balance denom",
+			group.TokenWeightedDecisionPolicy{Source: group.TOKEN_WEIGHT_SOURCE_BALANCE, Denom: "1", Percentage: "0.5", Windows: windows},
+			true,
+		},
+		{
+			"denom with staked source",
+			group.TokenWeightedDecisionPolicy{Source: group.TOKEN_WEIGHT_SOURCE_STAKED, Denom: "stake", Percentage: "0.5", Windows: windows},
+			true,
+		},
+		{
+			"invalid percentage",
+			group.TokenWeightedDecisionPolicy{Source: group.TOKEN_WEIGHT_SOURCE_STAKED, Percentage: "2", Windows: windows},
+			true,
+		},
+		{
+			"balance",
+			group.TokenWeightedDecisionPolicy{Source: group.TOKEN_WEIGHT_SOURCE_BALANCE, Denom: "stake", Percentage: "0.5", Windows: windows},
+			false,
+		},
+		{
+			"staked",
+			group.TokenWeightedDecisionPolicy{Source: group.TOKEN_WEIGHT_SOURCE_STAKED, Percentage: "0.5", Windows: windows},
+			false,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.policy.ValidateBasic()
+			if tc.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestTokenWeightedDecisionPolicyAllow(t *testing.T) {
+	policy := group.TokenWeightedDecisionPolicy{
+		Source:     group.TOKEN_WEIGHT_SOURCE_STAKED,
+		Percentage: "0.5",
+		Windows:    &group.DecisionPolicyWindows{VotingPeriod: time.Hour},
+	}
+	tally := group.TallyResult{YesCount: "60", NoCount: "0", AbstainCount: "0", NoWithVetoCount: "0"}
+
+	result, err := policy.Allow(tally, "100")
+	require.NoError(t, err)
+	require.Equal(t, group.DecisionPolicyResult{Allow: true, Final: true}, result)
+
+	result, err = policy.Allow(tally, "200")
+	require.NoError(t, err)
+	require.Equal(t, group.DecisionPolicyResult{Allow: false, Final: false}, result)
+
+	// a proposal cannot pass if no member holds tokens
+	result, err = policy.Allow(group.DefaultTallyResult(), "0")
+	require.NoError(t, err)
+	require.Equal(t, group.DecisionPolicyResult{Allow: false, Final: true}, result)
+}
@@ -0,0 +1,368 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/nft"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/version"
+)
+
+// flag for nft module
+const (
+	FlagName             = "name"
+	FlagSymbol           = "symbol"
+	FlagDescription      = "description"
+	FlagURI              = "uri"
+	FlagURIHash          = "uri-hash"
+	FlagMinters          = "minters"
+	FlagTransferDisabled = "transfer-disabled"
+	FlagRoyaltyReceiver  = "royalty-receiver"
+	FlagRoyaltyRate      = "royalty-rate"
+)
+
+// GetTxCmd returns the transaction commands for nft module
+func GetTxCmd() *cobra.Command {
+	nftTxCmd := &cobra.Command{
+		Use:                        nft.ModuleName,
+		Short:                      "nft transactions subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	nftTxCmd.AddCommand(
+		NewCmdCreateClass(),
+		NewCmdMint(),
+		NewCmdBurn(),
+		NewCmdUpdate(),
+		NewCmdApprove(),
+		NewCmdSetApprovalForAll(),
+	)
+
+	return nftTxCmd
+}
+
+// NewCmdCreateClass returns a CLI command handler to create a MsgCreateClass transaction.
+func NewCmdCreateClass() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-class [class-id] --from [admin]",
+		Short: "Create a NFT class administered by the signer",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(
+				`Create a NFT class administered by the signer. The admin can mint, burn and update the
+NFTs of the class, and the --minters can mint NFTs of the class. The NFTs of a class
+created with --transfer-disabled are soulbound and cannot be transferred once minted.
+The --royalty-rate of the sale price of its NFTs is owed to the --royalty-receiver.
+
+Examples:
+%s tx %s create-class kitty --name "Crypto Kitty" --symbol kitty --minters cosmos1skjw... --from mykey
+%s tx %s create-class kitty --royalty-receiver cosmos1skjw... --royalty-rate 0.05 --from mykey
+				`, version.AppName, nft.ModuleName, version.AppName, nft.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			name, err := cmd.Flags().GetString(FlagName)
+			if err != nil {
+				return err
+			}
+
+			symbol, err := cmd.Flags().GetString(FlagSymbol)
+			if err != nil {
+				return err
+			}
+
+			description, err := cmd.Flags().GetString(FlagDescription)
+			if err != nil {
+				return err
+			}
+
+			uri, uriHash, err := getURI(cmd)
+			if err != nil {
+				return err
+			}
+
+			minters, err := cmd.Flags().GetStringSlice(FlagMinters)
+			if err != nil {
+				return err
+			}
+
+			transferDisabled, err := cmd.Flags().GetBool(FlagTransferDisabled)
+			if err != nil {
+				return err
+			}
+
+			royaltyInfo, err := getRoyaltyInfo(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &nft.MsgCreateClass{
+				Admin: clientCtx.GetFromAddress().String(),
+				Class: &nft.Class{
+					Id:               args[0],
+					Name:             name,
+					Symbol:           symbol,
+					Description:      description,
+					Uri:              uri,
+					UriHash:          uriHash,
+					Minters:          minters,
+					TransferDisabled: transferDisabled,
+					RoyaltyInfo:      royaltyInfo,
+				},
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	cmd.Flags().String(FlagName, "", "Human-readable name of the class")
+	cmd.Flags().String(FlagSymbol, "", "Abbreviated name of the class")
+	cmd.Flags().String(FlagDescription, "", "Brief description of the class")
+	addURIFlags(cmd)
+	cmd.Flags().StringSlice(FlagMinters, []string{}, "Accounts allowed to mint NFTs of the class besides the admin")
+	cmd.Flags().Bool(FlagTransferDisabled, false, "Disable the transfer of the NFTs of the class once minted")
+	cmd.Flags().String(FlagRoyaltyReceiver, "", "Account receiving the royalty on the sales of the NFTs of the class")
+	cmd.Flags().String(FlagRoyaltyRate, "", "Fraction of the sale price paid as royalty, between 0 and 1")
+
+	return cmd
+}
+
+// NewCmdMint returns a CLI command handler to create a MsgMint transaction.
+func NewCmdMint() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "mint [class-id] [nft-id] [receiver] --from [minter]",
+		Short: "Mint a NFT of a class by its admin or one of its minters",
+		Example: fmt.Sprintf(
+			`%s tx %s mint kitty kitty1 cosmos1skjw... --uri "kitty uri" --from mykey`,
+			version.AppName, nft.ModuleName,
+		),
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			uri, uriHash, err := getURI(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &nft.MsgMint{
+				Minter: clientCtx.GetFromAddress().String(),
+				Nft: &nft.NFT{
+					ClassId: args[0],
+					Id:      args[1],
+					Uri:     uri,
+					UriHash: uriHash,
+				},
+				Receiver: args[2],
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	addURIFlags(cmd)
+
+	return cmd
+}
+
+// NewCmdBurn returns a CLI command handler to create a MsgBurn transaction.
+func NewCmdBurn() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "burn [class-id] [nft-id] --from [sender]",
+		Short: "Burn a NFT by its owner or the admin of its class",
+		Example: fmt.Sprintf(
+			`%s tx %s burn kitty kitty1 --from mykey`,
+			version.AppName, nft.ModuleName,
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &nft.MsgBurn{
+				Sender:  clientCtx.GetFromAddress().String(),
+				ClassId: args[0],
+				Id:      args[1],
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewCmdUpdate returns a CLI command handler to create a MsgUpdate transaction.
+func NewCmdUpdate() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update [class-id] [nft-id] --from [admin]",
+		Short: "Update the uri of a NFT by the admin of its class",
+		Example: fmt.Sprintf(
+			`%s tx %s update kitty kitty1 --uri "new kitty uri" --from mykey`,
+			version.AppName, nft.ModuleName,
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			uri, uriHash, err := getURI(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &nft.MsgUpdate{
+				Sender: clientCtx.GetFromAddress().String(),
+				Nft: &nft.NFT{
+					ClassId: args[0],
+					Id:      args[1],
+					Uri:     uri,
+					UriHash: uriHash,
+				},
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	addURIFlags(cmd)
+
+	return cmd
+}
+
+// NewCmdApprove returns a CLI command handler to create a MsgApprove transaction.
+func NewCmdApprove() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "approve [class-id] [nft-id] [spender] --from [sender]",
+		Short: "Approve an account to transfer a NFT, or clear its approval if no spender is given",
+		Example: fmt.Sprintf(
+			`%s tx %s approve kitty kitty1 cosmos1skjw... --from mykey
+%s tx %s approve kitty kitty1 --from mykey`,
+			version.AppName, nft.ModuleName, version.AppName, nft.ModuleName,
+		),
+		Args: cobra.RangeArgs(2, 3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &nft.MsgApprove{
+				Sender:  clientCtx.GetFromAddress().String(),
+				ClassId: args[0],
+				Id:      args[1],
+			}
+			if len(args) == 3 {
+				msg.Spender = args[2]
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewCmdSetApprovalForAll returns a CLI command handler to create a MsgSetApprovalForAll transaction.
+func NewCmdSetApprovalForAll() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-approval-for-all [class-id] [operator] [approved] --from [owner]",
+		Short: "Approve or revoke an operator to transfer all the NFTs of a class owned by the signer",
+		Example: fmt.Sprintf(
+			`%s tx %s set-approval-for-all kitty cosmos1skjw... true --from mykey`,
+			version.AppName, nft.ModuleName,
+		),
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			approved, err := strconv.ParseBool(args[2])
+			if err != nil {
+				return fmt.Errorf("invalid approved %s: %w", args[2], err)
+			}
+
+			msg := &nft.MsgSetApprovalForAll{
+				Owner:    clientCtx.GetFromAddress().String(),
+				ClassId:  args[0],
+				Operator: args[1],
+				Approved: approved,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func getRoyaltyInfo(cmd *cobra.Command) (*nft.RoyaltyInfo, error) {
+	receiver, err := cmd.Flags().GetString(FlagRoyaltyReceiver)
+	if err != nil {
+		return nil, err
+	}
+
+	rateStr, err := cmd.Flags().GetString(FlagRoyaltyRate)
+	if err != nil {
+		return nil, err
+	}
+
+	if receiver == "" && rateStr == "" {
+		return nil, nil
+	}
+
+	rate, err := math.LegacyNewDecFromStr(rateStr)
+	if err != nil {
+		return nil, fmt.Errorf("invalid royalty rate %s: %w", rateStr, err)
+	}
+
+	return &nft.RoyaltyInfo{Receiver: receiver, Rate: rate}, nil
+}
+
+func addURIFlags(cmd *cobra.Command) {
+	cmd.Flags().String(FlagURI, "", "URI of the metadata stored off chain")
+	cmd.Flags().String(FlagURIHash, "", "Hash of the document pointed by the URI")
+}
+
+func getURI(cmd *cobra.Command) (string, string, error) {
+	uri, err := cmd.Flags().GetString(FlagURI)
+	if err != nil {
+		return "", "", err
+	}
+
+	uriHash, err := cmd.Flags().GetString(FlagURIHash)
+	return uri, uriHash, err
+}
@@ -10,6 +10,21 @@ func ValidateGenesis(data GenesisState, ac address.Codec) error {
 		if len(class.Id) == 0 {
 			return ErrEmptyClassID
 		}
+		if len(class.Admin) > 0 {
+			if _, err := ac.StringToBytes(class.Admin); err != nil {
+				return err
+			}
+		}
+		for _, minter := range class.Minters {
+			if _, err := ac.StringToBytes(minter); err != nil {
+				return err
+			}
+		}
+		if class.RoyaltyInfo != nil {
+			if err := class.RoyaltyInfo.Validate(ac); err != nil {
+				return err
+			}
+		}
 	}
 	for _, entry := range data.Entries {
 		for _, nft := range entry.Nfts {
@@ -21,6 +36,28 @@ func ValidateGenesis(data GenesisState, ac address.Codec) error {
 			}
 		}
 	}
+	for _, approval := range data.TokenApprovals {
+		if len(approval.ClassId) == 0 {
+			return ErrEmptyClassID
+		}
+		if len(approval.Id) == 0 {
+			return ErrEmptyNFTID
+		}
+		if _, err := ac.StringToBytes(approval.Spender); err != nil {
+			return err
+		}
+	}
+	for _, approval := range data.OperatorApprovals {
+		if len(approval.ClassId) == 0 {
+			return ErrEmptyClassID
+		}
+		if _, err := ac.StringToBytes(approval.Owner); err != nil {
+			return err
+		}
+		if _, err := ac.StringToBytes(approval.Operator); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
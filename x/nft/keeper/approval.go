@@ -0,0 +1,134 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+
+	"cosmossdk.io/store/prefix"
+	"cosmossdk.io/x/nft"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Approve defines a method for approving an account to transfer a nft, same as approve in ERC721.
+// An empty spender clears the approval, which is also cleared when the nft is transferred or burnt.
+// Note: When the upper module uses this method, it needs to authenticate nft
+func (k Keeper) Approve(ctx context.Context, classID, nftID string, spender sdk.AccAddress) {
+	store := k.storeService.OpenKVStore(ctx)
+	if spender.Empty() {
+		if err := store.Delete(tokenApprovalStoreKey(classID, nftID)); err != nil {
+			panic(err)
+		}
+		return
+	}
+
+	if err := store.Set(tokenApprovalStoreKey(classID, nftID), spender.Bytes()); err != nil {
+		panic(err)
+	}
+}
+
+// GetApproved returns the account approved to transfer the specified nft, same as getApproved in ERC721
+func (k Keeper) GetApproved(ctx context.Context, classID, nftID string) sdk.AccAddress {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get(tokenApprovalStoreKey(classID, nftID))
+	if err != nil {
+		panic(err)
+	}
+	return sdk.AccAddress(bz)
+}
+
+// SetApprovalForAll defines a method for approving or revoking an operator to transfer all the nfts
+// of a class owned by the owner, same as setApprovalForAll in ERC721
+// Note: When the upper module uses this method, it needs to authenticate nft
+func (k Keeper) SetApprovalForAll(ctx context.Context, owner sdk.AccAddress, classID string, operator sdk.AccAddress, approved bool) {
+	store := k.storeService.OpenKVStore(ctx)
+	key := operatorApprovalStoreKey(owner, classID, operator)
+
+	var err error
+	if approved {
+		err = store.Set(key, Placeholder)
+	} else {
+		err = store.Delete(key)
+	}
+	if err != nil {
+		panic(err)
+	}
+}
+
+// HasApprovalForAll returns true if the operator is approved to transfer all the nfts of the class
+// owned by the owner, same as isApprovedForAll in ERC721
+func (k Keeper) HasApprovalForAll(ctx context.Context, owner sdk.AccAddress, classID string, operator sdk.AccAddress) bool {
+	store := k.storeService.OpenKVStore(ctx)
+	has, err := store.Has(operatorApprovalStoreKey(owner, classID, operator))
+	if err != nil {
+		panic(err)
+	}
+	return has
+}
+
+// IsApprovedOrOwner returns true if the account is the owner of the specified nft, the account
+// approved to transfer it or an operator of its owner. Marketplaces can use it to authenticate
+// the transfers they perform with the Transfer method.
+func (k Keeper) IsApprovedOrOwner(ctx context.Context, classID, nftID string, account sdk.AccAddress) bool {
+	owner := k.GetOwner(ctx, classID, nftID)
+	if owner.Empty() {
+		return false
+	}
+
+	if bytes.Equal(owner, account) {
+		return true
+	}
+
+	if bytes.Equal(k.GetApproved(ctx, classID, nftID), account) {
+		return true
+	}
+
+	return k.HasApprovalForAll(ctx, owner, classID, account)
+}
+
+// GetTokenApprovals returns all the nft token approvals
+func (k Keeper) GetTokenApprovals(ctx context.Context) (approvals []*nft.TokenApproval) {
+	store := k.storeService.OpenKVStore(ctx)
+	approvalStore := prefix.NewStore(runtime.KVStoreAdapter(store), TokenApprovalKey)
+	iterator := approvalStore.Iterator(nil, nil)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		classID, nftID := parseTokenApprovalStoreKey(iterator.Key())
+		spender, err := k.ac.BytesToString(iterator.Value())
+		if err != nil {
+			panic(err)
+		}
+		approvals = append(approvals, &nft.TokenApproval{
+			ClassId: classID,
+			Id:      nftID,
+			Spender: spender,
+		})
+	}
+	return approvals
+}
+
+// GetOperatorApprovals returns all the nft operator approvals
+func (k Keeper) GetOperatorApprovals(ctx context.Context) (approvals []*nft.OperatorApproval) {
+	store := k.storeService.OpenKVStore(ctx)
+	approvalStore := prefix.NewStore(runtime.KVStoreAdapter(store), OperatorApprovalKey)
+	iterator := approvalStore.Iterator(nil, nil)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		owner, classID, operator := parseOperatorApprovalStoreKey(iterator.Key())
+		ownerStr, err := k.ac.BytesToString(owner)
+		if err != nil {
+			panic(err)
+		}
+		operatorStr, err := k.ac.BytesToString(operator)
+		if err != nil {
+			panic(err)
+		}
+		approvals = append(approvals, &nft.OperatorApproval{
+			Owner:    ownerStr,
+			ClassId:  classID,
+			Operator: operatorStr,
+		})
+	}
+	return approvals
+}
@@ -0,0 +1,151 @@
+package keeper_test
+
+import (
+	"fmt"
+
+	"cosmossdk.io/x/nft"
+	"cosmossdk.io/x/nft/keeper"
+)
+
+func (s *TestSuite) TestApprove() {
+	msgServer := keeper.NewMsgServerImpl(s.nftKeeper)
+
+	s.Require().NoError(s.nftKeeper.SaveClass(s.ctx, ExpClass))
+	s.Require().NoError(s.nftKeeper.Mint(s.ctx, ExpNFT, s.addrs[0]))
+
+	// only the owner or an operator of the owner can approve
+	_, err := msgServer.Approve(s.ctx, &nft.MsgApprove{
+		Sender:  s.encodedAddrs[1],
+		ClassId: testClassID,
+		Id:      testID,
+		Spender: s.encodedAddrs[1],
+	})
+	s.Require().ErrorContains(err, fmt.Sprintf("%s is neither the owner of nft %s nor an operator of its owner", s.encodedAddrs[1], testID))
+
+	_, err = msgServer.Approve(s.ctx, &nft.MsgApprove{
+		Sender:  s.encodedAddrs[0],
+		ClassId: testClassID,
+		Id:      testID,
+		Spender: s.encodedAddrs[1],
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(s.addrs[1], s.nftKeeper.GetApproved(s.ctx, testClassID, testID))
+
+	res, err := s.queryClient.Approved(s.ctx, &nft.QueryApprovedRequest{ClassId: testClassID, Id: testID})
+	s.Require().NoError(err)
+	s.Require().Equal(s.encodedAddrs[1], res.Spender)
+
+	// the approved spender can transfer the nft, which clears the approval
+	_, err = msgServer.Send(s.ctx, &nft.MsgSend{
+		ClassId:  testClassID,
+		Id:       testID,
+		Sender:   s.encodedAddrs[1],
+		Receiver: s.encodedAddrs[2],
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(s.addrs[2], s.nftKeeper.GetOwner(s.ctx, testClassID, testID))
+	s.Require().True(s.nftKeeper.GetApproved(s.ctx, testClassID, testID).Empty())
+
+	_, err = msgServer.Send(s.ctx, &nft.MsgSend{
+		ClassId:  testClassID,
+		Id:       testID,
+		Sender:   s.encodedAddrs[1],
+		Receiver: s.encodedAddrs[1],
+	})
+	s.Require().ErrorContains(err, "unauthorized")
+
+	// an empty spender clears the approval
+	_, err = msgServer.Approve(s.ctx, &nft.MsgApprove{
+		Sender:  s.encodedAddrs[2],
+		ClassId: testClassID,
+		Id:      testID,
+		Spender: s.encodedAddrs[0],
+	})
+	s.Require().NoError(err)
+	_, err = msgServer.Approve(s.ctx, &nft.MsgApprove{
+		Sender:  s.encodedAddrs[2],
+		ClassId: testClassID,
+		Id:      testID,
+	})
+	s.Require().NoError(err)
+	s.Require().True(s.nftKeeper.GetApproved(s.ctx, testClassID, testID).Empty())
+}
+
+func (s *TestSuite) TestSetApprovalForAll() {
+	msgServer := keeper.NewMsgServerImpl(s.nftKeeper)
+
+	s.Require().NoError(s.nftKeeper.SaveClass(s.ctx, ExpClass))
+	s.Require().NoError(s.nftKeeper.Mint(s.ctx, ExpNFT, s.addrs[0]))
+
+	_, err := msgServer.SetApprovalForAll(s.ctx, &nft.MsgSetApprovalForAll{
+		Owner:    s.encodedAddrs[0],
+		ClassId:  testClassID,
+		Operator: s.encodedAddrs[0],
+		Approved: true,
+	})
+	s.Require().ErrorContains(err, "operator cannot be the owner")
+
+	_, err = msgServer.SetApprovalForAll(s.ctx, &nft.MsgSetApprovalForAll{
+		Owner:    s.encodedAddrs[0],
+		ClassId:  testClassID,
+		Operator: s.encodedAddrs[1],
+		Approved: true,
+	})
+	s.Require().NoError(err)
+
+	res, err := s.queryClient.IsApprovedForAll(s.ctx, &nft.QueryIsApprovedForAllRequest{
+		Owner:    s.encodedAddrs[0],
+		ClassId:  testClassID,
+		Operator: s.encodedAddrs[1],
+	})
+	s.Require().NoError(err)
+	s.Require().True(res.Approved)
+
+	// the operator can approve a spender of the nfts of the owner
+	_, err = msgServer.Approve(s.ctx, &nft.MsgApprove{
+		Sender:  s.encodedAddrs[1],
+		ClassId: testClassID,
+		Id:      testID,
+		Spender: s.encodedAddrs[2],
+	})
+	s.Require().NoError(err)
+
+	expGenesis := &nft.GenesisState{
+		Classes: []*nft.Class{&ExpClass},
+		Entries: []*nft.Entry{{
+			Owner: s.encodedAddrs[0],
+			Nfts:  []*nft.NFT{&ExpNFT},
+		}},
+		TokenApprovals: []*nft.TokenApproval{{
+			ClassId: testClassID,
+			Id:      testID,
+			Spender: s.encodedAddrs[2],
+		}},
+		OperatorApprovals: []*nft.OperatorApproval{{
+			Owner:    s.encodedAddrs[0],
+			ClassId:  testClassID,
+			Operator: s.encodedAddrs[1],
+		}},
+	}
+	s.Require().Equal(expGenesis, s.nftKeeper.ExportGenesis(s.ctx))
+
+	// the operator can transfer the nfts of the owner
+	_, err = msgServer.Send(s.ctx, &nft.MsgSend{
+		ClassId:  testClassID,
+		Id:       testID,
+		Sender:   s.encodedAddrs[1],
+		Receiver: s.encodedAddrs[1],
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(s.addrs[1], s.nftKeeper.GetOwner(s.ctx, testClassID, testID))
+
+	// a revoked operator cannot transfer the nfts of the owner anymore
+	_, err = msgServer.SetApprovalForAll(s.ctx, &nft.MsgSetApprovalForAll{
+		Owner:    s.encodedAddrs[0],
+		ClassId:  testClassID,
+		Operator: s.encodedAddrs[1],
+		Approved: false,
+	})
+	s.Require().NoError(err)
+	s.Require().False(s.nftKeeper.HasApprovalForAll(s.ctx, s.addrs[0], testClassID, s.addrs[1]))
+}
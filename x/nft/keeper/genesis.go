@@ -3,6 +3,7 @@ package keeper
 import (
 	"sort"
 
+	"cosmossdk.io/errors"
 	"cosmossdk.io/x/nft"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -28,6 +29,29 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data *nft.GenesisState) {
 			}
 		}
 	}
+	for _, approval := range data.TokenApprovals {
+		spender, err := k.ac.StringToBytes(approval.Spender)
+		if err != nil {
+			panic(err)
+		}
+
+		if !k.HasNFT(ctx, approval.ClassId, approval.Id) {
+			panic(errors.Wrap(nft.ErrNFTNotExists, approval.Id))
+		}
+		k.Approve(ctx, approval.ClassId, approval.Id, spender)
+	}
+	for _, approval := range data.OperatorApprovals {
+		owner, err := k.ac.StringToBytes(approval.Owner)
+		if err != nil {
+			panic(err)
+		}
+
+		operator, err := k.ac.StringToBytes(approval.Operator)
+		if err != nil {
+			panic(err)
+		}
+		k.SetApprovalForAll(ctx, owner, approval.ClassId, operator, true)
+	}
 }
 
 // ExportGenesis returns a GenesisState for a given context.
@@ -64,7 +88,9 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *nft.GenesisState {
 		})
 	}
 	return &nft.GenesisState{
-		Classes: classes,
-		Entries: entries,
+		Classes:           classes,
+		Entries:           entries,
+		TokenApprovals:    k.GetTokenApprovals(ctx),
+		OperatorApprovals: k.GetOperatorApprovals(ctx),
 	}
 }
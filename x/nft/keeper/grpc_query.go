@@ -202,3 +202,86 @@ func (k Keeper) Classes(goCtx context.Context, r *nft.QueryClassesRequest) (*nft
 		Pagination: pageRes,
 	}, nil
 }
+
+// Approved return the account approved to transfer an NFT, same as getApproved in ERC721
+func (k Keeper) Approved(goCtx context.Context, r *nft.QueryApprovedRequest) (*nft.QueryApprovedResponse, error) {
+	if r == nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("empty request")
+	}
+
+	if len(r.ClassId) == 0 {
+		return nil, nft.ErrEmptyClassID
+	}
+
+	if len(r.Id) == 0 {
+		return nil, nft.ErrEmptyNFTID
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	spender := k.GetApproved(ctx, r.ClassId, r.Id)
+	if spender.Empty() {
+		return &nft.QueryApprovedResponse{Spender: ""}, nil
+	}
+	spenderStr, err := k.ac.BytesToString(spender.Bytes())
+	if err != nil {
+		return nil, err
+	}
+	return &nft.QueryApprovedResponse{Spender: spenderStr}, nil
+}
+
+// IsApprovedForAll return if an operator is approved to transfer all the NFTs of a class owned by the owner,
+// same as isApprovedForAll in ERC721
+func (k Keeper) IsApprovedForAll(goCtx context.Context, r *nft.QueryIsApprovedForAllRequest) (*nft.QueryIsApprovedForAllResponse, error) {
+	if r == nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("empty request")
+	}
+
+	if len(r.ClassId) == 0 {
+		return nil, nft.ErrEmptyClassID
+	}
+
+	owner, err := k.ac.StringToBytes(r.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	operator, err := k.ac.StringToBytes(r.Operator)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	approved := k.HasApprovalForAll(ctx, owner, r.ClassId, operator)
+	return &nft.QueryIsApprovedForAllResponse{Approved: approved}, nil
+}
+
+// RoyaltyInfo return the royalty paid on the sale of an NFT of a class for a given sale price,
+// same as royaltyInfo in ERC2981
+func (k Keeper) RoyaltyInfo(goCtx context.Context, r *nft.QueryRoyaltyInfoRequest) (*nft.QueryRoyaltyInfoResponse, error) {
+	if r == nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("empty request")
+	}
+
+	if len(r.ClassId) == 0 {
+		return nil, nft.ErrEmptyClassID
+	}
+
+	if err := r.SalePrice.Validate(); err != nil {
+		return nil, sdkerrors.ErrInvalidCoins.Wrap(err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	receiver, amount, err := k.GetRoyalty(ctx, r.ClassId, r.SalePrice)
+	if err != nil {
+		return nil, err
+	}
+
+	if receiver.Empty() {
+		return &nft.QueryRoyaltyInfoResponse{Receiver: "", RoyaltyAmount: amount}, nil
+	}
+	receiverStr, err := k.ac.BytesToString(receiver.Bytes())
+	if err != nil {
+		return nil, err
+	}
+	return &nft.QueryRoyaltyInfoResponse{Receiver: receiverStr, RoyaltyAmount: amount}, nil
+}
@@ -44,6 +44,7 @@ type TestSuite struct {
 	queryClient   nft.QueryClient
 	nftKeeper     keeper.Keeper
 	accountKeeper *nfttestutil.MockAccountKeeper
+	bankKeeper    *nfttestutil.MockBankKeeper
 
 	encCfg moduletestutil.TestEncodingConfig
 }
@@ -72,6 +73,7 @@ func (s *TestSuite) SetupTest() {
 	}
 
 	s.accountKeeper = accountKeeper
+	s.bankKeeper = bankKeeper
 
 	nftKeeper := keeper.NewKeeper(storeService, s.encCfg.Codec, accountKeeper, bankKeeper)
 	queryHelper := baseapp.NewQueryServerTestHelper(ctx, s.encCfg.InterfaceRegistry)
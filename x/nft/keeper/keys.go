@@ -16,6 +16,8 @@ var (
 	NFTOfClassByOwnerKey = []byte{0x03}
 	OwnerKey             = []byte{0x04}
 	ClassTotalSupply     = []byte{0x05}
+	TokenApprovalKey     = []byte{0x06}
+	OperatorApprovalKey  = []byte{0x07}
 
 	Delimiter   = []byte{0x00}
 	Placeholder = []byte{0x01}
@@ -105,3 +107,58 @@ func ownerStoreKey(classID, nftID string) []byte {
 	copy(key[len(OwnerKey)+len(classIDBz)+len(Delimiter):], nftIDBz)
 	return key
 }
+
+// tokenApprovalStoreKey returns the byte representation of the nft token approval
+// Items are stored with the following key: values
+// 0x06<classID><Delimiter(1 Byte)><nftID>
+func tokenApprovalStoreKey(classID, nftID string) []byte {
+	classIDBz := conv.UnsafeStrToBytes(classID)
+	nftIDBz := conv.UnsafeStrToBytes(nftID)
+
+	key := make([]byte, len(TokenApprovalKey)+len(classIDBz)+len(Delimiter)+len(nftIDBz))
+	copy(key, TokenApprovalKey)
+	copy(key[len(TokenApprovalKey):], classIDBz)
+	copy(key[len(TokenApprovalKey)+len(classIDBz):], Delimiter)
+	copy(key[len(TokenApprovalKey)+len(classIDBz)+len(Delimiter):], nftIDBz)
+	return key
+}
+
+// parseTokenApprovalStoreKey parses the key of the prefix store of the TokenApprovalKey,
+// of the format <classID><Delimiter><nftID>
+func parseTokenApprovalStoreKey(key []byte) (classID, nftID string) {
+	i := bytes.Index(key, Delimiter)
+	if i < 0 {
+		panic("invalid tokenApprovalStoreKey")
+	}
+	return string(key[:i]), string(key[i+len(Delimiter):])
+}
+
+// operatorApprovalStoreKey returns the byte representation of the nft operator approval
+// Items are stored with the following key: values
+// 0x07<owner><classID><Delimiter(1 Byte)><operator>
+func operatorApprovalStoreKey(owner sdk.AccAddress, classID string, operator sdk.AccAddress) []byte {
+	owner = address.MustLengthPrefix(owner)
+	classIDBz := conv.UnsafeStrToBytes(classID)
+
+	key := make([]byte, len(OperatorApprovalKey)+len(owner)+len(classIDBz)+len(Delimiter)+len(operator))
+	copy(key, OperatorApprovalKey)
+	copy(key[len(OperatorApprovalKey):], owner)
+	copy(key[len(OperatorApprovalKey)+len(owner):], classIDBz)
+	copy(key[len(OperatorApprovalKey)+len(owner)+len(classIDBz):], Delimiter)
+	copy(key[len(OperatorApprovalKey)+len(owner)+len(classIDBz)+len(Delimiter):], operator)
+	return key
+}
+
+// parseOperatorApprovalStoreKey parses the key of the prefix store of the OperatorApprovalKey,
+// of the format <owner><classID><Delimiter><operator>
+func parseOperatorApprovalStoreKey(key []byte) (owner sdk.AccAddress, classID string, operator sdk.AccAddress) {
+	ownerLen := int(key[0])
+	owner = sdk.AccAddress(key[1 : 1+ownerLen])
+
+	rest := key[1+ownerLen:]
+	i := bytes.Index(rest, Delimiter)
+	if i < 0 {
+		panic("invalid operatorApprovalStoreKey")
+	}
+	return owner, string(rest[:i]), sdk.AccAddress(rest[i+len(Delimiter):])
+}
@@ -11,7 +11,17 @@ import (
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
-var _ nft.MsgServer = Keeper{}
+type msgServer struct {
+	Keeper
+}
+
+var _ nft.MsgServer = msgServer{}
+
+// NewMsgServerImpl returns an implementation of the nft MsgServer interface
+// for the provided Keeper.
+func NewMsgServerImpl(keeper Keeper) nft.MsgServer {
+	return &msgServer{Keeper: keeper}
+}
 
 // Send implements Send method of the types.MsgServer.
 func (k Keeper) Send(goCtx context.Context, msg *nft.MsgSend) (*nft.MsgSendResponse, error) {
@@ -34,9 +44,8 @@ func (k Keeper) Send(goCtx context.Context, msg *nft.MsgSend) (*nft.MsgSendRespo
 	}
 
 	ctx := sdk.UnwrapSDKContext(goCtx)
-	owner := k.GetOwner(ctx, msg.ClassId, msg.Id)
-	if !bytes.Equal(owner, sender) {
-		return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is not the owner of nft %s", msg.Sender, msg.Id)
+	if !k.IsApprovedOrOwner(ctx, msg.ClassId, msg.Id, sender) {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is not the owner of nft %s nor approved to transfer it", msg.Sender, msg.Id)
 	}
 
 	if err := k.Transfer(ctx, msg.ClassId, msg.Id, receiver); err != nil {
@@ -51,3 +60,278 @@ func (k Keeper) Send(goCtx context.Context, msg *nft.MsgSend) (*nft.MsgSendRespo
 	})
 	return &nft.MsgSendResponse{}, nil
 }
+
+// CreateClass implements CreateClass method of the types.MsgServer.
+func (m msgServer) CreateClass(goCtx context.Context, msg *nft.MsgCreateClass) (*nft.MsgCreateClassResponse, error) {
+	if msg.Class == nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "class cannot be nil")
+	}
+
+	if len(msg.Class.Id) == 0 {
+		return nil, nft.ErrEmptyClassID
+	}
+
+	if _, err := m.ac.StringToBytes(msg.Admin); err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid admin address (%s)", msg.Admin)
+	}
+
+	if len(msg.Class.Admin) > 0 && msg.Class.Admin != msg.Admin {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "class admin %s must be empty or the signer %s", msg.Class.Admin, msg.Admin)
+	}
+
+	for _, minter := range msg.Class.Minters {
+		if _, err := m.ac.StringToBytes(minter); err != nil {
+			return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid minter address (%s)", minter)
+		}
+	}
+
+	if msg.Class.RoyaltyInfo != nil {
+		if err := msg.Class.RoyaltyInfo.Validate(m.ac); err != nil {
+			return nil, err
+		}
+	}
+
+	class := *msg.Class
+	class.Admin = msg.Admin
+	if err := m.SaveClass(goCtx, class); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := ctx.EventManager().EmitTypedEvent(&nft.EventCreateClass{
+		ClassId: class.Id,
+		Admin:   class.Admin,
+	}); err != nil {
+		return nil, err
+	}
+	return &nft.MsgCreateClassResponse{}, nil
+}
+
+// Mint implements Mint method of the types.MsgServer.
+func (m msgServer) Mint(goCtx context.Context, msg *nft.MsgMint) (*nft.MsgMintResponse, error) {
+	if msg.Nft == nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "nft cannot be nil")
+	}
+
+	if len(msg.Nft.ClassId) == 0 {
+		return nil, nft.ErrEmptyClassID
+	}
+
+	if len(msg.Nft.Id) == 0 {
+		return nil, nft.ErrEmptyNFTID
+	}
+
+	minter, err := m.ac.StringToBytes(msg.Minter)
+	if err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid minter address (%s)", msg.Minter)
+	}
+
+	receiver, err := m.ac.StringToBytes(msg.Receiver)
+	if err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid receiver address (%s)", msg.Receiver)
+	}
+
+	class, has := m.GetClass(goCtx, msg.Nft.ClassId)
+	if !has {
+		return nil, errorsmod.Wrap(nft.ErrClassNotExists, msg.Nft.ClassId)
+	}
+
+	if !m.isClassAdmin(class, minter) && !m.isClassMinter(class, minter) {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is not a minter of class %s", msg.Minter, class.Id)
+	}
+
+	if err := m.Keeper.Mint(goCtx, *msg.Nft, receiver); err != nil {
+		return nil, err
+	}
+	return &nft.MsgMintResponse{}, nil
+}
+
+// Burn implements Burn method of the types.MsgServer.
+func (m msgServer) Burn(goCtx context.Context, msg *nft.MsgBurn) (*nft.MsgBurnResponse, error) {
+	if len(msg.ClassId) == 0 {
+		return nil, nft.ErrEmptyClassID
+	}
+
+	if len(msg.Id) == 0 {
+		return nil, nft.ErrEmptyNFTID
+	}
+
+	sender, err := m.ac.StringToBytes(msg.Sender)
+	if err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", msg.Sender)
+	}
+
+	class, has := m.GetClass(goCtx, msg.ClassId)
+	if !has {
+		return nil, errorsmod.Wrap(nft.ErrClassNotExists, msg.ClassId)
+	}
+
+	if !m.HasNFT(goCtx, msg.ClassId, msg.Id) {
+		return nil, errorsmod.Wrap(nft.ErrNFTNotExists, msg.Id)
+	}
+
+	owner := m.GetOwner(goCtx, msg.ClassId, msg.Id)
+	if !bytes.Equal(owner, sender) && !m.isClassAdmin(class, sender) {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is neither the owner of nft %s nor the admin of class %s", msg.Sender, msg.Id, msg.ClassId)
+	}
+
+	if err := m.burnWithNoCheck(goCtx, msg.ClassId, msg.Id); err != nil {
+		return nil, err
+	}
+	return &nft.MsgBurnResponse{}, nil
+}
+
+// Update implements Update method of the types.MsgServer.
+func (m msgServer) Update(goCtx context.Context, msg *nft.MsgUpdate) (*nft.MsgUpdateResponse, error) {
+	if msg.Nft == nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "nft cannot be nil")
+	}
+
+	if len(msg.Nft.ClassId) == 0 {
+		return nil, nft.ErrEmptyClassID
+	}
+
+	if len(msg.Nft.Id) == 0 {
+		return nil, nft.ErrEmptyNFTID
+	}
+
+	sender, err := m.ac.StringToBytes(msg.Sender)
+	if err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", msg.Sender)
+	}
+
+	class, has := m.GetClass(goCtx, msg.Nft.ClassId)
+	if !has {
+		return nil, errorsmod.Wrap(nft.ErrClassNotExists, msg.Nft.ClassId)
+	}
+
+	if !m.isClassAdmin(class, sender) {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is not the admin of class %s", msg.Sender, class.Id)
+	}
+
+	if err := m.Keeper.Update(goCtx, *msg.Nft); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := ctx.EventManager().EmitTypedEvent(&nft.EventUpdate{
+		ClassId: msg.Nft.ClassId,
+		Id:      msg.Nft.Id,
+	}); err != nil {
+		return nil, err
+	}
+	return &nft.MsgUpdateResponse{}, nil
+}
+
+// Approve implements Approve method of the types.MsgServer.
+func (m msgServer) Approve(goCtx context.Context, msg *nft.MsgApprove) (*nft.MsgApproveResponse, error) {
+	if len(msg.ClassId) == 0 {
+		return nil, nft.ErrEmptyClassID
+	}
+
+	if len(msg.Id) == 0 {
+		return nil, nft.ErrEmptyNFTID
+	}
+
+	sender, err := m.ac.StringToBytes(msg.Sender)
+	if err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", msg.Sender)
+	}
+
+	var spender sdk.AccAddress
+	if len(msg.Spender) > 0 {
+		if spender, err = m.ac.StringToBytes(msg.Spender); err != nil {
+			return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid spender address (%s)", msg.Spender)
+		}
+	}
+
+	if err := m.checkTransferable(goCtx, msg.ClassId); err != nil {
+		return nil, err
+	}
+
+	if !m.HasNFT(goCtx, msg.ClassId, msg.Id) {
+		return nil, errorsmod.Wrap(nft.ErrNFTNotExists, msg.Id)
+	}
+
+	owner := m.GetOwner(goCtx, msg.ClassId, msg.Id)
+	if !bytes.Equal(owner, sender) && !m.HasApprovalForAll(goCtx, owner, msg.ClassId, sender) {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is neither the owner of nft %s nor an operator of its owner", msg.Sender, msg.Id)
+	}
+
+	m.Keeper.Approve(goCtx, msg.ClassId, msg.Id, spender)
+
+	ownerStr, err := m.ac.BytesToString(owner)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := ctx.EventManager().EmitTypedEvent(&nft.EventApprove{
+		ClassId: msg.ClassId,
+		Id:      msg.Id,
+		Owner:   ownerStr,
+		Spender: msg.Spender,
+	}); err != nil {
+		return nil, err
+	}
+	return &nft.MsgApproveResponse{}, nil
+}
+
+// SetApprovalForAll implements SetApprovalForAll method of the types.MsgServer.
+func (m msgServer) SetApprovalForAll(goCtx context.Context, msg *nft.MsgSetApprovalForAll) (*nft.MsgSetApprovalForAllResponse, error) {
+	if len(msg.ClassId) == 0 {
+		return nil, nft.ErrEmptyClassID
+	}
+
+	owner, err := m.ac.StringToBytes(msg.Owner)
+	if err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid owner address (%s)", msg.Owner)
+	}
+
+	operator, err := m.ac.StringToBytes(msg.Operator)
+	if err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid operator address (%s)", msg.Operator)
+	}
+
+	if bytes.Equal(owner, operator) {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "operator cannot be the owner")
+	}
+
+	if err := m.checkTransferable(goCtx, msg.ClassId); err != nil {
+		return nil, err
+	}
+
+	m.Keeper.SetApprovalForAll(goCtx, owner, msg.ClassId, operator, msg.Approved)
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := ctx.EventManager().EmitTypedEvent(&nft.EventApprovalForAll{
+		ClassId:  msg.ClassId,
+		Owner:    msg.Owner,
+		Operator: msg.Operator,
+		Approved: msg.Approved,
+	}); err != nil {
+		return nil, err
+	}
+	return &nft.MsgSetApprovalForAllResponse{}, nil
+}
+
+// isClassAdmin returns true if the account is the admin of the class.
+func (k Keeper) isClassAdmin(class nft.Class, account sdk.AccAddress) bool {
+	if len(class.Admin) == 0 {
+		return false
+	}
+
+	admin, err := k.ac.StringToBytes(class.Admin)
+	return err == nil && bytes.Equal(admin, account)
+}
+
+// isClassMinter returns true if the account is one of the minters of the class.
+func (k Keeper) isClassMinter(class nft.Class, account sdk.AccAddress) bool {
+	for _, m := range class.Minters {
+		minter, err := k.ac.StringToBytes(m)
+		if err == nil && bytes.Equal(minter, account) {
+			return true
+		}
+	}
+	return false
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	"cosmossdk.io/x/nft"
+	"cosmossdk.io/x/nft/keeper"
 )
 
 var (
@@ -130,3 +131,159 @@ func (s *TestSuite) TestSend() {
 		})
 	}
 }
+
+func (s *TestSuite) TestCreateClass() {
+	msgServer := keeper.NewMsgServerImpl(s.nftKeeper)
+
+	testCases := []struct {
+		name   string
+		req    *nft.MsgCreateClass
+		expErr bool
+		errMsg string
+	}{
+		{
+			name: "nil class",
+			req: &nft.MsgCreateClass{
+				Admin: s.encodedAddrs[0],
+			},
+			expErr: true,
+			errMsg: "class cannot be nil",
+		},
+		{
+			name: "invalid admin",
+			req: &nft.MsgCreateClass{
+				Admin: "invalid",
+				Class: &nft.Class{Id: testClassID},
+			},
+			expErr: true,
+			errMsg: "Invalid admin address",
+		},
+		{
+			name: "class admin different from signer",
+			req: &nft.MsgCreateClass{
+				Admin: s.encodedAddrs[0],
+				Class: &nft.Class{Id: testClassID, Admin: s.encodedAddrs[1]},
+			},
+			expErr: true,
+			errMsg: "must be empty or the signer",
+		},
+		{
+			name: "invalid minter",
+			req: &nft.MsgCreateClass{
+				Admin: s.encodedAddrs[0],
+				Class: &nft.Class{Id: testClassID, Minters: []string{"invalid"}},
+			},
+			expErr: true,
+			errMsg: "Invalid minter address",
+		},
+		{
+			name: "valid transaction",
+			req: &nft.MsgCreateClass{
+				Admin: s.encodedAddrs[0],
+				Class: &nft.Class{Id: testClassID, Minters: []string{s.encodedAddrs[1]}},
+			},
+			expErr: false,
+		},
+		{
+			name: "class already exists",
+			req: &nft.MsgCreateClass{
+				Admin: s.encodedAddrs[0],
+				Class: &nft.Class{Id: testClassID},
+			},
+			expErr: true,
+			errMsg: "nft class already exists",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			_, err := msgServer.CreateClass(s.ctx, tc.req)
+			if tc.expErr {
+				s.Require().Error(err)
+				s.Require().Contains(err.Error(), tc.errMsg)
+			} else {
+				s.Require().NoError(err)
+			}
+		})
+	}
+
+	class, has := s.nftKeeper.GetClass(s.ctx, testClassID)
+	s.Require().True(has)
+	s.Require().Equal(s.encodedAddrs[0], class.Admin)
+	s.Require().Equal([]string{s.encodedAddrs[1]}, class.Minters)
+}
+
+func (s *TestSuite) TestMsgMintBurnUpdate() {
+	msgServer := keeper.NewMsgServerImpl(s.nftKeeper)
+
+	_, err := msgServer.CreateClass(s.ctx, &nft.MsgCreateClass{
+		Admin: s.encodedAddrs[0],
+		Class: &nft.Class{Id: testClassID, Minters: []string{s.encodedAddrs[1]}},
+	})
+	s.Require().NoError(err)
+
+	// only the admin and the minters can mint
+	_, err = msgServer.Mint(s.ctx, &nft.MsgMint{
+		Minter:   s.encodedAddrs[2],
+		Nft:      &ExpNFT,
+		Receiver: s.encodedAddrs[2],
+	})
+	s.Require().ErrorContains(err, fmt.Sprintf("%s is not a minter of class %s", s.encodedAddrs[2], testClassID))
+
+	_, err = msgServer.Mint(s.ctx, &nft.MsgMint{
+		Minter:   s.encodedAddrs[1],
+		Nft:      &ExpNFT,
+		Receiver: s.encodedAddrs[2],
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(s.addrs[2], s.nftKeeper.GetOwner(s.ctx, testClassID, testID))
+
+	// only the admin can update
+	updated := ExpNFT
+	updated.Uri = "updated uri"
+	_, err = msgServer.Update(s.ctx, &nft.MsgUpdate{Sender: s.encodedAddrs[1], Nft: &updated})
+	s.Require().ErrorContains(err, fmt.Sprintf("%s is not the admin of class %s", s.encodedAddrs[1], testClassID))
+
+	_, err = msgServer.Update(s.ctx, &nft.MsgUpdate{Sender: s.encodedAddrs[0], Nft: &updated})
+	s.Require().NoError(err)
+	actual, has := s.nftKeeper.GetNFT(s.ctx, testClassID, testID)
+	s.Require().True(has)
+	s.Require().Equal(updated, actual)
+
+	// only the owner and the admin can burn
+	_, err = msgServer.Burn(s.ctx, &nft.MsgBurn{Sender: s.encodedAddrs[1], ClassId: testClassID, Id: testID})
+	s.Require().ErrorContains(err, "unauthorized")
+
+	_, err = msgServer.Burn(s.ctx, &nft.MsgBurn{Sender: s.encodedAddrs[2], ClassId: testClassID, Id: testID})
+	s.Require().NoError(err)
+	s.Require().False(s.nftKeeper.HasNFT(s.ctx, testClassID, testID))
+}
+
+func (s *TestSuite) TestSendTransferDisabled() {
+	msgServer := keeper.NewMsgServerImpl(s.nftKeeper)
+
+	_, err := msgServer.CreateClass(s.ctx, &nft.MsgCreateClass{
+		Admin: s.encodedAddrs[0],
+		Class: &nft.Class{Id: testClassID, TransferDisabled: true},
+	})
+	s.Require().NoError(err)
+
+	_, err = msgServer.Mint(s.ctx, &nft.MsgMint{
+		Minter:   s.encodedAddrs[0],
+		Nft:      &ExpNFT,
+		Receiver: s.encodedAddrs[1],
+	})
+	s.Require().NoError(err)
+
+	_, err = msgServer.Send(s.ctx, &nft.MsgSend{
+		ClassId:  testClassID,
+		Id:       testID,
+		Sender:   s.encodedAddrs[1],
+		Receiver: s.encodedAddrs[2],
+	})
+	s.Require().ErrorIs(err, nft.ErrTransferDisabled)
+
+	// the owner can still burn a soulbound nft
+	_, err = msgServer.Burn(s.ctx, &nft.MsgBurn{Sender: s.encodedAddrs[1], ClassId: testClassID, Id: testID})
+	s.Require().NoError(err)
+}
@@ -68,6 +68,7 @@ func (k Keeper) burnWithNoCheck(ctx context.Context, classID, nftID string) erro
 
 	k.deleteOwner(ctx, classID, nftID, owner)
 	k.decrTotalSupply(ctx, classID)
+	k.Approve(ctx, classID, nftID, nil)
 	ownerStr, err := k.ac.BytesToString(owner.Bytes())
 	if err != nil {
 		return err
@@ -108,8 +109,8 @@ func (k Keeper) Transfer(ctx context.Context,
 	nftID string,
 	receiver sdk.AccAddress,
 ) error {
-	if !k.HasClass(ctx, classID) {
-		return errors.Wrap(nft.ErrClassNotExists, classID)
+	if err := k.checkTransferable(ctx, classID); err != nil {
+		return err
 	}
 
 	if !k.HasNFT(ctx, classID, nftID) {
@@ -120,6 +121,20 @@ func (k Keeper) Transfer(ctx context.Context,
 	return nil
 }
 
+// checkTransferable returns an error if the class does not exist or if the
+// transfer of its nfts is disabled.
+func (k Keeper) checkTransferable(ctx context.Context, classID string) error {
+	class, has := k.GetClass(ctx, classID)
+	if !has {
+		return errors.Wrap(nft.ErrClassNotExists, classID)
+	}
+
+	if class.TransferDisabled {
+		return errors.Wrap(nft.ErrTransferDisabled, classID)
+	}
+	return nil
+}
+
 // Transfer defines a method for sending a nft from one account to another account.
 // Note: this method does not check whether the class already exists in nft.
 // The upper-layer application needs to check it when it needs to use it
@@ -131,6 +146,7 @@ func (k Keeper) transferWithNoCheck(ctx context.Context,
 	owner := k.GetOwner(ctx, classID, nftID)
 	k.deleteOwner(ctx, classID, nftID, owner)
 	k.setOwner(ctx, classID, nftID, receiver)
+	k.Approve(ctx, classID, nftID, nil)
 	return nil
 }
 
@@ -74,8 +74,8 @@ func (k Keeper) BatchTransfer(ctx context.Context,
 	nftIDs []string,
 	receiver sdk.AccAddress,
 ) error {
-	if !k.HasClass(ctx, classID) {
-		return errors.Wrap(nft.ErrClassNotExists, classID)
+	if err := k.checkTransferable(ctx, classID); err != nil {
+		return err
 	}
 	for _, nftID := range nftIDs {
 		if !k.HasNFT(ctx, classID, nftID) {
@@ -0,0 +1,76 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/nft"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GetRoyalty returns the receiver and the amount of the royalty paid on the sale of a nft of the
+// specified class, same as royaltyInfo in ERC2981. The receiver is empty and the amount is zero if
+// the class has no royalty.
+func (k Keeper) GetRoyalty(ctx context.Context, classID string, salePrice sdk.Coin) (sdk.AccAddress, sdk.Coin, error) {
+	class, has := k.GetClass(ctx, classID)
+	if !has {
+		return nil, sdk.Coin{}, errors.Wrap(nft.ErrClassNotExists, classID)
+	}
+
+	if class.RoyaltyInfo == nil {
+		return nil, sdk.NewCoin(salePrice.Denom, math.ZeroInt()), nil
+	}
+
+	receiver, err := k.ac.StringToBytes(class.RoyaltyInfo.Receiver)
+	if err != nil {
+		return nil, sdk.Coin{}, err
+	}
+	return receiver, class.RoyaltyInfo.Amount(salePrice), nil
+}
+
+// PayRoyalty is the hook marketplaces call on the sale of a nft to pay the royalty of its class
+// from the payer to the royalty receiver. It returns the royalty paid for each coin of the sale price.
+func (k Keeper) PayRoyalty(ctx context.Context, classID, nftID string, payer sdk.AccAddress, salePrice sdk.Coins) (sdk.Coins, error) {
+	if !k.HasNFT(ctx, classID, nftID) {
+		return nil, errors.Wrap(nft.ErrNFTNotExists, nftID)
+	}
+
+	royalty := sdk.NewCoins()
+	var receiver sdk.AccAddress
+	for _, coin := range salePrice {
+		r, amount, err := k.GetRoyalty(ctx, classID, coin)
+		if err != nil {
+			return nil, err
+		}
+		receiver = r
+		royalty = royalty.Add(amount)
+	}
+
+	if royalty.IsZero() {
+		return royalty, nil
+	}
+
+	if err := k.bk.SendCoins(ctx, payer, receiver, royalty); err != nil {
+		return nil, err
+	}
+
+	payerStr, err := k.ac.BytesToString(payer)
+	if err != nil {
+		return nil, err
+	}
+
+	receiverStr, err := k.ac.BytesToString(receiver)
+	if err != nil {
+		return nil, err
+	}
+
+	return royalty, sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&nft.EventPayRoyalty{
+		ClassId:  classID,
+		Id:       nftID,
+		Payer:    payerStr,
+		Receiver: receiverStr,
+		Amount:   royalty.String(),
+	})
+}
@@ -0,0 +1,57 @@
+package keeper_test
+
+import (
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/nft"
+	"cosmossdk.io/x/nft/keeper"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (s *TestSuite) TestRoyalty() {
+	msgServer := keeper.NewMsgServerImpl(s.nftKeeper)
+
+	_, err := msgServer.CreateClass(s.ctx, &nft.MsgCreateClass{
+		Admin: s.encodedAddrs[0],
+		Class: &nft.Class{
+			Id:          testClassID,
+			RoyaltyInfo: &nft.RoyaltyInfo{Receiver: s.encodedAddrs[0], Rate: math.LegacyNewDecWithPrec(15, 1)},
+		},
+	})
+	s.Require().ErrorIs(err, nft.ErrInvalidRoyalty)
+
+	_, err = msgServer.CreateClass(s.ctx, &nft.MsgCreateClass{
+		Admin: s.encodedAddrs[0],
+		Class: &nft.Class{
+			Id:          testClassID,
+			RoyaltyInfo: &nft.RoyaltyInfo{Receiver: s.encodedAddrs[0], Rate: math.LegacyNewDecWithPrec(5, 2)},
+		},
+	})
+	s.Require().NoError(err)
+	s.Require().NoError(s.nftKeeper.Mint(s.ctx, ExpNFT, s.addrs[1]))
+
+	res, err := s.queryClient.RoyaltyInfo(s.ctx, &nft.QueryRoyaltyInfoRequest{
+		ClassId:   testClassID,
+		SalePrice: sdk.NewInt64Coin("stake", 1010),
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(s.encodedAddrs[0], res.Receiver)
+	s.Require().Equal(sdk.NewInt64Coin("stake", 50), res.RoyaltyAmount)
+
+	// the buyer pays the royalty of each coin of the sale price
+	salePrice := sdk.NewCoins(sdk.NewInt64Coin("atom", 100), sdk.NewInt64Coin("stake", 1010))
+	royalty := sdk.NewCoins(sdk.NewInt64Coin("atom", 5), sdk.NewInt64Coin("stake", 50))
+	s.bankKeeper.EXPECT().SendCoins(s.ctx, s.addrs[2], s.addrs[0], royalty).Return(nil)
+
+	paid, err := s.nftKeeper.PayRoyalty(s.ctx, testClassID, testID, s.addrs[2], salePrice)
+	s.Require().NoError(err)
+	s.Require().Equal(royalty, paid)
+
+	// no royalty is paid on the nfts of a class without royalty
+	s.Require().NoError(s.nftKeeper.SaveClass(s.ctx, nft.Class{Id: "no-royalty"}))
+	s.Require().NoError(s.nftKeeper.Mint(s.ctx, nft.NFT{ClassId: "no-royalty", Id: testID}, s.addrs[1]))
+
+	paid, err = s.nftKeeper.PayRoyalty(s.ctx, "no-royalty", testID, s.addrs[2], salePrice)
+	s.Require().NoError(err)
+	s.Require().True(paid.IsZero())
+}
@@ -79,6 +79,37 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Short:     "Query all NFT classes.",
 					Example:   fmt.Sprintf(`%s query %s classes`, version.AppName, nft.ModuleName),
 				},
+				{
+					RpcMethod: "Approved",
+					Use:       "approved [class-id] [nft-id]",
+					Short:     "Query the account approved to transfer an NFT.",
+					Example:   fmt.Sprintf(`%s query %s approved <class-id> <nft-id>`, version.AppName, nft.ModuleName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "class_id"},
+						{ProtoField: "id"},
+					},
+				},
+				{
+					RpcMethod: "IsApprovedForAll",
+					Use:       "is-approved-for-all [owner] [class-id] [operator]",
+					Short:     "Query if an operator is approved to transfer all the NFTs of a class owned by the owner.",
+					Example:   fmt.Sprintf(`%s query %s is-approved-for-all <owner> <class-id> <operator>`, version.AppName, nft.ModuleName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "owner"},
+						{ProtoField: "class_id"},
+						{ProtoField: "operator"},
+					},
+				},
+				{
+					RpcMethod: "RoyaltyInfo",
+					Use:       "royalty-info [class-id] [sale-price]",
+					Short:     "Query the royalty paid on the sale of an NFT of a class for a given sale price.",
+					Example:   fmt.Sprintf(`%s query %s royalty-info <class-id> 1000stake`, version.AppName, nft.ModuleName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "class_id"},
+						{ProtoField: "sale_price"},
+					},
+				},
 			},
 		},
 		Tx: &autocliv1.ServiceCommandDescriptor{
@@ -96,6 +127,7 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					// Sender is the signer of the transaction and is automatically added as from flag by AutoCLI.
 				},
 			},
+			EnhanceCustomCommand: true,
 		},
 	}
 }
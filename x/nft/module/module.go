@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 
 	gwruntime "github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"github.com/spf13/cobra"
 	"google.golang.org/grpc"
 
 	modulev1 "cosmossdk.io/api/cosmos/nft/module/v1"
@@ -14,6 +15,7 @@ import (
 	"cosmossdk.io/depinject"
 	"cosmossdk.io/errors"
 	"cosmossdk.io/x/nft"
+	"cosmossdk.io/x/nft/client/cli"
 	"cosmossdk.io/x/nft/keeper"
 	"cosmossdk.io/x/nft/simulation"
 
@@ -48,7 +50,7 @@ func (AppModuleBasic) Name() string {
 // RegisterServices registers a gRPC query service to respond to the
 // module-specific gRPC queries.
 func (am AppModule) RegisterServices(registrar grpc.ServiceRegistrar) error {
-	nft.RegisterMsgServer(registrar, am.keeper)
+	nft.RegisterMsgServer(registrar, keeper.NewMsgServerImpl(am.keeper))
 	nft.RegisterQueryServer(registrar, am.keeper)
 	return nil
 }
@@ -84,6 +86,11 @@ func (AppModuleBasic) RegisterGRPCGatewayRoutes(clientCtx sdkclient.Context, mux
 	}
 }
 
+// GetTxCmd returns the root tx command for the nft module.
+func (AppModuleBasic) GetTxCmd() *cobra.Command {
+	return cli.GetTxCmd()
+}
+
 // AppModule implements the sdk.AppModule interface
 type AppModule struct {
 	AppModuleBasic
@@ -0,0 +1,27 @@
+package nft
+
+import (
+	"cosmossdk.io/core/address"
+	"cosmossdk.io/errors"
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Validate checks that the royalty receiver is a valid address and that the
+// royalty rate is between 0 and 1.
+func (r RoyaltyInfo) Validate(ac address.Codec) error {
+	if _, err := ac.StringToBytes(r.Receiver); err != nil {
+		return errors.Wrapf(ErrInvalidRoyalty, "invalid receiver address (%s): %s", r.Receiver, err)
+	}
+
+	if r.Rate.IsNil() || r.Rate.IsNegative() || r.Rate.GT(math.LegacyOneDec()) {
+		return errors.Wrapf(ErrInvalidRoyalty, "rate must be between 0 and 1, got %s", r.Rate)
+	}
+	return nil
+}
+
+// Amount returns the royalty paid on the given sale price, rounded down.
+func (r RoyaltyInfo) Amount(salePrice sdk.Coin) sdk.Coin {
+	return sdk.NewCoin(salePrice.Denom, r.Rate.MulInt(salePrice.Amount).TruncateInt())
+}
@@ -11,15 +11,20 @@ import (
 )
 
 // genClasses returns a slice of nft class.
-func genClasses(r *rand.Rand, accounts []simtypes.Account) []*nft.Class {
+func genClasses(r *rand.Rand, accounts []simtypes.Account, ac address.Codec) []*nft.Class {
 	classes := make([]*nft.Class, len(accounts)-1)
 	for i := 0; i < len(accounts)-1; i++ {
+		admin, err := ac.BytesToString(accounts[i].Address.Bytes())
+		if err != nil {
+			panic(err)
+		}
 		classes[i] = &nft.Class{
 			Id:          simtypes.RandStringOfLength(r, 10),
 			Name:        simtypes.RandStringOfLength(r, 10),
 			Symbol:      simtypes.RandStringOfLength(r, 10),
 			Description: simtypes.RandStringOfLength(r, 10),
 			Uri:         simtypes.RandStringOfLength(r, 10),
+			Admin:       admin,
 		}
 	}
 	return classes
@@ -53,7 +58,7 @@ func RandomizedGenState(simState *module.SimulationState, ac address.Codec) {
 	var classes []*nft.Class
 	simState.AppParams.GetOrGenerate(
 		"nft", &classes, simState.Rand,
-		func(r *rand.Rand) { classes = genClasses(r, simState.Accounts) },
+		func(r *rand.Rand) { classes = genClasses(r, simState.Accounts, ac) },
 	)
 
 	var entries []*nft.Entry
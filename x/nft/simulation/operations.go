@@ -3,6 +3,7 @@ package simulation
 import (
 	"math/rand"
 
+	"cosmossdk.io/math"
 	"cosmossdk.io/x/nft"
 	"cosmossdk.io/x/nft/keeper"
 
@@ -18,13 +19,33 @@ import (
 
 const (
 	// OpWeightMsgSend Simulation operation weights constants
-	OpWeightMsgSend = "op_weight_msg_send"
+	OpWeightMsgSend              = "op_weight_msg_send"
+	OpWeightMsgCreateClass       = "op_weight_msg_create_class"
+	OpWeightMsgMint              = "op_weight_msg_mint"
+	OpWeightMsgBurn              = "op_weight_msg_burn"
+	OpWeightMsgUpdate            = "op_weight_msg_update"
+	OpWeightMsgApprove           = "op_weight_msg_approve"
+	OpWeightMsgSetApprovalForAll = "op_weight_msg_set_approval_for_all"
 
 	// WeightSend nft operations weights
-	WeightSend = 100
+	WeightSend              = 100
+	WeightCreateClass       = 20
+	WeightMint              = 60
+	WeightBurn              = 20
+	WeightUpdate            = 20
+	WeightApprove           = 20
+	WeightSetApprovalForAll = 10
 )
 
-var TypeMsgSend = sdk.MsgTypeURL(&nft.MsgSend{})
+var (
+	TypeMsgSend              = sdk.MsgTypeURL(&nft.MsgSend{})
+	TypeMsgCreateClass       = sdk.MsgTypeURL(&nft.MsgCreateClass{})
+	TypeMsgMint              = sdk.MsgTypeURL(&nft.MsgMint{})
+	TypeMsgBurn              = sdk.MsgTypeURL(&nft.MsgBurn{})
+	TypeMsgUpdate            = sdk.MsgTypeURL(&nft.MsgUpdate{})
+	TypeMsgApprove           = sdk.MsgTypeURL(&nft.MsgApprove{})
+	TypeMsgSetApprovalForAll = sdk.MsgTypeURL(&nft.MsgSetApprovalForAll{})
+)
 
 // WeightedOperations returns all the operations from the module with their respective weights
 func WeightedOperations(
@@ -36,7 +57,15 @@ func WeightedOperations(
 	bk nft.BankKeeper,
 	k keeper.Keeper,
 ) simulation.WeightedOperations {
-	var weightMsgSend int
+	var (
+		weightMsgSend              int
+		weightMsgCreateClass       int
+		weightMsgMint              int
+		weightMsgBurn              int
+		weightMsgUpdate            int
+		weightMsgApprove           int
+		weightMsgSetApprovalForAll int
+	)
 
 	appParams.GetOrGenerate(OpWeightMsgSend, &weightMsgSend, nil,
 		func(_ *rand.Rand) {
@@ -44,10 +73,71 @@ func WeightedOperations(
 		},
 	)
 
+	appParams.GetOrGenerate(OpWeightMsgCreateClass, &weightMsgCreateClass, nil,
+		func(_ *rand.Rand) {
+			weightMsgCreateClass = WeightCreateClass
+		},
+	)
+
+	appParams.GetOrGenerate(OpWeightMsgMint, &weightMsgMint, nil,
+		func(_ *rand.Rand) {
+			weightMsgMint = WeightMint
+		},
+	)
+
+	appParams.GetOrGenerate(OpWeightMsgBurn, &weightMsgBurn, nil,
+		func(_ *rand.Rand) {
+			weightMsgBurn = WeightBurn
+		},
+	)
+
+	appParams.GetOrGenerate(OpWeightMsgUpdate, &weightMsgUpdate, nil,
+		func(_ *rand.Rand) {
+			weightMsgUpdate = WeightUpdate
+		},
+	)
+
+	appParams.GetOrGenerate(OpWeightMsgApprove, &weightMsgApprove, nil,
+		func(_ *rand.Rand) {
+			weightMsgApprove = WeightApprove
+		},
+	)
+
+	appParams.GetOrGenerate(OpWeightMsgSetApprovalForAll, &weightMsgSetApprovalForAll, nil,
+		func(_ *rand.Rand) {
+			weightMsgSetApprovalForAll = WeightSetApprovalForAll
+		},
+	)
+
+	pCdc := codec.NewProtoCodec(registry)
 	return simulation.WeightedOperations{
 		simulation.NewWeightedOperation(
 			weightMsgSend,
-			SimulateMsgSend(codec.NewProtoCodec(registry), txCfg, ak, bk, k),
+			SimulateMsgSend(pCdc, txCfg, ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgCreateClass,
+			SimulateMsgCreateClass(pCdc, txCfg, ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgMint,
+			SimulateMsgMint(pCdc, txCfg, ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgBurn,
+			SimulateMsgBurn(pCdc, txCfg, ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgUpdate,
+			SimulateMsgUpdate(pCdc, txCfg, ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgApprove,
+			SimulateMsgApprove(pCdc, txCfg, ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgSetApprovalForAll,
+			SimulateMsgSetApprovalForAll(pCdc, txCfg, ak, bk, k),
 		),
 	}
 }
@@ -127,6 +217,326 @@ func SimulateMsgSend(
 	}
 }
 
+// SimulateMsgCreateClass generates a MsgCreateClass with random values.
+func SimulateMsgCreateClass(
+	_ *codec.ProtoCodec,
+	txCfg client.TxConfig,
+	ak nft.AccountKeeper,
+	bk nft.BankKeeper,
+	k keeper.Keeper,
+) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		admin, _ := simtypes.RandomAcc(r, accs)
+		minter, _ := simtypes.RandomAcc(r, accs)
+
+		adminStr, err := ak.AddressCodec().BytesToString(admin.Address.Bytes())
+		if err != nil {
+			return simtypes.NoOpMsg(nft.ModuleName, TypeMsgCreateClass, err.Error()), nil, err
+		}
+
+		minterStr, err := ak.AddressCodec().BytesToString(minter.Address.Bytes())
+		if err != nil {
+			return simtypes.NoOpMsg(nft.ModuleName, TypeMsgCreateClass, err.Error()), nil, err
+		}
+
+		classID := simtypes.RandStringOfLength(r, 10)
+		if k.HasClass(ctx, classID) {
+			return simtypes.NoOpMsg(nft.ModuleName, TypeMsgCreateClass, "class already exists"), nil, nil
+		}
+
+		msg := &nft.MsgCreateClass{
+			Admin: adminStr,
+			Class: &nft.Class{
+				Id:               classID,
+				Name:             simtypes.RandStringOfLength(r, 10),
+				Symbol:           simtypes.RandStringOfLength(r, 10),
+				Description:      simtypes.RandStringOfLength(r, 10),
+				Uri:              simtypes.RandStringOfLength(r, 10),
+				Minters:          []string{minterStr},
+				TransferDisabled: r.Intn(5) == 0,
+			},
+		}
+
+		if r.Intn(2) == 0 {
+			msg.Class.RoyaltyInfo = &nft.RoyaltyInfo{
+				Receiver: adminStr,
+				Rate:     math.LegacyNewDecWithPrec(int64(r.Intn(11)), 2),
+			}
+		}
+
+		return deliverTx(r, app, ctx, txCfg, ak, bk, admin, msg, chainID)
+	}
+}
+
+// SimulateMsgMint generates a MsgMint with random values.
+func SimulateMsgMint(
+	_ *codec.ProtoCodec,
+	txCfg client.TxConfig,
+	ak nft.AccountKeeper,
+	bk nft.BankKeeper,
+	k keeper.Keeper,
+) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		class, admin, found := randAdministeredClass(ctx, r, ak, k, accs)
+		if !found {
+			return simtypes.NoOpMsg(nft.ModuleName, TypeMsgMint, "no class administered by an account"), nil, nil
+		}
+
+		receiver, _ := simtypes.RandomAcc(r, accs)
+		receiverStr, err := ak.AddressCodec().BytesToString(receiver.Address.Bytes())
+		if err != nil {
+			return simtypes.NoOpMsg(nft.ModuleName, TypeMsgMint, err.Error()), nil, err
+		}
+
+		id := simtypes.RandStringOfLength(r, 10)
+		if k.HasNFT(ctx, class.Id, id) {
+			return simtypes.NoOpMsg(nft.ModuleName, TypeMsgMint, "nft already exists"), nil, nil
+		}
+
+		msg := &nft.MsgMint{
+			Minter: class.Admin,
+			Nft: &nft.NFT{
+				ClassId: class.Id,
+				Id:      id,
+				Uri:     simtypes.RandStringOfLength(r, 10),
+			},
+			Receiver: receiverStr,
+		}
+
+		return deliverTx(r, app, ctx, txCfg, ak, bk, admin, msg, chainID)
+	}
+}
+
+// SimulateMsgBurn generates a MsgBurn with random values.
+func SimulateMsgBurn(
+	_ *codec.ProtoCodec,
+	txCfg client.TxConfig,
+	ak nft.AccountKeeper,
+	bk nft.BankKeeper,
+	k keeper.Keeper,
+) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		owner, _ := simtypes.RandomAcc(r, accs)
+
+		n, err := randNFT(ctx, r, k, owner.Address)
+		if err != nil {
+			return simtypes.NoOpMsg(nft.ModuleName, TypeMsgBurn, err.Error()), nil, err
+		}
+
+		ownerStr, err := ak.AddressCodec().BytesToString(owner.Address.Bytes())
+		if err != nil {
+			return simtypes.NoOpMsg(nft.ModuleName, TypeMsgBurn, err.Error()), nil, err
+		}
+
+		msg := &nft.MsgBurn{
+			Sender:  ownerStr,
+			ClassId: n.ClassId,
+			Id:      n.Id,
+		}
+
+		return deliverTx(r, app, ctx, txCfg, ak, bk, owner, msg, chainID)
+	}
+}
+
+// SimulateMsgUpdate generates a MsgUpdate with random values.
+func SimulateMsgUpdate(
+	_ *codec.ProtoCodec,
+	txCfg client.TxConfig,
+	ak nft.AccountKeeper,
+	bk nft.BankKeeper,
+	k keeper.Keeper,
+) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		class, admin, found := randAdministeredClass(ctx, r, ak, k, accs)
+		if !found {
+			return simtypes.NoOpMsg(nft.ModuleName, TypeMsgUpdate, "no class administered by an account"), nil, nil
+		}
+
+		ns := k.GetNFTsOfClass(ctx, class.Id)
+		if len(ns) == 0 {
+			return simtypes.NoOpMsg(nft.ModuleName, TypeMsgUpdate, "no nft in class"), nil, nil
+		}
+
+		n := ns[r.Intn(len(ns))]
+		n.Uri = simtypes.RandStringOfLength(r, 10)
+
+		msg := &nft.MsgUpdate{
+			Sender: class.Admin,
+			Nft:    &n,
+		}
+
+		return deliverTx(r, app, ctx, txCfg, ak, bk, admin, msg, chainID)
+	}
+}
+
+// SimulateMsgApprove generates a MsgApprove with random values.
+func SimulateMsgApprove(
+	_ *codec.ProtoCodec,
+	txCfg client.TxConfig,
+	ak nft.AccountKeeper,
+	bk nft.BankKeeper,
+	k keeper.Keeper,
+) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		owner, _ := simtypes.RandomAcc(r, accs)
+		spender, _ := simtypes.RandomAcc(r, accs)
+
+		if owner.Address.Equals(spender.Address) {
+			return simtypes.NoOpMsg(nft.ModuleName, TypeMsgApprove, "owner and spender are the same"), nil, nil
+		}
+
+		n, err := randNFT(ctx, r, k, owner.Address)
+		if err != nil {
+			return simtypes.NoOpMsg(nft.ModuleName, TypeMsgApprove, err.Error()), nil, err
+		}
+
+		ownerStr, err := ak.AddressCodec().BytesToString(owner.Address.Bytes())
+		if err != nil {
+			return simtypes.NoOpMsg(nft.ModuleName, TypeMsgApprove, err.Error()), nil, err
+		}
+
+		spenderStr, err := ak.AddressCodec().BytesToString(spender.Address.Bytes())
+		if err != nil {
+			return simtypes.NoOpMsg(nft.ModuleName, TypeMsgApprove, err.Error()), nil, err
+		}
+
+		msg := &nft.MsgApprove{
+			Sender:  ownerStr,
+			ClassId: n.ClassId,
+			Id:      n.Id,
+			Spender: spenderStr,
+		}
+
+		return deliverTx(r, app, ctx, txCfg, ak, bk, owner, msg, chainID)
+	}
+}
+
+// SimulateMsgSetApprovalForAll generates a MsgSetApprovalForAll with random values.
+func SimulateMsgSetApprovalForAll(
+	_ *codec.ProtoCodec,
+	txCfg client.TxConfig,
+	ak nft.AccountKeeper,
+	bk nft.BankKeeper,
+	k keeper.Keeper,
+) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		owner, _ := simtypes.RandomAcc(r, accs)
+		operator, _ := simtypes.RandomAcc(r, accs)
+
+		if owner.Address.Equals(operator.Address) {
+			return simtypes.NoOpMsg(nft.ModuleName, TypeMsgSetApprovalForAll, "owner and operator are the same"), nil, nil
+		}
+
+		c, err := randClass(ctx, r, k)
+		if err != nil {
+			return simtypes.NoOpMsg(nft.ModuleName, TypeMsgSetApprovalForAll, err.Error()), nil, err
+		}
+
+		ownerStr, err := ak.AddressCodec().BytesToString(owner.Address.Bytes())
+		if err != nil {
+			return simtypes.NoOpMsg(nft.ModuleName, TypeMsgSetApprovalForAll, err.Error()), nil, err
+		}
+
+		operatorStr, err := ak.AddressCodec().BytesToString(operator.Address.Bytes())
+		if err != nil {
+			return simtypes.NoOpMsg(nft.ModuleName, TypeMsgSetApprovalForAll, err.Error()), nil, err
+		}
+
+		msg := &nft.MsgSetApprovalForAll{
+			Owner:    ownerStr,
+			ClassId:  c.Id,
+			Operator: operatorStr,
+			Approved: r.Intn(2) == 0,
+		}
+
+		return deliverTx(r, app, ctx, txCfg, ak, bk, owner, msg, chainID)
+	}
+}
+
+// deliverTx delivers a tx with the message signed by the account paying random fees.
+func deliverTx(
+	r *rand.Rand,
+	app *baseapp.BaseApp,
+	ctx sdk.Context,
+	txCfg client.TxConfig,
+	ak nft.AccountKeeper,
+	bk nft.BankKeeper,
+	signer simtypes.Account,
+	msg sdk.Msg,
+	chainID string,
+) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+	msgType := sdk.MsgTypeURL(msg)
+	signerAcc := ak.GetAccount(ctx, signer.Address)
+	spendableCoins := bk.SpendableCoins(ctx, signer.Address)
+	fees, err := simtypes.RandomFees(r, ctx, spendableCoins)
+	if err != nil {
+		return simtypes.NoOpMsg(nft.ModuleName, msgType, err.Error()), nil, err
+	}
+
+	tx, err := simtestutil.GenSignedMockTx(
+		r,
+		txCfg,
+		[]sdk.Msg{msg},
+		fees,
+		simtestutil.DefaultGenTxGas,
+		chainID,
+		[]uint64{signerAcc.GetAccountNumber()},
+		[]uint64{signerAcc.GetSequence()},
+		signer.PrivKey,
+	)
+	if err != nil {
+		return simtypes.NoOpMsg(nft.ModuleName, msgType, "unable to generate mock tx"), nil, err
+	}
+
+	if _, _, err = app.SimDeliver(txCfg.TxEncoder(), tx); err != nil {
+		return simtypes.NoOpMsg(nft.ModuleName, msgType, "unable to deliver tx"), nil, err
+	}
+
+	return simtypes.NewOperationMsg(msg, true, ""), nil, nil
+}
+
+// randAdministeredClass picks a random Class administered by one of the accounts.
+func randAdministeredClass(ctx sdk.Context, r *rand.Rand, ak nft.AccountKeeper, k keeper.Keeper, accs []simtypes.Account) (nft.Class, simtypes.Account, bool) {
+	var (
+		classes []nft.Class
+		admins  []simtypes.Account
+	)
+	for _, class := range k.GetClasses(ctx) {
+		if len(class.Admin) == 0 {
+			continue
+		}
+
+		addr, err := ak.AddressCodec().StringToBytes(class.Admin)
+		if err != nil {
+			continue
+		}
+
+		if admin, found := simtypes.FindAccount(accs, sdk.AccAddress(addr)); found {
+			classes = append(classes, *class)
+			admins = append(admins, admin)
+		}
+	}
+
+	if len(classes) == 0 {
+		return nft.Class{}, simtypes.Account{}, false
+	}
+
+	i := r.Intn(len(classes))
+	return classes[i], admins[i], true
+}
+
 // randNFT picks a random NFT from a class belonging to the specified owner(minter).
 func randNFT(ctx sdk.Context, r *rand.Rand, k keeper.Keeper, minter sdk.AccAddress) (nft.NFT, error) {
 	c, err := randClass(ctx, r, k)
@@ -150,9 +560,14 @@ func randNFT(ctx sdk.Context, r *rand.Rand, k keeper.Keeper, minter sdk.AccAddre
 	return n, nil
 }
 
-// randClass picks a random Class.
+// randClass picks a random Class whose NFTs can be transferred.
 func randClass(ctx sdk.Context, r *rand.Rand, k keeper.Keeper) (nft.Class, error) {
-	classes := k.GetClasses(ctx)
+	var classes []*nft.Class
+	for _, class := range k.GetClasses(ctx) {
+		if !class.TransferDisabled {
+			classes = append(classes, class)
+		}
+	}
 	if len(classes) == 0 {
 		c := nft.Class{
 			Id:          simtypes.RandStringOfLength(r, 10),
@@ -85,6 +85,12 @@ func (suite *SimTestSuite) TestWeightedOperations() {
 		opMsgName  string
 	}{
 		{simulation.WeightSend, nft.ModuleName, simulation.TypeMsgSend},
+		{simulation.WeightCreateClass, nft.ModuleName, simulation.TypeMsgCreateClass},
+		{simulation.WeightMint, nft.ModuleName, simulation.TypeMsgMint},
+		{simulation.WeightBurn, nft.ModuleName, simulation.TypeMsgBurn},
+		{simulation.WeightUpdate, nft.ModuleName, simulation.TypeMsgUpdate},
+		{simulation.WeightApprove, nft.ModuleName, simulation.TypeMsgApprove},
+		{simulation.WeightSetApprovalForAll, nft.ModuleName, simulation.TypeMsgSetApprovalForAll},
 	}
 
 	for i, w := range weightedOps {
@@ -141,6 +147,56 @@ func (suite *SimTestSuite) TestSimulateMsgSend() {
 	suite.Require().Len(futureOperations, 0)
 }
 
+func (suite *SimTestSuite) TestSimulateMsgCreateClass() {
+	s := rand.NewSource(1)
+	r := rand.New(s)
+	accounts := suite.getTestingAccounts(r, 2)
+
+	// begin new block
+	suite.app.FinalizeBlock(&abci.RequestFinalizeBlock{
+		Height: suite.app.LastBlockHeight() + 1,
+		Hash:   suite.app.LastCommitID().Hash,
+	})
+
+	// execute operation
+	registry := suite.interfaceRegistry
+	op := simulation.SimulateMsgCreateClass(codec.NewProtoCodec(registry), suite.txConfig, suite.accountKeeper, suite.bankKeeper, suite.nftKeeper)
+	operationMsg, futureOperations, err := op(r, suite.app.BaseApp, suite.ctx, accounts, "")
+	suite.Require().NoError(err)
+
+	suite.Require().True(operationMsg.OK)
+	suite.Require().Len(futureOperations, 0)
+	suite.Require().Equal(simulation.TypeMsgCreateClass, operationMsg.Name)
+	suite.Require().Len(suite.nftKeeper.GetClasses(suite.ctx), 1)
+}
+
+func (suite *SimTestSuite) TestSimulateMsgMint() {
+	s := rand.NewSource(1)
+	r := rand.New(s)
+	accounts := suite.getTestingAccounts(r, 2)
+
+	admin, err := suite.accountKeeper.AddressCodec().BytesToString(accounts[0].Address)
+	suite.Require().NoError(err)
+	suite.Require().NoError(suite.nftKeeper.SaveClass(suite.ctx, nft.Class{Id: "kitty", Admin: admin}))
+
+	// begin new block
+	suite.app.FinalizeBlock(&abci.RequestFinalizeBlock{
+		Height: suite.app.LastBlockHeight() + 1,
+		Hash:   suite.app.LastCommitID().Hash,
+	})
+
+	// execute operation
+	registry := suite.interfaceRegistry
+	op := simulation.SimulateMsgMint(codec.NewProtoCodec(registry), suite.txConfig, suite.accountKeeper, suite.bankKeeper, suite.nftKeeper)
+	operationMsg, futureOperations, err := op(r, suite.app.BaseApp, suite.ctx, accounts, "")
+	suite.Require().NoError(err)
+
+	suite.Require().True(operationMsg.OK)
+	suite.Require().Len(futureOperations, 0)
+	suite.Require().Equal(simulation.TypeMsgMint, operationMsg.Name)
+	suite.Require().Equal(uint64(1), suite.nftKeeper.GetTotalSupply(suite.ctx, "kitty"))
+}
+
 func TestSimTestSuite(t *testing.T) {
 	suite.Run(t, new(SimTestSuite))
 }
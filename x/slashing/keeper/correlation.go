@@ -0,0 +1,133 @@
+package keeper
+
+import (
+	"context"
+
+	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/slashing/types"
+)
+
+// SetRecentSlash records the slash of a validator within the correlation
+// window.
+func (k Keeper) SetRecentSlash(ctx context.Context, slash types.RecentSlash) error {
+	consAddr, err := k.sk.ConsensusAddressCodec().StringToBytes(slash.Address)
+	if err != nil {
+		return err
+	}
+
+	bz, err := k.cdc.Marshal(&slash)
+	if err != nil {
+		return err
+	}
+
+	store := k.storeService.OpenKVStore(ctx)
+	return store.Set(types.RecentSlashKey(slash.Height, consAddr), bz)
+}
+
+// IterateRecentSlashes iterates over the slashes recorded within the
+// correlation window, in the order of their height.
+func (k Keeper) IterateRecentSlashes(ctx context.Context, cb func(slash types.RecentSlash) (stop bool)) error {
+	store := k.storeService.OpenKVStore(ctx)
+	iter, err := store.Iterator(types.RecentSlashKeyPrefix, storetypes.PrefixEndBytes(types.RecentSlashKeyPrefix))
+	if err != nil {
+		return err
+	}
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var slash types.RecentSlash
+		if err := k.cdc.Unmarshal(iter.Value(), &slash); err != nil {
+			return err
+		}
+
+		if cb(slash) {
+			break
+		}
+	}
+
+	return nil
+}
+
+// correlatedSlashFraction records the slash of a validator and returns the
+// fraction to slash it by. When the correlation penalty is enabled, the
+// fraction is raised to the correlation penalty, which is the power slashed
+// within the correlation window, including this slash, over the total power,
+// times the correlation penalty multiplier. The penalty is capped to one.
+func (k Keeper) correlatedSlashFraction(ctx context.Context, consAddr sdk.ConsAddress, fraction sdkmath.LegacyDec, power int64) (sdkmath.LegacyDec, error) {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return sdkmath.LegacyDec{}, err
+	}
+
+	if params.CorrelationWindow == 0 {
+		return fraction, nil
+	}
+
+	height := sdk.UnwrapSDKContext(ctx).BlockHeight()
+	if err := k.pruneRecentSlashes(ctx, height-params.CorrelationWindow); err != nil {
+		return sdkmath.LegacyDec{}, err
+	}
+
+	err = k.SetRecentSlash(ctx, types.RecentSlash{Address: consAddr.String(), Height: height, Power: power})
+	if err != nil {
+		return sdkmath.LegacyDec{}, err
+	}
+
+	slashedPower := sdkmath.ZeroInt()
+	err = k.IterateRecentSlashes(ctx, func(slash types.RecentSlash) bool {
+		slashedPower = slashedPower.AddRaw(slash.Power)
+		return false
+	})
+	if err != nil {
+		return sdkmath.LegacyDec{}, err
+	}
+
+	totalPower, err := k.sk.GetLastTotalPower(ctx)
+	if err != nil {
+		return sdkmath.LegacyDec{}, err
+	}
+
+	if !totalPower.IsPositive() {
+		return fraction, nil
+	}
+
+	penalty := sdkmath.LegacyNewDecFromInt(slashedPower.Mul(sdkmath.NewIntFromUint64(params.CorrelationPenaltyMultiplier))).QuoInt(totalPower)
+	if penalty.GT(sdkmath.LegacyOneDec()) {
+		penalty = sdkmath.LegacyOneDec()
+	}
+
+	return sdkmath.LegacyMaxDec(fraction, penalty), nil
+}
+
+// pruneRecentSlashes deletes the slashes recorded up to, and including, the
+// given height.
+func (k Keeper) pruneRecentSlashes(ctx context.Context, height int64) error {
+	if height < 0 {
+		return nil
+	}
+
+	store := k.storeService.OpenKVStore(ctx)
+	iter, err := store.Iterator(types.RecentSlashKeyPrefix, types.RecentSlashesPrefixKey(height+1))
+	if err != nil {
+		return err
+	}
+
+	var keys [][]byte
+	for ; iter.Valid(); iter.Next() {
+		keys = append(keys, iter.Key())
+	}
+	if err := iter.Close(); err != nil {
+		return err
+	}
+
+	for _, key := range keys {
+		if err := store.Delete(key); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,83 @@
+package keeper_test
+
+import (
+	"github.com/golang/mock/gomock"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func (s *KeeperTestSuite) TestCorrelatedSlash() {
+	ctx, keeper := s.ctx.WithBlockHeight(100), s.slashingKeeper
+	require := s.Require()
+
+	params, err := keeper.GetParams(ctx)
+	require.NoError(err)
+	params.CorrelationWindow = 10
+	params.CorrelationPenaltyMultiplier = 3
+	params.SlashFractionDoubleSign = sdkmath.LegacyNewDecWithPrec(5, 2)
+	require.NoError(keeper.SetParams(ctx, params))
+
+	otherConsAddr := sdk.ConsAddress([]byte("addr2_______________"))
+	s.stakingKeeper.EXPECT().GetLastTotalPower(gomock.Any()).Return(sdkmath.NewInt(1000), nil).AnyTimes()
+
+	// a single slash of 1% of the total power is penalized by 3%, below the
+	// double sign slash fraction
+	s.stakingKeeper.EXPECT().SlashWithInfractionReason(gomock.Any(), otherConsAddr, int64(90), int64(10),
+		params.SlashFractionDoubleSign, stakingtypes.Infraction_INFRACTION_DOUBLE_SIGN,
+	).Return(sdkmath.NewInt(0), nil)
+	require.NoError(keeper.SlashWithInfractionReason(ctx, otherConsAddr, params.SlashFractionDoubleSign, 10, 90, stakingtypes.Infraction_INFRACTION_DOUBLE_SIGN))
+
+	// a correlated slash within the window is penalized by the power slashed
+	// within the window: 3 * (10 + 40) / 1000
+	ctx = ctx.WithBlockHeight(105)
+	s.stakingKeeper.EXPECT().SlashWithInfractionReason(gomock.Any(), consAddr, int64(95), int64(40),
+		sdkmath.LegacyNewDecWithPrec(15, 2), stakingtypes.Infraction_INFRACTION_DOUBLE_SIGN,
+	).Return(sdkmath.NewInt(0), nil)
+	require.NoError(keeper.SlashWithInfractionReason(ctx, consAddr, params.SlashFractionDoubleSign, 40, 95, stakingtypes.Infraction_INFRACTION_DOUBLE_SIGN))
+
+	var slashes []slashingtypes.RecentSlash
+	require.NoError(keeper.IterateRecentSlashes(ctx, func(slash slashingtypes.RecentSlash) bool {
+		slashes = append(slashes, slash)
+		return false
+	}))
+	require.Len(slashes, 2)
+
+	// downtime slashes are neither penalized nor recorded
+	s.stakingKeeper.EXPECT().SlashWithInfractionReason(gomock.Any(), otherConsAddr, int64(95), int64(10),
+		params.SlashFractionDowntime, stakingtypes.Infraction_INFRACTION_DOWNTIME,
+	).Return(sdkmath.NewInt(0), nil)
+	require.NoError(keeper.SlashWithInfractionReason(ctx, otherConsAddr, params.SlashFractionDowntime, 10, 95, stakingtypes.Infraction_INFRACTION_DOWNTIME))
+
+	slashes = nil
+	require.NoError(keeper.IterateRecentSlashes(ctx, func(slash slashingtypes.RecentSlash) bool {
+		slashes = append(slashes, slash)
+		return false
+	}))
+	require.Len(slashes, 2)
+
+	// the slashes out of the window are pruned: 3 * (40 + 10) / 1000
+	ctx = ctx.WithBlockHeight(112)
+	s.stakingKeeper.EXPECT().SlashWithInfractionReason(gomock.Any(), otherConsAddr, int64(112), int64(10),
+		sdkmath.LegacyNewDecWithPrec(15, 2), stakingtypes.Infraction_INFRACTION_DOUBLE_SIGN,
+	).Return(sdkmath.NewInt(0), nil)
+	require.NoError(keeper.SlashWithInfractionReason(ctx, otherConsAddr, params.SlashFractionDoubleSign, 10, 112, stakingtypes.Infraction_INFRACTION_DOUBLE_SIGN))
+
+	slashes = nil
+	require.NoError(keeper.IterateRecentSlashes(ctx, func(slash slashingtypes.RecentSlash) bool {
+		slashes = append(slashes, slash)
+		return false
+	}))
+	require.Len(slashes, 2)
+	require.Equal(int64(105), slashes[0].Height)
+
+	// the penalty is capped to slashing the whole stake
+	ctx = ctx.WithBlockHeight(113)
+	s.stakingKeeper.EXPECT().SlashWithInfractionReason(gomock.Any(), consAddr, int64(113), int64(400),
+		sdkmath.LegacyOneDec(), stakingtypes.Infraction_INFRACTION_DOUBLE_SIGN,
+	).Return(sdkmath.NewInt(0), nil)
+	require.NoError(keeper.SlashWithInfractionReason(ctx, consAddr, params.SlashFractionDoubleSign, 400, 113, stakingtypes.Infraction_INFRACTION_DOUBLE_SIGN))
+}
@@ -42,6 +42,12 @@ func (keeper Keeper) InitGenesis(ctx sdk.Context, stakingKeeper types.StakingKee
 		}
 	}
 
+	for _, slash := range data.RecentSlashes {
+		if err := keeper.SetRecentSlash(ctx, slash); err != nil {
+			panic(err)
+		}
+	}
+
 	if err := keeper.SetParams(ctx, data.Params); err != nil {
 		panic(err)
 	}
@@ -77,5 +83,16 @@ func (keeper Keeper) ExportGenesis(ctx sdk.Context) (data *types.GenesisState) {
 		return false
 	})
 
-	return types.NewGenesisState(params, signingInfos, missedBlocks)
+	recentSlashes := make([]types.RecentSlash, 0)
+	err = keeper.IterateRecentSlashes(ctx, func(slash types.RecentSlash) (stop bool) {
+		recentSlashes = append(recentSlashes, slash)
+		return false
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	genesis := types.NewGenesisState(params, signingInfos, missedBlocks)
+	genesis.RecentSlashes = recentSlashes
+	return genesis
 }
@@ -8,6 +8,7 @@ import (
 
 	sdkmath "cosmossdk.io/math"
 
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/slashing/types"
 )
@@ -96,3 +97,22 @@ func (h Hooks) BeforeValidatorSlashed(_ context.Context, _ sdk.ValAddress, _ sdk
 func (h Hooks) AfterUnbondingInitiated(_ context.Context, _ uint64) error {
 	return nil
 }
+
+// AfterConsensusPubKeyUpdate moves the signing info of a validator to its new
+// consensus address, and replaces the address-pubkey relation of the old key
+// with the one of the new key.
+func (h Hooks) AfterConsensusPubKeyUpdate(ctx context.Context, oldPubKey, newPubKey cryptotypes.PubKey, _ sdk.Coin) error {
+	if err := h.k.AddPubkey(ctx, newPubKey); err != nil {
+		return err
+	}
+
+	// a validator which was never bonded has no signing info
+	oldConsAddr := sdk.ConsAddress(oldPubKey.Address())
+	if h.k.HasValidatorSigningInfo(ctx, oldConsAddr) {
+		if err := h.k.migrateValidatorSigningInfo(ctx, oldConsAddr, sdk.ConsAddress(newPubKey.Address())); err != nil {
+			return err
+		}
+	}
+
+	return h.k.deleteAddrPubkeyRelation(ctx, oldPubKey.Address())
+}
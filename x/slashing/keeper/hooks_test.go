@@ -41,3 +41,41 @@ func (s *KeeperTestSuite) TestAfterValidatorCreatedOrRemoved() {
 	_, err = keeper.GetPubkey(ctx, addr.Bytes())
 	require.Error(err)
 }
+
+func (s *KeeperTestSuite) TestAfterConsensusPubKeyUpdate() {
+	ctx, keeper := s.ctx, s.slashingKeeper
+	require := s.Require()
+
+	_, oldPubKey, oldAddr := testdata.KeyTestPubAddr()
+	_, newPubKey, newAddr := testdata.KeyTestPubAddr()
+	oldConsAddr, newConsAddr := sdk.ConsAddress(oldAddr), sdk.ConsAddress(newAddr)
+
+	require.NoError(keeper.AddPubkey(ctx, oldPubKey))
+	require.NoError(keeper.Hooks().AfterValidatorBonded(ctx, oldConsAddr, sdk.ValAddress(oldAddr)))
+	require.NoError(keeper.SetMissedBlockBitmapValue(ctx, oldConsAddr, 1, true))
+
+	err := keeper.Hooks().AfterConsensusPubKeyUpdate(ctx, oldPubKey, newPubKey, sdk.Coin{})
+	require.NoError(err)
+
+	// the signing info and the missed blocks are moved to the new address
+	require.False(keeper.HasValidatorSigningInfo(ctx, oldConsAddr))
+	info, err := keeper.GetValidatorSigningInfo(ctx, newConsAddr)
+	require.NoError(err)
+	require.Equal(newConsAddr.String(), info.Address)
+
+	missed, err := keeper.GetMissedBlockBitmapValue(ctx, newConsAddr, 1)
+	require.NoError(err)
+	require.True(missed)
+
+	missed, err = keeper.GetMissedBlockBitmapValue(ctx, oldConsAddr, 1)
+	require.NoError(err)
+	require.False(missed)
+
+	// the address-pubkey relation of the old key is replaced
+	_, err = keeper.GetPubkey(ctx, oldAddr.Bytes())
+	require.Error(err)
+
+	ePubKey, err := keeper.GetPubkey(ctx, newAddr.Bytes())
+	require.NoError(err)
+	require.Equal(newPubKey, ePubKey)
+}
@@ -20,8 +20,12 @@ func (k Keeper) HandleValidatorSignature(ctx context.Context, addr cryptotypes.A
 	logger := k.Logger(ctx)
 	height := sdkCtx.BlockHeight()
 
-	// fetch the validator public key
-	consAddr := sdk.ConsAddress(addr)
+	// fetch the validator public key, the signatures made with a rotated key
+	// are accounted to the current consensus address of the validator
+	consAddr, err := k.sk.ValidatorIdentifier(ctx, sdk.ConsAddress(addr))
+	if err != nil {
+		return err
+	}
 
 	// don't update missed blocks when validator's jailed
 	isJailed, err := k.sk.IsValidatorJailed(ctx, consAddr)
@@ -141,6 +145,7 @@ func (k Keeper) HandleValidatorSignature(ctx context.Context, addr cryptotypes.A
 					sdk.NewAttribute(types.AttributeKeyPower, fmt.Sprintf("%d", power)),
 					sdk.NewAttribute(types.AttributeKeyReason, types.AttributeValueMissingSignature),
 					sdk.NewAttribute(types.AttributeKeyJailed, consAddr.String()),
+					sdk.NewAttribute(types.AttributeKeySlashFraction, slashFractionDowntime.String()),
 					sdk.NewAttribute(types.AttributeKeyBurnedCoins, coinsBurned.String()),
 				),
 			)
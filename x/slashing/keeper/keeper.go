@@ -82,7 +82,19 @@ func (k Keeper) Slash(ctx context.Context, consAddr sdk.ConsAddress, fraction sd
 
 // SlashWithInfractionReason attempts to slash a validator. The slash is delegated to the staking
 // module to make the necessary validator changes. It specifies an intraction reason.
+//
+// When the correlation penalty is enabled, a validator slashed for double signing is slashed by the
+// greater of the given fraction and the correlation penalty of the power slashed for double signing
+// within the correlation window.
 func (k Keeper) SlashWithInfractionReason(ctx context.Context, consAddr sdk.ConsAddress, fraction sdkmath.LegacyDec, power, distributionHeight int64, infraction stakingtypes.Infraction) error {
+	if infraction == stakingtypes.Infraction_INFRACTION_DOUBLE_SIGN {
+		var err error
+		fraction, err = k.correlatedSlashFraction(ctx, consAddr, fraction, power)
+		if err != nil {
+			return err
+		}
+	}
+
 	coinsBurned, err := k.sk.SlashWithInfractionReason(ctx, consAddr, distributionHeight, power, fraction, infraction)
 	if err != nil {
 		return err
@@ -103,6 +115,7 @@ func (k Keeper) SlashWithInfractionReason(ctx context.Context, consAddr sdk.Cons
 			sdk.NewAttribute(types.AttributeKeyAddress, consAddr.String()),
 			sdk.NewAttribute(types.AttributeKeyPower, fmt.Sprintf("%d", power)),
 			reasonAttr,
+			sdk.NewAttribute(types.AttributeKeySlashFraction, fraction.String()),
 			sdk.NewAttribute(types.AttributeKeyBurnedCoins, coinsBurned.String()),
 		),
 	)
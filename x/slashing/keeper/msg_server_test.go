@@ -115,6 +115,39 @@ func (s *KeeperTestSuite) TestUpdateParams() {
 			expectErr: true,
 			expErrMsg: "downtime slash fraction cannot be negative",
 		},
+		{
+			name: "set invalid correlation penalty multiplier",
+			request: &slashingtypes.MsgUpdateParams{
+				Authority: s.slashingKeeper.GetAuthority(),
+				Params: slashingtypes.Params{
+					SignedBlocksWindow:           int64(750),
+					MinSignedPerWindow:           minSignedPerWindow,
+					DowntimeJailDuration:         time.Duration(10),
+					SlashFractionDoubleSign:      slashFractionDoubleSign,
+					SlashFractionDowntime:        slashFractionDowntime,
+					CorrelationWindow:            int64(100),
+					CorrelationPenaltyMultiplier: 0,
+				},
+			},
+			expectErr: true,
+			expErrMsg: "correlation penalty multiplier must be positive",
+		},
+		{
+			name: "set valid correlation params",
+			request: &slashingtypes.MsgUpdateParams{
+				Authority: s.slashingKeeper.GetAuthority(),
+				Params: slashingtypes.Params{
+					SignedBlocksWindow:           int64(750),
+					MinSignedPerWindow:           minSignedPerWindow,
+					DowntimeJailDuration:         time.Duration(34800000000000),
+					SlashFractionDoubleSign:      slashFractionDoubleSign,
+					SlashFractionDowntime:        slashFractionDowntime,
+					CorrelationWindow:            int64(100),
+					CorrelationPenaltyMultiplier: 3,
+				},
+			},
+			expectErr: false,
+		},
 		{
 			name: "set full valid params",
 			request: &slashingtypes.MsgUpdateParams{
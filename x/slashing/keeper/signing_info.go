@@ -50,6 +50,55 @@ func (k Keeper) SetValidatorSigningInfo(ctx context.Context, address sdk.ConsAdd
 	return store.Set(types.ValidatorSigningInfoKey(address), bz)
 }
 
+// migrateValidatorSigningInfo moves the signing info and the missed block
+// bitmap of a validator from its old consensus address to its new one.
+func (k Keeper) migrateValidatorSigningInfo(ctx context.Context, oldConsAddr, newConsAddr sdk.ConsAddress) error {
+	info, err := k.GetValidatorSigningInfo(ctx, oldConsAddr)
+	if err != nil {
+		return err
+	}
+
+	info.Address = newConsAddr.String()
+	if err := k.SetValidatorSigningInfo(ctx, newConsAddr, info); err != nil {
+		return err
+	}
+
+	store := k.storeService.OpenKVStore(ctx)
+	if err := store.Delete(types.ValidatorSigningInfoKey(oldConsAddr)); err != nil {
+		return err
+	}
+
+	oldPrefix := types.ValidatorMissedBlockBitmapPrefixKey(oldConsAddr)
+	newPrefix := types.ValidatorMissedBlockBitmapPrefixKey(newConsAddr)
+	iter, err := store.Iterator(oldPrefix, storetypes.PrefixEndBytes(oldPrefix))
+	if err != nil {
+		return err
+	}
+
+	var keys, chunks [][]byte
+	for ; iter.Valid(); iter.Next() {
+		keys = append(keys, iter.Key())
+		chunks = append(chunks, iter.Value())
+	}
+
+	if err := iter.Close(); err != nil {
+		return err
+	}
+
+	for i, key := range keys {
+		newKey := append(append([]byte{}, newPrefix...), key[len(oldPrefix):]...)
+		if err := store.Set(newKey, chunks[i]); err != nil {
+			return err
+		}
+
+		if err := store.Delete(key); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 // IterateValidatorSigningInfos iterates over the stored ValidatorSigningInfo
 func (k Keeper) IterateValidatorSigningInfos(ctx context.Context,
 	handler func(address sdk.ConsAddress, info types.ValidatorSigningInfo) (stop bool),
@@ -37,6 +37,12 @@ func NewDecodeStore(cdc codec.BinaryCodec) func(kvA, kvB kv.Pair) string {
 			}
 			return fmt.Sprintf("PubKeyA: %s\nPubKeyB: %s", pubKeyA, pubKeyB)
 
+		case bytes.Equal(kvA.Key[:1], types.RecentSlashKeyPrefix):
+			var slashA, slashB types.RecentSlash
+			cdc.MustUnmarshal(kvA.Value, &slashA)
+			cdc.MustUnmarshal(kvB.Value, &slashB)
+			return fmt.Sprintf("%v\n%v", slashA, slashB)
+
 		default:
 			panic(fmt.Sprintf("invalid slashing key prefix %X", kvA.Key[:1]))
 		}
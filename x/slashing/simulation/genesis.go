@@ -67,6 +67,7 @@ func RandomizedGenState(simState *module.SimulationState) {
 	params := types.NewParams(
 		signedBlocksWindow, minSignedPerWindow, downtimeJailDuration,
 		slashFractionDoubleSign, slashFractionDowntime,
+		types.DefaultCorrelationWindow, types.DefaultCorrelationPenaltyMultiplier,
 	)
 
 	slashingGenesis := types.NewGenesisState(params, []types.SigningInfo{}, []types.ValidatorMissedBlocks{})
@@ -29,9 +29,10 @@ func NewMissedBlock(index int64, missed bool) MissedBlock {
 // DefaultGenesisState - default GenesisState used by Cosmos Hub
 func DefaultGenesisState() *GenesisState {
 	return &GenesisState{
-		Params:       DefaultParams(),
-		SigningInfos: []SigningInfo{},
-		MissedBlocks: []ValidatorMissedBlocks{},
+		Params:        DefaultParams(),
+		SigningInfos:  []SigningInfo{},
+		MissedBlocks:  []ValidatorMissedBlocks{},
+		RecentSlashes: []RecentSlash{},
 	}
 }
 
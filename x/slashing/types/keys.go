@@ -46,12 +46,15 @@ const (
 // - 0x02<consAddrLen (1 Byte)><consAddress_Bytes><chunk_index>: bitmap_chunk
 //
 // - 0x03<accAddrLen (1 Byte)><accAddr_Bytes>: cryptotypes.PubKey
+//
+// - 0x04<height (8 Bytes)><consAddrLen (1 Byte)><consAddress_Bytes>: RecentSlash
 
 var (
 	ParamsKey                           = []byte{0x00} // Prefix for params key
 	ValidatorSigningInfoKeyPrefix       = []byte{0x01} // Prefix for signing info
 	ValidatorMissedBlockBitmapKeyPrefix = []byte{0x02} // Prefix for missed block bitmap
 	AddrPubkeyRelationKeyPrefix         = []byte{0x03} // Prefix for address-pubkey relation
+	RecentSlashKeyPrefix                = []byte{0x04} // Prefix for the slashes within the correlation window
 )
 
 // ValidatorSigningInfoKey - stored by *Consensus* address (not operator address)
@@ -87,3 +90,14 @@ func ValidatorMissedBlockBitmapKey(v sdk.ConsAddress, chunkIndex int64) []byte {
 func AddrPubkeyRelationKey(addr []byte) []byte {
 	return append(AddrPubkeyRelationKeyPrefix, address.MustLengthPrefix(addr)...)
 }
+
+// RecentSlashesPrefixKey returns the key prefix of the slashes up to, and
+// excluding, the given height.
+func RecentSlashesPrefixKey(height int64) []byte {
+	return append(RecentSlashKeyPrefix, sdk.Uint64ToBigEndian(uint64(height))...)
+}
+
+// RecentSlashKey returns the key of the slash of a validator at a height.
+func RecentSlashKey(height int64, v sdk.ConsAddress) []byte {
+	return append(RecentSlashesPrefixKey(height), address.MustLengthPrefix(v.Bytes())...)
+}
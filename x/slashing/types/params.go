@@ -11,6 +11,9 @@ import (
 const (
 	DefaultSignedBlocksWindow   = int64(100)
 	DefaultDowntimeJailDuration = 60 * 10 * time.Second
+
+	DefaultCorrelationWindow            = int64(0)
+	DefaultCorrelationPenaltyMultiplier = uint64(3)
 )
 
 var (
@@ -23,13 +26,16 @@ var (
 func NewParams(
 	signedBlocksWindow int64, minSignedPerWindow math.LegacyDec, downtimeJailDuration time.Duration,
 	slashFractionDoubleSign, slashFractionDowntime math.LegacyDec,
+	correlationWindow int64, correlationPenaltyMultiplier uint64,
 ) Params {
 	return Params{
-		SignedBlocksWindow:      signedBlocksWindow,
-		MinSignedPerWindow:      minSignedPerWindow,
-		DowntimeJailDuration:    downtimeJailDuration,
-		SlashFractionDoubleSign: slashFractionDoubleSign,
-		SlashFractionDowntime:   slashFractionDowntime,
+		SignedBlocksWindow:           signedBlocksWindow,
+		MinSignedPerWindow:           minSignedPerWindow,
+		DowntimeJailDuration:         downtimeJailDuration,
+		SlashFractionDoubleSign:      slashFractionDoubleSign,
+		SlashFractionDowntime:        slashFractionDowntime,
+		CorrelationWindow:            correlationWindow,
+		CorrelationPenaltyMultiplier: correlationPenaltyMultiplier,
 	}
 }
 
@@ -41,6 +47,8 @@ func DefaultParams() Params {
 		DefaultDowntimeJailDuration,
 		DefaultSlashFractionDoubleSign,
 		DefaultSlashFractionDowntime,
+		DefaultCorrelationWindow,
+		DefaultCorrelationPenaltyMultiplier,
 	)
 }
 
@@ -61,6 +69,12 @@ func (p Params) Validate() error {
 	if err := validateSlashFractionDowntime(p.SlashFractionDowntime); err != nil {
 		return err
 	}
+	if err := validateCorrelationWindow(p.CorrelationWindow); err != nil {
+		return err
+	}
+	if err := validateCorrelationPenaltyMultiplier(p.CorrelationPenaltyMultiplier, p.CorrelationWindow); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -146,3 +160,29 @@ func validateSlashFractionDowntime(i interface{}) error {
 
 	return nil
 }
+
+func validateCorrelationWindow(i interface{}) error {
+	v, ok := i.(int64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v < 0 {
+		return fmt.Errorf("correlation window cannot be negative: %d", v)
+	}
+
+	return nil
+}
+
+func validateCorrelationPenaltyMultiplier(i interface{}, correlationWindow int64) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if correlationWindow != 0 && v == 0 {
+		return fmt.Errorf("correlation penalty multiplier must be positive with a correlation window: %d", v)
+	}
+
+	return nil
+}
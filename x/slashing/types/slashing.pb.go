@@ -134,6 +134,15 @@ type Params struct {
 	DowntimeJailDuration    time.Duration               `protobuf:"bytes,3,opt,name=downtime_jail_duration,json=downtimeJailDuration,proto3,stdduration" json:"downtime_jail_duration"`
 	SlashFractionDoubleSign cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=slash_fraction_double_sign,json=slashFractionDoubleSign,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"slash_fraction_double_sign"`
 	SlashFractionDowntime   cosmossdk_io_math.LegacyDec `protobuf:"bytes,5,opt,name=slash_fraction_downtime,json=slashFractionDowntime,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"slash_fraction_downtime"`
+	// correlation_window defines the number of blocks over which the power of
+	// the validators slashed for double signing is summed to compute the
+	// correlation penalty. Zero disables the correlation penalty.
+	CorrelationWindow int64 `protobuf:"varint,6,opt,name=correlation_window,json=correlationWindow,proto3" json:"correlation_window,omitempty"`
+	// correlation_penalty_multiplier defines the factor applied to the fraction
+	// of the total power slashed within the correlation window. A validator is
+	// slashed by the greater of its slash fraction and this correlation penalty,
+	// capped to one.
+	CorrelationPenaltyMultiplier uint64 `protobuf:"varint,7,opt,name=correlation_penalty_multiplier,json=correlationPenaltyMultiplier,proto3" json:"correlation_penalty_multiplier,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -183,9 +192,89 @@ func (m *Params) GetDowntimeJailDuration() time.Duration {
 	return 0
 }
 
+func (m *Params) GetCorrelationWindow() int64 {
+	if m != nil {
+		return m.CorrelationWindow
+	}
+	return 0
+}
+
+func (m *Params) GetCorrelationPenaltyMultiplier() uint64 {
+	if m != nil {
+		return m.CorrelationPenaltyMultiplier
+	}
+	return 0
+}
+
+// RecentSlash records the power of a validator slashed within the correlation
+// window.
+type RecentSlash struct {
+	// address is the consensus address of the slashed validator.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// height is the height at which the validator was slashed.
+	Height int64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	// power is the power of the validator at the time of the infraction.
+	Power int64 `protobuf:"varint,3,opt,name=power,proto3" json:"power,omitempty"`
+}
+
+func (m *RecentSlash) Reset()         { *m = RecentSlash{} }
+func (m *RecentSlash) String() string { return proto.CompactTextString(m) }
+func (*RecentSlash) ProtoMessage()    {}
+func (*RecentSlash) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1078e5d96a74cc52, []int{2}
+}
+func (m *RecentSlash) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RecentSlash) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RecentSlash.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RecentSlash) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RecentSlash.Merge(m, src)
+}
+func (m *RecentSlash) XXX_Size() int {
+	return m.Size()
+}
+func (m *RecentSlash) XXX_DiscardUnknown() {
+	xxx_messageInfo_RecentSlash.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RecentSlash proto.InternalMessageInfo
+
+func (m *RecentSlash) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *RecentSlash) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *RecentSlash) GetPower() int64 {
+	if m != nil {
+		return m.Power
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*ValidatorSigningInfo)(nil), "cosmos.slashing.v1beta1.ValidatorSigningInfo")
 	proto.RegisterType((*Params)(nil), "cosmos.slashing.v1beta1.Params")
+	proto.RegisterType((*RecentSlash)(nil), "cosmos.slashing.v1beta1.RecentSlash")
 }
 
 func init() {
@@ -193,47 +282,52 @@ func init() {
 }
 
 var fileDescriptor_1078e5d96a74cc52 = []byte{
-	// 634 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0xcf, 0x4f, 0x13, 0x41,
-	0x14, 0xee, 0x00, 0xa2, 0x4e, 0xf1, 0xe0, 0x58, 0xa4, 0x54, 0xdd, 0x16, 0x0e, 0xa6, 0x21, 0xe9,
-	0xae, 0x60, 0xe2, 0x01, 0x4f, 0x96, 0xc6, 0xf8, 0x83, 0x44, 0x52, 0xfc, 0x91, 0x78, 0x70, 0x33,
-	0xbb, 0x33, 0x9d, 0x8e, 0xec, 0xce, 0x34, 0x3b, 0xb3, 0x02, 0xff, 0x82, 0x89, 0x09, 0x47, 0x8f,
-	0x1e, 0x39, 0x72, 0xe0, 0x1f, 0xf0, 0xc6, 0x91, 0x70, 0x32, 0x1e, 0xd0, 0x94, 0x03, 0xfe, 0x19,
-	0x66, 0x67, 0x76, 0x8b, 0x01, 0x4f, 0x5c, 0x9a, 0xf6, 0xfb, 0xbe, 0xf7, 0xbd, 0x7d, 0xdf, 0x7b,
-	0x5d, 0x78, 0x3f, 0x94, 0x2a, 0x96, 0xca, 0x53, 0x11, 0x56, 0x7d, 0x2e, 0x98, 0xf7, 0x69, 0x31,
-	0xa0, 0x1a, 0x2f, 0x8e, 0x00, 0x77, 0x90, 0x48, 0x2d, 0xd1, 0x8c, 0xd5, 0xb9, 0x23, 0x38, 0xd7,
-	0xd5, 0x2a, 0x4c, 0x32, 0x69, 0x34, 0x5e, 0xf6, 0xcd, 0xca, 0x6b, 0x0e, 0x93, 0x92, 0x45, 0xd4,
-	0x33, 0xbf, 0x82, 0xb4, 0xe7, 0x91, 0x34, 0xc1, 0x9a, 0x4b, 0x91, 0xf3, 0xf5, 0xf3, 0xbc, 0xe6,
-	0x31, 0x55, 0x1a, 0xc7, 0x83, 0x5c, 0x30, 0x6b, 0xfb, 0xf9, 0xd6, 0x39, 0x6f, 0x6e, 0xa9, 0x9b,
-	0x38, 0xe6, 0x42, 0x7a, 0xe6, 0xd3, 0x42, 0xf3, 0xdf, 0xc7, 0x60, 0xe5, 0x2d, 0x8e, 0x38, 0xc1,
-	0x5a, 0x26, 0xeb, 0x9c, 0x09, 0x2e, 0xd8, 0x73, 0xd1, 0x93, 0xe8, 0x31, 0xbc, 0x8a, 0x09, 0x49,
-	0xa8, 0x52, 0x55, 0xd0, 0x00, 0xcd, 0xeb, 0xed, 0xb9, 0xa3, 0xfd, 0xd6, 0xbd, 0xdc, 0x6e, 0x45,
-	0x0a, 0x45, 0x85, 0x4a, 0xd5, 0x13, 0x2b, 0x59, 0xd7, 0x09, 0x17, 0xac, 0x5b, 0x54, 0xa0, 0x39,
-	0x38, 0xa5, 0x34, 0x4e, 0xb4, 0xdf, 0xa7, 0x9c, 0xf5, 0x75, 0x75, 0xac, 0x01, 0x9a, 0xe3, 0xdd,
-	0xb2, 0xc1, 0x9e, 0x19, 0x28, 0x93, 0x70, 0x41, 0xe8, 0x96, 0x2f, 0x7b, 0x3d, 0x45, 0x75, 0x75,
-	0xdc, 0x4a, 0x0c, 0xf6, 0xca, 0x40, 0x68, 0x15, 0x4e, 0x7d, 0xc4, 0x3c, 0xa2, 0xc4, 0x4f, 0x85,
-	0xe6, 0x51, 0x75, 0xa2, 0x01, 0x9a, 0xe5, 0xa5, 0x9a, 0x6b, 0x13, 0x70, 0x8b, 0x04, 0xdc, 0xd7,
-	0x45, 0x02, 0xed, 0x1b, 0x07, 0xc7, 0xf5, 0xd2, 0xce, 0xaf, 0x3a, 0xd8, 0x3d, 0xdd, 0x5b, 0x00,
-	0xdd, 0xb2, 0x2d, 0x7f, 0x93, 0x55, 0x23, 0x07, 0x42, 0x2d, 0xe3, 0x40, 0x69, 0x29, 0x28, 0xa9,
-	0x5e, 0x69, 0x80, 0xe6, 0xb5, 0xee, 0x3f, 0x08, 0x5a, 0x82, 0xd3, 0x31, 0x57, 0x8a, 0x12, 0x3f,
-	0x88, 0x64, 0xb8, 0xa1, 0xfc, 0x50, 0xa6, 0x42, 0xd3, 0xa4, 0x3a, 0x69, 0x9e, 0xec, 0x96, 0x25,
-	0xdb, 0x86, 0x5b, 0xb1, 0xd4, 0xf2, 0xc4, 0x9f, 0x6f, 0x75, 0x30, 0xff, 0x65, 0x02, 0x4e, 0xae,
-	0xe1, 0x04, 0xc7, 0x0a, 0x3d, 0x80, 0x15, 0xc5, 0x99, 0x38, 0x33, 0xd9, 0xe4, 0x82, 0xc8, 0x4d,
-	0x13, 0xe1, 0x78, 0x17, 0x59, 0xce, 0x7a, 0xbc, 0x33, 0x0c, 0xe2, 0x59, 0x5b, 0xe1, 0xe7, 0x55,
-	0x03, 0x9a, 0x14, 0x25, 0x59, 0x66, 0x53, 0xed, 0x47, 0xd9, 0x44, 0x3f, 0x8f, 0xeb, 0x77, 0x6c,
-	0xf2, 0x8a, 0x6c, 0xb8, 0x5c, 0x7a, 0x31, 0xd6, 0x7d, 0x77, 0x95, 0x32, 0x1c, 0x6e, 0x77, 0x68,
-	0x78, 0xb4, 0xdf, 0x82, 0xf9, 0x62, 0x3a, 0x34, 0xb4, 0xa3, 0xa3, 0x98, 0x8b, 0x75, 0xe3, 0xb9,
-	0x46, 0x93, 0xbc, 0xd5, 0x07, 0x78, 0x9b, 0xc8, 0x4d, 0x91, 0x1d, 0x8c, 0x9f, 0x25, 0xe3, 0x17,
-	0xa7, 0x65, 0xc2, 0x2f, 0x2f, 0xcd, 0x5e, 0x48, 0xb6, 0x93, 0x0b, 0x6c, 0xb0, 0x5f, 0x47, 0xc1,
-	0x56, 0x0a, 0x9f, 0x17, 0x98, 0x47, 0x85, 0x08, 0x29, 0x58, 0x33, 0x47, 0xee, 0xf7, 0x12, 0x1c,
-	0x66, 0x88, 0x4f, 0x64, 0x1a, 0x44, 0xd4, 0x0c, 0x67, 0xb6, 0x77, 0xf9, 0x79, 0x66, 0x8c, 0xf3,
-	0xd3, 0xdc, 0xb8, 0x63, 0x7c, 0xb3, 0xf9, 0x90, 0x80, 0x33, 0x17, 0x9a, 0xda, 0x67, 0x33, 0x3b,
-	0xbe, 0x7c, 0xc7, 0xe9, 0x73, 0x1d, 0xad, 0xe9, 0xf2, 0xdc, 0xe7, 0xd3, 0xbd, 0x85, 0xbb, 0x56,
-	0xdc, 0x52, 0x64, 0xc3, 0xdb, 0x3a, 0x7b, 0x03, 0xd8, 0x23, 0x68, 0xbf, 0xdc, 0x1d, 0x3a, 0xe0,
-	0x60, 0xe8, 0x80, 0xc3, 0xa1, 0x03, 0x7e, 0x0f, 0x1d, 0xb0, 0x73, 0xe2, 0x94, 0x0e, 0x4f, 0x9c,
-	0xd2, 0x8f, 0x13, 0xa7, 0xf4, 0xbe, 0xc5, 0xb8, 0xee, 0xa7, 0x81, 0x1b, 0xca, 0x38, 0xff, 0x77,
-	0x7a, 0xff, 0x77, 0xd3, 0xdb, 0x03, 0xaa, 0x82, 0x49, 0xb3, 0x8c, 0x87, 0x7f, 0x03, 0x00, 0x00,
-	0xff, 0xff, 0xec, 0x7c, 0x0b, 0xf0, 0x6f, 0x04, 0x00, 0x00,
+	// 715 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x54, 0xbf, 0x4f, 0x1b, 0x49,
+	0x18, 0xf5, 0x82, 0x6d, 0xee, 0xc6, 0x5c, 0xc1, 0x9c, 0x81, 0xc5, 0xc7, 0xad, 0x0d, 0xc5, 0xc9,
+	0x42, 0xf2, 0xee, 0x41, 0xa4, 0x14, 0xa4, 0x8a, 0xb1, 0xa2, 0xfc, 0x20, 0x0a, 0xb2, 0xf3, 0x43,
+	0x4a, 0x91, 0xd5, 0x78, 0x77, 0xbc, 0x9e, 0xb0, 0x3b, 0x63, 0xcd, 0xcc, 0xc6, 0xd0, 0xa6, 0x4c,
+	0x45, 0x99, 0x32, 0x25, 0x25, 0x05, 0xff, 0x40, 0x3a, 0x4a, 0x44, 0x15, 0xa5, 0x20, 0x91, 0x29,
+	0xc8, 0x9f, 0x11, 0xed, 0xcc, 0xae, 0x71, 0x20, 0x1d, 0x8d, 0xe5, 0x7d, 0xef, 0x7d, 0xef, 0x9b,
+	0xef, 0xed, 0x37, 0x0b, 0xfe, 0xf3, 0x98, 0x88, 0x98, 0x70, 0x44, 0x88, 0x44, 0x9f, 0xd0, 0xc0,
+	0x79, 0xb7, 0xde, 0xc5, 0x12, 0xad, 0x8f, 0x01, 0x7b, 0xc0, 0x99, 0x64, 0x70, 0x51, 0xeb, 0xec,
+	0x31, 0x9c, 0xea, 0x2a, 0xe5, 0x80, 0x05, 0x4c, 0x69, 0x9c, 0xe4, 0x9f, 0x96, 0x57, 0xac, 0x80,
+	0xb1, 0x20, 0xc4, 0x8e, 0x7a, 0xea, 0xc6, 0x3d, 0xc7, 0x8f, 0x39, 0x92, 0x84, 0xd1, 0x94, 0xaf,
+	0x5e, 0xe7, 0x25, 0x89, 0xb0, 0x90, 0x28, 0x1a, 0xa4, 0x82, 0x25, 0xdd, 0xcf, 0xd5, 0xce, 0x69,
+	0x73, 0x4d, 0xcd, 0xa1, 0x88, 0x50, 0xe6, 0xa8, 0x5f, 0x0d, 0xad, 0x7e, 0x9e, 0x02, 0xe5, 0x97,
+	0x28, 0x24, 0x3e, 0x92, 0x8c, 0x77, 0x48, 0x40, 0x09, 0x0d, 0x1e, 0xd1, 0x1e, 0x83, 0xf7, 0xc0,
+	0x0c, 0xf2, 0x7d, 0x8e, 0x85, 0x30, 0x8d, 0x9a, 0x51, 0xff, 0xb3, 0xb9, 0x72, 0x76, 0xdc, 0xf8,
+	0x37, 0xb5, 0xdb, 0x62, 0x54, 0x60, 0x2a, 0x62, 0x71, 0x5f, 0x4b, 0x3a, 0x92, 0x13, 0x1a, 0xb4,
+	0xb3, 0x0a, 0xb8, 0x02, 0x66, 0x85, 0x44, 0x5c, 0xba, 0x7d, 0x4c, 0x82, 0xbe, 0x34, 0xa7, 0x6a,
+	0x46, 0x7d, 0xba, 0x5d, 0x52, 0xd8, 0x43, 0x05, 0x25, 0x12, 0x42, 0x7d, 0xbc, 0xe7, 0xb2, 0x5e,
+	0x4f, 0x60, 0x69, 0x4e, 0x6b, 0x89, 0xc2, 0x9e, 0x29, 0x08, 0x6e, 0x83, 0xd9, 0xb7, 0x88, 0x84,
+	0xd8, 0x77, 0x63, 0x2a, 0x49, 0x68, 0xe6, 0x6b, 0x46, 0xbd, 0xb4, 0x51, 0xb1, 0x75, 0x02, 0x76,
+	0x96, 0x80, 0xfd, 0x3c, 0x4b, 0xa0, 0xf9, 0xd7, 0xc9, 0x79, 0x35, 0x77, 0xf0, 0xad, 0x6a, 0x1c,
+	0x5e, 0x1e, 0xad, 0x19, 0xed, 0x92, 0x2e, 0x7f, 0x91, 0x54, 0x43, 0x0b, 0x00, 0xc9, 0xa2, 0xae,
+	0x90, 0x8c, 0x62, 0xdf, 0x2c, 0xd4, 0x8c, 0xfa, 0x1f, 0xed, 0x09, 0x04, 0x6e, 0x80, 0xf9, 0x88,
+	0x08, 0x81, 0x7d, 0xb7, 0x1b, 0x32, 0x6f, 0x57, 0xb8, 0x1e, 0x8b, 0xa9, 0xc4, 0xdc, 0x2c, 0xaa,
+	0x93, 0xfd, 0xad, 0xc9, 0xa6, 0xe2, 0xb6, 0x34, 0xb5, 0x99, 0xff, 0xf1, 0xa9, 0x6a, 0xac, 0xbe,
+	0x2f, 0x80, 0xe2, 0x0e, 0xe2, 0x28, 0x12, 0xf0, 0x7f, 0x50, 0x16, 0x24, 0xa0, 0x57, 0x26, 0x43,
+	0x42, 0x7d, 0x36, 0x54, 0x11, 0x4e, 0xb7, 0xa1, 0xe6, 0xb4, 0xc7, 0x2b, 0xc5, 0x40, 0x92, 0xb4,
+	0xa5, 0x6e, 0x5a, 0x35, 0xc0, 0x3c, 0x2b, 0x49, 0x32, 0x9b, 0x6d, 0xde, 0x4d, 0x26, 0xfa, 0x7a,
+	0x5e, 0xfd, 0x47, 0x27, 0x2f, 0xfc, 0x5d, 0x9b, 0x30, 0x27, 0x42, 0xb2, 0x6f, 0x6f, 0xe3, 0x00,
+	0x79, 0xfb, 0x2d, 0xec, 0x9d, 0x1d, 0x37, 0x40, 0xfa, 0x62, 0x5a, 0xd8, 0xd3, 0xa3, 0xc3, 0x88,
+	0xd0, 0x8e, 0xf2, 0xdc, 0xc1, 0x3c, 0x6d, 0xf5, 0x06, 0x2c, 0xf8, 0x6c, 0x48, 0x93, 0x85, 0x71,
+	0x93, 0x64, 0xdc, 0x6c, 0xb5, 0x54, 0xf8, 0xa5, 0x8d, 0xa5, 0x1b, 0xc9, 0xb6, 0x52, 0x81, 0x0e,
+	0xf6, 0xe3, 0x38, 0xd8, 0x72, 0xe6, 0xf3, 0x18, 0x91, 0x30, 0x13, 0x41, 0x01, 0x2a, 0x6a, 0xc9,
+	0xdd, 0x1e, 0x47, 0x5e, 0x82, 0xb8, 0x3e, 0x8b, 0xbb, 0x21, 0x56, 0xc3, 0x99, 0xf9, 0x5b, 0xcd,
+	0xb3, 0xa8, 0x9c, 0x1f, 0xa4, 0xc6, 0x2d, 0xe5, 0x9b, 0xcc, 0x07, 0x29, 0x58, 0xbc, 0xd1, 0x54,
+	0x9f, 0xcd, 0x2c, 0xdc, 0xaa, 0xe3, 0xfc, 0xb5, 0x8e, 0xda, 0x14, 0x36, 0x00, 0xf4, 0x18, 0xe7,
+	0x38, 0x54, 0x33, 0x67, 0x2f, 0x4b, 0xef, 0xc8, 0xdc, 0x04, 0x93, 0x66, 0xde, 0x02, 0xd6, 0xa4,
+	0x7c, 0x80, 0x29, 0x0a, 0xe5, 0xbe, 0x1b, 0xc5, 0xa1, 0x24, 0x83, 0x90, 0x60, 0x6e, 0xce, 0xd4,
+	0x8c, 0x7a, 0xbe, 0xbd, 0x3c, 0xa1, 0xda, 0xd1, 0xa2, 0xa7, 0x63, 0xcd, 0xe6, 0xca, 0x87, 0xcb,
+	0xa3, 0xb5, 0x65, 0x7d, 0xc2, 0x86, 0xf0, 0x77, 0x9d, 0xbd, 0xab, 0xcf, 0x8e, 0xde, 0xbc, 0xd5,
+	0x3d, 0x50, 0x6a, 0x63, 0x0f, 0x53, 0xd9, 0x49, 0x88, 0xdb, 0x5d, 0xdf, 0x05, 0x50, 0xfc, 0xe5,
+	0xe2, 0xa6, 0x4f, 0xb0, 0x0c, 0x0a, 0x03, 0x36, 0xc4, 0x3c, 0xbd, 0xac, 0xfa, 0xa1, 0xf9, 0xe4,
+	0x70, 0x64, 0x19, 0x27, 0x23, 0xcb, 0x38, 0x1d, 0x59, 0xc6, 0xf7, 0x91, 0x65, 0x1c, 0x5c, 0x58,
+	0xb9, 0xd3, 0x0b, 0x2b, 0xf7, 0xe5, 0xc2, 0xca, 0xbd, 0x6e, 0x04, 0x44, 0xf6, 0xe3, 0xae, 0xed,
+	0xb1, 0x28, 0xfd, 0x18, 0x39, 0xbf, 0x9f, 0x43, 0xee, 0x0f, 0xb0, 0xe8, 0x16, 0xd5, 0xee, 0xdd,
+	0xf9, 0x39, 0x00, 0x4e, 0xc6, 0x79, 0x22, 0x5e, 0x05, 0x00, 0x00,
 }
 
 func (this *ValidatorSigningInfo) Equal(that interface{}) bool {
@@ -309,6 +403,42 @@ func (this *Params) Equal(that interface{}) bool {
 	if !this.SlashFractionDowntime.Equal(that1.SlashFractionDowntime) {
 		return false
 	}
+	if this.CorrelationWindow != that1.CorrelationWindow {
+		return false
+	}
+	if this.CorrelationPenaltyMultiplier != that1.CorrelationPenaltyMultiplier {
+		return false
+	}
+	return true
+}
+func (this *RecentSlash) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*RecentSlash)
+	if !ok {
+		that2, ok := that.(RecentSlash)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Address != that1.Address {
+		return false
+	}
+	if this.Height != that1.Height {
+		return false
+	}
+	if this.Power != that1.Power {
+		return false
+	}
 	return true
 }
 func (m *ValidatorSigningInfo) Marshal() (dAtA []byte, err error) {
@@ -394,6 +524,16 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.CorrelationPenaltyMultiplier != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.CorrelationPenaltyMultiplier))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.CorrelationWindow != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.CorrelationWindow))
+		i--
+		dAtA[i] = 0x30
+	}
 	{
 		size := m.SlashFractionDowntime.Size()
 		i -= size
@@ -440,6 +580,46 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *RecentSlash) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RecentSlash) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RecentSlash) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Power != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.Power))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Height != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintSlashing(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintSlashing(dAtA []byte, offset int, v uint64) int {
 	offset -= sovSlashing(v)
 	base := offset
@@ -495,6 +675,31 @@ func (m *Params) Size() (n int) {
 	n += 1 + l + sovSlashing(uint64(l))
 	l = m.SlashFractionDowntime.Size()
 	n += 1 + l + sovSlashing(uint64(l))
+	if m.CorrelationWindow != 0 {
+		n += 1 + sovSlashing(uint64(m.CorrelationWindow))
+	}
+	if m.CorrelationPenaltyMultiplier != 0 {
+		n += 1 + sovSlashing(uint64(m.CorrelationPenaltyMultiplier))
+	}
+	return n
+}
+
+func (m *RecentSlash) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovSlashing(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovSlashing(uint64(m.Height))
+	}
+	if m.Power != 0 {
+		n += 1 + sovSlashing(uint64(m.Power))
+	}
 	return n
 }
 
@@ -876,6 +1081,164 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CorrelationWindow", wireType)
+			}
+			m.CorrelationWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CorrelationWindow |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CorrelationPenaltyMultiplier", wireType)
+			}
+			m.CorrelationPenaltyMultiplier = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CorrelationPenaltyMultiplier |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSlashing(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RecentSlash) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSlashing
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RecentSlash: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RecentSlash: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Power", wireType)
+			}
+			m.Power = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Power |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSlashing(dAtA[iNdEx:])
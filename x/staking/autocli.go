@@ -137,6 +137,38 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Short:     "Query the current staking parameters information",
 					Long:      "Query values set as staking parameters.",
 				},
+				{
+					RpcMethod: "TokenizeShareRecordById",
+					Use:       "tokenize-share-record [id]",
+					Short:     "Query a tokenize share record by its id",
+					Example:   fmt.Sprintf("$ %s query staking tokenize-share-record 1", version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "id"},
+					},
+				},
+				{
+					RpcMethod: "TokenizeShareRecordsOwned",
+					Use:       "tokenize-share-records-owned [owner]",
+					Short:     "Query the tokenize share records of an owner",
+					Example:   fmt.Sprintf("$ %s query staking tokenize-share-records-owned cosmos1...", version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "owner"},
+					},
+				},
+				{
+					RpcMethod: "TotalLiquidStaked",
+					Use:       "total-liquid-staked",
+					Short:     "Query the total amount of liquid staked tokens",
+				},
+				{
+					RpcMethod: "ValidatorSelfBondLimit",
+					Use:       "validator-self-bond-limit [validator-addr]",
+					Short:     "Query the limit put by the minimum self-bond ratio on the tokens delegated to a validator",
+					Example:   fmt.Sprintf("$ %s query staking validator-self-bond-limit cosmosvaloper1...", version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "validator_addr"},
+					},
+				},
 			},
 		},
 		Tx: &autocliv1.ServiceCommandDescriptor{
@@ -173,6 +205,25 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Example:        fmt.Sprintf(`%s tx staking cancel-unbond cosmosvaloper... 100stake 2 --from mykey`, version.AppName),
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "validator_address"}, {ProtoField: "amount"}, {ProtoField: "creation_height"}},
 				},
+				{
+					RpcMethod:      "TokenizeShares",
+					Use:            "tokenize-share [validator-addr] [amount] [rewards-owner] --from [delegator_address]",
+					Short:          "Tokenize the shares of a delegation",
+					Long:           "Tokenize an amount of delegation shares into a transferable bank denom, whose rewards go to the rewards owner.",
+					Example:        fmt.Sprintf(`%s tx staking tokenize-share cosmosvaloper... 100stake cosmos1... --from mykey`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "validator_address"}, {ProtoField: "amount"}, {ProtoField: "tokenized_share_owner"}},
+				},
+				{
+					RpcMethod:      "RedeemTokensForShares",
+					Use:            "redeem-tokens [amount] --from [delegator_address]",
+					Short:          "Redeem tokenized shares back into a delegation",
+					Example:        fmt.Sprintf(`%s tx staking redeem-tokens 100cosmosvaloper.../1 --from mykey`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "amount"}},
+				},
+				{
+					RpcMethod: "RotateConsPubKey",
+					Skip:      true, // skipped because the new pubkey is provided as JSON, see the custom command
+				},
 				{
 					RpcMethod: "UpdateParams",
 					Skip:      true, // skipped because authority gated
@@ -20,5 +20,14 @@ func (k *Keeper) BeginBlocker(ctx context.Context) error {
 // EndBlocker called at every block, update validator set
 func (k *Keeper) EndBlocker(ctx context.Context) ([]abci.ValidatorUpdate, error) {
 	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyEndBlocker)
-	return k.BlockValidatorUpdates(ctx)
+	updates, err := k.BlockValidatorUpdates(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.PruneMatureConsPubKeyRotations(ctx); err != nil {
+		return nil, err
+	}
+
+	return updates, nil
 }
@@ -0,0 +1,327 @@
+package keeper
+
+import (
+	"context"
+	"time"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// RotateConsPubKey rotates the consensus public key of a validator. The
+// rotation fee is paid by the validator operator and burned, and the new key
+// replaces the old one in the validator set at the end of the block.
+//
+// A validator can rotate its consensus public key once per unbonding period,
+// so that the infractions committed with the old key can still be resolved.
+func (k Keeper) RotateConsPubKey(ctx context.Context, valAddr sdk.ValAddress, newPubKey cryptotypes.PubKey) error {
+	validator, err := k.GetValidator(ctx, valAddr)
+	if err != nil {
+		return err
+	}
+
+	// the new key must not be used, or have been used, by any validator
+	newConsAddr := sdk.ConsAddress(newPubKey.Address())
+	if _, err := k.GetValidatorByConsAddr(ctx, newConsAddr); err == nil {
+		return types.ErrValidatorPubKeyExists
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	lastRotation, found, err := k.GetValidatorLastRotationTime(ctx, valAddr)
+	if err != nil {
+		return err
+	}
+
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+
+	if found && sdkCtx.BlockTime().Before(lastRotation.Add(params.UnbondingTime)) {
+		return errorsmod.Wrapf(
+			types.ErrConsPubKeyRotationLimitExceeded, "next rotation allowed at %s", lastRotation.Add(params.UnbondingTime),
+		)
+	}
+
+	if params.KeyRotationFee.IsPositive() {
+		fee := sdk.NewCoins(params.KeyRotationFee)
+		if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, sdk.AccAddress(valAddr), types.ModuleName, fee); err != nil {
+			return err
+		}
+
+		if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, fee); err != nil {
+			return err
+		}
+	}
+
+	oldPubKey, err := validator.ConsPubKey()
+	if err != nil {
+		return err
+	}
+
+	history, err := types.NewConsPubKeyRotationHistory(
+		validator.OperatorAddress, oldPubKey, newPubKey, sdkCtx.BlockHeight(), sdkCtx.BlockTime(), params.KeyRotationFee,
+	)
+	if err != nil {
+		return err
+	}
+
+	if err := k.DeleteValidatorByConsAddr(ctx, sdk.ConsAddress(oldPubKey.Address())); err != nil {
+		return err
+	}
+
+	validator.ConsensusPubkey = history.NewConsPubkey
+	if err := k.SetValidator(ctx, validator); err != nil {
+		return err
+	}
+
+	if err := k.SetValidatorByConsAddr(ctx, validator); err != nil {
+		return err
+	}
+
+	if err := k.setConsPubKeyRotation(ctx, history); err != nil {
+		return err
+	}
+
+	return k.Hooks().AfterConsensusPubKeyUpdate(ctx, oldPubKey, newPubKey, params.KeyRotationFee)
+}
+
+// ValidatorIdentifier returns the current consensus address of the validator
+// which used the given consensus address, following the consensus public key
+// rotations of the validator. The given address is returned if it was never
+// rotated.
+func (k Keeper) ValidatorIdentifier(ctx context.Context, consAddr sdk.ConsAddress) (sdk.ConsAddress, error) {
+	store := k.storeService.OpenKVStore(ctx)
+	for {
+		bz, err := store.Get(types.GetOldToNewConsAddrKey(consAddr))
+		if err != nil {
+			return nil, err
+		}
+
+		if bz == nil {
+			return consAddr, nil
+		}
+
+		consAddr = bz
+	}
+}
+
+// DeleteValidatorByConsAddr deletes the consensus address index of a validator.
+func (k Keeper) DeleteValidatorByConsAddr(ctx context.Context, consAddr sdk.ConsAddress) error {
+	store := k.storeService.OpenKVStore(ctx)
+	return store.Delete(types.GetValidatorByConsAddrKey(consAddr))
+}
+
+// GetValidatorLastRotationTime returns the time of the last consensus public
+// key rotation of a validator.
+func (k Keeper) GetValidatorLastRotationTime(ctx context.Context, valAddr sdk.ValAddress) (time.Time, bool, error) {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get(types.GetValidatorLastRotationKey(valAddr))
+	if err != nil || bz == nil {
+		return time.Time{}, false, err
+	}
+
+	t, err := sdk.ParseTimeBytes(bz)
+	if err != nil {
+		return time.Time{}, false, err
+	}
+
+	return t, true, nil
+}
+
+// GetBlockConsPubKeyRotationHistory returns the consensus public key rotations
+// performed in the current block.
+func (k Keeper) GetBlockConsPubKeyRotationHistory(ctx context.Context) ([]types.ConsPubKeyRotationHistory, error) {
+	prefix := types.GetConsPubKeyRotationHistoriesKey(sdk.UnwrapSDKContext(ctx).BlockHeight())
+	return k.getConsPubKeyRotationHistory(ctx, prefix)
+}
+
+// GetAllConsPubKeyRotationHistory returns all the consensus public key
+// rotations.
+func (k Keeper) GetAllConsPubKeyRotationHistory(ctx context.Context) ([]types.ConsPubKeyRotationHistory, error) {
+	return k.getConsPubKeyRotationHistory(ctx, types.ConsPubKeyRotationHistoryKey)
+}
+
+func (k Keeper) getConsPubKeyRotationHistory(ctx context.Context, prefix []byte) (histories []types.ConsPubKeyRotationHistory, err error) {
+	store := k.storeService.OpenKVStore(ctx)
+	iterator, err := store.Iterator(prefix, storetypes.PrefixEndBytes(prefix))
+	if err != nil {
+		return nil, err
+	}
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var history types.ConsPubKeyRotationHistory
+		if err := k.cdc.Unmarshal(iterator.Value(), &history); err != nil {
+			return nil, err
+		}
+		histories = append(histories, history)
+	}
+
+	return histories, nil
+}
+
+// setConsPubKeyRotation records a consensus public key rotation, mapping the
+// old consensus address to the new one and updating the time of the last
+// rotation of the validator.
+func (k Keeper) setConsPubKeyRotation(ctx context.Context, history types.ConsPubKeyRotationHistory) error {
+	valAddr, err := k.validatorAddressCodec.StringToBytes(history.OperatorAddress)
+	if err != nil {
+		return err
+	}
+
+	oldPubKey, err := history.OldConsPubKey()
+	if err != nil {
+		return err
+	}
+
+	newPubKey, err := history.NewConsPubKey()
+	if err != nil {
+		return err
+	}
+
+	bz, err := k.cdc.Marshal(&history)
+	if err != nil {
+		return err
+	}
+
+	store := k.storeService.OpenKVStore(ctx)
+	if err := store.Set(types.GetConsPubKeyRotationHistoryKey(history.Height, valAddr), bz); err != nil {
+		return err
+	}
+
+	if err := store.Set(types.GetOldToNewConsAddrKey(sdk.ConsAddress(oldPubKey.Address())), newPubKey.Address()); err != nil {
+		return err
+	}
+
+	lastRotation, found, err := k.GetValidatorLastRotationTime(ctx, valAddr)
+	if err != nil {
+		return err
+	}
+
+	if found && lastRotation.After(history.Time) {
+		return nil
+	}
+
+	return store.Set(types.GetValidatorLastRotationKey(valAddr), sdk.FormatTimeBytes(history.Time))
+}
+
+// PruneMatureConsPubKeyRotations removes the consensus public key rotations
+// performed more than an unbonding period ago, along with the mapping of their
+// old consensus address. The infractions committed with the old key cannot be
+// submitted anymore once the unbonding period passed.
+func (k Keeper) PruneMatureConsPubKeyRotations(ctx context.Context) error {
+	unbondingTime, err := k.UnbondingTime(ctx)
+	if err != nil {
+		return err
+	}
+
+	blockTime := sdk.UnwrapSDKContext(ctx).BlockTime()
+	store := k.storeService.OpenKVStore(ctx)
+	iterator, err := store.Iterator(types.ConsPubKeyRotationHistoryKey, storetypes.PrefixEndBytes(types.ConsPubKeyRotationHistoryKey))
+	if err != nil {
+		return err
+	}
+	defer iterator.Close()
+
+	// the rotations are iterated by height, hence by time, so the iteration
+	// stops at the first rotation which is not mature yet
+	var historyKeys, oldConsAddrKeys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		var history types.ConsPubKeyRotationHistory
+		if err := k.cdc.Unmarshal(iterator.Value(), &history); err != nil {
+			return err
+		}
+
+		if !blockTime.After(history.Time.Add(unbondingTime)) {
+			break
+		}
+
+		oldPubKey, err := history.OldConsPubKey()
+		if err != nil {
+			return err
+		}
+
+		historyKeys = append(historyKeys, iterator.Key())
+		oldConsAddrKeys = append(oldConsAddrKeys, types.GetOldToNewConsAddrKey(sdk.ConsAddress(oldPubKey.Address())))
+	}
+
+	for i := range historyKeys {
+		if err := store.Delete(historyKeys[i]); err != nil {
+			return err
+		}
+
+		if err := store.Delete(oldConsAddrKeys[i]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// applyConsPubKeyRotationUpdates replaces the validator set updates of the
+// validators which rotated their consensus public key in the current block:
+// the old key is removed from the validator set if the validator was bonded,
+// and the new key is added with the validator power if it is still bonded.
+func (k Keeper) applyConsPubKeyRotationUpdates(
+	ctx context.Context, updates []abci.ValidatorUpdate, rotations []types.ConsPubKeyRotationHistory, wasBonded []bool,
+) ([]abci.ValidatorUpdate, error) {
+	for i, rotation := range rotations {
+		oldPubKey, err := rotation.OldConsPubKey()
+		if err != nil {
+			return nil, err
+		}
+
+		newPubKey, err := rotation.NewConsPubKey()
+		if err != nil {
+			return nil, err
+		}
+
+		oldCmtPubKey, err := cryptocodec.ToCmtProtoPublicKey(oldPubKey)
+		if err != nil {
+			return nil, err
+		}
+
+		newCmtPubKey, err := cryptocodec.ToCmtProtoPublicKey(newPubKey)
+		if err != nil {
+			return nil, err
+		}
+
+		// drop the update of the new key emitted from the validator power
+		// change, it is replaced below
+		filtered := updates[:0]
+		for _, update := range updates {
+			if !update.PubKey.Equal(newCmtPubKey) {
+				filtered = append(filtered, update)
+			}
+		}
+		updates = filtered
+
+		if wasBonded[i] {
+			updates = append(updates, abci.ValidatorUpdate{PubKey: oldCmtPubKey, Power: 0})
+		}
+
+		valAddr, err := k.validatorAddressCodec.StringToBytes(rotation.OperatorAddress)
+		if err != nil {
+			return nil, err
+		}
+
+		power, err := k.GetLastValidatorPower(ctx, valAddr)
+		if err != nil {
+			return nil, err
+		}
+
+		if power > 0 {
+			updates = append(updates, abci.ValidatorUpdate{PubKey: newCmtPubKey, Power: power})
+		}
+	}
+
+	return updates, nil
+}
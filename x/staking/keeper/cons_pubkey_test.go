@@ -0,0 +1,120 @@
+package keeper_test
+
+import (
+	"time"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/golang/mock/gomock"
+
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	"github.com/cosmos/cosmos-sdk/x/staking/testutil"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func (s *KeeperTestSuite) TestRotateConsPubKey() {
+	ctx, keeper, msgServer := s.ctx, s.stakingKeeper, s.msgServer
+	require := s.Require()
+
+	_, valAddrs := createValAddrs(2)
+	for i, valAddr := range valAddrs {
+		validator := testutil.NewValidator(s.T(), valAddr, PKs[i])
+		validator, _ = validator.AddTokensFromDel(keeper.TokensFromConsensusPower(ctx, 10))
+		s.bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), stakingtypes.NotBondedPoolName, stakingtypes.BondedPoolName, gomock.Any())
+		validator = stakingkeeper.TestingUpdateValidator(keeper, ctx, validator, true)
+		require.NoError(keeper.SetValidatorByConsAddr(ctx, validator))
+	}
+
+	oldConsAddr, newConsAddr := sdk.ConsAddress(PKs[0].Address()), sdk.ConsAddress(PKs[2].Address())
+
+	// the new key cannot be the key of another validator
+	msg, err := stakingtypes.NewMsgRotateConsPubKey(valAddrs[0].String(), PKs[1])
+	require.NoError(err)
+	_, err = msgServer.RotateConsPubKey(ctx, msg)
+	require.ErrorIs(err, stakingtypes.ErrValidatorPubKeyExists)
+
+	params, err := keeper.GetParams(ctx)
+	require.NoError(err)
+	fee := sdk.NewCoins(params.KeyRotationFee)
+	s.bankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), sdk.AccAddress(valAddrs[0]), stakingtypes.ModuleName, fee).Return(nil)
+	s.bankKeeper.EXPECT().BurnCoins(gomock.Any(), stakingtypes.ModuleName, fee).Return(nil)
+
+	msg, err = stakingtypes.NewMsgRotateConsPubKey(valAddrs[0].String(), PKs[2])
+	require.NoError(err)
+	_, err = msgServer.RotateConsPubKey(ctx, msg)
+	require.NoError(err)
+
+	validator, err := keeper.GetValidator(ctx, valAddrs[0])
+	require.NoError(err)
+	consAddr, err := validator.GetConsAddr()
+	require.NoError(err)
+	require.Equal(newConsAddr, sdk.ConsAddress(consAddr))
+
+	// the validator is still found by its old consensus address
+	for _, addr := range []sdk.ConsAddress{oldConsAddr, newConsAddr} {
+		validator, err = keeper.GetValidatorByConsAddr(ctx, addr)
+		require.NoError(err)
+		require.Equal(valAddrs[0].String(), validator.OperatorAddress)
+
+		identifier, err := keeper.ValidatorIdentifier(ctx, addr)
+		require.NoError(err)
+		require.Equal(newConsAddr, identifier)
+	}
+
+	// the old key cannot be used again
+	msg, err = stakingtypes.NewMsgRotateConsPubKey(valAddrs[1].String(), PKs[0])
+	require.NoError(err)
+	_, err = msgServer.RotateConsPubKey(ctx, msg)
+	require.ErrorIs(err, stakingtypes.ErrValidatorPubKeyExists)
+
+	// the validator cannot rotate its key again within the unbonding period
+	msg, err = stakingtypes.NewMsgRotateConsPubKey(valAddrs[0].String(), PKs[3])
+	require.NoError(err)
+	_, err = msgServer.RotateConsPubKey(ctx, msg)
+	require.ErrorIs(err, stakingtypes.ErrConsPubKeyRotationLimitExceeded)
+
+	// the old key is swapped for the new one in the validator set
+	power, err := keeper.GetLastValidatorPower(ctx, valAddrs[0])
+	require.NoError(err)
+	oldCmtPk, err := cryptocodec.ToCmtProtoPublicKey(PKs[0])
+	require.NoError(err)
+	newCmtPk, err := cryptocodec.ToCmtProtoPublicKey(PKs[2])
+	require.NoError(err)
+
+	updates, err := keeper.ApplyAndReturnValidatorSetUpdates(ctx)
+	require.NoError(err)
+	require.Equal([]abci.ValidatorUpdate{{PubKey: oldCmtPk, Power: 0}, {PubKey: newCmtPk, Power: power}}, updates)
+
+	// the validator can rotate its key again once the unbonding period passed
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1).WithBlockTime(ctx.BlockTime().Add(params.UnbondingTime + time.Second))
+	s.bankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), sdk.AccAddress(valAddrs[0]), stakingtypes.ModuleName, fee).Return(nil)
+	s.bankKeeper.EXPECT().BurnCoins(gomock.Any(), stakingtypes.ModuleName, fee).Return(nil)
+	_, err = msgServer.RotateConsPubKey(ctx, msg)
+	require.NoError(err)
+
+	identifier, err := keeper.ValidatorIdentifier(ctx, oldConsAddr)
+	require.NoError(err)
+	require.Equal(sdk.ConsAddress(PKs[3].Address()), identifier)
+
+	history, err := keeper.GetAllConsPubKeyRotationHistory(ctx)
+	require.NoError(err)
+	require.Len(history, 2)
+
+	// the first rotation is pruned once the unbonding period passed
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1).WithBlockTime(ctx.BlockTime().Add(time.Second))
+	require.NoError(keeper.PruneMatureConsPubKeyRotations(ctx))
+
+	history, err = keeper.GetAllConsPubKeyRotationHistory(ctx)
+	require.NoError(err)
+	require.Len(history, 1)
+	require.Equal(ctx.BlockHeight()-1, history[0].Height)
+
+	identifier, err = keeper.ValidatorIdentifier(ctx, oldConsAddr)
+	require.NoError(err)
+	require.Equal(oldConsAddr, identifier)
+
+	identifier, err = keeper.ValidatorIdentifier(ctx, newConsAddr)
+	require.NoError(err)
+	require.Equal(sdk.ConsAddress(PKs[3].Address()), identifier)
+}
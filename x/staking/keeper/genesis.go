@@ -137,6 +137,43 @@ func (k Keeper) InitGenesis(ctx context.Context, data *types.GenesisState) (res
 		}
 	}
 
+	if err := k.SetLastTokenizeShareRecordID(ctx, data.LastTokenizeShareRecordId); err != nil {
+		panic(err)
+	}
+
+	for _, record := range data.TokenizeShareRecords {
+		if err := k.SetTokenizeShareRecord(ctx, record); err != nil {
+			panic(err)
+		}
+
+		// the liquid staked shares of the validators are the shares delegated by
+		// the tokenize share records
+		valAddr, err := k.validatorAddressCodec.StringToBytes(record.Validator)
+		if err != nil {
+			panic(err)
+		}
+
+		delegation, err := k.GetDelegation(ctx, record.GetModuleAddress(), valAddr)
+		if err != nil {
+			panic(fmt.Sprintf("no delegation for tokenize share record %d", record.Id))
+		}
+
+		liquidShares, err := k.GetValidatorLiquidShares(ctx, valAddr)
+		if err != nil {
+			panic(err)
+		}
+
+		if err := k.SetValidatorLiquidShares(ctx, valAddr, liquidShares.Add(delegation.Shares)); err != nil {
+			panic(err)
+		}
+	}
+
+	for _, history := range data.RotationHistory {
+		if err := k.setConsPubKeyRotation(ctx, history); err != nil {
+			panic(err)
+		}
+	}
+
 	bondedCoins := sdk.NewCoins(sdk.NewCoin(data.Params.BondDenom, bondedTokens))
 	notBondedCoins := sdk.NewCoins(sdk.NewCoin(data.Params.BondDenom, notBondedTokens))
 
@@ -266,14 +303,32 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		panic(err)
 	}
 
+	tokenizeShareRecords, err := k.GetAllTokenizeShareRecords(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	lastTokenizeShareRecordID, err := k.GetLastTokenizeShareRecordID(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	rotationHistory, err := k.GetAllConsPubKeyRotationHistory(ctx)
+	if err != nil {
+		panic(err)
+	}
+
 	return &types.GenesisState{
-		Params:               params,
-		LastTotalPower:       totalPower,
-		LastValidatorPowers:  lastValidatorPowers,
-		Validators:           allValidators,
-		Delegations:          allDelegations,
-		UnbondingDelegations: unbondingDelegations,
-		Redelegations:        redelegations,
-		Exported:             true,
+		Params:                    params,
+		LastTotalPower:            totalPower,
+		LastValidatorPowers:       lastValidatorPowers,
+		Validators:                allValidators,
+		Delegations:               allDelegations,
+		UnbondingDelegations:      unbondingDelegations,
+		Redelegations:             redelegations,
+		Exported:                  true,
+		TokenizeShareRecords:      tokenizeShareRecords,
+		LastTokenizeShareRecordId: lastTokenizeShareRecordID,
+		RotationHistory:           rotationHistory,
 	}
 }
@@ -0,0 +1,496 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"errors"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	vestingexported "github.com/cosmos/cosmos-sdk/x/auth/vesting/exported"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// GetLastTokenizeShareRecordID returns the id of the last tokenize share record.
+func (k Keeper) GetLastTokenizeShareRecordID(ctx context.Context) (uint64, error) {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get(types.LastTokenizeShareRecordIDKey)
+	if err != nil || bz == nil {
+		return 0, err
+	}
+
+	return binary.BigEndian.Uint64(bz), nil
+}
+
+// SetLastTokenizeShareRecordID sets the id of the last tokenize share record.
+func (k Keeper) SetLastTokenizeShareRecordID(ctx context.Context, id uint64) error {
+	store := k.storeService.OpenKVStore(ctx)
+	return store.Set(types.LastTokenizeShareRecordIDKey, sdk.Uint64ToBigEndian(id))
+}
+
+// GetTokenizeShareRecord gets a tokenize share record by its id.
+func (k Keeper) GetTokenizeShareRecord(ctx context.Context, id uint64) (record types.TokenizeShareRecord, err error) {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get(types.GetTokenizeShareRecordKey(id))
+	if err != nil {
+		return record, err
+	}
+
+	if bz == nil {
+		return record, errorsmod.Wrapf(types.ErrTokenizeShareRecordNotFound, "id %d", id)
+	}
+
+	err = k.cdc.Unmarshal(bz, &record)
+	return record, err
+}
+
+// GetTokenizeShareRecordByDenom gets a tokenize share record by the denom of
+// its share tokens.
+func (k Keeper) GetTokenizeShareRecordByDenom(ctx context.Context, denom string) (types.TokenizeShareRecord, error) {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get(types.GetTokenizeShareRecordByDenomKey(denom))
+	if err != nil {
+		return types.TokenizeShareRecord{}, err
+	}
+
+	if bz == nil {
+		return types.TokenizeShareRecord{}, errorsmod.Wrapf(types.ErrTokenizeShareRecordNotFound, "denom %s", denom)
+	}
+
+	return k.GetTokenizeShareRecord(ctx, binary.BigEndian.Uint64(bz))
+}
+
+// GetTokenizeShareRecordsByOwner returns the tokenize share records of an owner.
+func (k Keeper) GetTokenizeShareRecordsByOwner(ctx context.Context, owner sdk.AccAddress) (records []types.TokenizeShareRecord, err error) {
+	store := k.storeService.OpenKVStore(ctx)
+	ownerPrefixKey := types.GetTokenizeShareRecordsByOwnerKey(owner)
+
+	iterator, err := store.Iterator(ownerPrefixKey, storetypes.PrefixEndBytes(ownerPrefixKey))
+	if err != nil {
+		return nil, err
+	}
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		record, err := k.GetTokenizeShareRecord(ctx, binary.BigEndian.Uint64(iterator.Key()[len(ownerPrefixKey):]))
+		if err != nil {
+			return nil, err
+		}
+		records = append(records, record)
+	}
+
+	return records, nil
+}
+
+// GetAllTokenizeShareRecords returns all the tokenize share records.
+func (k Keeper) GetAllTokenizeShareRecords(ctx context.Context) (records []types.TokenizeShareRecord, err error) {
+	store := k.storeService.OpenKVStore(ctx)
+	iterator, err := store.Iterator(types.TokenizeShareRecordKey, storetypes.PrefixEndBytes(types.TokenizeShareRecordKey))
+	if err != nil {
+		return nil, err
+	}
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var record types.TokenizeShareRecord
+		if err := k.cdc.Unmarshal(iterator.Value(), &record); err != nil {
+			return nil, err
+		}
+		records = append(records, record)
+	}
+
+	return records, nil
+}
+
+// SetTokenizeShareRecord sets a tokenize share record, indexed by owner and
+// share token denom.
+func (k Keeper) SetTokenizeShareRecord(ctx context.Context, record types.TokenizeShareRecord) error {
+	owner, err := k.authKeeper.AddressCodec().StringToBytes(record.Owner)
+	if err != nil {
+		return err
+	}
+
+	bz, err := k.cdc.Marshal(&record)
+	if err != nil {
+		return err
+	}
+
+	store := k.storeService.OpenKVStore(ctx)
+	if err := store.Set(types.GetTokenizeShareRecordKey(record.Id), bz); err != nil {
+		return err
+	}
+
+	if err := store.Set(types.GetTokenizeShareRecordByOwnerKey(owner, record.Id), []byte{}); err != nil {
+		return err
+	}
+
+	return store.Set(types.GetTokenizeShareRecordByDenomKey(record.GetShareTokenDenom()), sdk.Uint64ToBigEndian(record.Id))
+}
+
+// DeleteTokenizeShareRecord deletes a tokenize share record and its indexes.
+func (k Keeper) DeleteTokenizeShareRecord(ctx context.Context, record types.TokenizeShareRecord) error {
+	owner, err := k.authKeeper.AddressCodec().StringToBytes(record.Owner)
+	if err != nil {
+		return err
+	}
+
+	store := k.storeService.OpenKVStore(ctx)
+	if err := store.Delete(types.GetTokenizeShareRecordKey(record.Id)); err != nil {
+		return err
+	}
+
+	if err := store.Delete(types.GetTokenizeShareRecordByOwnerKey(owner, record.Id)); err != nil {
+		return err
+	}
+
+	return store.Delete(types.GetTokenizeShareRecordByDenomKey(record.GetShareTokenDenom()))
+}
+
+// GetValidatorLiquidShares returns the delegator shares of a validator which
+// are liquid staked, through tokenize share records.
+func (k Keeper) GetValidatorLiquidShares(ctx context.Context, valAddr sdk.ValAddress) (math.LegacyDec, error) {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get(types.GetValidatorLiquidSharesKey(valAddr))
+	if err != nil {
+		return math.LegacyZeroDec(), err
+	}
+
+	if bz == nil {
+		return math.LegacyZeroDec(), nil
+	}
+
+	dp := sdk.DecProto{}
+	if err := k.cdc.Unmarshal(bz, &dp); err != nil {
+		return math.LegacyZeroDec(), err
+	}
+
+	return dp.Dec, nil
+}
+
+// SetValidatorLiquidShares sets the liquid staked delegator shares of a
+// validator.
+func (k Keeper) SetValidatorLiquidShares(ctx context.Context, valAddr sdk.ValAddress, shares math.LegacyDec) error {
+	store := k.storeService.OpenKVStore(ctx)
+	if shares.IsZero() {
+		return store.Delete(types.GetValidatorLiquidSharesKey(valAddr))
+	}
+
+	bz, err := k.cdc.Marshal(&sdk.DecProto{Dec: shares})
+	if err != nil {
+		return err
+	}
+
+	return store.Set(types.GetValidatorLiquidSharesKey(valAddr), bz)
+}
+
+// GetTotalLiquidStakedTokens returns the amount of tokens liquid staked across
+// all the validators.
+func (k Keeper) GetTotalLiquidStakedTokens(ctx context.Context) (math.Int, error) {
+	store := k.storeService.OpenKVStore(ctx)
+	iterator, err := store.Iterator(types.ValidatorLiquidSharesKey, storetypes.PrefixEndBytes(types.ValidatorLiquidSharesKey))
+	if err != nil {
+		return math.ZeroInt(), err
+	}
+	defer iterator.Close()
+
+	total := math.LegacyZeroDec()
+	for ; iterator.Valid(); iterator.Next() {
+		// key is of format prefix || addrLen (1 byte) || valAddr
+		valAddr := sdk.ValAddress(iterator.Key()[len(types.ValidatorLiquidSharesKey)+1:])
+
+		dp := sdk.DecProto{}
+		if err := k.cdc.Unmarshal(iterator.Value(), &dp); err != nil {
+			return math.ZeroInt(), err
+		}
+
+		validator, err := k.GetValidator(ctx, valAddr)
+		if err != nil {
+			return math.ZeroInt(), err
+		}
+
+		total = total.Add(validator.TokensFromShares(dp.Dec))
+	}
+
+	return total.TruncateInt(), nil
+}
+
+// trackFreeVestingDelegation returns an error if the delegator is a vesting
+// account whose free delegated coins are lower than the tokens to tokenize. The
+// vesting or locked coins of a delegation cannot be tokenized, as the share
+// tokens would not be subject to the vesting schedule. Otherwise, the tokens
+// are removed from the free delegated coins of the vesting account, as they no
+// longer return to it once unbonded.
+func (k Keeper) trackFreeVestingDelegation(ctx context.Context, delAddr sdk.AccAddress, tokens math.Int) error {
+	acc, ok := k.authKeeper.GetAccount(ctx, delAddr).(vestingexported.VestingAccount)
+	if !ok {
+		return nil
+	}
+
+	bondDenom, err := k.BondDenom(ctx)
+	if err != nil {
+		return err
+	}
+
+	delegatedFree := acc.GetDelegatedFree().AmountOf(bondDenom)
+	if delegatedFree.LT(tokens) {
+		return errorsmod.Wrapf(types.ErrExceedingFreeVestingDelegations, "free delegated %s%s is lower than %s%s", delegatedFree, bondDenom, tokens, bondDenom)
+	}
+
+	acc.TrackUndelegation(sdk.NewCoins(sdk.NewCoin(bondDenom, tokens)))
+	k.authKeeper.SetAccount(ctx, acc)
+	return nil
+}
+
+// checkLiquidStakingCaps returns an error if liquid staking shares of a
+// validator would exceed the validator or the global liquid staking cap. A cap
+// of 100% is disabled.
+func (k Keeper) checkLiquidStakingCaps(ctx context.Context, validator types.Validator, valAddr sdk.ValAddress, shares math.LegacyDec) error {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+
+	if params.ValidatorLiquidStakingCap.LT(math.LegacyOneDec()) {
+		liquidShares, err := k.GetValidatorLiquidShares(ctx, valAddr)
+		if err != nil {
+			return err
+		}
+
+		if liquidShares.Add(shares).GT(validator.DelegatorShares.Mul(params.ValidatorLiquidStakingCap)) {
+			return types.ErrValidatorLiquidStakingCapExceeded
+		}
+	}
+
+	if params.GlobalLiquidStakingCap.LT(math.LegacyOneDec()) {
+		totalLiquidStaked, err := k.GetTotalLiquidStakedTokens(ctx)
+		if err != nil {
+			return err
+		}
+
+		totalBonded, err := k.TotalBondedTokens(ctx)
+		if err != nil {
+			return err
+		}
+
+		liquidStaked := math.LegacyNewDecFromInt(totalLiquidStaked).Add(validator.TokensFromShares(shares))
+		if liquidStaked.GT(math.LegacyNewDecFromInt(totalBonded).Mul(params.GlobalLiquidStakingCap)) {
+			return types.ErrGlobalLiquidStakingCapExceeded
+		}
+	}
+
+	return nil
+}
+
+// transferDelegationShares moves delegation shares from a delegator to another,
+// leaving the tokens and shares of the validator untouched.
+func (k Keeper) transferDelegationShares(ctx context.Context, from, to sdk.AccAddress, valAddr sdk.ValAddress, shares math.LegacyDec) error {
+	delegation, err := k.GetDelegation(ctx, from, valAddr)
+	if errors.Is(err, types.ErrNoDelegation) {
+		return types.ErrNoDelegatorForAddress
+	} else if err != nil {
+		return err
+	}
+
+	if delegation.Shares.LT(shares) {
+		return errorsmod.Wrap(types.ErrNotEnoughDelegationShares, delegation.Shares.String())
+	}
+
+	if err := k.Hooks().BeforeDelegationSharesModified(ctx, from, valAddr); err != nil {
+		return err
+	}
+
+	delegation.Shares = delegation.Shares.Sub(shares)
+	if delegation.Shares.IsZero() {
+		err = k.RemoveDelegation(ctx, delegation)
+	} else {
+		if err = k.SetDelegation(ctx, delegation); err != nil {
+			return err
+		}
+		err = k.Hooks().AfterDelegationModified(ctx, from, valAddr)
+	}
+	if err != nil {
+		return err
+	}
+
+	delegation, err = k.GetDelegation(ctx, to, valAddr)
+	if err == nil {
+		err = k.Hooks().BeforeDelegationSharesModified(ctx, to, valAddr)
+	} else if errors.Is(err, types.ErrNoDelegation) {
+		toStr, err1 := k.authKeeper.AddressCodec().BytesToString(to)
+		if err1 != nil {
+			return err1
+		}
+
+		valStr, err1 := k.validatorAddressCodec.BytesToString(valAddr)
+		if err1 != nil {
+			return err1
+		}
+
+		delegation = types.NewDelegation(toStr, valStr, math.LegacyZeroDec())
+		err = k.Hooks().BeforeDelegationCreated(ctx, to, valAddr)
+	}
+	if err != nil {
+		return err
+	}
+
+	delegation.Shares = delegation.Shares.Add(shares)
+	if err := k.SetDelegation(ctx, delegation); err != nil {
+		return err
+	}
+
+	return k.Hooks().AfterDelegationModified(ctx, to, valAddr)
+}
+
+// TokenizeShares moves delegation shares from a delegator to a new tokenize
+// share record, and mints the share tokens representing them to the
+// delegator. The fractional part of the shares is left to the delegator, so
+// that one share token always represents one share.
+func (k Keeper) TokenizeShares(
+	ctx context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, shares math.LegacyDec, owner sdk.AccAddress,
+) (types.TokenizeShareRecord, sdk.Coin, error) {
+	if bytes.Equal(delAddr, valAddr) {
+		return types.TokenizeShareRecord{}, sdk.Coin{}, types.ErrTokenizeSelfDelegation
+	}
+
+	// the shares received by a redelegation still in progress may be slashed for
+	// an infraction of the source validator, so they cannot be tokenized yet
+	inProgress, err := k.HasReceivingRedelegation(ctx, delAddr, valAddr)
+	if err != nil {
+		return types.TokenizeShareRecord{}, sdk.Coin{}, err
+	}
+	if inProgress {
+		return types.TokenizeShareRecord{}, sdk.Coin{}, types.ErrRedelegationInProgress
+	}
+
+	shareAmount := shares.TruncateInt()
+	if !shareAmount.IsPositive() {
+		return types.TokenizeShareRecord{}, sdk.Coin{}, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "too few shares to tokenize")
+	}
+	shares = math.LegacyNewDecFromInt(shareAmount)
+
+	validator, err := k.GetValidator(ctx, valAddr)
+	if err != nil {
+		return types.TokenizeShareRecord{}, sdk.Coin{}, err
+	}
+
+	if err := k.checkLiquidStakingCaps(ctx, validator, valAddr, shares); err != nil {
+		return types.TokenizeShareRecord{}, sdk.Coin{}, err
+	}
+
+	if err := k.trackFreeVestingDelegation(ctx, delAddr, validator.TokensFromShares(shares).TruncateInt()); err != nil {
+		return types.TokenizeShareRecord{}, sdk.Coin{}, err
+	}
+
+	ownerStr, err := k.authKeeper.AddressCodec().BytesToString(owner)
+	if err != nil {
+		return types.TokenizeShareRecord{}, sdk.Coin{}, err
+	}
+
+	id, err := k.GetLastTokenizeShareRecordID(ctx)
+	if err != nil {
+		return types.TokenizeShareRecord{}, sdk.Coin{}, err
+	}
+	id++
+
+	record := types.NewTokenizeShareRecord(id, ownerStr, validator.GetOperator())
+	if err := k.SetLastTokenizeShareRecordID(ctx, id); err != nil {
+		return types.TokenizeShareRecord{}, sdk.Coin{}, err
+	}
+	if err := k.SetTokenizeShareRecord(ctx, record); err != nil {
+		return types.TokenizeShareRecord{}, sdk.Coin{}, err
+	}
+
+	if err := k.transferDelegationShares(ctx, delAddr, record.GetModuleAddress(), valAddr, shares); err != nil {
+		return types.TokenizeShareRecord{}, sdk.Coin{}, err
+	}
+
+	liquidShares, err := k.GetValidatorLiquidShares(ctx, valAddr)
+	if err != nil {
+		return types.TokenizeShareRecord{}, sdk.Coin{}, err
+	}
+	if err := k.SetValidatorLiquidShares(ctx, valAddr, liquidShares.Add(shares)); err != nil {
+		return types.TokenizeShareRecord{}, sdk.Coin{}, err
+	}
+
+	shareToken := sdk.NewCoin(record.GetShareTokenDenom(), shareAmount)
+	if err := k.bankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(shareToken)); err != nil {
+		return types.TokenizeShareRecord{}, sdk.Coin{}, err
+	}
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, delAddr, sdk.NewCoins(shareToken)); err != nil {
+		return types.TokenizeShareRecord{}, sdk.Coin{}, err
+	}
+
+	return record, shareToken, nil
+}
+
+// RedeemTokensForShares burns share tokens of a delegator, and moves the
+// delegation shares they represent from their tokenize share record back to
+// the delegator. It returns the amount of tokens of the shares. Once all its
+// shares are redeemed, the record is deleted, and the balance of its module
+// account, e.g. rewards not withdrawn yet, is sent to the record owner.
+func (k Keeper) RedeemTokensForShares(ctx context.Context, delAddr sdk.AccAddress, shareToken sdk.Coin) (math.Int, error) {
+	record, err := k.GetTokenizeShareRecordByDenom(ctx, shareToken.Denom)
+	if err != nil {
+		return math.ZeroInt(), err
+	}
+
+	valAddr, err := k.validatorAddressCodec.StringToBytes(record.Validator)
+	if err != nil {
+		return math.ZeroInt(), err
+	}
+
+	validator, err := k.GetValidator(ctx, valAddr)
+	if err != nil {
+		return math.ZeroInt(), err
+	}
+
+	shareTokens := sdk.NewCoins(shareToken)
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, delAddr, types.ModuleName, shareTokens); err != nil {
+		return math.ZeroInt(), err
+	}
+	if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, shareTokens); err != nil {
+		return math.ZeroInt(), err
+	}
+
+	shares := math.LegacyNewDecFromInt(shareToken.Amount)
+	recordAddr := record.GetModuleAddress()
+	if err := k.transferDelegationShares(ctx, recordAddr, delAddr, valAddr, shares); err != nil {
+		return math.ZeroInt(), err
+	}
+
+	liquidShares, err := k.GetValidatorLiquidShares(ctx, valAddr)
+	if err != nil {
+		return math.ZeroInt(), err
+	}
+	if err := k.SetValidatorLiquidShares(ctx, valAddr, math.LegacyMaxDec(liquidShares.Sub(shares), math.LegacyZeroDec())); err != nil {
+		return math.ZeroInt(), err
+	}
+
+	_, err = k.GetDelegation(ctx, recordAddr, valAddr)
+	switch {
+	case errors.Is(err, types.ErrNoDelegation):
+		owner, err := k.authKeeper.AddressCodec().StringToBytes(record.Owner)
+		if err != nil {
+			return math.ZeroInt(), err
+		}
+
+		if balance := k.bankKeeper.GetAllBalances(ctx, recordAddr); !balance.IsZero() {
+			if err := k.bankKeeper.SendCoins(ctx, recordAddr, owner, balance); err != nil {
+				return math.ZeroInt(), err
+			}
+		}
+
+		if err := k.DeleteTokenizeShareRecord(ctx, record); err != nil {
+			return math.ZeroInt(), err
+		}
+	case err != nil:
+		return math.ZeroInt(), err
+	}
+
+	return validator.TokensFromShares(shares).TruncateInt(), nil
+}
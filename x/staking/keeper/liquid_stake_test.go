@@ -0,0 +1,242 @@
+package keeper_test
+
+import (
+	"github.com/golang/mock/gomock"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	"github.com/cosmos/cosmos-sdk/x/staking/testutil"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func (s *KeeperTestSuite) TestTokenizeAndRedeemShares() {
+	ctx, keeper := s.ctx, s.stakingKeeper
+	require := s.Require()
+
+	addrs, valAddrs := createValAddrs(3)
+	delAddr, owner := addrs[1], addrs[2]
+
+	startTokens := keeper.TokensFromConsensusPower(ctx, 10)
+	validator := testutil.NewValidator(s.T(), valAddrs[0], PKs[0])
+	validator, issuedShares := validator.AddTokensFromDel(startTokens)
+
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), stakingtypes.NotBondedPoolName, stakingtypes.BondedPoolName, gomock.Any())
+	_ = stakingkeeper.TestingUpdateValidator(keeper, ctx, validator, true)
+
+	delegation := stakingtypes.NewDelegation(delAddr.String(), valAddrs[0].String(), issuedShares)
+	require.NoError(keeper.SetDelegation(ctx, delegation))
+	s.accountKeeper.EXPECT().GetAccount(gomock.Any(), delAddr).Return(authtypes.NewBaseAccountWithAddress(delAddr)).AnyTimes()
+
+	// the self delegation of a validator cannot be tokenized
+	_, _, err := keeper.TokenizeShares(ctx, sdk.AccAddress(valAddrs[0]), valAddrs[0], math.LegacyNewDec(1), owner)
+	require.ErrorIs(err, stakingtypes.ErrTokenizeSelfDelegation)
+
+	// tokenize 6 of the 10 tokens of the delegation
+	tokenized := keeper.TokensFromConsensusPower(ctx, 6)
+	s.bankKeeper.EXPECT().MintCoins(gomock.Any(), stakingtypes.ModuleName, gomock.Any()).Return(nil)
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), stakingtypes.ModuleName, delAddr, gomock.Any()).Return(nil)
+	record, shareToken, err := keeper.TokenizeShares(ctx, delAddr, valAddrs[0], math.LegacyNewDecFromInt(tokenized), owner)
+	require.NoError(err)
+	require.Equal(uint64(1), record.Id)
+	require.Equal(owner.String(), record.Owner)
+	require.Equal(record.GetShareTokenDenom(), shareToken.Denom)
+	require.Equal(tokenized, shareToken.Amount)
+
+	delegation, err = keeper.GetDelegation(ctx, delAddr, valAddrs[0])
+	require.NoError(err)
+	require.Equal(startTokens.Sub(tokenized), delegation.Shares.RoundInt())
+
+	recordDelegation, err := keeper.GetDelegation(ctx, record.GetModuleAddress(), valAddrs[0])
+	require.NoError(err)
+	require.Equal(tokenized, recordDelegation.Shares.RoundInt())
+
+	// the validator tokens are left untouched
+	validator, err = keeper.GetValidator(ctx, valAddrs[0])
+	require.NoError(err)
+	require.Equal(startTokens, validator.BondedTokens())
+
+	totalLiquidStaked, err := keeper.GetTotalLiquidStakedTokens(ctx)
+	require.NoError(err)
+	require.Equal(tokenized, totalLiquidStaked)
+
+	records, err := keeper.GetTokenizeShareRecordsByOwner(ctx, owner)
+	require.NoError(err)
+	require.Equal([]stakingtypes.TokenizeShareRecord{record}, records)
+
+	// redeem part of the share tokens
+	redeemed := keeper.TokensFromConsensusPower(ctx, 2)
+	s.bankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), delAddr, stakingtypes.ModuleName, gomock.Any()).Return(nil).Times(2)
+	s.bankKeeper.EXPECT().BurnCoins(gomock.Any(), stakingtypes.ModuleName, gomock.Any()).Return(nil).Times(2)
+	amount, err := keeper.RedeemTokensForShares(ctx, delAddr, sdk.NewCoin(shareToken.Denom, redeemed))
+	require.NoError(err)
+	require.Equal(redeemed, amount)
+
+	delegation, err = keeper.GetDelegation(ctx, delAddr, valAddrs[0])
+	require.NoError(err)
+	require.Equal(startTokens.Sub(tokenized).Add(redeemed), delegation.Shares.RoundInt())
+
+	// redeem the remaining share tokens, which deletes the record
+	s.bankKeeper.EXPECT().GetAllBalances(gomock.Any(), record.GetModuleAddress()).Return(sdk.NewCoins())
+	_, err = keeper.RedeemTokensForShares(ctx, delAddr, sdk.NewCoin(shareToken.Denom, tokenized.Sub(redeemed)))
+	require.NoError(err)
+
+	delegation, err = keeper.GetDelegation(ctx, delAddr, valAddrs[0])
+	require.NoError(err)
+	require.Equal(startTokens, delegation.Shares.RoundInt())
+
+	_, err = keeper.GetTokenizeShareRecord(ctx, record.Id)
+	require.ErrorIs(err, stakingtypes.ErrTokenizeShareRecordNotFound)
+
+	totalLiquidStaked, err = keeper.GetTotalLiquidStakedTokens(ctx)
+	require.NoError(err)
+	require.True(totalLiquidStaked.IsZero())
+}
+
+func (s *KeeperTestSuite) TestTokenizeSharesLiquidStakingCaps() {
+	ctx, keeper := s.ctx, s.stakingKeeper
+	require := s.Require()
+
+	addrs, valAddrs := createValAddrs(3)
+	delAddr, owner := addrs[1], addrs[2]
+
+	startTokens := keeper.TokensFromConsensusPower(ctx, 10)
+	validator := testutil.NewValidator(s.T(), valAddrs[0], PKs[0])
+	validator, issuedShares := validator.AddTokensFromDel(startTokens)
+
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), stakingtypes.NotBondedPoolName, stakingtypes.BondedPoolName, gomock.Any())
+	_ = stakingkeeper.TestingUpdateValidator(keeper, ctx, validator, true)
+
+	delegation := stakingtypes.NewDelegation(delAddr.String(), valAddrs[0].String(), issuedShares)
+	require.NoError(keeper.SetDelegation(ctx, delegation))
+	s.accountKeeper.EXPECT().GetAccount(gomock.Any(), delAddr).Return(authtypes.NewBaseAccountWithAddress(delAddr)).AnyTimes()
+
+	params, err := keeper.GetParams(ctx)
+	require.NoError(err)
+
+	testCases := []struct {
+		name         string
+		globalCap    math.LegacyDec
+		validatorCap math.LegacyDec
+		expErr       error
+	}{
+		{
+			name:         "validator cap exceeded",
+			globalCap:    math.LegacyOneDec(),
+			validatorCap: math.LegacyNewDecWithPrec(5, 1),
+			expErr:       stakingtypes.ErrValidatorLiquidStakingCapExceeded,
+		},
+		{
+			name:         "global cap exceeded",
+			globalCap:    math.LegacyNewDecWithPrec(5, 1),
+			validatorCap: math.LegacyOneDec(),
+			expErr:       stakingtypes.ErrGlobalLiquidStakingCapExceeded,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			params.GlobalLiquidStakingCap = tc.globalCap
+			params.ValidatorLiquidStakingCap = tc.validatorCap
+			require.NoError(keeper.SetParams(ctx, params))
+
+			s.bankKeeper.EXPECT().GetBalance(gomock.Any(), bondedAcc.GetAddress(), sdk.DefaultBondDenom).Return(sdk.NewCoin(sdk.DefaultBondDenom, startTokens)).AnyTimes()
+			s.accountKeeper.EXPECT().GetModuleAccount(gomock.Any(), stakingtypes.BondedPoolName).Return(bondedAcc).AnyTimes()
+
+			_, _, err := keeper.TokenizeShares(ctx, delAddr, valAddrs[0], math.LegacyNewDecFromInt(keeper.TokensFromConsensusPower(ctx, 6)), owner)
+			require.ErrorIs(err, tc.expErr)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestTokenizeSharesVestingAccount() {
+	ctx, keeper := s.ctx, s.stakingKeeper
+	require := s.Require()
+
+	addrs, valAddrs := createValAddrs(3)
+	delAddr, owner := addrs[1], addrs[2]
+
+	startTokens := keeper.TokensFromConsensusPower(ctx, 10)
+	validator := testutil.NewValidator(s.T(), valAddrs[0], PKs[0])
+	validator, issuedShares := validator.AddTokensFromDel(startTokens)
+
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), stakingtypes.NotBondedPoolName, stakingtypes.BondedPoolName, gomock.Any())
+	_ = stakingkeeper.TestingUpdateValidator(keeper, ctx, validator, true)
+
+	delegation := stakingtypes.NewDelegation(delAddr.String(), valAddrs[0].String(), issuedShares)
+	require.NoError(keeper.SetDelegation(ctx, delegation))
+
+	// 6 of the 10 delegated tokens are still vesting
+	vestingTokens := keeper.TokensFromConsensusPower(ctx, 6)
+	blockTime := ctx.BlockTime()
+	acc, err := vestingtypes.NewContinuousVestingAccount(
+		authtypes.NewBaseAccountWithAddress(delAddr),
+		sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, vestingTokens)),
+		blockTime.Unix(), blockTime.AddDate(1, 0, 0).Unix(),
+	)
+	require.NoError(err)
+	acc.TrackDelegation(blockTime, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, startTokens)), sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, startTokens)))
+	s.accountKeeper.EXPECT().GetAccount(gomock.Any(), delAddr).Return(acc).AnyTimes()
+
+	// the vesting delegated tokens cannot be tokenized
+	_, _, err = keeper.TokenizeShares(ctx, delAddr, valAddrs[0], math.LegacyNewDecFromInt(keeper.TokensFromConsensusPower(ctx, 5)), owner)
+	require.ErrorIs(err, stakingtypes.ErrExceedingFreeVestingDelegations)
+
+	// the free delegated tokens can
+	freeTokens := startTokens.Sub(vestingTokens)
+	s.bankKeeper.EXPECT().MintCoins(gomock.Any(), stakingtypes.ModuleName, gomock.Any()).Return(nil)
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), stakingtypes.ModuleName, delAddr, gomock.Any()).Return(nil)
+	s.accountKeeper.EXPECT().SetAccount(gomock.Any(), acc)
+	_, shareToken, err := keeper.TokenizeShares(ctx, delAddr, valAddrs[0], math.LegacyNewDecFromInt(freeTokens), owner)
+	require.NoError(err)
+	require.Equal(freeTokens, shareToken.Amount)
+
+	// the tokenized tokens are no longer free delegated tokens
+	require.True(acc.GetDelegatedFree().IsZero())
+	_, _, err = keeper.TokenizeShares(ctx, delAddr, valAddrs[0], math.LegacyNewDecFromInt(keeper.TokensFromConsensusPower(ctx, 1)), owner)
+	require.ErrorIs(err, stakingtypes.ErrExceedingFreeVestingDelegations)
+}
+
+func (s *KeeperTestSuite) TestTokenizeSharesRedelegationInProgress() {
+	ctx, keeper := s.ctx, s.stakingKeeper
+	require := s.Require()
+
+	addrs, valAddrs := createValAddrs(4)
+	delAddr, owner := addrs[2], addrs[3]
+	s.accountKeeper.EXPECT().GetAccount(gomock.Any(), delAddr).Return(authtypes.NewBaseAccountWithAddress(delAddr)).AnyTimes()
+
+	startTokens := keeper.TokensFromConsensusPower(ctx, 10)
+	var issuedShares math.LegacyDec
+	for i := 0; i < 2; i++ {
+		validator := testutil.NewValidator(s.T(), valAddrs[i], PKs[i])
+		validator, issuedShares = validator.AddTokensFromDel(startTokens)
+		s.bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), stakingtypes.NotBondedPoolName, stakingtypes.BondedPoolName, gomock.Any())
+		validator = stakingkeeper.TestingUpdateValidator(keeper, ctx, validator, true)
+		require.Equal(stakingtypes.Bonded, validator.Status)
+	}
+
+	delegation := stakingtypes.NewDelegation(delAddr.String(), valAddrs[0].String(), issuedShares)
+	require.NoError(keeper.SetDelegation(ctx, delegation))
+
+	redelegated := math.LegacyNewDecFromInt(keeper.TokensFromConsensusPower(ctx, 5))
+	completionTime, err := keeper.BeginRedelegation(ctx, delAddr, valAddrs[0], valAddrs[1], redelegated)
+	require.NoError(err)
+
+	// the redelegated shares cannot be tokenized while the redelegation is in progress
+	_, _, err = keeper.TokenizeShares(ctx, delAddr, valAddrs[1], redelegated, owner)
+	require.ErrorIs(err, stakingtypes.ErrRedelegationInProgress)
+
+	// once the redelegation completed, they can
+	ctx = ctx.WithBlockTime(completionTime)
+	_, err = keeper.CompleteRedelegation(ctx, delAddr, valAddrs[0], valAddrs[1])
+	require.NoError(err)
+
+	s.bankKeeper.EXPECT().MintCoins(gomock.Any(), stakingtypes.ModuleName, gomock.Any()).Return(nil)
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), stakingtypes.ModuleName, delAddr, gomock.Any()).Return(nil)
+	_, shareToken, err := keeper.TokenizeShares(ctx, delAddr, valAddrs[1], redelegated, owner)
+	require.NoError(err)
+	require.Equal(redelegated.TruncateInt(), shareToken.Amount)
+}
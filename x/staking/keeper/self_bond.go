@@ -0,0 +1,79 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// ValidatorSelfBondLimit returns the tokens self-delegated by the operator of
+// a validator, and the maximum amount of tokens which can be delegated to the
+// validator under the minimum self-bond ratio. The maximum is zero when the
+// ratio is disabled.
+func (k Keeper) ValidatorSelfBondLimit(ctx context.Context, validator types.Validator) (selfBond, maxDelegated math.Int, err error) {
+	valAddr, err := k.validatorAddressCodec.StringToBytes(validator.GetOperator())
+	if err != nil {
+		return math.Int{}, math.Int{}, err
+	}
+
+	selfBond = math.ZeroInt()
+	delegation, err := k.GetDelegation(ctx, sdk.AccAddress(valAddr), valAddr)
+	switch {
+	case err == nil:
+		selfBond = validator.TokensFromShares(delegation.Shares).TruncateInt()
+	case !errors.Is(err, types.ErrNoDelegation):
+		return math.Int{}, math.Int{}, err
+	}
+
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return math.Int{}, math.Int{}, err
+	}
+
+	if params.MinSelfBondRatio.IsZero() {
+		return selfBond, math.ZeroInt(), nil
+	}
+
+	return selfBond, math.LegacyNewDecFromInt(selfBond).Quo(params.MinSelfBondRatio).TruncateInt(), nil
+}
+
+// CheckSelfBondRatio returns an error if delegating the given amount of tokens
+// to a validator would bring its self-bond below the minimum self-bond ratio.
+// Self-delegations of the validator operator are always allowed.
+func (k Keeper) CheckSelfBondRatio(ctx context.Context, delAddr sdk.AccAddress, validator types.Validator, amount math.Int) error {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+
+	if params.MinSelfBondRatio.IsZero() {
+		return nil
+	}
+
+	valAddr, err := k.validatorAddressCodec.StringToBytes(validator.GetOperator())
+	if err != nil {
+		return err
+	}
+
+	if delAddr.Equals(sdk.AccAddress(valAddr)) {
+		return nil
+	}
+
+	selfBond, maxDelegated, err := k.ValidatorSelfBondLimit(ctx, validator)
+	if err != nil {
+		return err
+	}
+
+	if validator.Tokens.Add(amount).GT(maxDelegated) {
+		return errorsmod.Wrapf(
+			types.ErrSelfBondRatioExceeded, "self-bond of %s allows at most %s delegated tokens", selfBond, maxDelegated,
+		)
+	}
+
+	return nil
+}
@@ -0,0 +1,76 @@
+package keeper_test
+
+import (
+	"github.com/golang/mock/gomock"
+
+	"cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func (s *KeeperTestSuite) TestMinSelfBondRatio() {
+	ctx, keeper, msgServer, queryClient := s.ctx, s.stakingKeeper, s.msgServer, s.queryClient
+	require := s.Require()
+	s.execExpectCalls()
+
+	delAddr := sdk.AccAddress(PKs[1].Address())
+	s.bankKeeper.EXPECT().DelegateCoinsFromAccountToModule(gomock.Any(), delAddr, stakingtypes.NotBondedPoolName, gomock.Any()).AnyTimes()
+
+	comm := stakingtypes.NewCommissionRates(math.LegacyZeroDec(), math.LegacyZeroDec(), math.LegacyZeroDec())
+	msg, err := stakingtypes.NewMsgCreateValidator(
+		ValAddr.String(), ed25519.GenPrivKey().PubKey(), sdk.NewInt64Coin(sdk.DefaultBondDenom, 10), stakingtypes.Description{Moniker: "NewVal"}, comm, math.OneInt(),
+	)
+	require.NoError(err)
+	_, err = msgServer.CreateValidator(ctx, msg)
+	require.NoError(err)
+
+	params, err := keeper.GetParams(ctx)
+	require.NoError(err)
+	params.MinSelfBondRatio = math.LegacyNewDecWithPrec(5, 1)
+	require.NoError(keeper.SetParams(ctx, params))
+
+	res, err := queryClient.ValidatorSelfBondLimit(ctx, &stakingtypes.QueryValidatorSelfBondLimitRequest{ValidatorAddr: ValAddr.String()})
+	require.NoError(err)
+	require.Equal(math.NewInt(10), res.SelfBondTokens)
+	require.Equal(math.NewInt(10), res.DelegatedTokens)
+	require.Equal(math.NewInt(20), res.MaxDelegatedTokens)
+	require.Equal(params.MinSelfBondRatio, res.MinSelfBondRatio)
+
+	// delegations are allowed up to the limit
+	_, err = msgServer.Delegate(ctx, stakingtypes.NewMsgDelegate(delAddr.String(), ValAddr.String(), sdk.NewInt64Coin(sdk.DefaultBondDenom, 10)))
+	require.NoError(err)
+
+	_, err = msgServer.Delegate(ctx, stakingtypes.NewMsgDelegate(delAddr.String(), ValAddr.String(), sdk.NewInt64Coin(sdk.DefaultBondDenom, 1)))
+	require.ErrorIs(err, stakingtypes.ErrSelfBondRatioExceeded)
+
+	// the operator can always self-delegate, raising the limit
+	_, err = msgServer.Delegate(ctx, stakingtypes.NewMsgDelegate(Addr.String(), ValAddr.String(), sdk.NewInt64Coin(sdk.DefaultBondDenom, 10)))
+	require.NoError(err)
+
+	res, err = queryClient.ValidatorSelfBondLimit(ctx, &stakingtypes.QueryValidatorSelfBondLimitRequest{ValidatorAddr: ValAddr.String()})
+	require.NoError(err)
+	require.Equal(math.NewInt(20), res.SelfBondTokens)
+	require.Equal(math.NewInt(30), res.DelegatedTokens)
+	require.Equal(math.NewInt(40), res.MaxDelegatedTokens)
+
+	// redelegations are subject to the limit of the destination validator
+	valAddr2 := sdk.ValAddress(delAddr)
+	msg, err = stakingtypes.NewMsgCreateValidator(
+		valAddr2.String(), ed25519.GenPrivKey().PubKey(), sdk.NewInt64Coin(sdk.DefaultBondDenom, 10), stakingtypes.Description{Moniker: "NewVal2"}, comm, math.OneInt(),
+	)
+	require.NoError(err)
+	_, err = msgServer.CreateValidator(ctx, msg)
+	require.NoError(err)
+
+	_, err = msgServer.BeginRedelegate(ctx, stakingtypes.NewMsgBeginRedelegate(Addr.String(), ValAddr.String(), valAddr2.String(), sdk.NewInt64Coin(sdk.DefaultBondDenom, 11)))
+	require.ErrorIs(err, stakingtypes.ErrSelfBondRatioExceeded)
+
+	// disabling the ratio removes the limit
+	params.MinSelfBondRatio = math.LegacyZeroDec()
+	require.NoError(keeper.SetParams(ctx, params))
+
+	_, err = msgServer.Delegate(ctx, stakingtypes.NewMsgDelegate(delAddr.String(), ValAddr.String(), sdk.NewInt64Coin(sdk.DefaultBondDenom, 100)))
+	require.NoError(err)
+}
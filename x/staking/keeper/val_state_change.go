@@ -145,6 +145,18 @@ func (k Keeper) ApplyAndReturnValidatorSetUpdates(ctx context.Context) (updates
 		return nil, err
 	}
 
+	// Retrieve the consensus public key rotations of this block, and whether
+	// the rotated validators were part of the last validator set.
+	rotations, err := k.GetBlockConsPubKeyRotationHistory(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rotatedWasBonded := make([]bool, len(rotations))
+	for i, rotation := range rotations {
+		_, rotatedWasBonded[i] = last[rotation.OperatorAddress]
+	}
+
 	// Iterate over validators, highest power to lowest.
 	iterator, err := k.ValidatorsPowerStoreIterator(ctx)
 	if err != nil {
@@ -235,6 +247,13 @@ func (k Keeper) ApplyAndReturnValidatorSetUpdates(ctx context.Context) (updates
 		updates = append(updates, validator.ABCIValidatorUpdateZero())
 	}
 
+	// Replace the updates of the validators which rotated their consensus
+	// public key, so that CometBFT swaps the old key for the new one.
+	updates, err = k.applyConsPubKeyRotationUpdates(ctx, updates, rotations, rotatedWasBonded)
+	if err != nil {
+		return nil, err
+	}
+
 	// Update the pools based on the recent updates in the validator set:
 	// - The tokens from the non-bonded candidates that enter the new validator set need to be transferred
 	// to the Bonded pool.
@@ -52,7 +52,18 @@ func (k Keeper) GetValidatorByConsAddr(ctx context.Context, consAddr sdk.ConsAdd
 	}
 
 	if opAddr == nil {
-		return validator, types.ErrNoValidatorFound
+		// the consensus address may have been rotated, in which case the
+		// validator is found by its current consensus address
+		newConsAddr, err := k.ValidatorIdentifier(ctx, consAddr)
+		if err != nil {
+			return validator, err
+		}
+
+		if newConsAddr.Equals(consAddr) {
+			return validator, types.ErrNoValidatorFound
+		}
+
+		return k.GetValidatorByConsAddr(ctx, newConsAddr)
 	}
 
 	return k.GetValidator(ctx, opAddr)
@@ -0,0 +1,44 @@
+package v6_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/testutil"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+	v6 "github.com/cosmos/cosmos-sdk/x/staking/migrations/v6"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func TestMigrateStore(t *testing.T) {
+	cdc := moduletestutil.MakeTestEncodingConfig(staking.AppModuleBasic{}).Codec
+	stakingKey := storetypes.NewKVStoreKey(v6.ModuleName)
+	ctx := testutil.DefaultContext(stakingKey, storetypes.NewTransientStoreKey("transient_test"))
+	store := ctx.KVStore(stakingKey)
+
+	// params without the liquid staking caps and the key rotation fee
+	defaultParams := types.DefaultParams()
+	params := types.Params{
+		UnbondingTime:     defaultParams.UnbondingTime,
+		MaxValidators:     42,
+		MaxEntries:        defaultParams.MaxEntries,
+		HistoricalEntries: defaultParams.HistoricalEntries,
+		BondDenom:         defaultParams.BondDenom,
+		MinCommissionRate: defaultParams.MinCommissionRate,
+	}
+	store.Set(v6.ParamsKey, cdc.MustMarshal(&params))
+
+	require.NoError(t, v6.MigrateStore(ctx, store, cdc))
+
+	var res types.Params
+	require.NoError(t, cdc.Unmarshal(store.Get(v6.ParamsKey), &res))
+	require.Equal(t, uint32(42), res.MaxValidators)
+	require.Equal(t, types.DefaultGlobalLiquidStakingCap, res.GlobalLiquidStakingCap)
+	require.Equal(t, types.DefaultValidatorLiquidStakingCap, res.ValidatorLiquidStakingCap)
+	require.Equal(t, types.DefaultKeyRotationFee, res.KeyRotationFee)
+	require.Equal(t, types.DefaultMinSelfBondRatio, res.MinSelfBondRatio)
+}
@@ -55,7 +55,11 @@ func RandomizedGenState(simState *module.SimulationState) {
 	// NOTE: the slashing module need to be defined after the staking module on the
 	// NewSimulationManager constructor for this to work
 	simState.UnbondTime = unbondTime
-	params := types.NewParams(simState.UnbondTime, maxVals, 7, histEntries, simState.BondDenom, minCommissionRate)
+	params := types.NewParams(
+		simState.UnbondTime, maxVals, 7, histEntries, simState.BondDenom, minCommissionRate,
+		types.DefaultGlobalLiquidStakingCap, types.DefaultValidatorLiquidStakingCap,
+		sdk.NewCoin(simState.BondDenom, types.DefaultKeyRotationFee.Amount), types.DefaultMinSelfBondRatio,
+	)
 
 	// validators & delegations
 	var (
@@ -56,6 +56,16 @@ var (
 	ParamsKey = []byte{0x51} // prefix for parameters for module x/staking
 
 	DelegationByValIndexKey = []byte{0x71} // key for delegations by a validator
+
+	TokenizeShareRecordKey        = []byte{0x81} // prefix for each key to a tokenize share record
+	TokenizeShareRecordByOwnerKey = []byte{0x82} // prefix for each key to a tokenize share record index, by owner
+	TokenizeShareRecordByDenomKey = []byte{0x83} // prefix for each key to a tokenize share record index, by share token denom
+	LastTokenizeShareRecordIDKey  = []byte{0x84} // key for the id of the last tokenize share record
+	ValidatorLiquidSharesKey      = []byte{0x85} // prefix for the liquid staked shares of each validator
+
+	ConsPubKeyRotationHistoryKey = []byte{0x91} // prefix for the consensus public key rotations, by height
+	OldToNewConsAddrKey          = []byte{0x92} // prefix for each key to the consensus address that replaced a rotated one
+	ValidatorLastRotationKey     = []byte{0x93} // prefix for the time of the last consensus public key rotation of each validator
 )
 
 // UnbondingType defines the type of unbonding operation
@@ -423,3 +433,56 @@ func GetHistoricalInfoKey(height int64) []byte {
 	binary.BigEndian.PutUint64(heightBytes, uint64(height))
 	return append(HistoricalInfoKey, heightBytes...)
 }
+
+// GetTokenizeShareRecordKey returns the key of a tokenize share record.
+func GetTokenizeShareRecordKey(id uint64) []byte {
+	return append(TokenizeShareRecordKey, sdk.Uint64ToBigEndian(id)...)
+}
+
+// GetTokenizeShareRecordsByOwnerKey returns the key prefix of the tokenize
+// share records of an owner.
+func GetTokenizeShareRecordsByOwnerKey(owner sdk.AccAddress) []byte {
+	return append(TokenizeShareRecordByOwnerKey, address.MustLengthPrefix(owner)...)
+}
+
+// GetTokenizeShareRecordByOwnerKey returns the key of a tokenize share record,
+// indexed by owner.
+func GetTokenizeShareRecordByOwnerKey(owner sdk.AccAddress, id uint64) []byte {
+	return append(GetTokenizeShareRecordsByOwnerKey(owner), sdk.Uint64ToBigEndian(id)...)
+}
+
+// GetTokenizeShareRecordByDenomKey returns the key of a tokenize share record,
+// indexed by share token denom.
+func GetTokenizeShareRecordByDenomKey(denom string) []byte {
+	return append(TokenizeShareRecordByDenomKey, []byte(denom)...)
+}
+
+// GetValidatorLiquidSharesKey returns the key of the liquid staked shares of a
+// validator.
+func GetValidatorLiquidSharesKey(valAddr sdk.ValAddress) []byte {
+	return append(ValidatorLiquidSharesKey, address.MustLengthPrefix(valAddr)...)
+}
+
+// GetConsPubKeyRotationHistoriesKey returns the key prefix of the consensus
+// public key rotations performed at a height.
+func GetConsPubKeyRotationHistoriesKey(height int64) []byte {
+	return append(ConsPubKeyRotationHistoryKey, sdk.Uint64ToBigEndian(uint64(height))...)
+}
+
+// GetConsPubKeyRotationHistoryKey returns the key of the consensus public key
+// rotation of a validator at a height.
+func GetConsPubKeyRotationHistoryKey(height int64, valAddr sdk.ValAddress) []byte {
+	return append(GetConsPubKeyRotationHistoriesKey(height), address.MustLengthPrefix(valAddr)...)
+}
+
+// GetOldToNewConsAddrKey returns the key of the consensus address that
+// replaced a rotated consensus address.
+func GetOldToNewConsAddrKey(oldConsAddr sdk.ConsAddress) []byte {
+	return append(OldToNewConsAddrKey, address.MustLengthPrefix(oldConsAddr)...)
+}
+
+// GetValidatorLastRotationKey returns the key of the time of the last
+// consensus public key rotation of a validator.
+func GetValidatorLastRotationKey(valAddr sdk.ValAddress) []byte {
+	return append(ValidatorLastRotationKey, address.MustLengthPrefix(valAddr)...)
+}
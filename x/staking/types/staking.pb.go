@@ -932,6 +932,20 @@ type Params struct {
 	BondDenom string `protobuf:"bytes,5,opt,name=bond_denom,json=bondDenom,proto3" json:"bond_denom,omitempty"`
 	// min_commission_rate is the chain-wide minimum commission rate that a validator can charge their delegators
 	MinCommissionRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,6,opt,name=min_commission_rate,json=minCommissionRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"min_commission_rate" yaml:"min_commission_rate"`
+	// global_liquid_staking_cap is the maximum fraction of the total bonded tokens
+	// which can be liquid staked, through tokenized delegation shares.
+	GlobalLiquidStakingCap cosmossdk_io_math.LegacyDec `protobuf:"bytes,7,opt,name=global_liquid_staking_cap,json=globalLiquidStakingCap,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"global_liquid_staking_cap"`
+	// validator_liquid_staking_cap is the maximum fraction of the delegator shares
+	// of a validator which can be liquid staked, through tokenized delegation
+	// shares.
+	ValidatorLiquidStakingCap cosmossdk_io_math.LegacyDec `protobuf:"bytes,8,opt,name=validator_liquid_staking_cap,json=validatorLiquidStakingCap,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"validator_liquid_staking_cap"`
+	// key_rotation_fee is the fee paid by a validator to rotate its consensus
+	// public key.
+	KeyRotationFee types2.Coin `protobuf:"bytes,9,opt,name=key_rotation_fee,json=keyRotationFee,proto3" json:"key_rotation_fee"`
+	// min_self_bond_ratio is the minimum fraction of the tokens delegated to a
+	// validator which must be self-delegated by the validator operator.
+	// Delegations and redelegations breaking it are rejected. Zero disables it.
+	MinSelfBondRatio cosmossdk_io_math.LegacyDec `protobuf:"bytes,10,opt,name=min_self_bond_ratio,json=minSelfBondRatio,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"min_self_bond_ratio"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -1002,6 +1016,89 @@ func (m *Params) GetBondDenom() string {
 	return ""
 }
 
+func (m *Params) GetKeyRotationFee() types2.Coin {
+	if m != nil {
+		return m.KeyRotationFee
+	}
+	return types2.Coin{}
+}
+
+// TokenizeShareRecord represents the delegation shares tokenized into a
+// transferable bank denom. The tokenized shares are delegated by the record
+// module account, and the rewards they accrue go to the record owner.
+type TokenizeShareRecord struct {
+	// id is the unique id of the record.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// owner is the address receiving the rewards of the tokenized shares.
+	Owner string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	// module_account is the name of the module account delegating the tokenized
+	// shares.
+	ModuleAccount string `protobuf:"bytes,3,opt,name=module_account,json=moduleAccount,proto3" json:"module_account,omitempty"`
+	// validator is the address of the validator of the tokenized shares.
+	Validator string `protobuf:"bytes,4,opt,name=validator,proto3" json:"validator,omitempty"`
+}
+
+func (m *TokenizeShareRecord) Reset()         { *m = TokenizeShareRecord{} }
+func (m *TokenizeShareRecord) String() string { return proto.CompactTextString(m) }
+func (*TokenizeShareRecord) ProtoMessage()    {}
+func (*TokenizeShareRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_64c30c6cf92913c9, []int{16}
+}
+func (m *TokenizeShareRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TokenizeShareRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TokenizeShareRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TokenizeShareRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TokenizeShareRecord.Merge(m, src)
+}
+func (m *TokenizeShareRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *TokenizeShareRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_TokenizeShareRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TokenizeShareRecord proto.InternalMessageInfo
+
+func (m *TokenizeShareRecord) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *TokenizeShareRecord) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *TokenizeShareRecord) GetModuleAccount() string {
+	if m != nil {
+		return m.ModuleAccount
+	}
+	return ""
+}
+
+func (m *TokenizeShareRecord) GetValidator() string {
+	if m != nil {
+		return m.Validator
+	}
+	return ""
+}
+
 // DelegationResponse is equivalent to Delegation except that it contains a
 // balance in addition to shares which is more suitable for client responses.
 type DelegationResponse struct {
@@ -1013,7 +1110,7 @@ func (m *DelegationResponse) Reset()         { *m = DelegationResponse{} }
 func (m *DelegationResponse) String() string { return proto.CompactTextString(m) }
 func (*DelegationResponse) ProtoMessage()    {}
 func (*DelegationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_64c30c6cf92913c9, []int{16}
+	return fileDescriptor_64c30c6cf92913c9, []int{17}
 }
 func (m *DelegationResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1068,7 +1165,7 @@ func (m *RedelegationEntryResponse) Reset()         { *m = RedelegationEntryResp
 func (m *RedelegationEntryResponse) String() string { return proto.CompactTextString(m) }
 func (*RedelegationEntryResponse) ProtoMessage()    {}
 func (*RedelegationEntryResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_64c30c6cf92913c9, []int{17}
+	return fileDescriptor_64c30c6cf92913c9, []int{18}
 }
 func (m *RedelegationEntryResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1116,7 +1213,7 @@ func (m *RedelegationResponse) Reset()         { *m = RedelegationResponse{} }
 func (m *RedelegationResponse) String() string { return proto.CompactTextString(m) }
 func (*RedelegationResponse) ProtoMessage()    {}
 func (*RedelegationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_64c30c6cf92913c9, []int{18}
+	return fileDescriptor_64c30c6cf92913c9, []int{19}
 }
 func (m *RedelegationResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1170,7 +1267,7 @@ func (m *Pool) Reset()         { *m = Pool{} }
 func (m *Pool) String() string { return proto.CompactTextString(m) }
 func (*Pool) ProtoMessage()    {}
 func (*Pool) Descriptor() ([]byte, []int) {
-	return fileDescriptor_64c30c6cf92913c9, []int{19}
+	return fileDescriptor_64c30c6cf92913c9, []int{20}
 }
 func (m *Pool) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1209,7 +1306,7 @@ func (m *ValidatorUpdates) Reset()         { *m = ValidatorUpdates{} }
 func (m *ValidatorUpdates) String() string { return proto.CompactTextString(m) }
 func (*ValidatorUpdates) ProtoMessage()    {}
 func (*ValidatorUpdates) Descriptor() ([]byte, []int) {
-	return fileDescriptor_64c30c6cf92913c9, []int{20}
+	return fileDescriptor_64c30c6cf92913c9, []int{21}
 }
 func (m *ValidatorUpdates) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1245,6 +1342,56 @@ func (m *ValidatorUpdates) GetUpdates() []types3.ValidatorUpdate {
 	return nil
 }
 
+// ConsPubKeyRotationHistory records the rotation of the consensus public key
+// of a validator.
+type ConsPubKeyRotationHistory struct {
+	// operator_address is the address of the validator.
+	OperatorAddress string `protobuf:"bytes,1,opt,name=operator_address,json=operatorAddress,proto3" json:"operator_address,omitempty"`
+	// old_cons_pubkey is the consensus public key used before the rotation.
+	OldConsPubkey *types1.Any `protobuf:"bytes,2,opt,name=old_cons_pubkey,json=oldConsPubkey,proto3" json:"old_cons_pubkey,omitempty"`
+	// new_cons_pubkey is the consensus public key used after the rotation.
+	NewConsPubkey *types1.Any `protobuf:"bytes,3,opt,name=new_cons_pubkey,json=newConsPubkey,proto3" json:"new_cons_pubkey,omitempty"`
+	// height is the block height at which the key was rotated.
+	Height int64 `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
+	// time is the block time at which the key was rotated.
+	Time time.Time `protobuf:"bytes,5,opt,name=time,proto3,stdtime" json:"time"`
+	// fee is the rotation fee paid by the validator.
+	Fee types2.Coin `protobuf:"bytes,6,opt,name=fee,proto3" json:"fee"`
+}
+
+func (m *ConsPubKeyRotationHistory) Reset()         { *m = ConsPubKeyRotationHistory{} }
+func (m *ConsPubKeyRotationHistory) String() string { return proto.CompactTextString(m) }
+func (*ConsPubKeyRotationHistory) ProtoMessage()    {}
+func (*ConsPubKeyRotationHistory) Descriptor() ([]byte, []int) {
+	return fileDescriptor_64c30c6cf92913c9, []int{22}
+}
+func (m *ConsPubKeyRotationHistory) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConsPubKeyRotationHistory) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConsPubKeyRotationHistory.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ConsPubKeyRotationHistory) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConsPubKeyRotationHistory.Merge(m, src)
+}
+func (m *ConsPubKeyRotationHistory) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConsPubKeyRotationHistory) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConsPubKeyRotationHistory.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConsPubKeyRotationHistory proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterEnum("cosmos.staking.v1beta1.BondStatus", BondStatus_name, BondStatus_value)
 	proto.RegisterEnum("cosmos.staking.v1beta1.Infraction", Infraction_name, Infraction_value)
@@ -1264,11 +1411,13 @@ func init() {
 	proto.RegisterType((*RedelegationEntry)(nil), "cosmos.staking.v1beta1.RedelegationEntry")
 	proto.RegisterType((*Redelegation)(nil), "cosmos.staking.v1beta1.Redelegation")
 	proto.RegisterType((*Params)(nil), "cosmos.staking.v1beta1.Params")
+	proto.RegisterType((*TokenizeShareRecord)(nil), "cosmos.staking.v1beta1.TokenizeShareRecord")
 	proto.RegisterType((*DelegationResponse)(nil), "cosmos.staking.v1beta1.DelegationResponse")
 	proto.RegisterType((*RedelegationEntryResponse)(nil), "cosmos.staking.v1beta1.RedelegationEntryResponse")
 	proto.RegisterType((*RedelegationResponse)(nil), "cosmos.staking.v1beta1.RedelegationResponse")
 	proto.RegisterType((*Pool)(nil), "cosmos.staking.v1beta1.Pool")
 	proto.RegisterType((*ValidatorUpdates)(nil), "cosmos.staking.v1beta1.ValidatorUpdates")
+	proto.RegisterType((*ConsPubKeyRotationHistory)(nil), "cosmos.staking.v1beta1.ConsPubKeyRotationHistory")
 }
 
 func init() {
@@ -1276,126 +1425,143 @@ func init() {
 }
 
 var fileDescriptor_64c30c6cf92913c9 = []byte{
-	// 1901 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x58, 0x4d, 0x6c, 0x63, 0x47,
-	0x1d, 0xcf, 0x8b, 0x53, 0x27, 0xf9, 0x3b, 0x89, 0x9d, 0xd9, 0x2f, 0xaf, 0x97, 0xc6, 0x5e, 0x77,
-	0xa1, 0xe9, 0xd2, 0xd8, 0x6c, 0x90, 0x7a, 0x08, 0x08, 0x14, 0xc7, 0xde, 0xae, 0x4b, 0x9b, 0x04,
-	0x3b, 0x09, 0x94, 0xaf, 0xa7, 0xf1, 0x7b, 0x13, 0x67, 0x88, 0x3d, 0xcf, 0xbc, 0x19, 0x2f, 0xf1,
-	0x9d, 0x43, 0x15, 0x84, 0x54, 0x09, 0x09, 0x21, 0xc1, 0x8a, 0x95, 0xb8, 0x94, 0x5b, 0x0f, 0x2b,
-	0xee, 0xdc, 0x0a, 0x12, 0xd2, 0x6a, 0x4f, 0x08, 0x89, 0x80, 0x76, 0x0f, 0xad, 0xe0, 0x82, 0x38,
-	0x71, 0x44, 0xf3, 0xf1, 0x3e, 0x1c, 0x27, 0x9b, 0xcd, 0xb6, 0x42, 0x15, 0x5c, 0x9e, 0xde, 0xcc,
-	0xfc, 0xff, 0xbf, 0x99, 0xff, 0xef, 0xff, 0x31, 0x1f, 0x70, 0xc3, 0xf1, 0x78, 0xd7, 0xe3, 0x65,
-	0x2e, 0xf0, 0x3e, 0x65, 0xed, 0xf2, 0xdd, 0x5b, 0x2d, 0x22, 0xf0, 0xad, 0xa0, 0x5d, 0xea, 0xf9,
-	0x9e, 0xf0, 0xd0, 0x65, 0x2d, 0x55, 0x0a, 0x7a, 0x8d, 0x54, 0xee, 0x62, 0xdb, 0x6b, 0x7b, 0x4a,
-	0xa4, 0x2c, 0xff, 0xb4, 0x74, 0xee, 0x6a, 0xdb, 0xf3, 0xda, 0x1d, 0x52, 0x56, 0xad, 0x56, 0x7f,
-	0xb7, 0x8c, 0xd9, 0xc0, 0x0c, 0x2d, 0x1c, 0x1f, 0x72, 0xfb, 0x3e, 0x16, 0xd4, 0x63, 0x66, 0x3c,
-	0x7f, 0x7c, 0x5c, 0xd0, 0x2e, 0xe1, 0x02, 0x77, 0x7b, 0x01, 0xb6, 0x5e, 0x89, 0xad, 0x27, 0x35,
-	0xcb, 0x32, 0xd8, 0xc6, 0x94, 0x16, 0xe6, 0x24, 0xb4, 0xc3, 0xf1, 0x68, 0x80, 0x3d, 0x8f, 0xbb,
-	0x94, 0x79, 0x65, 0xf5, 0x35, 0x5d, 0x9f, 0x11, 0x84, 0xb9, 0xc4, 0xef, 0x52, 0x26, 0xca, 0x62,
-	0xd0, 0x23, 0x5c, 0x7f, 0xcd, 0xe8, 0xb5, 0xd8, 0x28, 0x6e, 0x39, 0x34, 0x3e, 0x58, 0xfc, 0xa9,
-	0x05, 0x73, 0x77, 0x28, 0x17, 0x9e, 0x4f, 0x1d, 0xdc, 0xa9, 0xb3, 0x5d, 0x0f, 0x7d, 0x09, 0x92,
-	0x7b, 0x04, 0xbb, 0xc4, 0xcf, 0x5a, 0x05, 0x6b, 0x31, 0xb5, 0x9c, 0x2d, 0x45, 0x00, 0x25, 0xad,
-	0x7b, 0x47, 0x8d, 0x57, 0xa6, 0x3f, 0x38, 0xca, 0x8f, 0xbd, 0xf7, 0xe1, 0xfb, 0x37, 0xad, 0x86,
-	0x51, 0x41, 0x55, 0x48, 0xde, 0xc5, 0x1d, 0x4e, 0x44, 0x76, 0xbc, 0x90, 0x58, 0x4c, 0x2d, 0x5f,
-	0x2f, 0x9d, 0xcc, 0x79, 0x69, 0x07, 0x77, 0xa8, 0x8b, 0x85, 0x37, 0x8c, 0xa2, 0x75, 0x8b, 0x3f,
-	0x1b, 0x87, 0xf4, 0x9a, 0xd7, 0xed, 0x52, 0xce, 0xa9, 0xc7, 0x1a, 0x58, 0x10, 0x8e, 0xde, 0x80,
-	0x09, 0x1f, 0x0b, 0xa2, 0x16, 0x35, 0x5d, 0x79, 0x4d, 0x2a, 0xfd, 0xf9, 0x28, 0x7f, 0x4d, 0xc3,
-	0x73, 0x77, 0xbf, 0x44, 0xbd, 0x72, 0x17, 0x8b, 0xbd, 0xd2, 0x9b, 0xa4, 0x8d, 0x9d, 0x41, 0x95,
-	0x38, 0x8f, 0x1e, 0x2c, 0x81, 0x99, 0xbd, 0x4a, 0x1c, 0x3d, 0x83, 0xc2, 0x40, 0x5f, 0x87, 0xa9,
-	0x2e, 0x3e, 0xb0, 0x15, 0xde, 0xf8, 0xc7, 0xc2, 0x9b, 0xec, 0xe2, 0x03, 0xb9, 0x3e, 0xf4, 0x3d,
-	0x48, 0x4b, 0x48, 0x67, 0x0f, 0xb3, 0x36, 0xd1, 0xc8, 0x89, 0x8f, 0x85, 0x3c, 0xdb, 0xc5, 0x07,
-	0x6b, 0x0a, 0x4d, 0xe2, 0xaf, 0x4c, 0x7c, 0x74, 0x3f, 0x6f, 0x15, 0x7f, 0x67, 0x01, 0x44, 0xc4,
-	0x20, 0x0c, 0x19, 0x27, 0x6c, 0xa9, 0x49, 0xb9, 0x71, 0xda, 0xcb, 0xa7, 0xf1, 0x7e, 0x8c, 0xd6,
-	0xca, 0xac, 0x5c, 0xde, 0xc3, 0xa3, 0xbc, 0xa5, 0x67, 0x4d, 0x3b, 0x23, 0xb4, 0xa7, 0xfa, 0x3d,
-	0x17, 0x0b, 0x62, 0xcb, 0x18, 0x56, 0x6c, 0xa5, 0x96, 0x73, 0x25, 0x1d, 0xe0, 0xa5, 0x20, 0xc0,
-	0x4b, 0x5b, 0x41, 0x80, 0x6b, 0xc0, 0x77, 0xff, 0x1a, 0x00, 0x82, 0xd6, 0x96, 0xe3, 0xc6, 0x86,
-	0xf7, 0x2c, 0x48, 0x55, 0x09, 0x77, 0x7c, 0xda, 0x93, 0x29, 0x83, 0xb2, 0x30, 0xd9, 0xf5, 0x18,
-	0xdd, 0x37, 0x01, 0x37, 0xdd, 0x08, 0x9a, 0x28, 0x07, 0x53, 0xd4, 0x25, 0x4c, 0x50, 0x31, 0xd0,
-	0x6e, 0x6a, 0x84, 0x6d, 0xa9, 0xf5, 0x43, 0xd2, 0xe2, 0x34, 0xe0, 0xb9, 0x11, 0x34, 0xd1, 0x2b,
-	0x90, 0xe1, 0xc4, 0xe9, 0xfb, 0x54, 0x0c, 0x6c, 0xc7, 0x63, 0x02, 0x3b, 0x22, 0x3b, 0xa1, 0x44,
-	0xd2, 0x41, 0xff, 0x9a, 0xee, 0x96, 0x20, 0x2e, 0x11, 0x98, 0x76, 0x78, 0xf6, 0x05, 0x0d, 0x62,
-	0x9a, 0x66, 0xa9, 0x87, 0x93, 0x30, 0x1d, 0x06, 0x2a, 0x5a, 0x83, 0x8c, 0xd7, 0x23, 0xbe, 0xfc,
-	0xb7, 0xb1, 0xeb, 0xfa, 0x84, 0x73, 0x13, 0x8d, 0xd9, 0x47, 0x0f, 0x96, 0x2e, 0x1a, 0xc2, 0x57,
-	0xf5, 0x48, 0x53, 0xf8, 0x94, 0xb5, 0x1b, 0xe9, 0x40, 0xc3, 0x74, 0xa3, 0xb7, 0xa5, 0xcb, 0x18,
-	0x27, 0x8c, 0xf7, 0xb9, 0xdd, 0xeb, 0xb7, 0xf6, 0xc9, 0xc0, 0x90, 0x7a, 0x71, 0x84, 0xd4, 0x55,
-	0x36, 0xa8, 0x64, 0xff, 0x10, 0x41, 0x3b, 0xfe, 0xa0, 0x27, 0xbc, 0xd2, 0x66, 0xbf, 0xf5, 0x35,
-	0x32, 0x90, 0xae, 0x32, 0x38, 0x9b, 0x0a, 0x06, 0x5d, 0x86, 0xe4, 0xf7, 0x31, 0xed, 0x10, 0x57,
-	0x31, 0x32, 0xd5, 0x30, 0x2d, 0xb4, 0x02, 0x49, 0x2e, 0xb0, 0xe8, 0x73, 0x45, 0xc3, 0xdc, 0x72,
-	0xf1, 0xb4, 0xd8, 0xa8, 0x78, 0xcc, 0x6d, 0x2a, 0xc9, 0x86, 0xd1, 0x40, 0x6b, 0x90, 0x14, 0xde,
-	0x3e, 0x61, 0x86, 0xa0, 0xca, 0xe7, 0x4d, 0x34, 0x5f, 0x1a, 0x8d, 0xe6, 0x3a, 0x13, 0xb1, 0x38,
-	0xae, 0x33, 0xd1, 0x30, 0xaa, 0xe8, 0x3b, 0x90, 0x71, 0x49, 0x87, 0xb4, 0x15, 0x73, 0x7c, 0x0f,
-	0xfb, 0x84, 0x67, 0x93, 0x0a, 0xee, 0xd6, 0xb9, 0x93, 0xa3, 0x91, 0x0e, 0xa1, 0x9a, 0x0a, 0x09,
-	0x6d, 0x42, 0xca, 0x8d, 0xc2, 0x29, 0x3b, 0xa9, 0xc8, 0x7c, 0xe9, 0x34, 0x1b, 0x63, 0x91, 0x17,
-	0xaf, 0x3c, 0x71, 0x08, 0x19, 0x41, 0x7d, 0xd6, 0xf2, 0x98, 0x4b, 0x59, 0xdb, 0xde, 0x23, 0xb4,
-	0xbd, 0x27, 0xb2, 0x53, 0x05, 0x6b, 0x31, 0xd1, 0x48, 0x87, 0xfd, 0x77, 0x54, 0x37, 0xda, 0x84,
-	0xb9, 0x48, 0x54, 0x65, 0xc8, 0xf4, 0x79, 0x33, 0x64, 0x36, 0x04, 0x90, 0x22, 0xe8, 0x2d, 0x80,
-	0x28, 0x07, 0xb3, 0xa0, 0xd0, 0x8a, 0x67, 0x67, 0x73, 0xdc, 0x98, 0x18, 0x00, 0xfa, 0x36, 0x5c,
-	0xe8, 0x52, 0x66, 0x73, 0xd2, 0xd9, 0xb5, 0x0d, 0x73, 0x12, 0x37, 0x75, 0x7e, 0x6f, 0xce, 0x77,
-	0x29, 0x6b, 0x92, 0xce, 0x6e, 0x35, 0x44, 0x41, 0x5f, 0x86, 0x6b, 0x91, 0xf5, 0x1e, 0xb3, 0xf7,
-	0xbc, 0x8e, 0x6b, 0xfb, 0x64, 0xd7, 0x76, 0xbc, 0x3e, 0x13, 0xd9, 0x19, 0xc5, 0xd9, 0x95, 0x50,
-	0x64, 0x83, 0xdd, 0xf1, 0x3a, 0x6e, 0x83, 0xec, 0xae, 0xc9, 0x61, 0xf4, 0x12, 0x44, 0xa6, 0xdb,
-	0xd4, 0xe5, 0xd9, 0xd9, 0x42, 0x62, 0x71, 0xa2, 0x31, 0x13, 0x76, 0xd6, 0x5d, 0xbe, 0x32, 0xf5,
-	0xce, 0xfd, 0xfc, 0xd8, 0x47, 0xf7, 0xf3, 0x63, 0xc5, 0xdb, 0x30, 0xb3, 0x83, 0x3b, 0x26, 0x8f,
-	0x08, 0x47, 0xaf, 0xc1, 0x34, 0x0e, 0x1a, 0x59, 0xab, 0x90, 0x78, 0x6a, 0x1e, 0x46, 0xa2, 0xc5,
-	0xdf, 0x58, 0x90, 0xac, 0xee, 0x6c, 0x62, 0xea, 0xa3, 0x1a, 0xcc, 0x47, 0x81, 0xf9, 0xac, 0x29,
-	0x1d, 0xc5, 0x72, 0x90, 0xd3, 0xeb, 0x30, 0x7f, 0x37, 0xa8, 0x12, 0x21, 0x8c, 0xde, 0x57, 0xae,
-	0x3f, 0x7a, 0xb0, 0xf4, 0xa2, 0x81, 0x09, 0x2b, 0xc9, 0x31, 0xbc, 0xbb, 0xc7, 0xfa, 0x63, 0x36,
-	0xbf, 0x01, 0x93, 0x7a, 0xa9, 0x1c, 0x7d, 0x15, 0x5e, 0xe8, 0xc9, 0x1f, 0x65, 0x6a, 0x6a, 0x79,
-	0xe1, 0xd4, 0x00, 0x57, 0xf2, 0xf1, 0x70, 0xd0, 0x7a, 0xc5, 0x1f, 0x8f, 0x03, 0x54, 0x77, 0x76,
-	0xb6, 0x7c, 0xda, 0xeb, 0x10, 0xf1, 0x49, 0xd9, 0xbe, 0x0d, 0x97, 0x22, 0xdb, 0xb9, 0xef, 0x9c,
-	0xdf, 0xfe, 0x0b, 0xa1, 0x7e, 0xd3, 0x77, 0x4e, 0x84, 0x75, 0xb9, 0x08, 0x61, 0x13, 0xe7, 0x87,
-	0xad, 0x72, 0x31, 0xca, 0xec, 0x37, 0x21, 0x15, 0x91, 0xc1, 0x51, 0x1d, 0xa6, 0x84, 0xf9, 0x37,
-	0x04, 0x17, 0x4f, 0x27, 0x38, 0x50, 0x8b, 0x93, 0x1c, 0xaa, 0x17, 0xff, 0x6d, 0x01, 0xc4, 0x72,
-	0xe4, 0xd3, 0x19, 0x63, 0xa8, 0x0e, 0x49, 0x53, 0x89, 0x13, 0xcf, 0x5b, 0x89, 0x0d, 0x40, 0x8c,
-	0xd4, 0x9f, 0x8c, 0xc3, 0x85, 0xed, 0x20, 0x7b, 0x3f, 0xfd, 0x1c, 0x6c, 0xc3, 0x24, 0x61, 0xc2,
-	0xa7, 0x8a, 0x04, 0xe9, 0xf3, 0x2f, 0x9c, 0xe6, 0xf3, 0x13, 0x8c, 0xaa, 0x31, 0xe1, 0x0f, 0xe2,
-	0x11, 0x10, 0x60, 0xc5, 0xf8, 0xf8, 0x45, 0x02, 0xb2, 0xa7, 0xa9, 0xa2, 0x97, 0x21, 0xed, 0xf8,
-	0x44, 0x75, 0x04, 0x9b, 0x8c, 0xa5, 0x0a, 0xe6, 0x5c, 0xd0, 0x6d, 0xf6, 0x98, 0x06, 0xc8, 0x53,
-	0x99, 0x0c, 0x2e, 0x29, 0xfa, 0x7c, 0xc7, 0xb0, 0xb9, 0x08, 0x41, 0xed, 0x32, 0x5b, 0x90, 0xa6,
-	0x8c, 0x0a, 0x8a, 0x3b, 0x76, 0x0b, 0x77, 0x30, 0x73, 0x82, 0xe3, 0xea, 0xb9, 0xb6, 0x84, 0x39,
-	0x83, 0x51, 0xd1, 0x10, 0xa8, 0x06, 0x93, 0x01, 0xda, 0xc4, 0xf9, 0xd1, 0x02, 0x5d, 0x74, 0x1d,
-	0x66, 0xe2, 0x1b, 0x83, 0x3a, 0x7a, 0x4c, 0x34, 0x52, 0xb1, 0x7d, 0xe1, 0xac, 0x9d, 0x27, 0xf9,
-	0xd4, 0x9d, 0xc7, 0x9c, 0xee, 0x7e, 0x95, 0x80, 0xf9, 0x06, 0x71, 0xff, 0xf7, 0xdd, 0xb2, 0x09,
-	0xa0, 0x53, 0x55, 0x56, 0x52, 0xe3, 0x99, 0xe7, 0xc8, 0xf7, 0x69, 0x0d, 0x52, 0xe5, 0xe2, 0xbf,
-	0xe5, 0xa1, 0xbf, 0x8c, 0xc3, 0x4c, 0xdc, 0x43, 0xff, 0x97, 0x9b, 0x16, 0x5a, 0x8f, 0xca, 0xd4,
-	0x84, 0x2a, 0x53, 0xaf, 0x9c, 0x56, 0xa6, 0x46, 0xa2, 0xf9, 0x8c, 0xfa, 0xf4, 0xcb, 0x04, 0x24,
-	0x37, 0xb1, 0x8f, 0xbb, 0x1c, 0x6d, 0x8c, 0x1c, 0x64, 0xf5, 0x45, 0xf2, 0xea, 0x48, 0x30, 0x57,
-	0xcd, 0x5b, 0x87, 0x8e, 0xe5, 0x9f, 0x9f, 0x76, 0x8e, 0xfd, 0x2c, 0xcc, 0xc9, 0x0b, 0x71, 0x68,
-	0x90, 0x26, 0x77, 0x56, 0xdd, 0x6b, 0x43, 0xeb, 0x39, 0xca, 0x43, 0x4a, 0x8a, 0x45, 0x75, 0x58,
-	0xca, 0x40, 0x17, 0x1f, 0xd4, 0x74, 0x0f, 0x5a, 0x02, 0xb4, 0x17, 0x3e, 0x50, 0xd8, 0x11, 0x11,
-	0x52, 0x6e, 0x3e, 0x1a, 0x09, 0xc4, 0x5f, 0x04, 0x90, 0xab, 0xb0, 0x5d, 0xc2, 0xbc, 0xae, 0xb9,
-	0xd5, 0x4d, 0xcb, 0x9e, 0xaa, 0xec, 0x40, 0x3f, 0xb2, 0xf4, 0x79, 0xf8, 0xd8, 0xb5, 0xd9, 0x5c,
-	0x47, 0xb6, 0x9e, 0x21, 0x29, 0xfe, 0x75, 0x94, 0xcf, 0x0d, 0x70, 0xb7, 0xb3, 0x52, 0x3c, 0x01,
-	0xa7, 0x78, 0xd2, 0x4d, 0x5e, 0x1e, 0x9c, 0x87, 0xaf, 0xdd, 0x2b, 0x37, 0x64, 0x78, 0x1f, 0x7e,
-	0xf8, 0xfe, 0x4d, 0x33, 0xd3, 0x12, 0x77, 0xf7, 0xcb, 0x07, 0xe1, 0xf3, 0x95, 0xf6, 0x89, 0x3c,
-	0xa9, 0xa2, 0x68, 0xd7, 0x68, 0x10, 0xde, 0x93, 0x37, 0x3e, 0x79, 0x43, 0x88, 0x9d, 0xe4, 0xad,
-	0xa7, 0xdf, 0x10, 0x22, 0xfd, 0xa1, 0x1b, 0x42, 0x2c, 0xa7, 0xbe, 0x12, 0x15, 0xed, 0x71, 0xe3,
-	0x72, 0x83, 0xd5, 0xc2, 0x9c, 0xc4, 0xae, 0x1a, 0x74, 0x08, 0x22, 0x50, 0x52, 0xa9, 0x3a, 0x56,
-	0xfc, 0xa3, 0x05, 0x57, 0x47, 0xc2, 0x2f, 0x5c, 0xb2, 0x03, 0xc8, 0x8f, 0x0d, 0x2a, 0x37, 0x0e,
-	0xcc, 0xd2, 0x9f, 0x2f, 0x9a, 0xe7, 0xfd, 0x91, 0xca, 0xfd, 0xc9, 0xec, 0x3e, 0xa6, 0xf4, 0xfc,
-	0xde, 0x82, 0x8b, 0xf1, 0x05, 0x84, 0xa6, 0x34, 0x61, 0x26, 0x3e, 0xb5, 0x31, 0xe2, 0xc6, 0xb3,
-	0x18, 0x11, 0x5f, 0xff, 0x10, 0x08, 0xda, 0x89, 0x52, 0x5c, 0xbf, 0x9b, 0xdd, 0x7a, 0x66, 0x52,
-	0x82, 0x85, 0x9d, 0x98, 0xea, 0xda, 0x37, 0xff, 0xb0, 0x60, 0x62, 0xd3, 0xf3, 0x3a, 0xe8, 0x07,
-	0x30, 0xcf, 0x3c, 0x61, 0xcb, 0x74, 0x20, 0xae, 0x6d, 0x2e, 0xf6, 0xba, 0x7c, 0xd6, 0x9e, 0xca,
-	0xd5, 0xdf, 0x8f, 0xf2, 0xa3, 0x9a, 0xc3, 0x04, 0x9a, 0xf7, 0x23, 0xe6, 0x89, 0x8a, 0x12, 0xda,
-	0xd2, 0x77, 0xff, 0x5d, 0x98, 0x1d, 0x9e, 0x4e, 0x97, 0xd8, 0xd5, 0xb3, 0xa6, 0x9b, 0x3d, 0x73,
-	0xaa, 0x99, 0x56, 0x6c, 0x9e, 0x95, 0x29, 0xe9, 0xb5, 0x7f, 0x4a, 0xcf, 0xbd, 0x0d, 0x99, 0xb0,
-	0xbe, 0x6c, 0xab, 0xc7, 0x27, 0x2e, 0x43, 0x43, 0xbf, 0x43, 0x05, 0xa7, 0xfb, 0x42, 0xfc, 0x51,
-	0x13, 0xb7, 0x1c, 0x5a, 0x3a, 0xa6, 0x33, 0x44, 0xa7, 0xd1, 0xbd, 0xf9, 0x5b, 0x0b, 0x20, 0x7a,
-	0x24, 0x41, 0xaf, 0xc2, 0x95, 0xca, 0xc6, 0x7a, 0xd5, 0x6e, 0x6e, 0xad, 0x6e, 0x6d, 0x37, 0xed,
-	0xed, 0xf5, 0xe6, 0x66, 0x6d, 0xad, 0x7e, 0xbb, 0x5e, 0xab, 0x66, 0xc6, 0x72, 0xe9, 0xc3, 0x7b,
-	0x85, 0xd4, 0x36, 0xe3, 0x3d, 0xe2, 0xd0, 0x5d, 0x4a, 0x5c, 0xf4, 0x39, 0xb8, 0x38, 0x2c, 0x2d,
-	0x5b, 0xb5, 0x6a, 0xc6, 0xca, 0xcd, 0x1c, 0xde, 0x2b, 0x4c, 0xe9, 0x73, 0x22, 0x71, 0xd1, 0x22,
-	0x5c, 0x1a, 0x95, 0xab, 0xaf, 0xbf, 0x9e, 0x19, 0xcf, 0xcd, 0x1e, 0xde, 0x2b, 0x4c, 0x87, 0x07,
-	0x4a, 0x54, 0x04, 0x14, 0x97, 0x34, 0x78, 0x89, 0x1c, 0x1c, 0xde, 0x2b, 0x24, 0xb5, 0x17, 0x72,
-	0x13, 0xef, 0xfc, 0x7a, 0x61, 0xec, 0xe6, 0x77, 0x01, 0xea, 0x6c, 0xd7, 0xc7, 0x8e, 0x8a, 0xb6,
-	0x1c, 0x5c, 0xae, 0xaf, 0xdf, 0x6e, 0xac, 0xae, 0x6d, 0xd5, 0x37, 0xd6, 0x87, 0x97, 0x7d, 0x6c,
-	0xac, 0xba, 0xb1, 0x5d, 0x79, 0xb3, 0x66, 0x37, 0xeb, 0xaf, 0xaf, 0x67, 0x2c, 0x74, 0x05, 0x2e,
-	0x0c, 0x8d, 0x7d, 0x63, 0x7d, 0xab, 0xfe, 0x56, 0x2d, 0x33, 0x5e, 0xb9, 0xfd, 0xc1, 0xe3, 0x05,
-	0xeb, 0xe1, 0xe3, 0x05, 0xeb, 0x6f, 0x8f, 0x17, 0xac, 0x77, 0x9f, 0x2c, 0x8c, 0x3d, 0x7c, 0xb2,
-	0x30, 0xf6, 0xa7, 0x27, 0x0b, 0x63, 0xdf, 0x7a, 0xb5, 0x4d, 0xc5, 0x5e, 0xbf, 0x55, 0x72, 0xbc,
-	0xae, 0x79, 0xe6, 0x2e, 0x9f, 0x58, 0xf1, 0xd4, 0xbb, 0x72, 0x2b, 0xa9, 0x36, 0x99, 0x2f, 0xfe,
-	0x27, 0x00, 0x00, 0xff, 0xff, 0x7a, 0x6b, 0x77, 0xd8, 0xcf, 0x17, 0x00, 0x00,
+	// 2174 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x59, 0x4d, 0x6c, 0x1b, 0xc7,
+	0xf5, 0xd7, 0x92, 0x34, 0x25, 0x3d, 0x4a, 0x22, 0x35, 0xfe, 0xa2, 0xe8, 0x44, 0x92, 0x19, 0xff,
+	0xff, 0x71, 0xdc, 0x98, 0xaa, 0x5d, 0xc0, 0x07, 0xf5, 0x23, 0x10, 0x45, 0x39, 0x66, 0xea, 0xc8,
+	0xea, 0x52, 0x52, 0x9b, 0x7e, 0x2d, 0x86, 0xbb, 0x43, 0x6a, 0xab, 0xe5, 0x0e, 0xbd, 0x33, 0xb4,
+	0xcd, 0x9e, 0x7b, 0x08, 0x54, 0x14, 0x08, 0x50, 0xa0, 0x28, 0x50, 0x18, 0x35, 0xd0, 0x4b, 0x72,
+	0x0b, 0x0a, 0xa3, 0xd7, 0xa2, 0xe8, 0x25, 0x2d, 0x50, 0xc0, 0xf0, 0xa9, 0x28, 0x50, 0xb7, 0xb0,
+	0x0f, 0x09, 0xda, 0x4b, 0xd1, 0x53, 0x8f, 0xc5, 0x7c, 0xec, 0x07, 0x45, 0xc9, 0x92, 0xac, 0xa0,
+	0x08, 0xda, 0x0b, 0xb1, 0x33, 0xf3, 0xde, 0x6f, 0xe6, 0xbd, 0x79, 0xef, 0xcd, 0x7b, 0x8f, 0x70,
+	0xc1, 0xa6, 0xac, 0x43, 0xd9, 0x02, 0xe3, 0x78, 0xdb, 0xf5, 0xdb, 0x0b, 0x77, 0xae, 0x34, 0x09,
+	0xc7, 0x57, 0xc2, 0x71, 0xa5, 0x1b, 0x50, 0x4e, 0xd1, 0x19, 0x45, 0x55, 0x09, 0x67, 0x35, 0x55,
+	0xe9, 0x54, 0x9b, 0xb6, 0xa9, 0x24, 0x59, 0x10, 0x5f, 0x8a, 0xba, 0x34, 0xd3, 0xa6, 0xb4, 0xed,
+	0x91, 0x05, 0x39, 0x6a, 0xf6, 0x5a, 0x0b, 0xd8, 0xef, 0xeb, 0xa5, 0xd9, 0xdd, 0x4b, 0x4e, 0x2f,
+	0xc0, 0xdc, 0xa5, 0xbe, 0x5e, 0x9f, 0xdb, 0xbd, 0xce, 0xdd, 0x0e, 0x61, 0x1c, 0x77, 0xba, 0x21,
+	0xb6, 0x3a, 0x89, 0xa5, 0x36, 0xd5, 0xc7, 0xd2, 0xd8, 0x5a, 0x94, 0x26, 0x66, 0x24, 0x92, 0xc3,
+	0xa6, 0x6e, 0x88, 0x3d, 0x8d, 0x3b, 0xae, 0x4f, 0x17, 0xe4, 0xaf, 0x9e, 0x7a, 0x89, 0x13, 0xdf,
+	0x21, 0x41, 0xc7, 0xf5, 0xf9, 0x02, 0xef, 0x77, 0x09, 0x53, 0xbf, 0x7a, 0xf5, 0x5c, 0x62, 0x15,
+	0x37, 0x6d, 0x37, 0xb9, 0x58, 0xfe, 0xb1, 0x01, 0x53, 0x37, 0x5c, 0xc6, 0x69, 0xe0, 0xda, 0xd8,
+	0xab, 0xfb, 0x2d, 0x8a, 0xbe, 0x08, 0xd9, 0x2d, 0x82, 0x1d, 0x12, 0x14, 0x8d, 0x79, 0xe3, 0x62,
+	0xee, 0x6a, 0xb1, 0x12, 0x03, 0x54, 0x14, 0xef, 0x0d, 0xb9, 0x5e, 0x1d, 0xff, 0xe8, 0xc9, 0xdc,
+	0xc8, 0xfb, 0x1f, 0x7f, 0x78, 0xc9, 0x30, 0x35, 0x0b, 0xaa, 0x41, 0xf6, 0x0e, 0xf6, 0x18, 0xe1,
+	0xc5, 0xd4, 0x7c, 0xfa, 0x62, 0xee, 0xea, 0xf9, 0xca, 0xde, 0x3a, 0xaf, 0x6c, 0x62, 0xcf, 0x75,
+	0x30, 0xa7, 0x83, 0x28, 0x8a, 0xb7, 0xfc, 0x93, 0x14, 0xe4, 0x97, 0x69, 0xa7, 0xe3, 0x32, 0xe6,
+	0x52, 0xdf, 0xc4, 0x9c, 0x30, 0xf4, 0x16, 0x64, 0x02, 0xcc, 0x89, 0x3c, 0xd4, 0x78, 0xf5, 0x9a,
+	0x60, 0xfa, 0xd3, 0x93, 0xb9, 0x73, 0x0a, 0x9e, 0x39, 0xdb, 0x15, 0x97, 0x2e, 0x74, 0x30, 0xdf,
+	0xaa, 0xdc, 0x24, 0x6d, 0x6c, 0xf7, 0x6b, 0xc4, 0x7e, 0xfc, 0xf0, 0x32, 0xe8, 0xdd, 0x6b, 0xc4,
+	0x56, 0x3b, 0x48, 0x0c, 0xf4, 0x35, 0x18, 0xeb, 0xe0, 0x7b, 0x96, 0xc4, 0x4b, 0x1d, 0x0b, 0x6f,
+	0xb4, 0x83, 0xef, 0x89, 0xf3, 0xa1, 0xef, 0x42, 0x5e, 0x40, 0xda, 0x5b, 0xd8, 0x6f, 0x13, 0x85,
+	0x9c, 0x3e, 0x16, 0xf2, 0x64, 0x07, 0xdf, 0x5b, 0x96, 0x68, 0x02, 0x7f, 0x31, 0xf3, 0xc9, 0x83,
+	0x39, 0xa3, 0xfc, 0x1b, 0x03, 0x20, 0x56, 0x0c, 0xc2, 0x50, 0xb0, 0xa3, 0x91, 0xdc, 0x94, 0xe9,
+	0x4b, 0x7b, 0x75, 0x3f, 0xbd, 0xef, 0x52, 0x6b, 0x75, 0x52, 0x1c, 0xef, 0xd1, 0x93, 0x39, 0x43,
+	0xed, 0x9a, 0xb7, 0x87, 0xd4, 0x9e, 0xeb, 0x75, 0x1d, 0xcc, 0x89, 0x25, 0x6c, 0x58, 0x6a, 0x2b,
+	0x77, 0xb5, 0x54, 0x51, 0x06, 0x5e, 0x09, 0x0d, 0xbc, 0xb2, 0x1e, 0x1a, 0xb8, 0x02, 0x7c, 0xef,
+	0x2f, 0x21, 0x20, 0x28, 0x6e, 0xb1, 0xae, 0x65, 0x78, 0xdf, 0x80, 0x5c, 0x8d, 0x30, 0x3b, 0x70,
+	0xbb, 0xc2, 0x65, 0x50, 0x11, 0x46, 0x3b, 0xd4, 0x77, 0xb7, 0xb5, 0xc1, 0x8d, 0x9b, 0xe1, 0x10,
+	0x95, 0x60, 0xcc, 0x75, 0x88, 0xcf, 0x5d, 0xde, 0x57, 0xd7, 0x64, 0x46, 0x63, 0xc1, 0x75, 0x97,
+	0x34, 0x99, 0x1b, 0xea, 0xd9, 0x0c, 0x87, 0xe8, 0x35, 0x28, 0x30, 0x62, 0xf7, 0x02, 0x97, 0xf7,
+	0x2d, 0x9b, 0xfa, 0x1c, 0xdb, 0xbc, 0x98, 0x91, 0x24, 0xf9, 0x70, 0x7e, 0x59, 0x4d, 0x0b, 0x10,
+	0x87, 0x70, 0xec, 0x7a, 0xac, 0x78, 0x42, 0x81, 0xe8, 0xa1, 0x3e, 0xea, 0xce, 0x28, 0x8c, 0x47,
+	0x86, 0x8a, 0x96, 0xa1, 0x40, 0xbb, 0x24, 0x10, 0xdf, 0x16, 0x76, 0x9c, 0x80, 0x30, 0xa6, 0xad,
+	0xb1, 0xf8, 0xf8, 0xe1, 0xe5, 0x53, 0x5a, 0xe1, 0x4b, 0x6a, 0xa5, 0xc1, 0x03, 0xd7, 0x6f, 0x9b,
+	0xf9, 0x90, 0x43, 0x4f, 0xa3, 0x77, 0xc4, 0x95, 0xf9, 0x8c, 0xf8, 0xac, 0xc7, 0xac, 0x6e, 0xaf,
+	0xb9, 0x4d, 0xfa, 0x5a, 0xa9, 0xa7, 0x86, 0x94, 0xba, 0xe4, 0xf7, 0xab, 0xc5, 0xdf, 0xc7, 0xd0,
+	0x76, 0xd0, 0xef, 0x72, 0x5a, 0x59, 0xeb, 0x35, 0xbf, 0x4a, 0xfa, 0x66, 0x3e, 0xc2, 0x59, 0x93,
+	0x30, 0xe8, 0x0c, 0x64, 0xbf, 0x87, 0x5d, 0x8f, 0x38, 0x52, 0x23, 0x63, 0xa6, 0x1e, 0xa1, 0x45,
+	0xc8, 0x32, 0x8e, 0x79, 0x8f, 0x49, 0x35, 0x4c, 0x5d, 0x2d, 0xef, 0x67, 0x1b, 0x55, 0xea, 0x3b,
+	0x0d, 0x49, 0x69, 0x6a, 0x0e, 0xb4, 0x0c, 0x59, 0x4e, 0xb7, 0x89, 0xaf, 0x15, 0x54, 0xfd, 0x9c,
+	0xb6, 0xe6, 0xd3, 0xc3, 0xd6, 0x5c, 0xf7, 0x79, 0xc2, 0x8e, 0xeb, 0x3e, 0x37, 0x35, 0x2b, 0xfa,
+	0x36, 0x14, 0x1c, 0xe2, 0x91, 0xb6, 0xd4, 0x1c, 0xdb, 0xc2, 0x01, 0x61, 0xc5, 0xac, 0x84, 0xbb,
+	0x72, 0x64, 0xe7, 0x30, 0xf3, 0x11, 0x54, 0x43, 0x22, 0xa1, 0x35, 0xc8, 0x39, 0xb1, 0x39, 0x15,
+	0x47, 0xa5, 0x32, 0x5f, 0xd9, 0x4f, 0xc6, 0x84, 0xe5, 0x25, 0x23, 0x4f, 0x12, 0x42, 0x58, 0x50,
+	0xcf, 0x6f, 0x52, 0xdf, 0x71, 0xfd, 0xb6, 0xb5, 0x45, 0xdc, 0xf6, 0x16, 0x2f, 0x8e, 0xcd, 0x1b,
+	0x17, 0xd3, 0x66, 0x3e, 0x9a, 0xbf, 0x21, 0xa7, 0xd1, 0x1a, 0x4c, 0xc5, 0xa4, 0xd2, 0x43, 0xc6,
+	0x8f, 0xea, 0x21, 0x93, 0x11, 0x80, 0x20, 0x41, 0x6f, 0x03, 0xc4, 0x3e, 0x58, 0x04, 0x89, 0x56,
+	0x3e, 0xd8, 0x9b, 0x93, 0xc2, 0x24, 0x00, 0xd0, 0xb7, 0xe0, 0x64, 0xc7, 0xf5, 0x2d, 0x46, 0xbc,
+	0x96, 0xa5, 0x35, 0x27, 0x70, 0x73, 0x47, 0xbf, 0xcd, 0xe9, 0x8e, 0xeb, 0x37, 0x88, 0xd7, 0xaa,
+	0x45, 0x28, 0xe8, 0x4b, 0x70, 0x2e, 0x96, 0x9e, 0xfa, 0xd6, 0x16, 0xf5, 0x1c, 0x2b, 0x20, 0x2d,
+	0xcb, 0xa6, 0x3d, 0x9f, 0x17, 0x27, 0xa4, 0xce, 0xce, 0x46, 0x24, 0xb7, 0xfc, 0x1b, 0xd4, 0x73,
+	0x4c, 0xd2, 0x5a, 0x16, 0xcb, 0xe8, 0x15, 0x88, 0x45, 0xb7, 0x5c, 0x87, 0x15, 0x27, 0xe7, 0xd3,
+	0x17, 0x33, 0xe6, 0x44, 0x34, 0x59, 0x77, 0xd8, 0xe2, 0xd8, 0xbb, 0x0f, 0xe6, 0x46, 0x3e, 0x79,
+	0x30, 0x37, 0x52, 0xbe, 0x0e, 0x13, 0x9b, 0xd8, 0xd3, 0x7e, 0x44, 0x18, 0xba, 0x06, 0xe3, 0x38,
+	0x1c, 0x14, 0x8d, 0xf9, 0xf4, 0x73, 0xfd, 0x30, 0x26, 0x2d, 0x7f, 0x60, 0x40, 0xb6, 0xb6, 0xb9,
+	0x86, 0xdd, 0x00, 0xad, 0xc0, 0x74, 0x6c, 0x98, 0x87, 0x75, 0xe9, 0xd8, 0x96, 0x43, 0x9f, 0x5e,
+	0x85, 0xe9, 0x3b, 0x61, 0x94, 0x88, 0x60, 0xd4, 0xbb, 0x72, 0xfe, 0xf1, 0xc3, 0xcb, 0x2f, 0x6b,
+	0x98, 0x28, 0x92, 0xec, 0xc2, 0xbb, 0xb3, 0x6b, 0x3e, 0x21, 0xf3, 0x5b, 0x30, 0xaa, 0x8e, 0xca,
+	0xd0, 0x1b, 0x70, 0xa2, 0x2b, 0x3e, 0xa4, 0xa8, 0xb9, 0xab, 0xb3, 0xfb, 0x1a, 0xb8, 0xa4, 0x4f,
+	0x9a, 0x83, 0xe2, 0x2b, 0xff, 0x30, 0x05, 0x50, 0xdb, 0xdc, 0x5c, 0x0f, 0xdc, 0xae, 0x47, 0xf8,
+	0xa7, 0x25, 0xfb, 0x06, 0x9c, 0x8e, 0x65, 0x67, 0x81, 0x7d, 0x74, 0xf9, 0x4f, 0x46, 0xfc, 0x8d,
+	0xc0, 0xde, 0x13, 0xd6, 0x61, 0x3c, 0x82, 0x4d, 0x1f, 0x1d, 0xb6, 0xc6, 0xf8, 0xb0, 0x66, 0xbf,
+	0x01, 0xb9, 0x58, 0x19, 0x0c, 0xd5, 0x61, 0x8c, 0xeb, 0x6f, 0xad, 0xe0, 0xf2, 0xfe, 0x0a, 0x0e,
+	0xd9, 0x92, 0x4a, 0x8e, 0xd8, 0xcb, 0xff, 0x32, 0x00, 0x12, 0x3e, 0xf2, 0xd9, 0xb4, 0x31, 0x54,
+	0x87, 0xac, 0x8e, 0xc4, 0xe9, 0x17, 0x8d, 0xc4, 0x1a, 0x20, 0xa1, 0xd4, 0x1f, 0xa5, 0xe0, 0xe4,
+	0x46, 0xe8, 0xbd, 0x9f, 0x7d, 0x1d, 0x6c, 0xc0, 0x28, 0xf1, 0x79, 0xe0, 0x4a, 0x25, 0x88, 0x3b,
+	0xff, 0xfc, 0x7e, 0x77, 0xbe, 0x87, 0x50, 0x2b, 0x3e, 0x0f, 0xfa, 0x49, 0x0b, 0x08, 0xb1, 0x12,
+	0xfa, 0xf8, 0x59, 0x1a, 0x8a, 0xfb, 0xb1, 0xa2, 0x57, 0x21, 0x6f, 0x07, 0x44, 0x4e, 0x84, 0x8f,
+	0x8c, 0x21, 0x03, 0xe6, 0x54, 0x38, 0xad, 0xdf, 0x18, 0x13, 0x44, 0x56, 0x26, 0x8c, 0x4b, 0x90,
+	0xbe, 0x58, 0x1a, 0x36, 0x15, 0x23, 0xc8, 0x57, 0x66, 0x1d, 0xf2, 0xae, 0xef, 0x72, 0x17, 0x7b,
+	0x56, 0x13, 0x7b, 0xd8, 0xb7, 0xc3, 0x74, 0xf5, 0x48, 0x4f, 0xc2, 0x94, 0xc6, 0xa8, 0x2a, 0x08,
+	0xb4, 0x02, 0xa3, 0x21, 0x5a, 0xe6, 0xe8, 0x68, 0x21, 0x2f, 0x3a, 0x0f, 0x13, 0xc9, 0x87, 0x41,
+	0xa6, 0x1e, 0x19, 0x33, 0x97, 0x78, 0x17, 0x0e, 0x7a, 0x79, 0xb2, 0xcf, 0x7d, 0x79, 0x74, 0x76,
+	0xf7, 0xf3, 0x34, 0x4c, 0x9b, 0xc4, 0xf9, 0xef, 0xbf, 0x96, 0x35, 0x00, 0xe5, 0xaa, 0x22, 0x92,
+	0x16, 0x33, 0x2f, 0xea, 0xef, 0xe3, 0x0a, 0xa4, 0xc6, 0xf8, 0x7f, 0xea, 0x86, 0xfe, 0x9c, 0x82,
+	0x89, 0xe4, 0x0d, 0xfd, 0x4f, 0x3e, 0x5a, 0x68, 0x35, 0x0e, 0x53, 0x19, 0x19, 0xa6, 0x5e, 0xdb,
+	0x2f, 0x4c, 0x0d, 0x59, 0xf3, 0x01, 0xf1, 0xe9, 0xd7, 0x59, 0xc8, 0xae, 0xe1, 0x00, 0x77, 0x18,
+	0xba, 0x35, 0x94, 0xc8, 0xaa, 0x42, 0x72, 0x66, 0xc8, 0x98, 0x6b, 0xba, 0xd7, 0xa1, 0x6c, 0xf9,
+	0xa7, 0xfb, 0xe5, 0xb1, 0xff, 0x07, 0x53, 0xa2, 0x20, 0x8e, 0x04, 0x52, 0xca, 0x9d, 0x94, 0x75,
+	0x6d, 0x24, 0x3d, 0x43, 0x73, 0x90, 0x13, 0x64, 0x71, 0x1c, 0x16, 0x34, 0xd0, 0xc1, 0xf7, 0x56,
+	0xd4, 0x0c, 0xba, 0x0c, 0x68, 0x2b, 0x6a, 0x50, 0x58, 0xb1, 0x22, 0x04, 0xdd, 0x74, 0xbc, 0x12,
+	0x92, 0xbf, 0x0c, 0x20, 0x4e, 0x61, 0x39, 0xc4, 0xa7, 0x1d, 0x5d, 0xd5, 0x8d, 0x8b, 0x99, 0x9a,
+	0x98, 0x40, 0x3f, 0x30, 0x54, 0x3e, 0xbc, 0xab, 0x6c, 0xd6, 0xe5, 0xc8, 0xfa, 0x21, 0x9c, 0xe2,
+	0x9f, 0x4f, 0xe6, 0x4a, 0x7d, 0xdc, 0xf1, 0x16, 0xcb, 0x7b, 0xe0, 0x94, 0xf7, 0xaa, 0xe4, 0x45,
+	0xe2, 0x3c, 0x58, 0x76, 0xa3, 0xdb, 0x30, 0xd3, 0xf6, 0x68, 0x13, 0x7b, 0x96, 0xe7, 0xde, 0xee,
+	0xb9, 0x8e, 0xa5, 0x6f, 0xd2, 0xb2, 0x71, 0xb7, 0x38, 0x7a, 0xac, 0xbe, 0xc1, 0x19, 0x05, 0x7c,
+	0x53, 0xe2, 0x36, 0x14, 0xec, 0x32, 0xee, 0xa2, 0xbb, 0xf0, 0x52, 0x6c, 0x9c, 0x7b, 0xec, 0x3a,
+	0x76, 0xac, 0x5d, 0x67, 0x22, 0xec, 0xa1, 0x8d, 0x57, 0xa1, 0xb0, 0x4d, 0xfa, 0x56, 0x40, 0xb9,
+	0x0a, 0xaa, 0x2d, 0x12, 0x16, 0x49, 0x33, 0xa1, 0x1d, 0x8b, 0x5e, 0x57, 0xa2, 0xa6, 0x71, 0x07,
+	0xaa, 0x99, 0xa9, 0x6d, 0xd2, 0x37, 0x35, 0xf3, 0x75, 0x42, 0x10, 0x49, 0x54, 0x34, 0xf2, 0xaa,
+	0xa5, 0x39, 0x16, 0xe1, 0x58, 0xe7, 0x2f, 0xe8, 0xe2, 0x46, 0x94, 0xc0, 0xa6, 0xc0, 0x5b, 0xbc,
+	0x20, 0x22, 0xd0, 0xce, 0xc7, 0x1f, 0x5e, 0xd2, 0x50, 0x97, 0x99, 0xb3, 0xbd, 0x70, 0x2f, 0xea,
+	0x30, 0x2a, 0xb7, 0x29, 0xff, 0xd6, 0x80, 0x93, 0xeb, 0xa2, 0xca, 0x75, 0xbf, 0x4f, 0x64, 0x3d,
+	0x6a, 0x12, 0x9b, 0x06, 0x0e, 0x9a, 0x82, 0x94, 0xeb, 0x48, 0x17, 0xca, 0x98, 0x29, 0xd7, 0x41,
+	0x15, 0x38, 0x41, 0xef, 0xfa, 0x24, 0x28, 0xa6, 0x0e, 0x08, 0x56, 0x8a, 0x4c, 0x7a, 0x0f, 0x75,
+	0x7a, 0x1e, 0xb1, 0xb0, 0xad, 0x02, 0xa6, 0xea, 0x72, 0x4c, 0xaa, 0xd9, 0x25, 0x35, 0x89, 0xde,
+	0x80, 0xf1, 0x48, 0xf1, 0xc5, 0xcc, 0x61, 0xa3, 0x4c, 0xcc, 0xa3, 0xe3, 0xec, 0x07, 0x06, 0xa0,
+	0x38, 0x3d, 0x31, 0x09, 0xeb, 0x52, 0x9f, 0xc9, 0x52, 0x34, 0x51, 0x32, 0x1a, 0xcf, 0x2f, 0x45,
+	0x63, 0xfe, 0x81, 0x52, 0x34, 0x11, 0xbc, 0xbf, 0x12, 0x67, 0x07, 0xa9, 0x23, 0xdc, 0x7f, 0xc8,
+	0x24, 0xcf, 0x3a, 0x52, 0xfe, 0x83, 0x01, 0x33, 0x43, 0x71, 0x2e, 0x3a, 0xb2, 0x0d, 0x28, 0x48,
+	0x2c, 0xca, 0x78, 0xd1, 0xd7, 0x47, 0x7f, 0xb1, 0xb0, 0x39, 0x1d, 0xec, 0x5e, 0xfd, 0x94, 0xd2,
+	0x1c, 0xad, 0xfb, 0xdf, 0x19, 0x70, 0x2a, 0x79, 0x80, 0x48, 0x94, 0x06, 0x4c, 0x24, 0xb7, 0xd6,
+	0x42, 0x5c, 0x38, 0x8c, 0x10, 0xc9, 0xf3, 0x0f, 0x80, 0xa0, 0xcd, 0xf8, 0x2d, 0x51, 0x0d, 0xda,
+	0x2b, 0x87, 0x56, 0x4a, 0x78, 0xb0, 0x3d, 0xdf, 0x14, 0x75, 0x37, 0x7f, 0x37, 0x20, 0xb3, 0x46,
+	0xa9, 0x87, 0x6e, 0xc3, 0xb4, 0x4f, 0xb9, 0x74, 0x4f, 0xe2, 0x58, 0xba, 0x83, 0xa4, 0xde, 0xe9,
+	0x95, 0xe7, 0xea, 0xea, 0x6f, 0x4f, 0xe6, 0x86, 0x39, 0x07, 0x15, 0xa8, 0x1b, 0x95, 0x3e, 0xe5,
+	0x55, 0x49, 0x24, 0xdd, 0x8f, 0xa1, 0x16, 0x4c, 0x0e, 0x6e, 0xa7, 0x3c, 0x6d, 0xe9, 0xa0, 0xed,
+	0x26, 0x0f, 0xdc, 0x6a, 0xa2, 0x99, 0xd8, 0x67, 0x71, 0x4c, 0xdc, 0xda, 0x3f, 0xc4, 0xcd, 0xbd,
+	0x03, 0x85, 0xc8, 0xc1, 0x36, 0x64, 0x97, 0x93, 0x09, 0xd3, 0x50, 0x0d, 0xcf, 0xb0, 0x8c, 0x9c,
+	0x4f, 0x76, 0xcf, 0x45, 0xfb, 0xbd, 0xb2, 0x8b, 0x67, 0x40, 0x9d, 0x9a, 0xb7, 0xfc, 0xcb, 0x34,
+	0xcc, 0x2c, 0x53, 0x9f, 0xe9, 0x56, 0x9f, 0x8e, 0x7e, 0xaa, 0x51, 0xdf, 0x47, 0x37, 0xf7, 0x6d,
+	0x44, 0x1e, 0xc2, 0xf9, 0x87, 0x3a, 0x92, 0x9b, 0x90, 0x17, 0xa9, 0x99, 0x4d, 0xfd, 0x63, 0x36,
+	0x24, 0x27, 0xa9, 0xe7, 0xe8, 0x43, 0x8b, 0x76, 0xe4, 0x26, 0xe4, 0x7d, 0x72, 0x77, 0x00, 0x37,
+	0xfd, 0x62, 0xb8, 0x3e, 0xb9, 0x9b, 0xc0, 0x3d, 0x23, 0xfe, 0x9f, 0x90, 0x79, 0x79, 0x46, 0xe6,
+	0x90, 0x7a, 0x84, 0xbe, 0x0c, 0x19, 0x99, 0xb7, 0x9c, 0x38, 0x6a, 0x12, 0x2e, 0xd9, 0xd0, 0x35,
+	0x48, 0xb7, 0x88, 0x4a, 0x04, 0x0e, 0x1b, 0x99, 0x04, 0x43, 0x9c, 0x4d, 0x5d, 0xfa, 0x95, 0x01,
+	0x10, 0xb7, 0x50, 0xd1, 0xeb, 0x70, 0xb6, 0x7a, 0x6b, 0xb5, 0x66, 0x35, 0xd6, 0x97, 0xd6, 0x37,
+	0x1a, 0xd6, 0xc6, 0x6a, 0x63, 0x6d, 0x65, 0xb9, 0x7e, 0xbd, 0xbe, 0x52, 0x2b, 0x8c, 0x94, 0xf2,
+	0x3b, 0xf7, 0xe7, 0x73, 0x1b, 0x3e, 0xeb, 0x12, 0xdb, 0x6d, 0xb9, 0xc4, 0x41, 0xff, 0x0f, 0xa7,
+	0x06, 0xa9, 0xc5, 0x68, 0xa5, 0x56, 0x30, 0x4a, 0x13, 0x3b, 0xf7, 0xe7, 0xc7, 0x54, 0x15, 0x49,
+	0x1c, 0x74, 0x11, 0x4e, 0x0f, 0xd3, 0xd5, 0x57, 0xdf, 0x2c, 0xa4, 0x4a, 0x93, 0x3b, 0xf7, 0xe7,
+	0xc7, 0xa3, 0x72, 0x13, 0x95, 0x01, 0x25, 0x29, 0x35, 0x5e, 0xba, 0x04, 0x3b, 0xf7, 0xe7, 0xb3,
+	0xca, 0x75, 0x4a, 0x99, 0x77, 0x7f, 0x31, 0x3b, 0x72, 0xe9, 0x3b, 0x00, 0x75, 0xbf, 0x15, 0x60,
+	0x5b, 0x86, 0x88, 0x12, 0x9c, 0xa9, 0xaf, 0x5e, 0x37, 0x97, 0x96, 0xd7, 0xeb, 0xb7, 0x56, 0x07,
+	0x8f, 0xbd, 0x6b, 0xad, 0x76, 0x6b, 0xa3, 0x7a, 0x73, 0xc5, 0x6a, 0xd4, 0xdf, 0x5c, 0x2d, 0x18,
+	0xe8, 0x2c, 0x9c, 0x1c, 0x58, 0xfb, 0xfa, 0xea, 0x7a, 0xfd, 0xed, 0x95, 0x42, 0xaa, 0x7a, 0xfd,
+	0xa3, 0xa7, 0xb3, 0xc6, 0xa3, 0xa7, 0xb3, 0xc6, 0x5f, 0x9f, 0xce, 0x1a, 0xef, 0x3d, 0x9b, 0x1d,
+	0x79, 0xf4, 0x6c, 0x76, 0xe4, 0x8f, 0xcf, 0x66, 0x47, 0xbe, 0xf9, 0x7a, 0xdb, 0xe5, 0x5b, 0xbd,
+	0x66, 0xc5, 0xa6, 0x1d, 0xfd, 0x27, 0xd8, 0xc2, 0x9e, 0x8f, 0xad, 0xfc, 0xd7, 0xa9, 0x99, 0x95,
+	0x57, 0xf9, 0x85, 0x7f, 0x0f, 0x00, 0xb5, 0xff, 0xc1, 0x95, 0xed, 0x1b, 0x00, 0x00,
 }
 
 func (this *Pool) Description() (desc *github_com_cosmos_gogoproto_protoc_gen_gogo_descriptor.FileDescriptorSet) {
@@ -1404,707 +1570,791 @@ func (this *Pool) Description() (desc *github_com_cosmos_gogoproto_protoc_gen_go
 func StakingDescription() (desc *github_com_cosmos_gogoproto_protoc_gen_gogo_descriptor.FileDescriptorSet) {
 	d := &github_com_cosmos_gogoproto_protoc_gen_gogo_descriptor.FileDescriptorSet{}
 	var gzipped = []byte{
-		// 11200 bytes of a gzipped FileDescriptorSet
-		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x7d, 0x69, 0x90, 0x1c, 0xd7,
-		0x79, 0xd8, 0xce, 0x3d, 0xf3, 0xcd, 0xd5, 0xfb, 0x76, 0x01, 0x2c, 0x06, 0xe4, 0xee, 0xa2, 0x29,
-		0x12, 0x20, 0x48, 0x2e, 0x48, 0x90, 0x00, 0xc9, 0x85, 0x28, 0x66, 0x66, 0x76, 0xb0, 0x18, 0x70,
-		0x2f, 0xf6, 0xcc, 0x42, 0x24, 0x6d, 0xab, 0xdd, 0xdb, 0xf3, 0x76, 0xb7, 0x89, 0x99, 0xee, 0x51,
-		0x77, 0x0f, 0xb0, 0xcb, 0x4a, 0xa5, 0xe4, 0xc8, 0x4e, 0x64, 0xf8, 0x88, 0x1c, 0xbb, 0x6c, 0xd9,
-		0x12, 0x6c, 0xc9, 0x8e, 0x6d, 0xd9, 0xb9, 0x7c, 0x28, 0x3e, 0xe2, 0x8a, 0x63, 0xe7, 0xb4, 0x5d,
-		0x39, 0x14, 0xff, 0x48, 0x9c, 0x54, 0x99, 0xb1, 0x29, 0x57, 0xac, 0xc8, 0x72, 0xe2, 0x83, 0x4e,
-		0x9c, 0x52, 0x25, 0x95, 0x7a, 0x57, 0x1f, 0x73, 0xec, 0xcc, 0x42, 0xa4, 0xac, 0xd8, 0xf9, 0x03,
-		0x4c, 0x7f, 0xef, 0xfb, 0xbe, 0xf7, 0xde, 0xf7, 0xbe, 0xf7, 0xbd, 0xef, 0xfb, 0xde, 0xb1, 0xf0,
-		0xdb, 0x15, 0x58, 0xdc, 0xb3, 0xac, 0xbd, 0x36, 0xbe, 0xd8, 0xb5, 0x2d, 0xd7, 0xda, 0xe9, 0xed,
-		0x5e, 0x6c, 0x61, 0x47, 0xb7, 0x8d, 0xae, 0x6b, 0xd9, 0x4b, 0x14, 0x86, 0x8a, 0x0c, 0x63, 0x49,
-		0x60, 0xc8, 0xeb, 0x30, 0x7d, 0xcd, 0x68, 0xe3, 0x15, 0x0f, 0xb1, 0x81, 0x5d, 0xf4, 0x1c, 0xc4,
-		0x77, 0x8d, 0x36, 0x9e, 0x8b, 0x2c, 0xc6, 0xce, 0x67, 0x2f, 0xbd, 0x67, 0xa9, 0x8f, 0x68, 0x29,
-		0x4c, 0xb1, 0x45, 0xc0, 0x0a, 0xa5, 0x90, 0xff, 0x4f, 0x1c, 0x66, 0x86, 0x94, 0x22, 0x04, 0x71,
-		0x53, 0xeb, 0x10, 0x8e, 0x91, 0xf3, 0x19, 0x85, 0xfe, 0x46, 0x73, 0x90, 0xea, 0x6a, 0xfa, 0x2d,
-		0x6d, 0x0f, 0xcf, 0x45, 0x29, 0x58, 0x7c, 0xa2, 0x79, 0x80, 0x16, 0xee, 0x62, 0xb3, 0x85, 0x4d,
-		0xfd, 0x70, 0x2e, 0xb6, 0x18, 0x3b, 0x9f, 0x51, 0x02, 0x10, 0xf4, 0x18, 0x4c, 0x77, 0x7b, 0x3b,
-		0x6d, 0x43, 0x57, 0x03, 0x68, 0xb0, 0x18, 0x3b, 0x9f, 0x50, 0x24, 0x56, 0xb0, 0xe2, 0x23, 0x9f,
-		0x83, 0xe2, 0x1d, 0xac, 0xdd, 0x0a, 0xa2, 0x66, 0x29, 0x6a, 0x81, 0x80, 0x03, 0x88, 0x55, 0xc8,
-		0x75, 0xb0, 0xe3, 0x68, 0x7b, 0x58, 0x75, 0x0f, 0xbb, 0x78, 0x2e, 0x4e, 0x7b, 0xbf, 0x38, 0xd0,
-		0xfb, 0xfe, 0x9e, 0x67, 0x39, 0x55, 0xf3, 0xb0, 0x8b, 0x51, 0x19, 0x32, 0xd8, 0xec, 0x75, 0x18,
-		0x87, 0xc4, 0x08, 0xf9, 0xd5, 0xcc, 0x5e, 0xa7, 0x9f, 0x4b, 0x9a, 0x90, 0x71, 0x16, 0x29, 0x07,
-		0xdb, 0xb7, 0x0d, 0x1d, 0xcf, 0x25, 0x29, 0x83, 0x73, 0x03, 0x0c, 0x1a, 0xac, 0xbc, 0x9f, 0x87,
-		0xa0, 0x43, 0x55, 0xc8, 0xe0, 0x03, 0x17, 0x9b, 0x8e, 0x61, 0x99, 0x73, 0x29, 0xca, 0xe4, 0xe1,
-		0x21, 0xa3, 0x88, 0xdb, 0xad, 0x7e, 0x16, 0x3e, 0x1d, 0xba, 0x02, 0x29, 0xab, 0xeb, 0x1a, 0x96,
-		0xe9, 0xcc, 0xa5, 0x17, 0x23, 0xe7, 0xb3, 0x97, 0x1e, 0x18, 0xaa, 0x08, 0x9b, 0x0c, 0x47, 0x11,
-		0xc8, 0xa8, 0x0e, 0x92, 0x63, 0xf5, 0x6c, 0x1d, 0xab, 0xba, 0xd5, 0xc2, 0xaa, 0x61, 0xee, 0x5a,
-		0x73, 0x19, 0xca, 0x60, 0x61, 0xb0, 0x23, 0x14, 0xb1, 0x6a, 0xb5, 0x70, 0xdd, 0xdc, 0xb5, 0x94,
-		0x82, 0x13, 0xfa, 0x46, 0x27, 0x21, 0xe9, 0x1c, 0x9a, 0xae, 0x76, 0x30, 0x97, 0xa3, 0x1a, 0xc2,
-		0xbf, 0x88, 0xea, 0xe0, 0x96, 0x41, 0xaa, 0x9b, 0xcb, 0x33, 0xd5, 0xe1, 0x9f, 0xf2, 0xcf, 0x27,
-		0xa1, 0x38, 0x89, 0xf2, 0x5d, 0x85, 0xc4, 0x2e, 0xe9, 0xff, 0x5c, 0xf4, 0x38, 0xd2, 0x61, 0x34,
-		0x61, 0xf1, 0x26, 0xef, 0x53, 0xbc, 0x65, 0xc8, 0x9a, 0xd8, 0x71, 0x71, 0x8b, 0xe9, 0x4a, 0x6c,
-		0x42, 0x6d, 0x03, 0x46, 0x34, 0xa8, 0x6c, 0xf1, 0xfb, 0x52, 0xb6, 0x57, 0xa0, 0xe8, 0x35, 0x49,
-		0xb5, 0x35, 0x73, 0x4f, 0x68, 0xed, 0xc5, 0x71, 0x2d, 0x59, 0xaa, 0x09, 0x3a, 0x85, 0x90, 0x29,
-		0x05, 0x1c, 0xfa, 0x46, 0x2b, 0x00, 0x96, 0x89, 0xad, 0x5d, 0xb5, 0x85, 0xf5, 0xf6, 0x5c, 0x7a,
-		0x84, 0x94, 0x36, 0x09, 0xca, 0x80, 0x94, 0x2c, 0x06, 0xd5, 0xdb, 0xe8, 0x79, 0x5f, 0x09, 0x53,
-		0x23, 0x74, 0x68, 0x9d, 0x4d, 0xbf, 0x01, 0x3d, 0xdc, 0x86, 0x82, 0x8d, 0xc9, 0x8c, 0xc0, 0x2d,
-		0xde, 0xb3, 0x0c, 0x6d, 0xc4, 0xd2, 0xd8, 0x9e, 0x29, 0x9c, 0x8c, 0x75, 0x2c, 0x6f, 0x07, 0x3f,
-		0xd1, 0x43, 0xe0, 0x01, 0x54, 0xaa, 0x56, 0x40, 0xed, 0x53, 0x4e, 0x00, 0x37, 0xb4, 0x0e, 0x2e,
-		0xbd, 0x01, 0x85, 0xb0, 0x78, 0xd0, 0x2c, 0x24, 0x1c, 0x57, 0xb3, 0x5d, 0xaa, 0x85, 0x09, 0x85,
-		0x7d, 0x20, 0x09, 0x62, 0xd8, 0x6c, 0x51, 0xfb, 0x97, 0x50, 0xc8, 0x4f, 0xf4, 0x97, 0xfc, 0x0e,
-		0xc7, 0x68, 0x87, 0x1f, 0x19, 0x1c, 0xd1, 0x10, 0xe7, 0xfe, 0x7e, 0x97, 0x9e, 0x85, 0x7c, 0xa8,
-		0x03, 0x93, 0x56, 0x2d, 0xff, 0x65, 0x38, 0x31, 0x94, 0x35, 0x7a, 0x05, 0x66, 0x7b, 0xa6, 0x61,
-		0xba, 0xd8, 0xee, 0xda, 0x98, 0x68, 0x2c, 0xab, 0x6a, 0xee, 0x77, 0x53, 0x23, 0x74, 0x6e, 0x3b,
-		0x88, 0xcd, 0xb8, 0x28, 0x33, 0xbd, 0x41, 0xe0, 0x85, 0x4c, 0xfa, 0xf3, 0x29, 0xe9, 0x43, 0x1f,
-		0xfa, 0xd0, 0x87, 0xa2, 0xf2, 0x2f, 0x27, 0x61, 0x76, 0xd8, 0x9c, 0x19, 0x3a, 0x7d, 0x4f, 0x42,
-		0xd2, 0xec, 0x75, 0x76, 0xb0, 0x4d, 0x85, 0x94, 0x50, 0xf8, 0x17, 0x2a, 0x43, 0xa2, 0xad, 0xed,
-		0xe0, 0xf6, 0x5c, 0x7c, 0x31, 0x72, 0xbe, 0x70, 0xe9, 0xb1, 0x89, 0x66, 0xe5, 0xd2, 0x1a, 0x21,
-		0x51, 0x18, 0x25, 0x7a, 0x1f, 0xc4, 0xb9, 0xf1, 0x26, 0x1c, 0x2e, 0x4c, 0xc6, 0x81, 0xcc, 0x25,
-		0x85, 0xd2, 0xa1, 0x33, 0x90, 0x21, 0xff, 0x33, 0xdd, 0x48, 0xd2, 0x36, 0xa7, 0x09, 0x80, 0xe8,
-		0x05, 0x2a, 0x41, 0x9a, 0x4e, 0x93, 0x16, 0x16, 0x8b, 0x9e, 0xf7, 0x4d, 0x14, 0xab, 0x85, 0x77,
-		0xb5, 0x5e, 0xdb, 0x55, 0x6f, 0x6b, 0xed, 0x1e, 0xa6, 0x0a, 0x9f, 0x51, 0x72, 0x1c, 0x78, 0x93,
-		0xc0, 0xd0, 0x02, 0x64, 0xd9, 0xac, 0x32, 0xcc, 0x16, 0x3e, 0xa0, 0x76, 0x35, 0xa1, 0xb0, 0x89,
-		0x56, 0x27, 0x10, 0x52, 0xfd, 0xeb, 0x8e, 0x65, 0x0a, 0xd5, 0xa4, 0x55, 0x10, 0x00, 0xad, 0xfe,
-		0xd9, 0x7e, 0x93, 0xfe, 0xe0, 0xf0, 0xee, 0x0d, 0xcc, 0xa5, 0x73, 0x50, 0xa4, 0x18, 0x4f, 0xf3,
-		0xa1, 0xd7, 0xda, 0x73, 0xd3, 0x8b, 0x91, 0xf3, 0x69, 0xa5, 0xc0, 0xc0, 0x9b, 0x1c, 0x2a, 0xff,
-		0x4c, 0x14, 0xe2, 0xd4, 0xb0, 0x14, 0x21, 0xdb, 0x7c, 0x75, 0xab, 0xa6, 0xae, 0x6c, 0x6e, 0x57,
-		0xd6, 0x6a, 0x52, 0x04, 0x15, 0x00, 0x28, 0xe0, 0xda, 0xda, 0x66, 0xb9, 0x29, 0x45, 0xbd, 0xef,
-		0xfa, 0x46, 0xf3, 0xca, 0x33, 0x52, 0xcc, 0x23, 0xd8, 0x66, 0x80, 0x78, 0x10, 0xe1, 0xe9, 0x4b,
-		0x52, 0x02, 0x49, 0x90, 0x63, 0x0c, 0xea, 0xaf, 0xd4, 0x56, 0xae, 0x3c, 0x23, 0x25, 0xc3, 0x90,
-		0xa7, 0x2f, 0x49, 0x29, 0x94, 0x87, 0x0c, 0x85, 0x54, 0x36, 0x37, 0xd7, 0xa4, 0xb4, 0xc7, 0xb3,
-		0xd1, 0x54, 0xea, 0x1b, 0xab, 0x52, 0xc6, 0xe3, 0xb9, 0xaa, 0x6c, 0x6e, 0x6f, 0x49, 0xe0, 0x71,
-		0x58, 0xaf, 0x35, 0x1a, 0xe5, 0xd5, 0x9a, 0x94, 0xf5, 0x30, 0x2a, 0xaf, 0x36, 0x6b, 0x0d, 0x29,
-		0x17, 0x6a, 0xd6, 0xd3, 0x97, 0xa4, 0xbc, 0x57, 0x45, 0x6d, 0x63, 0x7b, 0x5d, 0x2a, 0xa0, 0x69,
-		0xc8, 0xb3, 0x2a, 0x44, 0x23, 0x8a, 0x7d, 0xa0, 0x2b, 0xcf, 0x48, 0x92, 0xdf, 0x10, 0xc6, 0x65,
-		0x3a, 0x04, 0xb8, 0xf2, 0x8c, 0x84, 0xe4, 0x2a, 0x24, 0xa8, 0x1a, 0x22, 0x04, 0x85, 0xb5, 0x72,
-		0xa5, 0xb6, 0xa6, 0x6e, 0x6e, 0x35, 0xeb, 0x9b, 0x1b, 0xe5, 0x35, 0x29, 0xe2, 0xc3, 0x94, 0xda,
-		0xcb, 0xdb, 0x75, 0xa5, 0xb6, 0x22, 0x45, 0x83, 0xb0, 0xad, 0x5a, 0xb9, 0x59, 0x5b, 0x91, 0x62,
-		0xb2, 0x0e, 0xb3, 0xc3, 0x0c, 0xea, 0xd0, 0x29, 0x14, 0xd0, 0x85, 0xe8, 0x08, 0x5d, 0xa0, 0xbc,
-		0xfa, 0x75, 0x41, 0xfe, 0x5c, 0x14, 0x66, 0x86, 0x2c, 0x2a, 0x43, 0x2b, 0x79, 0x11, 0x12, 0x4c,
-		0x97, 0xd9, 0x32, 0xfb, 0xe8, 0xd0, 0xd5, 0x89, 0x6a, 0xf6, 0xc0, 0x52, 0x4b, 0xe9, 0x82, 0x4e,
-		0x48, 0x6c, 0x84, 0x13, 0x42, 0x58, 0x0c, 0x28, 0xec, 0xd7, 0x0d, 0x18, 0x7f, 0xb6, 0x3e, 0x5e,
-		0x99, 0x64, 0x7d, 0xa4, 0xb0, 0xe3, 0x2d, 0x02, 0x89, 0x21, 0x8b, 0xc0, 0x55, 0x98, 0x1e, 0x60,
-		0x34, 0xb1, 0x31, 0xfe, 0x70, 0x04, 0xe6, 0x46, 0x09, 0x67, 0x8c, 0x49, 0x8c, 0x86, 0x4c, 0xe2,
-		0xd5, 0x7e, 0x09, 0x9e, 0x1d, 0x3d, 0x08, 0x03, 0x63, 0xfd, 0xa3, 0x11, 0x38, 0x39, 0xdc, 0xd9,
-		0x1c, 0xda, 0x86, 0xf7, 0x41, 0xb2, 0x83, 0xdd, 0x7d, 0x4b, 0xb8, 0x55, 0x8f, 0x0c, 0x59, 0xac,
-		0x49, 0x71, 0xff, 0x60, 0x73, 0xaa, 0xe0, 0x6a, 0x1f, 0x1b, 0xe5, 0x31, 0xb2, 0xd6, 0x0c, 0xb4,
-		0xf4, 0x9b, 0xa3, 0x70, 0x62, 0x28, 0xf3, 0xa1, 0x0d, 0x7d, 0x10, 0xc0, 0x30, 0xbb, 0x3d, 0x97,
-		0xb9, 0x4e, 0xcc, 0x12, 0x67, 0x28, 0x84, 0x1a, 0x2f, 0x62, 0x65, 0x7b, 0xae, 0x57, 0x1e, 0xa3,
-		0xe5, 0xc0, 0x40, 0x14, 0xe1, 0x39, 0xbf, 0xa1, 0x71, 0xda, 0xd0, 0xf9, 0x11, 0x3d, 0x1d, 0x50,
-		0xcc, 0x27, 0x41, 0xd2, 0xdb, 0x06, 0x36, 0x5d, 0xd5, 0x71, 0x6d, 0xac, 0x75, 0x0c, 0x73, 0x8f,
-		0x2e, 0x35, 0xe9, 0xe5, 0xc4, 0xae, 0xd6, 0x76, 0xb0, 0x52, 0x64, 0xc5, 0x0d, 0x51, 0x4a, 0x28,
-		0xa8, 0x02, 0xd9, 0x01, 0x8a, 0x64, 0x88, 0x82, 0x15, 0x7b, 0x14, 0xf2, 0x77, 0x64, 0x20, 0x1b,
-		0x70, 0xcd, 0xd1, 0x59, 0xc8, 0xbd, 0xae, 0xdd, 0xd6, 0x54, 0x11, 0x6e, 0x31, 0x49, 0x64, 0x09,
-		0x6c, 0x8b, 0x87, 0x5c, 0x4f, 0xc2, 0x2c, 0x45, 0xb1, 0x7a, 0x2e, 0xb6, 0x55, 0xbd, 0xad, 0x39,
-		0x0e, 0x15, 0x5a, 0x9a, 0xa2, 0x22, 0x52, 0xb6, 0x49, 0x8a, 0xaa, 0xa2, 0x04, 0x5d, 0x86, 0x19,
-		0x4a, 0xd1, 0xe9, 0xb5, 0x5d, 0xa3, 0xdb, 0xc6, 0x2a, 0x09, 0x00, 0x1d, 0xba, 0xe4, 0x78, 0x2d,
-		0x9b, 0x26, 0x18, 0xeb, 0x1c, 0x81, 0xb4, 0xc8, 0x41, 0x2b, 0xf0, 0x20, 0x25, 0xdb, 0xc3, 0x26,
-		0xb6, 0x35, 0x17, 0xab, 0xf8, 0x83, 0x3d, 0xad, 0xed, 0xa8, 0x9a, 0xd9, 0x52, 0xf7, 0x35, 0x67,
-		0x7f, 0x6e, 0x96, 0x30, 0xa8, 0x44, 0xe7, 0x22, 0xca, 0x69, 0x82, 0xb8, 0xca, 0xf1, 0x6a, 0x14,
-		0xad, 0x6c, 0xb6, 0xae, 0x6b, 0xce, 0x3e, 0x5a, 0x86, 0x93, 0x94, 0x8b, 0xe3, 0xda, 0x86, 0xb9,
-		0xa7, 0xea, 0xfb, 0x58, 0xbf, 0xa5, 0xf6, 0xdc, 0xdd, 0xe7, 0xe6, 0xce, 0x04, 0xeb, 0xa7, 0x2d,
-		0x6c, 0x50, 0x9c, 0x2a, 0x41, 0xd9, 0x76, 0x77, 0x9f, 0x43, 0x0d, 0xc8, 0x91, 0xc1, 0xe8, 0x18,
-		0x6f, 0x60, 0x75, 0xd7, 0xb2, 0xe9, 0x1a, 0x5a, 0x18, 0x62, 0x9a, 0x02, 0x12, 0x5c, 0xda, 0xe4,
-		0x04, 0xeb, 0x56, 0x0b, 0x2f, 0x27, 0x1a, 0x5b, 0xb5, 0xda, 0x8a, 0x92, 0x15, 0x5c, 0xae, 0x59,
-		0x36, 0x51, 0xa8, 0x3d, 0xcb, 0x13, 0x70, 0x96, 0x29, 0xd4, 0x9e, 0x25, 0xc4, 0x7b, 0x19, 0x66,
-		0x74, 0x9d, 0xf5, 0xd9, 0xd0, 0x55, 0x1e, 0xa6, 0x39, 0x73, 0x52, 0x48, 0x58, 0xba, 0xbe, 0xca,
-		0x10, 0xb8, 0x8e, 0x3b, 0xe8, 0x79, 0x38, 0xe1, 0x0b, 0x2b, 0x48, 0x38, 0x3d, 0xd0, 0xcb, 0x7e,
-		0xd2, 0xcb, 0x30, 0xd3, 0x3d, 0x1c, 0x24, 0x44, 0xa1, 0x1a, 0xbb, 0x87, 0xfd, 0x64, 0xcf, 0xc2,
-		0x6c, 0x77, 0xbf, 0x3b, 0x48, 0x77, 0x21, 0x48, 0x87, 0xba, 0xfb, 0xdd, 0x7e, 0xc2, 0x87, 0x69,
-		0xcc, 0x6e, 0x63, 0x5d, 0x73, 0x71, 0x6b, 0xee, 0x54, 0x10, 0x3d, 0x50, 0x80, 0x96, 0x40, 0xd2,
-		0x75, 0x15, 0x9b, 0xda, 0x4e, 0x1b, 0xab, 0x9a, 0x8d, 0x4d, 0xcd, 0x99, 0x5b, 0xa0, 0xc8, 0x71,
-		0xd7, 0xee, 0x61, 0xa5, 0xa0, 0xeb, 0x35, 0x5a, 0x58, 0xa6, 0x65, 0xe8, 0x02, 0x4c, 0x5b, 0x3b,
-		0xaf, 0xeb, 0x4c, 0x23, 0xd5, 0xae, 0x8d, 0x77, 0x8d, 0x83, 0xb9, 0xf7, 0x50, 0xf1, 0x16, 0x49,
-		0x01, 0xd5, 0xc7, 0x2d, 0x0a, 0x46, 0x8f, 0x82, 0xa4, 0x3b, 0xfb, 0x9a, 0xdd, 0xa5, 0x26, 0xd9,
-		0xe9, 0x6a, 0x3a, 0x9e, 0x7b, 0x98, 0xa1, 0x32, 0xf8, 0x86, 0x00, 0x93, 0x19, 0xe1, 0xdc, 0x31,
-		0x76, 0x5d, 0xc1, 0xf1, 0x1c, 0x9b, 0x11, 0x14, 0xc6, 0xb9, 0x9d, 0x07, 0x89, 0x48, 0x22, 0x54,
-		0xf1, 0x79, 0x8a, 0x56, 0xe8, 0xee, 0x77, 0x83, 0xf5, 0x3e, 0x04, 0x79, 0x82, 0xe9, 0x57, 0xfa,
-		0x28, 0x73, 0xdc, 0xba, 0xfb, 0x81, 0x1a, 0x9f, 0x81, 0x93, 0x04, 0xa9, 0x83, 0x5d, 0xad, 0xa5,
-		0xb9, 0x5a, 0x00, 0xfb, 0x71, 0x8a, 0x4d, 0xc4, 0xbe, 0xce, 0x0b, 0x43, 0xed, 0xb4, 0x7b, 0x3b,
-		0x87, 0x9e, 0x62, 0x3d, 0xc1, 0xda, 0x49, 0x60, 0x42, 0xb5, 0xde, 0x35, 0xe7, 0x5c, 0x5e, 0x86,
-		0x5c, 0x50, 0xef, 0x51, 0x06, 0x98, 0xe6, 0x4b, 0x11, 0xe2, 0x04, 0x55, 0x37, 0x57, 0x88, 0xfb,
-		0xf2, 0x5a, 0x4d, 0x8a, 0x12, 0x37, 0x6a, 0xad, 0xde, 0xac, 0xa9, 0xca, 0xf6, 0x46, 0xb3, 0xbe,
-		0x5e, 0x93, 0x62, 0x01, 0xc7, 0xfe, 0x46, 0x3c, 0xfd, 0x88, 0x74, 0x4e, 0xfe, 0x85, 0x18, 0x14,
-		0xc2, 0x91, 0x1a, 0x7a, 0x2f, 0x9c, 0x12, 0x09, 0x17, 0x07, 0xbb, 0xea, 0x1d, 0xc3, 0xa6, 0x13,
-		0xb2, 0xa3, 0xb1, 0xc5, 0xd1, 0xd3, 0x9f, 0x59, 0x8e, 0xd5, 0xc0, 0xee, 0xfb, 0x0d, 0x9b, 0x4c,
-		0xb7, 0x8e, 0xe6, 0xa2, 0x35, 0x58, 0x30, 0x2d, 0xd5, 0x71, 0x35, 0xb3, 0xa5, 0xd9, 0x2d, 0xd5,
-		0x4f, 0x75, 0xa9, 0x9a, 0xae, 0x63, 0xc7, 0xb1, 0xd8, 0x42, 0xe8, 0x71, 0x79, 0xc0, 0xb4, 0x1a,
-		0x1c, 0xd9, 0x5f, 0x21, 0xca, 0x1c, 0xb5, 0x4f, 0x7d, 0x63, 0xa3, 0xd4, 0xf7, 0x0c, 0x64, 0x3a,
-		0x5a, 0x57, 0xc5, 0xa6, 0x6b, 0x1f, 0x52, 0xff, 0x3c, 0xad, 0xa4, 0x3b, 0x5a, 0xb7, 0x46, 0xbe,
-		0xd1, 0x4d, 0x78, 0xc4, 0x47, 0x55, 0xdb, 0x78, 0x4f, 0xd3, 0x0f, 0x55, 0xea, 0x8c, 0xd3, 0xb4,
-		0x81, 0xaa, 0x5b, 0xe6, 0x6e, 0xdb, 0xd0, 0x5d, 0x87, 0xda, 0x07, 0x66, 0xe3, 0x64, 0x9f, 0x62,
-		0x8d, 0x12, 0xdc, 0x70, 0x2c, 0x93, 0xfa, 0xe0, 0x55, 0x81, 0xfd, 0x15, 0x09, 0xbf, 0x6e, 0xc4,
-		0xd3, 0x71, 0x29, 0x71, 0x23, 0x9e, 0x4e, 0x48, 0xc9, 0x1b, 0xf1, 0x74, 0x52, 0x4a, 0xdd, 0x88,
-		0xa7, 0xd3, 0x52, 0xe6, 0x46, 0x3c, 0x9d, 0x91, 0x40, 0xfe, 0xd9, 0x34, 0xe4, 0x82, 0x91, 0x01,
-		0x09, 0xb4, 0x74, 0xba, 0x36, 0x46, 0xa8, 0xf5, 0x7c, 0xe8, 0xc8, 0x38, 0x62, 0xa9, 0x4a, 0x16,
-		0xcd, 0xe5, 0x24, 0x73, 0xc3, 0x15, 0x46, 0x49, 0x1c, 0x16, 0xa2, 0xd6, 0x98, 0xb9, 0x3d, 0x69,
-		0x85, 0x7f, 0xa1, 0x55, 0x48, 0xbe, 0xee, 0x50, 0xde, 0x49, 0xca, 0xfb, 0x3d, 0x47, 0xf3, 0xbe,
-		0xd1, 0xa0, 0xcc, 0x33, 0x37, 0x1a, 0xea, 0xc6, 0xa6, 0xb2, 0x5e, 0x5e, 0x53, 0x38, 0x39, 0x3a,
-		0x0d, 0xf1, 0xb6, 0xf6, 0xc6, 0x61, 0x78, 0x79, 0xa5, 0x20, 0xb4, 0x04, 0xc5, 0x9e, 0x79, 0x1b,
-		0xdb, 0xc6, 0xae, 0x41, 0x86, 0x8a, 0x60, 0x15, 0x83, 0x58, 0x05, 0xbf, 0x74, 0x8d, 0xe0, 0x4f,
-		0xa8, 0x1e, 0xa7, 0x21, 0x7e, 0x07, 0x6b, 0xb7, 0xc2, 0x8b, 0x20, 0x05, 0xa1, 0xf3, 0x90, 0x6b,
-		0xe1, 0x9d, 0xde, 0x9e, 0x6a, 0xe3, 0x96, 0xa6, 0xbb, 0x61, 0xd3, 0x9f, 0xa5, 0x45, 0x0a, 0x2d,
-		0x41, 0x2f, 0x41, 0x86, 0x8c, 0x91, 0x49, 0xc7, 0x78, 0x9a, 0x8a, 0xe0, 0x89, 0xa3, 0x45, 0xc0,
-		0x87, 0x58, 0x10, 0x29, 0x3e, 0x3d, 0xba, 0x06, 0x49, 0x57, 0xb3, 0xf7, 0xb0, 0x4b, 0x2d, 0x7f,
-		0x61, 0x48, 0xf2, 0x63, 0x08, 0xa7, 0x26, 0xa5, 0xa0, 0x31, 0x2d, 0xa7, 0x7e, 0x17, 0xad, 0xcc,
-		0x45, 0x48, 0x50, 0xf5, 0x40, 0x00, 0x5c, 0x41, 0xa4, 0x29, 0x94, 0x86, 0x78, 0x75, 0x53, 0x21,
-		0x96, 0x46, 0x82, 0x1c, 0x83, 0xaa, 0x5b, 0xf5, 0x5a, 0xb5, 0x26, 0x45, 0xe5, 0xcb, 0x90, 0x64,
-		0x63, 0x4e, 0xac, 0x90, 0x37, 0xea, 0xd2, 0x14, 0xff, 0xe4, 0x3c, 0x22, 0xa2, 0x74, 0x7b, 0xbd,
-		0x52, 0x53, 0xa4, 0xa8, 0xbc, 0x0d, 0xc5, 0x3e, 0x39, 0xa1, 0x13, 0x30, 0xad, 0xd4, 0x9a, 0xb5,
-		0x0d, 0x12, 0x67, 0xa9, 0xdb, 0x1b, 0x2f, 0x6d, 0x6c, 0xbe, 0x7f, 0x43, 0x9a, 0x0a, 0x83, 0x85,
-		0x49, 0x8b, 0xa0, 0x59, 0x90, 0x7c, 0x70, 0x63, 0x73, 0x5b, 0xa1, 0xad, 0xf9, 0xd6, 0x28, 0x48,
-		0xfd, 0x52, 0x43, 0xa7, 0x60, 0xa6, 0x59, 0x56, 0x56, 0x6b, 0x4d, 0x95, 0xc5, 0x8e, 0x1e, 0xeb,
-		0x59, 0x90, 0x82, 0x05, 0xd7, 0xea, 0x34, 0x34, 0x5e, 0x80, 0x33, 0x41, 0x68, 0xed, 0x95, 0x66,
-		0x6d, 0xa3, 0x41, 0x2b, 0x2f, 0x6f, 0xac, 0x12, 0xfb, 0xda, 0xc7, 0x4f, 0x44, 0xab, 0x31, 0xd2,
-		0xd4, 0x30, 0xbf, 0xda, 0xda, 0x8a, 0x14, 0xef, 0x07, 0x6f, 0x6e, 0xd4, 0x36, 0xaf, 0x49, 0x89,
-		0xfe, 0xda, 0x69, 0x04, 0x9b, 0x44, 0x25, 0x38, 0xd9, 0x0f, 0x55, 0x6b, 0x1b, 0x4d, 0xe5, 0x55,
-		0x29, 0xd5, 0x5f, 0x71, 0xa3, 0xa6, 0xdc, 0xac, 0x57, 0x6b, 0x52, 0x1a, 0x9d, 0x04, 0x14, 0x6e,
-		0x51, 0xf3, 0xfa, 0xe6, 0x8a, 0x94, 0x19, 0xb0, 0x28, 0xb2, 0x03, 0xb9, 0x60, 0x18, 0xf9, 0x95,
-		0xc9, 0x25, 0x7d, 0x2c, 0x0a, 0xd9, 0x40, 0x58, 0x48, 0xfc, 0x79, 0xad, 0xdd, 0xb6, 0xee, 0xa8,
-		0x5a, 0xdb, 0xd0, 0x1c, 0x6e, 0x6f, 0x80, 0x82, 0xca, 0x04, 0x32, 0xe9, 0xfc, 0x9e, 0xdc, 0xc2,
-		0x27, 0xbf, 0x1a, 0x2d, 0x7c, 0x42, 0x4a, 0xca, 0xdf, 0x1f, 0x01, 0xa9, 0x3f, 0xde, 0xeb, 0xeb,
-		0x7e, 0x64, 0x54, 0xf7, 0xbf, 0x22, 0x63, 0xf7, 0x89, 0x08, 0x14, 0xc2, 0x41, 0x5e, 0x5f, 0xf3,
-		0xce, 0xfe, 0x99, 0x36, 0xef, 0xb7, 0xa2, 0x90, 0x0f, 0x85, 0x76, 0x93, 0xb6, 0xee, 0x83, 0x30,
-		0x6d, 0xb4, 0x70, 0xa7, 0x6b, 0xb9, 0xd8, 0xd4, 0x0f, 0xd5, 0x36, 0xbe, 0x8d, 0xdb, 0x73, 0x32,
-		0x35, 0xca, 0x17, 0x8f, 0x0e, 0x1e, 0x97, 0xea, 0x3e, 0xdd, 0x1a, 0x21, 0x5b, 0x9e, 0xa9, 0xaf,
-		0xd4, 0xd6, 0xb7, 0x36, 0x9b, 0xb5, 0x8d, 0xea, 0xab, 0xc2, 0xba, 0x28, 0x92, 0xd1, 0x87, 0xf6,
-		0x2e, 0x1a, 0xed, 0x2d, 0x90, 0xfa, 0x1b, 0x45, 0x6c, 0xc5, 0x90, 0x66, 0x49, 0x53, 0x68, 0x06,
-		0x8a, 0x1b, 0x9b, 0x6a, 0xa3, 0xbe, 0x52, 0x53, 0x6b, 0xd7, 0xae, 0xd5, 0xaa, 0xcd, 0x06, 0x4b,
-		0x07, 0x7a, 0xd8, 0x4d, 0x29, 0x1a, 0x14, 0xf1, 0xf7, 0xc5, 0x60, 0x66, 0x48, 0x4b, 0x50, 0x99,
-		0x07, 0xf2, 0x2c, 0xb7, 0xf0, 0xc4, 0x24, 0xad, 0x5f, 0x22, 0xae, 0xf4, 0x96, 0x66, 0xbb, 0x3c,
-		0xee, 0x7f, 0x14, 0x88, 0x94, 0x4c, 0x97, 0xac, 0xec, 0x36, 0x4f, 0xb3, 0xb2, 0xe8, 0xbe, 0xe8,
-		0xc3, 0x59, 0xa6, 0xf5, 0x71, 0x40, 0x5d, 0xcb, 0x31, 0x5c, 0xe3, 0x36, 0x56, 0x0d, 0x53, 0xe4,
-		0x64, 0x49, 0xb4, 0x1f, 0x57, 0x24, 0x51, 0x52, 0x37, 0x5d, 0x0f, 0xdb, 0xc4, 0x7b, 0x5a, 0x1f,
-		0x36, 0xf1, 0x3c, 0x62, 0x8a, 0x24, 0x4a, 0x3c, 0xec, 0xb3, 0x90, 0x6b, 0x59, 0x3d, 0x12, 0x02,
-		0x31, 0x3c, 0x62, 0x2d, 0x22, 0x4a, 0x96, 0xc1, 0x3c, 0x14, 0x1e, 0xdc, 0xfa, 0xc9, 0xe0, 0x9c,
-		0x92, 0x65, 0x30, 0x86, 0x72, 0x0e, 0x8a, 0xda, 0xde, 0x9e, 0x4d, 0x98, 0x0b, 0x46, 0x2c, 0x5c,
-		0x2f, 0x78, 0x60, 0x8a, 0x58, 0xba, 0x01, 0x69, 0x21, 0x07, 0xe2, 0xc1, 0x12, 0x49, 0xa8, 0x5d,
-		0x96, 0x83, 0x8a, 0x9e, 0xcf, 0x28, 0x69, 0x53, 0x14, 0x9e, 0x85, 0x9c, 0xe1, 0xa8, 0xfe, 0xde,
-		0x56, 0x74, 0x31, 0x7a, 0x3e, 0xad, 0x64, 0x0d, 0xc7, 0xdb, 0x17, 0x90, 0x7f, 0x34, 0x0a, 0x85,
-		0xf0, 0xae, 0x1d, 0x5a, 0x81, 0x74, 0xdb, 0xd2, 0x35, 0xaa, 0x5a, 0x6c, 0xcb, 0xf8, 0xfc, 0x98,
-		0x8d, 0xbe, 0xa5, 0x35, 0x8e, 0xaf, 0x78, 0x94, 0xa5, 0x7f, 0x13, 0x81, 0xb4, 0x00, 0xa3, 0x93,
-		0x10, 0xef, 0x6a, 0xee, 0x3e, 0x65, 0x97, 0xa8, 0x44, 0xa5, 0x88, 0x42, 0xbf, 0x09, 0xdc, 0xe9,
-		0x6a, 0x26, 0x55, 0x01, 0x0e, 0x27, 0xdf, 0x64, 0x5c, 0xdb, 0x58, 0x6b, 0xd1, 0x5c, 0x80, 0xd5,
-		0xe9, 0x60, 0xd3, 0x75, 0xc4, 0xb8, 0x72, 0x78, 0x95, 0x83, 0xd1, 0x63, 0x30, 0xed, 0xda, 0x9a,
-		0xd1, 0x0e, 0xe1, 0xc6, 0x29, 0xae, 0x24, 0x0a, 0x3c, 0xe4, 0x65, 0x38, 0x2d, 0xf8, 0xb6, 0xb0,
-		0xab, 0xe9, 0xfb, 0xb8, 0xe5, 0x13, 0x25, 0x69, 0xce, 0xef, 0x14, 0x47, 0x58, 0xe1, 0xe5, 0x82,
-		0x56, 0xfe, 0x6c, 0x14, 0xa6, 0x45, 0xf6, 0xa2, 0xe5, 0x09, 0x6b, 0x1d, 0x40, 0x33, 0x4d, 0xcb,
-		0x0d, 0x8a, 0x6b, 0x50, 0x95, 0x07, 0xe8, 0x96, 0xca, 0x1e, 0x91, 0x12, 0x60, 0x50, 0xfa, 0xbd,
-		0x08, 0x80, 0x5f, 0x34, 0x52, 0x6e, 0x0b, 0x90, 0xe5, 0x7b, 0xb2, 0x74, 0x63, 0x9f, 0x25, 0xbc,
-		0x80, 0x81, 0xae, 0x19, 0x6d, 0x9a, 0x96, 0xdc, 0xc1, 0x7b, 0x86, 0xc9, 0xf7, 0x53, 0xd8, 0x87,
-		0x48, 0x4b, 0xc6, 0xfd, 0xed, 0x29, 0x05, 0xd2, 0x0e, 0xee, 0x68, 0xa6, 0x6b, 0xe8, 0x7c, 0x87,
-		0xe4, 0xca, 0xb1, 0x1a, 0xbf, 0xd4, 0xe0, 0xd4, 0x8a, 0xc7, 0x47, 0x3e, 0x0f, 0x69, 0x01, 0x25,
-		0x8e, 0xdf, 0xc6, 0xe6, 0x46, 0x4d, 0x9a, 0x42, 0x29, 0x88, 0x35, 0x6a, 0x4d, 0x29, 0x42, 0xc2,
-		0xce, 0xf2, 0x5a, 0xbd, 0xdc, 0x90, 0xa2, 0x95, 0xbf, 0x02, 0x33, 0xba, 0xd5, 0xe9, 0xaf, 0xb0,
-		0x22, 0xf5, 0xa5, 0xfc, 0x9c, 0xeb, 0x91, 0xd7, 0x9e, 0xe0, 0x48, 0x7b, 0x56, 0x5b, 0x33, 0xf7,
-		0x96, 0x2c, 0x7b, 0xcf, 0x3f, 0x16, 0x41, 0xa2, 0x03, 0x27, 0x70, 0x38, 0xa2, 0xbb, 0xf3, 0xa7,
-		0x91, 0xc8, 0x0f, 0x46, 0x63, 0xab, 0x5b, 0x95, 0x1f, 0x8f, 0x96, 0x56, 0x19, 0xe1, 0x96, 0xe8,
-		0x8e, 0x82, 0x77, 0xdb, 0x58, 0x27, 0x8d, 0x87, 0xdf, 0x7f, 0x0c, 0x66, 0xf7, 0xac, 0x3d, 0x8b,
-		0x72, 0xba, 0x48, 0x7e, 0xf1, 0x73, 0x15, 0x19, 0x0f, 0x5a, 0x1a, 0x7b, 0x08, 0x63, 0x79, 0x03,
-		0x66, 0x38, 0xb2, 0x4a, 0xb7, 0x6f, 0x59, 0x72, 0x01, 0x1d, 0x99, 0xd9, 0x9e, 0xfb, 0xc9, 0xdf,
-		0xa1, 0x5e, 0x89, 0x32, 0xcd, 0x49, 0x49, 0x19, 0xcb, 0x3f, 0x2c, 0x2b, 0x70, 0x22, 0xc4, 0x8f,
-		0xd9, 0x08, 0x6c, 0x8f, 0xe1, 0xf8, 0xcf, 0x39, 0xc7, 0x99, 0x00, 0xc7, 0x06, 0x27, 0x5d, 0xae,
-		0x42, 0xfe, 0x38, 0xbc, 0xfe, 0x05, 0xe7, 0x95, 0xc3, 0x41, 0x26, 0xab, 0x50, 0xa4, 0x4c, 0xf4,
-		0x9e, 0xe3, 0x5a, 0x1d, 0x6a, 0x80, 0x8f, 0x66, 0xf3, 0x2f, 0x7f, 0x87, 0x4d, 0xda, 0x02, 0x21,
-		0xab, 0x7a, 0x54, 0xcb, 0xcb, 0x40, 0x77, 0xac, 0x5b, 0x58, 0x6f, 0x8f, 0xe1, 0xf0, 0x2b, 0xbc,
-		0x21, 0x1e, 0xfe, 0xf2, 0x4d, 0x98, 0x25, 0xbf, 0xa9, 0x7d, 0x0c, 0xb6, 0x64, 0x7c, 0x1a, 0x7c,
-		0xee, 0xdf, 0x7d, 0x98, 0xd9, 0x85, 0x19, 0x8f, 0x41, 0xa0, 0x4d, 0x81, 0x51, 0xdc, 0xc3, 0xae,
-		0x8b, 0x6d, 0x47, 0xd5, 0xda, 0xc3, 0x9a, 0x17, 0xc8, 0x23, 0xce, 0x7d, 0xef, 0x17, 0xc3, 0xa3,
-		0xb8, 0xca, 0x28, 0xcb, 0xed, 0xf6, 0xf2, 0x36, 0x9c, 0x1a, 0xa2, 0x15, 0x13, 0xf0, 0xfc, 0x3e,
-		0xce, 0x73, 0x76, 0x40, 0x33, 0x08, 0xdb, 0x2d, 0x10, 0x70, 0x6f, 0x2c, 0x27, 0xe0, 0xf9, 0x71,
-		0xce, 0x13, 0x71, 0x5a, 0x31, 0xa4, 0x84, 0xe3, 0x0d, 0x98, 0xbe, 0x8d, 0xed, 0x1d, 0xcb, 0xe1,
-		0xb9, 0xdb, 0x09, 0xd8, 0x7d, 0x82, 0xb3, 0x2b, 0x72, 0x42, 0x9a, 0xcc, 0x25, 0xbc, 0x9e, 0x87,
-		0xf4, 0xae, 0xa6, 0xe3, 0x09, 0x58, 0xdc, 0xe3, 0x2c, 0x52, 0x04, 0x9f, 0x90, 0x96, 0x21, 0xb7,
-		0x67, 0xf1, 0x25, 0x72, 0x3c, 0xf9, 0xf7, 0x73, 0xf2, 0xac, 0xa0, 0xe1, 0x2c, 0xba, 0x56, 0xb7,
-		0xd7, 0x26, 0xeb, 0xe7, 0x78, 0x16, 0x3f, 0x20, 0x58, 0x08, 0x1a, 0xce, 0xe2, 0x18, 0x62, 0xfd,
-		0xa4, 0x60, 0xe1, 0x04, 0xe4, 0xf9, 0x22, 0x64, 0x2d, 0xb3, 0x7d, 0x68, 0x99, 0x93, 0x34, 0xe2,
-		0x53, 0x9c, 0x03, 0x70, 0x12, 0xc2, 0xe0, 0x2a, 0x64, 0x26, 0x1d, 0x88, 0x1f, 0xfe, 0xa2, 0x98,
-		0x1e, 0x62, 0x04, 0x56, 0xa1, 0x28, 0x0c, 0x94, 0x61, 0x99, 0x13, 0xb0, 0xf8, 0x11, 0xce, 0xa2,
-		0x10, 0x20, 0xe3, 0xdd, 0x70, 0xb1, 0xe3, 0xee, 0xe1, 0x49, 0x98, 0xfc, 0xa8, 0xe8, 0x06, 0x27,
-		0xe1, 0xa2, 0xdc, 0xc1, 0xa6, 0xbe, 0x3f, 0x19, 0x87, 0x4f, 0x0b, 0x51, 0x0a, 0x1a, 0xc2, 0xa2,
-		0x0a, 0xf9, 0x8e, 0x66, 0x3b, 0xfb, 0x5a, 0x7b, 0xa2, 0xe1, 0xf8, 0x31, 0xce, 0x23, 0xe7, 0x11,
-		0x71, 0x89, 0xf4, 0xcc, 0xe3, 0xb0, 0xf9, 0x71, 0x21, 0x91, 0x00, 0x19, 0x9f, 0x7a, 0x8e, 0x4b,
-		0x13, 0xdd, 0xc7, 0xe1, 0xf6, 0xb7, 0xc5, 0xd4, 0x63, 0xb4, 0xeb, 0x41, 0x8e, 0x57, 0x21, 0xe3,
-		0x18, 0x6f, 0x4c, 0xc4, 0xe6, 0xef, 0x88, 0x91, 0xa6, 0x04, 0x84, 0xf8, 0x55, 0x38, 0x3d, 0x74,
-		0x99, 0x98, 0x80, 0xd9, 0xdf, 0xe5, 0xcc, 0x4e, 0x0e, 0x59, 0x2a, 0xb8, 0x49, 0x38, 0x2e, 0xcb,
-		0xbf, 0x27, 0x4c, 0x02, 0xee, 0xe3, 0xb5, 0x45, 0x82, 0x16, 0x47, 0xdb, 0x3d, 0x9e, 0xd4, 0xfe,
-		0xbe, 0x90, 0x1a, 0xa3, 0x0d, 0x49, 0xad, 0x09, 0x27, 0x39, 0xc7, 0xe3, 0x8d, 0xeb, 0x4f, 0x08,
-		0xc3, 0xca, 0xa8, 0xb7, 0xc3, 0xa3, 0xfb, 0x35, 0x50, 0xf2, 0xc4, 0x29, 0xbc, 0x63, 0x47, 0xed,
-		0x68, 0xdd, 0x09, 0x38, 0xff, 0x24, 0xe7, 0x2c, 0x2c, 0xbe, 0xe7, 0x5e, 0x3b, 0xeb, 0x5a, 0x97,
-		0x30, 0x7f, 0x05, 0xe6, 0x04, 0xf3, 0x9e, 0x69, 0x63, 0xdd, 0xda, 0x33, 0x8d, 0x37, 0x70, 0x6b,
-		0x02, 0xd6, 0x3f, 0xd5, 0x37, 0x54, 0xdb, 0x01, 0x72, 0xc2, 0xb9, 0x0e, 0x92, 0xe7, 0xab, 0xa8,
-		0x46, 0xa7, 0x6b, 0xd9, 0xee, 0x18, 0x8e, 0x3f, 0x2d, 0x46, 0xca, 0xa3, 0xab, 0x53, 0xb2, 0xe5,
-		0x1a, 0xb0, 0xd3, 0x1f, 0x93, 0xaa, 0xe4, 0x67, 0x38, 0xa3, 0xbc, 0x4f, 0xc5, 0x0d, 0x87, 0x6e,
-		0x75, 0xba, 0x9a, 0x3d, 0x89, 0xfd, 0xfb, 0x07, 0xc2, 0x70, 0x70, 0x12, 0x6e, 0x38, 0x88, 0x47,
-		0x47, 0x56, 0xfb, 0x09, 0x38, 0xfc, 0x8c, 0x30, 0x1c, 0x82, 0x86, 0xb3, 0x10, 0x0e, 0xc3, 0x04,
-		0x2c, 0x7e, 0x56, 0xb0, 0x10, 0x34, 0x84, 0xc5, 0xcb, 0xfe, 0x42, 0x6b, 0xe3, 0x3d, 0xc3, 0x71,
-		0x6d, 0xe6, 0x92, 0x1f, 0xcd, 0xea, 0xe7, 0xbe, 0x18, 0x76, 0xc2, 0x94, 0x00, 0x29, 0xb1, 0x44,
-		0x7c, 0xeb, 0x83, 0x86, 0x6c, 0xe3, 0x1b, 0xf6, 0xf3, 0xc2, 0x12, 0x05, 0xc8, 0x48, 0xdb, 0x02,
-		0x1e, 0x22, 0x11, 0xbb, 0x4e, 0x02, 0x95, 0x09, 0xd8, 0xfd, 0xc3, 0xbe, 0xc6, 0x35, 0x04, 0x2d,
-		0xe1, 0x19, 0xf0, 0x7f, 0x7a, 0xe6, 0x2d, 0x7c, 0x38, 0x91, 0x76, 0xfe, 0x42, 0x9f, 0xff, 0xb3,
-		0xcd, 0x28, 0x99, 0x0d, 0x29, 0xf6, 0xf9, 0x53, 0x68, 0xdc, 0x59, 0xbf, 0xb9, 0x6f, 0x78, 0x9b,
-		0xf7, 0x37, 0xec, 0x4e, 0x2d, 0xaf, 0x11, 0x25, 0x0f, 0x3b, 0x3d, 0xe3, 0x99, 0x7d, 0xf8, 0x6d,
-		0x4f, 0xcf, 0x43, 0x3e, 0xcf, 0xf2, 0x35, 0xc8, 0x87, 0x1c, 0x9e, 0xf1, 0xac, 0xbe, 0x91, 0xb3,
-		0xca, 0x05, 0xfd, 0x9d, 0xe5, 0xcb, 0x10, 0x27, 0xce, 0xcb, 0x78, 0xf2, 0x6f, 0xe2, 0xe4, 0x14,
-		0x7d, 0xf9, 0x05, 0x48, 0x0b, 0xa7, 0x65, 0x3c, 0xe9, 0x5f, 0xe3, 0xa4, 0x1e, 0x09, 0x21, 0x17,
-		0x0e, 0xcb, 0x78, 0xf2, 0xbf, 0x2e, 0xc8, 0x05, 0x09, 0x21, 0x9f, 0x5c, 0x84, 0xbf, 0xf4, 0x2d,
-		0x71, 0xbe, 0xe8, 0x08, 0xd9, 0x5d, 0x85, 0x14, 0xf7, 0x54, 0xc6, 0x53, 0x7f, 0x33, 0xaf, 0x5c,
-		0x50, 0x2c, 0x3f, 0x0b, 0x89, 0x09, 0x05, 0xfe, 0x6d, 0x9c, 0x94, 0xe1, 0x2f, 0x57, 0x21, 0x1b,
-		0xf0, 0x4e, 0xc6, 0x93, 0x7f, 0x3b, 0x27, 0x0f, 0x52, 0x91, 0xa6, 0x73, 0xef, 0x64, 0x3c, 0x83,
-		0xbf, 0x21, 0x9a, 0xce, 0x29, 0x88, 0xd8, 0x84, 0x63, 0x32, 0x9e, 0xfa, 0xa3, 0x42, 0xea, 0x82,
-		0x64, 0xf9, 0x45, 0xc8, 0x78, 0x8b, 0xcd, 0x78, 0xfa, 0xef, 0xe0, 0xf4, 0x3e, 0x0d, 0x91, 0x40,
-		0x60, 0xb1, 0x1b, 0xcf, 0xe2, 0x6f, 0x0a, 0x09, 0x04, 0xa8, 0xc8, 0x34, 0xea, 0x77, 0x60, 0xc6,
-		0x73, 0xfa, 0x4e, 0x31, 0x8d, 0xfa, 0xfc, 0x17, 0x32, 0x9a, 0xd4, 0xe6, 0x8f, 0x67, 0xf1, 0x5d,
-		0x62, 0x34, 0x29, 0x3e, 0x69, 0x46, 0xbf, 0x47, 0x30, 0x9e, 0xc7, 0xf7, 0x88, 0x66, 0xf4, 0x39,
-		0x04, 0xcb, 0x5b, 0x80, 0x06, 0xbd, 0x81, 0xf1, 0xfc, 0x3e, 0xc6, 0xf9, 0x4d, 0x0f, 0x38, 0x03,
-		0xcb, 0xef, 0x87, 0x93, 0xc3, 0x3d, 0x81, 0xf1, 0x5c, 0xbf, 0xf7, 0xed, 0xbe, 0xd8, 0x2d, 0xe8,
-		0x08, 0x2c, 0x37, 0xfd, 0x25, 0x25, 0xe8, 0x05, 0x8c, 0x67, 0xfb, 0x7d, 0x6f, 0x87, 0x0d, 0x77,
-		0xd0, 0x09, 0x58, 0x2e, 0x03, 0xf8, 0x0b, 0xf0, 0x78, 0x5e, 0x9f, 0xe0, 0xbc, 0x02, 0x44, 0x64,
-		0x6a, 0xf0, 0xf5, 0x77, 0x3c, 0xfd, 0x3d, 0x31, 0x35, 0x38, 0x05, 0x99, 0x1a, 0x62, 0xe9, 0x1d,
-		0x4f, 0xfd, 0xfd, 0x62, 0x6a, 0x08, 0x12, 0xa2, 0xd9, 0x81, 0xd5, 0x6d, 0x3c, 0x87, 0x4f, 0x09,
-		0xcd, 0x0e, 0x50, 0x2d, 0x6f, 0xc0, 0xf4, 0xc0, 0x82, 0x38, 0x9e, 0xd5, 0x0f, 0x72, 0x56, 0x52,
-		0xff, 0x7a, 0x18, 0x5c, 0xbc, 0xf8, 0x62, 0x38, 0x9e, 0xdb, 0x0f, 0xf5, 0x2d, 0x5e, 0x7c, 0x2d,
-		0x5c, 0xbe, 0x0a, 0x69, 0xb3, 0xd7, 0x6e, 0x93, 0xc9, 0x83, 0x8e, 0x3e, 0x9f, 0x3b, 0xf7, 0x5f,
-		0xbf, 0xc4, 0xa5, 0x23, 0x08, 0x96, 0x2f, 0x43, 0x02, 0x77, 0x76, 0x70, 0x6b, 0x1c, 0xe5, 0x17,
-		0xbe, 0x24, 0x0c, 0x26, 0xc1, 0x5e, 0x7e, 0x11, 0x80, 0xa5, 0x46, 0xe8, 0xc6, 0xf9, 0x18, 0xda,
-		0xdf, 0xfb, 0x12, 0x3f, 0x10, 0xe7, 0x93, 0xf8, 0x0c, 0xd8, 0xf1, 0xba, 0xa3, 0x19, 0x7c, 0x31,
-		0xcc, 0x80, 0x8e, 0xc8, 0xf3, 0x90, 0x7a, 0xdd, 0xb1, 0x4c, 0x57, 0xdb, 0x1b, 0x47, 0xfd, 0xfb,
-		0x9c, 0x5a, 0xe0, 0x13, 0x81, 0x75, 0x2c, 0x1b, 0xbb, 0xda, 0x9e, 0x33, 0x8e, 0xf6, 0xbf, 0x71,
-		0x5a, 0x8f, 0x80, 0x10, 0xeb, 0x9a, 0xe3, 0x4e, 0xd2, 0xef, 0xff, 0x2e, 0x88, 0x05, 0x01, 0x69,
-		0x34, 0xf9, 0x7d, 0x0b, 0x1f, 0x8e, 0xa3, 0xfd, 0x03, 0xd1, 0x68, 0x8e, 0xbf, 0xfc, 0x02, 0x64,
-		0xc8, 0x4f, 0x76, 0xca, 0x75, 0x0c, 0xf1, 0x1f, 0x72, 0x62, 0x9f, 0x82, 0xd4, 0xec, 0xb8, 0x2d,
-		0xd7, 0x18, 0x2f, 0xec, 0x3f, 0xe2, 0x23, 0x2d, 0xf0, 0x97, 0xcb, 0x90, 0x75, 0xdc, 0x56, 0xab,
-		0xc7, 0xfd, 0xd3, 0x31, 0xe4, 0x7f, 0xfc, 0x25, 0x2f, 0x65, 0xe1, 0xd1, 0x90, 0xd1, 0xbe, 0x73,
-		0xcb, 0xed, 0x5a, 0x74, 0xbf, 0x65, 0x1c, 0x87, 0xb7, 0x39, 0x87, 0x00, 0xc9, 0x72, 0x15, 0x72,
-		0xa4, 0x2f, 0x36, 0xee, 0x62, 0xba, 0x39, 0x36, 0x86, 0xc5, 0x9f, 0x70, 0x01, 0x84, 0x88, 0x2a,
-		0x5f, 0xff, 0x2b, 0x6f, 0xcd, 0x47, 0x3e, 0xfb, 0xd6, 0x7c, 0xe4, 0xb7, 0xde, 0x9a, 0x8f, 0x7c,
-		0xf4, 0x73, 0xf3, 0x53, 0x9f, 0xfd, 0xdc, 0xfc, 0xd4, 0x6f, 0x7c, 0x6e, 0x7e, 0x6a, 0x78, 0x96,
-		0x18, 0x56, 0xad, 0x55, 0x8b, 0xe5, 0x87, 0x5f, 0x7b, 0x78, 0xcf, 0x70, 0xf7, 0x7b, 0x3b, 0x4b,
-		0xba, 0xd5, 0xb9, 0xa8, 0x5b, 0x4e, 0xc7, 0x72, 0x2e, 0x86, 0xf3, 0xba, 0xf4, 0x17, 0xfc, 0xef,
-		0x08, 0x89, 0x99, 0xc3, 0xe9, 0x5c, 0xcd, 0x3c, 0x1c, 0x75, 0x99, 0xee, 0x0a, 0xc4, 0xca, 0xe6,
-		0x21, 0x3a, 0xcd, 0x0c, 0x9c, 0xda, 0xb3, 0xdb, 0xfc, 0xa8, 0x65, 0x8a, 0x7c, 0x6f, 0xdb, 0x6d,
-		0x34, 0xeb, 0x9f, 0x87, 0x8e, 0x9c, 0xcf, 0xf1, 0x43, 0xce, 0x95, 0x6f, 0x8f, 0x1c, 0xaf, 0x27,
-		0xe9, 0xb2, 0x79, 0x48, 0x3b, 0xb2, 0x15, 0x79, 0xed, 0xf1, 0xb1, 0x79, 0xee, 0x5b, 0xa6, 0x75,
-		0xc7, 0x24, 0xcd, 0xee, 0xee, 0x88, 0x1c, 0xf7, 0x7c, 0x7f, 0x8e, 0xfb, 0xfd, 0xb8, 0xdd, 0x7e,
-		0x89, 0xe0, 0x35, 0x09, 0xc9, 0x4e, 0x92, 0x9d, 0xea, 0x87, 0xef, 0x8c, 0xc2, 0xfc, 0x40, 0x3a,
-		0x9b, 0x2b, 0xc1, 0x28, 0x21, 0x2c, 0x43, 0x7a, 0x45, 0xe8, 0xd6, 0x1c, 0xa4, 0x1c, 0xac, 0x5b,
-		0x66, 0xcb, 0xa1, 0x82, 0x88, 0x29, 0xe2, 0x93, 0x08, 0xc2, 0xd4, 0x4c, 0xcb, 0xe1, 0x87, 0x95,
-		0xd9, 0x47, 0xe5, 0xe3, 0xc7, 0x14, 0x44, 0x5e, 0xd4, 0x24, 0xa4, 0xf1, 0xd4, 0x84, 0xd2, 0x10,
-		0x9d, 0x08, 0x65, 0xfe, 0x27, 0x95, 0xca, 0xf7, 0x44, 0x61, 0xa1, 0x5f, 0x2a, 0x64, 0x66, 0x39,
-		0xae, 0xd6, 0xe9, 0x8e, 0x12, 0xcb, 0x55, 0xc8, 0x34, 0x05, 0xce, 0xb1, 0xe5, 0x72, 0xef, 0x98,
-		0x72, 0x29, 0x78, 0x55, 0x09, 0xc1, 0x5c, 0x9a, 0x50, 0x30, 0x5e, 0x3f, 0xee, 0x4b, 0x32, 0xff,
-		0x2b, 0x09, 0xa7, 0xd9, 0x74, 0x52, 0xd9, 0x54, 0x62, 0x1f, 0x5c, 0x26, 0xb9, 0x60, 0xd1, 0xf8,
-		0x7d, 0x12, 0xf9, 0x25, 0x98, 0xa9, 0x13, 0x6b, 0x41, 0xa2, 0x20, 0x7f, 0x87, 0x67, 0xe8, 0x79,
-		0xee, 0xc5, 0x90, 0xc3, 0xcf, 0xf7, 0xb7, 0x82, 0x20, 0xf9, 0x1b, 0x22, 0x20, 0x35, 0x74, 0xad,
-		0xad, 0xd9, 0x5f, 0x2e, 0x2b, 0xf4, 0x2c, 0x00, 0x3b, 0xee, 0xe1, 0x5d, 0xdc, 0x2b, 0x5c, 0x9a,
-		0x5b, 0x0a, 0x76, 0x6e, 0x89, 0xd5, 0x44, 0x4f, 0x50, 0x65, 0x28, 0x2e, 0xf9, 0x79, 0xe1, 0x15,
-		0x00, 0xbf, 0x00, 0x9d, 0x81, 0x53, 0x8d, 0x6a, 0x79, 0xad, 0xac, 0x88, 0x43, 0x42, 0x8d, 0xad,
-		0x5a, 0xb5, 0x7e, 0xad, 0x5e, 0x5b, 0x91, 0xa6, 0xd0, 0x49, 0x40, 0xc1, 0x42, 0xef, 0x50, 0xd3,
-		0x09, 0x98, 0x0e, 0xc2, 0xd9, 0x2d, 0x95, 0x28, 0xf1, 0x14, 0x8d, 0x4e, 0xb7, 0x8d, 0xe9, 0xce,
-		0xa3, 0x6a, 0x08, 0xa9, 0x8d, 0x77, 0x42, 0x7e, 0xf5, 0xdf, 0xb3, 0x9b, 0x0b, 0x33, 0x3e, 0xb9,
-		0x27, 0xf3, 0xe5, 0x35, 0x98, 0xd6, 0x74, 0x1d, 0x77, 0x43, 0x2c, 0xc7, 0x98, 0x6a, 0xc2, 0x90,
-		0xee, 0xa5, 0x72, 0x4a, 0x9f, 0xdb, 0xb3, 0x90, 0x74, 0x68, 0xef, 0xc7, 0xb1, 0xf8, 0x35, 0xce,
-		0x82, 0xa3, 0x2f, 0x9b, 0x30, 0x4d, 0x3c, 0x3f, 0xcd, 0xc6, 0x81, 0x66, 0x1c, 0x9d, 0x67, 0xf8,
-		0x47, 0x3f, 0xfd, 0x24, 0xdd, 0x59, 0x3d, 0x1b, 0x1e, 0x96, 0x21, 0xea, 0xa4, 0x48, 0x9c, 0xb7,
-		0xdf, 0x50, 0x0c, 0x05, 0x51, 0x1f, 0x6f, 0xf0, 0xd1, 0x95, 0xfd, 0x22, 0xaf, 0x6c, 0x7e, 0x98,
-		0x0e, 0x04, 0x6a, 0xca, 0x73, 0xae, 0xac, 0xa0, 0x52, 0x1b, 0x35, 0xa7, 0x5f, 0x7b, 0x6c, 0x70,
-		0x75, 0x62, 0xff, 0x3d, 0x41, 0x39, 0x5f, 0x0d, 0x56, 0xe3, 0xcd, 0xbd, 0x8f, 0xc7, 0x61, 0x5a,
-		0xeb, 0x18, 0xa6, 0x75, 0x91, 0xfe, 0xcb, 0xe7, 0x5c, 0x82, 0x7e, 0x4c, 0xb0, 0x29, 0x79, 0x85,
-		0x4d, 0x85, 0xf1, 0x1a, 0xf3, 0x87, 0xdf, 0xfa, 0x23, 0x09, 0x7f, 0xba, 0x2c, 0xaf, 0x83, 0x24,
-		0x0e, 0xf1, 0x62, 0x53, 0xb7, 0x5a, 0x13, 0x65, 0x29, 0xfe, 0x48, 0xf0, 0x10, 0xf9, 0xad, 0x1a,
-		0x27, 0x5d, 0x7e, 0x2f, 0xa4, 0x3d, 0x36, 0xe3, 0x3c, 0x13, 0xc1, 0xc4, 0xa3, 0x20, 0x7e, 0x09,
-		0x9b, 0x99, 0x93, 0x78, 0xa1, 0x6f, 0x0b, 0x7a, 0x36, 0x43, 0x37, 0x48, 0x6f, 0x56, 0xa1, 0xd0,
-		0xb2, 0x4c, 0x57, 0xb5, 0x3a, 0x86, 0x8b, 0x3b, 0x5d, 0x77, 0xac, 0x5f, 0xf7, 0x27, 0x8c, 0x49,
-		0x5a, 0xc9, 0x13, 0xba, 0x4d, 0x41, 0x46, 0x5a, 0xc2, 0xee, 0xe9, 0x4d, 0xd2, 0x92, 0xff, 0xe1,
-		0xb5, 0x84, 0xd2, 0x90, 0x96, 0xdc, 0x97, 0x76, 0x38, 0xad, 0x5b, 0xdc, 0x98, 0xbb, 0x07, 0x4c,
-		0x0b, 0x3c, 0xed, 0xf8, 0xa7, 0x71, 0x98, 0xe7, 0xc8, 0x3b, 0x9a, 0x83, 0x2f, 0xde, 0x7e, 0x6a,
-		0x07, 0xbb, 0xda, 0x53, 0x17, 0x75, 0xcb, 0x10, 0x2b, 0xf9, 0x0c, 0x37, 0xd6, 0xa4, 0x7c, 0x89,
-		0x97, 0x97, 0x86, 0x6e, 0x77, 0x97, 0x46, 0x1b, 0xf9, 0xd2, 0xa0, 0x0e, 0xca, 0x6d, 0x88, 0x57,
-		0x2d, 0xc3, 0x24, 0x6b, 0x5b, 0x0b, 0x9b, 0x56, 0x87, 0x9b, 0x5b, 0xf6, 0x81, 0xae, 0x43, 0x52,
-		0xeb, 0x58, 0x3d, 0xd3, 0x65, 0xa6, 0xb6, 0xf2, 0xe4, 0xaf, 0xbc, 0xb9, 0x30, 0xf5, 0x9f, 0xde,
-		0x5c, 0x38, 0xc1, 0xd8, 0x3a, 0xad, 0x5b, 0x4b, 0x86, 0x75, 0xb1, 0xa3, 0xb9, 0xfb, 0x64, 0xfa,
-		0xfe, 0xfa, 0x67, 0x9e, 0x00, 0x5e, 0x5f, 0xdd, 0x74, 0x3f, 0xfd, 0xbb, 0x3f, 0x71, 0x21, 0xa2,
-		0x70, 0xfa, 0xe5, 0xf8, 0xe7, 0x3f, 0xb9, 0x10, 0x91, 0xbb, 0x90, 0x5a, 0xc1, 0xfa, 0x11, 0x15,
-		0xd6, 0xfb, 0x2a, 0x7c, 0x8a, 0x57, 0x78, 0x66, 0xb0, 0x42, 0x76, 0x60, 0x6f, 0x05, 0xeb, 0x81,
-		0x6a, 0x57, 0xb0, 0xde, 0x57, 0x63, 0x1d, 0xd2, 0x75, 0xd3, 0x65, 0x97, 0x8d, 0x5e, 0x80, 0x98,
-		0x61, 0xb2, 0xf3, 0xeb, 0x99, 0xca, 0x63, 0xc7, 0xe8, 0x8a, 0x42, 0xe8, 0xe4, 0x4d, 0x48, 0xaf,
-		0x60, 0x9d, 0xb1, 0xaa, 0x42, 0xac, 0x85, 0x75, 0xce, 0xea, 0x3e, 0x1a, 0x49, 0xa8, 0x2b, 0x2b,
-		0xbf, 0xf1, 0xdb, 0xf3, 0x53, 0x1f, 0x7a, 0x6b, 0x7e, 0x6a, 0xa4, 0x3a, 0xc9, 0xe3, 0xd5, 0xc9,
-		0xd3, 0xa2, 0x1f, 0x89, 0xc3, 0x83, 0xf4, 0xb6, 0xaa, 0xdd, 0x31, 0x4c, 0xf7, 0xa2, 0x6e, 0x1f,
-		0x76, 0x5d, 0x8b, 0x18, 0x15, 0x6b, 0x97, 0x2b, 0xd1, 0xb4, 0x5f, 0xbc, 0xc4, 0x8a, 0x87, 0xab,
-		0x90, 0xbc, 0x0b, 0x89, 0x2d, 0x42, 0x47, 0x06, 0xc9, 0xb5, 0x5c, 0xad, 0xcd, 0x3d, 0x21, 0xf6,
-		0x41, 0xa0, 0xec, 0x86, 0x6b, 0x94, 0x41, 0x0d, 0x71, 0xb9, 0xb5, 0x8d, 0xb5, 0x5d, 0x76, 0x51,
-		0x28, 0x46, 0x5d, 0xe8, 0x34, 0x01, 0xd0, 0x3b, 0x41, 0xb3, 0x90, 0xd0, 0x7a, 0xec, 0x30, 0x4f,
-		0x8c, 0xf8, 0xd6, 0xf4, 0x43, 0x7e, 0x09, 0x52, 0x7c, 0x4f, 0x1f, 0x49, 0x10, 0xbb, 0x85, 0x0f,
-		0x69, 0x3d, 0x39, 0x85, 0xfc, 0x44, 0x4b, 0x90, 0xa0, 0x8d, 0xe7, 0x37, 0x20, 0xe7, 0x96, 0x06,
-		0x5a, 0xbf, 0x44, 0x1b, 0xa9, 0x30, 0x34, 0xf9, 0x06, 0xa4, 0x57, 0x2c, 0xa2, 0xd9, 0x61, 0x6e,
-		0x19, 0xc6, 0x8d, 0xb6, 0xb9, 0xdb, 0xe3, 0x7a, 0xa5, 0xb0, 0x0f, 0x74, 0x12, 0x92, 0xec, 0xe2,
-		0x18, 0x3f, 0x90, 0xc4, 0xbf, 0xe4, 0x2a, 0xa4, 0x28, 0xef, 0xcd, 0x2e, 0x71, 0x43, 0xbc, 0xb3,
-		0xf4, 0x19, 0x7e, 0x8d, 0x98, 0xb3, 0x8f, 0xfa, 0x8d, 0x45, 0x10, 0x6f, 0x69, 0xae, 0xc6, 0xfb,
-		0x4d, 0x7f, 0xcb, 0xef, 0x83, 0x34, 0x67, 0xe2, 0xa0, 0x4b, 0x10, 0xb3, 0xba, 0x0e, 0x3f, 0x52,
-		0x54, 0x1a, 0xd5, 0x95, 0xcd, 0x6e, 0x25, 0x4e, 0x74, 0x49, 0x21, 0xc8, 0x95, 0x8d, 0x91, 0x6a,
-		0xf1, 0x4c, 0x48, 0x2d, 0x3a, 0xd8, 0xdd, 0xd9, 0x75, 0xfd, 0x1f, 0x6c, 0x38, 0x07, 0x54, 0xc1,
-		0x53, 0x94, 0x7b, 0x51, 0x98, 0x0f, 0x94, 0xde, 0xc6, 0xb6, 0x63, 0x58, 0x26, 0xd3, 0x26, 0xae,
-		0x29, 0x28, 0xd0, 0x40, 0x5e, 0x3e, 0x42, 0x55, 0x5e, 0x80, 0x58, 0xb9, 0xdb, 0x45, 0x25, 0x48,
-		0xd3, 0x6f, 0xdd, 0x62, 0xba, 0x12, 0x57, 0xbc, 0x6f, 0x52, 0xe6, 0x58, 0xbb, 0xee, 0x1d, 0xcd,
-		0xf6, 0xee, 0x55, 0x8b, 0x6f, 0xf9, 0x79, 0xc8, 0x54, 0x2d, 0xd3, 0xc1, 0xa6, 0xd3, 0xa3, 0xfe,
-		0xf5, 0x4e, 0xdb, 0xd2, 0x6f, 0x71, 0x0e, 0xec, 0x83, 0x08, 0x5b, 0xeb, 0x76, 0x29, 0x65, 0x5c,
-		0x21, 0x3f, 0xd9, 0xcc, 0xae, 0x6c, 0x8e, 0x14, 0xcf, 0xe5, 0xe3, 0x89, 0x87, 0x77, 0xd0, 0x77,
-		0x94, 0x23, 0xf0, 0xc0, 0xe0, 0x44, 0xba, 0x85, 0x0f, 0x9d, 0xe3, 0xce, 0xa3, 0x57, 0x20, 0xb3,
-		0x45, 0x9f, 0x3c, 0x79, 0x09, 0x1f, 0xa2, 0x12, 0xa4, 0x70, 0xeb, 0xd2, 0xe5, 0xcb, 0x4f, 0x3d,
-		0xcf, 0xb4, 0xfc, 0xfa, 0x94, 0x22, 0x00, 0x68, 0x1e, 0x32, 0x0e, 0xd6, 0xbb, 0x97, 0x2e, 0x5f,
-		0xb9, 0xf5, 0x14, 0x53, 0xab, 0xeb, 0x53, 0x8a, 0x0f, 0x5a, 0x4e, 0x93, 0x1e, 0x7f, 0xfe, 0x53,
-		0x0b, 0x91, 0x4a, 0x02, 0x62, 0x4e, 0xaf, 0xf3, 0xae, 0xe9, 0xc6, 0x1f, 0x24, 0x61, 0x31, 0x50,
-		0xca, 0x16, 0xac, 0xdb, 0x5a, 0xdb, 0x68, 0x69, 0xfe, 0x43, 0x35, 0x52, 0xa0, 0xff, 0x14, 0x63,
-		0xc4, 0x4a, 0x74, 0xa4, 0x14, 0xe5, 0x9f, 0x8a, 0x40, 0xee, 0xa6, 0xe0, 0xdc, 0xc0, 0x2e, 0xba,
-		0x0a, 0xe0, 0xd5, 0x24, 0xa6, 0xca, 0x99, 0xa5, 0xfe, 0xba, 0x96, 0x3c, 0x1a, 0x25, 0x80, 0x8e,
-		0x9e, 0xa5, 0x0a, 0xd8, 0xb5, 0x1c, 0x7e, 0xc7, 0x76, 0x0c, 0xa9, 0x87, 0x8c, 0x1e, 0x07, 0x44,
-		0xad, 0x9a, 0x7a, 0xdb, 0x72, 0x0d, 0x73, 0x4f, 0xed, 0x5a, 0x77, 0xf8, 0xcb, 0x05, 0x31, 0x45,
-		0xa2, 0x25, 0x37, 0x69, 0xc1, 0x16, 0x81, 0x93, 0x46, 0x67, 0x3c, 0x2e, 0x24, 0x54, 0xd4, 0x5a,
-		0x2d, 0x1b, 0x3b, 0x0e, 0x37, 0x5c, 0xe2, 0x13, 0x5d, 0x85, 0x54, 0xb7, 0xb7, 0xa3, 0x0a, 0x2b,
-		0x91, 0xbd, 0xf4, 0xc0, 0xb0, 0x39, 0x2f, 0x74, 0x83, 0xcf, 0xfa, 0x64, 0xb7, 0xb7, 0x43, 0x34,
-		0xe5, 0x2c, 0xe4, 0x86, 0x34, 0x26, 0x7b, 0xdb, 0x6f, 0x07, 0x7d, 0x65, 0x87, 0xf7, 0x40, 0xed,
-		0xda, 0x86, 0x65, 0x1b, 0xee, 0x21, 0x3d, 0x0a, 0x18, 0x53, 0x24, 0x51, 0xb0, 0xc5, 0xe1, 0xf2,
-		0x2d, 0x28, 0x36, 0x68, 0x08, 0xe1, 0xb7, 0xfc, 0xb2, 0xdf, 0xbe, 0xc8, 0xf8, 0xf6, 0x8d, 0x6c,
-		0x59, 0x74, 0xa0, 0x65, 0x17, 0xfe, 0x43, 0x04, 0xb2, 0x15, 0x32, 0x71, 0xeb, 0x2b, 0xd7, 0xda,
-		0xda, 0x1e, 0x7a, 0x0a, 0x4e, 0x54, 0xd6, 0x36, 0xab, 0x2f, 0xa9, 0xf5, 0x15, 0xf5, 0xda, 0x5a,
-		0x79, 0xd5, 0x3f, 0x7b, 0x5c, 0x3a, 0x79, 0xf7, 0xde, 0x22, 0x0a, 0xe0, 0x6e, 0x9b, 0x34, 0xce,
-		0x45, 0x17, 0x61, 0x36, 0x4c, 0x52, 0xae, 0x34, 0x6a, 0x1b, 0x4d, 0x29, 0x52, 0x3a, 0x71, 0xf7,
-		0xde, 0xe2, 0x74, 0x80, 0xa2, 0xbc, 0xe3, 0x60, 0xd3, 0x1d, 0x24, 0xa8, 0x6e, 0xae, 0xaf, 0xd7,
-		0x9b, 0x52, 0x74, 0x80, 0xa0, 0x6a, 0x75, 0x3a, 0x86, 0x8b, 0x1e, 0x85, 0xe9, 0x30, 0xc1, 0x46,
-		0x7d, 0x4d, 0x8a, 0x95, 0xd0, 0xdd, 0x7b, 0x8b, 0x85, 0x00, 0xf6, 0x86, 0xd1, 0x2e, 0xa5, 0x3f,
-		0xf2, 0x43, 0xf3, 0x53, 0x9f, 0xfe, 0x5b, 0xf3, 0x91, 0xca, 0xfa, 0xc8, 0x39, 0xf7, 0xf4, 0xf1,
-		0xe6, 0x5c, 0x78, 0xdd, 0xfe, 0xd2, 0x03, 0x21, 0x73, 0xc3, 0x7d, 0xc4, 0x80, 0x31, 0x9e, 0x74,
-		0xba, 0x8d, 0xcb, 0x7b, 0x94, 0x8e, 0x76, 0x0f, 0x4a, 0x63, 0x16, 0x85, 0xd2, 0x58, 0xc3, 0x20,
-		0x3f, 0x0f, 0xf9, 0x2d, 0xcd, 0x76, 0x1b, 0xd8, 0xbd, 0x8e, 0xb5, 0x16, 0xb6, 0xc3, 0xfe, 0x43,
-		0x5e, 0xf8, 0x0f, 0x08, 0xe2, 0xd4, 0x49, 0x60, 0xeb, 0x27, 0xfd, 0x2d, 0xef, 0x43, 0x9c, 0x9e,
-		0x72, 0xf6, 0x7c, 0x0b, 0x4e, 0xc1, 0x7c, 0x0b, 0xb2, 0x32, 0x1c, 0xba, 0xd8, 0x11, 0xa9, 0x39,
-		0xfa, 0x81, 0x9e, 0x11, 0x1e, 0x42, 0xec, 0x68, 0x0f, 0x81, 0x4f, 0x2f, 0xee, 0x27, 0xb4, 0x21,
-		0xc5, 0x87, 0xd8, 0x6b, 0x48, 0xc4, 0x6f, 0x08, 0x5a, 0x87, 0x62, 0x57, 0xb3, 0x5d, 0x7a, 0xeb,
-		0x71, 0x9f, 0xf6, 0x82, 0xcf, 0xe0, 0x85, 0x41, 0x7b, 0x12, 0xea, 0x2c, 0xaf, 0x25, 0xdf, 0x0d,
-		0x02, 0xe5, 0xff, 0x12, 0x87, 0x24, 0x17, 0xc6, 0x0b, 0x90, 0xe2, 0x62, 0xe5, 0x73, 0xee, 0xc1,
-		0xa5, 0xc1, 0x65, 0x76, 0xc9, 0x5b, 0x0e, 0x39, 0x3f, 0x41, 0x83, 0x1e, 0x81, 0xb4, 0xbe, 0xaf,
-		0x19, 0xa6, 0x6a, 0xb4, 0xb8, 0x73, 0x9c, 0x7d, 0xeb, 0xcd, 0x85, 0x54, 0x95, 0xc0, 0xea, 0x2b,
-		0x4a, 0x8a, 0x16, 0xd6, 0x5b, 0xc4, 0xa7, 0xd9, 0xc7, 0xc6, 0xde, 0xbe, 0xcb, 0xed, 0x06, 0xff,
-		0x42, 0xcf, 0x41, 0x9c, 0x28, 0x04, 0xbf, 0x13, 0x5f, 0x1a, 0x88, 0x7a, 0xbc, 0xb4, 0x54, 0x25,
-		0x4d, 0x2a, 0xfe, 0xe8, 0x7f, 0x5e, 0x88, 0x28, 0x94, 0x02, 0x55, 0x21, 0xdf, 0xd6, 0x1c, 0x57,
-		0xa5, 0xeb, 0x31, 0xa9, 0x3e, 0x41, 0x59, 0x9c, 0x1e, 0x14, 0x08, 0x17, 0x2c, 0x6f, 0x7a, 0x96,
-		0x50, 0x31, 0x50, 0x0b, 0x9d, 0x07, 0x89, 0x32, 0xd1, 0xe9, 0x0c, 0x64, 0x5e, 0x62, 0x92, 0xca,
-		0xbd, 0x40, 0xe0, 0x6c, 0x62, 0x52, 0x5f, 0xf1, 0x0c, 0x64, 0xe8, 0x2d, 0x5c, 0x8a, 0xc2, 0x8e,
-		0xd6, 0xa7, 0x09, 0x80, 0x16, 0x9e, 0x83, 0xa2, 0x6f, 0xf5, 0x19, 0x4a, 0x9a, 0x71, 0xf1, 0xc1,
-		0x14, 0xf1, 0x49, 0x98, 0x35, 0xf1, 0x01, 0x3d, 0xec, 0x1f, 0xc2, 0xce, 0x50, 0x6c, 0x44, 0xca,
-		0x6e, 0x86, 0x29, 0x1e, 0x86, 0x82, 0x2e, 0x84, 0xcf, 0x70, 0x81, 0xe2, 0xe6, 0x3d, 0x28, 0x45,
-		0x3b, 0x0d, 0x69, 0xad, 0xdb, 0x65, 0x08, 0x59, 0x6e, 0xf5, 0xbb, 0x5d, 0x5a, 0x74, 0x01, 0xa6,
-		0x69, 0x1f, 0x6d, 0xec, 0xf4, 0xda, 0x2e, 0x67, 0x92, 0xa3, 0x38, 0x45, 0x52, 0xa0, 0x30, 0x38,
-		0xc5, 0x7d, 0x08, 0xf2, 0xf8, 0xb6, 0xd1, 0xc2, 0xa6, 0x8e, 0x19, 0x5e, 0x9e, 0xe2, 0xe5, 0x04,
-		0x90, 0x22, 0x3d, 0x0a, 0x9e, 0x35, 0x57, 0xc5, 0x4a, 0x53, 0x60, 0xfc, 0x04, 0xbc, 0xcc, 0xc0,
-		0xf2, 0x1c, 0xc4, 0x57, 0x34, 0x57, 0x23, 0xee, 0x92, 0x7b, 0xc0, 0x96, 0xcf, 0x9c, 0x42, 0x7e,
-		0xca, 0x3f, 0x1b, 0x83, 0xf8, 0x4d, 0xcb, 0xc5, 0xe8, 0xe9, 0x80, 0x2b, 0x5b, 0x18, 0xa6, 0xcf,
-		0x0d, 0x63, 0xcf, 0xc4, 0xad, 0x75, 0x67, 0x2f, 0xf0, 0x64, 0x8e, 0xaf, 0x4e, 0xd1, 0x90, 0x3a,
-		0xcd, 0x42, 0xc2, 0xb6, 0x7a, 0x66, 0x4b, 0x1c, 0x4a, 0xa7, 0x1f, 0xa8, 0x06, 0x69, 0x4f, 0x4b,
-		0xe2, 0xe3, 0xb4, 0xa4, 0x48, 0xb4, 0x84, 0xe8, 0x30, 0x07, 0x28, 0xa9, 0x1d, 0xae, 0x2c, 0x15,
-		0xc8, 0x78, 0xc6, 0x8b, 0x6b, 0xdb, 0x64, 0x0a, 0xeb, 0x93, 0x91, 0x25, 0xd2, 0x1b, 0x7b, 0x4f,
-		0x78, 0x4c, 0xe3, 0x24, 0xaf, 0x80, 0x4b, 0x2f, 0xa4, 0x56, 0xfc, 0xf9, 0x9e, 0x14, 0xed, 0x97,
-		0xaf, 0x56, 0xec, 0x09, 0x9f, 0x07, 0x20, 0xe3, 0x18, 0x7b, 0xa6, 0xe6, 0xf6, 0x6c, 0xcc, 0x35,
-		0xcf, 0x07, 0x90, 0x52, 0xff, 0x82, 0x06, 0xd3, 0xb4, 0xc0, 0xab, 0x62, 0x17, 0x61, 0xc6, 0x7f,
-		0xcf, 0xcb, 0xe7, 0xc2, 0xb4, 0x0c, 0x79, 0x45, 0x0d, 0x51, 0x22, 0xff, 0x52, 0x04, 0x92, 0x7c,
-		0xc5, 0xf2, 0x87, 0x21, 0x32, 0x7c, 0x18, 0xa2, 0xa3, 0x86, 0x21, 0x76, 0xff, 0xc3, 0x50, 0x06,
-		0xf0, 0x9a, 0xe9, 0xf0, 0x47, 0x5a, 0x86, 0xb8, 0x55, 0xac, 0x89, 0x0d, 0x63, 0x8f, 0xcf, 0xfb,
-		0x00, 0x91, 0xfc, 0x9b, 0x11, 0xe2, 0xe1, 0xf3, 0x72, 0x54, 0x86, 0xbc, 0x68, 0x97, 0xba, 0xdb,
-		0xd6, 0xf6, 0xb8, 0x2a, 0x3e, 0x38, 0xb2, 0x71, 0x64, 0x15, 0x56, 0xb2, 0xbc, 0x3d, 0xd4, 0x9f,
-		0x18, 0x3a, 0xac, 0xd1, 0x11, 0xc3, 0x1a, 0xd2, 0xa3, 0xd8, 0xfd, 0xe9, 0x51, 0x68, 0xc4, 0xe3,
-		0x7d, 0x23, 0x2e, 0xff, 0x76, 0x84, 0xbf, 0x26, 0xd6, 0x62, 0xb7, 0x4b, 0xfe, 0xac, 0x86, 0xea,
-		0x35, 0xae, 0x5b, 0x2d, 0xdc, 0x52, 0x07, 0xc6, 0xec, 0xa1, 0x41, 0x8e, 0xe1, 0x36, 0xfb, 0x63,
-		0x87, 0x04, 0x97, 0x86, 0x3f, 0x86, 0x9f, 0x89, 0xc2, 0xf4, 0x00, 0xfe, 0x9f, 0xbf, 0xb1, 0x0c,
-		0xcf, 0xde, 0xc4, 0x84, 0xb3, 0x37, 0x39, 0x72, 0xf6, 0xfe, 0x74, 0x94, 0x26, 0x00, 0xba, 0x96,
-		0xa3, 0xb5, 0xbf, 0x12, 0xb6, 0xf7, 0x0c, 0x64, 0xba, 0x56, 0x5b, 0x65, 0x25, 0xec, 0x5a, 0x50,
-		0xba, 0x6b, 0xb5, 0x95, 0x01, 0x35, 0x4b, 0xbc, 0x43, 0x86, 0x39, 0xf9, 0x0e, 0x0c, 0x42, 0xaa,
-		0x7f, 0x42, 0xd9, 0x90, 0x63, 0xa2, 0xe0, 0x5e, 0xd3, 0x93, 0x44, 0x06, 0xd4, 0x0d, 0x8b, 0x0c,
-		0x7a, 0x79, 0xac, 0xd9, 0x0c, 0x53, 0xe1, 0x78, 0x84, 0x82, 0x39, 0x19, 0xc3, 0x32, 0x47, 0x41,
-		0x8b, 0xa5, 0x70, 0x3c, 0xf9, 0xbb, 0x23, 0x00, 0x6b, 0x44, 0xb2, 0xb4, 0xbf, 0xc4, 0xdf, 0x71,
-		0x68, 0x13, 0xd4, 0x50, 0xcd, 0xf3, 0xa3, 0x06, 0x8d, 0xd7, 0x9f, 0x73, 0x82, 0xed, 0xae, 0x42,
-		0xde, 0xd7, 0x6d, 0x07, 0x8b, 0xc6, 0xcc, 0x1f, 0x11, 0x95, 0x36, 0xb0, 0xab, 0xe4, 0x6e, 0x07,
-		0xbe, 0xe4, 0x7f, 0x12, 0x81, 0x0c, 0x6d, 0xd3, 0x3a, 0x76, 0xb5, 0xd0, 0x18, 0x46, 0xee, 0x7f,
-		0x0c, 0x1f, 0x04, 0x60, 0x6c, 0x1c, 0xe3, 0x0d, 0xcc, 0x35, 0x2b, 0x43, 0x21, 0x0d, 0xe3, 0x0d,
-		0x8c, 0xae, 0x78, 0x02, 0x8f, 0x1d, 0x2d, 0x70, 0x11, 0xb5, 0x72, 0xb1, 0x9f, 0x82, 0x14, 0x7d,
-		0xef, 0xf2, 0xc0, 0xe1, 0x81, 0x68, 0xd2, 0xec, 0x75, 0x9a, 0x07, 0x8e, 0xfc, 0x3a, 0xa4, 0x9a,
-		0x07, 0x2c, 0x9f, 0x78, 0x06, 0x32, 0xb6, 0x65, 0x71, 0xef, 0x8f, 0x79, 0xdd, 0x69, 0x02, 0xa0,
-		0xce, 0x8e, 0xc8, 0xa1, 0x45, 0xfd, 0x1c, 0x9a, 0x9f, 0x04, 0x8c, 0x4d, 0x94, 0x04, 0x24, 0xd1,
-		0x67, 0x3e, 0x34, 0x93, 0xd0, 0xe3, 0x70, 0xaa, 0x51, 0x5f, 0xdd, 0xa8, 0xad, 0xa8, 0xeb, 0x8d,
-		0xd5, 0xbe, 0x2b, 0xff, 0xa5, 0xe2, 0xdd, 0x7b, 0x8b, 0x59, 0x1e, 0x76, 0x8e, 0xc2, 0xde, 0x52,
-		0x6a, 0x37, 0x37, 0x9b, 0x35, 0x29, 0xc2, 0xb0, 0xb7, 0x6c, 0x7c, 0xdb, 0x72, 0xd9, 0x83, 0xb2,
-		0x4f, 0xc2, 0xe9, 0x21, 0xd8, 0x5e, 0xf0, 0x39, 0x7d, 0xf7, 0xde, 0x62, 0x7e, 0xcb, 0xc6, 0x4c,
-		0xcb, 0x28, 0xc5, 0x12, 0xcc, 0x0d, 0x52, 0x6c, 0x6e, 0x6d, 0x36, 0xca, 0x6b, 0xd2, 0x62, 0x49,
-		0xba, 0x7b, 0x6f, 0x31, 0x27, 0x4c, 0x06, 0xc1, 0x7f, 0xf7, 0xa3, 0xcf, 0x6f, 0x4e, 0x87, 0xb2,
-		0xc6, 0x2c, 0xae, 0xeb, 0x6a, 0xb6, 0xd6, 0x39, 0x6e, 0xf8, 0x39, 0xe6, 0x30, 0x82, 0xfc, 0x53,
-		0x51, 0x28, 0x7a, 0xc1, 0xcd, 0x16, 0xad, 0x01, 0x3d, 0x1d, 0xcc, 0xf8, 0x65, 0x47, 0xae, 0x1d,
-		0x0c, 0x5b, 0x24, 0x04, 0xdf, 0x0b, 0x69, 0xe1, 0x24, 0xf3, 0x49, 0xb5, 0x38, 0x64, 0x7d, 0xe3,
-		0x18, 0x9c, 0xd4, 0xa3, 0x40, 0x2f, 0x42, 0xc6, 0x9b, 0x62, 0xde, 0xa3, 0x6b, 0xa3, 0xe7, 0x24,
-		0xa7, 0xf7, 0x69, 0xd0, 0xf3, 0x7e, 0x18, 0x17, 0x1f, 0x15, 0x18, 0xde, 0x64, 0x08, 0x9c, 0xd8,
-		0x0b, 0xe1, 0x9e, 0x84, 0xb8, 0xb6, 0xa3, 0x1b, 0xdc, 0x00, 0x3f, 0x30, 0x48, 0x57, 0xae, 0x54,
-		0xeb, 0x9c, 0x88, 0x62, 0xca, 0x75, 0x9e, 0x4c, 0xe1, 0xf2, 0xa2, 0x4f, 0xdc, 0x1c, 0xa8, 0x2c,
-		0x16, 0x66, 0xde, 0x45, 0xba, 0xa3, 0x1d, 0x54, 0x68, 0x38, 0x7c, 0x0a, 0x52, 0xa4, 0x70, 0x8f,
-		0x3f, 0xa2, 0x10, 0x53, 0x92, 0x1d, 0xed, 0x60, 0x55, 0x73, 0x6e, 0xc4, 0xd3, 0x31, 0x29, 0x2e,
-		0xff, 0x18, 0xf1, 0x54, 0x42, 0x52, 0x41, 0x8f, 0x01, 0x22, 0x14, 0xda, 0x1e, 0x56, 0xc9, 0xd4,
-		0xa5, 0xe2, 0x15, 0x7c, 0x8b, 0x1d, 0xed, 0xa0, 0xbc, 0x87, 0x37, 0x7a, 0x1d, 0xda, 0x00, 0x07,
-		0xad, 0x83, 0x24, 0x90, 0xc5, 0xc8, 0x72, 0xf1, 0x9f, 0x1e, 0x7c, 0xb4, 0x95, 0x23, 0xb0, 0x05,
-		0xe0, 0x63, 0x64, 0x01, 0x28, 0x30, 0x7e, 0xde, 0xf1, 0x93, 0x50, 0x57, 0x62, 0xe1, 0xae, 0xc8,
-		0x2f, 0x42, 0xb1, 0x6f, 0x04, 0x90, 0x0c, 0x79, 0x9e, 0xb1, 0xa2, 0x5b, 0xfb, 0x2c, 0xc2, 0xc9,
-		0x28, 0x59, 0x96, 0x99, 0xa2, 0x47, 0x1d, 0x96, 0xd3, 0x3f, 0xf7, 0xc9, 0x85, 0x08, 0xdd, 0xf6,
-		0x79, 0x0c, 0xf2, 0xa1, 0x31, 0x10, 0x59, 0xe4, 0x88, 0x9f, 0x45, 0xf6, 0x91, 0x5f, 0x83, 0x1c,
-		0x31, 0x40, 0xb8, 0xc5, 0x71, 0x1f, 0x81, 0x22, 0x33, 0x90, 0xfd, 0xb2, 0x66, 0x0e, 0xcf, 0xba,
-		0x10, 0xb8, 0x2c, 0x3c, 0xa0, 0xb0, 0xd8, 0xb3, 0x02, 0x6b, 0x55, 0x73, 0xe4, 0x4d, 0x00, 0x7f,
-		0x50, 0x51, 0x19, 0x1e, 0x24, 0xc6, 0x23, 0x78, 0x14, 0x95, 0x3f, 0xb7, 0x15, 0xf2, 0x18, 0x4b,
-		0x04, 0xc9, 0x3f, 0x6e, 0xca, 0x1e, 0xdd, 0xba, 0x4e, 0x31, 0x2a, 0x2f, 0x7f, 0xfa, 0xad, 0xf9,
-		0xc8, 0xbb, 0x63, 0x0b, 0xfe, 0xe3, 0xab, 0x70, 0x26, 0x50, 0x48, 0x14, 0x30, 0x94, 0x88, 0x2a,
-		0x06, 0xf4, 0x95, 0x14, 0x8e, 0x4b, 0x28, 0x1d, 0x99, 0xff, 0x2d, 0x1d, 0x6d, 0x76, 0xc6, 0x67,
-		0x9b, 0xc6, 0xe7, 0xbb, 0x86, 0x27, 0xe5, 0xff, 0x71, 0x06, 0x52, 0x0a, 0xfe, 0x60, 0x0f, 0x3b,
-		0x2e, 0xba, 0x04, 0x71, 0xac, 0xef, 0x5b, 0xc3, 0x72, 0xa0, 0xa4, 0x83, 0x4b, 0x1c, 0xaf, 0xa6,
-		0xef, 0x5b, 0xd7, 0xa7, 0x14, 0x8a, 0x8b, 0x2e, 0x43, 0x62, 0xb7, 0xdd, 0xe3, 0xe9, 0xab, 0x3e,
-		0x9b, 0x15, 0x24, 0xba, 0x46, 0x90, 0xae, 0x4f, 0x29, 0x0c, 0x9b, 0x54, 0x45, 0x5f, 0xdb, 0x8e,
-		0x1d, 0x5d, 0x55, 0xdd, 0xdc, 0xa5, 0x55, 0x11, 0x5c, 0x54, 0x01, 0x30, 0x4c, 0xc3, 0x55, 0x69,
-		0x6a, 0x87, 0x5b, 0x8d, 0xb3, 0xa3, 0x29, 0x0d, 0x97, 0x26, 0x83, 0xae, 0x4f, 0x29, 0x19, 0x43,
-		0x7c, 0x90, 0xe6, 0x7e, 0xb0, 0x87, 0xed, 0x43, 0xee, 0xad, 0x8d, 0x6c, 0xee, 0xcb, 0x04, 0x89,
-		0x34, 0x97, 0x62, 0x13, 0x23, 0xcb, 0x1e, 0xee, 0x73, 0x0f, 0xf8, 0x73, 0xb4, 0x0b, 0xa3, 0x28,
-		0xe9, 0xeb, 0x7d, 0xcd, 0x83, 0xeb, 0x53, 0x4a, 0x4a, 0x67, 0x3f, 0xd1, 0x73, 0x9e, 0x0b, 0x96,
-		0x1d, 0xf4, 0x7a, 0x42, 0xb4, 0x2c, 0xed, 0x33, 0x25, 0x5c, 0x31, 0xb4, 0x01, 0x85, 0xb6, 0xe1,
-		0xb8, 0xaa, 0x63, 0x6a, 0x5d, 0x67, 0xdf, 0x72, 0x1d, 0x9a, 0x3f, 0xc9, 0x5e, 0x7a, 0x78, 0x14,
-		0x87, 0x35, 0xc3, 0x71, 0x1b, 0x02, 0xf9, 0xfa, 0x94, 0x92, 0x6f, 0x07, 0x01, 0x84, 0x9f, 0xb5,
-		0xbb, 0x8b, 0x6d, 0x8f, 0x21, 0xcd, 0xb3, 0x1c, 0xc1, 0x6f, 0x93, 0x60, 0x0b, 0x7a, 0xc2, 0xcf,
-		0x0a, 0x02, 0xd0, 0xd7, 0xc0, 0x4c, 0xdb, 0xd2, 0x5a, 0x1e, 0x3b, 0x55, 0xdf, 0xef, 0x99, 0xb7,
-		0x68, 0x52, 0x26, 0x7b, 0xe9, 0xd1, 0x91, 0x8d, 0xb4, 0xb4, 0x96, 0x60, 0x51, 0x25, 0x04, 0xd7,
-		0xa7, 0x94, 0xe9, 0x76, 0x3f, 0x10, 0x7d, 0x00, 0x66, 0xb5, 0x6e, 0xb7, 0x7d, 0xd8, 0xcf, 0xbd,
-		0x48, 0xb9, 0x5f, 0x18, 0xc5, 0xbd, 0x4c, 0x68, 0xfa, 0xd9, 0x23, 0x6d, 0x00, 0x8a, 0x9a, 0x20,
-		0x75, 0x6d, 0x4c, 0x6f, 0x73, 0x75, 0xb9, 0x97, 0x41, 0xdf, 0xba, 0xca, 0x5e, 0x3a, 0x37, 0x8a,
-		0xf7, 0x16, 0xc3, 0x17, 0x4e, 0xc9, 0xf5, 0x29, 0xa5, 0xd8, 0x0d, 0x83, 0x18, 0x57, 0x4b, 0xc7,
-		0xf4, 0x29, 0x3e, 0xce, 0x75, 0x7a, 0x1c, 0x57, 0x8a, 0x1f, 0xe6, 0x1a, 0x02, 0xa1, 0x1a, 0x64,
-		0x59, 0x28, 0xaa, 0x12, 0x63, 0x48, 0x5f, 0xc8, 0xca, 0x5e, 0x92, 0x47, 0xce, 0x50, 0x8a, 0x7a,
-		0xd3, 0x72, 0xf1, 0xf5, 0x29, 0x05, 0xb0, 0xf7, 0x85, 0x34, 0x38, 0x41, 0x1f, 0x0b, 0x3b, 0x54,
-		0xc3, 0x86, 0x77, 0x6e, 0x86, 0x32, 0x7c, 0x6c, 0x14, 0xc3, 0x9b, 0x94, 0xe8, 0x66, 0xd0, 0x0e,
-		0x5f, 0x9f, 0x52, 0x66, 0x6e, 0x0f, 0x82, 0x89, 0x8a, 0xed, 0x1a, 0xa6, 0xd6, 0x36, 0xde, 0xc0,
-		0x6c, 0x09, 0xa5, 0xcf, 0x64, 0x1e, 0xa1, 0x62, 0xd7, 0x38, 0x36, 0x5d, 0x58, 0x89, 0x8a, 0xed,
-		0x06, 0x01, 0x95, 0x14, 0x3f, 0x87, 0xea, 0x3d, 0xfb, 0x96, 0x92, 0xd2, 0xec, 0xa9, 0xb7, 0x1b,
-		0xf1, 0x34, 0x48, 0x59, 0xf9, 0x1c, 0x64, 0x03, 0x86, 0x09, 0xcd, 0x41, 0x8a, 0x9f, 0xd4, 0x11,
-		0x27, 0x5a, 0xf9, 0xa7, 0x5c, 0x80, 0x5c, 0xd0, 0x18, 0xc9, 0x1f, 0x8d, 0x78, 0x94, 0xf4, 0xbd,
-		0x8b, 0xb9, 0x70, 0x46, 0x3a, 0xe3, 0x7b, 0x2a, 0x0f, 0x89, 0xa5, 0x4d, 0x94, 0xb3, 0xed, 0xd7,
-		0x1c, 0x05, 0xf2, 0x95, 0x15, 0x2d, 0x40, 0xb6, 0x7b, 0xa9, 0xeb, 0xa1, 0xc4, 0x28, 0x0a, 0x74,
-		0x2f, 0x75, 0x05, 0xc2, 0x59, 0xc8, 0x91, 0x7e, 0xab, 0x41, 0x7f, 0x29, 0xa3, 0x64, 0x09, 0x8c,
-		0xa3, 0xc8, 0xff, 0x2a, 0x0a, 0x52, 0xbf, 0x01, 0xf3, 0x52, 0xd5, 0x91, 0x63, 0xa7, 0xaa, 0x4f,
-		0xf7, 0x27, 0xc9, 0xfd, 0xbc, 0xf8, 0x1a, 0x48, 0x7e, 0x7a, 0x97, 0x2d, 0x35, 0xa3, 0xfd, 0xbf,
-		0x3e, 0x47, 0x55, 0x29, 0xea, 0x7d, 0x9e, 0xeb, 0xb5, 0xd0, 0x66, 0xa5, 0xf8, 0x73, 0x14, 0xfd,
-		0x03, 0xee, 0x39, 0x31, 0xdb, 0xdd, 0x96, 0xe6, 0x62, 0x91, 0x1f, 0x0b, 0xec, 0x5b, 0x3e, 0x02,
-		0x45, 0xad, 0xdb, 0x55, 0x1d, 0x57, 0x73, 0x31, 0xf7, 0x35, 0x58, 0xe6, 0x21, 0xaf, 0x75, 0xbb,
-		0x0d, 0x02, 0x65, 0xbe, 0xc6, 0xc3, 0x50, 0x20, 0x36, 0xdd, 0xd0, 0xda, 0xc2, 0x55, 0x48, 0x32,
-		0x97, 0x84, 0x43, 0x99, 0x77, 0x20, 0xb7, 0xbc, 0x11, 0xa7, 0xf6, 0xdc, 0x8b, 0xa9, 0x22, 0x81,
-		0x98, 0x0a, 0xf1, 0x77, 0x48, 0x98, 0x7c, 0xc4, 0xdb, 0x2d, 0xc3, 0x37, 0x0d, 0x66, 0x69, 0xfc,
-		0x75, 0x9b, 0x25, 0x4b, 0xd2, 0x0a, 0xfb, 0x90, 0x15, 0x28, 0x84, 0x6d, 0x3f, 0x2a, 0x40, 0xd4,
-		0x3d, 0xe0, 0xb5, 0x44, 0xdd, 0x03, 0xe2, 0xe9, 0x7a, 0xaf, 0xf7, 0x16, 0x86, 0xac, 0x76, 0x9c,
-		0xce, 0xcf, 0x75, 0xc8, 0x45, 0xc8, 0x87, 0xd6, 0x04, 0xf9, 0x24, 0xcc, 0x0e, 0x33, 0xf1, 0xf2,
-		0xbe, 0x07, 0x0f, 0x99, 0x6a, 0x74, 0x19, 0xd2, 0x9e, 0x8d, 0x1f, 0x12, 0x1d, 0xd3, 0x6a, 0x05,
-		0xb2, 0xe2, 0xa1, 0x86, 0xd2, 0xf9, 0xd1, 0x50, 0x3a, 0x5f, 0xfe, 0x7a, 0x98, 0x1b, 0x65, 0xbf,
-		0xfb, 0x92, 0x7c, 0x71, 0x4f, 0x60, 0x27, 0x21, 0xc9, 0x9f, 0xc9, 0x8c, 0xd2, 0x0d, 0x2c, 0xfe,
-		0x45, 0x04, 0xc9, 0x6c, 0x79, 0x8c, 0xed, 0x6b, 0xd1, 0x0f, 0x59, 0x85, 0xd3, 0x23, 0x6d, 0xf8,
-		0xe8, 0xad, 0x30, 0xc6, 0x88, 0x6f, 0x85, 0xe9, 0xa2, 0x39, 0x0e, 0xed, 0xab, 0x38, 0xc8, 0xc2,
-		0xbe, 0xe4, 0x8f, 0xc5, 0xe0, 0xe4, 0x70, 0x4b, 0x8e, 0x16, 0x21, 0x47, 0xfc, 0x56, 0x37, 0xec,
-		0xe2, 0x42, 0x47, 0x3b, 0x68, 0x72, 0xff, 0x96, 0x6f, 0x25, 0x44, 0xbd, 0xad, 0x04, 0xb4, 0x0d,
-		0xd3, 0x6d, 0x4b, 0xd7, 0xda, 0x6a, 0x60, 0x2b, 0x87, 0x4f, 0xa2, 0x87, 0x06, 0x84, 0x1d, 0x4e,
-		0x19, 0x12, 0x83, 0xc3, 0xf5, 0xbf, 0x48, 0x79, 0xac, 0x79, 0xbb, 0x3e, 0x68, 0x05, 0xb2, 0x1d,
-		0xc3, 0xd9, 0xc1, 0xfb, 0xda, 0x6d, 0xc3, 0xb2, 0xf9, 0x6c, 0x1a, 0x54, 0x9a, 0x75, 0x1f, 0x47,
-		0xec, 0x30, 0x05, 0xc8, 0x02, 0x43, 0x92, 0x18, 0xba, 0xf1, 0x95, 0x3c, 0xb6, 0x35, 0x19, 0xb5,
-		0x87, 0x94, 0x1a, 0xb9, 0x87, 0x34, 0x6c, 0xc3, 0x26, 0x3d, 0x7c, 0xc3, 0xe6, 0x23, 0xc1, 0xa1,
-		0x09, 0xaf, 0x7d, 0x03, 0x7b, 0x38, 0xa8, 0x01, 0xb3, 0x9c, 0xbe, 0x15, 0x92, 0xfd, 0x90, 0xa3,
-		0x0e, 0x6c, 0x7e, 0xf5, 0xcb, 0x1c, 0x09, 0xf2, 0xd1, 0x62, 0x8f, 0xdd, 0x9f, 0xd8, 0xc5, 0x26,
-		0x6a, 0x3c, 0xb0, 0x89, 0xfa, 0xff, 0xd8, 0x50, 0x7c, 0x38, 0x06, 0xd3, 0x03, 0x8e, 0xc4, 0xd0,
-		0xdd, 0xe1, 0x51, 0x19, 0x59, 0xd1, 0xb1, 0xd8, 0xb1, 0x3b, 0xc6, 0xc7, 0x3a, 0x3e, 0x7e, 0xac,
-		0x13, 0xef, 0xe0, 0x58, 0x27, 0xef, 0x6f, 0xac, 0xdf, 0xd5, 0x51, 0xf8, 0x44, 0x04, 0x4a, 0xa3,
-		0xbd, 0xaf, 0xa1, 0xc3, 0x71, 0xac, 0x0d, 0x84, 0x51, 0x6b, 0xdc, 0xc3, 0x50, 0xe8, 0xf3, 0x0d,
-		0x99, 0x2a, 0xe7, 0x43, 0x51, 0xb8, 0xfc, 0x8d, 0x31, 0x6f, 0xe1, 0x09, 0x39, 0x70, 0x43, 0x66,
-		0xeb, 0xcb, 0x30, 0xd3, 0xc2, 0xba, 0xd1, 0xba, 0xdf, 0xc9, 0x3a, 0xcd, 0xa9, 0xff, 0xff, 0x5c,
-		0x1d, 0xd4, 0x92, 0xef, 0x02, 0x48, 0x2b, 0xd8, 0xe9, 0x12, 0x7f, 0x0c, 0x55, 0x20, 0x83, 0x0f,
-		0x74, 0xdc, 0x75, 0xfd, 0x43, 0x15, 0xc3, 0x42, 0x04, 0x86, 0x5d, 0x13, 0x98, 0x24, 0x40, 0xf6,
-		0xc8, 0xd0, 0xd3, 0x3c, 0x07, 0x30, 0x3a, 0x9c, 0xe7, 0xe4, 0xc1, 0x24, 0xc0, 0x15, 0x91, 0x04,
-		0x88, 0x8d, 0x8c, 0x6f, 0x19, 0x55, 0x5f, 0x16, 0xe0, 0x69, 0x9e, 0x05, 0x88, 0x8f, 0xa9, 0x2c,
-		0x94, 0x06, 0xa8, 0x86, 0xd2, 0x00, 0xc9, 0x31, 0xdd, 0x1c, 0x91, 0x07, 0xb8, 0x22, 0xf2, 0x00,
-		0xa9, 0x31, 0x2d, 0xee, 0x4b, 0x04, 0xbc, 0x10, 0x48, 0x04, 0x64, 0x06, 0xb3, 0xad, 0x21, 0xd2,
-		0x21, 0x99, 0x80, 0xe7, 0xbd, 0x4c, 0x40, 0x6e, 0x64, 0x16, 0x81, 0x13, 0xf7, 0xa7, 0x02, 0x36,
-		0x07, 0x52, 0x01, 0x79, 0xfe, 0x47, 0x97, 0x46, 0xb1, 0x18, 0x93, 0x0b, 0xd8, 0x1c, 0xc8, 0x05,
-		0x14, 0xc6, 0x30, 0x1c, 0x93, 0x0c, 0xf8, 0xda, 0xe1, 0xc9, 0x80, 0xd1, 0xe1, 0x3a, 0x6f, 0xe6,
-		0x64, 0xd9, 0x00, 0x75, 0x44, 0x36, 0x40, 0x1a, 0x19, 0xb9, 0x32, 0xf6, 0x13, 0xa7, 0x03, 0xb6,
-		0x87, 0xa4, 0x03, 0x58, 0xe0, 0x7e, 0x7e, 0x24, 0xf3, 0x09, 0xf2, 0x01, 0xdb, 0x43, 0xf2, 0x01,
-		0x68, 0x2c, 0xdb, 0xb1, 0x09, 0x81, 0x6b, 0xe1, 0x84, 0xc0, 0xcc, 0x08, 0xaf, 0xd3, 0x9f, 0xed,
-		0x23, 0x32, 0x02, 0x3b, 0xa3, 0x32, 0x02, 0x2c, 0x6a, 0x7f, 0x7c, 0x24, 0xc7, 0x63, 0xa4, 0x04,
-		0x36, 0x07, 0x52, 0x02, 0x27, 0xc6, 0x68, 0xda, 0xe4, 0x39, 0x01, 0xf6, 0x08, 0x3c, 0x7b, 0xfe,
-		0x1d, 0xa4, 0xec, 0x8d, 0x78, 0x3a, 0x2b, 0xe5, 0xe4, 0x47, 0x89, 0x07, 0xd3, 0x67, 0xe7, 0x48,
-		0xac, 0x80, 0x6d, 0xdb, 0xb2, 0xc5, 0x1d, 0x0b, 0xfa, 0x21, 0x9f, 0x27, 0x31, 0xa2, 0x6f, 0xd3,
-		0x8e, 0xc8, 0x1f, 0xd0, 0x98, 0x2c, 0x60, 0xc7, 0xe4, 0x9f, 0x8b, 0xf8, 0xb4, 0x34, 0x83, 0x10,
-		0x8c, 0x2f, 0x33, 0x3c, 0xbe, 0x0c, 0x64, 0x15, 0xa2, 0xe1, 0xac, 0xc2, 0x02, 0x64, 0x49, 0xac,
-		0xd5, 0x97, 0x30, 0xd0, 0xba, 0x5e, 0xc2, 0x40, 0x1c, 0xa0, 0x62, 0xb9, 0x07, 0xbe, 0x2c, 0xb1,
-		0xdd, 0xc4, 0xa2, 0x77, 0x98, 0x8c, 0x85, 0xba, 0xe8, 0x09, 0x98, 0x09, 0xe0, 0x7a, 0x31, 0x1c,
-		0x8b, 0x9e, 0x25, 0x0f, 0xbb, 0xcc, 0x83, 0xb9, 0x7f, 0x16, 0xf1, 0x25, 0xe4, 0x67, 0x1a, 0x86,
-		0x25, 0x05, 0x22, 0xef, 0x50, 0x52, 0x20, 0x7a, 0xdf, 0x49, 0x81, 0x60, 0x4c, 0x1a, 0x0b, 0xc7,
-		0xa4, 0xff, 0x33, 0xe2, 0x8f, 0x89, 0x17, 0xe2, 0xeb, 0x56, 0x0b, 0xf3, 0x28, 0x91, 0xfe, 0x26,
-		0x2e, 0x49, 0xdb, 0xda, 0xe3, 0xb1, 0x20, 0xf9, 0x49, 0xb0, 0xbc, 0x85, 0x27, 0xc3, 0xd7, 0x15,
-		0x2f, 0xc0, 0x4c, 0x04, 0xef, 0x71, 0xf0, 0xcb, 0x0d, 0x49, 0xff, 0x72, 0x83, 0x77, 0x31, 0x3a,
-		0x15, 0xb8, 0x18, 0x8d, 0x9e, 0x83, 0x0c, 0x4d, 0xf6, 0xab, 0x56, 0x57, 0xfc, 0xc5, 0xb2, 0x33,
-		0xa3, 0x2f, 0x36, 0x38, 0xf4, 0xc8, 0x35, 0xbb, 0x0c, 0xe1, 0x7b, 0x1c, 0x99, 0x90, 0xc7, 0xf1,
-		0x00, 0x64, 0x48, 0xeb, 0xd9, 0x5f, 0xde, 0x00, 0x7e, 0xab, 0x5e, 0x00, 0xe4, 0x1f, 0x8f, 0x42,
-		0xb1, 0x6f, 0xa1, 0x19, 0xda, 0xf7, 0x61, 0xdb, 0xc8, 0x93, 0xc9, 0x63, 0x1e, 0x60, 0x4f, 0x73,
-		0xd4, 0x3b, 0x9a, 0xe9, 0xe2, 0x16, 0x17, 0x4a, 0x00, 0x82, 0x4a, 0x90, 0x26, 0x5f, 0x3d, 0x07,
-		0xb7, 0x78, 0xf6, 0xc5, 0xfb, 0x46, 0xd7, 0x21, 0x89, 0x6f, 0xd3, 0x97, 0x67, 0xd9, 0xfb, 0xcd,
-		0x27, 0x07, 0xc3, 0x61, 0x52, 0x5c, 0x99, 0x23, 0x83, 0xfd, 0x85, 0x37, 0x17, 0x24, 0x86, 0xfd,
-		0xb8, 0x77, 0x99, 0x4d, 0xe1, 0xf4, 0x61, 0x29, 0xa4, 0xfb, 0xa4, 0x40, 0xf3, 0x80, 0x39, 0x11,
-		0xde, 0x13, 0x99, 0xb2, 0x33, 0xde, 0x4a, 0xbe, 0x83, 0x3b, 0x5d, 0xcb, 0x6a, 0xab, 0x6c, 0x8e,
-		0x97, 0xa1, 0x10, 0x5e, 0x57, 0xd9, 0x5f, 0xc5, 0x72, 0x35, 0xc3, 0x54, 0x43, 0x4e, 0x70, 0x8e,
-		0x01, 0xd9, 0x9c, 0xba, 0x11, 0x4f, 0x47, 0xa4, 0xe8, 0x8d, 0x78, 0x3a, 0x2a, 0xc5, 0xe4, 0x2d,
-		0x38, 0x31, 0x74, 0x5d, 0x45, 0xcf, 0x42, 0xc6, 0x5f, 0x92, 0xd9, 0x31, 0xfd, 0x23, 0x32, 0x2d,
-		0x3e, 0xae, 0xfc, 0x8b, 0x11, 0x9f, 0x65, 0x38, 0x77, 0x53, 0x83, 0x24, 0x3b, 0x33, 0xc9, 0xcf,
-		0xc7, 0x3c, 0x31, 0xd9, 0x8a, 0xbc, 0xc4, 0x0e, 0x54, 0x2a, 0x9c, 0x58, 0xfe, 0x00, 0x24, 0x19,
-		0x04, 0x65, 0x21, 0xe5, 0xbf, 0x71, 0x0d, 0x90, 0x2c, 0x57, 0xab, 0xb5, 0x2d, 0xf1, 0x60, 0x6d,
-		0x65, 0x53, 0x69, 0x4a, 0x51, 0x02, 0x56, 0x6a, 0x37, 0x6a, 0xd5, 0xa6, 0x14, 0x43, 0xd3, 0x90,
-		0x67, 0xbf, 0xd5, 0x6b, 0x9b, 0xca, 0x7a, 0xb9, 0x29, 0xc5, 0x03, 0xa0, 0x46, 0x6d, 0x63, 0xa5,
-		0xa6, 0x48, 0x09, 0xf9, 0x29, 0x38, 0x3d, 0x72, 0x0d, 0xf7, 0x13, 0x33, 0x91, 0x40, 0x62, 0x46,
-		0xfe, 0x58, 0x94, 0x04, 0x35, 0xa3, 0x16, 0x66, 0x74, 0xa3, 0xaf, 0xe3, 0x97, 0x8e, 0xb1, 0xaa,
-		0xf7, 0xf5, 0x9e, 0xc4, 0x31, 0x36, 0xde, 0xc5, 0xae, 0xbe, 0xcf, 0x1c, 0x05, 0x66, 0x81, 0xf2,
-		0x4a, 0x9e, 0x43, 0x29, 0x91, 0xc3, 0xd0, 0x5e, 0xc7, 0xba, 0xab, 0x32, 0x25, 0x72, 0xf8, 0x1f,
-		0xf1, 0xcd, 0x33, 0x68, 0x83, 0x01, 0xe5, 0xaf, 0x3f, 0x96, 0x2c, 0x33, 0x90, 0x50, 0x6a, 0x4d,
-		0xe5, 0x55, 0x29, 0x86, 0x10, 0x14, 0xe8, 0x4f, 0xb5, 0xb1, 0x51, 0xde, 0x6a, 0x5c, 0xdf, 0x24,
-		0xb2, 0x9c, 0x81, 0xa2, 0x90, 0xa5, 0x00, 0x26, 0xe4, 0xc7, 0xe0, 0xd4, 0x08, 0xaf, 0x62, 0xc8,
-		0x21, 0xd6, 0x4f, 0x45, 0x82, 0xd8, 0x61, 0xcf, 0x60, 0x13, 0x92, 0x8e, 0xab, 0xb9, 0x3d, 0x87,
-		0x0b, 0xf1, 0xd9, 0x49, 0xdd, 0x8c, 0x25, 0xf1, 0xa3, 0x41, 0xc9, 0x15, 0xce, 0x46, 0xbe, 0x0c,
-		0x85, 0x70, 0xc9, 0x68, 0x19, 0xf8, 0x4a, 0x14, 0x95, 0xaf, 0x02, 0x1a, 0xf4, 0x3e, 0x86, 0x84,
-		0x97, 0x91, 0x61, 0xe1, 0xe5, 0x0f, 0x47, 0xe0, 0xcc, 0x11, 0x9e, 0x06, 0x7a, 0xb9, 0xaf, 0x93,
-		0xcf, 0x1f, 0xc7, 0x4f, 0x59, 0x62, 0xb0, 0xbe, 0x6e, 0x3e, 0x0d, 0xb9, 0x20, 0x7c, 0xb2, 0x4e,
-		0x7e, 0x21, 0xea, 0x4f, 0xe2, 0x70, 0x1c, 0xec, 0x9b, 0xc0, 0xc8, 0x97, 0x69, 0x02, 0xdf, 0x0b,
-		0xe0, 0x1e, 0x88, 0x53, 0xd4, 0x7c, 0x1d, 0x7d, 0x70, 0x48, 0x7e, 0x11, 0xeb, 0xcd, 0x03, 0x3e,
-		0x09, 0x32, 0x2e, 0xff, 0xe5, 0xa0, 0x46, 0x30, 0x29, 0xd0, 0xa3, 0x6b, 0xac, 0xe3, 0xfd, 0x09,
-		0xdf, 0xc9, 0x16, 0x63, 0x3f, 0x79, 0xc0, 0xc0, 0x0e, 0x7a, 0x15, 0x4e, 0xf5, 0x39, 0x0a, 0x1e,
-		0xeb, 0xf8, 0xa4, 0xfe, 0xc2, 0x89, 0xb0, 0xbf, 0x20, 0x58, 0x07, 0x57, 0xfb, 0x44, 0x78, 0xb5,
-		0x7f, 0x15, 0xc0, 0x4f, 0x0e, 0xf8, 0xc7, 0xfe, 0x22, 0xc1, 0x63, 0x7f, 0x97, 0x21, 0x41, 0x34,
-		0x49, 0xc8, 0x69, 0xd0, 0x14, 0x13, 0x4d, 0x08, 0x24, 0x17, 0x18, 0xb6, 0x6c, 0x00, 0x1a, 0x4c,
-		0xd0, 0x8e, 0xa8, 0xe2, 0x85, 0x70, 0x15, 0x67, 0x47, 0xa6, 0x7a, 0x87, 0x57, 0xf5, 0x06, 0x24,
-		0xe8, 0xc8, 0x0f, 0xbd, 0x4b, 0xf9, 0x75, 0x00, 0x9a, 0xeb, 0xda, 0xc6, 0x4e, 0xcf, 0xaf, 0x60,
-		0x61, 0xb8, 0xe6, 0x94, 0x05, 0x5e, 0xe5, 0x01, 0xae, 0x42, 0xb3, 0x3e, 0x69, 0x40, 0x8d, 0x02,
-		0x0c, 0xe5, 0x0d, 0x28, 0x84, 0x69, 0x87, 0xdf, 0x0d, 0xf5, 0x1f, 0x7e, 0xc9, 0x08, 0xff, 0xc6,
-		0xf3, 0x8e, 0xf8, 0x63, 0x4c, 0xf4, 0x43, 0xfe, 0x50, 0x14, 0x72, 0x41, 0xc5, 0xfb, 0x8b, 0xe7,
-		0x82, 0xc8, 0xdf, 0x14, 0x81, 0xb4, 0xd7, 0xfd, 0x23, 0x0e, 0x3b, 0xfb, 0x77, 0x84, 0xbd, 0xcd,
-		0x0b, 0xb6, 0x4d, 0x14, 0xf3, 0xb6, 0x89, 0xae, 0x7a, 0xcb, 0xdf, 0xa8, 0x84, 0x48, 0x50, 0xd6,
-		0xe2, 0xc0, 0x21, 0x5f, 0xed, 0xaf, 0x4e, 0x76, 0x15, 0x6f, 0x16, 0x12, 0xc1, 0x6b, 0x74, 0xec,
-		0x43, 0x6e, 0x05, 0x4e, 0x18, 0xb1, 0x89, 0x18, 0xbc, 0xb3, 0x17, 0x39, 0xf6, 0x9d, 0x3d, 0xaf,
-		0x96, 0x68, 0xb0, 0x96, 0xef, 0x8c, 0x40, 0x5a, 0xcc, 0x09, 0xf4, 0xbe, 0xe0, 0xc9, 0x33, 0xb1,
-		0xb5, 0x39, 0xd2, 0x1e, 0x71, 0xfe, 0x81, 0x83, 0x67, 0x03, 0x07, 0xae, 0x63, 0xc7, 0x3d, 0x70,
-		0xcd, 0x3d, 0xbb, 0x3f, 0x8e, 0x80, 0xd4, 0x3f, 0x63, 0xbf, 0xec, 0xd6, 0x0d, 0x2e, 0x73, 0xb1,
-		0x21, 0xcb, 0xdc, 0xa8, 0x53, 0xd4, 0xf1, 0x51, 0xa7, 0xa8, 0x07, 0x7b, 0x9d, 0xb8, 0xcf, 0x5e,
-		0x7f, 0x38, 0x0a, 0xd9, 0x40, 0x7a, 0x14, 0x3d, 0x13, 0x3a, 0x91, 0xbd, 0x78, 0x54, 0x2a, 0x35,
-		0x70, 0x24, 0x3b, 0x24, 0xa6, 0xe8, 0xf1, 0xc5, 0xf4, 0xce, 0xdf, 0xce, 0x1a, 0x7e, 0x81, 0x35,
-		0x31, 0xe2, 0x02, 0xeb, 0x5f, 0x8d, 0x40, 0xda, 0x73, 0xbb, 0x8f, 0xbb, 0x89, 0x79, 0x12, 0x92,
-		0xdc, 0xb3, 0x64, 0xbb, 0x98, 0xfc, 0x6b, 0x68, 0x5a, 0xb9, 0x04, 0x69, 0xf1, 0xf7, 0x14, 0xf9,
-		0xaa, 0xe6, 0x7d, 0x5f, 0x78, 0x1e, 0xb2, 0x81, 0x0d, 0x60, 0x62, 0x1a, 0x37, 0x6a, 0xef, 0x97,
-		0xa6, 0x4a, 0xa9, 0xbb, 0xf7, 0x16, 0x63, 0x1b, 0xf8, 0x0e, 0x99, 0xcd, 0x4a, 0xad, 0x7a, 0xbd,
-		0x56, 0x7d, 0x49, 0x8a, 0x94, 0xb2, 0x77, 0xef, 0x2d, 0xa6, 0x14, 0x4c, 0x33, 0x8a, 0x17, 0x5e,
-		0x82, 0x62, 0xdf, 0xc0, 0x84, 0xdd, 0x16, 0x04, 0x85, 0x95, 0xed, 0xad, 0xb5, 0x7a, 0xb5, 0xdc,
-		0xac, 0xa9, 0xec, 0xdc, 0x2e, 0x3a, 0x05, 0x33, 0x6b, 0xf5, 0xd5, 0xeb, 0x4d, 0xb5, 0xba, 0x56,
-		0xaf, 0x6d, 0x34, 0xd5, 0x72, 0xb3, 0x59, 0xae, 0xbe, 0x24, 0x45, 0x2f, 0xdd, 0xcb, 0x42, 0xbc,
-		0x5c, 0xa9, 0xd6, 0x51, 0x15, 0xe2, 0x34, 0x15, 0x72, 0xe4, 0x09, 0xb0, 0xd2, 0xd1, 0xb9, 0x61,
-		0x74, 0x0d, 0x12, 0x34, 0x4b, 0x82, 0x8e, 0x3e, 0x12, 0x56, 0x1a, 0x93, 0x2c, 0x26, 0x8d, 0xa1,
-		0x33, 0xf2, 0xc8, 0x33, 0x62, 0xa5, 0xa3, 0x73, 0xc7, 0x68, 0x0d, 0x52, 0x22, 0x48, 0x1e, 0x77,
-		0x70, 0xab, 0x34, 0x36, 0xa1, 0x4b, 0xba, 0xc6, 0x92, 0x0d, 0x47, 0x1f, 0x1f, 0x2b, 0x8d, 0xc9,
-		0x2a, 0xa3, 0xba, 0x77, 0x9f, 0x69, 0xcc, 0x89, 0xb0, 0xd2, 0xb8, 0x3c, 0x31, 0x52, 0x20, 0xe3,
-		0xa7, 0x71, 0xc6, 0x1f, 0x8a, 0x2b, 0x4d, 0x90, 0x30, 0x47, 0x1f, 0x80, 0x7c, 0x38, 0xd4, 0x9d,
-		0xec, 0xd4, 0x59, 0x69, 0xc2, 0x8c, 0x34, 0xe1, 0x1f, 0x8e, 0x7b, 0x27, 0x3b, 0x85, 0x56, 0x9a,
-		0x30, 0x41, 0x8d, 0x5e, 0x87, 0xe9, 0xc1, 0xb8, 0x74, 0xf2, 0x43, 0x69, 0xa5, 0x63, 0xa4, 0xac,
-		0x51, 0x07, 0xd0, 0x90, 0x78, 0xf6, 0x18, 0x67, 0xd4, 0x4a, 0xc7, 0xc9, 0x60, 0xa3, 0x16, 0x14,
-		0xfb, 0x83, 0xc4, 0x49, 0xcf, 0xac, 0x95, 0x26, 0xce, 0x66, 0xb3, 0x5a, 0xc2, 0xc1, 0xe5, 0xa4,
-		0x67, 0xd8, 0x4a, 0x13, 0x27, 0xb7, 0xd1, 0x36, 0x40, 0x20, 0x3e, 0x9c, 0xe0, 0x4c, 0x5b, 0x69,
-		0x92, 0x34, 0x37, 0xea, 0xc2, 0xcc, 0xb0, 0xc0, 0xf1, 0x38, 0x47, 0xdc, 0x4a, 0xc7, 0xca, 0x7e,
-		0x13, 0x7d, 0x0e, 0x87, 0x80, 0x93, 0x1d, 0x79, 0x2b, 0x4d, 0x98, 0x06, 0xaf, 0x94, 0x47, 0x9e,
-		0x73, 0x3e, 0x77, 0xe4, 0x39, 0x67, 0xff, 0xe4, 0xb2, 0x77, 0xb6, 0xf9, 0xdf, 0x5e, 0x84, 0xf7,
-		0xf0, 0x17, 0x74, 0x1c, 0x57, 0xbb, 0x65, 0x98, 0x7b, 0xde, 0x33, 0x4b, 0xfc, 0x9b, 0x1f, 0x72,
-		0x3e, 0xc9, 0x5f, 0xe9, 0x11, 0xd0, 0x31, 0x8f, 0x2d, 0x8d, 0x7c, 0x81, 0x72, 0xdc, 0x7d, 0x88,
-		0xf1, 0xc7, 0x97, 0x8f, 0x78, 0xc8, 0x69, 0xcc, 0x73, 0x51, 0x43, 0x1e, 0x7a, 0x2a, 0x1d, 0xf9,
-		0xc6, 0x40, 0xe9, 0xa8, 0x73, 0xdf, 0xc4, 0x8b, 0x2d, 0x5c, 0x37, 0x1c, 0xd7, 0xb2, 0x0d, 0x5d,
-		0x6b, 0xd3, 0xe5, 0xe5, 0xea, 0xa4, 0xb7, 0xb2, 0x2a, 0x19, 0xe2, 0xae, 0xf0, 0xb7, 0xa0, 0xf8,
-		0x4d, 0xa1, 0x15, 0x48, 0xde, 0xd6, 0xda, 0xec, 0x4e, 0x54, 0xf0, 0x21, 0xb8, 0x7e, 0x99, 0x07,
-		0xfc, 0xa8, 0x20, 0x17, 0x46, 0x2b, 0x7f, 0x37, 0xbd, 0x4f, 0xd2, 0xe9, 0x18, 0x0e, 0xd1, 0x45,
-		0x85, 0x86, 0xd2, 0x37, 0x20, 0x6e, 0x6b, 0x2e, 0x0f, 0x30, 0x2b, 0x57, 0x8e, 0xfd, 0x2e, 0x13,
-		0xab, 0x81, 0xf2, 0x40, 0x2f, 0x43, 0xba, 0xa3, 0x1d, 0xa8, 0x94, 0x5f, 0xf4, 0xcb, 0xe2, 0x97,
-		0xea, 0x68, 0x07, 0xa4, 0x7d, 0xe8, 0x03, 0x50, 0x24, 0x2c, 0xf5, 0x7d, 0xcd, 0xdc, 0xc3, 0x8c,
-		0x73, 0xec, 0xcb, 0xe2, 0x9c, 0xef, 0x68, 0x07, 0x55, 0xca, 0x8d, 0xf0, 0xe7, 0x4f, 0x5e, 0xfd,
-		0x72, 0x84, 0x67, 0x0d, 0xa8, 0x60, 0x90, 0x06, 0x92, 0xee, 0x7d, 0xd1, 0x4a, 0xc5, 0x16, 0xc7,
-		0xb9, 0x51, 0x72, 0xef, 0x13, 0x6b, 0x25, 0x4f, 0x9a, 0xf7, 0xd9, 0x37, 0x17, 0x22, 0xac, 0xd6,
-		0xa2, 0x3e, 0x20, 0xf6, 0x2c, 0x4b, 0x86, 0xa8, 0xd4, 0x87, 0x8d, 0x8e, 0xf5, 0x61, 0xf3, 0xc2,
-		0x87, 0x65, 0x0c, 0x81, 0x51, 0x93, 0x72, 0xde, 0x87, 0x4f, 0x47, 0x20, 0xbb, 0x12, 0x78, 0xd6,
-		0x71, 0x0e, 0x52, 0x1d, 0xcb, 0x34, 0x6e, 0x61, 0xdb, 0xdb, 0xa3, 0x62, 0x9f, 0xc4, 0xcf, 0x64,
-		0x7f, 0x1d, 0xd0, 0x3d, 0x14, 0xaf, 0x0b, 0x89, 0x6f, 0x42, 0x75, 0x07, 0xef, 0x38, 0x86, 0x90,
-		0xb3, 0x22, 0x3e, 0xd1, 0xa3, 0x20, 0x39, 0x58, 0xef, 0xd9, 0x86, 0x7b, 0xa8, 0xea, 0x96, 0xe9,
-		0x6a, 0xba, 0xcb, 0xe3, 0xf0, 0xa2, 0x80, 0x57, 0x19, 0x98, 0x30, 0x69, 0x61, 0x57, 0x33, 0xda,
-		0xec, 0x64, 0x66, 0x46, 0x11, 0x9f, 0xbc, 0xa9, 0x77, 0x53, 0xc1, 0x38, 0xb4, 0x0a, 0x92, 0xd5,
-		0xc5, 0x76, 0xe8, 0x40, 0x0a, 0xd3, 0xc6, 0xb9, 0x5f, 0xff, 0xcc, 0x13, 0xb3, 0x5c, 0xe0, 0xfc,
-		0x30, 0x03, 0xfb, 0x2b, 0x06, 0x4a, 0x51, 0x50, 0x88, 0x93, 0x2a, 0xaf, 0x86, 0x76, 0xa5, 0x7a,
-		0x3b, 0xfe, 0x33, 0x32, 0xb3, 0x03, 0x42, 0x2d, 0x9b, 0x87, 0x95, 0xb9, 0x5f, 0xf3, 0x59, 0xfb,
-		0x71, 0xea, 0x4b, 0xf8, 0x30, 0xb8, 0x45, 0x45, 0xd9, 0x10, 0x17, 0xfe, 0x75, 0xcd, 0x68, 0x8b,
-		0x3f, 0xa4, 0xaa, 0xf0, 0x2f, 0xb4, 0xec, 0xa5, 0x17, 0xe3, 0x34, 0x1e, 0x92, 0x47, 0xe9, 0x46,
-		0xc5, 0x32, 0x5b, 0xe1, 0x3c, 0x22, 0xaa, 0x42, 0xd2, 0xb5, 0x6e, 0x61, 0x93, 0x0b, 0xe8, 0x78,
-		0x4f, 0xab, 0x71, 0x52, 0xf4, 0xb5, 0x20, 0xb5, 0x70, 0x1b, 0xef, 0xb1, 0xfb, 0x92, 0xfb, 0x9a,
-		0x8d, 0xd9, 0x6d, 0xfd, 0xfb, 0x7a, 0x5e, 0xad, 0xe8, 0xb1, 0x6a, 0x50, 0x4e, 0x68, 0x2b, 0xfc,
-		0x70, 0x68, 0x8a, 0x6f, 0x1e, 0x8f, 0xe8, 0x63, 0x40, 0xf3, 0x82, 0x96, 0x27, 0xf4, 0xd0, 0xe8,
-		0xa3, 0x20, 0xf5, 0xcc, 0x1d, 0xcb, 0xa4, 0x7f, 0x7f, 0x90, 0x47, 0x51, 0x69, 0xb6, 0x53, 0xe9,
-		0xc1, 0xf9, 0x4e, 0xe5, 0x16, 0x14, 0x7c, 0x54, 0x3a, 0x43, 0x32, 0xc7, 0x9d, 0x21, 0x79, 0x8f,
-		0x01, 0x41, 0x41, 0xeb, 0x00, 0xfe, 0x1c, 0xa4, 0x5b, 0x65, 0xd9, 0xd1, 0x23, 0xe6, 0xcf, 0xe6,
-		0x60, 0x67, 0x02, 0x0c, 0xd0, 0xd7, 0xc0, 0x4c, 0xc7, 0x30, 0x55, 0x07, 0xb7, 0x77, 0x55, 0x2e,
-		0x39, 0xc2, 0x37, 0x7b, 0xfc, 0xd1, 0x9c, 0xee, 0x18, 0x66, 0x03, 0xb7, 0x77, 0x57, 0x3c, 0x2e,
-		0xe8, 0xbd, 0x70, 0xc6, 0xef, 0xbd, 0x65, 0xaa, 0xfb, 0x56, 0xbb, 0xa5, 0xda, 0x78, 0x57, 0xd5,
-		0xe9, 0x3b, 0x7f, 0x39, 0x2a, 0xb3, 0x53, 0x1e, 0xca, 0xa6, 0x79, 0xdd, 0x6a, 0xb7, 0x14, 0xbc,
-		0x5b, 0x25, 0xc5, 0xe8, 0x21, 0xf0, 0xbb, 0xae, 0x1a, 0x2d, 0x67, 0x2e, 0xbf, 0x18, 0x3b, 0x1f,
-		0x57, 0x72, 0x1e, 0xb0, 0xde, 0x72, 0x96, 0xd3, 0x1f, 0xf9, 0xe4, 0xc2, 0xd4, 0xe7, 0x3f, 0xb9,
-		0x30, 0x25, 0x5f, 0xa3, 0x6f, 0x4a, 0xf1, 0x79, 0x84, 0x1d, 0x74, 0x05, 0x32, 0x9a, 0xf8, 0x60,
-		0x37, 0xc6, 0x8e, 0x98, 0x87, 0x3e, 0xaa, 0xfc, 0x63, 0x11, 0x48, 0xae, 0xdc, 0xdc, 0xd2, 0x0c,
-		0x1b, 0xd5, 0x60, 0xda, 0x57, 0xcc, 0x49, 0xa7, 0xb4, 0xaf, 0xcb, 0x62, 0x4e, 0x6f, 0x8c, 0x3a,
-		0xaa, 0x96, 0xa9, 0x9c, 0xfd, 0xf5, 0xcf, 0x3c, 0xf1, 0x20, 0x67, 0x73, 0xb3, 0xef, 0xd4, 0x9a,
-		0xe0, 0xd7, 0x7f, 0x9a, 0x2d, 0xd0, 0xe7, 0x1b, 0x90, 0x62, 0x4d, 0x75, 0xd0, 0x8b, 0x90, 0xe8,
-		0x92, 0x1f, 0x3c, 0x03, 0x3f, 0x3f, 0x52, 0xc1, 0x29, 0x7e, 0x50, 0x1d, 0x18, 0x9d, 0xfc, 0x2d,
-		0x51, 0x80, 0x95, 0x9b, 0x37, 0x9b, 0xb6, 0xd1, 0x6d, 0x63, 0xf7, 0x9d, 0xea, 0xfb, 0x36, 0x9c,
-		0x08, 0xdc, 0x85, 0xb6, 0xf5, 0xe3, 0xf7, 0x7f, 0xc6, 0xbf, 0x16, 0x6d, 0xeb, 0x43, 0xd9, 0xb6,
-		0x1c, 0xd7, 0x63, 0x1b, 0x3b, 0x3e, 0xdb, 0x15, 0xc7, 0x1d, 0x94, 0xec, 0x2b, 0x90, 0xf5, 0x85,
-		0xe1, 0xa0, 0x3a, 0xa4, 0x5d, 0xfe, 0x9b, 0x0b, 0x58, 0x1e, 0x2d, 0x60, 0x41, 0x16, 0x14, 0xb2,
-		0x47, 0x2e, 0xff, 0x69, 0x04, 0x20, 0x30, 0x47, 0xbe, 0x3a, 0x75, 0x8c, 0x84, 0xeb, 0xdc, 0x12,
-		0xc7, 0xee, 0xfb, 0x35, 0x4e, 0xc6, 0x20, 0x20, 0xd4, 0x6f, 0x8b, 0xc2, 0xcc, 0xb6, 0x98, 0xbd,
-		0x5f, 0xfd, 0x32, 0xd8, 0x86, 0x14, 0x36, 0x5d, 0xdb, 0xf0, 0xf6, 0x90, 0x9e, 0x1c, 0x35, 0xe6,
-		0x43, 0x3a, 0x55, 0x33, 0x5d, 0xfb, 0x30, 0xa8, 0x01, 0x82, 0x57, 0x40, 0x1e, 0x1f, 0x8f, 0xc1,
-		0xdc, 0x28, 0x52, 0x74, 0x0e, 0x8a, 0xba, 0x8d, 0x29, 0x20, 0x7c, 0x45, 0xb4, 0x20, 0xc0, 0x7c,
-		0x8d, 0x51, 0x80, 0x78, 0x65, 0x44, 0xb9, 0x08, 0xea, 0xfd, 0xb9, 0x61, 0x05, 0x9f, 0x03, 0x5d,
-		0x65, 0x9a, 0x50, 0x14, 0x77, 0x4e, 0x76, 0xb4, 0xb6, 0x66, 0xea, 0xc2, 0x5d, 0x3d, 0xd6, 0x92,
-		0x20, 0xee, 0xad, 0x54, 0x18, 0x0b, 0x54, 0x83, 0x94, 0xe0, 0x16, 0x3f, 0x3e, 0x37, 0x41, 0x8b,
-		0xce, 0x42, 0x2e, 0xb8, 0x30, 0x50, 0xd7, 0x23, 0xae, 0x64, 0x03, 0xeb, 0xc2, 0xb8, 0x95, 0x27,
-		0x79, 0xe4, 0xca, 0xc3, 0xbd, 0xbb, 0x1f, 0xa0, 0x47, 0xc3, 0x5b, 0x7f, 0xfe, 0x87, 0x65, 0x0b,
-		0x80, 0x4d, 0x55, 0x62, 0x49, 0xf9, 0xc8, 0xdc, 0xc7, 0x7c, 0xcf, 0x30, 0x26, 0x2b, 0x8e, 0xfb,
-		0x95, 0x1a, 0xa1, 0xdf, 0x8c, 0x42, 0x2e, 0x38, 0x42, 0x7f, 0x21, 0x17, 0x2d, 0xb4, 0xe1, 0x9b,
-		0x29, 0x76, 0x7d, 0xe6, 0xd1, 0x51, 0x66, 0x6a, 0x40, 0x9b, 0xc7, 0xd8, 0xa7, 0x4f, 0xc4, 0x20,
-		0xc9, 0xcf, 0xb7, 0x6d, 0x0e, 0x38, 0xb2, 0x91, 0x71, 0x0f, 0x00, 0xe4, 0xc5, 0x03, 0x00, 0x43,
-		0xfd, 0xd8, 0x87, 0xa1, 0x40, 0x02, 0xe2, 0xd0, 0xa1, 0xb9, 0xc8, 0xf9, 0x3c, 0x8d, 0x6b, 0xfd,
-		0x23, 0xe2, 0x68, 0x01, 0xb2, 0x04, 0xcd, 0xb7, 0xc3, 0x04, 0x07, 0x3a, 0xda, 0x41, 0x8d, 0x41,
-		0xd0, 0x13, 0x80, 0xf6, 0xbd, 0x04, 0x85, 0xea, 0x0b, 0x82, 0xe0, 0x4d, 0xfb, 0x25, 0x02, 0xfd,
-		0x41, 0x00, 0xd2, 0x0a, 0x95, 0x3d, 0x40, 0xcd, 0x9f, 0xf7, 0x26, 0x90, 0x15, 0xfa, 0x08, 0xf5,
-		0x37, 0x46, 0x98, 0x3f, 0xdc, 0x17, 0x36, 0xf3, 0x70, 0xa4, 0x39, 0xc1, 0xa4, 0xf8, 0xa3, 0x37,
-		0x17, 0x4a, 0x87, 0x5a, 0xa7, 0xbd, 0x2c, 0x0f, 0xe1, 0x23, 0x0f, 0x8b, 0xe4, 0x89, 0xe3, 0x1c,
-		0x0e, 0xbb, 0x97, 0xdf, 0x43, 0xd4, 0xfb, 0xee, 0xef, 0xfe, 0xc4, 0x85, 0x33, 0x81, 0x37, 0x9f,
-		0x0f, 0xbc, 0xf4, 0x15, 0x1b, 0x13, 0xe2, 0xa9, 0x22, 0x7f, 0xd5, 0xf0, 0x4e, 0xc6, 0xaf, 0x03,
-		0x04, 0x3c, 0xf9, 0xc8, 0xd1, 0x11, 0x82, 0x4f, 0x1f, 0x8a, 0x10, 0x02, 0x73, 0xea, 0x7d, 0xbe,
-		0xd1, 0x16, 0x6f, 0x3e, 0x0c, 0x79, 0x91, 0x7c, 0xa9, 0x6a, 0x19, 0x21, 0x16, 0x82, 0x88, 0x4e,
-		0xd5, 0x29, 0xf9, 0x5f, 0x47, 0xe0, 0xf4, 0x80, 0xfa, 0x79, 0x4d, 0xd6, 0x01, 0xd9, 0x81, 0x42,
-		0x3a, 0x8c, 0x62, 0x2b, 0xf6, 0xfe, 0xb4, 0x79, 0xda, 0x1e, 0xb0, 0xdc, 0xef, 0xcc, 0xea, 0xc3,
-		0x4d, 0xcf, 0xaf, 0x46, 0x60, 0x36, 0xd8, 0x00, 0xaf, 0x2b, 0x0d, 0xc8, 0x05, 0xab, 0xe6, 0x9d,
-		0x78, 0xcf, 0x24, 0x9d, 0x08, 0xb6, 0x3f, 0xc4, 0x04, 0xdd, 0xf4, 0xa7, 0x38, 0xcb, 0x9b, 0x3d,
-		0x35, 0xb1, 0x50, 0x44, 0xc3, 0x86, 0x4e, 0x75, 0x36, 0x36, 0xbf, 0x17, 0x81, 0xf8, 0x96, 0x65,
-		0xb5, 0xd1, 0x07, 0x61, 0xda, 0xb4, 0x5c, 0x95, 0x4c, 0x07, 0xdc, 0x52, 0x79, 0x60, 0xcf, 0xcc,
-		0x67, 0xed, 0x48, 0x59, 0x7d, 0xe1, 0xcd, 0x85, 0x41, 0xca, 0x61, 0x6f, 0xc2, 0x17, 0x4d, 0xcb,
-		0xad, 0x50, 0xa4, 0x26, 0x8b, 0xfd, 0x77, 0x21, 0x1f, 0xae, 0x8e, 0x99, 0xd8, 0xf2, 0xb8, 0xea,
-		0xf2, 0x63, 0xab, 0xca, 0xed, 0x04, 0xea, 0x61, 0xcf, 0x28, 0xff, 0x01, 0x19, 0xb9, 0x57, 0x41,
-		0xba, 0xd9, 0x7f, 0xc4, 0xa7, 0x06, 0x29, 0x71, 0xa4, 0x27, 0x32, 0xe1, 0x69, 0xa1, 0xa0, 0x38,
-		0x39, 0xed, 0x85, 0x9f, 0x89, 0x00, 0xf8, 0x49, 0x12, 0xf4, 0x38, 0x9c, 0xaa, 0x6c, 0x6e, 0xac,
-		0xa8, 0x8d, 0x66, 0xb9, 0xb9, 0xdd, 0x08, 0xff, 0x25, 0x11, 0xf1, 0xfe, 0x90, 0xd3, 0xc5, 0xba,
-		0xb1, 0x6b, 0xe0, 0x16, 0x7a, 0x04, 0x66, 0xc3, 0xd8, 0xe4, 0xab, 0xb6, 0x22, 0x45, 0x4a, 0xb9,
-		0xbb, 0xf7, 0x16, 0xd3, 0xcc, 0x4f, 0xc4, 0x2d, 0x74, 0x1e, 0x4e, 0x0c, 0xe2, 0xd5, 0x37, 0x56,
-		0xa5, 0x68, 0x29, 0x7f, 0xf7, 0xde, 0x62, 0xc6, 0x73, 0x28, 0x91, 0x0c, 0x28, 0x88, 0xc9, 0xf9,
-		0xc5, 0x4a, 0x70, 0xf7, 0xde, 0x62, 0x92, 0x8d, 0x42, 0x29, 0xfe, 0x91, 0x1f, 0x9a, 0x9f, 0xba,
-		0xf0, 0x75, 0x00, 0x75, 0x73, 0xd7, 0xd6, 0xe8, 0x9f, 0xd3, 0x47, 0x25, 0x38, 0x59, 0xdf, 0xb8,
-		0xa6, 0x94, 0xab, 0xcd, 0xfa, 0xe6, 0x46, 0xdf, 0x1f, 0x40, 0x09, 0x97, 0xad, 0x6c, 0x6e, 0x57,
-		0xd6, 0x6a, 0x6a, 0xa3, 0xbe, 0xba, 0xc1, 0x36, 0x5b, 0x43, 0x65, 0xef, 0xdf, 0x68, 0xd6, 0xd7,
-		0x6b, 0x52, 0xb4, 0x72, 0x6d, 0x64, 0x32, 0xff, 0xf1, 0x23, 0x5f, 0xb9, 0xf7, 0x2d, 0x5e, 0x28,
-		0xa3, 0xff, 0x7f, 0x03, 0x00, 0x00, 0xff, 0xff, 0x39, 0x56, 0x48, 0x57, 0x54, 0xa1, 0x00, 0x00,
+		// 12537 bytes of a gzipped FileDescriptorSet
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x7d, 0x6d, 0x94, 0x64, 0xc7,
+		0x55, 0xd8, 0xbc, 0xfe, 0xee, 0xdb, 0x5f, 0x6f, 0x6a, 0x66, 0x77, 0x7b, 0x7b, 0xa5, 0xdd, 0xd1,
+		0x93, 0x25, 0xad, 0x56, 0xd2, 0xac, 0xb4, 0xd2, 0xae, 0xb4, 0x23, 0xcb, 0x4a, 0x7f, 0xed, 0xee,
+		0xac, 0x66, 0xa6, 0x47, 0xaf, 0x67, 0x56, 0x5a, 0x01, 0x7e, 0xbc, 0xe9, 0x7e, 0x33, 0xd3, 0xda,
+		0xee, 0xf7, 0x5a, 0xfd, 0x5e, 0xef, 0xee, 0xe8, 0x07, 0xc7, 0x60, 0x93, 0x98, 0x35, 0x26, 0x26,
+		0x70, 0x40, 0x60, 0x16, 0x6c, 0x08, 0xd8, 0x10, 0x48, 0xc0, 0x38, 0x7c, 0x24, 0x27, 0x7c, 0x84,
+		0x7c, 0x00, 0x87, 0xe4, 0x18, 0xce, 0x49, 0x42, 0x72, 0x0e, 0x4a, 0xb0, 0x39, 0x96, 0x30, 0x26,
+		0x7c, 0x89, 0x84, 0x1c, 0x9f, 0x1c, 0xe7, 0xdc, 0xfa, 0x78, 0x1f, 0xfd, 0x31, 0xdd, 0xb3, 0x92,
+		0x89, 0x02, 0xf9, 0x33, 0xd3, 0x55, 0x75, 0xef, 0xad, 0xaa, 0x5b, 0xb7, 0x6e, 0xdd, 0xba, 0x75,
+		0xab, 0x1e, 0x7c, 0x59, 0x83, 0x85, 0x1d, 0xcb, 0xda, 0x69, 0x1b, 0xa7, 0xbb, 0x3d, 0xcb, 0xb1,
+		0xb6, 0xfa, 0xdb, 0xa7, 0x9b, 0x86, 0xdd, 0xe8, 0xb5, 0xba, 0x8e, 0xd5, 0x5b, 0xa4, 0x79, 0x24,
+		0xc7, 0x20, 0x16, 0x05, 0x84, 0xb2, 0x0a, 0xb3, 0x17, 0x5a, 0x6d, 0xa3, 0xe2, 0x02, 0xd6, 0x0d,
+		0x87, 0x3c, 0x05, 0x91, 0xed, 0x56, 0xdb, 0xc8, 0x4b, 0x0b, 0xe1, 0x93, 0xa9, 0x33, 0xef, 0x59,
+		0x1c, 0x40, 0x5a, 0x0c, 0x62, 0xac, 0x63, 0xb6, 0x4a, 0x31, 0x94, 0xd7, 0xa2, 0x30, 0x37, 0xa2,
+		0x94, 0x10, 0x88, 0x98, 0x7a, 0x07, 0x29, 0x4a, 0x27, 0x93, 0x2a, 0xfd, 0x4d, 0xf2, 0x10, 0xef,
+		0xea, 0x8d, 0x6b, 0xfa, 0x8e, 0x91, 0x0f, 0xd1, 0x6c, 0x91, 0x24, 0xc7, 0x01, 0x9a, 0x46, 0xd7,
+		0x30, 0x9b, 0x86, 0xd9, 0xd8, 0xcb, 0x87, 0x17, 0xc2, 0x27, 0x93, 0xaa, 0x2f, 0x87, 0x3c, 0x04,
+		0xb3, 0xdd, 0xfe, 0x56, 0xbb, 0xd5, 0xd0, 0x7c, 0x60, 0xb0, 0x10, 0x3e, 0x19, 0x55, 0x65, 0x56,
+		0x50, 0xf1, 0x80, 0x1f, 0x80, 0xdc, 0x0d, 0x43, 0xbf, 0xe6, 0x07, 0x4d, 0x51, 0xd0, 0x2c, 0x66,
+		0xfb, 0x00, 0xcb, 0x90, 0xee, 0x18, 0xb6, 0xad, 0xef, 0x18, 0x9a, 0xb3, 0xd7, 0x35, 0xf2, 0x11,
+		0xda, 0xfb, 0x85, 0xa1, 0xde, 0x0f, 0xf6, 0x3c, 0xc5, 0xb1, 0x36, 0xf6, 0xba, 0x06, 0x29, 0x42,
+		0xd2, 0x30, 0xfb, 0x1d, 0x46, 0x21, 0x3a, 0x86, 0x7f, 0x55, 0xb3, 0xdf, 0x19, 0xa4, 0x92, 0x40,
+		0x34, 0x4e, 0x22, 0x6e, 0x1b, 0xbd, 0xeb, 0xad, 0x86, 0x91, 0x8f, 0x51, 0x02, 0x0f, 0x0c, 0x11,
+		0xa8, 0xb3, 0xf2, 0x41, 0x1a, 0x02, 0x8f, 0x94, 0x21, 0x69, 0xdc, 0x74, 0x0c, 0xd3, 0x6e, 0x59,
+		0x66, 0x3e, 0x4e, 0x89, 0xdc, 0x37, 0x62, 0x14, 0x8d, 0x76, 0x73, 0x90, 0x84, 0x87, 0x47, 0xce,
+		0x41, 0xdc, 0xea, 0x3a, 0x2d, 0xcb, 0xb4, 0xf3, 0x89, 0x05, 0xe9, 0x64, 0xea, 0xcc, 0x5d, 0x23,
+		0x05, 0xa1, 0xc6, 0x60, 0x54, 0x01, 0x4c, 0x96, 0x41, 0xb6, 0xad, 0x7e, 0xaf, 0x61, 0x68, 0x0d,
+		0xab, 0x69, 0x68, 0x2d, 0x73, 0xdb, 0xca, 0x27, 0x29, 0x81, 0x13, 0xc3, 0x1d, 0xa1, 0x80, 0x65,
+		0xab, 0x69, 0x2c, 0x9b, 0xdb, 0x96, 0x9a, 0xb5, 0x03, 0x69, 0x72, 0x18, 0x62, 0xf6, 0x9e, 0xe9,
+		0xe8, 0x37, 0xf3, 0x69, 0x2a, 0x21, 0x3c, 0x45, 0xce, 0x40, 0xdc, 0x68, 0xb6, 0xb0, 0xba, 0x7c,
+		0x76, 0x41, 0x3a, 0x99, 0x3d, 0x93, 0x1f, 0xe6, 0x31, 0x2b, 0x57, 0x05, 0xa0, 0xf2, 0x8b, 0x31,
+		0xc8, 0x4d, 0x23, 0x96, 0x4f, 0x43, 0x74, 0x1b, 0x39, 0x93, 0x0f, 0x1d, 0x84, 0x6f, 0x0c, 0x27,
+		0xc8, 0xf8, 0xd8, 0x1d, 0x32, 0xbe, 0x08, 0x29, 0xd3, 0xb0, 0x1d, 0xa3, 0xc9, 0xa4, 0x28, 0x3c,
+		0xa5, 0x1c, 0x02, 0x43, 0x1a, 0x16, 0xc3, 0xc8, 0x1d, 0x89, 0xe1, 0x8b, 0x90, 0x73, 0x9b, 0xa4,
+		0xf5, 0x74, 0x73, 0x47, 0xc8, 0xf3, 0xe9, 0x49, 0x2d, 0x59, 0xac, 0x0a, 0x3c, 0x15, 0xd1, 0xd4,
+		0xac, 0x11, 0x48, 0x93, 0x0a, 0x80, 0x65, 0x1a, 0xd6, 0xb6, 0xd6, 0x34, 0x1a, 0xed, 0x7c, 0x62,
+		0x0c, 0x97, 0x6a, 0x08, 0x32, 0xc4, 0x25, 0x8b, 0xe5, 0x36, 0xda, 0xe4, 0xbc, 0x27, 0x9e, 0xf1,
+		0x31, 0xd2, 0xb5, 0xca, 0x26, 0xe6, 0x90, 0x84, 0x6e, 0x42, 0xb6, 0x67, 0xe0, 0x5c, 0x31, 0x9a,
+		0xbc, 0x67, 0x49, 0xda, 0x88, 0xc5, 0x89, 0x3d, 0x53, 0x39, 0x1a, 0xeb, 0x58, 0xa6, 0xe7, 0x4f,
+		0x92, 0x7b, 0xc1, 0xcd, 0xd0, 0xa8, 0x58, 0x01, 0xd5, 0x5c, 0x69, 0x91, 0xb9, 0xa6, 0x77, 0x8c,
+		0xc2, 0xab, 0x90, 0x0d, 0xb2, 0x87, 0xcc, 0x43, 0xd4, 0x76, 0xf4, 0x9e, 0x43, 0xa5, 0x30, 0xaa,
+		0xb2, 0x04, 0x91, 0x21, 0x6c, 0x98, 0x4d, 0xaa, 0x19, 0xa3, 0x2a, 0xfe, 0x24, 0x7f, 0xc7, 0xeb,
+		0x70, 0x98, 0x76, 0xf8, 0xfe, 0xe1, 0x11, 0x0d, 0x50, 0x1e, 0xec, 0x77, 0xe1, 0x49, 0xc8, 0x04,
+		0x3a, 0x30, 0x6d, 0xd5, 0xca, 0x6f, 0x45, 0xe0, 0xd0, 0x48, 0xda, 0xe4, 0x45, 0x98, 0xef, 0x9b,
+		0x2d, 0xd3, 0x31, 0x7a, 0xdd, 0x9e, 0x81, 0x22, 0xcb, 0xea, 0xca, 0xbf, 0x11, 0x1f, 0x23, 0x74,
+		0x9b, 0x7e, 0x68, 0x46, 0x45, 0x9d, 0xeb, 0x0f, 0x67, 0x92, 0xab, 0x90, 0x42, 0xf9, 0xd0, 0x7b,
+		0x3a, 0x25, 0xc8, 0x66, 0xe3, 0x99, 0xe9, 0xba, 0xbc, 0x58, 0xf1, 0x30, 0x4b, 0xe1, 0x0f, 0x4b,
+		0x21, 0xd5, 0x4f, 0x8b, 0x3c, 0x09, 0x89, 0x6d, 0x43, 0x77, 0xfa, 0x3d, 0xc3, 0xce, 0x9f, 0xa1,
+		0xac, 0x3c, 0x36, 0x3c, 0x49, 0x19, 0x40, 0xdd, 0x70, 0x54, 0x17, 0x98, 0x74, 0x20, 0x7d, 0xdd,
+		0xe8, 0xb5, 0xb6, 0x5b, 0x0d, 0xd6, 0xa8, 0x30, 0x55, 0x3e, 0x4f, 0x4d, 0xd9, 0xa8, 0x2b, 0x3e,
+		0xd4, 0xba, 0xa3, 0x3b, 0xc6, 0x12, 0x6c, 0xae, 0x5d, 0xa9, 0xaa, 0xcb, 0x17, 0x96, 0xab, 0x15,
+		0xd6, 0xcc, 0x00, 0xf9, 0xc2, 0x77, 0x4b, 0x90, 0xf2, 0xf5, 0x04, 0xd5, 0xa1, 0xd9, 0xef, 0x6c,
+		0x19, 0x3d, 0x3e, 0x5e, 0x3c, 0x45, 0x8e, 0x41, 0x72, 0xbb, 0xdf, 0x6e, 0x33, 0xa1, 0x63, 0x6b,
+		0x69, 0x02, 0x33, 0x50, 0xe0, 0x50, 0xc7, 0x71, 0x35, 0x42, 0x75, 0x1c, 0xfe, 0x26, 0x05, 0x48,
+		0x08, 0xa1, 0xcc, 0x47, 0x17, 0xa4, 0x93, 0x09, 0xd5, 0x4d, 0xb3, 0xb2, 0xae, 0xa1, 0x3b, 0x46,
+		0x33, 0x1f, 0x13, 0x65, 0x2c, 0x7d, 0x39, 0x92, 0x88, 0xc8, 0x51, 0xe5, 0x09, 0x98, 0x1d, 0xea,
+		0x0a, 0xc9, 0x41, 0xaa, 0x52, 0x2d, 0xaf, 0x14, 0xd5, 0xe2, 0xc6, 0x72, 0x6d, 0x4d, 0x9e, 0x21,
+		0x59, 0xf0, 0xf5, 0x4e, 0x96, 0x4e, 0x25, 0x13, 0x6f, 0xc6, 0xe5, 0x0f, 0x7c, 0xe0, 0x03, 0x1f,
+		0x08, 0x29, 0xbf, 0x1a, 0x83, 0xf9, 0x51, 0x4a, 0x70, 0xa4, 0x3e, 0xf6, 0x3a, 0x1d, 0x0e, 0x74,
+		0xba, 0x08, 0xd1, 0xb6, 0xbe, 0x65, 0xb4, 0xf3, 0x11, 0x3a, 0x08, 0x0f, 0x4d, 0xa5, 0x66, 0x17,
+		0x57, 0x10, 0x45, 0x65, 0x98, 0xe4, 0x7d, 0x9c, 0x35, 0x51, 0x4a, 0xe1, 0xd4, 0x74, 0x14, 0x50,
+		0x39, 0x72, 0x36, 0x1e, 0x83, 0x24, 0xfe, 0x67, 0x7c, 0x8f, 0x31, 0xbe, 0x63, 0x06, 0xe5, 0x7b,
+		0x01, 0x12, 0x54, 0xef, 0x35, 0x0d, 0x77, 0x4c, 0x44, 0x1a, 0x35, 0x45, 0xd3, 0xd8, 0xd6, 0xfb,
+		0x6d, 0x47, 0xbb, 0xae, 0xb7, 0xfb, 0x06, 0xd5, 0x60, 0x49, 0x35, 0xcd, 0x33, 0xaf, 0x60, 0x1e,
+		0x39, 0x01, 0x29, 0xa6, 0x26, 0x5b, 0x66, 0xd3, 0xb8, 0x49, 0x97, 0xd0, 0xa8, 0xca, 0x34, 0xe7,
+		0x32, 0xe6, 0x60, 0xf5, 0x2f, 0xdb, 0x96, 0x29, 0x74, 0x0d, 0xad, 0x02, 0x33, 0x68, 0xf5, 0x4f,
+		0x0e, 0xae, 0xde, 0x77, 0x8f, 0xee, 0xde, 0x90, 0x72, 0x7c, 0x00, 0x72, 0x14, 0xe2, 0x71, 0x3e,
+		0x95, 0xf5, 0x76, 0x7e, 0x96, 0x8a, 0x41, 0x96, 0x65, 0xd7, 0x78, 0xae, 0xf2, 0x73, 0x21, 0x88,
+		0xd0, 0x95, 0x22, 0x07, 0xa9, 0x8d, 0xab, 0xeb, 0x55, 0xad, 0x52, 0xdb, 0x2c, 0xad, 0x54, 0x65,
+		0x09, 0x87, 0x9e, 0x66, 0x5c, 0x58, 0xa9, 0x15, 0x37, 0xe4, 0x90, 0x9b, 0x5e, 0x5e, 0xdb, 0x38,
+		0xf7, 0x84, 0x1c, 0x76, 0x11, 0x36, 0x59, 0x46, 0xc4, 0x0f, 0xf0, 0xf8, 0x19, 0x39, 0x4a, 0x64,
+		0x48, 0x33, 0x02, 0xcb, 0x2f, 0x56, 0x2b, 0xe7, 0x9e, 0x90, 0x63, 0xc1, 0x9c, 0xc7, 0xcf, 0xc8,
+		0x71, 0x92, 0x81, 0x24, 0xcd, 0x29, 0xd5, 0x6a, 0x2b, 0x72, 0xc2, 0xa5, 0x59, 0xdf, 0x50, 0x97,
+		0xd7, 0x2e, 0xca, 0x49, 0x97, 0xe6, 0x45, 0xb5, 0xb6, 0xb9, 0x2e, 0x83, 0x4b, 0x61, 0xb5, 0x5a,
+		0xaf, 0x17, 0x2f, 0x56, 0xe5, 0x94, 0x0b, 0x51, 0xba, 0xba, 0x51, 0xad, 0xcb, 0xe9, 0x40, 0xb3,
+		0x1e, 0x3f, 0x23, 0x67, 0xdc, 0x2a, 0xaa, 0x6b, 0x9b, 0xab, 0x72, 0x96, 0xcc, 0x42, 0x86, 0x55,
+		0x21, 0x1a, 0x91, 0x1b, 0xc8, 0x3a, 0xf7, 0x84, 0x2c, 0x7b, 0x0d, 0x61, 0x54, 0x66, 0x03, 0x19,
+		0xe7, 0x9e, 0x90, 0x89, 0x52, 0x86, 0x28, 0x15, 0x43, 0x42, 0x20, 0xbb, 0x52, 0x2c, 0x55, 0x57,
+		0xb4, 0xda, 0x3a, 0x4e, 0x9a, 0xe2, 0x8a, 0x2c, 0x79, 0x79, 0x6a, 0x75, 0xbd, 0x5a, 0xdc, 0xa8,
+		0x56, 0xe4, 0xb0, 0x3f, 0xef, 0xf9, 0xcd, 0x65, 0xb5, 0x5a, 0x91, 0x43, 0x4a, 0x03, 0xe6, 0x47,
+		0xad, 0x90, 0x23, 0xa7, 0x90, 0x4f, 0x16, 0x42, 0x63, 0x64, 0x81, 0xd2, 0x1a, 0x94, 0x05, 0xe5,
+		0x0b, 0x21, 0x98, 0x1b, 0x61, 0x25, 0x8c, 0xac, 0xe4, 0x59, 0x88, 0x32, 0x59, 0x66, 0x9a, 0xfa,
+		0xc1, 0x91, 0xe6, 0x06, 0x95, 0xec, 0x21, 0xdb, 0x89, 0xe2, 0xf9, 0xed, 0xcd, 0xf0, 0x18, 0x7b,
+		0x13, 0x49, 0x0c, 0x09, 0xec, 0x37, 0x0c, 0xad, 0xe6, 0xcc, 0xe0, 0x39, 0x37, 0x8d, 0xc1, 0x43,
+		0xf3, 0x0e, 0xb6, 0xaa, 0x47, 0x47, 0xac, 0xea, 0x4f, 0xc3, 0xec, 0x10, 0xa1, 0xa9, 0x57, 0xd7,
+		0x0f, 0x4a, 0x90, 0x1f, 0xc7, 0x9c, 0x09, 0x2a, 0x31, 0x14, 0x50, 0x89, 0x4f, 0x0f, 0x72, 0xf0,
+		0x9e, 0xf1, 0x83, 0x30, 0x34, 0xd6, 0x9f, 0x92, 0xe0, 0xf0, 0xe8, 0x7d, 0xc5, 0xc8, 0x36, 0xbc,
+		0x0f, 0x62, 0x1d, 0xc3, 0xd9, 0xb5, 0x84, 0x9d, 0x7c, 0xff, 0x08, 0xeb, 0x0b, 0x8b, 0x07, 0x07,
+		0x9b, 0x63, 0x91, 0xf3, 0x83, 0x6d, 0x3d, 0x31, 0x6e, 0x97, 0x33, 0xd4, 0xd2, 0x6f, 0x0b, 0xc1,
+		0xa1, 0x91, 0xc4, 0x47, 0x36, 0xf4, 0x6e, 0x80, 0x96, 0xd9, 0xed, 0x3b, 0xcc, 0x16, 0x66, 0x9a,
+		0x38, 0x49, 0x73, 0xa8, 0xf2, 0x42, 0x2d, 0xdb, 0x77, 0xdc, 0x72, 0xb6, 0x4a, 0x02, 0xcb, 0xa2,
+		0x00, 0x4f, 0x79, 0x0d, 0x8d, 0xd0, 0x86, 0x1e, 0x1f, 0xd3, 0xd3, 0x21, 0xc1, 0x7c, 0x14, 0xe4,
+		0x46, 0xbb, 0x65, 0x98, 0x8e, 0x66, 0x3b, 0x3d, 0x43, 0xef, 0xb4, 0xcc, 0x1d, 0xb6, 0xda, 0x2e,
+		0x45, 0xb7, 0xf5, 0xb6, 0x6d, 0xa8, 0x39, 0x56, 0x5c, 0x17, 0xa5, 0x88, 0x41, 0x05, 0xa8, 0xe7,
+		0xc3, 0x88, 0x05, 0x30, 0x58, 0xb1, 0x8b, 0xa1, 0xfc, 0x54, 0x12, 0x52, 0xbe, 0x5d, 0x18, 0xb9,
+		0x07, 0xd2, 0x2f, 0xeb, 0xd7, 0x75, 0x4d, 0xec, 0xac, 0x19, 0x27, 0x52, 0x98, 0xb7, 0xce, 0xb2,
+		0xc8, 0xa3, 0x30, 0x4f, 0x41, 0xac, 0xbe, 0x63, 0xf4, 0xb4, 0x46, 0x5b, 0xb7, 0x6d, 0xca, 0xb4,
+		0x04, 0x05, 0x25, 0x58, 0x56, 0xc3, 0xa2, 0xb2, 0x28, 0x21, 0x67, 0x61, 0x8e, 0x62, 0x74, 0xfa,
+		0x6d, 0xa7, 0xd5, 0x6d, 0x1b, 0x1a, 0xee, 0xf5, 0xed, 0x3c, 0xf8, 0x5b, 0x36, 0x8b, 0x10, 0xab,
+		0x1c, 0x00, 0x5b, 0x64, 0x93, 0x0a, 0xdc, 0x4d, 0xd1, 0x76, 0x0c, 0xd3, 0xe8, 0xe9, 0x8e, 0xa1,
+		0x19, 0xaf, 0xf4, 0xf5, 0xb6, 0xad, 0xe9, 0x66, 0x53, 0xdb, 0xd5, 0xed, 0xdd, 0xfc, 0x3c, 0x12,
+		0x28, 0x85, 0xf2, 0x92, 0x7a, 0x14, 0x01, 0x2f, 0x72, 0xb8, 0x2a, 0x05, 0x2b, 0x9a, 0xcd, 0x4b,
+		0xba, 0xbd, 0x4b, 0x96, 0xe0, 0x30, 0xa5, 0x62, 0x3b, 0xbd, 0x96, 0xb9, 0xa3, 0x35, 0x76, 0x8d,
+		0xc6, 0x35, 0xad, 0xef, 0x6c, 0x3f, 0x95, 0x3f, 0xe6, 0xaf, 0x9f, 0xb6, 0xb0, 0x4e, 0x61, 0xca,
+		0x08, 0xb2, 0xe9, 0x6c, 0x3f, 0x45, 0xea, 0x90, 0xc6, 0xc1, 0xe8, 0xb4, 0x5e, 0x35, 0xb4, 0x6d,
+		0xab, 0x47, 0xd7, 0xd0, 0xec, 0x08, 0xd5, 0xe4, 0xe3, 0xe0, 0x62, 0x8d, 0x23, 0xac, 0x5a, 0x4d,
+		0x63, 0x29, 0x5a, 0x5f, 0xaf, 0x56, 0x2b, 0x6a, 0x4a, 0x50, 0xb9, 0x60, 0xf5, 0x50, 0xa0, 0x76,
+		0x2c, 0x97, 0xc1, 0x29, 0x26, 0x50, 0x3b, 0x96, 0x60, 0xef, 0x59, 0x98, 0x6b, 0x34, 0x58, 0x9f,
+		0x5b, 0x0d, 0x8d, 0xef, 0xc8, 0xed, 0xbc, 0x1c, 0x60, 0x56, 0xa3, 0x71, 0x91, 0x01, 0x70, 0x19,
+		0xb7, 0xc9, 0x79, 0x38, 0xe4, 0x31, 0xcb, 0x8f, 0x38, 0x3b, 0xd4, 0xcb, 0x41, 0xd4, 0xb3, 0x30,
+		0xd7, 0xdd, 0x1b, 0x46, 0x24, 0x81, 0x1a, 0xbb, 0x7b, 0x83, 0x68, 0xf7, 0x51, 0x2f, 0x4b, 0xcf,
+		0x68, 0x50, 0x53, 0xef, 0x88, 0x1f, 0xda, 0x57, 0x40, 0x16, 0x41, 0x6e, 0x34, 0x34, 0xc3, 0xd4,
+		0xb7, 0xda, 0x86, 0xa6, 0xf7, 0x0c, 0x53, 0xb7, 0xf3, 0x27, 0x28, 0x70, 0xc4, 0xe9, 0xf5, 0x0d,
+		0x35, 0xdb, 0x68, 0x54, 0x69, 0x61, 0x91, 0x96, 0x91, 0x53, 0x30, 0x6b, 0x6d, 0xbd, 0xdc, 0x60,
+		0x82, 0xa5, 0x75, 0x7b, 0xc6, 0x76, 0xeb, 0x66, 0xfe, 0x3d, 0x94, 0x4b, 0x39, 0x2c, 0xa0, 0x62,
+		0xb5, 0x4e, 0xb3, 0xc9, 0x83, 0x20, 0x37, 0xec, 0x5d, 0xbd, 0xd7, 0xa5, 0x9a, 0xd5, 0xee, 0xea,
+		0x0d, 0x23, 0x7f, 0x1f, 0x03, 0x65, 0xf9, 0x6b, 0x22, 0x1b, 0x05, 0xdb, 0xbe, 0xd1, 0xda, 0x76,
+		0x04, 0xc5, 0x07, 0x98, 0x60, 0xd3, 0x3c, 0x4e, 0xed, 0x24, 0xc8, 0xdd, 0xdd, 0x6e, 0xb0, 0xe2,
+		0x93, 0x14, 0x2c, 0xdb, 0xdd, 0xed, 0xfa, 0xeb, 0xbd, 0x17, 0x32, 0xdd, 0x5d, 0x7f, 0xa5, 0x0f,
+		0x32, 0xfb, 0xab, 0xbb, 0xeb, 0xab, 0xf1, 0x09, 0x38, 0x8c, 0x40, 0x1d, 0xc3, 0xd1, 0x9b, 0xba,
+		0xa3, 0xfb, 0xa0, 0x1f, 0xa6, 0xd0, 0xf3, 0xdd, 0xdd, 0xee, 0x2a, 0x2f, 0x0c, 0xb4, 0xb3, 0xd7,
+		0xdf, 0xda, 0x73, 0xe5, 0xe3, 0x11, 0xd6, 0x4e, 0xcc, 0x13, 0x12, 0x72, 0xc7, 0xdb, 0x8f, 0xaf,
+		0xd9, 0x66, 0x4b, 0x59, 0x82, 0xb4, 0x5f, 0xee, 0x49, 0x12, 0x98, 0xe4, 0xcb, 0x12, 0x1a, 0x41,
+		0xe5, 0x5a, 0x05, 0xcd, 0x97, 0x97, 0xaa, 0x72, 0x08, 0xcd, 0xa8, 0x95, 0xe5, 0x8d, 0xaa, 0xa6,
+		0x6e, 0xae, 0x6d, 0x2c, 0xaf, 0x56, 0xe5, 0xb0, 0xcf, 0xb0, 0xbf, 0x1c, 0x49, 0x9c, 0x92, 0x1f,
+		0xba, 0x1c, 0x49, 0xdc, 0x2f, 0x3f, 0x40, 0xd9, 0x33, 0x24, 0x94, 0xca, 0x5b, 0x61, 0xc8, 0x06,
+		0xb7, 0xe5, 0xe4, 0xbd, 0x70, 0x44, 0xf8, 0xdd, 0x6c, 0xc3, 0xd1, 0x6e, 0xb4, 0x7a, 0x74, 0xb2,
+		0x76, 0x74, 0xb6, 0x70, 0xba, 0x42, 0x39, 0xcf, 0xa1, 0xea, 0x86, 0xf3, 0x42, 0xab, 0x87, 0x53,
+		0xb1, 0xa3, 0x3b, 0x64, 0x05, 0x4e, 0x98, 0x96, 0x66, 0x3b, 0xba, 0xd9, 0xd4, 0x7b, 0x4d, 0xcd,
+		0xf3, 0x78, 0x6a, 0x7a, 0xa3, 0x61, 0xd8, 0xb6, 0xc5, 0x16, 0x49, 0x97, 0xca, 0x5d, 0xa6, 0x55,
+		0xe7, 0xc0, 0xde, 0xea, 0x51, 0xe4, 0xa0, 0x03, 0x73, 0x22, 0x3c, 0x6e, 0x4e, 0x1c, 0x83, 0x64,
+		0x47, 0xef, 0x6a, 0x86, 0xe9, 0xf4, 0xf6, 0xa8, 0xed, 0x9e, 0x50, 0x13, 0x1d, 0xbd, 0x5b, 0xc5,
+		0x34, 0xb9, 0x02, 0xf7, 0x7b, 0xa0, 0x5a, 0xdb, 0xd8, 0xd1, 0x1b, 0x7b, 0x1a, 0x35, 0xd4, 0xa9,
+		0x8f, 0x48, 0x6b, 0x58, 0xe6, 0x76, 0xbb, 0xd5, 0x70, 0xec, 0x7c, 0xca, 0xd5, 0x7f, 0x8a, 0x87,
+		0xb1, 0x42, 0x11, 0x2e, 0xdb, 0x96, 0x49, 0xed, 0xf3, 0xb2, 0x80, 0x0e, 0x88, 0x4d, 0xfa, 0x5d,
+		0x21, 0x36, 0xc1, 0xa1, 0x8f, 0xc8, 0xd1, 0xcb, 0x91, 0x44, 0x54, 0x8e, 0x5d, 0x8e, 0x24, 0x62,
+		0x72, 0xfc, 0x72, 0x24, 0x91, 0x90, 0x93, 0x97, 0x23, 0x89, 0xa4, 0x0c, 0xca, 0xed, 0x0c, 0xa4,
+		0xfd, 0xdb, 0x0d, 0xdc, 0xbd, 0x35, 0xe8, 0x82, 0x2b, 0x51, 0x95, 0x7c, 0xef, 0xbe, 0x9b, 0x93,
+		0xc5, 0x32, 0xae, 0xc4, 0x4b, 0x31, 0x66, 0xdb, 0xab, 0x0c, 0x13, 0xad, 0x20, 0x9c, 0x64, 0x06,
+		0xb3, 0xa5, 0x12, 0x2a, 0x4f, 0x91, 0x8b, 0x10, 0x7b, 0xd9, 0xa6, 0xb4, 0x63, 0x94, 0xf6, 0x7b,
+		0xf6, 0xa7, 0x7d, 0xb9, 0x4e, 0x89, 0x27, 0x2f, 0xd7, 0xb5, 0xb5, 0x9a, 0xba, 0x5a, 0x5c, 0x51,
+		0x39, 0x3a, 0x39, 0x0a, 0x91, 0xb6, 0xfe, 0xea, 0x5e, 0x70, 0xcd, 0xa6, 0x59, 0x64, 0x11, 0x72,
+		0x7d, 0x93, 0xed, 0xd5, 0x71, 0x8c, 0x11, 0x2a, 0xe7, 0x87, 0xca, 0x7a, 0xa5, 0x2b, 0x08, 0x3f,
+		0xa5, 0x5c, 0x1d, 0x85, 0x08, 0x3a, 0xa5, 0x83, 0x2b, 0x2b, 0xcd, 0x22, 0x27, 0x21, 0xdd, 0x34,
+		0xb6, 0xfa, 0x3b, 0x5a, 0xcf, 0x68, 0xea, 0x0d, 0x27, 0xb8, 0x9e, 0xa4, 0x68, 0x91, 0x4a, 0x4b,
+		0xc8, 0x73, 0x90, 0xc4, 0x31, 0x32, 0xe9, 0x18, 0xcf, 0x52, 0x16, 0x3c, 0xb2, 0x3f, 0x0b, 0xf8,
+		0x10, 0x0b, 0x24, 0xd5, 0xc3, 0x27, 0x97, 0x20, 0xee, 0xe8, 0xbd, 0x1d, 0xc3, 0xb1, 0xf3, 0x73,
+		0x0b, 0xe1, 0x93, 0xd9, 0x33, 0x8b, 0xd3, 0x90, 0xda, 0xa0, 0x28, 0x74, 0xa7, 0x2c, 0xd0, 0xc9,
+		0x0b, 0x20, 0x73, 0x57, 0xac, 0xc6, 0xb7, 0xb9, 0x76, 0x7e, 0x9e, 0x0a, 0xe0, 0xc3, 0xfb, 0x93,
+		0xe4, 0x9e, 0xdc, 0x0a, 0x43, 0x52, 0x73, 0x46, 0x20, 0x1d, 0x9c, 0x17, 0x87, 0x0e, 0x32, 0x2f,
+		0x36, 0x21, 0xc7, 0x7f, 0x6b, 0x76, 0xbf, 0xdb, 0xb5, 0x7a, 0x4e, 0xfe, 0xf0, 0x82, 0x34, 0xb9,
+		0x41, 0x82, 0x18, 0xc3, 0x51, 0xb3, 0xdb, 0x81, 0xf4, 0xd7, 0x6e, 0xba, 0x15, 0x5e, 0x82, 0x6c,
+		0x90, 0x19, 0x7e, 0x47, 0x78, 0x78, 0x4a, 0x47, 0x38, 0x6e, 0x4b, 0xc4, 0x46, 0x0d, 0x97, 0x26,
+		0x96, 0x28, 0x7c, 0x4f, 0x08, 0xb2, 0xc1, 0x8e, 0x91, 0x8b, 0x40, 0xc4, 0x88, 0xb5, 0x4c, 0xa7,
+		0x67, 0x35, 0xfb, 0x0d, 0xa3, 0x99, 0x97, 0x26, 0xd4, 0x33, 0xcb, 0x71, 0x96, 0x5d, 0x14, 0x3f,
+		0x21, 0xdf, 0x2c, 0x08, 0x4d, 0x49, 0xa8, 0xe2, 0xcd, 0x8f, 0xd3, 0x30, 0x27, 0x08, 0x20, 0xb1,
+		0x1b, 0x7a, 0xcf, 0x44, 0x13, 0x99, 0x19, 0xed, 0xc4, 0x57, 0xf4, 0x02, 0x2b, 0x21, 0x45, 0x10,
+		0xe2, 0xa2, 0xf5, 0x8c, 0x8e, 0x85, 0xfe, 0xae, 0xc8, 0x84, 0x6a, 0xb3, 0x1c, 0x41, 0x65, 0xf0,
+		0xca, 0x69, 0x88, 0x52, 0xf5, 0x43, 0x00, 0xb8, 0x02, 0x92, 0x67, 0x48, 0x02, 0x22, 0xe5, 0x9a,
+		0x8a, 0xcb, 0xa3, 0x0c, 0x69, 0x96, 0xab, 0xad, 0x2f, 0x57, 0xcb, 0x55, 0x39, 0xa4, 0x9c, 0x85,
+		0x18, 0xd3, 0x29, 0xb8, 0x74, 0xba, 0x5a, 0x45, 0x9e, 0xe1, 0x49, 0x4e, 0x43, 0x12, 0xa5, 0x9b,
+		0xab, 0xa5, 0xaa, 0x2a, 0x87, 0x94, 0x4d, 0xc8, 0x0d, 0xcc, 0x43, 0x72, 0x08, 0x66, 0xd5, 0xea,
+		0x46, 0x75, 0x0d, 0x9d, 0x03, 0xda, 0xe6, 0xda, 0x73, 0x6b, 0xb5, 0x17, 0xd0, 0xb3, 0x16, 0xc8,
+		0x16, 0xeb, 0xb0, 0x44, 0xe6, 0x41, 0xf6, 0xb2, 0xeb, 0xb5, 0x4d, 0x95, 0xb6, 0xe6, 0xdb, 0x43,
+		0x20, 0x0f, 0x4e, 0x4a, 0x72, 0x04, 0xe6, 0x36, 0x8a, 0xea, 0xc5, 0xea, 0x86, 0xc6, 0x1c, 0x1e,
+		0x2e, 0xe9, 0x79, 0x90, 0xfd, 0x05, 0x17, 0x96, 0xa9, 0x3f, 0xe7, 0x04, 0x1c, 0xf3, 0xe7, 0x56,
+		0x5f, 0xdc, 0xa8, 0xae, 0xd5, 0x69, 0xe5, 0xc5, 0xb5, 0x8b, 0x68, 0x14, 0x0c, 0xd0, 0x13, 0x2e,
+		0x96, 0x30, 0x36, 0x35, 0x48, 0xaf, 0xba, 0x52, 0x91, 0x23, 0x83, 0xd9, 0xb5, 0xb5, 0x6a, 0xed,
+		0x82, 0x1c, 0x1d, 0xac, 0x9d, 0xba, 0x5d, 0x62, 0xa4, 0x00, 0x87, 0x07, 0x73, 0xb5, 0xea, 0xda,
+		0x86, 0x7a, 0x55, 0x8e, 0x0f, 0x56, 0x5c, 0xaf, 0xaa, 0x57, 0x96, 0xcb, 0x55, 0x39, 0x41, 0x0e,
+		0x03, 0x09, 0xb6, 0x68, 0xe3, 0x52, 0xad, 0x22, 0x27, 0x47, 0xad, 0x58, 0x44, 0x9e, 0x53, 0x7e,
+		0x52, 0x82, 0xb4, 0xdf, 0x05, 0x12, 0x50, 0x2a, 0xd2, 0xbb, 0x6d, 0xb1, 0x55, 0x7e, 0x3b, 0x04,
+		0x29, 0x9f, 0x2f, 0x04, 0x37, 0xb1, 0x7a, 0xbb, 0x6d, 0xdd, 0xd0, 0xf4, 0x76, 0x4b, 0xb7, 0xf9,
+		0x7a, 0x08, 0x34, 0xab, 0x88, 0x39, 0xd3, 0xae, 0x3f, 0xd3, 0x9b, 0x2e, 0xb1, 0x3b, 0x36, 0x5d,
+		0xe2, 0xef, 0x42, 0xd3, 0x25, 0x2a, 0xc7, 0x94, 0xdf, 0x0d, 0x81, 0x3c, 0xe8, 0x1d, 0x19, 0xe0,
+		0x9b, 0x34, 0x8e, 0x6f, 0xfe, 0xfe, 0x85, 0x0e, 0xd2, 0xbf, 0xc1, 0x55, 0x3d, 0x3c, 0x76, 0x55,
+		0x1f, 0xb1, 0x58, 0x45, 0xde, 0xcd, 0x8b, 0x95, 0x5f, 0x5c, 0xff, 0x83, 0x04, 0xd9, 0xa0, 0x33,
+		0x27, 0xc0, 0x31, 0xe5, 0x20, 0x1c, 0x0b, 0x8e, 0xc8, 0x3d, 0xe3, 0x46, 0xe4, 0xaf, 0xa5, 0x5f,
+		0xdf, 0x17, 0x86, 0x4c, 0xc0, 0xf7, 0x33, 0x6d, 0xeb, 0x5e, 0x81, 0xd9, 0x56, 0xd3, 0xe8, 0x74,
+		0x2d, 0x07, 0x23, 0x0f, 0xb4, 0xb6, 0x71, 0xdd, 0x68, 0x53, 0x36, 0x64, 0x47, 0x9c, 0xae, 0x06,
+		0x6a, 0x58, 0x5c, 0xf6, 0xf0, 0x56, 0x10, 0x6d, 0x69, 0x6e, 0xb9, 0x52, 0x5d, 0x5d, 0xaf, 0x6d,
+		0x54, 0xd7, 0xca, 0x57, 0x85, 0x26, 0x57, 0xe5, 0xd6, 0x00, 0x58, 0x80, 0xe1, 0xf7, 0xbe, 0x3b,
+		0x36, 0x9d, 0xeb, 0x20, 0x0f, 0xf6, 0x06, 0x15, 0xfa, 0x88, 0xfe, 0xc8, 0x33, 0x64, 0x0e, 0x72,
+		0x6b, 0x35, 0xad, 0xbe, 0x5c, 0xa9, 0x6a, 0xd5, 0x0b, 0x17, 0xaa, 0xe5, 0x8d, 0x3a, 0x3b, 0x68,
+		0x70, 0xa1, 0x37, 0xe4, 0x90, 0x7f, 0x6c, 0xbe, 0x3f, 0x0c, 0x73, 0x23, 0x5a, 0x42, 0x8a, 0xdc,
+		0x45, 0xc8, 0xbc, 0x96, 0x8f, 0x4c, 0xd3, 0xfa, 0x45, 0xdc, 0xdd, 0xaf, 0xeb, 0x3d, 0x87, 0x7b,
+		0x14, 0x1f, 0x04, 0x64, 0xaf, 0xe9, 0xa0, 0x79, 0xdf, 0xe3, 0x07, 0x38, 0xcc, 0x04, 0xc9, 0x79,
+		0xf9, 0xec, 0x0c, 0xe7, 0x61, 0x20, 0x5d, 0xcb, 0x6e, 0x39, 0xad, 0xeb, 0x18, 0x08, 0x21, 0x4e,
+		0x7b, 0x70, 0xe2, 0x46, 0x54, 0x59, 0x94, 0x2c, 0x9b, 0x8e, 0x0b, 0x6d, 0x1a, 0x3b, 0xfa, 0x00,
+		0x34, 0x6e, 0x3f, 0xc2, 0xaa, 0x2c, 0x4a, 0x5c, 0xe8, 0x7b, 0x20, 0xdd, 0xb4, 0xfa, 0xe8, 0x95,
+		0x61, 0x70, 0xa8, 0x92, 0x25, 0x35, 0xc5, 0xf2, 0x5c, 0x10, 0xee, 0x36, 0xf3, 0x8e, 0x99, 0xd2,
+		0x6a, 0x8a, 0xe5, 0x31, 0x90, 0x07, 0x20, 0xa7, 0xef, 0xec, 0xf4, 0x90, 0xb8, 0x20, 0xc4, 0x1c,
+		0x81, 0x59, 0x37, 0x9b, 0x02, 0x16, 0x2e, 0x43, 0x42, 0xf0, 0x01, 0xf7, 0xbf, 0xc8, 0x09, 0xad,
+		0xcb, 0xbc, 0xdb, 0x21, 0x3c, 0x79, 0x32, 0x45, 0xe1, 0x3d, 0x90, 0x6e, 0xd9, 0x9a, 0x17, 0x06,
+		0x11, 0x5a, 0x08, 0x9d, 0x4c, 0xa8, 0xa9, 0x96, 0xed, 0x9e, 0x8a, 0x2a, 0x9f, 0x02, 0x00, 0x4f,
+		0xd8, 0xc8, 0x77, 0x4a, 0x90, 0x65, 0x0b, 0x4c, 0xb7, 0x67, 0xd8, 0x86, 0xd9, 0x10, 0xdb, 0xc2,
+		0x07, 0xf7, 0x11, 0x51, 0xa6, 0xe6, 0xd6, 0x39, 0x42, 0xe9, 0xd9, 0x0f, 0x4b, 0xd2, 0x6b, 0x52,
+		0xe4, 0x35, 0x49, 0xfa, 0x61, 0x29, 0x43, 0x12, 0xd5, 0x17, 0xd7, 0x57, 0x96, 0xcb, 0xcb, 0x1b,
+		0xf9, 0x2f, 0xc6, 0x69, 0x7a, 0x79, 0x95, 0xa7, 0xdf, 0x88, 0x07, 0xcb, 0xdf, 0x8c, 0x7f, 0x46,
+		0x0a, 0x27, 0xde, 0x8c, 0xab, 0x99, 0x6d, 0x3f, 0x3d, 0xd2, 0xf6, 0x47, 0x50, 0x84, 0xc6, 0x6d,
+		0x24, 0xbd, 0xd6, 0x54, 0x79, 0xdc, 0x44, 0xe9, 0x41, 0xda, 0x90, 0x18, 0x6d, 0x48, 0x8a, 0xc4,
+		0xca, 0x2b, 0xb5, 0x7a, 0xb5, 0x42, 0x9b, 0x91, 0x24, 0x91, 0xda, 0x7a, 0x75, 0x2d, 0xff, 0x86,
+		0xa8, 0xd2, 0x0b, 0xb6, 0x78, 0x4d, 0x82, 0x23, 0xe2, 0x94, 0x95, 0xaf, 0xb5, 0x86, 0xd9, 0xb0,
+		0x9a, 0xc2, 0xba, 0xcd, 0x9e, 0x79, 0x6c, 0xbf, 0xca, 0x55, 0x8e, 0x4a, 0x59, 0x52, 0xe5, 0x88,
+		0xa5, 0x47, 0x86, 0x58, 0x52, 0x5c, 0xab, 0xf0, 0xb6, 0xa4, 0x48, 0x6c, 0xbd, 0x58, 0x7e, 0xae,
+		0x5a, 0xf1, 0x5a, 0x73, 0xa8, 0x37, 0x8a, 0x0a, 0xf9, 0x26, 0xc8, 0xa1, 0xb7, 0x15, 0x65, 0xa3,
+		0xd5, 0x64, 0xc7, 0xde, 0x91, 0x71, 0xe7, 0xa5, 0x5e, 0x8b, 0xd0, 0xfd, 0x7a, 0xc5, 0xc5, 0x28,
+		0x3d, 0xe8, 0x6b, 0x4a, 0x92, 0x44, 0xd6, 0x6a, 0x6b, 0x55, 0xd1, 0x0c, 0x7a, 0x44, 0x7c, 0xd5,
+		0x6b, 0x46, 0xb6, 0x1f, 0x40, 0x25, 0xdf, 0x04, 0xb2, 0x70, 0x0f, 0xb9, 0x2c, 0x89, 0x8e, 0x3b,
+		0xf2, 0xf5, 0x1a, 0xc0, 0x9d, 0x4c, 0x2e, 0x33, 0xee, 0xf7, 0xb5, 0x60, 0x9e, 0xe4, 0x56, 0xaa,
+		0x6b, 0x17, 0x37, 0x2e, 0x69, 0xeb, 0x6a, 0x95, 0x9e, 0xdc, 0xe5, 0xbf, 0x28, 0xaa, 0xcf, 0x75,
+		0x82, 0x88, 0xe4, 0x5b, 0x24, 0x48, 0x31, 0x13, 0x88, 0xf9, 0xa4, 0x98, 0x53, 0xe1, 0xfe, 0xfd,
+		0xea, 0xa6, 0x16, 0x10, 0x85, 0x2e, 0x9d, 0xa7, 0xd5, 0x86, 0x85, 0x40, 0x1c, 0x21, 0x64, 0xa5,
+		0x7a, 0xb1, 0x58, 0xbe, 0xaa, 0x95, 0xaa, 0xf5, 0x0d, 0xd4, 0x64, 0x35, 0x95, 0xc9, 0x28, 0x90,
+		0x68, 0x71, 0x65, 0xa5, 0xf6, 0x82, 0xc7, 0x08, 0x78, 0xd9, 0x25, 0xa3, 0x7c, 0x3d, 0x64, 0x02,
+		0xe2, 0x8e, 0x46, 0x31, 0x35, 0xa6, 0xb1, 0x07, 0xf5, 0xea, 0x5a, 0xd9, 0x6f, 0xc4, 0xa7, 0xc1,
+		0x15, 0x6f, 0x59, 0xc2, 0x94, 0x10, 0x7e, 0x39, 0x84, 0x6a, 0x94, 0x37, 0xc0, 0x3d, 0x4b, 0x0c,
+		0x2b, 0x4f, 0x42, 0x42, 0x88, 0x2f, 0x9a, 0xe6, 0xd4, 0xc2, 0x1e, 0xd8, 0x18, 0x24, 0x80, 0xca,
+		0xae, 0x2c, 0xe1, 0x36, 0x88, 0xc9, 0xb4, 0x1c, 0x52, 0xae, 0xc0, 0xa1, 0x91, 0xa2, 0x47, 0xee,
+		0x85, 0x13, 0xe2, 0xfc, 0x92, 0x19, 0xfd, 0x5a, 0x75, 0xad, 0x5c, 0xab, 0xe0, 0x36, 0xc9, 0xa3,
+		0x09, 0xc0, 0x65, 0x90, 0xb5, 0x52, 0xc8, 0xa7, 0x1c, 0x52, 0x96, 0x21, 0x1b, 0x14, 0x20, 0x72,
+		0x0c, 0x8e, 0x6c, 0x6e, 0x5c, 0x78, 0x4a, 0xbb, 0x52, 0x5c, 0x59, 0xae, 0x14, 0x07, 0x36, 0x44,
+		0x00, 0x5c, 0x8a, 0xe4, 0x10, 0x36, 0x14, 0xa5, 0x4b, 0x0e, 0x2b, 0x91, 0x84, 0x24, 0x4b, 0x4a,
+		0x1d, 0x72, 0x03, 0xa2, 0x40, 0xee, 0x82, 0x3c, 0xdf, 0xa1, 0x8c, 0x6a, 0xd5, 0x1c, 0x0c, 0x0a,
+		0x07, 0xdb, 0xab, 0x55, 0xaa, 0x2b, 0xcb, 0xab, 0xcb, 0x1b, 0xb4, 0x7d, 0x97, 0x00, 0xbc, 0x31,
+		0xc6, 0x35, 0xeb, 0x72, 0xbd, 0xb6, 0xa6, 0x5d, 0xc0, 0x8d, 0xde, 0x86, 0x8f, 0x54, 0x12, 0xd8,
+		0x98, 0xca, 0x12, 0xee, 0x47, 0x86, 0x07, 0x5e, 0x0e, 0x9d, 0x8a, 0xe1, 0x8a, 0xf5, 0x91, 0xb5,
+		0x53, 0xb1, 0xc4, 0x47, 0xd6, 0xe4, 0x8f, 0xe1, 0xff, 0x8f, 0xad, 0xc9, 0xdf, 0xb9, 0x76, 0x39,
+		0x96, 0x78, 0x23, 0x2e, 0xbf, 0x19, 0x57, 0xfe, 0x24, 0x0c, 0xc4, 0x93, 0x2c, 0xd7, 0xe7, 0xf1,
+		0x22, 0x24, 0x5c, 0x27, 0x0a, 0x8b, 0xd2, 0x7c, 0xef, 0x3e, 0x02, 0x29, 0xd0, 0x7c, 0x59, 0x03,
+		0x4e, 0x15, 0x97, 0x1a, 0xee, 0x98, 0x3b, 0x2d, 0xb3, 0xd5, 0xe9, 0x77, 0x34, 0xe1, 0x59, 0x98,
+		0xb8, 0x63, 0xe6, 0x08, 0x3c, 0x4d, 0x49, 0xe8, 0x37, 0x03, 0x24, 0xa2, 0x13, 0x49, 0x30, 0x04,
+		0x9e, 0x2e, 0xfc, 0x95, 0x04, 0xf9, 0x71, 0x8d, 0xbd, 0x23, 0xa7, 0xc7, 0x1a, 0xcc, 0x5b, 0xd7,
+		0x8d, 0x5e, 0xaf, 0xd5, 0xa4, 0xe7, 0x18, 0xae, 0x29, 0x14, 0x99, 0x6c, 0x0a, 0xcd, 0xf9, 0x10,
+		0x79, 0xb6, 0x4d, 0x4a, 0xb8, 0x62, 0xdd, 0x44, 0x65, 0x2d, 0x28, 0x45, 0x27, 0x53, 0xca, 0x50,
+		0x14, 0x41, 0xe3, 0x32, 0x0a, 0x28, 0xee, 0x3e, 0x42, 0x72, 0xd8, 0xb3, 0xb7, 0x94, 0x4f, 0x85,
+		0x20, 0x1b, 0x0c, 0x8b, 0x24, 0x15, 0x48, 0xb4, 0x2d, 0x1e, 0x72, 0xc4, 0x46, 0xfb, 0xe4, 0x84,
+		0x48, 0xca, 0xc5, 0x15, 0x0e, 0xaf, 0xba, 0x98, 0x85, 0x7f, 0x2f, 0x41, 0x42, 0x64, 0x93, 0xc3,
+		0x10, 0xe9, 0xea, 0xce, 0x2e, 0x25, 0x17, 0x2d, 0x85, 0x64, 0x49, 0xa5, 0x69, 0xcc, 0xb7, 0xbb,
+		0x3a, 0x0b, 0xb7, 0xe2, 0xf9, 0x98, 0x46, 0x9b, 0xa7, 0x6d, 0xe8, 0x4d, 0x7a, 0x02, 0x67, 0x75,
+		0x3a, 0x86, 0xe9, 0xd8, 0xc2, 0xe6, 0xe1, 0xf9, 0x65, 0x9e, 0x8d, 0xd1, 0xb9, 0x4e, 0x4f, 0x6f,
+		0xb5, 0x03, 0xb0, 0x11, 0x0a, 0x2b, 0x8b, 0x02, 0x17, 0x78, 0x09, 0x8e, 0x0a, 0xba, 0x4d, 0xc3,
+		0xd1, 0x1b, 0xbb, 0x46, 0xd3, 0x43, 0x8a, 0xd1, 0x93, 0xf6, 0x23, 0x1c, 0xa0, 0xc2, 0xcb, 0x05,
+		0xae, 0xf2, 0xb9, 0x10, 0xcc, 0x8a, 0x33, 0xc3, 0xa6, 0xcb, 0xac, 0x55, 0x00, 0xdd, 0x34, 0x2d,
+		0xc7, 0xcf, 0xae, 0x61, 0x33, 0x6f, 0x08, 0x6f, 0xb1, 0xe8, 0x22, 0xa9, 0x3e, 0x02, 0x85, 0x3f,
+		0x92, 0x00, 0xbc, 0xa2, 0xb1, 0x7c, 0x3b, 0x01, 0x29, 0x1e, 0xf4, 0x4a, 0x23, 0xa7, 0x99, 0x6b,
+		0x0d, 0x58, 0x16, 0x9e, 0x2e, 0xa2, 0xd7, 0x6d, 0xcb, 0xd8, 0x69, 0x99, 0x3c, 0x8a, 0x89, 0x25,
+		0x44, 0x30, 0x40, 0xc4, 0x8b, 0xf2, 0x53, 0x21, 0x61, 0x1b, 0x1d, 0xdd, 0x74, 0x5a, 0x0d, 0x3e,
+		0x6b, 0xce, 0x1d, 0xa8, 0xf1, 0x8b, 0x75, 0x8e, 0xad, 0xba, 0x74, 0x94, 0x93, 0x90, 0x10, 0xb9,
+		0xae, 0x7e, 0x9c, 0x21, 0x71, 0x08, 0xd7, 0xab, 0xb8, 0x42, 0x50, 0x35, 0xb5, 0x5c, 0xac, 0xcb,
+		0xa1, 0x53, 0x9f, 0x0a, 0x41, 0x5c, 0x4c, 0xe3, 0x39, 0xc8, 0x55, 0x2b, 0xcb, 0x03, 0xaa, 0x76,
+		0x0e, 0xb2, 0x22, 0x93, 0xe9, 0x33, 0xf9, 0x43, 0x71, 0x7f, 0xe6, 0xba, 0x5a, 0xdb, 0xa8, 0x9d,
+		0x91, 0xbf, 0x38, 0x9c, 0xf9, 0xb8, 0xfc, 0x46, 0x9c, 0xcc, 0x42, 0x5a, 0x64, 0x9e, 0x79, 0xf4,
+		0xcc, 0xe3, 0xf2, 0x9b, 0x83, 0x59, 0x4f, 0xc8, 0x7f, 0x48, 0xbd, 0x3a, 0x22, 0xeb, 0x31, 0x6d,
+		0x03, 0xf5, 0x65, 0x6d, 0x6d, 0xe5, 0xaa, 0x2c, 0xf9, 0x0b, 0xce, 0xf8, 0x0a, 0x42, 0xe4, 0x6e,
+		0x38, 0x22, 0x0a, 0xce, 0x9f, 0x3f, 0x7f, 0xfe, 0x49, 0x5f, 0xe1, 0xed, 0x8f, 0xc6, 0x06, 0x8b,
+		0x9f, 0xf2, 0x15, 0xff, 0xe0, 0x70, 0xf1, 0x79, 0x5f, 0xf1, 0x0f, 0x7d, 0x34, 0x46, 0xe6, 0x20,
+		0x25, 0x8a, 0x57, 0x8b, 0x2f, 0xca, 0x5f, 0xfd, 0xea, 0x57, 0xbf, 0x1a, 0x2f, 0x7d, 0x13, 0xcc,
+		0x35, 0xac, 0xce, 0xe0, 0xd0, 0x94, 0xe4, 0x81, 0x90, 0x04, 0xfb, 0x92, 0xf4, 0xd2, 0x23, 0x1c,
+		0x68, 0xc7, 0x6a, 0xeb, 0xe6, 0xce, 0xa2, 0xd5, 0xdb, 0xf1, 0x22, 0xf4, 0xd1, 0xbc, 0xb4, 0x7d,
+		0x71, 0xfa, 0xdd, 0xad, 0xbf, 0x92, 0xa4, 0x1f, 0x0e, 0x85, 0x2f, 0xae, 0x97, 0x7e, 0x22, 0x54,
+		0xb8, 0xc8, 0x10, 0xd7, 0xc5, 0xc0, 0xab, 0xc6, 0x76, 0xdb, 0x68, 0xe0, 0xe8, 0xc0, 0x1f, 0x3f,
+		0x04, 0xf3, 0x3b, 0xd6, 0x8e, 0x45, 0x29, 0x9d, 0xc6, 0x5f, 0xac, 0x11, 0x24, 0xe9, 0xe6, 0x16,
+		0x26, 0xde, 0x07, 0x58, 0x5a, 0x83, 0x39, 0x0e, 0xac, 0x51, 0x6b, 0x97, 0x9d, 0x9a, 0x92, 0x7d,
+		0x23, 0x6f, 0xf2, 0x3f, 0xf3, 0x07, 0xd4, 0x4d, 0xa1, 0xce, 0x72, 0x54, 0x2c, 0x63, 0x07, 0xab,
+		0x4b, 0x2a, 0x1c, 0x0a, 0xd0, 0x63, 0x3b, 0x0d, 0xa3, 0x37, 0x81, 0xe2, 0xbf, 0xe6, 0x14, 0xe7,
+		0x7c, 0x14, 0xeb, 0x1c, 0x75, 0xa9, 0x0c, 0x99, 0x83, 0xd0, 0xfa, 0x37, 0x9c, 0x56, 0xda, 0xf0,
+		0x13, 0xb9, 0x08, 0x39, 0x4a, 0xa4, 0xd1, 0xb7, 0x1d, 0xab, 0x43, 0xb7, 0x71, 0xfb, 0x93, 0xf9,
+		0xb7, 0x7f, 0xc0, 0xd4, 0x5b, 0x16, 0xd1, 0xca, 0x2e, 0xd6, 0xd2, 0x12, 0x50, 0xab, 0x1d, 0xc3,
+		0x49, 0x27, 0x50, 0xf8, 0x75, 0xde, 0x10, 0x17, 0x7e, 0xe9, 0x0a, 0xcc, 0xe3, 0x6f, 0xba, 0xcb,
+		0xf2, 0xb7, 0x64, 0x72, 0x98, 0x4e, 0xfe, 0xb7, 0x3f, 0xc8, 0x34, 0xe8, 0x9c, 0x4b, 0xc0, 0xd7,
+		0x26, 0xdf, 0x28, 0xee, 0x18, 0x8e, 0x63, 0xf4, 0x6c, 0x4d, 0x6f, 0x8f, 0x6a, 0x9e, 0x2f, 0xce,
+		0x21, 0xff, 0x7d, 0x5f, 0x0e, 0x8e, 0xe2, 0x45, 0x86, 0x59, 0x6c, 0xb7, 0x97, 0x36, 0xe1, 0xc8,
+		0x08, 0xa9, 0x98, 0x82, 0xe6, 0xf7, 0x73, 0x9a, 0xf3, 0x43, 0x92, 0x81, 0x64, 0xd7, 0x41, 0xe4,
+		0xbb, 0x63, 0x39, 0x05, 0xcd, 0x8f, 0x73, 0x9a, 0x84, 0xe3, 0x8a, 0x21, 0x45, 0x8a, 0x97, 0x61,
+		0xf6, 0xba, 0xd1, 0xdb, 0xb2, 0x6c, 0x1e, 0x5b, 0x32, 0x05, 0xb9, 0x1f, 0xe0, 0xe4, 0x72, 0x1c,
+		0x91, 0x06, 0x9b, 0x20, 0xad, 0xf3, 0x90, 0xd8, 0xd6, 0x1b, 0xc6, 0x14, 0x24, 0x6e, 0x73, 0x12,
+		0x71, 0x84, 0x47, 0xd4, 0x22, 0xa4, 0x77, 0x2c, 0xbe, 0xd1, 0x9e, 0x8c, 0xfe, 0x83, 0x1c, 0x3d,
+		0x25, 0x70, 0x38, 0x89, 0xae, 0xd5, 0xed, 0xb7, 0x71, 0x17, 0x3e, 0x99, 0xc4, 0x0f, 0x09, 0x12,
+		0x02, 0x87, 0x93, 0x38, 0x00, 0x5b, 0x3f, 0x21, 0x48, 0xd8, 0x3e, 0x7e, 0x3e, 0x8b, 0x21, 0xa7,
+		0xed, 0x3d, 0xcb, 0x9c, 0xa6, 0x11, 0x9f, 0xe4, 0x14, 0x80, 0xa3, 0x20, 0x81, 0xa7, 0x21, 0x39,
+		0xed, 0x40, 0xfc, 0xe8, 0x97, 0xc5, 0xf4, 0x10, 0x23, 0x70, 0x11, 0x72, 0x42, 0x41, 0xe1, 0x81,
+		0xcd, 0x64, 0x12, 0x3f, 0xc6, 0x49, 0x64, 0x7d, 0x68, 0xbc, 0x1b, 0x8e, 0x61, 0x3b, 0x3b, 0xc6,
+		0x34, 0x44, 0x3e, 0x25, 0xba, 0xc1, 0x51, 0x38, 0x2b, 0xb7, 0x0c, 0xb3, 0xb1, 0x3b, 0x1d, 0x85,
+		0x4f, 0x0b, 0x56, 0x0a, 0x1c, 0x24, 0x51, 0x86, 0x4c, 0x47, 0xef, 0xd9, 0xbb, 0x7a, 0x7b, 0xaa,
+		0xe1, 0xf8, 0x71, 0x4e, 0x23, 0xed, 0x22, 0x71, 0x8e, 0xf4, 0xcd, 0x83, 0x90, 0xf9, 0x09, 0xc1,
+		0x91, 0xbe, 0x19, 0x20, 0xb4, 0x0e, 0xf3, 0xb6, 0x43, 0x2d, 0xdf, 0x83, 0x50, 0xfb, 0x47, 0x62,
+		0xea, 0x31, 0xdc, 0x55, 0x3f, 0xc5, 0xa7, 0x21, 0x69, 0xb7, 0x5e, 0x9d, 0x8a, 0xcc, 0x4f, 0x8a,
+		0x91, 0xa6, 0x08, 0x88, 0x7c, 0x15, 0x8e, 0x8e, 0x5c, 0x26, 0xa6, 0x20, 0xf6, 0x53, 0x9c, 0xd8,
+		0xe1, 0x11, 0x4b, 0x05, 0x57, 0x09, 0x07, 0x25, 0xf9, 0x8f, 0x85, 0x4a, 0x30, 0x06, 0x68, 0xad,
+		0xa3, 0xeb, 0xd3, 0xd6, 0xb7, 0x0f, 0xc6, 0xb5, 0x7f, 0x22, 0xb8, 0xc6, 0x70, 0x03, 0x5c, 0xdb,
+		0x80, 0xc3, 0x9c, 0xe2, 0xc1, 0xc6, 0xf5, 0xa7, 0x85, 0x62, 0x65, 0xd8, 0x9b, 0xc1, 0xd1, 0xfd,
+		0x3a, 0x28, 0xb8, 0xec, 0x14, 0x3e, 0x36, 0x5b, 0xc3, 0x08, 0x95, 0xc9, 0x94, 0x7f, 0x86, 0x53,
+		0x16, 0x1a, 0xdf, 0x75, 0xd2, 0xd9, 0xab, 0x7a, 0x17, 0x89, 0xbf, 0x08, 0x79, 0x41, 0xbc, 0x6f,
+		0xf6, 0x8c, 0x86, 0xb5, 0x63, 0xb6, 0x5e, 0x35, 0x9a, 0x53, 0x90, 0xfe, 0xcc, 0xc0, 0x50, 0x6d,
+		0xfa, 0xd0, 0x91, 0xf2, 0x32, 0xc8, 0xae, 0xad, 0xa2, 0xb5, 0x3a, 0xf4, 0x3c, 0x62, 0x7f, 0x8a,
+		0x3f, 0x2b, 0x46, 0xca, 0xc5, 0x5b, 0xa6, 0x68, 0x4b, 0x55, 0x60, 0xd1, 0xe9, 0xd3, 0x8a, 0xe4,
+		0x67, 0x39, 0xa1, 0x8c, 0x87, 0xc5, 0x15, 0x47, 0xc3, 0xea, 0x74, 0xf5, 0xde, 0x34, 0xfa, 0xef,
+		0x9f, 0x0a, 0xc5, 0xc1, 0x51, 0xb8, 0xe2, 0x40, 0x8b, 0x0e, 0x57, 0xfb, 0x29, 0x28, 0xfc, 0x9c,
+		0x50, 0x1c, 0x02, 0x87, 0x93, 0x10, 0x06, 0xc3, 0x14, 0x24, 0x7e, 0x5e, 0x90, 0x10, 0x38, 0x48,
+		0xe2, 0x79, 0x6f, 0xa1, 0xed, 0x19, 0x3b, 0x2d, 0xdb, 0xe1, 0xf7, 0x47, 0xf6, 0x27, 0xf5, 0x0b,
+		0x5f, 0x0e, 0x1a, 0x61, 0xaa, 0x0f, 0x15, 0x35, 0x11, 0x77, 0x8c, 0x51, 0xc7, 0xef, 0xe4, 0x86,
+		0xfd, 0xa2, 0xd0, 0x44, 0x3e, 0x34, 0x6c, 0x9b, 0xcf, 0x42, 0x44, 0xb6, 0x37, 0x70, 0x4b, 0x37,
+		0x05, 0xb9, 0x7f, 0x36, 0xd0, 0xb8, 0xba, 0xc0, 0x45, 0x9a, 0x3e, 0xfb, 0xa7, 0x6f, 0x5e, 0x33,
+		0xf6, 0xa6, 0x92, 0xce, 0x7f, 0x3e, 0x60, 0xff, 0x6c, 0x32, 0x4c, 0xa6, 0x43, 0x72, 0x03, 0xf6,
+		0x14, 0x99, 0x74, 0xb9, 0x2c, 0xff, 0xcd, 0x6f, 0xf1, 0xfe, 0x06, 0xcd, 0xa9, 0xa5, 0x15, 0x90,
+		0x79, 0x8e, 0x67, 0xc0, 0x4e, 0x24, 0xf6, 0xc1, 0xb7, 0x5c, 0x39, 0x0f, 0xd8, 0x3c, 0x4b, 0x17,
+		0x20, 0x13, 0x30, 0x78, 0x26, 0x93, 0xfa, 0x10, 0x27, 0x95, 0xf6, 0xdb, 0x3b, 0x4b, 0x67, 0x21,
+		0x82, 0xc6, 0xcb, 0x64, 0xf4, 0x6f, 0xe5, 0xe8, 0x14, 0x7c, 0xe9, 0x19, 0x48, 0x08, 0xa3, 0x65,
+		0x32, 0xea, 0xdf, 0xe5, 0xa8, 0x2e, 0x0a, 0xa2, 0x0b, 0x83, 0x65, 0x32, 0xfa, 0xdf, 0x13, 0xe8,
+		0x02, 0x05, 0xd1, 0xa7, 0x67, 0xe1, 0xaf, 0x7c, 0x24, 0xc2, 0xd0, 0x05, 0xca, 0x12, 0x46, 0xc7,
+		0x33, 0x4b, 0x65, 0x32, 0xf6, 0xb7, 0xf1, 0xca, 0x05, 0xc6, 0xd2, 0x93, 0x10, 0x9d, 0x92, 0xe1,
+		0x1f, 0xe5, 0xa8, 0x0c, 0x7e, 0xa9, 0x0c, 0x29, 0x9f, 0x75, 0x32, 0x19, 0xfd, 0x3b, 0x38, 0xba,
+		0x1f, 0x0b, 0x9b, 0xce, 0xad, 0x93, 0xc9, 0x04, 0xfe, 0xbe, 0x68, 0x3a, 0xc7, 0x40, 0xb6, 0x09,
+		0xc3, 0x64, 0x32, 0xf6, 0xc7, 0x04, 0xd7, 0x05, 0xca, 0xd2, 0xb3, 0x90, 0x74, 0x17, 0x9b, 0xc9,
+		0xf8, 0xdf, 0xc9, 0xf1, 0x3d, 0x1c, 0xe4, 0x40, 0xdf, 0x3c, 0x00, 0x89, 0x7f, 0x20, 0x38, 0xe0,
+		0xc3, 0xc2, 0x69, 0x34, 0x68, 0xc0, 0x4c, 0xa6, 0xf4, 0x5d, 0x62, 0x1a, 0x0d, 0xd8, 0x2f, 0x38,
+		0x9a, 0x54, 0xe7, 0x4f, 0x26, 0xf1, 0xdd, 0x62, 0x34, 0x29, 0x3c, 0x36, 0x63, 0xd0, 0x22, 0x98,
+		0x4c, 0xe3, 0x7b, 0x45, 0x33, 0x06, 0x0c, 0x82, 0xa5, 0x75, 0x20, 0xc3, 0xd6, 0xc0, 0x64, 0x7a,
+		0xaf, 0x71, 0x7a, 0xb3, 0x43, 0xc6, 0xc0, 0xd2, 0x0b, 0x70, 0x78, 0xb4, 0x25, 0x30, 0x99, 0xea,
+		0xf7, 0xbd, 0x35, 0xb0, 0x77, 0xf3, 0x1b, 0x02, 0x4b, 0x1b, 0x30, 0x3f, 0xca, 0x0a, 0x98, 0x4c,
+		0xf6, 0xfb, 0xdf, 0x0a, 0x2a, 0x6e, 0xbf, 0x11, 0xb0, 0x54, 0x04, 0xf0, 0x16, 0xe0, 0xc9, 0xb4,
+		0x7e, 0x80, 0xd3, 0xf2, 0x21, 0xe1, 0xd4, 0xe0, 0xeb, 0xef, 0x64, 0xfc, 0xdb, 0x62, 0x6a, 0x70,
+		0x0c, 0x9c, 0x1a, 0x62, 0xe9, 0x9d, 0x8c, 0xfd, 0x83, 0x62, 0x6a, 0x08, 0x14, 0x94, 0x6c, 0xdf,
+		0xea, 0x36, 0x99, 0xc2, 0x27, 0x85, 0x64, 0xfb, 0xb0, 0x96, 0xd6, 0x60, 0x76, 0x68, 0x41, 0x9c,
+		0x4c, 0xea, 0x87, 0x39, 0x29, 0x79, 0x70, 0x3d, 0xf4, 0x2f, 0x5e, 0x7c, 0x31, 0x9c, 0x4c, 0xed,
+		0x47, 0x06, 0x16, 0x2f, 0xbe, 0x16, 0x2e, 0x3d, 0x0d, 0x09, 0xb3, 0xdf, 0x6e, 0xe3, 0xe4, 0x21,
+		0xfb, 0xdf, 0x1f, 0xcc, 0xff, 0xe1, 0x57, 0x38, 0x77, 0x04, 0xc2, 0xd2, 0x59, 0x88, 0x1a, 0x9d,
+		0x2d, 0xa3, 0x39, 0x09, 0xf3, 0x4b, 0x5f, 0x11, 0x0a, 0x13, 0xa1, 0x97, 0x9e, 0x05, 0x60, 0xae,
+		0x11, 0x1a, 0x83, 0x3b, 0x01, 0xf7, 0x8f, 0xbe, 0xc2, 0x2f, 0xec, 0x78, 0x28, 0x1e, 0x01, 0x76,
+		0xfd, 0x67, 0x7f, 0x02, 0x5f, 0x0e, 0x12, 0xa0, 0x23, 0x72, 0x1e, 0xe2, 0x78, 0xf4, 0xe6, 0xe8,
+		0x3b, 0x93, 0xb0, 0xff, 0x98, 0x63, 0x0b, 0x78, 0x64, 0x58, 0xc7, 0xea, 0x19, 0x8e, 0xbe, 0x63,
+		0x4f, 0xc2, 0xfd, 0xef, 0x1c, 0xd7, 0x45, 0x40, 0xe4, 0x86, 0x6e, 0x3b, 0xd3, 0xf4, 0xfb, 0x4f,
+		0x04, 0xb2, 0x40, 0xc0, 0x46, 0xe3, 0xef, 0x6b, 0xc6, 0xde, 0x24, 0xdc, 0x3f, 0x15, 0x8d, 0xe6,
+		0xf0, 0x4b, 0xcf, 0x40, 0x12, 0x7f, 0xb2, 0x5b, 0x78, 0x13, 0x90, 0xff, 0x8c, 0x23, 0x7b, 0x18,
+		0x58, 0xb3, 0xed, 0x34, 0x9d, 0xd6, 0x64, 0x66, 0xff, 0x39, 0x1f, 0x69, 0x01, 0xbf, 0x54, 0x84,
+		0x94, 0xed, 0x34, 0x9b, 0x7d, 0x6e, 0x9f, 0x4e, 0x40, 0xff, 0x8b, 0xaf, 0xb8, 0x2e, 0x0b, 0x17,
+		0x07, 0x47, 0xfb, 0xc6, 0x35, 0xa7, 0x6b, 0xd1, 0xa8, 0x8d, 0x49, 0x14, 0xde, 0xe2, 0x14, 0x7c,
+		0x28, 0x4b, 0x65, 0x48, 0x63, 0x5f, 0xc4, 0xe1, 0xf7, 0x24, 0x12, 0x7f, 0xc9, 0x19, 0x10, 0x40,
+		0x2a, 0x7d, 0xe3, 0xaf, 0x7f, 0xfe, 0xb8, 0xf4, 0xb9, 0xcf, 0x1f, 0x97, 0xfe, 0xdb, 0xe7, 0x8f,
+		0x4b, 0x1f, 0xfb, 0xc2, 0xf1, 0x99, 0xcf, 0x7d, 0xe1, 0xf8, 0xcc, 0xef, 0x7e, 0xe1, 0xf8, 0xcc,
+		0x68, 0x2f, 0x31, 0x5c, 0xb4, 0x2e, 0x5a, 0xcc, 0x3f, 0xfc, 0xd2, 0x7d, 0x3b, 0x2d, 0x67, 0xb7,
+		0xbf, 0xb5, 0xd8, 0xb0, 0x3a, 0xa7, 0x1b, 0x96, 0xdd, 0xb1, 0xec, 0xd3, 0x41, 0xbf, 0x2e, 0xfd,
+		0x05, 0xff, 0x5b, 0x82, 0xa3, 0x8c, 0x8c, 0xe7, 0xce, 0xd5, 0xcd, 0xbd, 0x71, 0xef, 0xba, 0x9c,
+		0x83, 0x70, 0xd1, 0xdc, 0x23, 0x47, 0x99, 0x82, 0xd3, 0xfa, 0xbd, 0x36, 0xbf, 0x0a, 0x16, 0xc7,
+		0xf4, 0x66, 0xaf, 0x1d, 0x0c, 0x03, 0x4e, 0xf3, 0x30, 0xe0, 0xd2, 0x77, 0x48, 0x07, 0xeb, 0x49,
+		0xa2, 0x68, 0xee, 0xd1, 0x8e, 0xac, 0x4b, 0x2f, 0x3d, 0x3c, 0xd1, 0xcf, 0x7d, 0xcd, 0xb4, 0x6e,
+		0x98, 0xd8, 0xec, 0xee, 0x96, 0xf0, 0x71, 0x1f, 0x1f, 0xf4, 0x71, 0xbf, 0x60, 0xb4, 0xdb, 0xcf,
+		0x21, 0x1c, 0x1e, 0x47, 0xdb, 0x5b, 0x31, 0x76, 0xeb, 0x18, 0xbe, 0x2b, 0x04, 0xc7, 0x87, 0xdc,
+		0xd9, 0x5c, 0x08, 0xc6, 0x31, 0x61, 0x09, 0x12, 0x15, 0x21, 0x5b, 0x79, 0x7c, 0x55, 0xa5, 0x61,
+		0x99, 0x4d, 0x16, 0xcf, 0x19, 0x56, 0x45, 0x12, 0x19, 0x61, 0xea, 0xa6, 0x65, 0xf3, 0xcb, 0x94,
+		0x2c, 0x51, 0xfa, 0xf8, 0x01, 0x19, 0x91, 0x11, 0x35, 0x09, 0x6e, 0x3c, 0x36, 0x25, 0x37, 0x44,
+		0x27, 0x02, 0x9e, 0xff, 0x69, 0xb9, 0xf2, 0xbd, 0x21, 0x38, 0x31, 0xc8, 0x15, 0x9c, 0x59, 0xb6,
+		0xa3, 0x77, 0xba, 0xe3, 0xd8, 0xf2, 0x34, 0x24, 0x37, 0x04, 0xcc, 0x81, 0xf9, 0x72, 0xfb, 0x80,
+		0x7c, 0xc9, 0xba, 0x55, 0x09, 0xc6, 0x9c, 0x99, 0x92, 0x31, 0x6e, 0x3f, 0xee, 0x88, 0x33, 0xff,
+		0x2b, 0x06, 0x47, 0xd9, 0x74, 0xd2, 0xd8, 0x54, 0x62, 0x09, 0xce, 0x93, 0xb4, 0xbf, 0x68, 0xf2,
+		0x39, 0x89, 0xf2, 0x1c, 0xcc, 0x2d, 0xa3, 0xb6, 0xc0, 0x5d, 0x90, 0x77, 0xc2, 0x33, 0xf2, 0xbe,
+		0xe9, 0x42, 0xc0, 0xe0, 0xe7, 0x27, 0x81, 0xfe, 0x2c, 0xe5, 0x9b, 0x25, 0x90, 0xeb, 0x0d, 0xbd,
+		0xad, 0xf7, 0xde, 0x2e, 0x29, 0xf2, 0x24, 0x00, 0x8b, 0x16, 0x72, 0x5f, 0x8a, 0xc1, 0xd3, 0x70,
+		0x7f, 0xe7, 0x16, 0x59, 0x4d, 0xf4, 0x2e, 0x46, 0x92, 0xc2, 0xe2, 0xcf, 0x53, 0x2f, 0x02, 0x78,
+		0x05, 0x18, 0x5d, 0x51, 0x2f, 0x17, 0x57, 0x8a, 0xaa, 0x08, 0xfb, 0xa8, 0xaf, 0x57, 0xcb, 0xec,
+		0xe1, 0x86, 0x19, 0x0c, 0x5d, 0xf0, 0x17, 0xba, 0xf1, 0xeb, 0x87, 0x60, 0xd6, 0x9f, 0xcf, 0x6e,
+		0xd1, 0x87, 0xd0, 0x52, 0x6c, 0x75, 0xba, 0x6d, 0x83, 0x9e, 0xd1, 0x6a, 0x2d, 0xc1, 0xb5, 0xc9,
+		0x46, 0xc8, 0x6f, 0xfc, 0x47, 0x76, 0xb3, 0x7a, 0xce, 0x43, 0x77, 0x79, 0xbe, 0xb4, 0x02, 0xb3,
+		0x78, 0x9f, 0xab, 0x1b, 0x20, 0x39, 0x41, 0x55, 0x23, 0x41, 0x7a, 0xea, 0xcc, 0x31, 0x3d, 0x6a,
+		0x4f, 0x42, 0xcc, 0xa6, 0xbd, 0x9f, 0x44, 0xe2, 0x37, 0x39, 0x09, 0x0e, 0xbe, 0x64, 0xc2, 0x2c,
+		0x7b, 0x48, 0xc4, 0xf0, 0x35, 0x63, 0x7f, 0x3f, 0xc3, 0xbf, 0xf8, 0xd9, 0x47, 0xe9, 0x19, 0xf4,
+		0x3d, 0xc1, 0x61, 0x19, 0x21, 0x4e, 0xaa, 0xcc, 0x69, 0x7b, 0x0d, 0x35, 0x20, 0x2b, 0xea, 0xe3,
+		0x0d, 0xde, 0xbf, 0xb2, 0x5f, 0xe2, 0x95, 0x1d, 0x1f, 0x25, 0x03, 0xbe, 0x9a, 0x32, 0x9c, 0x2a,
+		0x2b, 0x28, 0x55, 0xc7, 0xcd, 0xe9, 0x97, 0x1e, 0x1a, 0x5e, 0x9d, 0xd8, 0xbf, 0x47, 0x28, 0xe5,
+		0xa7, 0xfd, 0xd5, 0xb8, 0x73, 0xef, 0xe3, 0x11, 0x98, 0xc5, 0xeb, 0xc9, 0xd6, 0x69, 0xfa, 0x97,
+		0xcf, 0xb9, 0x28, 0x4d, 0x4c, 0x71, 0x28, 0x79, 0x8e, 0x4d, 0x85, 0xc9, 0x12, 0xf3, 0x67, 0xdf,
+		0xfe, 0x63, 0x51, 0x6f, 0xba, 0x2c, 0xad, 0x0e, 0x47, 0x8a, 0x4d, 0xa6, 0xf1, 0xe7, 0x82, 0xc6,
+		0x60, 0xe0, 0xd7, 0xd2, 0x7b, 0xf1, 0xa4, 0x8f, 0x93, 0x99, 0x64, 0x99, 0x08, 0x22, 0x2e, 0x06,
+		0xda, 0x25, 0x6c, 0x66, 0x4e, 0x63, 0x85, 0xbe, 0x25, 0xf0, 0xd9, 0x0c, 0xc5, 0x38, 0xcb, 0xa5,
+		0x8b, 0x90, 0x6d, 0x5a, 0xa6, 0xa3, 0x59, 0x9d, 0x96, 0x63, 0x74, 0xba, 0xce, 0x44, 0xbb, 0xee,
+		0x2f, 0x19, 0x91, 0x84, 0x9a, 0x41, 0xbc, 0x9a, 0x40, 0xc3, 0x96, 0xb0, 0x77, 0x44, 0xa6, 0x69,
+		0xc9, 0xff, 0x70, 0x5b, 0x42, 0x71, 0xb0, 0x25, 0x77, 0x24, 0x1d, 0x76, 0xf3, 0x1a, 0x57, 0xe6,
+		0xce, 0x4d, 0x26, 0x05, 0xae, 0x74, 0xfc, 0x5a, 0x04, 0x8e, 0x73, 0xe0, 0x2d, 0xdd, 0x36, 0x4e,
+		0x5f, 0x7f, 0x6c, 0xcb, 0x70, 0xf4, 0xc7, 0x4e, 0x37, 0xac, 0x96, 0x58, 0xc9, 0xe7, 0x58, 0xf9,
+		0x22, 0x96, 0x2f, 0xf2, 0xf2, 0xc2, 0xc8, 0xe3, 0xee, 0xc2, 0x78, 0x25, 0x5f, 0x18, 0x96, 0x41,
+		0xa5, 0x0d, 0x91, 0xb2, 0xd5, 0xa2, 0x77, 0xa0, 0x9a, 0x86, 0x69, 0x75, 0xb8, 0xba, 0x65, 0x09,
+		0x72, 0x09, 0x62, 0x7a, 0xc7, 0xea, 0x9b, 0x0e, 0x53, 0xb5, 0xa5, 0x47, 0x7f, 0xfd, 0xf5, 0x13,
+		0x33, 0xff, 0xe5, 0xf5, 0x13, 0x87, 0x18, 0x59, 0xbb, 0x79, 0x6d, 0xb1, 0x65, 0x9d, 0xee, 0xe8,
+		0xce, 0x2e, 0x4e, 0xdf, 0xdf, 0xf9, 0xec, 0x23, 0xc0, 0xeb, 0x5b, 0x36, 0x9d, 0x4f, 0xbf, 0xf1,
+		0xd3, 0xa7, 0x24, 0x95, 0xe3, 0x2f, 0x45, 0xde, 0xfc, 0xc4, 0x09, 0x49, 0xe9, 0x42, 0xbc, 0x62,
+		0x34, 0xf6, 0xa9, 0x70, 0x79, 0xa0, 0xc2, 0xc7, 0x78, 0x85, 0xc7, 0x86, 0x2b, 0x64, 0x77, 0x2b,
+		0x2a, 0x46, 0xc3, 0x57, 0x6d, 0xc5, 0x68, 0x0c, 0xd4, 0xb8, 0x0c, 0x89, 0x65, 0xd3, 0x61, 0x8f,
+		0x21, 0x3c, 0x03, 0xe1, 0x96, 0xc9, 0xee, 0xd0, 0x26, 0x4b, 0x0f, 0x1d, 0xa0, 0x2b, 0x2a, 0xe2,
+		0x29, 0x35, 0x48, 0x54, 0x8c, 0x06, 0x23, 0x55, 0x86, 0x70, 0xd3, 0x68, 0xe4, 0xa5, 0x3b, 0x6d,
+		0x24, 0x62, 0x97, 0x2a, 0xbf, 0xfb, 0xfb, 0xc7, 0x67, 0x3e, 0xf0, 0xf9, 0xe3, 0x33, 0x63, 0xc5,
+		0x49, 0x99, 0x2c, 0x4e, 0xae, 0x14, 0xfd, 0x58, 0x04, 0xee, 0xa6, 0xaf, 0xe9, 0xf4, 0x3a, 0x2d,
+		0xd3, 0x39, 0xdd, 0xe8, 0xed, 0x75, 0x1d, 0x0b, 0x95, 0x8a, 0xb5, 0xcd, 0x85, 0x68, 0xd6, 0x2b,
+		0x5e, 0x64, 0xc5, 0xa3, 0x45, 0x48, 0xd9, 0x86, 0xe8, 0x3a, 0xe2, 0xe1, 0x20, 0x39, 0x96, 0xa3,
+		0xb7, 0xb9, 0x25, 0xc4, 0x12, 0x98, 0xcb, 0x5e, 0xe0, 0x09, 0xb1, 0xdc, 0x96, 0x78, 0x7c, 0xa7,
+		0x6d, 0xe8, 0xdb, 0xec, 0x21, 0x83, 0x30, 0x35, 0xa1, 0x13, 0x98, 0x41, 0xdf, 0x2c, 0x98, 0x87,
+		0xa8, 0xde, 0x67, 0x61, 0x4f, 0x61, 0xb4, 0xad, 0x69, 0x42, 0x79, 0x0e, 0xe2, 0xfc, 0x4c, 0x1f,
+		0xe3, 0x7e, 0xae, 0x19, 0x7b, 0xb4, 0x9e, 0xb4, 0x8a, 0x3f, 0xc9, 0x22, 0x44, 0x69, 0xe3, 0xf9,
+		0xfd, 0x91, 0xfc, 0xe2, 0x50, 0xeb, 0x17, 0x69, 0x23, 0x55, 0x06, 0xa6, 0x5c, 0x86, 0x44, 0xc5,
+		0x42, 0xc9, 0x0e, 0x52, 0x4b, 0x32, 0x6a, 0xb4, 0xcd, 0xdd, 0xbe, 0x23, 0xee, 0xf8, 0xd1, 0x04,
+		0xde, 0x98, 0x65, 0x0f, 0x5b, 0xf0, 0xd0, 0x2d, 0x9e, 0x52, 0xca, 0x10, 0xa7, 0xb4, 0x6b, 0x5d,
+		0xf7, 0xb5, 0x28, 0xc9, 0xf7, 0x5a, 0x14, 0x27, 0x1f, 0xf2, 0x1a, 0x4b, 0x20, 0x82, 0xb7, 0xde,
+		0x79, 0xbf, 0xe9, 0x6f, 0xe5, 0x7d, 0x90, 0xe0, 0x44, 0x6c, 0x72, 0x06, 0xc2, 0x56, 0x57, 0x44,
+		0x26, 0x16, 0xc6, 0x75, 0xa5, 0xd6, 0x2d, 0x45, 0x50, 0x96, 0x54, 0x04, 0x2e, 0xad, 0x8d, 0x15,
+		0x8b, 0x27, 0x02, 0x62, 0xd1, 0x31, 0x9c, 0xad, 0x6d, 0xc7, 0xfb, 0xc1, 0x86, 0x73, 0x48, 0x14,
+		0x5c, 0x41, 0xb9, 0x1d, 0x82, 0xe3, 0xbe, 0xd2, 0xeb, 0x46, 0x0f, 0x9d, 0x5a, 0x4c, 0x9a, 0xb8,
+		0xa4, 0x10, 0x5f, 0x03, 0x79, 0xf9, 0x18, 0x51, 0x79, 0x06, 0xc2, 0xc5, 0x6e, 0x17, 0xdf, 0x76,
+		0xa2, 0xe9, 0x86, 0xc5, 0x64, 0x25, 0xa2, 0xba, 0x69, 0x2c, 0xb3, 0xad, 0x6d, 0xe7, 0x86, 0xde,
+		0x73, 0xdf, 0x7d, 0x12, 0x69, 0xe5, 0x3c, 0x24, 0xcb, 0x96, 0x69, 0x1b, 0xa6, 0xdd, 0xa7, 0xf6,
+		0xf5, 0x56, 0xdb, 0x6a, 0x5c, 0xe3, 0x14, 0x58, 0x02, 0x99, 0xad, 0x77, 0xbb, 0x14, 0x33, 0xa2,
+		0xe2, 0x4f, 0x36, 0xb3, 0x4b, 0xb5, 0xb1, 0xec, 0x39, 0x7b, 0x30, 0xf6, 0xf0, 0x0e, 0x7a, 0x86,
+		0xb2, 0x04, 0x77, 0x0d, 0x4f, 0xa4, 0x6b, 0xc6, 0x9e, 0x7d, 0xd0, 0x79, 0xf4, 0x22, 0x24, 0xd7,
+		0xe9, 0xeb, 0x9b, 0xcf, 0x19, 0x7b, 0xa4, 0x80, 0x41, 0x9a, 0x67, 0xce, 0x9e, 0x7d, 0xec, 0x3c,
+		0x93, 0xf2, 0x4b, 0x33, 0xaa, 0xc8, 0x20, 0xc7, 0x21, 0x69, 0x1b, 0x8d, 0xee, 0x99, 0xb3, 0xe7,
+		0xae, 0x3d, 0xc6, 0xc4, 0xea, 0xd2, 0x8c, 0xea, 0x65, 0x2d, 0x25, 0xb0, 0xc7, 0x6f, 0x7e, 0xf2,
+		0x84, 0x54, 0x8a, 0x42, 0xd8, 0xee, 0x77, 0xbe, 0x66, 0xb2, 0xf1, 0xa7, 0x31, 0x58, 0xf0, 0x95,
+		0xb2, 0x05, 0x8b, 0x07, 0xba, 0xbb, 0x6f, 0xa6, 0xca, 0xbe, 0xfe, 0x53, 0x88, 0x31, 0x2b, 0xd1,
+		0xbe, 0x5c, 0x54, 0x3e, 0x23, 0x41, 0xfa, 0x8a, 0xa0, 0x8c, 0x97, 0x1e, 0x9e, 0x06, 0x70, 0x6b,
+		0x12, 0x53, 0xe5, 0xd8, 0xe2, 0x60, 0x5d, 0x8b, 0x2e, 0x8e, 0xea, 0x03, 0xc7, 0xdb, 0x3c, 0xdd,
+		0x9e, 0xd5, 0xb5, 0x6c, 0xfe, 0x06, 0xd0, 0x04, 0x54, 0x17, 0x18, 0xaf, 0x98, 0x50, 0xad, 0xa6,
+		0x5d, 0xb7, 0x1c, 0x0c, 0x57, 0xe9, 0x5a, 0x37, 0xf8, 0xcb, 0x6a, 0x61, 0x55, 0xa6, 0x25, 0x57,
+		0x68, 0xc1, 0x3a, 0xe6, 0x63, 0xa3, 0x93, 0x2e, 0x15, 0xdc, 0x2a, 0xea, 0xcd, 0x66, 0xcf, 0xb0,
+		0x6d, 0xae, 0xb8, 0x44, 0x12, 0x1f, 0x1e, 0xea, 0xf6, 0xb7, 0x34, 0xa1, 0x25, 0xf0, 0xe9, 0xa6,
+		0x11, 0x73, 0x5e, 0xc8, 0x06, 0x9f, 0xf5, 0xb1, 0x6e, 0x7f, 0x0b, 0x25, 0xe5, 0x1e, 0x48, 0x8f,
+		0x68, 0x4c, 0xea, 0xba, 0xd7, 0x0e, 0xfa, 0xe0, 0x2b, 0xef, 0x81, 0xd6, 0xed, 0xb5, 0xac, 0x5e,
+		0xcb, 0xd9, 0xa3, 0x41, 0x93, 0x61, 0x55, 0x16, 0x05, 0xeb, 0x3c, 0x5f, 0xb9, 0x06, 0xb9, 0x3a,
+		0xdd, 0x42, 0x78, 0x2d, 0x3f, 0xeb, 0xb5, 0x4f, 0x9a, 0xdc, 0xbe, 0xb1, 0x2d, 0x0b, 0x0d, 0xb5,
+		0xec, 0xd4, 0x7f, 0x92, 0x20, 0x55, 0xc2, 0x89, 0xbb, 0x5c, 0xb9, 0xd0, 0xd6, 0x77, 0xc8, 0x63,
+		0x70, 0xa8, 0xb4, 0x52, 0x2b, 0x3f, 0xa7, 0x2d, 0x57, 0xb4, 0x0b, 0x2b, 0x45, 0x5f, 0x60, 0x79,
+		0xe1, 0xf0, 0xad, 0xdb, 0x0b, 0xc4, 0x07, 0xbb, 0x69, 0xd2, 0x7d, 0x2e, 0x39, 0x0d, 0xf3, 0x41,
+		0x94, 0x62, 0xa9, 0x8e, 0xd7, 0x99, 0xa4, 0xc2, 0xa1, 0x5b, 0xb7, 0x17, 0x66, 0x7d, 0x18, 0xc5,
+		0x2d, 0xdb, 0x30, 0x9d, 0x61, 0x84, 0x72, 0x6d, 0x75, 0x15, 0xa3, 0xfb, 0x87, 0x10, 0x30, 0x54,
+		0xb6, 0xe5, 0x90, 0x07, 0x61, 0x36, 0x88, 0xb0, 0xb6, 0xbc, 0x22, 0x87, 0x0b, 0xe4, 0xd6, 0xed,
+		0x85, 0xac, 0x0f, 0x7a, 0xad, 0xd5, 0x2e, 0x24, 0x3e, 0xfc, 0x23, 0xc7, 0x67, 0x3e, 0xfd, 0x0f,
+		0x8f, 0x4b, 0xa5, 0xd5, 0xb1, 0x73, 0xee, 0xf1, 0x83, 0xcd, 0xb9, 0xe0, 0xba, 0xfd, 0x95, 0xbb,
+		0xe0, 0xae, 0xc1, 0xc2, 0x80, 0x32, 0x9e, 0x76, 0xba, 0x4d, 0xf2, 0x7b, 0x14, 0xf6, 0x37, 0x0f,
+		0x0a, 0x13, 0x16, 0x85, 0xc2, 0x44, 0xc5, 0xa0, 0x9c, 0x87, 0x0c, 0x5e, 0x78, 0xaa, 0x1b, 0xce,
+		0x25, 0x43, 0x6f, 0x1a, 0xbd, 0xa0, 0xfd, 0x90, 0x11, 0xf6, 0x03, 0x81, 0x08, 0x35, 0x12, 0xd8,
+		0xfa, 0x49, 0x7f, 0x2b, 0xbb, 0x10, 0x41, 0x54, 0xcf, 0xb6, 0xe0, 0x18, 0x34, 0x81, 0xb9, 0x5b,
+		0x7b, 0x0e, 0xbf, 0x4b, 0x9a, 0x56, 0x59, 0x82, 0x3c, 0x21, 0x2c, 0x84, 0xf0, 0xfe, 0x16, 0x02,
+		0x9f, 0x5e, 0xdc, 0x4e, 0x68, 0x43, 0x9c, 0x0f, 0xb1, 0xdb, 0x10, 0xc9, 0x6b, 0x08, 0x59, 0x85,
+		0x5c, 0x57, 0xef, 0x39, 0xf4, 0xe5, 0x95, 0x5d, 0xda, 0x0b, 0x3e, 0x83, 0x4f, 0x0c, 0xeb, 0x93,
+		0x40, 0x67, 0x79, 0x2d, 0x99, 0xae, 0x3f, 0x53, 0xf9, 0x62, 0x04, 0x62, 0x9c, 0x19, 0xcf, 0x40,
+		0x9c, 0xb3, 0x95, 0xcf, 0xb9, 0xbb, 0x17, 0x87, 0x97, 0xd9, 0x45, 0x77, 0x39, 0xe4, 0xf4, 0x04,
+		0x0e, 0xb9, 0x1f, 0x12, 0x8d, 0x5d, 0xbd, 0x65, 0x6a, 0xad, 0x26, 0x37, 0x8e, 0x53, 0x9f, 0x7f,
+		0xfd, 0x44, 0xbc, 0x8c, 0x79, 0xcb, 0x15, 0x35, 0x4e, 0x0b, 0x97, 0x9b, 0x68, 0xd3, 0xec, 0x1a,
+		0xad, 0x9d, 0x5d, 0x87, 0xeb, 0x0d, 0x9e, 0xc2, 0x37, 0xac, 0x51, 0x20, 0x78, 0x80, 0x7f, 0x61,
+		0x68, 0xd7, 0xe3, 0xba, 0xa5, 0x4a, 0x09, 0xac, 0xf8, 0x63, 0xff, 0xf5, 0x84, 0xa4, 0x52, 0x0c,
+		0x52, 0x86, 0x4c, 0x5b, 0xb7, 0x1d, 0x8d, 0xae, 0xc7, 0x58, 0x3d, 0x8b, 0xec, 0x3f, 0x3a, 0xcc,
+		0x10, 0xce, 0x58, 0xde, 0xf4, 0x14, 0x62, 0xb1, 0xac, 0x26, 0xbe, 0x45, 0x44, 0x89, 0x34, 0xe8,
+		0x0c, 0x64, 0x56, 0x62, 0x8c, 0xf2, 0x3d, 0x8b, 0xf9, 0x6c, 0x62, 0x52, 0x5b, 0xf1, 0x18, 0x24,
+		0xe9, 0xf3, 0x42, 0x14, 0x84, 0x5d, 0xd0, 0x4b, 0x60, 0x06, 0x2d, 0x7c, 0x00, 0x72, 0x9e, 0xd6,
+		0x67, 0x20, 0x09, 0x46, 0xc5, 0xcb, 0xa6, 0x80, 0x8f, 0xc2, 0xbc, 0x69, 0xdc, 0x74, 0xb4, 0x41,
+		0xe8, 0x24, 0x85, 0x26, 0x58, 0x76, 0x25, 0x88, 0x71, 0x1f, 0x64, 0x1b, 0x82, 0xf9, 0x0c, 0x16,
+		0x28, 0x6c, 0xc6, 0xcd, 0xa5, 0x60, 0x47, 0x21, 0xa1, 0x77, 0xbb, 0x0c, 0x20, 0xc5, 0xb5, 0x7e,
+		0xb7, 0x4b, 0x8b, 0x4e, 0xc1, 0x2c, 0xed, 0x63, 0xcf, 0xb0, 0xf1, 0xea, 0x08, 0x83, 0x49, 0x53,
+		0x98, 0x1c, 0x16, 0xa8, 0x2c, 0x9f, 0xc2, 0xde, 0x0b, 0x19, 0xe3, 0x3a, 0xde, 0x8e, 0x6c, 0x18,
+		0x0c, 0x2e, 0x43, 0xe1, 0xd2, 0x22, 0x93, 0x02, 0x3d, 0x08, 0xae, 0x36, 0xd7, 0xc4, 0x4a, 0x93,
+		0x65, 0xf4, 0x44, 0x7e, 0x91, 0x65, 0x2b, 0x79, 0x88, 0x54, 0x74, 0x47, 0x47, 0x73, 0xc9, 0xb9,
+		0xc9, 0x96, 0xcf, 0xb4, 0x8a, 0x3f, 0x95, 0x9f, 0x0f, 0x43, 0xe4, 0x8a, 0xe5, 0x18, 0xe4, 0x71,
+		0x9f, 0x29, 0x9b, 0x1d, 0x25, 0xcf, 0xf5, 0xd6, 0x8e, 0x69, 0x34, 0x57, 0xed, 0x1d, 0xdf, 0x93,
+		0x9e, 0x9e, 0x38, 0x85, 0x02, 0xe2, 0x34, 0x0f, 0xd1, 0x9e, 0xd5, 0x37, 0x9b, 0x22, 0x7c, 0x9f,
+		0x26, 0x48, 0x15, 0x12, 0xae, 0x94, 0x44, 0x26, 0x49, 0x49, 0x0e, 0xa5, 0x04, 0x65, 0x98, 0x67,
+		0xa8, 0xf1, 0x2d, 0x2e, 0x2c, 0x25, 0x48, 0xba, 0xca, 0x2b, 0x1f, 0x3d, 0x80, 0xc0, 0x7a, 0x68,
+		0xb8, 0x44, 0xba, 0x63, 0xef, 0x32, 0x8f, 0x49, 0x9c, 0xec, 0x16, 0x70, 0xee, 0x05, 0xc4, 0x8a,
+		0x3f, 0x2f, 0x1a, 0xa7, 0xfd, 0xf2, 0xc4, 0x8a, 0x3d, 0x31, 0x7a, 0x17, 0x46, 0xf9, 0xed, 0x98,
+		0xf4, 0x6a, 0x0a, 0x97, 0x3c, 0x2f, 0x03, 0x4b, 0xbd, 0x6b, 0x9e, 0x4c, 0xd2, 0xbc, 0x0c, 0x7c,
+		0xac, 0xc3, 0x4d, 0x68, 0x1e, 0x15, 0x26, 0x65, 0xc4, 0x2d, 0xaa, 0x8b, 0x12, 0xe5, 0x57, 0x24,
+		0x88, 0xf1, 0x15, 0xcb, 0x1b, 0x06, 0x69, 0xf4, 0x30, 0x84, 0xc6, 0x0d, 0x43, 0xf8, 0xce, 0x87,
+		0xa1, 0x08, 0xe0, 0x36, 0xd3, 0xe6, 0x8f, 0x48, 0x8e, 0x30, 0xab, 0x58, 0x13, 0xeb, 0xad, 0x1d,
+		0x3e, 0xef, 0x7d, 0x48, 0xca, 0xef, 0x49, 0x90, 0x74, 0xcb, 0x49, 0x11, 0x32, 0xa2, 0x5d, 0xda,
+		0x76, 0x5b, 0xdf, 0xe1, 0xa2, 0x78, 0xf7, 0xd8, 0xc6, 0xe1, 0x2a, 0xac, 0xa6, 0x78, 0x7b, 0x30,
+		0x31, 0x7a, 0x58, 0x43, 0x63, 0x86, 0x35, 0x20, 0x47, 0xe1, 0x3b, 0x93, 0xa3, 0xc0, 0x88, 0x47,
+		0x06, 0x46, 0x5c, 0xf9, 0x7d, 0x89, 0x3f, 0x5f, 0xdd, 0x34, 0x9a, 0xff, 0x37, 0x87, 0xea, 0x25,
+		0x2e, 0x5b, 0x4d, 0xa3, 0xa9, 0x0d, 0x8d, 0xd9, 0xbd, 0xc3, 0x14, 0x83, 0x6d, 0xf6, 0xc6, 0x8e,
+		0x08, 0x2a, 0x75, 0x6f, 0x0c, 0x3f, 0x1b, 0x82, 0xd9, 0x21, 0xf8, 0xbf, 0x79, 0x63, 0x19, 0x9c,
+		0xbd, 0xd1, 0x29, 0x67, 0x6f, 0x6c, 0xec, 0xec, 0xfd, 0xd9, 0x10, 0x75, 0x00, 0x74, 0x2d, 0x5b,
+		0x6f, 0xff, 0x75, 0xe8, 0xde, 0x63, 0x90, 0xec, 0x5a, 0x6d, 0x8d, 0x95, 0xb0, 0x0b, 0x54, 0x89,
+		0xae, 0xd5, 0x56, 0x87, 0xc4, 0x2c, 0xfa, 0x0e, 0x29, 0xe6, 0xd8, 0x3b, 0x30, 0x08, 0xf1, 0xc1,
+		0x09, 0xd5, 0x83, 0x34, 0x63, 0x05, 0xb7, 0x9a, 0x1e, 0x45, 0x1e, 0xe0, 0xaf, 0xbc, 0x34, 0x6c,
+		0xe5, 0xb1, 0x66, 0x33, 0x48, 0x35, 0xb6, 0xeb, 0x62, 0x30, 0x23, 0x23, 0x1f, 0x1a, 0x87, 0xc1,
+		0xa4, 0x58, 0xe5, 0x70, 0xca, 0xf7, 0x48, 0x00, 0x2b, 0xc8, 0x59, 0xda, 0x5f, 0xb4, 0x77, 0x6c,
+		0xda, 0x04, 0x2d, 0x50, 0xf3, 0xf1, 0x71, 0x83, 0xc6, 0xeb, 0x4f, 0xdb, 0xfe, 0x76, 0x97, 0x21,
+		0xe3, 0xc9, 0xb6, 0x6d, 0x88, 0xc6, 0x1c, 0xdf, 0x67, 0x57, 0x8a, 0x37, 0x22, 0xd3, 0xd7, 0x7d,
+		0x29, 0xe5, 0x5f, 0x4a, 0x90, 0xa4, 0x6d, 0xc2, 0x67, 0x15, 0x03, 0x63, 0x28, 0xdd, 0xf9, 0x18,
+		0xde, 0x0d, 0xc0, 0xc8, 0x60, 0x74, 0x0d, 0x97, 0xac, 0x24, 0xcd, 0xc1, 0x98, 0x19, 0x72, 0xce,
+		0x65, 0x78, 0x78, 0x7f, 0x86, 0x8b, 0x5d, 0x2b, 0x67, 0xfb, 0x11, 0x88, 0xd3, 0xe7, 0x01, 0x6e,
+		0xda, 0x7c, 0x23, 0x8a, 0x8f, 0xf0, 0x6e, 0xdc, 0xb4, 0x95, 0x97, 0x21, 0xbe, 0x71, 0x93, 0xf9,
+		0x13, 0x8f, 0x41, 0xb2, 0x67, 0x59, 0xdc, 0xfa, 0x63, 0x56, 0x77, 0x02, 0x33, 0xa8, 0xb1, 0x23,
+		0x7c, 0x68, 0x21, 0xcf, 0x87, 0xe6, 0x39, 0x01, 0xc3, 0x53, 0x39, 0x01, 0x71, 0xf7, 0x99, 0x09,
+		0xcc, 0x24, 0xf2, 0x30, 0x1c, 0xa9, 0x2f, 0x5f, 0x5c, 0xab, 0x56, 0xb4, 0xd5, 0xfa, 0xc5, 0x81,
+		0x4b, 0xdc, 0x85, 0xdc, 0xad, 0xdb, 0x0b, 0x29, 0xbe, 0xed, 0x1c, 0x07, 0xbd, 0xae, 0x56, 0xaf,
+		0xd4, 0x36, 0xaa, 0xb2, 0xc4, 0xa0, 0xd7, 0x7b, 0xc6, 0x75, 0xcb, 0x61, 0xdf, 0x36, 0x79, 0x14,
+		0x8e, 0x8e, 0x80, 0x76, 0x37, 0x9f, 0xb3, 0xb7, 0x6e, 0x2f, 0x64, 0xd6, 0x7b, 0x06, 0x93, 0x32,
+		0x8a, 0xb1, 0x08, 0xf9, 0x61, 0x8c, 0xda, 0x7a, 0xad, 0x5e, 0x5c, 0x91, 0x17, 0x0a, 0xf2, 0xad,
+		0xdb, 0x0b, 0x69, 0xa1, 0x32, 0x10, 0xfe, 0x6b, 0xbf, 0xfb, 0xfc, 0xb6, 0x04, 0xdc, 0x3d, 0x58,
+		0x78, 0xba, 0xab, 0xf7, 0xf4, 0xce, 0x41, 0xb7, 0x9f, 0x13, 0x82, 0x11, 0x94, 0xcf, 0x84, 0x20,
+		0xe7, 0x6e, 0x6e, 0xd6, 0x69, 0x0d, 0xe4, 0x71, 0xbf, 0xc7, 0x2f, 0x35, 0x76, 0xed, 0x60, 0xd0,
+		0xc2, 0x21, 0x88, 0xc7, 0x50, 0xdc, 0x48, 0xe6, 0x93, 0x6a, 0x61, 0xc4, 0xfa, 0xc6, 0x21, 0x38,
+		0xaa, 0x8b, 0x41, 0x9e, 0x85, 0xa4, 0x3b, 0xc5, 0xdc, 0x47, 0xa1, 0xc7, 0xcf, 0x49, 0x8e, 0xef,
+		0xe1, 0xe0, 0x3b, 0xcd, 0x62, 0x1b, 0x17, 0x19, 0xb7, 0x31, 0xbc, 0xc2, 0x00, 0x38, 0xb2, 0x80,
+		0x27, 0x8f, 0x42, 0x44, 0xdf, 0x6a, 0xb4, 0xf2, 0xd1, 0x61, 0x97, 0x0b, 0xc3, 0x2b, 0x96, 0xca,
+		0xcb, 0x1c, 0x89, 0x42, 0x2a, 0xcb, 0xdc, 0x99, 0xc2, 0xf9, 0x45, 0x9f, 0xd9, 0xbc, 0xa9, 0xb1,
+		0xbd, 0x30, 0xb3, 0x2e, 0x12, 0x1d, 0xfd, 0x66, 0x09, 0xd3, 0x38, 0x01, 0xb1, 0x70, 0x87, 0xbf,
+		0x77, 0x15, 0x56, 0x63, 0x1d, 0xfd, 0xe6, 0x45, 0x1d, 0xaf, 0x55, 0x87, 0xe5, 0x88, 0xf2, 0xe3,
+		0x68, 0xa9, 0x04, 0xb8, 0x42, 0x1e, 0x02, 0x82, 0x18, 0x78, 0x3e, 0x88, 0x53, 0x97, 0xb2, 0x57,
+		0xd0, 0xc5, 0x1b, 0xeb, 0xc5, 0x1d, 0x63, 0xad, 0xdf, 0xa1, 0x0d, 0xb0, 0x09, 0x1e, 0x26, 0x72,
+		0x60, 0x31, 0xb2, 0x9c, 0xfd, 0x47, 0x87, 0xbf, 0x12, 0xc2, 0x01, 0xd8, 0x02, 0xf0, 0x1a, 0x2e,
+		0x00, 0x59, 0x46, 0x4f, 0x94, 0x04, 0xbb, 0x12, 0x0e, 0x76, 0x45, 0x79, 0x16, 0x72, 0x03, 0x23,
+		0x40, 0x14, 0xc8, 0x70, 0x8f, 0x15, 0x3d, 0xda, 0x67, 0x3b, 0x9c, 0xa4, 0x9a, 0x62, 0x9e, 0x29,
+		0x1a, 0xea, 0xb0, 0x94, 0xf8, 0x05, 0x74, 0x93, 0xe2, 0xb1, 0xcf, 0x43, 0x90, 0x09, 0x8c, 0x81,
+		0xf0, 0x22, 0x4b, 0x9e, 0x17, 0xd9, 0x03, 0x7e, 0x09, 0xd2, 0xa8, 0x80, 0x8c, 0x26, 0x87, 0xbd,
+		0x1f, 0x72, 0x4c, 0x41, 0x0e, 0xf2, 0x9a, 0x19, 0x3c, 0xab, 0x82, 0xe1, 0x0a, 0x64, 0x3c, 0x38,
+		0x8f, 0xed, 0x29, 0x01, 0x75, 0x51, 0xb7, 0x95, 0x1a, 0x80, 0x37, 0xa8, 0xa4, 0x08, 0x77, 0xa3,
+		0xf2, 0xf0, 0x87, 0xa2, 0xf2, 0x77, 0x84, 0x03, 0x16, 0x63, 0x01, 0x81, 0xbc, 0x70, 0x53, 0xf6,
+		0x9a, 0xf0, 0x25, 0x0a, 0x51, 0x7a, 0xfe, 0xd3, 0x9f, 0x3f, 0x2e, 0x7d, 0x6d, 0x74, 0xc1, 0x7f,
+		0xbe, 0x0a, 0xc7, 0x7c, 0x85, 0x28, 0x80, 0x01, 0x47, 0x54, 0xce, 0x2b, 0x5c, 0xc4, 0xc2, 0x49,
+		0x0e, 0xa5, 0x7d, 0xfd, 0xbf, 0x85, 0xfd, 0xd5, 0xce, 0x64, 0x6f, 0xd3, 0x64, 0x7f, 0xd7, 0x68,
+		0xa7, 0xfc, 0x2f, 0x27, 0x21, 0xae, 0x1a, 0xaf, 0xf4, 0x0d, 0x1b, 0x1f, 0x4e, 0x88, 0x18, 0x8d,
+		0x5d, 0x6b, 0x94, 0x0f, 0x14, 0x3b, 0xb8, 0xc8, 0xe1, 0xaa, 0x8d, 0x5d, 0xeb, 0xd2, 0x8c, 0x4a,
+		0x61, 0xc9, 0x59, 0x88, 0x6e, 0xb7, 0xfb, 0xdc, 0x7d, 0x35, 0xa0, 0xb3, 0xfc, 0x48, 0x17, 0x10,
+		0xe8, 0xd2, 0x8c, 0xca, 0xa0, 0xb1, 0x2a, 0xfa, 0xe1, 0xa7, 0xf0, 0xfe, 0x55, 0xe1, 0xe5, 0x75,
+		0xac, 0x0a, 0x61, 0x49, 0x09, 0xa0, 0x65, 0xb6, 0x1c, 0x8d, 0xba, 0x76, 0xf2, 0xd1, 0x61, 0x65,
+		0x15, 0xc4, 0x6c, 0x39, 0xd4, 0x19, 0x84, 0x47, 0x07, 0x2d, 0x91, 0xc0, 0xe6, 0xbe, 0xd2, 0x37,
+		0x7a, 0x7b, 0xf9, 0xd8, 0xfe, 0xcd, 0x7d, 0x1e, 0x81, 0xb0, 0xb9, 0x14, 0x1a, 0x95, 0x2c, 0x7b,
+		0x58, 0xdc, 0xb9, 0x99, 0x4f, 0x0c, 0xab, 0x39, 0x3f, 0x26, 0x7d, 0x5d, 0x7c, 0xe3, 0x26, 0x9e,
+		0x67, 0x34, 0xd8, 0x4f, 0xf2, 0x94, 0x6b, 0x82, 0xa5, 0x86, 0xad, 0x9e, 0x00, 0x2e, 0x73, 0xfb,
+		0xcc, 0x08, 0x53, 0x8c, 0xac, 0x41, 0xb6, 0xdd, 0xb2, 0x1d, 0xcd, 0x36, 0xf5, 0xae, 0xbd, 0x6b,
+		0x39, 0xe2, 0x65, 0xdf, 0xfb, 0xc6, 0x51, 0x58, 0x69, 0xd9, 0x4e, 0x5d, 0x00, 0x5f, 0x9a, 0x51,
+		0x33, 0x6d, 0x7f, 0x06, 0xd2, 0xb3, 0xb6, 0xb7, 0x8d, 0x9e, 0x4b, 0x30, 0x9f, 0xd9, 0x9f, 0x5e,
+		0x0d, 0xa1, 0x05, 0x3e, 0xd2, 0xb3, 0xfc, 0x19, 0xe4, 0xeb, 0x60, 0xae, 0x6d, 0xe9, 0x4d, 0x97,
+		0x9c, 0xd6, 0xd8, 0xed, 0x9b, 0xd7, 0xa8, 0x53, 0x06, 0x3f, 0xf1, 0x30, 0xae, 0x91, 0x96, 0xde,
+		0x14, 0x24, 0xca, 0x88, 0x70, 0x69, 0x46, 0x9d, 0x6d, 0x0f, 0x66, 0x92, 0xf7, 0xc3, 0xbc, 0xde,
+		0xed, 0xb6, 0xf7, 0x06, 0xa9, 0xe7, 0x28, 0xf5, 0x53, 0xe3, 0xa8, 0x17, 0x11, 0x67, 0x90, 0x3c,
+		0xd1, 0x87, 0x72, 0xc9, 0x06, 0xba, 0x93, 0x0c, 0x7a, 0x9b, 0xab, 0xcb, 0xad, 0x0c, 0xfa, 0x6c,
+		0x2e, 0x7e, 0x51, 0x6d, 0x0c, 0xed, 0x75, 0x06, 0x2f, 0x8c, 0x92, 0x4b, 0x33, 0xe8, 0x79, 0x0a,
+		0x64, 0x31, 0xaa, 0x56, 0xc3, 0xa0, 0x6f, 0x8c, 0x73, 0xaa, 0xb3, 0x93, 0xa8, 0x52, 0xf8, 0x20,
+		0xd5, 0x40, 0x16, 0xa9, 0x42, 0x8a, 0x6d, 0x45, 0xf1, 0x60, 0xc6, 0xa0, 0x6f, 0xb7, 0xa7, 0xce,
+		0x28, 0x63, 0x67, 0x28, 0x05, 0x45, 0x2f, 0xd7, 0xa5, 0x19, 0x15, 0x0c, 0x37, 0x45, 0x74, 0x38,
+		0x44, 0xdf, 0x1d, 0xde, 0xd3, 0x82, 0x8a, 0x37, 0x3f, 0x47, 0x09, 0x3e, 0x34, 0x8e, 0x20, 0xfd,
+		0x9e, 0xcf, 0xde, 0x15, 0xbf, 0x1e, 0xbe, 0x34, 0xa3, 0xce, 0x5d, 0x1f, 0xce, 0x46, 0x11, 0xdb,
+		0x6e, 0x99, 0x7a, 0x1b, 0xdf, 0xd4, 0x67, 0xd6, 0xcc, 0xfc, 0xfe, 0x22, 0x76, 0x81, 0x43, 0xd3,
+		0x85, 0x15, 0x45, 0x6c, 0xdb, 0x9f, 0x51, 0x8a, 0xf3, 0x38, 0x54, 0xf7, 0x3d, 0xce, 0xb8, 0x9c,
+		0x60, 0xaf, 0x46, 0x5f, 0x8e, 0x24, 0x40, 0x4e, 0x29, 0x0f, 0x40, 0xca, 0xa7, 0x98, 0xf0, 0x14,
+		0x8a, 0x47, 0xea, 0x88, 0x88, 0x56, 0x9e, 0x54, 0xb2, 0x90, 0xf6, 0x2b, 0x23, 0xe5, 0x63, 0x12,
+		0xa4, 0x7c, 0x7a, 0x06, 0x31, 0xfd, 0x1e, 0xe9, 0xa4, 0x67, 0xa9, 0xdc, 0x2b, 0x96, 0x36, 0x51,
+		0xce, 0x8e, 0x5f, 0xd3, 0x34, 0x93, 0xaf, 0xac, 0xf8, 0xc4, 0x47, 0xf7, 0x4c, 0xd7, 0x05, 0x09,
+		0x53, 0x10, 0xe8, 0x9e, 0xe9, 0x0a, 0x80, 0x7b, 0x20, 0x8d, 0xfd, 0xd6, 0xfc, 0xf6, 0x52, 0x52,
+		0x4d, 0x61, 0x1e, 0x07, 0x51, 0x7e, 0x2b, 0x04, 0xf2, 0xa0, 0x02, 0x73, 0x5d, 0xd5, 0xd2, 0x81,
+		0x5d, 0xd5, 0x47, 0x07, 0x9d, 0xe4, 0x9e, 0x5f, 0x7c, 0x05, 0x64, 0xcf, 0xbd, 0xcb, 0x96, 0x9a,
+		0xf1, 0xf6, 0xdf, 0x80, 0xa1, 0xaa, 0xe6, 0x1a, 0xc1, 0x0c, 0x72, 0x21, 0x70, 0x58, 0x29, 0xbe,
+		0x8c, 0x38, 0x38, 0xe0, 0xae, 0x11, 0xb3, 0xd9, 0x6d, 0xea, 0x8e, 0x21, 0xfc, 0x63, 0x1e, 0x26,
+		0xda, 0x1a, 0xe8, 0x4d, 0xb6, 0x1d, 0xdd, 0x31, 0xb8, 0xad, 0xc1, 0x3c, 0x0f, 0x19, 0xbd, 0xdb,
+		0xa5, 0x1f, 0x92, 0x62, 0xb6, 0xc6, 0x7d, 0x90, 0x45, 0x9d, 0xde, 0xd2, 0xdb, 0xc2, 0x54, 0x88,
+		0x31, 0x93, 0x84, 0xe7, 0x32, 0xeb, 0x40, 0x69, 0x42, 0xda, 0xaf, 0xcf, 0xdd, 0x3d, 0x95, 0xe4,
+		0xdb, 0x53, 0x11, 0xfe, 0x62, 0x0b, 0xe3, 0x8f, 0x78, 0xe5, 0x66, 0xf4, 0xa1, 0xc1, 0x3c, 0xdd,
+		0x7f, 0x5d, 0x67, 0xce, 0x92, 0x84, 0xca, 0x12, 0x8a, 0x0a, 0xd9, 0xa0, 0xee, 0x27, 0x59, 0x08,
+		0x39, 0x37, 0x79, 0x2d, 0x21, 0xe7, 0x26, 0x5a, 0xba, 0xbe, 0x77, 0xe2, 0x86, 0x57, 0x3b, 0x8e,
+		0xe7, 0xf9, 0x3a, 0x94, 0x1c, 0x64, 0x04, 0x4d, 0xb6, 0x29, 0x3f, 0x0c, 0xf3, 0xa3, 0x54, 0xbc,
+		0xb2, 0x0b, 0xf3, 0xa3, 0x54, 0x35, 0x39, 0x0b, 0x09, 0x57, 0xc7, 0x8f, 0xd8, 0x1d, 0xd3, 0x6a,
+		0x05, 0xb0, 0xea, 0x82, 0x06, 0xdc, 0xf9, 0xa1, 0x80, 0x3b, 0x5f, 0xf9, 0x46, 0xc8, 0x8f, 0xd3,
+		0xdf, 0x03, 0x4e, 0xbe, 0x88, 0xcb, 0xb0, 0xc3, 0x10, 0xe3, 0xcf, 0xa2, 0x85, 0xe8, 0x01, 0x16,
+		0x4f, 0x21, 0x23, 0x99, 0x2e, 0x0f, 0xd3, 0x6c, 0x96, 0x50, 0x34, 0x38, 0x3a, 0x56, 0x87, 0x8f,
+		0x3f, 0x0a, 0x63, 0x84, 0xf8, 0x51, 0x58, 0x43, 0x34, 0xc7, 0xa6, 0x7d, 0x15, 0x81, 0x2c, 0x2c,
+		0xa5, 0xbc, 0x16, 0x86, 0xc3, 0xa3, 0x35, 0x39, 0x59, 0x80, 0x34, 0xda, 0xad, 0x4e, 0xd0, 0xc4,
+		0x85, 0x8e, 0x7e, 0x73, 0x83, 0xdb, 0xb7, 0xfc, 0x28, 0x21, 0xe4, 0x1e, 0x25, 0x90, 0x4d, 0x98,
+		0x6d, 0x5b, 0x0d, 0xbd, 0xad, 0xf9, 0x8e, 0x72, 0xf8, 0x24, 0xba, 0x77, 0x88, 0xd9, 0x41, 0x97,
+		0x21, 0x2a, 0x1c, 0x2e, 0xff, 0x39, 0x4a, 0x63, 0xc5, 0x3d, 0xf5, 0x21, 0x15, 0x48, 0x75, 0x5a,
+		0xf6, 0x96, 0xb1, 0xab, 0x5f, 0x6f, 0x59, 0x3d, 0x3e, 0x9b, 0x86, 0x85, 0x66, 0xd5, 0x83, 0x11,
+		0x27, 0x4c, 0x3e, 0x34, 0xdf, 0x90, 0x44, 0x47, 0x1e, 0x7c, 0xc5, 0x0e, 0xac, 0x4d, 0xc6, 0x9d,
+		0x21, 0xc5, 0xc7, 0x9e, 0x21, 0x8d, 0x3a, 0xb0, 0x49, 0x8c, 0x3e, 0xb0, 0xf9, 0xb0, 0x7f, 0x68,
+		0x82, 0x6b, 0xdf, 0xd0, 0x19, 0x0e, 0xa9, 0xc3, 0x3c, 0xc7, 0x6f, 0x06, 0x78, 0x3f, 0x22, 0xd4,
+		0x81, 0xcd, 0xaf, 0x41, 0x9e, 0x13, 0x81, 0x3e, 0x9e, 0xed, 0xe1, 0x3b, 0x63, 0xbb, 0x38, 0x44,
+		0x8d, 0xf8, 0x0e, 0x51, 0xff, 0x1f, 0x1b, 0x8a, 0x0f, 0x86, 0x61, 0x76, 0xc8, 0x90, 0x18, 0x79,
+		0x3a, 0x3c, 0xce, 0x23, 0x2b, 0x3a, 0x16, 0x3e, 0x70, 0xc7, 0xf8, 0x58, 0x47, 0x26, 0x8f, 0x75,
+		0xf4, 0x1d, 0x1c, 0xeb, 0xd8, 0x9d, 0x8d, 0xf5, 0xd7, 0x74, 0x14, 0x7e, 0x40, 0x82, 0xc2, 0x78,
+		0xeb, 0x6b, 0xe4, 0x70, 0x1c, 0xe8, 0x00, 0x61, 0xdc, 0x1a, 0x77, 0x1f, 0x64, 0x07, 0x6c, 0x43,
+		0x26, 0xca, 0x99, 0xc0, 0x2e, 0x5c, 0xf9, 0x50, 0x18, 0xe6, 0x47, 0x19, 0x70, 0x23, 0x66, 0xeb,
+		0xf3, 0xf8, 0x2a, 0x7f, 0xa3, 0xd5, 0xbc, 0xd3, 0xc9, 0x3a, 0xcb, 0xb1, 0xff, 0xff, 0x5c, 0x1d,
+		0x96, 0x92, 0xef, 0x06, 0x48, 0xa8, 0x86, 0xdd, 0x45, 0x7b, 0x0c, 0x8f, 0x23, 0x8c, 0x9b, 0x0d,
+		0xa3, 0xeb, 0x78, 0x41, 0x15, 0xa3, 0xb6, 0x08, 0x0c, 0xba, 0x2a, 0x20, 0x71, 0x83, 0xec, 0xa2,
+		0xe1, 0xc9, 0x0c, 0xf5, 0x01, 0x8c, 0xdf, 0xce, 0x73, 0x74, 0xbf, 0x13, 0xe0, 0x9c, 0x70, 0x02,
+		0x84, 0xc7, 0xee, 0x6f, 0x19, 0xd6, 0x80, 0x17, 0xe0, 0x71, 0xee, 0x05, 0x88, 0x4c, 0xa8, 0x2c,
+		0xe0, 0x06, 0x28, 0x07, 0xdc, 0x00, 0xb1, 0x09, 0xdd, 0x1c, 0xe3, 0x07, 0x38, 0x27, 0xfc, 0x00,
+		0xf1, 0x09, 0x2d, 0x1e, 0x70, 0x04, 0x3c, 0xe3, 0x73, 0x04, 0x24, 0x87, 0xbd, 0xad, 0x01, 0xd4,
+		0x11, 0x9e, 0x80, 0xf3, 0xae, 0x27, 0x20, 0x3d, 0xd6, 0x8b, 0xc0, 0x91, 0x07, 0x5d, 0x01, 0xb5,
+		0x21, 0x57, 0x40, 0x86, 0x7f, 0xe5, 0x77, 0x1c, 0x89, 0x09, 0xbe, 0x80, 0xda, 0x90, 0x2f, 0x20,
+		0x3b, 0x81, 0xe0, 0x04, 0x67, 0xc0, 0xd7, 0x8f, 0x76, 0x06, 0x8c, 0xdf, 0xae, 0xf3, 0x66, 0x4e,
+		0xe7, 0x0d, 0xd0, 0xc6, 0x78, 0x03, 0xe4, 0xb1, 0x3b, 0x57, 0x46, 0x7e, 0x6a, 0x77, 0xc0, 0xe6,
+		0x08, 0x77, 0x00, 0xdb, 0xb8, 0x9f, 0x1c, 0x4b, 0x7c, 0x0a, 0x7f, 0xc0, 0xe6, 0x08, 0x7f, 0x00,
+		0x99, 0x48, 0x76, 0xa2, 0x43, 0xe0, 0x42, 0xd0, 0x21, 0x30, 0x37, 0xc6, 0xea, 0xf4, 0x66, 0xfb,
+		0x18, 0x8f, 0xc0, 0xd6, 0x38, 0x8f, 0xc0, 0x3c, 0xff, 0x7a, 0xc0, 0x38, 0x8a, 0x07, 0x70, 0x09,
+		0xd4, 0x86, 0x5c, 0x02, 0x87, 0x26, 0x48, 0xda, 0xf4, 0x3e, 0x01, 0xf6, 0x3d, 0x29, 0xf6, 0x25,
+		0x29, 0x90, 0x53, 0x97, 0x23, 0x89, 0x94, 0x9c, 0x56, 0x1e, 0x84, 0x59, 0x41, 0xca, 0xd5, 0x73,
+		0xb8, 0x57, 0x30, 0x7a, 0x3d, 0xab, 0x27, 0xee, 0x58, 0xd0, 0x84, 0x72, 0x12, 0xd2, 0x2e, 0xe8,
+		0xfe, 0xfe, 0x03, 0xba, 0x27, 0xf3, 0xe9, 0x31, 0xe5, 0x17, 0x24, 0x48, 0xfb, 0x55, 0x54, 0x60,
+		0x7f, 0x99, 0xe4, 0xfb, 0x4b, 0x9f, 0x57, 0x21, 0x14, 0xf4, 0x2a, 0xe0, 0x57, 0x39, 0xba, 0x43,
+		0x0e, 0x03, 0xbd, 0xeb, 0x3a, 0x0c, 0x44, 0x00, 0x15, 0xf3, 0x3d, 0xf0, 0x65, 0x89, 0x9d, 0x26,
+		0xe6, 0xdc, 0x60, 0x32, 0xb6, 0xd5, 0x25, 0x8f, 0xc0, 0x9c, 0x0f, 0xd6, 0xdd, 0xc3, 0xb1, 0xdd,
+		0xb3, 0xec, 0x42, 0x17, 0xf9, 0x66, 0xee, 0x5f, 0x49, 0x30, 0x3b, 0xa4, 0x22, 0x47, 0x3a, 0x05,
+		0xa4, 0x77, 0xc8, 0x29, 0x10, 0xba, 0x63, 0xa7, 0x80, 0x7f, 0x4f, 0x1a, 0x0e, 0xee, 0x49, 0xff,
+		0xa7, 0x04, 0x99, 0x80, 0xa6, 0xc6, 0x21, 0x68, 0x58, 0x4d, 0x83, 0xef, 0x12, 0xe9, 0x6f, 0x34,
+		0x49, 0xda, 0x96, 0xf8, 0x0c, 0x10, 0xfe, 0x44, 0x28, 0x77, 0xe1, 0x49, 0xf2, 0x75, 0xc5, 0xdd,
+		0x60, 0x46, 0xfd, 0xf7, 0x38, 0xf8, 0xe5, 0x86, 0x98, 0x77, 0xb9, 0xc1, 0xbd, 0x18, 0x1d, 0xf7,
+		0x5d, 0x8c, 0x26, 0x4f, 0x41, 0x92, 0x3a, 0xfb, 0x35, 0xab, 0xcb, 0x16, 0xeb, 0x01, 0xd3, 0x26,
+		0x78, 0xb1, 0xc1, 0xa6, 0x21, 0xd7, 0xf4, 0x97, 0xcf, 0xe2, 0x48, 0x06, 0x2c, 0x8e, 0xbb, 0x20,
+		0x89, 0xad, 0x67, 0x9f, 0x14, 0x04, 0x7e, 0xab, 0x5e, 0x64, 0x28, 0x3f, 0x11, 0x82, 0xdc, 0xc0,
+		0x42, 0x33, 0xb2, 0xef, 0xa3, 0x8e, 0x91, 0xa7, 0xe3, 0xc7, 0x71, 0x80, 0x1d, 0xdd, 0xd6, 0x6e,
+		0xe8, 0xa6, 0xc3, 0x3f, 0x03, 0x1e, 0x56, 0x7d, 0x39, 0x78, 0x91, 0x01, 0x53, 0x7d, 0x9b, 0x7f,
+		0x08, 0x3c, 0xac, 0xba, 0x69, 0xbc, 0x29, 0x65, 0x5c, 0xa7, 0x6f, 0xf4, 0xb2, 0xaf, 0x40, 0x1c,
+		0x1e, 0xde, 0x0e, 0x63, 0x71, 0x29, 0x8f, 0x83, 0xfd, 0xa5, 0xd7, 0x4f, 0xc8, 0x0c, 0xfa, 0x61,
+		0xf7, 0x32, 0x9b, 0xca, 0xf1, 0x83, 0x5c, 0x48, 0x0c, 0x70, 0x81, 0xfa, 0x01, 0xd3, 0x62, 0x7b,
+		0x8f, 0x3c, 0x65, 0x31, 0xde, 0x6a, 0xa6, 0x63, 0x74, 0xba, 0x96, 0xd5, 0xd6, 0xd8, 0x1c, 0x2f,
+		0x42, 0xd6, 0xe5, 0x15, 0x5b, 0x4d, 0xe9, 0x57, 0x7b, 0x1d, 0x74, 0x8d, 0x05, 0x8c, 0xe0, 0x34,
+		0xcb, 0x64, 0x73, 0xca, 0xff, 0x00, 0xb3, 0xb2, 0x0e, 0x87, 0x04, 0x89, 0xc0, 0xba, 0x4a, 0x9e,
+		0x84, 0xa4, 0xb7, 0x24, 0xb3, 0x30, 0xfd, 0x7d, 0x3c, 0x2d, 0x1e, 0xac, 0xf2, 0x4b, 0x12, 0x1c,
+		0x1a, 0xb9, 0xb2, 0x92, 0x2a, 0xc4, 0x58, 0xcc, 0x24, 0x8f, 0x8f, 0x79, 0x64, 0xba, 0x15, 0x79,
+		0x91, 0x05, 0x54, 0xaa, 0x1c, 0x59, 0x79, 0x3f, 0xc4, 0x58, 0x0e, 0x49, 0x41, 0x3c, 0xf0, 0x1a,
+		0x7a, 0xb1, 0x5c, 0xae, 0xae, 0x8b, 0xa7, 0x7d, 0x4b, 0xf4, 0xa5, 0x71, 0xcc, 0x56, 0xab, 0x97,
+		0xab, 0xe5, 0x0d, 0x39, 0x8c, 0x9f, 0xad, 0x66, 0xbf, 0xf9, 0x9b, 0xe5, 0x72, 0xc4, 0x97, 0x55,
+		0xaf, 0xae, 0x55, 0xaa, 0xaa, 0x1c, 0x55, 0x1e, 0x83, 0xa3, 0xa2, 0x1d, 0xc3, 0x1e, 0x21, 0xd7,
+		0x31, 0x23, 0xf9, 0x1c, 0x33, 0xca, 0x6b, 0x21, 0x28, 0x08, 0x9c, 0x11, 0x3e, 0x9e, 0xcb, 0x03,
+		0x1d, 0x3f, 0x73, 0x80, 0x55, 0x7d, 0xa0, 0xf7, 0xb8, 0x8f, 0xe9, 0x19, 0xdb, 0x86, 0xd3, 0xd8,
+		0x65, 0x86, 0x02, 0xd3, 0x40, 0x19, 0x35, 0xc3, 0x73, 0x29, 0x92, 0xcd, 0xc0, 0x5e, 0x36, 0x1a,
+		0x8e, 0xc6, 0x84, 0xc8, 0xa6, 0x9b, 0x89, 0xa4, 0x9a, 0x61, 0xb9, 0x75, 0x96, 0xa9, 0x7c, 0xe3,
+		0x81, 0x78, 0x99, 0x84, 0xa8, 0x5a, 0xc5, 0x2f, 0x50, 0xd1, 0x6f, 0x73, 0xd3, 0x9f, 0x5a, 0x7d,
+		0xad, 0xb8, 0x5e, 0xbf, 0x54, 0x43, 0x5e, 0xce, 0x41, 0x4e, 0xf0, 0x52, 0x64, 0x46, 0x95, 0x87,
+		0xe0, 0xc8, 0x18, 0xab, 0x62, 0x44, 0x10, 0xeb, 0x27, 0x25, 0x3f, 0x74, 0xd0, 0x32, 0xa8, 0x41,
+		0xcc, 0x76, 0x74, 0xa7, 0x6f, 0x73, 0x26, 0x3e, 0x39, 0xad, 0x99, 0xb1, 0x28, 0x7e, 0xd4, 0x29,
+		0xba, 0xca, 0xc9, 0x28, 0x67, 0x21, 0x1b, 0x2c, 0x19, 0xcf, 0x03, 0x4f, 0x88, 0x42, 0xca, 0xd3,
+		0x40, 0x86, 0xad, 0x8f, 0x11, 0xdb, 0x4b, 0x69, 0xd4, 0xf6, 0xf2, 0x47, 0x25, 0x38, 0xb6, 0x8f,
+		0xa5, 0x41, 0x9e, 0x1f, 0xe8, 0xe4, 0xf9, 0x83, 0xd8, 0x29, 0x8b, 0x2c, 0x6f, 0xa0, 0x9b, 0x8f,
+		0x43, 0xda, 0x9f, 0x3f, 0x5d, 0x27, 0xbf, 0x14, 0x82, 0x43, 0x23, 0x8d, 0x16, 0x9f, 0x0a, 0x94,
+		0xde, 0xa6, 0x0a, 0x7c, 0x2f, 0x80, 0x73, 0x53, 0x44, 0x51, 0xf3, 0x75, 0xf4, 0xee, 0x11, 0xfe,
+		0x45, 0xa3, 0xb1, 0x71, 0x93, 0x4f, 0x82, 0xa4, 0xc3, 0x7f, 0xa1, 0xff, 0xc4, 0xe7, 0x14, 0xe8,
+		0xd3, 0x35, 0xd6, 0xce, 0x87, 0x0f, 0xb4, 0x18, 0xcb, 0xd7, 0x83, 0xd9, 0x36, 0xb9, 0x0a, 0x47,
+		0x06, 0x0c, 0x05, 0x97, 0x74, 0x64, 0x5a, 0x7b, 0xe1, 0x50, 0xd0, 0x5e, 0x10, 0xa4, 0xfd, 0xab,
+		0x7d, 0x34, 0xb8, 0xda, 0x5f, 0x05, 0xf0, 0x9c, 0x03, 0x5e, 0xd8, 0x9f, 0xe4, 0x0f, 0xfb, 0x3b,
+		0x0b, 0x51, 0x94, 0x24, 0xc1, 0xa7, 0x61, 0x55, 0x8c, 0x92, 0xe0, 0x73, 0x2e, 0x30, 0x68, 0xa5,
+		0x05, 0x64, 0xd8, 0x41, 0x3b, 0xa6, 0x8a, 0x67, 0x82, 0x55, 0xdc, 0x33, 0xd6, 0xd5, 0x3b, 0xba,
+		0xaa, 0x57, 0x21, 0x4a, 0x47, 0x7e, 0xe4, 0x5d, 0xca, 0x6f, 0x00, 0xd0, 0x1d, 0xa7, 0xd7, 0xda,
+		0xea, 0x7b, 0x15, 0x9c, 0x18, 0x2d, 0x39, 0x45, 0x01, 0x57, 0xba, 0x8b, 0x8b, 0xd0, 0xbc, 0x87,
+		0xea, 0x13, 0x23, 0x1f, 0x41, 0x65, 0x0d, 0xb2, 0x41, 0xdc, 0xd1, 0x77, 0x43, 0x87, 0xbf, 0xff,
+		0xe8, 0x59, 0x47, 0xfc, 0x31, 0x26, 0x9a, 0x50, 0x3e, 0x10, 0x82, 0xb4, 0x5f, 0xf0, 0xfe, 0xf6,
+		0x99, 0x20, 0xca, 0xb7, 0x4a, 0x90, 0x70, 0xbb, 0xbf, 0x4f, 0xb0, 0xb3, 0x77, 0x47, 0xd8, 0x3d,
+		0xbc, 0x60, 0xc7, 0x44, 0x61, 0xf7, 0x98, 0xe8, 0x69, 0x77, 0xf9, 0x1b, 0xe7, 0x10, 0xf1, 0xf3,
+		0x5a, 0x04, 0x1c, 0xf2, 0xd5, 0xfe, 0xe9, 0xe9, 0xae, 0xe2, 0xe1, 0x11, 0x96, 0xef, 0x1a, 0x1d,
+		0x4b, 0x28, 0x4d, 0x5f, 0x84, 0x11, 0x9b, 0x88, 0xfe, 0x3b, 0x7b, 0xd2, 0x81, 0xef, 0xec, 0xb9,
+		0xb5, 0x84, 0xfc, 0xb5, 0x7c, 0x97, 0x04, 0x09, 0x31, 0x27, 0xc8, 0xfb, 0xfc, 0x91, 0x67, 0xe2,
+		0x68, 0x73, 0xac, 0x3e, 0xe2, 0xf4, 0x3d, 0x94, 0xe1, 0x80, 0xeb, 0xf0, 0x41, 0x03, 0xae, 0xb9,
+		0x65, 0xf7, 0x17, 0x12, 0xc8, 0x83, 0x33, 0xf6, 0x6d, 0xb7, 0x6e, 0x78, 0x99, 0x0b, 0x8f, 0x58,
+		0xe6, 0xc6, 0x45, 0x51, 0x47, 0xc6, 0x45, 0x51, 0x0f, 0xf7, 0x3a, 0x7a, 0x87, 0xbd, 0xfe, 0x60,
+		0x08, 0x52, 0x3e, 0xf7, 0x28, 0x79, 0x22, 0x10, 0x91, 0xbd, 0xb0, 0x9f, 0x2b, 0xd5, 0x17, 0x92,
+		0x1d, 0x60, 0x53, 0xe8, 0xe0, 0x6c, 0x7a, 0xe7, 0x6f, 0x67, 0x8d, 0xbe, 0xc0, 0x1a, 0x1d, 0x73,
+		0x81, 0xf5, 0x5b, 0x24, 0x48, 0xb8, 0x66, 0xf7, 0x41, 0x0f, 0x31, 0x0f, 0x43, 0x8c, 0x5b, 0x96,
+		0xec, 0x14, 0x93, 0xa7, 0x46, 0xba, 0x95, 0x0b, 0x90, 0x10, 0x1f, 0x8a, 0xe7, 0xab, 0x9a, 0x9b,
+		0x3e, 0x75, 0x1e, 0x52, 0xbe, 0x03, 0x60, 0x54, 0x8d, 0x6b, 0xd5, 0x17, 0xe4, 0x99, 0x42, 0xfc,
+		0xd6, 0xed, 0x85, 0xf0, 0x9a, 0x71, 0x03, 0x67, 0xb3, 0x5a, 0x2d, 0x5f, 0xaa, 0x96, 0x9f, 0x93,
+		0xa5, 0x42, 0xea, 0xd6, 0xed, 0x85, 0xb8, 0x6a, 0x50, 0x8f, 0xe2, 0xa9, 0xe7, 0x20, 0x37, 0x30,
+		0x30, 0x41, 0xb3, 0x85, 0x40, 0xb6, 0xb2, 0x89, 0x1f, 0x77, 0x2a, 0x6e, 0x54, 0x35, 0x16, 0xb7,
+		0x8b, 0x1f, 0xc9, 0x58, 0x59, 0xbe, 0x78, 0x69, 0x43, 0x2b, 0xaf, 0x2c, 0x57, 0xd7, 0x36, 0xb4,
+		0xe2, 0xc6, 0x46, 0xb1, 0xfc, 0x9c, 0x1c, 0x3a, 0x73, 0x3b, 0x05, 0x11, 0x8c, 0xce, 0x23, 0x65,
+		0x88, 0x50, 0x57, 0xc8, 0xbe, 0x11, 0x60, 0x85, 0xfd, 0x7d, 0xc3, 0xe4, 0x02, 0x44, 0xa9, 0x97,
+		0x84, 0xec, 0x1f, 0x12, 0x56, 0x98, 0xe0, 0x2c, 0xc6, 0xc6, 0xd0, 0x19, 0xb9, 0x6f, 0x8c, 0x58,
+		0x61, 0x7f, 0xdf, 0x31, 0x59, 0x81, 0xb8, 0xd8, 0x24, 0x4f, 0x0a, 0xdc, 0x2a, 0x4c, 0x74, 0xe8,
+		0x62, 0xd7, 0x98, 0xb3, 0x61, 0xff, 0xf0, 0xb1, 0xc2, 0x04, 0xaf, 0x32, 0x3e, 0xef, 0xc1, 0xb7,
+		0xa3, 0x13, 0x22, 0xc2, 0x0a, 0x93, 0xfc, 0xc4, 0x44, 0x85, 0xa4, 0xe7, 0xc6, 0x99, 0x1c, 0x14,
+		0x57, 0x98, 0xc2, 0x61, 0x4e, 0xde, 0x0f, 0x99, 0xe0, 0x56, 0x77, 0xba, 0xa8, 0xb3, 0xc2, 0x94,
+		0x1e, 0x69, 0xa4, 0x1f, 0xdc, 0xf7, 0x4e, 0x17, 0x85, 0x56, 0x98, 0xd2, 0x41, 0x4d, 0x5e, 0x86,
+		0xd9, 0xe1, 0x7d, 0xe9, 0xf4, 0x41, 0x69, 0x85, 0x03, 0xb8, 0xac, 0x49, 0x07, 0xc8, 0x88, 0xfd,
+		0xec, 0x01, 0x62, 0xd4, 0x0a, 0x07, 0xf1, 0x60, 0x93, 0x26, 0xe4, 0x06, 0x37, 0x89, 0xd3, 0xc6,
+		0xac, 0x15, 0xa6, 0xf6, 0x66, 0xb3, 0x5a, 0x82, 0x9b, 0xcb, 0x69, 0x63, 0xd8, 0x0a, 0x53, 0x3b,
+		0xb7, 0xc9, 0x26, 0x80, 0x6f, 0x7f, 0x38, 0x45, 0x4c, 0x5b, 0x61, 0x1a, 0x37, 0x37, 0xe9, 0xc2,
+		0xdc, 0xa8, 0x8d, 0xe3, 0x41, 0x42, 0xdc, 0x0a, 0x07, 0xf2, 0x7e, 0xa3, 0x3c, 0x07, 0xb7, 0x80,
+		0xd3, 0x85, 0xbc, 0x15, 0xa6, 0x74, 0x83, 0x97, 0x8a, 0x63, 0xe3, 0x9c, 0x1f, 0xd8, 0x37, 0xce,
+		0xd9, 0x8b, 0x5c, 0x76, 0x63, 0x9b, 0xff, 0xf8, 0x49, 0x78, 0x0f, 0x7f, 0x41, 0xc7, 0x76, 0xf4,
+		0x6b, 0x2d, 0x73, 0xc7, 0x7d, 0x66, 0x89, 0xa7, 0x79, 0x90, 0xf3, 0x61, 0x06, 0xb5, 0x28, 0x72,
+		0x27, 0x3c, 0xb6, 0x34, 0xf6, 0x05, 0xca, 0x49, 0xf7, 0x21, 0x26, 0x87, 0x2f, 0xef, 0xf3, 0x90,
+		0xd3, 0x84, 0xe7, 0xa2, 0x46, 0x3c, 0xf4, 0x54, 0xd8, 0xf7, 0x8d, 0x81, 0xc2, 0x7e, 0x71, 0xdf,
+		0x68, 0xc5, 0x66, 0x2f, 0xb5, 0x6c, 0xc7, 0xea, 0xb5, 0x1a, 0x7a, 0x9b, 0x2e, 0x2f, 0x4f, 0x4f,
+		0x7b, 0x2b, 0xab, 0x94, 0x44, 0x73, 0x85, 0xbf, 0x05, 0xc5, 0x50, 0x48, 0x05, 0x62, 0xd7, 0xf5,
+		0x36, 0xbb, 0x13, 0xe5, 0x7f, 0x08, 0x6e, 0x90, 0xe7, 0x3e, 0x3b, 0xca, 0x4f, 0x85, 0xe1, 0x2a,
+		0xdf, 0x43, 0xef, 0x93, 0x74, 0x3a, 0x2d, 0x1b, 0x65, 0x51, 0xa5, 0x5b, 0xe9, 0xcb, 0x10, 0xe9,
+		0xe9, 0x0e, 0xdf, 0x60, 0x96, 0xce, 0x1d, 0xf8, 0x5d, 0x26, 0x56, 0x03, 0xa5, 0x41, 0x9e, 0x07,
+		0xbc, 0x8f, 0xa0, 0x51, 0x7a, 0xa1, 0xb7, 0x45, 0x0f, 0xaf, 0x65, 0x60, 0xfb, 0xc8, 0xfb, 0xe9,
+		0x77, 0x00, 0xf1, 0x1c, 0xd7, 0xdc, 0x31, 0x18, 0xe5, 0xf0, 0xdb, 0xa2, 0x9c, 0xe9, 0xe8, 0x37,
+		0xcb, 0x94, 0x1a, 0xd2, 0xe7, 0x4f, 0x5e, 0xfd, 0xaa, 0x04, 0xe0, 0x31, 0x86, 0xe8, 0x78, 0xc4,
+		0x21, 0x52, 0xb4, 0x52, 0x71, 0xc4, 0xf1, 0xc0, 0x38, 0xbe, 0x0f, 0xb0, 0xb5, 0x94, 0xc1, 0xe6,
+		0x7d, 0xee, 0xf5, 0x13, 0x12, 0xab, 0x35, 0xd7, 0x18, 0x62, 0x7b, 0x8a, 0x39, 0x43, 0x34, 0x6a,
+		0xc3, 0x86, 0x26, 0xda, 0xb0, 0x19, 0x61, 0xc3, 0x32, 0x82, 0xc0, 0xb0, 0xb1, 0x9c, 0xf7, 0xe1,
+		0xd3, 0x12, 0xa4, 0x2a, 0xbe, 0x67, 0x1d, 0xf1, 0x8c, 0xca, 0x32, 0x5b, 0xd7, 0x8c, 0x9e, 0x7b,
+		0x46, 0xc5, 0x92, 0x68, 0x67, 0xb2, 0x6f, 0x0c, 0x3b, 0x7b, 0xe2, 0x75, 0x21, 0x91, 0x46, 0xac,
+		0x1b, 0xc6, 0x96, 0xdd, 0x12, 0x7c, 0x56, 0x45, 0x12, 0x03, 0x0e, 0x6c, 0xa3, 0xd1, 0x47, 0xd7,
+		0x39, 0x7e, 0xc3, 0xdd, 0xc1, 0x4f, 0x8d, 0xb3, 0x7d, 0x78, 0x4e, 0xe4, 0x97, 0x59, 0x36, 0x12,
+		0x69, 0x1a, 0x8e, 0xde, 0x6a, 0xb3, 0xc8, 0xcc, 0xa4, 0x2a, 0x92, 0xbc, 0xa9, 0xb7, 0xe2, 0xfe,
+		0x7d, 0x68, 0x19, 0x64, 0xab, 0x6b, 0xf4, 0x02, 0x01, 0x29, 0x4c, 0x1a, 0xf3, 0xbf, 0xf3, 0xd9,
+		0x47, 0xe6, 0x39, 0xc3, 0x79, 0x30, 0x03, 0xfb, 0x8a, 0x81, 0x9a, 0x13, 0x18, 0x3c, 0x9b, 0x5c,
+		0x0d, 0x9c, 0x4a, 0xf5, 0xb7, 0xbc, 0x67, 0x64, 0xe6, 0x87, 0x98, 0x5a, 0x34, 0xf7, 0x4a, 0xf9,
+		0xdf, 0xf4, 0x48, 0x7b, 0xfb, 0x54, 0x7c, 0xb8, 0xc5, 0x77, 0x44, 0x45, 0xc9, 0xa0, 0x09, 0xff,
+		0xb2, 0xde, 0x6a, 0x8b, 0x6f, 0xde, 0xab, 0x3c, 0x45, 0x96, 0x5c, 0xf7, 0x22, 0xfb, 0xb8, 0xa5,
+		0x32, 0x4e, 0x36, 0x4a, 0x96, 0xd9, 0x0c, 0xfa, 0x11, 0x49, 0x19, 0x62, 0x8e, 0x75, 0xcd, 0x30,
+		0x39, 0x83, 0x0e, 0xf6, 0xb4, 0x1a, 0x47, 0x25, 0x5f, 0x0f, 0x72, 0xd3, 0x68, 0x1b, 0x3b, 0x94,
+		0x73, 0xf6, 0xae, 0x8e, 0xb7, 0x97, 0x63, 0x77, 0xfa, 0xbc, 0x5a, 0xce, 0x25, 0x55, 0xa7, 0x94,
+		0xc8, 0x7a, 0xf0, 0xe1, 0xd0, 0x38, 0x3f, 0x3c, 0x1e, 0xd3, 0x47, 0x9f, 0xe4, 0xf9, 0x35, 0x8f,
+		0x9f, 0x04, 0x4a, 0x50, 0xdf, 0xdc, 0xb2, 0x4c, 0xfa, 0xa5, 0x46, 0xbe, 0x8b, 0x4a, 0xb0, 0x93,
+		0x4a, 0x37, 0x9f, 0x9f, 0x54, 0xae, 0x43, 0xd6, 0x03, 0xa5, 0x33, 0x24, 0x79, 0xd0, 0x19, 0x92,
+		0x71, 0x09, 0x20, 0x08, 0x7e, 0xd2, 0xd1, 0x9b, 0x83, 0x79, 0xe0, 0x11, 0x21, 0x13, 0x67, 0xb3,
+		0xbf, 0x33, 0x3e, 0x02, 0x78, 0xa9, 0xa1, 0xd3, 0x32, 0x35, 0xdb, 0x68, 0x6f, 0x6b, 0x9c, 0x73,
+		0x48, 0x37, 0x75, 0xf0, 0xd1, 0x9c, 0xed, 0xb4, 0xcc, 0xba, 0xd1, 0xde, 0xae, 0xb8, 0x54, 0xc8,
+		0x7b, 0xe1, 0x98, 0xd7, 0x7b, 0xcb, 0xd4, 0x76, 0xad, 0x76, 0x53, 0xeb, 0x19, 0xdb, 0x5a, 0x83,
+		0xbe, 0xf3, 0x97, 0xa6, 0x3c, 0x3b, 0xe2, 0x82, 0xd4, 0xcc, 0x4b, 0x56, 0xbb, 0xa9, 0x1a, 0xdb,
+		0x65, 0x2c, 0xc6, 0x63, 0x2b, 0x0f, 0xbb, 0xd5, 0xc4, 0x18, 0x90, 0x30, 0x06, 0xa2, 0xbb, 0x99,
+		0xcb, 0x4d, 0x7b, 0x29, 0xf1, 0xe1, 0x4f, 0x9c, 0x98, 0x79, 0xf3, 0x13, 0x27, 0x66, 0x94, 0x0b,
+		0xf4, 0x4d, 0x29, 0x3e, 0x8f, 0x0c, 0x9b, 0x9c, 0x83, 0xa4, 0x2e, 0x12, 0xec, 0xc6, 0xd8, 0x3e,
+		0xf3, 0xd0, 0x03, 0xc5, 0xcb, 0x72, 0xb1, 0xca, 0x95, 0x75, 0xbd, 0xd5, 0x23, 0x55, 0x7c, 0xe1,
+		0x54, 0x08, 0xe6, 0xb4, 0x53, 0xda, 0x93, 0x65, 0x31, 0xa7, 0xd7, 0xc6, 0x85, 0xaa, 0x25, 0x4b,
+		0xf7, 0xfc, 0xce, 0x67, 0x1f, 0xb9, 0x9b, 0x93, 0xb9, 0x32, 0x10, 0xb5, 0x26, 0xe8, 0x0d, 0x46,
+		0xb3, 0xf9, 0xfa, 0x7c, 0x19, 0xe2, 0xac, 0xa9, 0x36, 0x79, 0x16, 0xa2, 0x5d, 0xfc, 0x91, 0x97,
+		0x02, 0x8f, 0x9e, 0x0e, 0x0b, 0x38, 0x85, 0xf7, 0x8b, 0x03, 0xc3, 0x53, 0x3e, 0x12, 0x02, 0xa8,
+		0x5c, 0xb9, 0xb2, 0xd1, 0x6b, 0x75, 0xdb, 0x86, 0xf3, 0x4e, 0xf5, 0x7d, 0x13, 0x0e, 0x79, 0x7d,
+		0xb7, 0x7b, 0x8d, 0x83, 0xf7, 0x7f, 0xce, 0xbb, 0x16, 0xdd, 0x6b, 0x8c, 0x24, 0xdb, 0xb4, 0x1d,
+		0x97, 0x6c, 0xf8, 0xe0, 0x64, 0x2b, 0xb6, 0x33, 0xcc, 0xd9, 0x17, 0x21, 0xe5, 0x31, 0xc3, 0x26,
+		0xcb, 0x90, 0x70, 0xf8, 0x6f, 0xce, 0x60, 0x65, 0x3c, 0x83, 0x05, 0x9a, 0x9f, 0xc9, 0x2e, 0xba,
+		0xf2, 0x57, 0x12, 0x80, 0x6f, 0x8e, 0xbc, 0x3b, 0x65, 0x0c, 0xb7, 0xeb, 0x5c, 0x13, 0x87, 0xef,
+		0xf8, 0x35, 0x4e, 0x46, 0xc0, 0xc7, 0xd4, 0x8f, 0x86, 0x60, 0x6e, 0x53, 0xcc, 0xde, 0x77, 0x3f,
+		0x0f, 0x36, 0x21, 0x6e, 0x98, 0x4e, 0xaf, 0xe5, 0x9e, 0x21, 0x3d, 0x3a, 0x6e, 0xcc, 0x47, 0x74,
+		0xaa, 0x6a, 0x3a, 0xbd, 0x3d, 0xbf, 0x04, 0x08, 0x5a, 0x3e, 0x7e, 0x7c, 0x3c, 0x0c, 0xf9, 0x71,
+		0xa8, 0xf8, 0x2e, 0x4d, 0xa3, 0x67, 0xd0, 0x8c, 0xe0, 0x15, 0xd1, 0xac, 0xc8, 0xe6, 0x6b, 0x8c,
+		0x0a, 0x68, 0x95, 0xa1, 0x70, 0x21, 0xe8, 0x9d, 0x99, 0x61, 0x59, 0x8f, 0x02, 0x5d, 0x65, 0x36,
+		0x20, 0x27, 0xee, 0x9c, 0x6c, 0xe9, 0x6d, 0x1d, 0xef, 0x5b, 0x87, 0x0f, 0xbe, 0x24, 0x88, 0x7b,
+		0x2b, 0x25, 0x46, 0x82, 0x54, 0x21, 0x2e, 0xa8, 0x45, 0x0e, 0x4e, 0x4d, 0xe0, 0xe2, 0xdd, 0x22,
+		0xff, 0xc2, 0x40, 0x4d, 0x8f, 0x88, 0x9a, 0x72, 0xf3, 0x96, 0x9b, 0x93, 0x56, 0x9e, 0xd8, 0xbe,
+		0x2b, 0x0f, 0xb7, 0xee, 0x7e, 0x88, 0x86, 0x86, 0x37, 0xff, 0xe6, 0x0f, 0xcb, 0x3a, 0x00, 0x9b,
+		0xaa, 0xa8, 0x49, 0xf3, 0x91, 0x3b, 0x9d, 0xef, 0x49, 0x46, 0xa4, 0x62, 0x3b, 0x7f, 0x5d, 0x23,
+		0xf4, 0x7b, 0x21, 0x48, 0xfb, 0x47, 0xe8, 0x6f, 0xe5, 0xa2, 0x45, 0xd6, 0x3c, 0x35, 0xc5, 0xae,
+		0xcf, 0x3c, 0x38, 0x4e, 0x4d, 0x0d, 0x49, 0xf3, 0x04, 0xfd, 0xf4, 0xcb, 0x31, 0x88, 0xf1, 0xf8,
+		0xb6, 0xda, 0x90, 0x21, 0x2b, 0x4d, 0x7a, 0x00, 0x20, 0x23, 0x1e, 0x00, 0x18, 0x69, 0xc7, 0xde,
+		0x07, 0xf8, 0x2c, 0x80, 0x16, 0x08, 0x9a, 0xc3, 0x83, 0x05, 0xdc, 0xd7, 0xba, 0xbd, 0xb7, 0x31,
+		0x6e, 0x10, 0xc1, 0x3c, 0x3d, 0x8c, 0x30, 0x78, 0x53, 0xa9, 0xca, 0x72, 0xc8, 0x23, 0x40, 0x76,
+		0x5d, 0x07, 0x85, 0xe6, 0x31, 0x02, 0xe1, 0x66, 0xbd, 0x12, 0x01, 0x8e, 0x2f, 0xa0, 0x58, 0x66,
+		0x53, 0x63, 0x0f, 0x50, 0xf3, 0xe7, 0xbd, 0x31, 0xa7, 0x82, 0x19, 0xe4, 0x43, 0x12, 0xb3, 0x87,
+		0x07, 0xb6, 0xcd, 0x7c, 0x3b, 0xb2, 0x31, 0xc5, 0xa4, 0xf8, 0xf3, 0xd7, 0x4f, 0x14, 0xf6, 0xf4,
+		0x4e, 0x7b, 0x49, 0x19, 0x41, 0x47, 0x19, 0xb5, 0x93, 0x47, 0xc3, 0x39, 0xb8, 0xed, 0x26, 0xaf,
+		0xc0, 0xd1, 0x9d, 0xb6, 0xb5, 0x85, 0xb7, 0xad, 0x5a, 0xaf, 0xf4, 0x5b, 0x4d, 0x8d, 0x8f, 0xa4,
+		0xd6, 0xd0, 0xbb, 0xf9, 0xf8, 0xdb, 0xf2, 0x1b, 0x1c, 0x66, 0x84, 0x57, 0x28, 0xdd, 0x3a, 0x23,
+		0x5b, 0xd6, 0xbb, 0xe4, 0x06, 0xdc, 0xe5, 0x09, 0xe7, 0x88, 0x5a, 0x13, 0x6f, 0xab, 0xd6, 0xa3,
+		0x2e, 0xed, 0xa1, 0x8a, 0xd7, 0x40, 0xc6, 0x97, 0x1d, 0x7a, 0xfc, 0x9b, 0xed, 0xda, 0xb6, 0x21,
+		0x36, 0x49, 0x47, 0x17, 0x47, 0x3c, 0x7d, 0xbe, 0x88, 0x4f, 0x88, 0xfb, 0xe5, 0x36, 0x7b, 0xcd,
+		0xd8, 0x53, 0x39, 0xf2, 0x05, 0xc3, 0x20, 0x86, 0x6f, 0x47, 0x43, 0x87, 0x9a, 0x8a, 0x63, 0x1e,
+		0xde, 0x56, 0xfb, 0x65, 0xbe, 0xb9, 0xc1, 0x2d, 0xb0, 0x8a, 0xf4, 0x96, 0xde, 0x83, 0x1a, 0xe8,
+		0xd6, 0x1b, 0x3f, 0x7d, 0xea, 0x98, 0xef, 0x59, 0xee, 0x9b, 0xae, 0x87, 0x91, 0x4d, 0x1b, 0xe5,
+		0xd7, 0x24, 0x98, 0xdb, 0xc0, 0x5d, 0x6e, 0xeb, 0x55, 0x83, 0xee, 0x47, 0x55, 0xa3, 0x61, 0xf5,
+		0x9a, 0x78, 0x1a, 0xce, 0x5f, 0xf2, 0x89, 0xa8, 0xa1, 0x56, 0x13, 0x1f, 0xbb, 0xb1, 0x6e, 0x98,
+		0xfc, 0x0c, 0x79, 0x3f, 0x65, 0xc5, 0xc0, 0xe8, 0xec, 0xb1, 0x9a, 0xfd, 0xb6, 0xa1, 0xe9, 0x0d,
+		0xa6, 0x30, 0x99, 0x97, 0x23, 0xc3, 0x72, 0x8b, 0x2c, 0x33, 0xf8, 0xe2, 0x49, 0x64, 0x5a, 0x2d,
+		0xe3, 0xe1, 0x70, 0x3d, 0xfb, 0xe3, 0x12, 0x10, 0xcf, 0x3c, 0x71, 0xaf, 0x60, 0xac, 0x02, 0xf8,
+		0xb6, 0x8c, 0xd2, 0xfe, 0x5b, 0x51, 0x0f, 0x3f, 0xb0, 0x15, 0xf5, 0x29, 0xef, 0xf7, 0x79, 0xd6,
+		0x41, 0xe8, 0x00, 0xe3, 0x2f, 0x90, 0x68, 0x5b, 0x67, 0x94, 0x7f, 0x27, 0x61, 0xa4, 0xde, 0x80,
+		0x9e, 0x73, 0x9b, 0xdc, 0x00, 0xd2, 0xf3, 0x15, 0x52, 0x7d, 0x21, 0xce, 0xfc, 0xef, 0x4c, 0x6d,
+		0xce, 0xf6, 0x06, 0x4b, 0xdf, 0x21, 0x33, 0x87, 0xf3, 0xfe, 0x37, 0x24, 0xbc, 0x7b, 0xd4, 0x1c,
+		0xe6, 0x7e, 0x1d, 0xd2, 0xfe, 0xaa, 0x79, 0x27, 0xde, 0x33, 0x4d, 0x27, 0xfc, 0xed, 0x0f, 0x10,
+		0x21, 0x57, 0xbc, 0xb5, 0x84, 0x39, 0x68, 0x1f, 0x9b, 0x9a, 0x29, 0xa2, 0x61, 0x23, 0xd7, 0x14,
+		0x36, 0x36, 0x7f, 0x24, 0x41, 0x64, 0xdd, 0xb2, 0xda, 0xe4, 0x15, 0x98, 0x35, 0x2d, 0x87, 0x4e,
+		0x4f, 0xa3, 0xa9, 0x71, 0x0f, 0x12, 0x5b, 0xa7, 0xab, 0xfb, 0xf2, 0xea, 0x4b, 0xaf, 0x9f, 0x18,
+		0xc6, 0x1c, 0xf5, 0xf1, 0x81, 0x9c, 0x69, 0x39, 0x25, 0x0a, 0x44, 0xa7, 0x9f, 0x4d, 0xb6, 0x21,
+		0x13, 0xac, 0x8e, 0xcd, 0xb4, 0xe2, 0xa4, 0xea, 0x32, 0x13, 0xab, 0x4a, 0x6f, 0xf9, 0xea, 0x61,
+		0xef, 0x75, 0xff, 0x29, 0x8e, 0xdc, 0x55, 0x90, 0xaf, 0x0c, 0xc6, 0x92, 0x55, 0x21, 0x2e, 0x62,
+		0xc7, 0xa4, 0x29, 0xc3, 0xd2, 0xfc, 0xec, 0xe4, 0xb8, 0xca, 0x67, 0xc2, 0x70, 0x14, 0x43, 0xcc,
+		0xb8, 0xab, 0x8f, 0x6b, 0x3f, 0xe6, 0xa8, 0xdf, 0xc3, 0xc8, 0xf6, 0x31, 0x8e, 0xc8, 0x29, 0x26,
+		0xff, 0x90, 0x47, 0xf2, 0x0a, 0xe4, 0xd0, 0x34, 0x43, 0x6f, 0xe2, 0xdb, 0x73, 0x48, 0x66, 0xac,
+		0x76, 0x93, 0x37, 0x1a, 0xdd, 0x91, 0x57, 0x20, 0x67, 0x1a, 0x37, 0x02, 0x74, 0xc3, 0x77, 0x46,
+		0xd7, 0x34, 0x6e, 0xf8, 0xe8, 0x7a, 0x91, 0x0d, 0x91, 0x40, 0x98, 0xc5, 0x33, 0x3c, 0xcc, 0x22,
+		0x7a, 0x50, 0x23, 0x9c, 0xa2, 0x91, 0x73, 0x10, 0xde, 0x36, 0x98, 0x21, 0x30, 0xad, 0x66, 0x42,
+		0x04, 0xcf, 0x9a, 0x3a, 0xf5, 0x73, 0x12, 0x80, 0xe7, 0x42, 0xc5, 0xf7, 0xc6, 0x4a, 0xb5, 0xb5,
+		0x8a, 0x56, 0xdf, 0x28, 0x6e, 0x6c, 0xd6, 0x83, 0xdf, 0x19, 0x12, 0xaf, 0x93, 0xd9, 0x5d, 0xa3,
+		0xd1, 0xda, 0x6e, 0x19, 0x4d, 0x72, 0x3f, 0xcc, 0x07, 0xa1, 0x31, 0x55, 0xad, 0xc8, 0x52, 0x21,
+		0x7d, 0xeb, 0xf6, 0x42, 0x82, 0xed, 0x22, 0x0d, 0x7c, 0x6b, 0xf7, 0xd0, 0x30, 0x1c, 0x7e, 0xa3,
+		0x28, 0x54, 0xc8, 0xdc, 0xba, 0xbd, 0x90, 0x74, 0xb7, 0x9b, 0x44, 0x01, 0xe2, 0x87, 0xe4, 0xf4,
+		0xc2, 0x05, 0xb8, 0x75, 0x7b, 0x21, 0xc6, 0xa6, 0x4e, 0x21, 0x82, 0x6f, 0x90, 0x9d, 0xfa, 0x06,
+		0x80, 0x65, 0x73, 0xbb, 0xa7, 0x37, 0xa8, 0x8a, 0x28, 0xc0, 0xe1, 0xe5, 0xb5, 0x0b, 0x6a, 0xb1,
+		0xbc, 0xb1, 0x5c, 0x5b, 0x0b, 0x36, 0x7b, 0xa0, 0xac, 0x52, 0xdb, 0x2c, 0xad, 0x54, 0x35, 0x7c,
+		0xf5, 0x8c, 0x85, 0x62, 0x04, 0xca, 0x5e, 0x58, 0xdb, 0x58, 0x5e, 0xad, 0xca, 0xa1, 0xd2, 0x85,
+		0xb1, 0x47, 0x7d, 0x0f, 0xef, 0xfb, 0x0d, 0x0c, 0x6f, 0xb1, 0x0d, 0x9c, 0xf7, 0xfd, 0x9f, 0x01,
+		0x00, 0x7e, 0xf4, 0xbe, 0xf3, 0xfd, 0xb3, 0x00, 0x00,
 	}
 	r := bytes.NewReader(gzipped)
 	gzipr, err := compress_gzip.NewReader(r)
@@ -2328,6 +2578,51 @@ func (this *Params) Equal(that interface{}) bool {
 	if !this.MinCommissionRate.Equal(that1.MinCommissionRate) {
 		return false
 	}
+	if !this.GlobalLiquidStakingCap.Equal(that1.GlobalLiquidStakingCap) {
+		return false
+	}
+	if !this.ValidatorLiquidStakingCap.Equal(that1.ValidatorLiquidStakingCap) {
+		return false
+	}
+	if !this.KeyRotationFee.Equal(&that1.KeyRotationFee) {
+		return false
+	}
+	if !this.MinSelfBondRatio.Equal(that1.MinSelfBondRatio) {
+		return false
+	}
+	return true
+}
+func (this *TokenizeShareRecord) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*TokenizeShareRecord)
+	if !ok {
+		that2, ok := that.(TokenizeShareRecord)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Id != that1.Id {
+		return false
+	}
+	if this.Owner != that1.Owner {
+		return false
+	}
+	if this.ModuleAccount != that1.ModuleAccount {
+		return false
+	}
+	if this.Validator != that1.Validator {
+		return false
+	}
 	return true
 }
 func (this *RedelegationEntryResponse) Equal(that interface{}) bool {
@@ -3221,6 +3516,46 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.MinSelfBondRatio.Size()
+		i -= size
+		if _, err := m.MinSelfBondRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	{
+		size, err := m.KeyRotationFee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	{
+		size := m.ValidatorLiquidStakingCap.Size()
+		i -= size
+		if _, err := m.ValidatorLiquidStakingCap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.GlobalLiquidStakingCap.Size()
+		i -= size
+		if _, err := m.GlobalLiquidStakingCap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
 	{
 		size := m.MinCommissionRate.Size()
 		i -= size
@@ -3253,17 +3588,66 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x10
 	}
-	n12, err12 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.UnbondingTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.UnbondingTime):])
-	if err12 != nil {
-		return 0, err12
+	n13, err13 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.UnbondingTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.UnbondingTime):])
+	if err13 != nil {
+		return 0, err13
 	}
-	i -= n12
-	i = encodeVarintStaking(dAtA, i, uint64(n12))
+	i -= n13
+	i = encodeVarintStaking(dAtA, i, uint64(n13))
 	i--
 	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
+func (m *TokenizeShareRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TokenizeShareRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TokenizeShareRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ModuleAccount) > 0 {
+		i -= len(m.ModuleAccount)
+		copy(dAtA[i:], m.ModuleAccount)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.ModuleAccount)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *DelegationResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -3477,6 +3861,83 @@ func (m *ValidatorUpdates) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ConsPubKeyRotationHistory) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConsPubKeyRotationHistory) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConsPubKeyRotationHistory) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Fee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	n19, err19 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
+	if err19 != nil {
+		return 0, err19
+	}
+	i -= n19
+	i = encodeVarintStaking(dAtA, i, uint64(n19))
+	i--
+	dAtA[i] = 0x2a
+	if m.Height != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.NewConsPubkey != nil {
+		{
+			size, err := m.NewConsPubkey.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintStaking(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.OldConsPubkey != nil {
+		{
+			size, err := m.OldConsPubkey.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintStaking(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.OperatorAddress) > 0 {
+		i -= len(m.OperatorAddress)
+		copy(dAtA[i:], m.OperatorAddress)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.OperatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintStaking(dAtA []byte, offset int, v uint64) int {
 	offset -= sovStaking(v)
 	base := offset
@@ -3833,6 +4294,38 @@ func (m *Params) Size() (n int) {
 	}
 	l = m.MinCommissionRate.Size()
 	n += 1 + l + sovStaking(uint64(l))
+	l = m.GlobalLiquidStakingCap.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = m.ValidatorLiquidStakingCap.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = m.KeyRotationFee.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = m.MinSelfBondRatio.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	return n
+}
+
+func (m *TokenizeShareRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovStaking(uint64(m.Id))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.ModuleAccount)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
 	return n
 }
 
@@ -3907,7 +4400,35 @@ func (m *ValidatorUpdates) Size() (n int) {
 	return n
 }
 
-func sovStaking(x uint64) (n int) {
+func (m *ConsPubKeyRotationHistory) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OperatorAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	if m.OldConsPubkey != nil {
+		l = m.OldConsPubkey.Size()
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	if m.NewConsPubkey != nil {
+		l = m.NewConsPubkey.Size()
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovStaking(uint64(m.Height))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovStaking(uint64(l))
+	l = m.Fee.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	return n
+}
+
+func sovStaking(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
 func sozStaking(x uint64) (n int) {
@@ -6567,59 +7088,77 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipStaking(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GlobalLiquidStakingCap", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *DelegationResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowStaking
+			if err := m.GlobalLiquidStakingCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if iNdEx >= l {
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorLiquidStakingCap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.ValidatorLiquidStakingCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: DelegationResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DelegationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Delegation", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyRotationFee", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6646,15 +7185,15 @@ func (m *DelegationResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Delegation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.KeyRotationFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MinSelfBondRatio", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -6664,22 +7203,23 @@ func (m *DelegationResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Balance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MinSelfBondRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6704,7 +7244,7 @@ func (m *DelegationResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RedelegationEntryResponse) Unmarshal(dAtA []byte) error {
+func (m *TokenizeShareRecord) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6727,17 +7267,36 @@ func (m *RedelegationEntryResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RedelegationEntryResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: TokenizeShareRecord: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RedelegationEntryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TokenizeShareRecord: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RedelegationEntry", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -6747,28 +7306,59 @@ func (m *RedelegationEntryResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.RedelegationEntry.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModuleAccount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.ModuleAccount = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6796,9 +7386,7 @@ func (m *RedelegationEntryResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Balance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Validator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6821,7 +7409,7 @@ func (m *RedelegationEntryResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RedelegationResponse) Unmarshal(dAtA []byte) error {
+func (m *DelegationResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6844,15 +7432,15 @@ func (m *RedelegationResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RedelegationResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: DelegationResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RedelegationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DelegationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Redelegation", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegation", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6879,13 +7467,13 @@ func (m *RedelegationResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Redelegation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Delegation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6912,8 +7500,7 @@ func (m *RedelegationResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Entries = append(m.Entries, RedelegationEntryResponse{})
-			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Balance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6938,7 +7525,7 @@ func (m *RedelegationResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Pool) Unmarshal(dAtA []byte) error {
+func (m *RedelegationEntryResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6961,17 +7548,17 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Pool: wiretype end group for non-group")
+			return fmt.Errorf("proto: RedelegationEntryResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Pool: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RedelegationEntryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NotBondedTokens", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RedelegationEntry", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -6981,29 +7568,28 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.NotBondedTokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.RedelegationEntry.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BondedTokens", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7031,7 +7617,7 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BondedTokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Balance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -7056,7 +7642,7 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ValidatorUpdates) Unmarshal(dAtA []byte) error {
+func (m *RedelegationResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7079,15 +7665,15 @@ func (m *ValidatorUpdates) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ValidatorUpdates: wiretype end group for non-group")
+			return fmt.Errorf("proto: RedelegationResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ValidatorUpdates: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RedelegationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Updates", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Redelegation", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7114,8 +7700,482 @@ func (m *ValidatorUpdates) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Updates = append(m.Updates, types3.ValidatorUpdate{})
-			if err := m.Updates[len(m.Updates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Redelegation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Entries = append(m.Entries, RedelegationEntryResponse{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Pool) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Pool: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Pool: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NotBondedTokens", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.NotBondedTokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BondedTokens", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BondedTokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorUpdates) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorUpdates: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorUpdates: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Updates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Updates = append(m.Updates, types3.ValidatorUpdate{})
+			if err := m.Updates[len(m.Updates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConsPubKeyRotationHistory) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConsPubKeyRotationHistory: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConsPubKeyRotationHistory: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OperatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OldConsPubkey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.OldConsPubkey == nil {
+				m.OldConsPubkey = &types1.Any{}
+			}
+			if err := m.OldConsPubkey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewConsPubkey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.NewConsPubkey == nil {
+				m.NewConsPubkey = &types1.Any{}
+			}
+			if err := m.NewConsPubkey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Fee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex